@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/k8s"
+	"github.com/coding-cave-dev/nimbul/pkg/nimbul"
+	"github.com/joho/godotenv"
+	"k8s.io/client-go/rest"
+)
+
+// pollWait is how long the API holds each long-poll request open, in seconds
+const pollWait int64 = 30
+
+// retryDelay is how long to back off after a failed poll
+const retryDelay = 5 * time.Second
+
+// nimbul-agent runs inside a target cluster and connects out to the Nimbul API,
+// applying the manifests queued for it. The API never needs to reach the cluster.
+func main() {
+	// Load environment variables from .env file
+	err := godotenv.Load()
+	if err != nil {
+		// Don't panic if .env file doesn't exist, use system env vars
+	}
+
+	baseURL := os.Getenv("NIMBUL_API_URL")
+	if baseURL == "" {
+		fmt.Fprintln(os.Stderr, "NIMBUL_API_URL environment variable is not set")
+		os.Exit(1)
+	}
+
+	token := os.Getenv("NIMBUL_AGENT_TOKEN")
+	if token == "" {
+		fmt.Fprintln(os.Stderr, "NIMBUL_AGENT_TOKEN environment variable is not set")
+		os.Exit(1)
+	}
+
+	// In-cluster service account unless KUBECONFIG is set
+	clusterConfig, err := k8s.GetServerConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load cluster configuration: %v\n", err)
+		os.Exit(1)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	client, err := nimbul.NewClientWithResponses(baseURL)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to create API client: %v\n", err)
+		os.Exit(1)
+	}
+	authHeader := fmt.Sprintf("Bearer %s", token)
+
+	fmt.Println("Nimbul agent started, waiting for deployments")
+	for ctx.Err() == nil {
+		if err := poll(ctx, client, clusterConfig, authHeader); err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+			fmt.Printf("Error polling for deployments: %v\n", err)
+			select {
+			case <-ctx.Done():
+			case <-time.After(retryDelay):
+			}
+		}
+	}
+	fmt.Println("Nimbul agent stopped")
+}
+
+// poll waits for the next queued deployment, applies it and reports the outcome
+func poll(ctx context.Context, client *nimbul.ClientWithResponses, clusterConfig *rest.Config, authHeader string) error {
+	wait := pollWait
+	resp, err := client.GetAgentDeploymentsNextWithResponse(ctx, &nimbul.GetAgentDeploymentsNextParams{
+		Wait:          &wait,
+		Authorization: &authHeader,
+	})
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode() != 200 {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode())
+	}
+
+	if resp.JSON200 == nil || resp.JSON200.Deployment == nil {
+		return nil
+	}
+
+	deployment := resp.JSON200.Deployment
+	fmt.Printf("\n=== Applying Deployment %d (config %s) ===\n", deployment.Id, deployment.ConfigId)
+
+	body := nimbul.ReportAgentDeploymentRequestBody{Status: nimbul.Applied}
+	if err := applyDeployment(ctx, clusterConfig, deployment.Manifests); err != nil {
+		fmt.Printf("✗ Failed to apply deployment %d: %v\n", deployment.Id, err)
+		errMsg := err.Error()
+		body = nimbul.ReportAgentDeploymentRequestBody{Status: nimbul.Failed, Error: &errMsg}
+	} else {
+		fmt.Printf("✓ Successfully applied deployment %d\n", deployment.Id)
+	}
+
+	reportResp, err := client.PostAgentDeploymentsByIdStatusWithResponse(ctx, deployment.Id, &nimbul.PostAgentDeploymentsByIdStatusParams{
+		Authorization: &authHeader,
+	}, body)
+	if err != nil {
+		return fmt.Errorf("failed to report deployment %d: %w", deployment.Id, err)
+	}
+
+	if reportResp.StatusCode() != 200 {
+		return fmt.Errorf("failed to report deployment %d: status %d", deployment.Id, reportResp.StatusCode())
+	}
+
+	return nil
+}
+
+// applyDeployment validates the manifests with a server-side dry run before applying
+// them, so an invalid document does not leave the cluster half-updated
+func applyDeployment(ctx context.Context, clusterConfig *rest.Config, manifests string) error {
+	if err := k8s.DryRunManifestsWithConfig(ctx, clusterConfig, []byte(manifests)); err != nil {
+		return fmt.Errorf("manifest validation failed, nothing was applied: %w", err)
+	}
+
+	_, err := k8s.ApplyManifestsWithConfig(ctx, clusterConfig, []byte(manifests))
+	return err
+}
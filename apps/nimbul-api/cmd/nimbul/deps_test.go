@@ -0,0 +1,34 @@
+package main
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+// The CLI must build without the server, so it may not import the packages that talk
+// to the database, the cluster or BuildKit
+func TestDoesNotImportServerPackages(t *testing.T) {
+	out, err := exec.Command("go", "list", "-deps", ".").Output()
+	if err != nil {
+		t.Fatalf("go list -deps: %v", err)
+	}
+
+	forbidden := []string{
+		"github.com/coding-cave-dev/nimbul/internal/db",
+		"github.com/coding-cave-dev/nimbul/internal/k8s",
+		"github.com/coding-cave-dev/nimbul/internal/buildkit",
+		"github.com/coding-cave-dev/nimbul/internal/registry",
+		"github.com/coding-cave-dev/nimbul/internal/httpserver",
+		"k8s.io/",
+		"github.com/moby/buildkit",
+		"github.com/jackc/pgx",
+	}
+	for _, pkg := range strings.Fields(string(out)) {
+		for _, prefix := range forbidden {
+			if pkg == prefix || strings.HasPrefix(pkg, strings.TrimSuffix(prefix, "/")+"/") {
+				t.Errorf("the CLI imports %s", pkg)
+			}
+		}
+	}
+}
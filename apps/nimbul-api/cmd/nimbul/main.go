@@ -0,0 +1,12 @@
+// Command nimbul is the Nimbul CLI. It is its own module and only imports the
+// packages the CLI needs, so it builds without the server's dependencies and runs
+// without its configuration.
+package main
+
+import (
+	cli "github.com/coding-cave-dev/nimbul/internal/cli"
+)
+
+func main() {
+	cli.Execute()
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/coding-cave-dev/nimbul/internal/agents"
+	"github.com/coding-cave-dev/nimbul/internal/artifacts"
+	"github.com/coding-cave-dev/nimbul/internal/auth"
+	"github.com/coding-cave-dev/nimbul/internal/builds"
+	"github.com/coding-cave-dev/nimbul/internal/configs"
+	"github.com/coding-cave-dev/nimbul/internal/credentials"
+	"github.com/coding-cave-dev/nimbul/internal/db"
+	"github.com/coding-cave-dev/nimbul/internal/deployments"
+	"github.com/coding-cave-dev/nimbul/internal/email"
+	"github.com/coding-cave-dev/nimbul/internal/github"
+	"github.com/coding-cave-dev/nimbul/internal/githubapp"
+	"github.com/coding-cave-dev/nimbul/internal/hooks"
+	"github.com/coding-cave-dev/nimbul/internal/installations"
+	"github.com/coding-cave-dev/nimbul/internal/jobs"
+	"github.com/coding-cave-dev/nimbul/internal/limits"
+	"github.com/coding-cave-dev/nimbul/internal/notifications"
+	"github.com/coding-cave-dev/nimbul/internal/policies"
+	"github.com/coding-cave-dev/nimbul/internal/sandbox"
+	"github.com/coding-cave-dev/nimbul/internal/storage"
+	"github.com/coding-cave-dev/nimbul/internal/usage"
+	"github.com/coding-cave-dev/nimbul/internal/webhooks"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/joho/godotenv"
+)
+
+func getDatabaseURL() string {
+	// Check if DATABASE_URL is set directly
+	databaseURL := os.Getenv("DATABASE_URL")
+	if databaseURL != "" {
+		return databaseURL
+	}
+
+	// Construct database URL from individual PostgreSQL environment variables
+	host := os.Getenv("POSTGRES_HOST")
+	if host == "" {
+		host = "localhost"
+	}
+	port := os.Getenv("POSTGRES_PORT")
+	if port == "" {
+		port = "5432"
+	}
+	user := os.Getenv("POSTGRES_USER")
+	if user == "" {
+		user = "nimbul"
+	}
+	password := os.Getenv("POSTGRES_PASSWORD")
+	if password == "" {
+		password = "nimbul"
+	}
+	dbName := os.Getenv("POSTGRES_DB")
+	if dbName == "" {
+		dbName = "nimbul"
+	}
+	return fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable", user, password, host, port, dbName)
+}
+
+// nimbul-worker runs the pipeline jobs the API queues when NIMBUL_JOB_RUNNER is
+// "worker": it clones, builds and deploys, so the API server stays small and workers
+// scale on their own. It needs the database, MASTER_ENCRYPTION_KEY and the BuildKit
+// and registry settings of the API, and uses the GitHub App the API uses. Workers on
+// the host of the API server need their own NIMBUL_SANDBOX_ROOT, since each removes
+// the sandboxes left in it when it starts. Workers claim jobs from the control service
+// of the API server at NIMBUL_WORKER_GRPC_URL over TLS, authenticating with
+// NIMBUL_WORKER_TOKEN, and relay the logs of their builds through it so they stream from
+// the API as builds run.
+func main() {
+	// Load environment variables from .env file
+	err := godotenv.Load()
+	if err != nil {
+		// Don't panic if .env file doesn't exist, use system env vars
+	}
+
+	conn, err := pgxpool.New(context.Background(), getDatabaseURL())
+	if err != nil {
+		panic(err)
+	}
+	defer conn.Close()
+
+	queries := db.New(conn)
+
+	// Only the account checks of auth are used, which need no JWT secret
+	authService := auth.NewService(queries, "")
+
+	credentialsService, err := credentials.NewService(queries)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to initialize credentials service: %v\n", err)
+		os.Exit(1)
+	}
+
+	limitsService := limits.NewService(queries)
+
+	// The GitHub App admins configure takes precedence over the environment, as in the
+	// API server
+	github.SetAppConfigSource(githubapp.NewService(queries, credentialsService).Load)
+
+	// Workers write build logs and artifacts to the object storage of the API server
+	store := storage.NewFromEnv()
+
+	// Clones and builds happen in per-config sandboxes; those left behind by a previous
+	// run of the worker are removed before any new ones are opened
+	sandboxes := sandbox.NewManagerFromEnv()
+	sandboxes.Sweep(0)
+
+	workerID, _ := os.Hostname()
+	workerID = fmt.Sprintf("%s-%d", workerID, os.Getpid())
+	control, err := jobs.NewControlClientFromEnv(workerID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to connect to the API server: %v\n", err)
+		os.Exit(1)
+	}
+	defer control.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	go sandboxes.Run(ctx)
+
+	buildsService := builds.NewService(queries, store)
+	buildsService.SetLogRelay(control)
+	relayCtx, stopRelay := context.WithCancel(context.Background())
+	relayed := make(chan struct{})
+	go func() {
+		control.RelayLogs(relayCtx)
+		close(relayed)
+	}()
+
+	webhooksService := webhooks.NewService(
+		configs.NewService(queries),
+		credentialsService,
+		agents.NewService(queries, store),
+		deployments.NewService(queries, store),
+		hooks.NewService(queries),
+		notifications.NewService(queries, email.NewFromEnv()),
+		limitsService,
+		buildsService,
+		usage.NewService(queries, limitsService),
+		artifacts.NewService(queries, store),
+		installations.NewService(queries),
+		policies.NewService(queries),
+		sandboxes,
+	)
+
+	jobsService := jobs.NewService(queries)
+	webhooksService.RegisterJobs(jobsService, authService)
+
+	fmt.Printf("Nimbul worker %s started, waiting for jobs\n", workerID)
+	// Running jobs finish before the worker exits
+	jobsService.Work(ctx, control)
+	stopRelay()
+	<-relayed
+	fmt.Println("Nimbul worker stopped")
+}
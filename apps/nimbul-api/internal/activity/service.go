@@ -0,0 +1,88 @@
+package activity
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/db"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Kinds of timeline entries
+const (
+	KindBuild      = "build"
+	KindDeployment = "deployment"
+	KindRollback   = "rollback"
+)
+
+// DefaultLimit is how many entries List returns unless told otherwise
+const DefaultLimit = 50
+
+type Service struct {
+	queries *db.Queries
+}
+
+func NewService(queries *db.Queries) *Service {
+	return &Service{
+		queries: queries,
+	}
+}
+
+// Entry is a build, deployment or rollback of a config
+type Entry struct {
+	Kind         string
+	ID           int64 // of the build or deployment
+	ConfigID     string
+	RepoFullName string
+	Name         string // build name, empty for deployments
+	Ref          string
+	CommitSHA    string
+	Status       string
+	Error        string
+	Actor        string // who deployed or rolled back, empty for builds
+	RollbackOf   *int64 // deployment a rollback went back to
+	StartedAt    time.Time
+	FinishedAt   *time.Time
+}
+
+// List returns the builds, deployments and rollbacks of an owner's configs, or of one
+// of them when configID is set, newest first
+func (s *Service) List(ctx context.Context, ownerID, configID string, limit int) ([]Entry, error) {
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+
+	rows, err := s.queries.GetActivityByOwnerID(ctx, db.GetActivityByOwnerIDParams{
+		OwnerID:    ownerID,
+		ConfigID:   pgtype.Text{String: configID, Valid: configID != ""},
+		MaxResults: int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get activity: %w", err)
+	}
+
+	entries := make([]Entry, len(rows))
+	for i, row := range rows {
+		entries[i] = Entry{
+			Kind:         row.Kind,
+			ID:           row.ID,
+			ConfigID:     row.ConfigID,
+			RepoFullName: row.RepoFullName,
+			Name:         row.Name,
+			Ref:          row.Ref,
+			CommitSHA:    row.CommitSha,
+			Status:       row.Status,
+			Error:        row.Error,
+			Actor:        row.Actor,
+			StartedAt:    row.StartedAt.Time,
+		}
+		if row.RollbackOf.Valid {
+			entries[i].RollbackOf = &row.RollbackOf.Int64
+		}
+		if row.FinishedAt.Valid {
+			entries[i].FinishedAt = &row.FinishedAt.Time
+		}
+	}
+	return entries, nil
+}
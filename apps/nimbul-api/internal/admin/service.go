@@ -0,0 +1,153 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/auth"
+	"github.com/coding-cave-dev/nimbul/internal/db"
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	ErrUserNotFound = errors.New("user not found")
+	ErrInvalidRole  = errors.New("role must be user or admin")
+)
+
+// DefaultActivityLimit is how many deployments GetActivity returns unless told otherwise
+const DefaultActivityLimit = 50
+
+type Service struct {
+	queries *db.Queries
+}
+
+func NewService(queries *db.Queries) *Service {
+	return &Service{
+		queries: queries,
+	}
+}
+
+// User is an account as seen by instance admins
+type User struct {
+	ID         string
+	Email      string
+	Role       string
+	DisabledAt *time.Time
+	CreatedAt  time.Time
+}
+
+// Activity is a deployment of any user's config
+type Activity struct {
+	DeploymentID int64
+	ConfigID     string
+	RepoFullName string
+	OwnerID      string
+	OwnerEmail   string
+	Ref          string
+	CommitSHA    string
+	Status       string
+	Error        string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// GetUsers retrieves every user of the instance, newest first
+func (s *Service) GetUsers(ctx context.Context) ([]User, error) {
+	users, err := s.queries.GetUsers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users: %w", err)
+	}
+
+	result := make([]User, len(users))
+	for i, u := range users {
+		result[i] = *dbUserToUser(u)
+	}
+
+	return result, nil
+}
+
+// SetDisabled disables or re-enables a user. Disabled users cannot log in, their
+// existing tokens stop working and pushes to their configs are not built.
+func (s *Service) SetDisabled(ctx context.Context, userID string, disabled bool) (*User, error) {
+	user, err := s.queries.UpdateUserDisabled(ctx, db.UpdateUserDisabledParams{
+		ID:       userID,
+		Disabled: disabled,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+
+	return dbUserToUser(user), nil
+}
+
+// SetRole changes a user's role
+func (s *Service) SetRole(ctx context.Context, userID, role string) (*User, error) {
+	if role != auth.RoleUser && role != auth.RoleAdmin {
+		return nil, ErrInvalidRole
+	}
+
+	user, err := s.queries.UpdateUserRole(ctx, db.UpdateUserRoleParams{
+		ID:   userID,
+		Role: role,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+
+	return dbUserToUser(user), nil
+}
+
+// GetActivity retrieves the most recent deployments across all users, newest first
+func (s *Service) GetActivity(ctx context.Context, limit int) ([]Activity, error) {
+	if limit <= 0 {
+		limit = DefaultActivityLimit
+	}
+
+	rows, err := s.queries.GetRecentDeployments(ctx, int32(limit))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get activity: %w", err)
+	}
+
+	result := make([]Activity, len(rows))
+	for i, row := range rows {
+		result[i] = Activity{
+			DeploymentID: row.ID,
+			ConfigID:     row.ConfigID,
+			RepoFullName: row.RepoFullName,
+			OwnerID:      row.OwnerID,
+			OwnerEmail:   row.OwnerEmail,
+			Ref:          row.Ref,
+			CommitSHA:    row.CommitSha,
+			Status:       row.Status,
+			Error:        row.Error.String,
+			CreatedAt:    row.CreatedAt.Time,
+			UpdatedAt:    row.UpdatedAt.Time,
+		}
+	}
+
+	return result, nil
+}
+
+// dbUserToUser converts a db.User to an admin.User
+func dbUserToUser(dbUser db.User) *User {
+	var disabledAt *time.Time
+	if dbUser.DisabledAt.Valid {
+		disabledAt = &dbUser.DisabledAt.Time
+	}
+
+	return &User{
+		ID:         dbUser.ID,
+		Email:      dbUser.Email,
+		Role:       dbUser.Role,
+		DisabledAt: disabledAt,
+		CreatedAt:  dbUser.CreatedAt.Time,
+	}
+}
@@ -0,0 +1,308 @@
+package agents
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/db"
+	"github.com/coding-cave-dev/nimbul/internal/storage"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/oklog/ulid/v2"
+)
+
+// Deployment statuses reported for manifests handed to an agent
+const (
+	DeploymentStatusPending   = "pending"
+	DeploymentStatusDelivered = "delivered"
+	DeploymentStatusApplied   = "applied"
+	DeploymentStatusFailed    = "failed"
+)
+
+// tokenPrefix makes agent tokens recognisable (and distinguishable from user JWTs)
+const tokenPrefix = "nbl_agent_"
+
+// pollInterval is how often a waiting agent re-checks for new deployments
+const pollInterval = 2 * time.Second
+
+// An agent leases the deployment it claims until it reports the outcome, so the
+// deployment is delivered again when the agent crashed while applying it. After
+// maxDeliveries deliveries without an outcome the deployment fails.
+const (
+	deliveryLease = 10 * time.Minute
+	maxDeliveries = 3
+)
+
+var (
+	ErrInvalidToken       = errors.New("invalid agent token")
+	ErrAgentNotFound      = errors.New("agent not found")
+	ErrDeploymentNotFound = errors.New("agent deployment not found")
+	ErrInvalidStatus      = errors.New("invalid deployment status")
+)
+
+type Service struct {
+	queries *db.Queries
+	store   storage.Store
+}
+
+func NewService(queries *db.Queries, store storage.Store) *Service {
+	return &Service{
+		queries: queries,
+		store:   store,
+	}
+}
+
+type Agent struct {
+	ID         string
+	OwnerID    string
+	Name       string
+	LastSeenAt *time.Time
+	CreatedAt  time.Time
+}
+
+type CreateAgentResult struct {
+	AgentID string
+	// Token is only returned once, at creation; the database stores its hash
+	Token string
+}
+
+type Deployment struct {
+	ID       int64
+	AgentID  string
+	ConfigID string
+	// Manifests is only loaded when a deployment is queued or claimed
+	Manifests string
+	Status    string
+}
+
+// CreateAgent registers a new agent for ownerID and generates its token
+func (s *Service) CreateAgent(ctx context.Context, ownerID, name string) (*CreateAgentResult, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("failed to generate agent token: %w", err)
+	}
+	token := tokenPrefix + hex.EncodeToString(secret)
+
+	agent, err := s.queries.CreateAgent(ctx, db.CreateAgentParams{
+		ID:        ulid.Make().String(),
+		OwnerID:   ownerID,
+		Name:      name,
+		TokenHash: hashToken(token),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create agent: %w", err)
+	}
+
+	return &CreateAgentResult{
+		AgentID: agent.ID,
+		Token:   token,
+	}, nil
+}
+
+// GetAgentByID retrieves an agent by its ID
+func (s *Service) GetAgentByID(ctx context.Context, id string) (*Agent, error) {
+	agent, err := s.queries.GetAgentByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrAgentNotFound
+		}
+		return nil, fmt.Errorf("failed to get agent: %w", err)
+	}
+
+	return dbAgentToAgent(agent), nil
+}
+
+// GetAgentByIDAndOwner retrieves an agent of ownerID; agents of other owners are not
+// found
+func (s *Service) GetAgentByIDAndOwner(ctx context.Context, id, ownerID string) (*Agent, error) {
+	agent, err := s.queries.GetAgentByIDAndOwner(ctx, db.GetAgentByIDAndOwnerParams{
+		ID:      id,
+		OwnerID: ownerID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrAgentNotFound
+		}
+		return nil, fmt.Errorf("failed to get agent: %w", err)
+	}
+
+	return dbAgentToAgent(agent), nil
+}
+
+// GetAgentsByOwnerID retrieves all agents for a user
+func (s *Service) GetAgentsByOwnerID(ctx context.Context, ownerID string) ([]Agent, error) {
+	agents, err := s.queries.GetAgentsByOwnerID(ctx, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get agents: %w", err)
+	}
+
+	result := make([]Agent, len(agents))
+	for i, a := range agents {
+		result[i] = *dbAgentToAgent(a)
+	}
+
+	return result, nil
+}
+
+// Authenticate resolves the agent owning token and records that it was seen
+func (s *Service) Authenticate(ctx context.Context, token string) (*Agent, error) {
+	agent, err := s.queries.GetAgentByTokenHash(ctx, hashToken(token))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInvalidToken
+		}
+		return nil, fmt.Errorf("failed to get agent: %w", err)
+	}
+
+	if err := s.queries.UpdateAgentLastSeen(ctx, agent.ID); err != nil {
+		return nil, fmt.Errorf("failed to update agent last seen: %w", err)
+	}
+
+	return dbAgentToAgent(agent), nil
+}
+
+// EnqueueDeployment queues rendered manifests for an agent to apply
+func (s *Service) EnqueueDeployment(ctx context.Context, agentID, configID, manifests string) (*Deployment, error) {
+	// Stored before the deployment is queued, so an agent claiming it finds them
+	key := fmt.Sprintf("agents/%s/deployments/%s.yaml", agentID, ulid.Make().String())
+	if err := s.store.Put(ctx, key, strings.NewReader(manifests), int64(len(manifests))); err != nil {
+		return nil, fmt.Errorf("failed to store agent manifests: %w", err)
+	}
+
+	deployment, err := s.queries.CreateAgentDeployment(ctx, db.CreateAgentDeploymentParams{
+		AgentID:    agentID,
+		ConfigID:   configID,
+		StorageKey: pgtype.Text{String: key, Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to enqueue agent deployment: %w", err)
+	}
+
+	result := dbDeploymentToDeployment(deployment)
+	result.Manifests = manifests
+	return result, nil
+}
+
+// WaitForDeployment claims the next pending deployment for agentID, waiting up to
+// timeout for one to be queued. Returns nil when no deployment became available.
+// Deployments the agent claimed before without reporting their outcome in time are
+// claimed again.
+func (s *Service) WaitForDeployment(ctx context.Context, agentID string, timeout time.Duration) (*Deployment, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err := s.queries.FailUnreportedAgentDeployments(ctx, db.FailUnreportedAgentDeploymentsParams{
+		AgentID:       agentID,
+		MaxDeliveries: maxDeliveries,
+		Error:         pgtype.Text{String: fmt.Sprintf("the agent did not report the outcome of %d deliveries", maxDeliveries), Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fail unreported agent deployments: %w", err)
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		deployment, err := s.queries.ClaimNextAgentDeployment(ctx, db.ClaimNextAgentDeploymentParams{
+			AgentID:      agentID,
+			LeaseSeconds: int32(deliveryLease.Seconds()),
+		})
+		if err == nil {
+			return s.loadManifests(ctx, deployment)
+		}
+		if !errors.Is(err, pgx.ErrNoRows) && ctx.Err() == nil {
+			return nil, fmt.Errorf("failed to claim agent deployment: %w", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// ReportDeployment records the outcome of a deployment reported by its agent
+func (s *Service) ReportDeployment(ctx context.Context, agentID string, deploymentID int64, status, errMsg string) (*Deployment, error) {
+	if status != DeploymentStatusApplied && status != DeploymentStatusFailed {
+		return nil, ErrInvalidStatus
+	}
+
+	deployment, err := s.queries.UpdateAgentDeploymentStatus(ctx, db.UpdateAgentDeploymentStatusParams{
+		ID:      deploymentID,
+		AgentID: agentID,
+		Status:  status,
+		Error:   pgtype.Text{String: errMsg, Valid: errMsg != ""},
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrDeploymentNotFound
+		}
+		return nil, fmt.Errorf("failed to update agent deployment: %w", err)
+	}
+
+	return dbDeploymentToDeployment(deployment), nil
+}
+
+// loadManifests converts a claimed deployment, reading its manifests from storage
+// unless they are stored inline
+func (s *Service) loadManifests(ctx context.Context, dbDeployment db.AgentDeployment) (*Deployment, error) {
+	deployment := dbDeploymentToDeployment(dbDeployment)
+	if !dbDeployment.StorageKey.Valid {
+		return deployment, nil
+	}
+
+	contents, err := s.store.Get(ctx, dbDeployment.StorageKey.String)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open agent manifests: %w", err)
+	}
+	defer contents.Close()
+
+	manifests, err := io.ReadAll(contents)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read agent manifests: %w", err)
+	}
+	deployment.Manifests = string(manifests)
+	return deployment, nil
+}
+
+// hashToken returns the hex-encoded sha256 of an agent token
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// dbAgentToAgent converts a db.Agent to an agents.Agent
+func dbAgentToAgent(dbAgent db.Agent) *Agent {
+	var lastSeenAt *time.Time
+	if dbAgent.LastSeenAt.Valid {
+		lastSeenAt = &dbAgent.LastSeenAt.Time
+	}
+
+	return &Agent{
+		ID:         dbAgent.ID,
+		OwnerID:    dbAgent.OwnerID,
+		Name:       dbAgent.Name,
+		LastSeenAt: lastSeenAt,
+		CreatedAt:  dbAgent.CreatedAt.Time,
+	}
+}
+
+// dbDeploymentToDeployment converts a db.AgentDeployment to an agents.Deployment
+func dbDeploymentToDeployment(dbDeployment db.AgentDeployment) *Deployment {
+	return &Deployment{
+		ID:        dbDeployment.ID,
+		AgentID:   dbDeployment.AgentID,
+		ConfigID:  dbDeployment.ConfigID,
+		Manifests: dbDeployment.Manifests,
+		Status:    dbDeployment.Status,
+	}
+}
@@ -0,0 +1,177 @@
+package artifacts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/db"
+	"github.com/coding-cave-dev/nimbul/internal/storage"
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	ErrArtifactNotFound = errors.New("artifact not found")
+	// ErrStorageLimit means the artifacts of a build are larger than a build may store
+	ErrStorageLimit = errors.New("artifact storage limit exceeded")
+)
+
+type Service struct {
+	queries *db.Queries
+	store   storage.Store
+}
+
+func NewService(queries *db.Queries, store storage.Store) *Service {
+	return &Service{
+		queries: queries,
+		store:   store,
+	}
+}
+
+// Artifact is a file kept from a build
+type Artifact struct {
+	ID        int64
+	BuildID   int64
+	Name      string
+	SizeBytes int64
+	CreatedAt time.Time
+}
+
+// Upload stores the files at paths below dir as artifacts of a build. Each artifact
+// is named after the base name of its path; directories are stored as .tar.gz archives.
+// Artifacts past maxBytes in total are not stored and fail the upload with
+// ErrStorageLimit; 0 stores any size.
+func (s *Service) Upload(ctx context.Context, buildID int64, dir string, paths []string, maxBytes int64) error {
+	var stored int64
+	for _, p := range paths {
+		size, err := s.upload(ctx, buildID, dir, path.Clean(strings.TrimPrefix(p, "./")), maxBytes-stored, maxBytes)
+		if err != nil {
+			return err
+		}
+		stored += size
+	}
+
+	return nil
+}
+
+// upload stores a single artifact of at most remaining bytes, returning its size
+func (s *Service) upload(ctx context.Context, buildID int64, dir, p string, remaining, maxBytes int64) (int64, error) {
+	fullPath := filepath.Join(dir, filepath.FromSlash(p))
+	info, err := os.Lstat(fullPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, fmt.Errorf("artifact %s was not produced by the build", p)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to read artifact %s: %w", p, err)
+	}
+
+	name := path.Base(p)
+	var file *os.File
+	switch {
+	case info.Mode().IsRegular():
+		file, err = os.Open(fullPath)
+		if err != nil {
+			return 0, fmt.Errorf("failed to open artifact %s: %w", p, err)
+		}
+	case info.IsDir():
+		name += ".tar.gz"
+		file, err = archiveDir(fullPath)
+		if err != nil {
+			return 0, fmt.Errorf("failed to archive artifact %s: %w", p, err)
+		}
+		defer os.Remove(file.Name())
+	default:
+		return 0, fmt.Errorf("artifact %s is not a file or directory", p)
+	}
+	defer file.Close()
+
+	stat, err := file.Stat()
+	if err != nil {
+		return 0, fmt.Errorf("failed to read artifact %s: %w", p, err)
+	}
+	if maxBytes > 0 && stat.Size() > remaining {
+		return 0, fmt.Errorf("%w: %s takes the artifacts of the build past %d MB", ErrStorageLimit, name, maxBytes/(1024*1024))
+	}
+
+	key := fmt.Sprintf("builds/%d/artifacts/%s", buildID, name)
+	if err := s.store.Put(ctx, key, file, stat.Size()); err != nil {
+		return 0, fmt.Errorf("failed to store artifact %s: %w", name, err)
+	}
+
+	_, err = s.queries.CreateBuildArtifact(ctx, db.CreateBuildArtifactParams{
+		BuildID:    buildID,
+		Name:       name,
+		StorageKey: key,
+		SizeBytes:  stat.Size(),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to create artifact: %w", err)
+	}
+
+	// Count the artifact towards the owner's storage usage
+	err = s.queries.AddBuildArtifactBytes(ctx, db.AddBuildArtifactBytesParams{
+		ID:            buildID,
+		ArtifactBytes: stat.Size(),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to update build: %w", err)
+	}
+
+	return stat.Size(), nil
+}
+
+// GetArtifactsByBuildID retrieves the artifacts of a build
+func (s *Service) GetArtifactsByBuildID(ctx context.Context, buildID int64) ([]Artifact, error) {
+	artifacts, err := s.queries.GetBuildArtifactsByBuildID(ctx, buildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get artifacts: %w", err)
+	}
+
+	result := make([]Artifact, len(artifacts))
+	for i, a := range artifacts {
+		result[i] = *dbArtifactToArtifact(a)
+	}
+
+	return result, nil
+}
+
+// Open opens the contents of a build's artifact. The caller closes the returned reader.
+func (s *Service) Open(ctx context.Context, buildID int64, name string) (*Artifact, io.ReadCloser, error) {
+	artifact, err := s.queries.GetBuildArtifact(ctx, db.GetBuildArtifactParams{
+		BuildID: buildID,
+		Name:    name,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil, ErrArtifactNotFound
+		}
+		return nil, nil, fmt.Errorf("failed to get artifact: %w", err)
+	}
+
+	contents, err := s.store.Get(ctx, artifact.StorageKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, nil, ErrArtifactNotFound
+		}
+		return nil, nil, err
+	}
+
+	return dbArtifactToArtifact(artifact), contents, nil
+}
+
+// dbArtifactToArtifact converts a db.BuildArtifact to an artifacts.Artifact
+func dbArtifactToArtifact(dbArtifact db.BuildArtifact) *Artifact {
+	return &Artifact{
+		ID:        dbArtifact.ID,
+		BuildID:   dbArtifact.BuildID,
+		Name:      dbArtifact.Name,
+		SizeBytes: dbArtifact.SizeBytes,
+		CreatedAt: dbArtifact.CreatedAt.Time,
+	}
+}
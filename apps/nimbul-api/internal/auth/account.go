@@ -0,0 +1,182 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// EmailChangeTTL is how long the confirmation token of an email change stays valid
+const EmailChangeTTL = 24 * time.Hour
+
+type EmailChangeResult struct {
+	NewEmail string
+	// Token is emailed to the new address; the database only stores its hash
+	Token string
+}
+
+// RequestEmailChange starts changing the user's email. The email only changes once the
+// token sent to the new address is confirmed, proving the user owns it.
+func (s *Service) RequestEmailChange(ctx context.Context, userID, currentPassword, newEmail string) (*EmailChangeResult, error) {
+	if !isValidEmail(newEmail) {
+		return nil, ErrInvalidEmail
+	}
+
+	if _, err := s.checkPassword(ctx, userID, currentPassword); err != nil {
+		return nil, err
+	}
+
+	_, err := s.queries.GetUserByEmail(ctx, newEmail)
+	if err == nil {
+		return nil, ErrEmailExists
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	token := hex.EncodeToString(secret)
+
+	err = s.queries.CreateEmailChange(ctx, db.CreateEmailChangeParams{
+		TokenHash: hashToken(token),
+		UserID:    userID,
+		NewEmail:  newEmail,
+		ExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(EmailChangeTTL), Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &EmailChangeResult{
+		NewEmail: newEmail,
+		Token:    token,
+	}, nil
+}
+
+// ConfirmEmailChange sets the new email of the user a confirmation token was issued to.
+// Each token can be used once.
+func (s *Service) ConfirmEmailChange(ctx context.Context, token string) (*UserResponse, error) {
+	change, err := s.queries.ClaimEmailChange(ctx, hashToken(token))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInvalidEmailChangeToken
+		}
+		return nil, err
+	}
+
+	user, err := s.queries.UpdateUserEmail(ctx, db.UpdateUserEmailParams{
+		ID:    change.UserID,
+		Email: change.NewEmail,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		// Someone registered the address after the change was requested
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return nil, ErrEmailExists
+		}
+		return nil, err
+	}
+
+	response := dbUserToUserResponse(user)
+	return &response, nil
+}
+
+// ChangePassword sets a new password after checking the current one, and logs out
+// every other session of the user
+func (s *Service) ChangePassword(ctx context.Context, userID, sessionID, currentPassword, newPassword string) error {
+	if len(newPassword) < 8 {
+		return ErrInvalidPassword
+	}
+
+	if _, err := s.checkPassword(ctx, userID, currentPassword); err != nil {
+		return err
+	}
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	err = s.queries.UpdateUserPassword(ctx, db.UpdateUserPasswordParams{
+		ID:           userID,
+		PasswordHash: string(passwordHash),
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.queries.DeleteOtherSessions(ctx, db.DeleteOtherSessionsParams{
+		UserID: userID,
+		ID:     sessionID,
+	})
+}
+
+// DeleteAccount deletes the user with everything it owns: configs, agents, credentials,
+// hooks, sessions and other personal data, through the cascades of the user's foreign
+// keys. In the same transaction an anonymized row takes the user's place, so past builds
+// remain attributed for usage metering. Apps already deployed to a cluster are not removed.
+func (s *Service) DeleteAccount(ctx context.Context, userID, password string) error {
+	user, err := s.checkPassword(ctx, userID, password)
+	if err != nil {
+		return err
+	}
+
+	return s.queries.InTx(ctx, func(queries *db.Queries) error {
+		deleted, err := queries.DeleteUser(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("failed to delete user: %w", err)
+		}
+		if deleted == 0 {
+			return ErrUserNotFound
+		}
+
+		err = queries.CreateDeletedUser(ctx, db.CreateDeletedUserParams{
+			ID:                 user.ID,
+			Role:               user.Role,
+			CreatedAt:          user.CreatedAt,
+			BuildRetentionDays: user.BuildRetentionDays,
+			LogRetentionDays:   user.LogRetentionDays,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to anonymize user: %w", err)
+		}
+		return nil
+	})
+}
+
+// checkPassword verifies the password of a user. Accounts created by OIDC sign-in have
+// none until they reset one, and cannot confirm sensitive changes before then.
+func (s *Service) checkPassword(ctx context.Context, userID, password string) (db.User, error) {
+	user, err := s.queries.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return db.User{}, ErrUserNotFound
+		}
+		return db.User{}, err
+	}
+
+	if user.PasswordHash == "" {
+		return db.User{}, ErrNoPassword
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
+		return db.User{}, ErrWrongPassword
+	}
+
+	return user, nil
+}
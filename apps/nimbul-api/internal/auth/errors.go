@@ -8,4 +8,16 @@ var (
 	ErrInvalidEmail       = errors.New("invalid email format")
 	ErrInvalidPassword    = errors.New("password must be at least 8 characters long")
 	ErrInvalidToken       = errors.New("invalid or expired token")
+	ErrUserNotFound       = errors.New("user not found")
+	ErrInvalidResetToken  = errors.New("invalid or expired password reset token")
+	ErrAccountDisabled    = errors.New("account is disabled")
+	ErrSessionNotFound    = errors.New("session not found")
+	ErrWrongPassword      = errors.New("current password is incorrect")
+	ErrNoPassword         = errors.New("account has no password, set one with a password reset first")
+
+	ErrInvalidEmailChangeToken = errors.New("invalid or expired email change token")
+
+	ErrOIDCEmailMissing    = errors.New("the identity provider did not return a valid email")
+	ErrOIDCEmailUnverified = errors.New("the identity provider has not verified the email of an existing account")
+	ErrOIDCEmailLinked     = errors.New("the account with this email is linked to another identity")
 )
@@ -0,0 +1,138 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+
+	"github.com/coding-cave-dev/nimbul/internal/db"
+	"github.com/coding-cave-dev/nimbul/internal/oidc"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/oklog/ulid/v2"
+)
+
+// LoginOIDC signs in the Nimbul user of an identity authenticated by the OIDC provider.
+// Identities are matched by issuer and subject. An unknown identity is linked to the
+// user with the same email when the provider verified it, and otherwise gets a new user.
+// When NIMBUL_OIDC_ADMIN_GROUPS is set, membership of one of its groups decides the role.
+func (s *Service) LoginOIDC(ctx context.Context, identity *oidc.Identity, client Client) (*LoginResult, error) {
+	issuer := pgtype.Text{String: identity.Issuer, Valid: true}
+	subject := pgtype.Text{String: identity.Subject, Valid: true}
+
+	user, err := s.queries.GetUserByOIDCIdentity(ctx, db.GetUserByOIDCIdentityParams{
+		OidcIssuer:  issuer,
+		OidcSubject: subject,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		user, err = s.createOrLinkOIDCUser(ctx, identity)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if user.DisabledAt.Valid {
+		return nil, ErrAccountDisabled
+	}
+
+	if identity.EmailVerified && !user.EmailVerifiedAt.Valid && strings.EqualFold(identity.Email, user.Email) {
+		user, err = s.queries.MarkUserEmailVerified(ctx, user.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	user, err = s.bootstrapAdmin(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err = s.syncOIDCRole(ctx, user, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	response := dbUserToUserResponse(user)
+
+	token, err := s.StartSession(ctx, response, client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoginResult{
+		User:  response,
+		Token: token,
+	}, nil
+}
+
+// createOrLinkOIDCUser links a new identity to the user with its email, or creates a user
+func (s *Service) createOrLinkOIDCUser(ctx context.Context, identity *oidc.Identity) (db.User, error) {
+	if !isValidEmail(identity.Email) {
+		return db.User{}, ErrOIDCEmailMissing
+	}
+
+	issuer := pgtype.Text{String: identity.Issuer, Valid: true}
+	subject := pgtype.Text{String: identity.Subject, Valid: true}
+
+	existing, err := s.queries.GetUserByEmail(ctx, identity.Email)
+	if err == nil {
+		// An unverified email could be anyone's, so it must not take over an account
+		if !identity.EmailVerified {
+			return db.User{}, ErrOIDCEmailUnverified
+		}
+		user, err := s.queries.LinkUserOIDCIdentity(ctx, db.LinkUserOIDCIdentityParams{
+			ID:          existing.ID,
+			OidcIssuer:  issuer,
+			OidcSubject: subject,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return db.User{}, ErrOIDCEmailLinked
+		}
+		return user, err
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return db.User{}, err
+	}
+
+	return s.queries.CreateOIDCUser(ctx, db.CreateOIDCUserParams{
+		ID:          ulid.Make().String(),
+		Email:       identity.Email,
+		OidcIssuer:  issuer,
+		OidcSubject: subject,
+	})
+}
+
+// syncOIDCRole makes members of NIMBUL_OIDC_ADMIN_GROUPS admins and everyone else users.
+// Users listed in NIMBUL_ADMIN_EMAILS with a verified email stay admins. Roles are left alone when no admin
+// groups are configured.
+func (s *Service) syncOIDCRole(ctx context.Context, user db.User, identity *oidc.Identity) (db.User, error) {
+	groups := oidcAdminGroups()
+	if len(groups) == 0 {
+		return user, nil
+	}
+
+	role := RoleUser
+	if identity.InGroup(groups) || isListedAdmin(user) {
+		role = RoleAdmin
+	}
+	if role == user.Role {
+		return user, nil
+	}
+
+	return s.queries.UpdateUserRole(ctx, db.UpdateUserRoleParams{
+		ID:   user.ID,
+		Role: role,
+	})
+}
+
+// oidcAdminGroups returns the groups listed in NIMBUL_OIDC_ADMIN_GROUPS (comma-separated)
+func oidcAdminGroups() []string {
+	var groups []string
+	for _, group := range strings.Split(os.Getenv("NIMBUL_OIDC_ADMIN_GROUPS"), ",") {
+		if group = strings.TrimSpace(group); group != "" {
+			groups = append(groups, group)
+		}
+	}
+	return groups
+}
@@ -2,13 +2,20 @@ package auth
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"os"
 	"regexp"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/coding-cave-dev/nimbul/internal/db"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/oklog/ulid/v2"
 	"golang.org/x/crypto/bcrypt"
 )
@@ -38,9 +45,25 @@ type LoginResult struct {
 type UserResponse struct {
 	ID    string `json:"id"`
 	Email string `json:"email"`
+	Role  string `json:"role"`
 }
 
-func (s *Service) Register(ctx context.Context, email, password string) (*RegisterResult, error) {
+// User roles. Admins operate the instance: they manage users and instance-wide limits.
+const (
+	RoleUser  = "user"
+	RoleAdmin = "admin"
+)
+
+// PasswordResetTTL is how long a password reset token stays valid
+const PasswordResetTTL = time.Hour
+
+type PasswordResetResult struct {
+	User UserResponse
+	// Token is emailed to the user; the database only stores its hash
+	Token string
+}
+
+func (s *Service) Register(ctx context.Context, email, password string, client Client) (*RegisterResult, error) {
 	// Validate email format
 	if !isValidEmail(email) {
 		return nil, ErrInvalidEmail
@@ -80,22 +103,25 @@ func (s *Service) Register(ctx context.Context, email, password string) (*Regist
 		return nil, err
 	}
 
+	// Registering does not prove the address is the user's, so NIMBUL_ADMIN_EMAILS only
+	// applies once it is verified
+	response := dbUserToUserResponse(user)
+
 	// Generate JWT token
-	token, err := s.generateToken(userID, email)
+	token, err := s.StartSession(ctx, response, client)
 	if err != nil {
 		return nil, err
 	}
 
 	return &RegisterResult{
-		User: UserResponse{
-			ID:    user.ID,
-			Email: user.Email,
-		},
+		User:  response,
 		Token: token,
 	}, nil
 }
 
-func (s *Service) Login(ctx context.Context, email, password string) (*LoginResult, error) {
+// Authenticate checks an email and password. It does not start a session, so callers
+// can ask for a second factor first; StartSession issues the token.
+func (s *Service) Authenticate(ctx context.Context, email, password string) (*UserResponse, error) {
 	// Get user by email
 	user, err := s.queries.GetUserByEmail(ctx, email)
 	if err != nil {
@@ -111,26 +137,55 @@ func (s *Service) Login(ctx context.Context, email, password string) (*LoginResu
 		return nil, ErrInvalidCredentials
 	}
 
-	// Generate JWT token
-	token, err := s.generateToken(user.ID, user.Email)
+	if user.DisabledAt.Valid {
+		return nil, ErrAccountDisabled
+	}
+
+	user, err = s.bootstrapAdmin(ctx, user)
 	if err != nil {
 		return nil, err
 	}
 
-	return &LoginResult{
-		User: UserResponse{
-			ID:    user.ID,
-			Email: user.Email,
-		},
-		Token: token,
-	}, nil
+	response := dbUserToUserResponse(user)
+	return &response, nil
+}
+
+// bootstrapAdmin promotes a user listed in NIMBUL_ADMIN_EMAILS (comma-separated) to admin,
+// so a fresh instance has an operator without touching the database
+func (s *Service) bootstrapAdmin(ctx context.Context, user db.User) (db.User, error) {
+	if user.Role == RoleAdmin || !isListedAdmin(user) {
+		return user, nil
+	}
+
+	return s.queries.UpdateUserRole(ctx, db.UpdateUserRoleParams{
+		ID:   user.ID,
+		Role: RoleAdmin,
+	})
+}
+
+// isListedAdmin reports whether the user's email is listed in NIMBUL_ADMIN_EMAILS and
+// verified. Anyone could register the address first, so an unverified one does not count.
+func isListedAdmin(user db.User) bool {
+	return user.EmailVerifiedAt.Valid && slices.Contains(adminEmails(), strings.ToLower(user.Email))
+}
+
+// adminEmails returns the lowercased emails listed in NIMBUL_ADMIN_EMAILS
+func adminEmails() []string {
+	var emails []string
+	for _, email := range strings.Split(os.Getenv("NIMBUL_ADMIN_EMAILS"), ",") {
+		if email = strings.TrimSpace(email); email != "" {
+			emails = append(emails, strings.ToLower(email))
+		}
+	}
+	return emails
 }
 
-func (s *Service) generateToken(userID, email string) (string, error) {
+func (s *Service) generateToken(userID, email, sessionID string, expiresAt time.Time) (string, error) {
 	claims := jwt.MapClaims{
 		"user_id": userID,
 		"email":   email,
-		"exp":     time.Now().Add(time.Hour * 24 * 7).Unix(), // 7 days
+		"sid":     sessionID,
+		"exp":     expiresAt.Unix(),
 		"iat":     time.Now().Unix(),
 	}
 
@@ -138,7 +193,9 @@ func (s *Service) generateToken(userID, email string) (string, error) {
 	return token.SignedString([]byte(s.jwtSecret))
 }
 
-func (s *Service) ValidateToken(tokenString string) (string, string, error) {
+// ValidateToken checks a token's signature and expiry and returns its user ID, email and
+// session ID. CheckSession checks that the session is still active.
+func (s *Service) ValidateToken(tokenString string) (string, string, string, error) {
 	// Parse token
 	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
 		// Validate signing method
@@ -149,32 +206,38 @@ func (s *Service) ValidateToken(tokenString string) (string, string, error) {
 	})
 
 	if err != nil {
-		return "", "", ErrInvalidToken
+		return "", "", "", ErrInvalidToken
 	}
 
 	// Validate token
 	if !token.Valid {
-		return "", "", ErrInvalidToken
+		return "", "", "", ErrInvalidToken
 	}
 
 	// Extract claims
 	claims, ok := token.Claims.(jwt.MapClaims)
 	if !ok {
-		return "", "", ErrInvalidToken
+		return "", "", "", ErrInvalidToken
 	}
 
 	// Get user_id and email from claims
 	userID, ok := claims["user_id"].(string)
 	if !ok || userID == "" {
-		return "", "", ErrInvalidToken
+		return "", "", "", ErrInvalidToken
 	}
 
 	email, ok := claims["email"].(string)
 	if !ok || email == "" {
-		return "", "", ErrInvalidToken
+		return "", "", "", ErrInvalidToken
+	}
+
+	// Tokens issued before sessions were tracked have no session and must log in again
+	sessionID, ok := claims["sid"].(string)
+	if !ok || sessionID == "" {
+		return "", "", "", ErrInvalidToken
 	}
 
-	return userID, email, nil
+	return userID, email, sessionID, nil
 }
 
 func (s *Service) GetUserByID(ctx context.Context, userID string) (*UserResponse, error) {
@@ -186,10 +249,111 @@ func (s *Service) GetUserByID(ctx context.Context, userID string) (*UserResponse
 		return nil, err
 	}
 
-	return &UserResponse{
+	response := dbUserToUserResponse(user)
+	return &response, nil
+}
+
+// Authorize checks that the user a token was issued to still exists and is not disabled,
+// and returns their role. Tokens stay valid for days, so this is checked on every request.
+func (s *Service) Authorize(ctx context.Context, userID string) (string, error) {
+	user, err := s.queries.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", ErrUserNotFound
+		}
+		return "", err
+	}
+
+	if user.DisabledAt.Valid {
+		return "", ErrAccountDisabled
+	}
+
+	return user.Role, nil
+}
+
+// CreatePasswordReset generates a single-use password reset token for the user with email.
+// Returns ErrUserNotFound when there is no such user; callers must not reveal this to the requester.
+func (s *Service) CreatePasswordReset(ctx context.Context, email string) (*PasswordResetResult, error) {
+	user, err := s.queries.GetUserByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	token := hex.EncodeToString(secret)
+
+	err = s.queries.CreatePasswordReset(ctx, db.CreatePasswordResetParams{
+		TokenHash: hashToken(token),
+		UserID:    user.ID,
+		ExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(PasswordResetTTL), Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &PasswordResetResult{
+		User:  dbUserToUserResponse(user),
+		Token: token,
+	}, nil
+}
+
+// ResetPassword sets a new password for the user a reset token was issued to, and logs
+// them out everywhere in case the old password leaked. Each token can be used once.
+func (s *Service) ResetPassword(ctx context.Context, token, password string) error {
+	// Validate password
+	if len(password) < 8 {
+		return ErrInvalidPassword
+	}
+
+	reset, err := s.queries.ClaimPasswordReset(ctx, hashToken(token))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrInvalidResetToken
+		}
+		return err
+	}
+
+	// Hash password
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	err = s.queries.UpdateUserPassword(ctx, db.UpdateUserPasswordParams{
+		ID:           reset.UserID,
+		PasswordHash: string(passwordHash),
+	})
+	if err != nil {
+		return err
+	}
+
+	// The token was emailed to the user, which proves the address is theirs
+	if _, err := s.queries.MarkUserEmailVerified(ctx, reset.UserID); err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+
+	return s.RevokeAllSessions(ctx, reset.UserID)
+}
+
+// hashToken returns the hex-encoded sha256 of a password reset or email change token
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// dbUserToUserResponse converts a db.User to an auth.UserResponse
+func dbUserToUserResponse(user db.User) UserResponse {
+	return UserResponse{
 		ID:    user.ID,
 		Email: user.Email,
-	}, nil
+		Role:  user.Role,
+	}
 }
 
 func isValidEmail(email string) bool {
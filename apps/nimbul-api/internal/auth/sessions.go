@@ -0,0 +1,134 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/oklog/ulid/v2"
+)
+
+// SessionTTL is how long a login stays valid
+const SessionTTL = 7 * 24 * time.Hour
+
+// sessionTouchInterval limits how often last use is recorded, so busy clients do not
+// write to the database on every request
+const sessionTouchInterval = time.Minute
+
+// Client describes where a login comes from
+type Client struct {
+	UserAgent string
+	IP        string
+}
+
+// Session is a login of a user. Each token belongs to one, and revoking the session
+// invalidates the token before it expires.
+type Session struct {
+	ID         string
+	UserAgent  string
+	IP         string
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+	ExpiresAt  time.Time
+}
+
+// StartSession records a new session for the user and issues its token
+func (s *Service) StartSession(ctx context.Context, user UserResponse, client Client) (string, error) {
+	// Expired sessions are only kept until the user logs in again
+	if err := s.queries.DeleteExpiredSessions(ctx, user.ID); err != nil {
+		return "", fmt.Errorf("failed to delete expired sessions: %w", err)
+	}
+
+	session, err := s.queries.CreateSession(ctx, db.CreateSessionParams{
+		ID:        ulid.Make().String(),
+		UserID:    user.ID,
+		UserAgent: client.UserAgent,
+		Ip:        client.IP,
+		ExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(SessionTTL), Valid: true},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create session: %w", err)
+	}
+
+	return s.generateToken(user.ID, user.Email, session.ID, session.ExpiresAt.Time)
+}
+
+// ListSessions returns the user's active sessions, most recently used first
+func (s *Service) ListSessions(ctx context.Context, userID string) ([]Session, error) {
+	sessions, err := s.queries.GetActiveSessionsByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	result := make([]Session, 0, len(sessions))
+	for _, session := range sessions {
+		result = append(result, dbSessionToSession(session))
+	}
+	return result, nil
+}
+
+// RevokeSession logs the user out of one session
+func (s *Service) RevokeSession(ctx context.Context, userID, sessionID string) error {
+	deleted, err := s.queries.DeleteSession(ctx, db.DeleteSessionParams{
+		ID:     sessionID,
+		UserID: userID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+	if deleted == 0 {
+		return ErrSessionNotFound
+	}
+	return nil
+}
+
+// RevokeAllSessions logs the user out everywhere
+func (s *Service) RevokeAllSessions(ctx context.Context, userID string) error {
+	if err := s.queries.DeleteSessionsByUserID(ctx, userID); err != nil {
+		return fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+	return nil
+}
+
+// CheckSession verifies that a token's session has not been revoked or expired, and
+// records that it was used. Returns ErrInvalidToken for revoked sessions.
+func (s *Service) CheckSession(ctx context.Context, userID, sessionID string) error {
+	session, err := s.queries.GetActiveSession(ctx, db.GetActiveSessionParams{
+		ID:     sessionID,
+		UserID: userID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrInvalidToken
+		}
+		return err
+	}
+
+	if time.Since(session.LastUsedAt.Time) < sessionTouchInterval {
+		return nil
+	}
+
+	// Recorded in the background so that requests are not held up by the write
+	go func() {
+		if err := s.queries.TouchSession(context.Background(), session.ID); err != nil {
+			fmt.Printf("Warning: Failed to record use of session %s: %v\n", session.ID, err)
+		}
+	}()
+	return nil
+}
+
+// dbSessionToSession converts a db.Session to a Session
+func dbSessionToSession(session db.Session) Session {
+	return Session{
+		ID:         session.ID,
+		UserAgent:  session.UserAgent,
+		IP:         session.Ip,
+		CreatedAt:  session.CreatedAt.Time,
+		LastUsedAt: session.LastUsedAt.Time,
+		ExpiresAt:  session.ExpiresAt.Time,
+	}
+}
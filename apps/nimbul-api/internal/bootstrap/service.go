@@ -0,0 +1,176 @@
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/coding-cave-dev/nimbul/internal/github"
+	"github.com/coding-cave-dev/nimbul/internal/nimbulconfig"
+)
+
+// Branch is the branch bootstrap pull requests are opened from
+const Branch = "nimbul/bootstrap"
+
+var (
+	ErrRepositoryNotFound = errors.New("repository not found")
+	ErrAlreadyConfigured  = errors.New("repository already has a nimbul.yaml")
+	ErrNothingToBuild     = errors.New("nothing to build found in repository")
+)
+
+// Options tune the files a bootstrap pull request adds
+type Options struct {
+	// Dockerfile adds a starter Dockerfile at the repository root when there is none
+	Dockerfile bool
+	// Registry prefixes generated image tags, ghcr.io/<owner> when empty
+	Registry string
+}
+
+// Result is an opened bootstrap pull request
+type Result struct {
+	Number int
+	URL    string
+	Branch string
+	Files  []string // paths the pull request adds
+	Notes  []string // what the generated files could not settle, to look at in review
+}
+
+type Service struct{}
+
+func NewService() *Service {
+	return &Service{}
+}
+
+// OpenPullRequest opens a pull request against the default branch of a repository adding
+// a nimbul.yaml generated from its CI configuration or Dockerfiles, the manifests of its
+// deploy stage and, with opts.Dockerfile, a starter Dockerfile. The repository is read
+// and written with token, so only users allowed to push to it can open one.
+func (s *Service) OpenPullRequest(ctx context.Context, token, owner, repo string, opts Options) (*Result, error) {
+	client := github.NewClient(ctx, token)
+
+	repository, _, err := client.Repositories.Get(ctx, owner, repo)
+	if err != nil {
+		if github.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: %s/%s", ErrRepositoryNotFound, owner, repo)
+		}
+		return nil, fmt.Errorf("failed to get repository: %w", err)
+	}
+	base := repository.GetDefaultBranch()
+
+	tempDir, err := os.MkdirTemp("", "nimbul-bootstrap-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp directory: %w", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	// Builds of a Dockerfile at the root are named after the directory
+	repoDir := filepath.Join(tempDir, repo)
+	if err := github.CloneRepositoryWithToken(ctx, token, owner, repo, "", repoDir, nil); err != nil {
+		return nil, err
+	}
+
+	if _, err := os.Stat(filepath.Join(repoDir, "nimbul.yaml")); err == nil {
+		return nil, ErrAlreadyConfigured
+	}
+
+	files := make(map[string][]byte)
+	var notes []string
+
+	if opts.Dockerfile {
+		dockerfilePath := filepath.Join(repoDir, "Dockerfile")
+		if _, err := os.Stat(dockerfilePath); err == nil {
+			notes = append(notes, "The repository already has a Dockerfile, no starter Dockerfile was added.")
+		} else {
+			content, language, err := nimbulconfig.StarterDockerfile(repoDir)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %v", ErrNothingToBuild, err)
+			}
+			// Written into the clone so the import below builds it
+			if err := os.WriteFile(dockerfilePath, content, 0644); err != nil {
+				return nil, fmt.Errorf("failed to write Dockerfile: %w", err)
+			}
+			files["Dockerfile"] = content
+			notes = append(notes, fmt.Sprintf("The Dockerfile is a starter for %s projects, check its commands and exposed port.", language))
+		}
+	}
+
+	registry := opts.Registry
+	if registry == "" {
+		registry = "ghcr.io/" + strings.ToLower(owner)
+	}
+
+	imported, err := nimbulconfig.Import(repoDir, nimbulconfig.ImportOptions{Registry: registry})
+	if err != nil {
+		if !opts.Dockerfile {
+			return nil, fmt.Errorf("%w, ask for a starter Dockerfile to add one: %v", ErrNothingToBuild, err)
+		}
+		return nil, fmt.Errorf("%w: %v", ErrNothingToBuild, err)
+	}
+	notes = append(notes, imported.Notes...)
+
+	configYAML, err := nimbulconfig.Marshal(imported.Config)
+	if err != nil {
+		return nil, err
+	}
+	files["nimbul.yaml"] = configYAML
+	for manifestPath, manifest := range imported.Manifests {
+		files[manifestPath] = manifest
+	}
+
+	paths := make([]string, 0, len(files))
+	for p := range files {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	pr, err := github.CreatePullRequestWithFiles(ctx, client, owner, repo, github.NewPullRequest{
+		Base:    base,
+		Branch:  Branch,
+		Title:   "Add nimbul.yaml",
+		Body:    pullRequestBody(imported, paths, notes),
+		Message: "Add nimbul.yaml",
+		Files:   files,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Result{
+		Number: pr.Number,
+		URL:    pr.URL,
+		Branch: Branch,
+		Files:  paths,
+		Notes:  notes,
+	}, nil
+}
+
+// pullRequestBody renders the markdown description of a bootstrap pull request
+func pullRequestBody(imported *nimbulconfig.ImportResult, paths, notes []string) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Builds and deploys this repository with Nimbul. The configuration was generated from its %s configuration.\n\n", imported.Source)
+
+	b.WriteString("**Builds**\n\n")
+	for _, build := range imported.Config.Build {
+		fmt.Fprintf(&b, "- `%s`: `%s`, tagged %s\n", build.Name, build.Dockerfile, "`"+strings.Join(build.Tags, "`, `")+"`")
+	}
+
+	b.WriteString("\n**Files**\n\n")
+	for _, p := range paths {
+		fmt.Fprintf(&b, "- `%s`\n", p)
+	}
+
+	if len(notes) > 0 {
+		b.WriteString("\n**Review before merging**\n\n")
+		for _, note := range notes {
+			fmt.Fprintf(&b, "- %s\n", note)
+		}
+	}
+
+	b.WriteString("\nOnce merged, run `nimbul init` in the repository to create its config.\n")
+	return b.String()
+}
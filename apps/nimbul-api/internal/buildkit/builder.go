@@ -0,0 +1,51 @@
+package buildkit
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/coding-cave-dev/nimbul/internal/registry"
+	"github.com/docker/cli/cli/config"
+	"github.com/docker/cli/cli/config/configfile"
+)
+
+// ImageBuilder builds the images and artifacts of build configs
+type ImageBuilder interface {
+	// BuildAndPush builds the image of req once, exports it under every name of
+	// ImageRefs and returns its digest
+	BuildAndPush(ctx context.Context, req BuildRequest) (string, error)
+	// ExportStage builds the target stage of the Dockerfile and copies the stage's
+	// filesystem into outputDir
+	ExportStage(ctx context.Context, req BuildRequest, stage, outputDir string) error
+	// FetchProvenance reads the provenance attestation pushed alongside an image, or
+	// returns ErrNoProvenance
+	FetchProvenance(ctx context.Context, imageRef, digest string, login *registry.Login) (*Provenance, error)
+}
+
+// NewImageBuilderFromEnv returns a builder using the Docker daemon when NIMBUL_BUILDER
+// is "docker" and one using buildkitd otherwise
+func NewImageBuilderFromEnv() ImageBuilder {
+	switch builder := os.Getenv("NIMBUL_BUILDER"); builder {
+	case "docker":
+		return NewDockerFromEnv()
+	case "", "buildkit":
+		return NewFromEnv()
+	default:
+		fmt.Printf("Warning: Invalid NIMBUL_BUILDER %q, using buildkit\n", builder)
+		return NewFromEnv()
+	}
+}
+
+// loadDockerConfig loads the Docker config in dir, with login in place of any
+// credentials it has for the registry of login when login is set
+func loadDockerConfig(dir string, login *registry.Login) (*configfile.ConfigFile, error) {
+	dockerConfig, err := config.Load(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load docker config: %w", err)
+	}
+	if login != nil {
+		login.Apply(dockerConfig)
+	}
+	return dockerConfig, nil
+}
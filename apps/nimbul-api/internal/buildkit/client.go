@@ -3,20 +3,73 @@ package buildkit
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
-	"github.com/docker/cli/cli/config"
+	"github.com/coding-cave-dev/nimbul/internal/registry"
 	bkclient "github.com/moby/buildkit/client"
+	"github.com/moby/buildkit/client/llb"
+	"github.com/moby/buildkit/exporter/containerimage/exptypes"
 	"github.com/moby/buildkit/session"
 	"github.com/moby/buildkit/session/auth/authprovider"
 	"github.com/moby/buildkit/session/filesync"
+	"github.com/moby/buildkit/session/secrets/secretsprovider"
+	digest "github.com/opencontainers/go-digest"
 	"github.com/tonistiigi/fsutil"
 )
 
 type Builder struct {
 	Addr         string // e.g. tcp://127.0.0.1:1234 or tcp://buildkitd...:1234
 	DockerConfig string // e.g. ~/.docker or /docker (mounted secret)
+	BuilderID    string // identifies this instance as the builder in provenance attestations
+	TLS          TLSConfig
+}
+
+// TLSConfig configures TLS for a tcp:// BuildKit address, as buildctl's --tls* flags do.
+// The certificates are PEM files, e.g. mounted from a secret. Setting any field enables TLS.
+type TLSConfig struct {
+	CACert     string // CA verifying buildkitd, the system roots by default
+	Cert       string // client certificate for mTLS
+	Key        string // client key for mTLS
+	ServerName string // name buildkitd's certificate is verified against, the address host by default
+}
+
+// Enabled reports whether TLS is configured
+func (t TLSConfig) Enabled() bool {
+	return t.CACert != "" || t.Cert != "" || t.Key != "" || t.ServerName != ""
+}
+
+// clientOpts returns the BuildKit client options of the TLS configuration
+func (t TLSConfig) clientOpts(addr string) ([]bkclient.ClientOpt, error) {
+	if !t.Enabled() {
+		return nil, nil
+	}
+	if (t.Cert == "") != (t.Key == "") {
+		return nil, fmt.Errorf("buildkit TLS: client certificate and key must be set together")
+	}
+
+	serverName := t.ServerName
+	if serverName == "" {
+		u, err := url.Parse(addr)
+		if err != nil {
+			return nil, fmt.Errorf("buildkit TLS: invalid address %s: %w", addr, err)
+		}
+		serverName = u.Hostname()
+	}
+
+	var opts []bkclient.ClientOpt
+	if t.CACert != "" {
+		opts = append(opts, bkclient.WithServerConfig(serverName, t.CACert))
+	} else {
+		opts = append(opts, bkclient.WithServerConfigSystem(serverName))
+	}
+	if t.Cert != "" {
+		opts = append(opts, bkclient.WithCredentials(t.Cert, t.Key))
+	}
+	return opts, nil
 }
 
 func NewFromEnv() *Builder {
@@ -24,53 +77,158 @@ func NewFromEnv() *Builder {
 	if addr == "" {
 		addr = "tcp://127.0.0.1:1234"
 	}
+	dcfg := dockerConfigDir()
+	builderID := os.Getenv("NIMBUL_BUILDER_ID")
+	if builderID == "" {
+		builderID = "https://github.com/coding-cave-dev/nimbul"
+	}
+	tlsConfig := TLSConfig{
+		CACert:     os.Getenv("BUILDKIT_TLS_CA_CERT"),
+		Cert:       os.Getenv("BUILDKIT_TLS_CERT"),
+		Key:        os.Getenv("BUILDKIT_TLS_KEY"),
+		ServerName: os.Getenv("BUILDKIT_TLS_SERVER_NAME"),
+	}
+	return &Builder{Addr: addr, DockerConfig: dcfg, BuilderID: builderID, TLS: tlsConfig}
+}
+
+// dockerConfigDir returns the directory of the Docker config holding registry credentials
+func dockerConfigDir() string {
 	dcfg := os.Getenv("DOCKER_CONFIG")
 	if dcfg == "" {
 		home, _ := os.UserHomeDir()
 		dcfg = filepath.Join(home, ".docker")
 	}
-	return &Builder{Addr: addr, DockerConfig: dcfg}
+	return dcfg
 }
 
 type BuildRequest struct {
-	ContextDir string // local path (for local mode)
-	Dockerfile string // path to Dockerfile relative to context (e.g., "Dockerfile" or "path/to/Dockerfile")
-	ImageRef   string // ghcr.io/coding-cave-dev/nimbul-api:sha-xxxx
-	CacheRef   string // ghcr.io/coding-cave-dev/nimbul-api:buildcache
-	Push       bool   // whether to push to registry
+	ContextDir string            // local path (for local mode)
+	Dockerfile string            // path to Dockerfile relative to context (e.g., "Dockerfile" or "path/to/Dockerfile")
+	ImageRefs  []string          // ghcr.io/coding-cave-dev/nimbul-api:sha-xxxx, each name of the image
+	CacheRef   string            // ghcr.io/coding-cave-dev/nimbul-api:buildcache
+	Push       bool              // whether to push to registry
+	BuildArgs  map[string]string // values of the Dockerfile ARGs
+	// Login, when set, logs in to its registry instead of using the Docker config
+	Login *registry.Login
+	// GitContext, when set, is a git URL BuildKit clones the context from instead of
+	// uploading ContextDir, e.g. https://github.com/org/repo.git#<commit>:<subdir>.
+	// Dockerfile is relative to it.
+	GitContext string
+	GitToken   string // token to clone GitContext with
+	// OnLog, when set, receives the build output line by line and the state changes of
+	// the build steps. It is called from a single goroutine.
+	OnLog func(LogEntry)
+	// OnTiming, when set, receives how long each part of the build took once it ends
+	OnTiming func(Timing)
+
+	// Recorded in the provenance attestation of pushed images
+	VCSSource   string // e.g. https://github.com/coding-cave-dev/nimbul.git
+	VCSRevision string // commit SHA
+}
+
+// BuildAndPush builds the image of req once, exports it under every name of ImageRefs
+// and returns its digest. Pushed images carry a SLSA provenance attestation generated
+// by BuildKit.
+func (b *Builder) BuildAndPush(ctx context.Context, req BuildRequest) (string, error) {
+	// Configure exports; the image exporter takes a comma-separated list of names
+	exports := []bkclient.ExportEntry{
+		{
+			Type: "image",
+			Attrs: map[string]string{
+				"name": strings.Join(req.ImageRefs, ","),
+				"push": fmt.Sprintf("%t", req.Push),
+			},
+		},
+	}
+
+	attrs := map[string]string{}
+	if req.Push {
+		attrs["attest:provenance"] = "mode=max,builder-id=" + b.BuilderID
+		if req.VCSSource != "" {
+			attrs["vcs:source"] = req.VCSSource
+		}
+		if req.VCSRevision != "" {
+			attrs["vcs:revision"] = req.VCSRevision
+		}
+	}
+
+	resp, err := b.solve(ctx, req, attrs, exports)
+	if err != nil {
+		return "", err
+	}
+
+	return resp.ExporterResponse[exptypes.ExporterImageDigestKey], nil
 }
 
-func (b *Builder) BuildAndPush(ctx context.Context, req BuildRequest) error {
-	c, err := bkclient.New(ctx, b.Addr)
+// ExportStage builds the target stage of the Dockerfile and copies the stage's
+// filesystem into outputDir. ImageRefs and Push of req are ignored.
+func (b *Builder) ExportStage(ctx context.Context, req BuildRequest, stage, outputDir string) error {
+	exports := []bkclient.ExportEntry{
+		{
+			Type:      bkclient.ExporterLocal,
+			OutputDir: outputDir,
+		},
+	}
+
+	_, err := b.solve(ctx, req, map[string]string{"target": stage}, exports)
+	return err
+}
+
+// solve builds the Dockerfile of req with additional frontend attributes and hands
+// the result to exports
+func (b *Builder) solve(ctx context.Context, req BuildRequest, attrs map[string]string, exports []bkclient.ExportEntry) (*bkclient.SolveResponse, error) {
+	opts, err := b.TLS.clientOpts(b.Addr)
+	if err != nil {
+		return nil, err
+	}
+	c, err := bkclient.New(ctx, b.Addr, opts...)
 	if err != nil {
-		return fmt.Errorf("buildkit client: %w", err)
+		return nil, fmt.Errorf("buildkit client: %w", err)
 	}
 	defer c.Close()
 
 	// Create session
 	sess, err := session.NewSession(ctx, "nimbul")
 	if err != nil {
-		return fmt.Errorf("session: %w", err)
+		return nil, fmt.Errorf("session: %w", err)
 	}
 
-	// Add filesync provider for local directories
-	contextFS, err := fsutil.NewFS(req.ContextDir)
-	if err != nil {
-		return fmt.Errorf("failed to create context fs: %w", err)
-	}
-	dockerfileFS, err := fsutil.NewFS(req.ContextDir)
-	if err != nil {
-		return fmt.Errorf("failed to create dockerfile fs: %w", err)
+	if req.GitContext != "" {
+		// BuildKit clones the context itself, with the token as a session secret
+		if req.GitToken != "" {
+			sess.Allow(secretsprovider.FromMap(map[string][]byte{
+				llb.GitAuthTokenKey: []byte(req.GitToken),
+			}))
+		}
+	} else {
+		// Add filesync provider for local directories. The context leaves out .git and
+		// the files matching .dockerignore, so they are never uploaded to the builder.
+		contextFS, err := fsutil.NewFS(req.ContextDir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create context fs: %w", err)
+		}
+		excludes, err := ContextExcludes(req.ContextDir, req.Dockerfile)
+		if err != nil {
+			return nil, err
+		}
+		contextFS, err = fsutil.NewFilterFS(contextFS, &fsutil.FilterOpt{ExcludePatterns: excludes})
+		if err != nil {
+			return nil, fmt.Errorf("failed to filter context fs: %w", err)
+		}
+		dockerfileFS, err := fsutil.NewFS(req.ContextDir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create dockerfile fs: %w", err)
+		}
+		sess.Allow(filesync.NewFSSyncProvider(filesync.StaticDirSource{
+			"context":    contextFS,
+			"dockerfile": dockerfileFS,
+		}))
 	}
-	sess.Allow(filesync.NewFSSyncProvider(filesync.StaticDirSource{
-		"context":    contextFS,
-		"dockerfile": dockerfileFS,
-	}))
 
 	// Add auth provider for registry
-	dockerConfig, err := config.Load(b.DockerConfig)
+	dockerConfig, err := loadDockerConfig(b.DockerConfig, req.Login)
 	if err != nil {
-		return fmt.Errorf("failed to load docker config: %w", err)
+		return nil, err
 	}
 	auth := authprovider.NewDockerAuthProvider(authprovider.DockerAuthProviderConfig{
 		ConfigFile: dockerConfig,
@@ -88,25 +246,29 @@ func (b *Builder) BuildAndPush(ctx context.Context, req BuildRequest) error {
 	if req.Dockerfile != "" && req.Dockerfile != "Dockerfile" {
 		frontendAttrs["filename"] = req.Dockerfile
 	}
-
-	// Configure exports
-	exports := []bkclient.ExportEntry{
-		{
-			Type: "image",
-			Attrs: map[string]string{
-				"name": req.ImageRef,
-				"push": fmt.Sprintf("%t", req.Push),
-			},
-		},
+	if req.GitContext != "" {
+		frontendAttrs["context"] = req.GitContext
+	}
+	for k, v := range req.BuildArgs {
+		frontendAttrs["build-arg:"+k] = v
+	}
+	for k, v := range attrs {
+		frontendAttrs[k] = v
 	}
 
 	// Solve with status channel for build logs
 	statusCh := make(chan *bkclient.SolveStatus)
 	statusDone := make(chan struct{})
+	steps := newStepTimings()
 
-	// Process status updates in background
+	// Process status updates in background, handing them to req.OnLog as log entries
 	go func() {
 		defer close(statusDone)
+		lines := newLogLines(req.OnLog)
+		defer lines.flush()
+		vertexNames := make(map[digest.Digest]string)
+		vertexStates := make(map[digest.Digest]string) // last state reported of each step
+
 		fmt.Fprintf(os.Stderr, "[BuildKit] Starting to receive status updates...\n")
 		for {
 			select {
@@ -124,13 +286,30 @@ func (b *Builder) BuildAndPush(ctx context.Context, req BuildRequest) error {
 
 				// Print vertex progress
 				for _, vertex := range status.Vertexes {
-					if vertex.Name != "" {
-						if vertex.Error != "" {
-							fmt.Fprintf(os.Stderr, "[ERROR] %s: %s\n", vertex.Name, vertex.Error)
-						} else if vertex.Completed != nil {
-							fmt.Fprintf(os.Stderr, "[✓] %s\n", vertex.Name)
-						} else if vertex.Started != nil {
-							fmt.Fprintf(os.Stderr, "[*] %s\n", vertex.Name)
+					if vertex.Name == "" {
+						continue
+					}
+					vertexNames[vertex.Digest] = vertex.Name
+
+					state, t := "", time.Now()
+					if vertex.Error != "" {
+						fmt.Fprintf(os.Stderr, "[ERROR] %s: %s\n", vertex.Name, vertex.Error)
+						state = "error: " + vertex.Error
+					} else if vertex.Completed != nil {
+						fmt.Fprintf(os.Stderr, "[✓] %s\n", vertex.Name)
+						state, t = "done", *vertex.Completed
+						if vertex.Cached {
+							state = "cached"
+						}
+					} else if vertex.Started != nil {
+						fmt.Fprintf(os.Stderr, "[*] %s\n", vertex.Name)
+						state, t = "started", *vertex.Started
+					}
+					if state != "" && vertexStates[vertex.Digest] != state {
+						vertexStates[vertex.Digest] = state
+						lines.status(t, vertex.Name, state)
+						if vertex.Started != nil && vertex.Completed != nil {
+							steps.add(vertex.Name, *vertex.Started, *vertex.Completed)
 						}
 					}
 				}
@@ -138,6 +317,11 @@ func (b *Builder) BuildAndPush(ctx context.Context, req BuildRequest) error {
 				// Print log output
 				for _, log := range status.Logs {
 					os.Stderr.Write(log.Data)
+					stream := LogStdout
+					if log.Stream == 2 {
+						stream = LogStderr
+					}
+					lines.write(log.Timestamp, vertexNames[log.Vertex], stream, log.Data)
 				}
 
 				os.Stderr.Sync()
@@ -149,18 +333,23 @@ func (b *Builder) BuildAndPush(ctx context.Context, req BuildRequest) error {
 	}()
 
 	// Use Solve with status channel
-	_, err = c.Solve(ctx, nil, bkclient.SolveOpt{
+	resp, err := c.Solve(ctx, nil, bkclient.SolveOpt{
 		Frontend:      "dockerfile.v0",
 		FrontendAttrs: frontendAttrs,
 		Exports:       exports,
 		SharedSession: sess,
 	}, statusCh)
 	if err != nil {
-		return fmt.Errorf("solve: %w", err)
+		return nil, fmt.Errorf("solve: %w", err)
 	}
 
 	// Wait for status processing to complete
 	<-statusDone
+	if req.OnTiming != nil {
+		for _, timing := range steps.timings() {
+			req.OnTiming(timing)
+		}
+	}
 
-	return nil
+	return resp, nil
 }
@@ -0,0 +1,616 @@
+package buildkit
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/registry"
+	"github.com/distribution/reference"
+	"github.com/docker/cli/cli/config/configfile"
+	"github.com/moby/patternmatcher"
+)
+
+// dockerAPIVersion is the Docker Engine API version requests use (Docker 20.10 and later)
+const dockerAPIVersion = "v1.41"
+
+// contextDockerfile is where a Dockerfile outside the build context is placed in the
+// context sent to the daemon
+const contextDockerfile = ".nimbul.Dockerfile"
+
+// DockerBuilder builds images through the Engine API of a Docker daemon, for instances
+// that run Docker rather than a separate buildkitd. Images are built by the daemon's
+// classic builder and pushed by the daemon, so they carry no provenance attestation.
+type DockerBuilder struct {
+	Host         string // e.g. unix:///var/run/docker.sock or tcp://docker:2376
+	CertPath     string // directory of ca.pem, cert.pem and key.pem when the daemon requires TLS
+	DockerConfig string // e.g. ~/.docker or /docker (mounted secret)
+}
+
+// NewDockerFromEnv configures the daemon like the Docker CLI does, with DOCKER_HOST,
+// DOCKER_TLS_VERIFY and DOCKER_CERT_PATH
+func NewDockerFromEnv() *DockerBuilder {
+	host := os.Getenv("DOCKER_HOST")
+	if host == "" {
+		host = "unix:///var/run/docker.sock"
+	}
+
+	certPath := ""
+	if os.Getenv("DOCKER_TLS_VERIFY") != "" {
+		certPath = os.Getenv("DOCKER_CERT_PATH")
+		if certPath == "" {
+			certPath = dockerConfigDir()
+		}
+	}
+
+	return &DockerBuilder{Host: host, CertPath: certPath, DockerConfig: dockerConfigDir()}
+}
+
+// BuildAndPush builds the image of req, tags it with every name of ImageRefs and
+// pushes each of them when req.Push is set. Returns the digest of the pushed manifest.
+func (d *DockerBuilder) BuildAndPush(ctx context.Context, req BuildRequest) (string, error) {
+	dockerConfig, err := loadDockerConfig(d.DockerConfig, req.Login)
+	if err != nil {
+		return "", err
+	}
+
+	// The daemon reports no step timings, so the build and the push are timed whole
+	started := time.Now()
+	if err := d.build(ctx, req, req.ImageRefs, "", dockerConfig); err != nil {
+		return "", err
+	}
+	if req.OnTiming != nil {
+		req.OnTiming(Timing{Name: "build", Kind: TimingStage, Started: started, Duration: time.Since(started)})
+	}
+
+	if !req.Push {
+		return "", nil
+	}
+
+	// Every tag names the same image, so each push returns the same digest
+	started = time.Now()
+	var digest string
+	for _, imageRef := range req.ImageRefs {
+		pushed, err := d.push(ctx, imageRef, dockerConfig)
+		if err != nil {
+			return "", err
+		}
+		if digest == "" {
+			digest = pushed
+		}
+	}
+	if req.OnTiming != nil {
+		req.OnTiming(Timing{Name: "push", Kind: TimingPush, Started: started, Duration: time.Since(started)})
+	}
+
+	return digest, nil
+}
+
+// ExportStage builds the target stage of the Dockerfile into a temporary image and
+// copies its filesystem into outputDir through a container that is never started.
+// ImageRefs and Push of req are ignored.
+func (d *DockerBuilder) ExportStage(ctx context.Context, req BuildRequest, stage, outputDir string) error {
+	dockerConfig, err := loadDockerConfig(d.DockerConfig, req.Login)
+	if err != nil {
+		return err
+	}
+
+	// Clean up even when the build was canceled
+	cleanupCtx := context.WithoutCancel(ctx)
+
+	image := fmt.Sprintf("nimbul-export:%d", time.Now().UnixNano())
+	if err := d.build(ctx, req, []string{image}, stage, dockerConfig); err != nil {
+		return err
+	}
+	defer func() {
+		if err := d.call(cleanupCtx, http.MethodDelete, "/images/"+image, url.Values{"force": {"1"}}, nil, nil, nil); err != nil {
+			fmt.Printf("Warning: Failed to remove image %s: %v\n", image, err)
+		}
+	}()
+
+	// Images without a command cannot be created as containers, so give it one
+	body, err := json.Marshal(map[string]any{"Image": image, "Cmd": []string{"nimbul-export"}})
+	if err != nil {
+		return err
+	}
+	var container struct {
+		ID string `json:"Id"`
+	}
+	header := http.Header{"Content-Type": {"application/json"}}
+	if err := d.call(ctx, http.MethodPost, "/containers/create", nil, header, bytes.NewReader(body), &container); err != nil {
+		return fmt.Errorf("failed to create container of stage %s: %w", stage, err)
+	}
+	defer func() {
+		if err := d.call(cleanupCtx, http.MethodDelete, "/containers/"+container.ID, url.Values{"force": {"1"}}, nil, nil, nil); err != nil {
+			fmt.Printf("Warning: Failed to remove container %s: %v\n", container.ID, err)
+		}
+	}()
+
+	resp, err := d.do(ctx, http.MethodGet, "/containers/"+container.ID+"/archive", url.Values{"path": {"/"}}, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to copy filesystem of stage %s: %w", stage, err)
+	}
+	defer resp.Body.Close()
+
+	if err := extractTar(resp.Body, outputDir); err != nil {
+		return fmt.Errorf("failed to extract filesystem of stage %s: %w", stage, err)
+	}
+
+	return nil
+}
+
+// FetchProvenance always returns ErrNoProvenance, the classic builder attests nothing
+func (d *DockerBuilder) FetchProvenance(ctx context.Context, imageRef, digest string, login *registry.Login) (*Provenance, error) {
+	return nil, ErrNoProvenance
+}
+
+// build builds the Dockerfile of req, or its target stage when set, and tags the image
+// with tags. Base images are pulled with the registry credentials of dockerConfig.
+func (d *DockerBuilder) build(ctx context.Context, req BuildRequest, tags []string, target string, dockerConfig *configfile.ConfigFile) error {
+	dockerfile := req.Dockerfile
+	if dockerfile == "" {
+		dockerfile = "Dockerfile"
+	}
+	// The daemon only reads the Dockerfile from the context
+	external := !filepath.IsLocal(dockerfile)
+
+	query := url.Values{}
+	for _, tag := range tags {
+		query.Add("t", tag)
+	}
+	if external {
+		query.Set("dockerfile", contextDockerfile)
+	} else {
+		query.Set("dockerfile", filepath.ToSlash(dockerfile))
+	}
+	if target != "" {
+		query.Set("target", target)
+	}
+	query.Set("rm", "1")
+	query.Set("forcerm", "1")
+	if len(req.BuildArgs) > 0 {
+		buildArgs, err := json.Marshal(req.BuildArgs)
+		if err != nil {
+			return fmt.Errorf("failed to encode build args: %w", err)
+		}
+		query.Set("buildargs", string(buildArgs))
+	}
+
+	credentials, err := dockerConfig.GetAllCredentials()
+	if err != nil {
+		return fmt.Errorf("failed to read registry credentials: %w", err)
+	}
+	registryConfig, err := json.Marshal(credentials)
+	if err != nil {
+		return fmt.Errorf("failed to encode registry credentials: %w", err)
+	}
+
+	header := http.Header{
+		"X-Registry-Config": {base64.URLEncoding.EncodeToString(registryConfig)},
+	}
+
+	var body io.Reader
+	if req.GitContext != "" {
+		// The daemon clones the context itself
+		remote, err := url.Parse(req.GitContext)
+		if err != nil {
+			return fmt.Errorf("invalid git context: %w", err)
+		}
+		if req.GitToken != "" {
+			remote.User = url.UserPassword("x-access-token", req.GitToken)
+		}
+		query.Set("remote", remote.String())
+	} else {
+		excludes, err := ContextExcludes(req.ContextDir, req.Dockerfile)
+		if err != nil {
+			return err
+		}
+
+		// Stream the context to the daemon while it is archived
+		contextReader, contextWriter := io.Pipe()
+		go func() {
+			contextWriter.CloseWithError(writeContext(contextWriter, req.ContextDir, dockerfile, external, excludes))
+		}()
+		defer contextReader.Close()
+
+		header.Set("Content-Type", "application/x-tar")
+		body = contextReader
+	}
+
+	resp, err := d.do(ctx, http.MethodPost, "/build", query, header, body)
+	if err != nil {
+		return fmt.Errorf("build: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// The classic builder reports no steps, its output is one stream
+	lines := newLogLines(req.OnLog)
+	defer lines.flush()
+	if err := readStream(resp.Body, lines, nil); err != nil {
+		lines.write(time.Now(), "", LogStderr, []byte(err.Error()+"\n"))
+		return fmt.Errorf("build: %w", err)
+	}
+	return nil
+}
+
+// push pushes a tagged image and returns the digest of its manifest
+func (d *DockerBuilder) push(ctx context.Context, imageRef string, dockerConfig *configfile.ConfigFile) (string, error) {
+	named, err := reference.ParseNormalizedNamed(imageRef)
+	if err != nil {
+		return "", fmt.Errorf("invalid image reference %q: %w", imageRef, err)
+	}
+	tag := "latest"
+	if tagged, ok := named.(reference.Tagged); ok {
+		tag = tagged.Tag()
+	}
+
+	auth, err := dockerConfig.GetAuthConfig(registry.AuthKey(reference.Domain(named)))
+	if err != nil {
+		return "", fmt.Errorf("failed to read credentials of %s: %w", reference.Domain(named), err)
+	}
+	authJSON, err := json.Marshal(auth)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode credentials: %w", err)
+	}
+
+	header := http.Header{"X-Registry-Auth": {base64.URLEncoding.EncodeToString(authJSON)}}
+	resp, err := d.do(ctx, http.MethodPost, "/images/"+reference.FamiliarName(named)+"/push", url.Values{"tag": {tag}}, header, nil)
+	if err != nil {
+		return "", fmt.Errorf("push %s: %w", imageRef, err)
+	}
+	defer resp.Body.Close()
+
+	var digest string
+	err = readStream(resp.Body, nil, func(aux json.RawMessage) {
+		var pushed struct {
+			Digest string `json:"Digest"`
+		}
+		if json.Unmarshal(aux, &pushed) == nil && pushed.Digest != "" {
+			digest = pushed.Digest
+		}
+	})
+	if err != nil {
+		return "", fmt.Errorf("push %s: %w", imageRef, err)
+	}
+
+	return digest, nil
+}
+
+// call sends a request to the daemon and decodes its JSON response into out, when set
+func (d *DockerBuilder) call(ctx context.Context, method, endpoint string, query url.Values, header http.Header, body io.Reader, out any) error {
+	resp, err := d.do(ctx, method, endpoint, query, header, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// do sends a request to the daemon and returns its response, or an error when the
+// daemon rejected it
+func (d *DockerBuilder) do(ctx context.Context, method, endpoint string, query url.Values, header http.Header, body io.Reader) (*http.Response, error) {
+	client, baseURL, err := d.client()
+	if err != nil {
+		return nil, err
+	}
+
+	reqURL := baseURL + "/" + dockerAPIVersion + endpoint
+	if len(query) > 0 {
+		reqURL += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, body)
+	if err != nil {
+		return nil, err
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("docker daemon: %w", err)
+	}
+
+	if resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		var problem struct {
+			Message string `json:"message"`
+		}
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		if json.Unmarshal(data, &problem) == nil && problem.Message != "" {
+			return nil, fmt.Errorf("docker daemon: %s: %s", resp.Status, problem.Message)
+		}
+		return nil, fmt.Errorf("docker daemon: %s: %s", resp.Status, strings.TrimSpace(string(data)))
+	}
+
+	return resp, nil
+}
+
+// client returns an HTTP client dialing the daemon and the base URL of its API
+func (d *DockerBuilder) client() (*http.Client, string, error) {
+	u, err := url.Parse(d.Host)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid DOCKER_HOST %s: %w", d.Host, err)
+	}
+
+	switch u.Scheme {
+	case "unix":
+		socket := u.Path
+		transport := &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				var dialer net.Dialer
+				return dialer.DialContext(ctx, "unix", socket)
+			},
+		}
+		return &http.Client{Transport: transport}, "http://docker", nil
+	case "tcp", "http", "https":
+		scheme := "http"
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		if d.CertPath != "" {
+			tlsConfig, err := dockerTLSConfig(d.CertPath)
+			if err != nil {
+				return nil, "", err
+			}
+			transport.TLSClientConfig = tlsConfig
+			scheme = "https"
+		}
+		if u.Scheme == "https" {
+			scheme = "https"
+		}
+		return &http.Client{Transport: transport}, scheme + "://" + u.Host, nil
+	default:
+		return nil, "", fmt.Errorf("unsupported DOCKER_HOST %s, use unix:// or tcp://", d.Host)
+	}
+}
+
+// dockerTLSConfig loads the CA and client certificate the Docker CLI uses for daemons
+// requiring TLS
+func dockerTLSConfig(certPath string) (*tls.Config, error) {
+	caCert, err := os.ReadFile(filepath.Join(certPath, "ca.pem"))
+	if err != nil {
+		return nil, fmt.Errorf("docker TLS: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caCert) {
+		return nil, fmt.Errorf("docker TLS: no certificates in ca.pem")
+	}
+
+	cert, err := tls.LoadX509KeyPair(filepath.Join(certPath, "cert.pem"), filepath.Join(certPath, "key.pem"))
+	if err != nil {
+		return nil, fmt.Errorf("docker TLS: %w", err)
+	}
+
+	return &tls.Config{
+		RootCAs:      pool,
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// readStream reads the JSON messages the daemon streams while building and pushing,
+// printing the build output and writing it to lines when set, and handing aux
+// messages to onAux when set. Returns the error the daemon reported, if any.
+func readStream(r io.Reader, lines *logLines, onAux func(json.RawMessage)) error {
+	decoder := json.NewDecoder(r)
+	for {
+		var message struct {
+			Stream string          `json:"stream"`
+			Error  string          `json:"error"`
+			Aux    json.RawMessage `json:"aux"`
+		}
+		if err := decoder.Decode(&message); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("failed to read daemon output: %w", err)
+		}
+
+		if message.Error != "" {
+			return errors.New(strings.TrimSpace(message.Error))
+		}
+		if message.Stream != "" {
+			fmt.Fprint(os.Stderr, message.Stream)
+			if lines != nil {
+				lines.write(time.Now(), "", LogStdout, []byte(message.Stream))
+			}
+		}
+		if message.Aux != nil && onAux != nil {
+			onAux(message.Aux)
+		}
+	}
+}
+
+// writeContext archives the build context for the daemon, leaving out excluded files.
+// The Dockerfile is always included; one outside the context is added as contextDockerfile.
+func writeContext(w io.Writer, contextDir, dockerfile string, external bool, excludes []string) error {
+	matcher, err := patternmatcher.New(excludes)
+	if err != nil {
+		return fmt.Errorf("invalid .dockerignore: %w", err)
+	}
+
+	tw := tar.NewWriter(w)
+	dockerfilePath := filepath.Clean(dockerfile)
+
+	err = filepath.WalkDir(contextDir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(contextDir, p)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+
+		if rel != dockerfilePath {
+			excluded, err := matcher.MatchesOrParentMatches(filepath.ToSlash(rel))
+			if err != nil {
+				return err
+			}
+			if excluded {
+				// Exceptions may include files of an excluded directory
+				if d.IsDir() && !matcher.Exclusions() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+		}
+
+		return addToTar(tw, p, filepath.ToSlash(rel), d)
+	})
+	if err != nil {
+		return err
+	}
+
+	if external {
+		info, err := os.Stat(filepath.Join(contextDir, dockerfile))
+		if err != nil {
+			return fmt.Errorf("failed to read Dockerfile: %w", err)
+		}
+		if err := addToTar(tw, filepath.Join(contextDir, dockerfile), contextDockerfile, fs.FileInfoToDirEntry(info)); err != nil {
+			return err
+		}
+	}
+
+	return tw.Close()
+}
+
+// addToTar writes the file at p to tw under name. Symlinks are archived as links.
+func addToTar(tw *tar.Writer, p, name string, d fs.DirEntry) error {
+	info, err := d.Info()
+	if err != nil {
+		return err
+	}
+
+	var link string
+	if info.Mode()&fs.ModeSymlink != 0 {
+		if link, err = os.Readlink(p); err != nil {
+			return err
+		}
+	}
+
+	header, err := tar.FileInfoHeader(info, link)
+	if err != nil {
+		return err
+	}
+	header.Name = name
+	if d.IsDir() {
+		header.Name += "/"
+	}
+
+	if err := tw.WriteHeader(header); err != nil {
+		return err
+	}
+
+	if !info.Mode().IsRegular() {
+		return nil
+	}
+
+	f, err := os.Open(p)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(tw, f)
+	return err
+}
+
+// extractTar extracts a filesystem archived by the daemon into dir. Entries never
+// land outside dir: names are cleaned and nothing is written through a symlink.
+func extractTar(r io.Reader, dir string) error {
+	symlinks := make(map[string]bool)
+
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		name := strings.TrimPrefix(path.Clean("/"+header.Name), "/")
+		if name == "" || symlinks[name] || underSymlink(name, symlinks) {
+			continue
+		}
+		target := filepath.Join(dir, filepath.FromSlash(name))
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0o755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+				return err
+			}
+			f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, header.FileInfo().Mode().Perm())
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(f, tr)
+			f.Close()
+			if err != nil {
+				return err
+			}
+		case tar.TypeSymlink:
+			if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+				return err
+			}
+			if err := os.Symlink(header.Linkname, target); err != nil && !errors.Is(err, fs.ErrExist) {
+				return err
+			}
+			symlinks[name] = true
+		case tar.TypeLink:
+			linkName := strings.TrimPrefix(path.Clean("/"+header.Linkname), "/")
+			if linkName == "" || underSymlink(linkName, symlinks) || symlinks[linkName] {
+				continue
+			}
+			if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+				return err
+			}
+			if err := os.Link(filepath.Join(dir, filepath.FromSlash(linkName)), target); err != nil && !errors.Is(err, fs.ErrExist) {
+				return err
+			}
+		}
+		// Devices and other special files are not extracted
+	}
+}
+
+// underSymlink reports whether a parent directory of name was extracted as a symlink
+func underSymlink(name string, symlinks map[string]bool) bool {
+	for dir := path.Dir(name); dir != "."; dir = path.Dir(dir) {
+		if symlinks[dir] {
+			return true
+		}
+	}
+	return false
+}
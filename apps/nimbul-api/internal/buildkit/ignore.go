@@ -0,0 +1,45 @@
+package buildkit
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/moby/patternmatcher/ignorefile"
+)
+
+// ContextExcludes returns the patterns of the files not to send to BuildKit with the
+// build context: .git, then the patterns of the Dockerfile's own ignore file
+// (e.g. app.Dockerfile.dockerignore) or else of the context's .dockerignore. Since
+// .git comes first, "!.git" in an ignore file sends it after all.
+func ContextExcludes(contextDir, dockerfile string) ([]string, error) {
+	excludes := []string{".git"}
+
+	if dockerfile == "" {
+		dockerfile = "Dockerfile"
+	}
+	candidates := []string{
+		filepath.Join(contextDir, dockerfile+".dockerignore"),
+		filepath.Join(contextDir, ".dockerignore"),
+	}
+
+	for _, path := range candidates {
+		f, err := os.Open(path)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to open %s: %w", filepath.Base(path), err)
+		}
+		defer f.Close()
+
+		patterns, err := ignorefile.ReadAll(f)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", filepath.Base(path), err)
+		}
+		return append(excludes, patterns...), nil
+	}
+
+	return excludes, nil
+}
@@ -0,0 +1,84 @@
+package buildkit
+
+import (
+	"bytes"
+	"time"
+)
+
+// Log entry streams, matching those of stored build logs
+const (
+	LogStdout = "stdout"
+	LogStderr = "stderr"
+	LogStatus = "status"
+)
+
+// LogEntry is a line of build output, or a build step starting, finishing or failing
+type LogEntry struct {
+	Time    time.Time
+	Vertex  string // build step, e.g. "[2/5] RUN go build"
+	Stream  string
+	Message string
+}
+
+// logLines splits the output chunks of build steps into lines. A nil emit discards them.
+type logLines struct {
+	emit    func(LogEntry)
+	partial map[lineKey]*bytes.Buffer
+}
+
+type lineKey struct {
+	vertex string
+	stream string
+}
+
+func newLogLines(emit func(LogEntry)) *logLines {
+	return &logLines{emit: emit, partial: make(map[lineKey]*bytes.Buffer)}
+}
+
+// write emits every complete line of data, keeping the rest until the step writes more
+func (l *logLines) write(t time.Time, vertex, stream string, data []byte) {
+	if l.emit == nil {
+		return
+	}
+
+	key := lineKey{vertex: vertex, stream: stream}
+	buf := l.partial[key]
+	if buf == nil {
+		buf = &bytes.Buffer{}
+		l.partial[key] = buf
+	}
+	buf.Write(data)
+
+	for {
+		line, err := buf.ReadString('\n')
+		if err != nil {
+			// No newline yet, keep the partial line
+			rest := []byte(line)
+			buf.Reset()
+			buf.Write(rest)
+			return
+		}
+		l.emit(LogEntry{Time: t, Vertex: vertex, Stream: stream, Message: line[:len(line)-1]})
+	}
+}
+
+// status emits a change of a build step's state
+func (l *logLines) status(t time.Time, vertex, message string) {
+	if l.emit == nil {
+		return
+	}
+	l.emit(LogEntry{Time: t, Vertex: vertex, Stream: LogStatus, Message: message})
+}
+
+// flush emits the partial lines left once the build ended
+func (l *logLines) flush() {
+	if l.emit == nil {
+		return
+	}
+	for key, buf := range l.partial {
+		if buf.Len() > 0 {
+			l.emit(LogEntry{Time: time.Now(), Vertex: key.vertex, Stream: key.stream, Message: buf.String()})
+		}
+	}
+	l.partial = make(map[lineKey]*bytes.Buffer)
+}
@@ -0,0 +1,121 @@
+package buildkit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/coding-cave-dev/nimbul/internal/registry"
+	"github.com/containerd/containerd/v2/core/remotes"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+var ErrNoProvenance = errors.New("image has no provenance attestation")
+
+// Annotations BuildKit sets on attestation manifests and their layers
+const (
+	referenceTypeAnnotation = "vnd.docker.reference.type"
+	predicateTypeAnnotation = "in-toto.io/predicate-type"
+)
+
+// Provenance is a SLSA provenance attestation of a pushed image, as an in-toto statement
+type Provenance struct {
+	PredicateType string
+	Statement     []byte
+}
+
+// FetchProvenance reads the provenance attestation BuildKit pushed alongside an image.
+// imageRef names the repository, digest the image index returned by BuildAndPush.
+// A non-nil login is used for the registry of the image.
+func (b *Builder) FetchProvenance(ctx context.Context, imageRef, digest string, login *registry.Login) (*Provenance, error) {
+	// Read with the same credentials BuildKit pushed with
+	client := &registry.Client{DockerConfig: b.DockerConfig, Login: login}
+	resolver, err := client.Resolver()
+	if err != nil {
+		return nil, err
+	}
+
+	repository, err := registry.Repository(imageRef)
+	if err != nil {
+		return nil, err
+	}
+
+	ref := repository + "@" + digest
+	_, desc, err := resolver.Resolve(ctx, ref)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve %s: %w", ref, err)
+	}
+
+	fetcher, err := resolver.Fetcher(ctx, ref)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create fetcher: %w", err)
+	}
+
+	// Attestations are stored as extra manifests of the image index
+	if desc.MediaType != ocispec.MediaTypeImageIndex {
+		return nil, ErrNoProvenance
+	}
+
+	var index ocispec.Index
+	if err := fetchJSON(ctx, fetcher, desc, &index); err != nil {
+		return nil, err
+	}
+
+	for _, manifestDesc := range index.Manifests {
+		if manifestDesc.Annotations[referenceTypeAnnotation] != "attestation-manifest" {
+			continue
+		}
+
+		var manifest ocispec.Manifest
+		if err := fetchJSON(ctx, fetcher, manifestDesc, &manifest); err != nil {
+			return nil, err
+		}
+
+		for _, layer := range manifest.Layers {
+			predicateType := layer.Annotations[predicateTypeAnnotation]
+			if !strings.HasPrefix(predicateType, "https://slsa.dev/provenance/") {
+				continue
+			}
+
+			statement, err := fetchBlob(ctx, fetcher, layer)
+			if err != nil {
+				return nil, err
+			}
+
+			return &Provenance{
+				PredicateType: predicateType,
+				Statement:     statement,
+			}, nil
+		}
+	}
+
+	return nil, ErrNoProvenance
+}
+
+func fetchJSON(ctx context.Context, fetcher remotes.Fetcher, desc ocispec.Descriptor, v any) error {
+	data, err := fetchBlob(ctx, fetcher, desc)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("failed to decode %s: %w", desc.Digest, err)
+	}
+	return nil
+}
+
+func fetchBlob(ctx context.Context, fetcher remotes.Fetcher, desc ocispec.Descriptor) ([]byte, error) {
+	rc, err := fetcher.Fetch(ctx, desc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", desc.Digest, err)
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(io.LimitReader(rc, desc.Size+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", desc.Digest, err)
+	}
+	return data, nil
+}
@@ -0,0 +1,98 @@
+package buildkit
+
+import (
+	"strings"
+	"time"
+)
+
+// Timing kinds, matching those of stored build timings
+const (
+	TimingContext = "context" // uploading or cloning the build context
+	TimingStage   = "stage"   // the steps of a Dockerfile stage
+	TimingPush    = "push"    // exporting and pushing the image
+)
+
+// Timing is how long a part of a build took
+type Timing struct {
+	Name     string // e.g. "context", "builder" or "push"
+	Kind     string
+	Started  time.Time
+	Duration time.Duration
+}
+
+// stepTimings groups the steps of a build by the Dockerfile stage or the part of the
+// build they belong to. A group spans from its first step starting to its last one
+// finishing, so steps running in parallel are not counted twice.
+type stepTimings struct {
+	groups map[string]*stepGroup
+	order  []string
+}
+
+type stepGroup struct {
+	kind      string
+	started   time.Time
+	completed time.Time
+}
+
+func newStepTimings() *stepTimings {
+	return &stepTimings{groups: make(map[string]*stepGroup)}
+}
+
+// add records a finished step
+func (s *stepTimings) add(vertex string, started, completed time.Time) {
+	name, kind := stepGroupOf(vertex)
+	group := s.groups[name]
+	if group == nil {
+		s.groups[name] = &stepGroup{kind: kind, started: started, completed: completed}
+		s.order = append(s.order, name)
+		return
+	}
+	if started.Before(group.started) {
+		group.started = started
+	}
+	if completed.After(group.completed) {
+		group.completed = completed
+	}
+}
+
+// timings returns a timing per group, in the order their first step finished
+func (s *stepTimings) timings() []Timing {
+	timings := make([]Timing, 0, len(s.order))
+	for _, name := range s.order {
+		group := s.groups[name]
+		timings = append(timings, Timing{
+			Name:     name,
+			Kind:     group.kind,
+			Started:  group.started,
+			Duration: group.completed.Sub(group.started),
+		})
+	}
+	return timings
+}
+
+// stepGroupOf returns the group of a BuildKit step from its name, e.g. "builder" for
+// "[builder 2/5] RUN go build"
+func stepGroupOf(vertex string) (name, kind string) {
+	switch {
+	case strings.HasPrefix(vertex, "[internal] load build context"),
+		strings.HasPrefix(vertex, "[internal] load git source"):
+		return "context", TimingContext
+	case strings.HasPrefix(vertex, "[internal]"):
+		// Reading the Dockerfile, .dockerignore and base image metadata
+		return "setup", TimingStage
+	case strings.HasPrefix(vertex, "exporting"), strings.HasPrefix(vertex, "pushing"):
+		return "push", TimingPush
+	case strings.HasPrefix(vertex, "importing cache"):
+		return "cache import", TimingStage
+	}
+
+	// Dockerfile steps are named "[<stage> <n>/<total>] ..." and the steps of the
+	// last, unnamed stage "[<n>/<total>] ..."
+	if label, _, ok := strings.Cut(strings.TrimPrefix(vertex, "["), "]"); ok && strings.HasPrefix(vertex, "[") {
+		if stage, _, ok := strings.Cut(label, " "); ok {
+			return stage, TimingStage
+		}
+		return "final", TimingStage
+	}
+	return "other", TimingStage
+}
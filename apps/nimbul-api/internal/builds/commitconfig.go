@@ -0,0 +1,53 @@
+package builds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/coding-cave-dev/nimbul/internal/db"
+	"github.com/jackc/pgx/v5"
+)
+
+var ErrCommitConfigNotFound = errors.New("nimbul.yaml of commit not cached")
+
+// SaveCommitConfig caches the validated nimbul.yaml of a commit of a config. A commit
+// never changes, so a cached nimbul.yaml is kept as it was first saved.
+func (s *Service) SaveCommitConfig(ctx context.Context, configID, commitSHA string, content []byte) error {
+	err := s.queries.SaveCommitConfig(ctx, db.SaveCommitConfigParams{
+		ConfigID:  configID,
+		CommitSha: commitSHA,
+		Content:   string(content),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to cache nimbul.yaml: %w", err)
+	}
+	return nil
+}
+
+// GetCommitConfig returns the cached nimbul.yaml of a commit of a config
+func (s *Service) GetCommitConfig(ctx context.Context, configID, commitSHA string) ([]byte, error) {
+	content, err := s.queries.GetCommitConfig(ctx, db.GetCommitConfigParams{
+		ConfigID:  configID,
+		CommitSha: commitSHA,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCommitConfigNotFound
+		}
+		return nil, fmt.Errorf("failed to get cached nimbul.yaml: %w", err)
+	}
+	return []byte(content), nil
+}
+
+// SetPipelineConfig records the rendered nimbul.yaml a pipeline runs with
+func (s *Service) SetPipelineConfig(ctx context.Context, pipelineID int64, rendered []byte) error {
+	err := s.queries.SetPipelineRenderedConfig(ctx, db.SetPipelineRenderedConfigParams{
+		ID:             pipelineID,
+		RenderedConfig: string(rendered),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record rendered nimbul.yaml: %w", err)
+	}
+	return nil
+}
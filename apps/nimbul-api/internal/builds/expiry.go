@@ -0,0 +1,177 @@
+package builds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ExpiredBatch is how many expired builds or logs a single call deletes
+const ExpiredBatch = 100
+
+// DeleteExpiredBuilds deletes up to ExpiredBatch finished builds older than the build
+// retention of their owner, with their logs, artifacts and provenance. Their images
+// stay in the registry, where tag retention applies. Returns how many builds were
+// deleted and the bytes of storage they used.
+func (s *Service) DeleteExpiredBuilds(ctx context.Context) (int, int64, error) {
+	expired, err := s.queries.GetExpiredBuilds(ctx, ExpiredBatch)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get expired builds: %w", err)
+	}
+
+	deleted, reclaimed := 0, int64(0)
+	for _, build := range expired {
+		if err := s.deleteBuildObjects(ctx, build.ID); err != nil {
+			return deleted, reclaimed, err
+		}
+		if err := s.queries.DeleteBuild(ctx, build.ID); err != nil {
+			return deleted, reclaimed, fmt.Errorf("failed to delete build %d: %w", build.ID, err)
+		}
+		deleted++
+		reclaimed += build.LogBytes + build.ArtifactBytes
+	}
+
+	return deleted, reclaimed, nil
+}
+
+// DeleteExpiredLogs deletes up to ExpiredBatch stored logs older than the log retention
+// of their build's owner. Returns how many logs were deleted and the bytes they used.
+func (s *Service) DeleteExpiredLogs(ctx context.Context) (int, int64, error) {
+	expired, err := s.queries.GetExpiredBuildLogs(ctx, ExpiredBatch)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get expired build logs: %w", err)
+	}
+
+	deleted, reclaimed := 0, int64(0)
+	for _, buildLog := range expired {
+		build, err := s.queries.GetBuildByID(ctx, buildLog.BuildID)
+		if err != nil {
+			return deleted, reclaimed, fmt.Errorf("failed to get build %d: %w", buildLog.BuildID, err)
+		}
+		if buildLog.StorageKey.Valid {
+			if err := s.store.Delete(ctx, buildLog.StorageKey.String); err != nil {
+				return deleted, reclaimed, fmt.Errorf("failed to delete log of build %d: %w", buildLog.BuildID, err)
+			}
+		}
+		if err := s.queries.DeleteBuildLog(ctx, buildLog.BuildID); err != nil {
+			return deleted, reclaimed, fmt.Errorf("failed to delete log of build %d: %w", buildLog.BuildID, err)
+		}
+		deleted++
+		reclaimed += build.LogBytes
+	}
+
+	return deleted, reclaimed, nil
+}
+
+// deleteBuildObjects removes the log, artifacts and provenance of a build from storage
+func (s *Service) deleteBuildObjects(ctx context.Context, buildID int64) error {
+	var keys []string
+
+	buildLog, err := s.queries.GetBuildLog(ctx, buildID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("failed to get log of build %d: %w", buildID, err)
+	}
+	if err == nil && buildLog.StorageKey.Valid {
+		keys = append(keys, buildLog.StorageKey.String)
+	}
+
+	artifacts, err := s.queries.GetBuildArtifactsByBuildID(ctx, buildID)
+	if err != nil {
+		return fmt.Errorf("failed to get artifacts of build %d: %w", buildID, err)
+	}
+	for _, artifact := range artifacts {
+		keys = append(keys, artifact.StorageKey)
+	}
+
+	provenance, err := s.queries.GetBuildProvenanceByBuildID(ctx, buildID)
+	if err != nil {
+		return fmt.Errorf("failed to get provenance of build %d: %w", buildID, err)
+	}
+	for _, row := range provenance {
+		if row.StorageKey.Valid {
+			keys = append(keys, row.StorageKey.String)
+		}
+	}
+
+	for _, key := range keys {
+		if err := s.store.Delete(ctx, key); err != nil {
+			return fmt.Errorf("failed to delete %s of build %d: %w", key, buildID, err)
+		}
+	}
+
+	return nil
+}
+
+// RetentionRun is one run of the data retention cleanup that deleted something
+type RetentionRun struct {
+	BuildsDeleted  int
+	LogsDeleted    int
+	BytesReclaimed int64
+	StartedAt      time.Time
+	FinishedAt     time.Time
+}
+
+// RetentionStats sums up the space the data retention cleanup reclaimed
+type RetentionStats struct {
+	Runs           int64
+	BuildsDeleted  int64
+	LogsDeleted    int64
+	BytesReclaimed int64
+	LastRunAt      *time.Time
+	RecentRuns     []RetentionRun // newest first
+}
+
+// RecordRetentionRun records a run of the data retention cleanup
+func (s *Service) RecordRetentionRun(ctx context.Context, run RetentionRun) error {
+	err := s.queries.CreateRetentionRun(ctx, db.CreateRetentionRunParams{
+		BuildsDeleted:  int32(run.BuildsDeleted),
+		LogsDeleted:    int32(run.LogsDeleted),
+		BytesReclaimed: run.BytesReclaimed,
+		StartedAt:      pgtype.Timestamptz{Time: run.StartedAt, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record retention run: %w", err)
+	}
+	return nil
+}
+
+// GetRetentionStats retrieves the totals of the data retention cleanup and its latest
+// runs
+func (s *Service) GetRetentionStats(ctx context.Context, recent int) (*RetentionStats, error) {
+	totals, err := s.queries.GetRetentionTotals(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get retention totals: %w", err)
+	}
+
+	rows, err := s.queries.GetRecentRetentionRuns(ctx, int32(recent))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get retention runs: %w", err)
+	}
+
+	stats := &RetentionStats{
+		Runs:           totals.Runs,
+		BuildsDeleted:  totals.BuildsDeleted,
+		LogsDeleted:    totals.LogsDeleted,
+		BytesReclaimed: totals.BytesReclaimed,
+		RecentRuns:     make([]RetentionRun, len(rows)),
+	}
+	if totals.LastRunAt.Valid {
+		stats.LastRunAt = &totals.LastRunAt.Time
+	}
+	for i, row := range rows {
+		stats.RecentRuns[i] = RetentionRun{
+			BuildsDeleted:  int(row.BuildsDeleted),
+			LogsDeleted:    int(row.LogsDeleted),
+			BytesReclaimed: row.BytesReclaimed,
+			StartedAt:      row.StartedAt.Time,
+			FinishedAt:     row.FinishedAt.Time,
+		}
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,119 @@
+package builds
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/db"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Image is an image tag pushed by a build that is still in the registry
+type Image struct {
+	ID             int64
+	BuildID        int64
+	Image          string // e.g. ghcr.io/acme/api:abc123
+	Digest         string
+	Ref            string // git ref the build ran for
+	CommitSHA      string // commit the build ran for
+	BranchDeleted  bool
+	BuildStartedAt time.Time
+}
+
+// AddImage records an image pushed by a build
+func (s *Service) AddImage(ctx context.Context, buildID int64, image, digest string) error {
+	_, err := s.queries.CreateBuildImage(ctx, db.CreateBuildImageParams{
+		BuildID: buildID,
+		Image:   image,
+		Digest:  digest,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create build image: %w", err)
+	}
+
+	return nil
+}
+
+// MarkBranchDeleted records that the branch of ref was deleted, so retention rules
+// can remove the images its builds pushed
+func (s *Service) MarkBranchDeleted(ctx context.Context, configID, ref string) error {
+	err := s.queries.SetBuildImagesBranchDeleted(ctx, db.SetBuildImagesBranchDeletedParams{
+		ConfigID: pgtype.Text{String: configID, Valid: true},
+		Ref:      ref,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update build images: %w", err)
+	}
+
+	return nil
+}
+
+// GetLiveImagesByConfigID retrieves the images of a config's builds that are still in
+// the registry, newest build first
+func (s *Service) GetLiveImagesByConfigID(ctx context.Context, configID string) ([]Image, error) {
+	rows, err := s.queries.GetLiveBuildImagesByConfigID(ctx, pgtype.Text{String: configID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get build images: %w", err)
+	}
+
+	result := make([]Image, len(rows))
+	for i, row := range rows {
+		result[i] = Image{
+			ID:             row.ID,
+			BuildID:        row.BuildID,
+			Image:          row.Image,
+			Digest:         row.Digest,
+			Ref:            row.Ref,
+			CommitSHA:      row.CommitSha,
+			BranchDeleted:  row.BranchDeletedAt.Valid,
+			BuildStartedAt: row.BuildStartedAt.Time,
+		}
+	}
+
+	return result, nil
+}
+
+// GetImagesByBuildIDs retrieves the images the given builds pushed that are still in
+// the registry
+func (s *Service) GetImagesByBuildIDs(ctx context.Context, buildIDs []int64) ([]Image, error) {
+	rows, err := s.queries.GetBuildImagesByBuildIDs(ctx, buildIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get build images: %w", err)
+	}
+
+	result := make([]Image, len(rows))
+	for i, row := range rows {
+		result[i] = Image{
+			ID:             row.ID,
+			BuildID:        row.BuildID,
+			Image:          row.Image,
+			Digest:         row.Digest,
+			Ref:            row.Ref,
+			CommitSHA:      row.CommitSha,
+			BranchDeleted:  row.BranchDeletedAt.Valid,
+			BuildStartedAt: row.BuildStartedAt.Time,
+		}
+	}
+
+	return result, nil
+}
+
+// MarkImagesDeleted records that images were removed from the registry
+func (s *Service) MarkImagesDeleted(ctx context.Context, ids []int64) error {
+	if err := s.queries.SetBuildImagesDeleted(ctx, ids); err != nil {
+		return fmt.Errorf("failed to update build images: %w", err)
+	}
+
+	return nil
+}
+
+// IsBuiltImage reports whether a build of any config pushed the manifest digest
+func (s *Service) IsBuiltImage(ctx context.Context, digest string) (bool, error) {
+	count, err := s.queries.CountBuildImagesByDigest(ctx, digest)
+	if err != nil {
+		return false, fmt.Errorf("failed to count build images: %w", err)
+	}
+
+	return count > 0, nil
+}
@@ -0,0 +1,340 @@
+package builds
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Log entry streams. Build output is stdout or stderr of the step that printed it,
+// status entries record steps starting, finishing and failing.
+const (
+	LogStdout = "stdout"
+	LogStderr = "stderr"
+	LogStatus = "status"
+	LogSystem = "system" // written by Nimbul, e.g. the truncation marker
+)
+
+// DefaultLogLimit is how many bytes of log messages a build stores by default
+const DefaultLogLimit = 10 << 20
+
+// logBuffer is how many entries a slow subscriber may fall behind before entries are
+// dropped for it
+const logBuffer = 256
+
+// ansiEscape matches ANSI escape sequences, e.g. colors and cursor movement
+var ansiEscape = regexp.MustCompile(`\x1b\[[0-9;?]*[ -/]*[@-~]|\x1b\][^\x07\x1b]*(\x07|\x1b\\)|\x1b[@-_]`)
+
+var ErrLogNotFound = errors.New("build log not found")
+
+// LogEntry is a line of a build's log
+type LogEntry struct {
+	Time    time.Time `json:"time"`
+	Vertex  string    `json:"vertex,omitempty"` // build step, e.g. "[2/5] RUN go build"
+	Stream  string    `json:"stream"`
+	Message string    `json:"message"`
+}
+
+// Log is the stored log of a build
+type Log struct {
+	Entries   []LogEntry
+	Truncated bool
+}
+
+// LogLimit returns how many bytes of log messages a build stores, set with
+// NIMBUL_BUILD_LOG_LIMIT
+func LogLimit() int {
+	limit := DefaultLogLimit
+	if value := os.Getenv("NIMBUL_BUILD_LOG_LIMIT"); value != "" {
+		if parsed, err := strconv.Atoi(value); err == nil && parsed > 0 {
+			limit = parsed
+		} else {
+			fmt.Printf("Warning: Invalid NIMBUL_BUILD_LOG_LIMIT %q, using %d\n", value, limit)
+		}
+	}
+	return limit
+}
+
+// StripANSI removes ANSI escape sequences and carriage returns from build output
+func StripANSI(s string) string {
+	s = ansiEscape.ReplaceAllString(s, "")
+	// Progress bars redraw their line with \r, keep what was drawn last
+	for i := len(s) - 1; i >= 0; i-- {
+		if s[i] == '\r' {
+			if i == len(s)-1 {
+				s = s[:i]
+				continue
+			}
+			return s[i+1:]
+		}
+	}
+	return s
+}
+
+// LogRecorder collects the log of a running build, streaming each entry to the
+// subscribers of the build. Entries are stored once the build finishes, up to a
+// limit; the rest is dropped after a truncation marker.
+type LogRecorder struct {
+	service *Service
+	buildID int64
+	limit   int
+
+	mu        sync.Mutex
+	entries   []LogEntry
+	size      int
+	truncated bool
+}
+
+// NewLogRecorder starts collecting the log of a build. maxBytes lowers the stored size
+// below LogLimit, 0 keeps it.
+func (s *Service) NewLogRecorder(buildID int64, maxBytes int64) *LogRecorder {
+	limit := LogLimit()
+	if maxBytes > 0 && maxBytes < int64(limit) {
+		limit = int(maxBytes)
+	}
+	r := &LogRecorder{service: s, buildID: buildID, limit: limit}
+	s.logs.start(r)
+	return r
+}
+
+// Add records an entry, without ANSI escapes. Safe for concurrent use.
+func (r *LogRecorder) Add(entry LogEntry) {
+	entry.Message = StripANSI(entry.Message)
+	if entry.Time.IsZero() {
+		entry.Time = time.Now()
+	}
+
+	r.mu.Lock()
+	if r.truncated {
+		r.mu.Unlock()
+		return
+	}
+	if r.size+len(entry.Message) > r.limit {
+		r.truncated = true
+		entry = LogEntry{
+			Time:    entry.Time,
+			Stream:  LogSystem,
+			Message: fmt.Sprintf("[log truncated: the build wrote more than %d bytes]", r.limit),
+		}
+	}
+	r.entries = append(r.entries, entry)
+	r.size += len(entry.Message)
+	// Published while locked so subscribers see each entry once, either in their
+	// backlog or on their channel
+	r.service.logs.publish(r.buildID, entry)
+	if r.service.relay != nil {
+		r.service.relay.RelayLog(r.buildID, entry)
+	}
+	r.mu.Unlock()
+}
+
+// Save stores the log and counts its size towards the owner's storage usage. The
+// stream of the build ends.
+func (r *LogRecorder) Save(ctx context.Context) error {
+	defer r.service.logs.close(r.buildID)
+	if r.service.relay != nil {
+		defer r.service.relay.EndLog(r.buildID)
+	}
+
+	r.mu.Lock()
+	entries, size, truncated := r.entries, r.size, r.truncated
+	r.mu.Unlock()
+
+	if entries == nil {
+		entries = []LogEntry{}
+	}
+	data, err := json.Marshal(entries)
+	if err != nil {
+		return fmt.Errorf("failed to encode build log: %w", err)
+	}
+
+	key := logKey(r.buildID)
+	if err := r.service.store.Put(ctx, key, bytes.NewReader(data), int64(len(data))); err != nil {
+		return fmt.Errorf("failed to store build log: %w", err)
+	}
+
+	err = r.service.queries.CreateBuildLog(ctx, db.CreateBuildLogParams{
+		BuildID:    r.buildID,
+		StorageKey: pgtype.Text{String: key, Valid: true},
+		Truncated:  truncated,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to store build log: %w", err)
+	}
+
+	err = r.service.queries.AddBuildLogBytes(ctx, db.AddBuildLogBytesParams{
+		ID:       r.buildID,
+		LogBytes: int64(size),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record build log size: %w", err)
+	}
+
+	return nil
+}
+
+// GetBuildLog retrieves the stored log of a finished build
+func (s *Service) GetBuildLog(ctx context.Context, buildID int64) (*Log, error) {
+	buildLog, err := s.queries.GetBuildLog(ctx, buildID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrLogNotFound
+		}
+		return nil, fmt.Errorf("failed to get build log: %w", err)
+	}
+
+	// Logs saved before they were kept in storage are stored inline
+	data := buildLog.Entries
+	if buildLog.StorageKey.Valid {
+		data, err = s.readObject(ctx, buildLog.StorageKey.String)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read build log: %w", err)
+		}
+	}
+
+	var entries []LogEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to decode build log: %w", err)
+	}
+
+	return &Log{Entries: entries, Truncated: buildLog.Truncated}, nil
+}
+
+// logKey is the storage key of the log of a build
+func logKey(buildID int64) string {
+	return fmt.Sprintf("builds/%d/log.json", buildID)
+}
+
+// LogSubscription follows the log of a running build
+type LogSubscription struct {
+	Backlog   []LogEntry      // entries logged before subscribing
+	Truncated bool            // whether the backlog ends with the truncation marker
+	Entries   <-chan LogEntry // entries logged since, closed once the log is saved
+	Close     func()          // stops receiving entries
+}
+
+// SubscribeLog follows the log of a build running in this process. It returns false
+// when the build is not running here, e.g. because it finished and its log is stored.
+func (s *Service) SubscribeLog(buildID int64) (*LogSubscription, bool) {
+	return s.logs.subscribe(buildID)
+}
+
+// logStreams fans out the entries of running builds to their subscribers. Builds run
+// in the API server process, or in workers relaying their logs to it, so subscribers
+// of the API server see every entry.
+type logStreams struct {
+	mu          sync.Mutex
+	recorders   map[int64]*LogRecorder
+	subscribers map[int64]map[chan LogEntry]struct{}
+}
+
+func newLogStreams() *logStreams {
+	return &logStreams{
+		recorders:   make(map[int64]*LogRecorder),
+		subscribers: make(map[int64]map[chan LogEntry]struct{}),
+	}
+}
+
+func (l *logStreams) start(r *LogRecorder) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.recorders[r.buildID] = r
+}
+
+// recorderFor returns the recorder of a build, starting the one newRecorder creates
+// when the build has none
+func (l *logStreams) recorderFor(buildID int64, newRecorder func() *LogRecorder) *LogRecorder {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if r, ok := l.recorders[buildID]; ok {
+		return r
+	}
+	r := newRecorder()
+	l.recorders[buildID] = r
+	return r
+}
+
+// publish sends an entry to the subscribers of a build without blocking. Entries are
+// dropped for subscribers more than logBuffer entries behind.
+func (l *logStreams) publish(buildID int64, entry LogEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for ch := range l.subscribers[buildID] {
+		select {
+		case ch <- entry:
+		default:
+		}
+	}
+}
+
+func (l *logStreams) subscribe(buildID int64) (*LogSubscription, bool) {
+	l.mu.Lock()
+	r := l.recorders[buildID]
+	l.mu.Unlock()
+	if r == nil {
+		return nil, false
+	}
+
+	// The recorder is locked first, like when it publishes an entry, so no entry is
+	// logged between copying the backlog and subscribing
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// The log was saved in the meantime
+	if l.recorders[buildID] != r {
+		return nil, false
+	}
+
+	ch := make(chan LogEntry, logBuffer)
+	if l.subscribers[buildID] == nil {
+		l.subscribers[buildID] = make(map[chan LogEntry]struct{})
+	}
+	l.subscribers[buildID][ch] = struct{}{}
+
+	unsubscribe := func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		// Closed channels were removed when the build's stream ended
+		if _, ok := l.subscribers[buildID][ch]; ok {
+			delete(l.subscribers[buildID], ch)
+			close(ch)
+		}
+		if len(l.subscribers[buildID]) == 0 {
+			delete(l.subscribers, buildID)
+		}
+	}
+
+	return &LogSubscription{
+		Backlog:   append([]LogEntry(nil), r.entries...),
+		Truncated: r.truncated,
+		Entries:   ch,
+		Close:     unsubscribe,
+	}, true
+}
+
+// close ends the stream of a build, closing the channels of its subscribers
+func (l *logStreams) close(buildID int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for ch := range l.subscribers[buildID] {
+		close(ch)
+	}
+	delete(l.subscribers, buildID)
+	delete(l.recorders, buildID)
+}
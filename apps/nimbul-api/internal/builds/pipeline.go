@@ -0,0 +1,253 @@
+package builds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Pipeline stage kinds. A push clones the repository, then runs the builds of
+// nimbul.yaml and its deploys. nimbul.yaml has no test stage yet.
+const (
+	StageClone  = "clone"
+	StageBuild  = "build"
+	StageTest   = "test"
+	StageDeploy = "deploy"
+)
+
+// Pipeline and stage statuses, besides the build statuses
+const (
+	StatusPending = "pending"
+	StatusSkipped = "skipped" // not run because an earlier stage failed or was canceled
+	StatusPartial = "partial" // some stages failed, the stages independent of them succeeded
+)
+
+var ErrPipelineNotFound = errors.New("pipeline not found")
+
+// Pipeline is the run of a config's pipeline for a push
+type Pipeline struct {
+	ID             int64
+	ConfigID       string
+	Ref            string
+	CommitSHA      string
+	Status         string
+	RenderedConfig string // nimbul.yaml the run used, templates rendered; '' before it was recorded
+	CreatedAt      time.Time
+	FinishedAt     *time.Time
+	Stages         []Stage
+}
+
+// Stage is a step of a pipeline. Needs names the stages it runs after.
+type Stage struct {
+	ID           int64
+	Name         string
+	Kind         string
+	Needs        []string
+	Status       string
+	Error        string
+	BuildID      *int64
+	DeploymentID *int64
+	StartedAt    *time.Time
+	FinishedAt   *time.Time
+}
+
+// StageSpec describes a stage to add to a pipeline
+type StageSpec struct {
+	Name  string
+	Kind  string
+	Needs []string
+}
+
+// BuildStageName and DeployStageName name the stages of the builds and deploys of nimbul.yaml
+func BuildStageName(build string) string   { return StageBuild + ":" + build }
+func DeployStageName(deploy string) string { return StageDeploy + ":" + deploy }
+
+type CreatePipelineParams struct {
+	ConfigID  string
+	Ref       string
+	CommitSHA string
+}
+
+// CreatePipeline records the start of a pipeline run
+func (s *Service) CreatePipeline(ctx context.Context, params CreatePipelineParams) (*Pipeline, error) {
+	pipeline, err := s.queries.CreatePipeline(ctx, db.CreatePipelineParams{
+		ConfigID:  params.ConfigID,
+		Ref:       params.Ref,
+		CommitSha: params.CommitSHA,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pipeline: %w", err)
+	}
+
+	return &Pipeline{
+		ID:        pipeline.ID,
+		ConfigID:  pipeline.ConfigID,
+		Ref:       pipeline.Ref,
+		CommitSHA: pipeline.CommitSha,
+		Status:    pipeline.Status,
+		CreatedAt: pipeline.CreatedAt.Time,
+	}, nil
+}
+
+// FinishPipeline records the outcome of a pipeline run once none of its stages runs anymore
+func (s *Service) FinishPipeline(ctx context.Context, pipelineID int64, status string) error {
+	err := s.queries.FinishPipeline(ctx, db.FinishPipelineParams{
+		ID:     pipelineID,
+		Status: status,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to finish pipeline: %w", err)
+	}
+	return nil
+}
+
+// AddStage adds a pending stage to a pipeline
+func (s *Service) AddStage(ctx context.Context, pipelineID int64, spec StageSpec) (*Stage, error) {
+	needs := spec.Needs
+	if needs == nil {
+		needs = []string{}
+	}
+
+	stage, err := s.queries.CreatePipelineStage(ctx, db.CreatePipelineStageParams{
+		PipelineID: pipelineID,
+		Name:       spec.Name,
+		Kind:       spec.Kind,
+		Needs:      needs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pipeline stage %s: %w", spec.Name, err)
+	}
+
+	return dbStageToStage(stage), nil
+}
+
+// StartStage marks a stage as running
+func (s *Service) StartStage(ctx context.Context, stageID int64) error {
+	if err := s.queries.StartPipelineStage(ctx, stageID); err != nil {
+		return fmt.Errorf("failed to start pipeline stage: %w", err)
+	}
+	return nil
+}
+
+// FinishStage records the outcome of a stage. A nil stageErr marks the stage as
+// succeeded, ErrCanceled as canceled.
+func (s *Service) FinishStage(ctx context.Context, stageID int64, stageErr error) error {
+	status := StatusSucceeded
+	errText := pgtype.Text{}
+	if stageErr != nil {
+		status = StatusFailed
+		if errors.Is(stageErr, ErrCanceled) {
+			status = StatusCanceled
+		}
+		errText = pgtype.Text{String: stageErr.Error(), Valid: true}
+	}
+
+	err := s.queries.FinishPipelineStage(ctx, db.FinishPipelineStageParams{
+		ID:     stageID,
+		Status: status,
+		Error:  errText,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to finish pipeline stage: %w", err)
+	}
+
+	return nil
+}
+
+// SetStageBuild links a build stage to the build it ran
+func (s *Service) SetStageBuild(ctx context.Context, stageID, buildID int64) error {
+	err := s.queries.SetPipelineStageBuild(ctx, db.SetPipelineStageBuildParams{
+		ID:      stageID,
+		BuildID: pgtype.Int8{Int64: buildID, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to link pipeline stage to build: %w", err)
+	}
+	return nil
+}
+
+// SetStageDeployment links a deploy stage to the deployment it recorded
+func (s *Service) SetStageDeployment(ctx context.Context, stageID, deploymentID int64) error {
+	err := s.queries.SetPipelineStageDeployment(ctx, db.SetPipelineStageDeploymentParams{
+		ID:           stageID,
+		DeploymentID: pgtype.Int8{Int64: deploymentID, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to link pipeline stage to deployment: %w", err)
+	}
+	return nil
+}
+
+// SkipPendingStages marks the stages of a pipeline that did not start as skipped,
+// once the run has ended
+func (s *Service) SkipPendingStages(ctx context.Context, pipelineID int64) error {
+	if err := s.queries.SkipPendingPipelineStages(ctx, pipelineID); err != nil {
+		return fmt.Errorf("failed to skip pipeline stages: %w", err)
+	}
+	return nil
+}
+
+// GetPipelineByID retrieves a pipeline with its stages
+func (s *Service) GetPipelineByID(ctx context.Context, id int64) (*Pipeline, error) {
+	pipeline, err := s.queries.GetPipelineByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrPipelineNotFound
+		}
+		return nil, fmt.Errorf("failed to get pipeline: %w", err)
+	}
+
+	stages, err := s.queries.GetPipelineStagesByPipelineID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pipeline stages: %w", err)
+	}
+
+	result := &Pipeline{
+		ID:             pipeline.ID,
+		ConfigID:       pipeline.ConfigID,
+		Ref:            pipeline.Ref,
+		CommitSHA:      pipeline.CommitSha,
+		Status:         pipeline.Status,
+		RenderedConfig: pipeline.RenderedConfig,
+		CreatedAt:      pipeline.CreatedAt.Time,
+		Stages:         make([]Stage, len(stages)),
+	}
+	if pipeline.FinishedAt.Valid {
+		result.FinishedAt = &pipeline.FinishedAt.Time
+	}
+	for i, stage := range stages {
+		result.Stages[i] = *dbStageToStage(stage)
+	}
+
+	return result, nil
+}
+
+// dbStageToStage converts a db.PipelineStage to a builds.Stage
+func dbStageToStage(dbStage db.PipelineStage) *Stage {
+	stage := &Stage{
+		ID:     dbStage.ID,
+		Name:   dbStage.Name,
+		Kind:   dbStage.Kind,
+		Needs:  dbStage.Needs,
+		Status: dbStage.Status,
+		Error:  dbStage.Error.String,
+	}
+	if dbStage.BuildID.Valid {
+		stage.BuildID = &dbStage.BuildID.Int64
+	}
+	if dbStage.DeploymentID.Valid {
+		stage.DeploymentID = &dbStage.DeploymentID.Int64
+	}
+	if dbStage.StartedAt.Valid {
+		stage.StartedAt = &dbStage.StartedAt.Time
+	}
+	if dbStage.FinishedAt.Valid {
+		stage.FinishedAt = &dbStage.FinishedAt.Time
+	}
+	return stage
+}
@@ -0,0 +1,81 @@
+package builds
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/db"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Provenance is the SLSA provenance attestation of an image pushed by a build
+type Provenance struct {
+	Image         string
+	Digest        string
+	PredicateType string
+	Statement     []byte // in-toto statement as JSON
+	CreatedAt     time.Time
+}
+
+type AddProvenanceParams struct {
+	BuildID       int64
+	Image         string
+	Digest        string
+	PredicateType string
+	Statement     []byte
+}
+
+// AddProvenance stores the provenance attestation of an image pushed by a build
+func (s *Service) AddProvenance(ctx context.Context, params AddProvenanceParams) error {
+	// Images are unique per build; escaped so their slashes do not nest the key
+	key := fmt.Sprintf("builds/%d/provenance/%s.json", params.BuildID, url.PathEscape(params.Image))
+	if err := s.store.Put(ctx, key, bytes.NewReader(params.Statement), int64(len(params.Statement))); err != nil {
+		return fmt.Errorf("failed to store provenance: %w", err)
+	}
+
+	_, err := s.queries.CreateBuildProvenance(ctx, db.CreateBuildProvenanceParams{
+		BuildID:       params.BuildID,
+		Image:         params.Image,
+		Digest:        params.Digest,
+		PredicateType: params.PredicateType,
+		StorageKey:    pgtype.Text{String: key, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create provenance: %w", err)
+	}
+
+	return nil
+}
+
+// GetProvenanceByBuildID retrieves the provenance attestations of the images pushed by a build
+func (s *Service) GetProvenanceByBuildID(ctx context.Context, buildID int64) ([]Provenance, error) {
+	rows, err := s.queries.GetBuildProvenanceByBuildID(ctx, buildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get provenance: %w", err)
+	}
+
+	result := make([]Provenance, len(rows))
+	for i, row := range rows {
+		// Statements recorded before they were kept in storage are stored inline
+		statement := row.Statement
+		if row.StorageKey.Valid {
+			statement, err = s.readObject(ctx, row.StorageKey.String)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read provenance of %s: %w", row.Image, err)
+			}
+		}
+
+		result[i] = Provenance{
+			Image:         row.Image,
+			Digest:        row.Digest,
+			PredicateType: row.PredicateType,
+			Statement:     statement,
+			CreatedAt:     row.CreatedAt.Time,
+		}
+	}
+
+	return result, nil
+}
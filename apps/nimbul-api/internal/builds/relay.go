@@ -0,0 +1,33 @@
+package builds
+
+import "math"
+
+// LogRelay forwards the logs of the builds running in this process to the process
+// serving their subscribers, like a worker relaying them to the API server. Its
+// methods are called while logging and must not block.
+type LogRelay interface {
+	RelayLog(buildID int64, entry LogEntry)
+	// EndLog tells that the log of a build was stored and no more entries follow
+	EndLog(buildID int64)
+}
+
+// SetLogRelay relays the logs of the builds this process runs. Set it before any
+// build starts.
+func (s *Service) SetLogRelay(relay LogRelay) {
+	s.relay = relay
+}
+
+// ReceiveLog streams an entry of a build running in another process, which relayed
+// it, to the log subscribers of this process
+func (s *Service) ReceiveLog(buildID int64, entry LogEntry) {
+	// The process running the build already cut its log off at the limit
+	s.logs.recorderFor(buildID, func() *LogRecorder {
+		return &LogRecorder{service: s, buildID: buildID, limit: math.MaxInt}
+	}).Add(entry)
+}
+
+// EndReceivedLog ends the stream of a build running in another process, once its log
+// was stored or the process stopped relaying it
+func (s *Service) EndReceivedLog(buildID int64) {
+	s.logs.close(buildID)
+}
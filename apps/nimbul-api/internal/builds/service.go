@@ -0,0 +1,222 @@
+package builds
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/db"
+	"github.com/coding-cave-dev/nimbul/internal/storage"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Build statuses
+const (
+	StatusRunning   = "running"
+	StatusSucceeded = "succeeded"
+	StatusFailed    = "failed"
+	StatusCanceled  = "canceled"
+)
+
+var (
+	ErrBuildNotFound = errors.New("build not found")
+	// ErrCanceled marks a build cancelled because a newer push superseded it
+	ErrCanceled = errors.New("build canceled: superseded by a newer push")
+)
+
+type Service struct {
+	queries *db.Queries
+	store   storage.Store
+	logs    *logStreams
+	relay   LogRelay
+}
+
+func NewService(queries *db.Queries, store storage.Store) *Service {
+	return &Service{
+		queries: queries,
+		store:   store,
+		logs:    newLogStreams(),
+	}
+}
+
+// Build is a single run of a build from nimbul.yaml
+type Build struct {
+	ID         int64
+	ConfigID   string
+	OwnerID    string
+	Name       string
+	Ref        string
+	CommitSHA  string
+	Status     string
+	Error      string
+	StartedAt  time.Time
+	FinishedAt *time.Time
+	PipelineID *int64
+	Timings    []Timing // how long each part of the build took
+}
+
+type StartBuildParams struct {
+	ConfigID  string
+	OwnerID   string
+	Name      string
+	Ref       string
+	CommitSHA string
+	// PipelineID is the pipeline run the build is a stage of, 0 for none
+	PipelineID int64
+	// ConfigHash identifies the rendered nimbul.yaml build, so that a later push of
+	// the same commit can reuse the build
+	ConfigHash string
+}
+
+// StartBuild records the start of a build
+func (s *Service) StartBuild(ctx context.Context, params StartBuildParams) (*Build, error) {
+	build, err := s.queries.CreateBuild(ctx, db.CreateBuildParams{
+		ConfigID:   pgtype.Text{String: params.ConfigID, Valid: true},
+		OwnerID:    params.OwnerID,
+		Name:       params.Name,
+		Ref:        params.Ref,
+		CommitSha:  params.CommitSHA,
+		PipelineID: pgtype.Int8{Int64: params.PipelineID, Valid: params.PipelineID != 0},
+		ConfigHash: params.ConfigHash,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create build: %w", err)
+	}
+
+	return dbBuildToBuild(build), nil
+}
+
+// GetReusableBuild finds the latest succeeded build of a commit with the same rendered
+// nimbul.yaml build whose images are all still in the registry. It returns
+// ErrBuildNotFound when the commit has to be built.
+func (s *Service) GetReusableBuild(ctx context.Context, configID, name, commitSHA, configHash string) (*Build, error) {
+	build, err := s.queries.GetReusableBuild(ctx, db.GetReusableBuildParams{
+		ConfigID:   pgtype.Text{String: configID, Valid: true},
+		CommitSha:  commitSHA,
+		Name:       name,
+		ConfigHash: configHash,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrBuildNotFound
+		}
+		return nil, fmt.Errorf("failed to get reusable build: %w", err)
+	}
+
+	return dbBuildToBuild(build), nil
+}
+
+// FinishBuild records the outcome of a build. A nil buildErr marks the build as succeeded,
+// ErrCanceled as canceled.
+func (s *Service) FinishBuild(ctx context.Context, id int64, buildErr error) error {
+	status := StatusSucceeded
+	errText := pgtype.Text{}
+	if buildErr != nil {
+		status = StatusFailed
+		if errors.Is(buildErr, ErrCanceled) {
+			status = StatusCanceled
+		}
+		errText = pgtype.Text{String: buildErr.Error(), Valid: true}
+	}
+
+	_, err := s.queries.FinishBuild(ctx, db.FinishBuildParams{
+		ID:     id,
+		Status: status,
+		Error:  errText,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update build: %w", err)
+	}
+
+	return nil
+}
+
+// GetBuildByID retrieves a build by its ID
+func (s *Service) GetBuildByID(ctx context.Context, id int64) (*Build, error) {
+	build, err := s.queries.GetBuildByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrBuildNotFound
+		}
+		return nil, fmt.Errorf("failed to get build: %w", err)
+	}
+
+	return dbBuildToBuild(build), nil
+}
+
+// GetBuildByIDAndOwner retrieves a build of ownerID; builds of other owners are not
+// found
+func (s *Service) GetBuildByIDAndOwner(ctx context.Context, id int64, ownerID string) (*Build, error) {
+	build, err := s.queries.GetBuildByIDAndOwner(ctx, db.GetBuildByIDAndOwnerParams{
+		ID:      id,
+		OwnerID: ownerID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrBuildNotFound
+		}
+		return nil, fmt.Errorf("failed to get build: %w", err)
+	}
+
+	return dbBuildToBuild(build), nil
+}
+
+// GetLatestBuildByConfigID retrieves the most recently started build of a config
+func (s *Service) GetLatestBuildByConfigID(ctx context.Context, configID string) (*Build, error) {
+	build, err := s.queries.GetLatestBuildByConfigID(ctx, pgtype.Text{String: configID, Valid: true})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrBuildNotFound
+		}
+		return nil, fmt.Errorf("failed to get latest build: %w", err)
+	}
+
+	return dbBuildToBuild(build), nil
+}
+
+// dbBuildToBuild converts a db.Build to a builds.Build
+func dbBuildToBuild(dbBuild db.Build) *Build {
+	var finishedAt *time.Time
+	if dbBuild.FinishedAt.Valid {
+		finishedAt = &dbBuild.FinishedAt.Time
+	}
+
+	var pipelineID *int64
+	if dbBuild.PipelineID.Valid {
+		pipelineID = &dbBuild.PipelineID.Int64
+	}
+
+	var timings []Timing
+	if err := json.Unmarshal(dbBuild.Timings, &timings); err != nil {
+		fmt.Printf("Warning: Failed to decode timings of build %d: %v\n", dbBuild.ID, err)
+	}
+
+	return &Build{
+		ID:         dbBuild.ID,
+		ConfigID:   dbBuild.ConfigID.String,
+		OwnerID:    dbBuild.OwnerID,
+		Name:       dbBuild.Name,
+		Ref:        dbBuild.Ref,
+		CommitSHA:  dbBuild.CommitSha,
+		Status:     dbBuild.Status,
+		Error:      dbBuild.Error.String,
+		StartedAt:  dbBuild.StartedAt.Time,
+		FinishedAt: finishedAt,
+		PipelineID: pipelineID,
+		Timings:    timings,
+	}
+}
+
+// readObject reads an object the service keeps in storage
+func (s *Service) readObject(ctx context.Context, key string) ([]byte, error) {
+	contents, err := s.store.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer contents.Close()
+	return io.ReadAll(contents)
+}
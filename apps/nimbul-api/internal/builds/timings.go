@@ -0,0 +1,50 @@
+package builds
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/db"
+)
+
+// Timing kinds. A build's breakdown starts with the clone of its push, then the parts
+// reported by the builder and ends with the deploys run after it.
+const (
+	TimingClone   = "clone"
+	TimingContext = "context" // uploading or cloning the build context
+	TimingStage   = "stage"   // the steps of a Dockerfile stage
+	TimingPush    = "push"    // exporting and pushing the image
+	TimingDeploy  = "deploy"
+)
+
+// Timing is how long a part of a build took
+type Timing struct {
+	Name     string        `json:"name"`
+	Kind     string        `json:"kind"`
+	Started  time.Time     `json:"started"`
+	Duration time.Duration `json:"duration"`
+}
+
+// AddBuildTimings appends timings to the breakdown of a build
+func (s *Service) AddBuildTimings(ctx context.Context, buildID int64, timings ...Timing) error {
+	if len(timings) == 0 {
+		return nil
+	}
+
+	data, err := json.Marshal(timings)
+	if err != nil {
+		return fmt.Errorf("failed to encode build timings: %w", err)
+	}
+
+	err = s.queries.AddBuildTimings(ctx, db.AddBuildTimingsParams{
+		ID:      buildID,
+		Timings: data,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record build timings: %w", err)
+	}
+
+	return nil
+}
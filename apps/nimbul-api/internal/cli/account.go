@@ -0,0 +1,206 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/coding-cave-dev/nimbul/pkg/nimbul"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+var meUpdateCmd = &cobra.Command{
+	Use:   "update",
+	Short: "Change your email or password",
+	Long: `Change the email or password of your account. Both ask for your current password.
+
+  nimbul me update --email new@example.com
+  nimbul me update --password
+
+A new email takes effect once you confirm it with the token emailed to it, using
+'nimbul me confirm-email --token <token>'. Changing the password logs out your
+other sessions.`,
+	Args: cobra.NoArgs,
+	RunE: meUpdateExec,
+}
+
+var meConfirmEmailCmd = &cobra.Command{
+	Use:   "confirm-email",
+	Short: "Confirm an email change with the token emailed to the new address",
+	Args:  cobra.NoArgs,
+	RunE:  meConfirmEmailExec,
+}
+
+var meDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete your account",
+	Long: `Delete your account along with its configs, agents, stored credentials and hooks.
+Your past builds stay, anonymized, in the instance's usage records.
+
+Apps already deployed to your cluster keep running; Nimbul no longer manages them.
+This cannot be undone.`,
+	Args: cobra.NoArgs,
+	RunE: meDeleteExec,
+}
+
+func init() {
+	meUpdateCmd.Flags().String("email", "", "New email address")
+	meUpdateCmd.Flags().Bool("password", false, "Set a new password")
+	meConfirmEmailCmd.Flags().String("token", "", "Token from the confirmation email")
+	_ = meConfirmEmailCmd.MarkFlagRequired("token")
+
+	meCmd.AddCommand(meUpdateCmd)
+	meCmd.AddCommand(meConfirmEmailCmd)
+	meCmd.AddCommand(meDeleteCmd)
+}
+
+func meUpdateExec(cmd *cobra.Command, args []string) error {
+	email, _ := cmd.Flags().GetString("email")
+	changePassword, _ := cmd.Flags().GetBool("password")
+	if email == "" && !changePassword {
+		return usageErrorf("nothing to change, pass --email or --password")
+	}
+
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	currentPassword, err := readPassword("Current password: ")
+	if err != nil {
+		return err
+	}
+
+	body := nimbul.UpdateMeRequestBody{
+		CurrentPassword: currentPassword,
+	}
+	if email != "" {
+		body.Email = &email
+	}
+	if changePassword {
+		newPassword, err := readPassword("New password: ")
+		if err != nil {
+			return err
+		}
+		confirm, err := readPassword("Confirm password: ")
+		if err != nil {
+			return err
+		}
+		if newPassword != confirm {
+			return usageErrorf("passwords do not match")
+		}
+		body.NewPassword = &newPassword
+	}
+
+	resp, err := client.PatchMeWithResponse(context.Background(), nil, body)
+	if err != nil {
+		return fmt.Errorf("failed to update account: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to update account", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	if resp.JSON200 == nil {
+		return fmt.Errorf("empty response body")
+	}
+
+	if resp.JSON200.PasswordChanged {
+		fmt.Println(successStyle.Render("✓ Password changed, your other sessions were logged out"))
+	}
+	if resp.JSON200.PendingEmail != nil {
+		fmt.Println(successStyle.Render(fmt.Sprintf("✓ Confirmation sent to %s", *resp.JSON200.PendingEmail)))
+		fmt.Println(lipgloss.NewStyle().Foreground(grayColor).Render("Then run: nimbul me confirm-email --token <token>"))
+	}
+	return nil
+}
+
+func meConfirmEmailExec(cmd *cobra.Command, args []string) error {
+	token, _ := cmd.Flags().GetString("token")
+
+	client, err := getSDKClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.PostEmailChangeConfirmWithResponse(context.Background(), nimbul.ConfirmEmailChangeRequestBody{
+		Token: token,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to confirm email change: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to confirm email change", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	if resp.JSON200 == nil {
+		return fmt.Errorf("empty response body")
+	}
+
+	fmt.Println(successStyle.Render(fmt.Sprintf("✓ Your email is now %s", resp.JSON200.User.Email)))
+	return nil
+}
+
+func meDeleteExec(cmd *cobra.Command, args []string) error {
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(errorStyle.Render("This deletes your account, configs, agents, credentials and hooks. It cannot be undone."))
+	fmt.Print("Type 'delete' to continue: ")
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil {
+		return fmt.Errorf("failed to read confirmation: %w", err)
+	}
+	if strings.TrimSpace(answer) != "delete" {
+		return fmt.Errorf("account deletion aborted")
+	}
+
+	password, err := readPassword("Password: ")
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.DeleteMeWithResponse(context.Background(), nil, nimbul.DeleteMeRequestBody{
+		Password: password,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete account: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to delete account", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	// The token stopped working with the account. NIMBUL_TOKEN stays set, but the data
+	// cached for the account goes either way.
+	if err := deleteToken(); err != nil {
+		if !errors.Is(err, errEnvToken) {
+			return err
+		}
+		if err := clearCache(); err != nil {
+			return err
+		}
+	}
+
+	fmt.Println(successStyle.Render("✓ Account deleted"))
+	return nil
+}
+
+// readPassword prompts for a password without echoing it
+func readPassword(prompt string) (string, error) {
+	fmt.Print(prompt)
+	password, err := term.ReadPassword(int(os.Stdin.Fd()))
+	fmt.Println()
+	if err != nil {
+		return "", fmt.Errorf("failed to read password: %w", err)
+	}
+	return string(password), nil
+}
@@ -0,0 +1,745 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/coding-cave-dev/nimbul/pkg/nimbul"
+	"github.com/spf13/cobra"
+)
+
+var adminCmd = &cobra.Command{
+	Use:   "admin",
+	Short: "Operate this Nimbul instance (admins only)",
+	Long: `Manage users, review build activity and set instance-wide limits.
+
+These commands require the admin role. Operators bootstrap the first admin by
+listing their email in NIMBUL_ADMIN_EMAILS on the server and logging in again,
+once the address is verified: by signing in through SSO with a verified email,
+by resetting the password with the token emailed to it, or by changing to it
+with a confirmed email change.`,
+	PersistentPreRunE: requireAdmin,
+}
+
+var adminUsersCmd = &cobra.Command{
+	Use:   "users",
+	Short: "List all users",
+	Args:  cobra.NoArgs,
+	RunE:  adminUsersExec,
+}
+
+var adminDisableCmd = &cobra.Command{
+	Use:   "disable <user>",
+	Short: "Disable an account by ID or email",
+	Long:  "Disabled users cannot log in, their tokens stop working and pushes to their configs are not built.",
+	Args:  cobra.ExactArgs(1),
+	RunE:  adminUpdateUserExec(func(body *nimbul.UpdateAdminUserRequestBody) { body.Disabled = boolPtr(true) }, "disabled"),
+}
+
+var adminEnableCmd = &cobra.Command{
+	Use:   "enable <user>",
+	Short: "Re-enable a disabled account by ID or email",
+	Args:  cobra.ExactArgs(1),
+	RunE:  adminUpdateUserExec(func(body *nimbul.UpdateAdminUserRequestBody) { body.Disabled = boolPtr(false) }, "enabled"),
+}
+
+var adminPromoteCmd = &cobra.Command{
+	Use:   "promote <user>",
+	Short: "Give a user the admin role",
+	Args:  cobra.ExactArgs(1),
+	RunE:  adminUpdateUserExec(func(body *nimbul.UpdateAdminUserRequestBody) { body.Role = rolePtr(nimbul.Admin) }, "promoted to admin"),
+}
+
+var adminDemoteCmd = &cobra.Command{
+	Use:   "demote <user>",
+	Short: "Take the admin role away from a user",
+	Args:  cobra.ExactArgs(1),
+	RunE:  adminUpdateUserExec(func(body *nimbul.UpdateAdminUserRequestBody) { body.Role = rolePtr(nimbul.User) }, "demoted to user"),
+}
+
+var adminActivityCmd = &cobra.Command{
+	Use:   "activity",
+	Short: "Show recent deployments across all users",
+	Args:  cobra.NoArgs,
+	RunE:  adminActivityExec,
+}
+
+var adminLimitsCmd = &cobra.Command{
+	Use:   "limits",
+	Short: "Show or change instance-wide limits",
+	Long: `Show the instance-wide limits and usage quotas, or change them with flags.
+0 removes a limit:
+
+  nimbul admin limits --max-configs 10
+  nimbul admin limits --max-build-minutes 30
+  nimbul admin limits --soft-build-minutes 500 --hard-build-minutes 1000
+  nimbul admin limits --max-configs 0
+  nimbul admin limits --max-log-mb 5 --max-artifact-mb 100
+  nimbul admin limits --config-hard-build-minutes 300
+  nimbul admin limits --build-retention-days 90 --log-retention-days 30
+
+Quotas apply to each user, and those with --config- to each of their configs. Past
+a soft quota users are warned; past a hard quota their new builds are blocked.
+Build minutes and deploys are counted per calendar month.
+
+Finished builds and their logs, artifacts and provenance are deleted once older
+than the build retention; logs alone once older than the log retention.`,
+	Args: cobra.NoArgs,
+	RunE: adminLimitsExec,
+}
+
+var adminRetentionCmd = &cobra.Command{
+	Use:   "retention [user]",
+	Short: "Show the space reclaimed by data retention, or a user's retention",
+	Long: `Without a user, show what the data retention cleanup deleted and the
+storage it reclaimed. With a user ID or email, show or change how long that
+user's builds and logs are kept, overriding the instance retention set with
+nimbul admin limits. 0 removes an override:
+
+  nimbul admin retention
+  nimbul admin retention alice@example.com --build-days 365
+  nimbul admin retention alice@example.com --log-days 7
+  nimbul admin retention alice@example.com --build-days 0`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: adminRetentionExec,
+}
+
+var adminGitHubAppCmd = &cobra.Command{
+	Use:   "github-app",
+	Short: "Show or change the GitHub App Nimbul acts as",
+	Long: `Show the GitHub App the server uses to clone repositories, manage webhooks and
+sign users in with 'nimbul connect', or change it with flags. The private key and
+client secret are stored encrypted and never shown:
+
+  nimbul admin github-app --app-id 123456 --slug my-nimbul --private-key-file app.pem
+  nimbul admin github-app --client-id Iv1.abc --client-secret s3cret
+  nimbul admin github-app --webhook-secret s3cret
+  nimbul admin github-app --clear
+
+Point the app's webhook at <api>/webhooks/github-app with the webhook secret, so
+builds of repositories the app lost access to fail right away.
+
+Without a stored configuration the server uses its GITHUB_APP_ID, GITHUB_APP_SLUG,
+GITHUB_APP_PRIVATE_KEY, GITHUB_CLIENT_ID, GITHUB_CLIENT_SECRET and
+GITHUB_APP_WEBHOOK_SECRET environment variables. --clear removes the stored configuration to use them again.`,
+	Args: cobra.NoArgs,
+	RunE: adminGitHubAppExec,
+}
+
+func init() {
+	adminActivityCmd.Flags().Int("limit", 20, "Number of deployments to show")
+	adminLimitsCmd.Flags().Int("max-configs", 0, "Most configs a user may create")
+	adminLimitsCmd.Flags().Int("max-build-minutes", 0, "Longest a single build may run, in minutes")
+	adminLimitsCmd.Flags().Int("soft-build-minutes", 0, "Build minutes per user per month before warning")
+	adminLimitsCmd.Flags().Int("hard-build-minutes", 0, "Build minutes per user per month before blocking builds")
+	adminLimitsCmd.Flags().Int("soft-deploys", 0, "Deploys per user per month before warning")
+	adminLimitsCmd.Flags().Int("hard-deploys", 0, "Deploys per user per month before blocking builds")
+	adminLimitsCmd.Flags().Int("soft-storage-mb", 0, "Log and artifact storage per user in MB before warning")
+	adminLimitsCmd.Flags().Int("hard-storage-mb", 0, "Log and artifact storage per user in MB before blocking builds")
+	adminLimitsCmd.Flags().Int("max-log-mb", 0, "Log a single build stores in MB, the rest is truncated")
+	adminLimitsCmd.Flags().Int("max-artifact-mb", 0, "Artifacts a single build may store in MB")
+	adminLimitsCmd.Flags().Int("config-soft-build-minutes", 0, "Build minutes per config per month before warning")
+	adminLimitsCmd.Flags().Int("config-hard-build-minutes", 0, "Build minutes per config per month before blocking its builds")
+	adminLimitsCmd.Flags().Int("config-soft-storage-mb", 0, "Log and artifact storage per config in MB before warning")
+	adminLimitsCmd.Flags().Int("config-hard-storage-mb", 0, "Log and artifact storage per config in MB before blocking its builds")
+	adminLimitsCmd.Flags().Int("build-retention-days", 0, "Days finished builds are kept, with their logs and artifacts, and past deployments")
+	adminLimitsCmd.Flags().Int("log-retention-days", 0, "Days build logs are kept")
+	adminRetentionCmd.Flags().Int("build-days", 0, "Days the user's finished builds are kept")
+	adminRetentionCmd.Flags().Int("log-days", 0, "Days the user's build logs are kept")
+	adminRetentionCmd.Flags().Int("limit", 10, "Number of recent cleanup runs to show")
+	adminGitHubAppCmd.Flags().Int64("app-id", 0, "ID of the GitHub App")
+	adminGitHubAppCmd.Flags().String("slug", "", "Slug of the GitHub App, from github.com/apps/<slug>")
+	adminGitHubAppCmd.Flags().String("client-id", "", "Client ID of the GitHub App")
+	adminGitHubAppCmd.Flags().String("client-secret", "", "Client secret of the GitHub App")
+	adminGitHubAppCmd.Flags().String("private-key-file", "", "PEM file with a private key of the GitHub App")
+	adminGitHubAppCmd.Flags().String("webhook-secret", "", "Secret of the GitHub App's webhook, which receives installation events")
+	adminGitHubAppCmd.Flags().Bool("clear", false, "Remove the stored configuration and use the server's environment")
+
+	adminCmd.AddCommand(adminUsersCmd)
+	adminCmd.AddCommand(adminDisableCmd)
+	adminCmd.AddCommand(adminEnableCmd)
+	adminCmd.AddCommand(adminPromoteCmd)
+	adminCmd.AddCommand(adminDemoteCmd)
+	adminCmd.AddCommand(adminActivityCmd)
+	adminCmd.AddCommand(adminLimitsCmd)
+	adminCmd.AddCommand(adminRetentionCmd)
+	adminCmd.AddCommand(adminGitHubAppCmd)
+	rootCmd.AddCommand(adminCmd)
+}
+
+// requireAdmin fails early with a clear message when the logged-in user is not an admin.
+// The API enforces this too; checking here just avoids confusing 403s.
+func requireAdmin(cmd *cobra.Command, args []string) error {
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetMeWithResponse(context.Background(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to get current user: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to get current user", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	if resp.JSON200 == nil || resp.JSON200.Role != string(nimbul.Admin) {
+		return fmt.Errorf("admin commands require the admin role")
+	}
+
+	return nil
+}
+
+func adminUsersExec(cmd *cobra.Command, args []string) error {
+	users, err := fetchAdminUsers()
+	if err != nil {
+		return err
+	}
+
+	grayStyle := lipgloss.NewStyle().Foreground(grayColor)
+
+	fmt.Println(titleStyle.Render("Users"))
+	for _, user := range users {
+		status := successStyle.Render("active")
+		if user.DisabledAt != nil {
+			status = errorStyle.Render("disabled")
+		}
+
+		fmt.Printf("%s  %-32s %-6s %s  %s\n", user.Id, user.Email, user.Role, status, grayStyle.Render("joined "+user.CreatedAt.Local().Format("2006-01-02")))
+	}
+
+	return nil
+}
+
+// adminUpdateUserExec returns a command that applies update to the user named by the first argument
+func adminUpdateUserExec(update func(body *nimbul.UpdateAdminUserRequestBody), done string) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		client, err := authenticatedClient()
+		if err != nil {
+			return err
+		}
+
+		userID, err := resolveAdminUser(args[0])
+		if err != nil {
+			return err
+		}
+
+		body := nimbul.UpdateAdminUserRequestBody{}
+		update(&body)
+
+		resp, err := client.PatchAdminUsersByIdWithResponse(context.Background(), userID, nil, body)
+		if err != nil {
+			return fmt.Errorf("failed to update user: %w", err)
+		}
+
+		if resp.StatusCode() != 200 {
+			return apiError("failed to update user", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+		}
+
+		if resp.JSON200 == nil {
+			return fmt.Errorf("empty response body")
+		}
+
+		fmt.Println(successStyle.Render(fmt.Sprintf("✓ %s %s", resp.JSON200.Email, done)))
+		return nil
+	}
+}
+
+func adminActivityExec(cmd *cobra.Command, args []string) error {
+	limit, _ := cmd.Flags().GetInt("limit")
+	limit64 := int64(limit)
+
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetAdminActivityWithResponse(context.Background(), &nimbul.GetAdminActivityParams{
+		Limit: &limit64,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get activity: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to get activity", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	if resp.JSON200 == nil || resp.JSON200.Activity == nil || len(*resp.JSON200.Activity) == 0 {
+		fmt.Println("No deployments yet")
+		return nil
+	}
+
+	grayStyle := lipgloss.NewStyle().Foreground(grayColor)
+
+	fmt.Println(titleStyle.Render("Recent activity"))
+	for _, a := range *resp.JSON200.Activity {
+		commit := a.CommitSha
+		if len(commit) > 7 {
+			commit = commit[:7]
+		}
+
+		fmt.Printf("%s  %-10s %s %s  %s\n",
+			a.CreatedAt.Local().Format("2006-01-02 15:04:05"),
+			a.Status,
+			a.RepoFullName,
+			commit,
+			grayStyle.Render(a.OwnerEmail),
+		)
+		if a.Error != nil && *a.Error != "" {
+			fmt.Printf("  %s\n", errorStyle.Render(*a.Error))
+		}
+	}
+
+	return nil
+}
+
+func adminLimitsExec(cmd *cobra.Command, args []string) error {
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	getResp, err := client.GetAdminLimitsWithResponse(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get limits: %w", err)
+	}
+
+	if getResp.StatusCode() != 200 {
+		return apiError("failed to get limits", getResp.StatusCode(), getResp.ApplicationproblemJSONDefault)
+	}
+
+	if getResp.JSON200 == nil {
+		return fmt.Errorf("empty response body")
+	}
+
+	instanceLimits := *getResp.JSON200
+
+	// Only flags given on the command line change a limit
+	limitFlags := map[string]**int64{
+		"max-configs":        &instanceLimits.MaxConfigsPerUser,
+		"max-build-minutes":  &instanceLimits.MaxBuildMinutes,
+		"soft-build-minutes": &instanceLimits.BuildMinutesSoftQuota,
+		"hard-build-minutes": &instanceLimits.BuildMinutesHardQuota,
+		"soft-deploys":       &instanceLimits.DeploysSoftQuota,
+		"hard-deploys":       &instanceLimits.DeploysHardQuota,
+		"soft-storage-mb":    &instanceLimits.StorageMbSoftQuota,
+		"hard-storage-mb":    &instanceLimits.StorageMbHardQuota,
+		"max-log-mb":         &instanceLimits.MaxLogMb,
+		"max-artifact-mb":    &instanceLimits.MaxArtifactMb,
+
+		"config-soft-build-minutes": &instanceLimits.ConfigBuildMinutesSoftQuota,
+		"config-hard-build-minutes": &instanceLimits.ConfigBuildMinutesHardQuota,
+		"config-soft-storage-mb":    &instanceLimits.ConfigStorageMbSoftQuota,
+		"config-hard-storage-mb":    &instanceLimits.ConfigStorageMbHardQuota,
+		"build-retention-days":      &instanceLimits.BuildRetentionDays,
+		"log-retention-days":        &instanceLimits.LogRetentionDays,
+	}
+
+	changed := false
+	for name, limit := range limitFlags {
+		if cmd.Flags().Changed(name) {
+			value, _ := cmd.Flags().GetInt(name)
+			*limit = limitPtr(value)
+			changed = true
+		}
+	}
+
+	if changed {
+		instanceLimits.Schema = nil
+		putResp, err := client.PutAdminLimitsWithResponse(ctx, nil, instanceLimits)
+		if err != nil {
+			return fmt.Errorf("failed to update limits: %w", err)
+		}
+
+		if putResp.StatusCode() != 200 {
+			return apiError("failed to update limits", putResp.StatusCode(), putResp.ApplicationproblemJSONDefault)
+		}
+
+		if putResp.JSON200 != nil {
+			instanceLimits = *putResp.JSON200
+		}
+
+		fmt.Println(successStyle.Render("✓ Limits updated"))
+		fmt.Println()
+	}
+
+	fmt.Println(titleStyle.Render("Instance limits"))
+	fmt.Printf("%s %s\n", labelStyle.Render("Configs per user:"), formatLimit(instanceLimits.MaxConfigsPerUser, ""))
+	fmt.Printf("%s %s\n", labelStyle.Render("Build time:      "), formatLimit(instanceLimits.MaxBuildMinutes, " minutes"))
+	fmt.Printf("%s %s\n", labelStyle.Render("Build log:       "), formatLimit(instanceLimits.MaxLogMb, " MB"))
+	fmt.Printf("%s %s\n", labelStyle.Render("Build artifacts: "), formatLimit(instanceLimits.MaxArtifactMb, " MB"))
+	fmt.Println()
+	fmt.Println(titleStyle.Render("Quotas per user"))
+	fmt.Printf("%s soft %s, hard %s\n", labelStyle.Render("Build minutes:   "), formatLimit(instanceLimits.BuildMinutesSoftQuota, "/month"), formatLimit(instanceLimits.BuildMinutesHardQuota, "/month"))
+	fmt.Printf("%s soft %s, hard %s\n", labelStyle.Render("Deploys:         "), formatLimit(instanceLimits.DeploysSoftQuota, "/month"), formatLimit(instanceLimits.DeploysHardQuota, "/month"))
+	fmt.Printf("%s soft %s, hard %s\n", labelStyle.Render("Storage:         "), formatLimit(instanceLimits.StorageMbSoftQuota, " MB"), formatLimit(instanceLimits.StorageMbHardQuota, " MB"))
+	fmt.Println()
+	fmt.Println(titleStyle.Render("Quotas per config"))
+	fmt.Printf("%s soft %s, hard %s\n", labelStyle.Render("Build minutes:   "), formatLimit(instanceLimits.ConfigBuildMinutesSoftQuota, "/month"), formatLimit(instanceLimits.ConfigBuildMinutesHardQuota, "/month"))
+	fmt.Printf("%s soft %s, hard %s\n", labelStyle.Render("Storage:         "), formatLimit(instanceLimits.ConfigStorageMbSoftQuota, " MB"), formatLimit(instanceLimits.ConfigStorageMbHardQuota, " MB"))
+	fmt.Println()
+	fmt.Println(titleStyle.Render("Retention"))
+	fmt.Printf("%s %s\n", labelStyle.Render("Builds:          "), formatRetention(instanceLimits.BuildRetentionDays, "forever"))
+	fmt.Printf("%s %s\n", labelStyle.Render("Logs:            "), formatRetention(instanceLimits.LogRetentionDays, "as long as their build"))
+
+	return nil
+}
+
+func adminRetentionExec(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return adminRetentionStats(cmd)
+	}
+
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	userID, err := resolveAdminUser(args[0])
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	getResp, err := client.GetAdminUsersByIdRetentionWithResponse(ctx, userID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get retention: %w", err)
+	}
+
+	if getResp.StatusCode() != 200 {
+		return apiError("failed to get retention", getResp.StatusCode(), getResp.ApplicationproblemJSONDefault)
+	}
+
+	if getResp.JSON200 == nil {
+		return fmt.Errorf("empty response body")
+	}
+
+	retention := *getResp.JSON200
+
+	// Only flags given on the command line change an override
+	changed := false
+	if cmd.Flags().Changed("build-days") {
+		days, _ := cmd.Flags().GetInt("build-days")
+		retention.BuildRetentionDays = limitPtr(days)
+		changed = true
+	}
+	if cmd.Flags().Changed("log-days") {
+		days, _ := cmd.Flags().GetInt("log-days")
+		retention.LogRetentionDays = limitPtr(days)
+		changed = true
+	}
+
+	if changed {
+		retention.Schema = nil
+		putResp, err := client.PutAdminUsersByIdRetentionWithResponse(ctx, userID, nil, retention)
+		if err != nil {
+			return fmt.Errorf("failed to update retention: %w", err)
+		}
+
+		if putResp.StatusCode() != 200 {
+			return apiError("failed to update retention", putResp.StatusCode(), putResp.ApplicationproblemJSONDefault)
+		}
+
+		if putResp.JSON200 != nil {
+			retention = *putResp.JSON200
+		}
+
+		fmt.Println(successStyle.Render("✓ Retention updated"))
+		fmt.Println()
+	}
+
+	fmt.Println(titleStyle.Render("Retention of " + args[0]))
+	fmt.Printf("%s %s\n", labelStyle.Render("Builds:"), formatRetention(retention.BuildRetentionDays, "instance setting"))
+	fmt.Printf("%s %s\n", labelStyle.Render("Logs:  "), formatRetention(retention.LogRetentionDays, "instance setting"))
+
+	return nil
+}
+
+// adminRetentionStats shows what the data retention cleanup deleted
+func adminRetentionStats(cmd *cobra.Command) error {
+	limit, _ := cmd.Flags().GetInt("limit")
+	limit64 := int64(limit)
+
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetAdminRetentionWithResponse(context.Background(), &nimbul.GetAdminRetentionParams{
+		Limit: &limit64,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get retention stats: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to get retention stats", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	if resp.JSON200 == nil {
+		return fmt.Errorf("empty response body")
+	}
+
+	stats := resp.JSON200
+	if stats.Runs == 0 {
+		fmt.Println("Nothing deleted by data retention yet")
+		return nil
+	}
+
+	grayStyle := lipgloss.NewStyle().Foreground(grayColor)
+
+	fmt.Println(titleStyle.Render("Data retention"))
+	fmt.Printf("%s %d\n", labelStyle.Render("Builds deleted:"), stats.BuildsDeleted)
+	fmt.Printf("%s %d\n", labelStyle.Render("Logs deleted:  "), stats.LogsDeleted)
+	fmt.Printf("%s %.1f MB\n", labelStyle.Render("Reclaimed:     "), float64(stats.BytesReclaimed)/(1024*1024))
+	if stats.LastRunAt != nil {
+		fmt.Printf("%s %s\n", labelStyle.Render("Last deleted:  "), stats.LastRunAt.Local().Format("2006-01-02 15:04:05"))
+	}
+
+	if stats.RecentRuns != nil && len(*stats.RecentRuns) > 0 {
+		fmt.Println()
+		fmt.Println(titleStyle.Render("Recent runs"))
+		for _, run := range *stats.RecentRuns {
+			fmt.Printf("%s  %d builds, %d logs  %s\n",
+				run.FinishedAt.Local().Format("2006-01-02 15:04:05"),
+				run.BuildsDeleted,
+				run.LogsDeleted,
+				grayStyle.Render(fmt.Sprintf("%.1f MB", float64(run.BytesReclaimed)/(1024*1024))),
+			)
+		}
+	}
+
+	return nil
+}
+
+// fetchAdminUsers lists every user of the instance
+func fetchAdminUsers() ([]nimbul.AdminUserResponse, error) {
+	client, err := authenticatedClient()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetAdminUsersWithResponse(context.Background(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return nil, apiError("failed to list users", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	if resp.JSON200 == nil || resp.JSON200.Users == nil {
+		return nil, nil
+	}
+
+	return *resp.JSON200.Users, nil
+}
+
+// resolveAdminUser turns a user ID or email into a user ID
+func resolveAdminUser(user string) (string, error) {
+	if !strings.Contains(user, "@") {
+		return user, nil
+	}
+
+	users, err := fetchAdminUsers()
+	if err != nil {
+		return "", err
+	}
+
+	for _, u := range users {
+		if strings.EqualFold(u.Email, user) {
+			return u.Id, nil
+		}
+	}
+
+	return "", fmt.Errorf("no user with email %s", user)
+}
+
+// limitPtr converts a limit flag to an API limit, where 0 means unlimited
+func limitPtr(limit int) *int64 {
+	if limit <= 0 {
+		return nil
+	}
+	value := int64(limit)
+	return &value
+}
+
+// formatLimit renders an optional limit
+func formatLimit(limit *int64, unit string) string {
+	if limit == nil {
+		return "unlimited"
+	}
+	return fmt.Sprintf("%d%s", *limit, unit)
+}
+
+// formatRetention renders an optional retention period, or unset when it is nil
+func formatRetention(days *int64, unset string) string {
+	if days == nil {
+		return unset
+	}
+	return fmt.Sprintf("%d days", *days)
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+func rolePtr(role nimbul.UpdateAdminUserRequestBodyRole) *nimbul.UpdateAdminUserRequestBodyRole {
+	return &role
+}
+
+func adminGitHubAppExec(cmd *cobra.Command, args []string) error {
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	if clear, _ := cmd.Flags().GetBool("clear"); clear {
+		resp, err := client.DeleteAdminGithubAppWithResponse(ctx, nil)
+		if err != nil {
+			return fmt.Errorf("failed to clear GitHub App: %w", err)
+		}
+
+		if resp.StatusCode() != 200 {
+			return apiError("failed to clear GitHub App", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+		}
+
+		if resp.JSON200 == nil {
+			return fmt.Errorf("empty response body")
+		}
+
+		fmt.Println(successStyle.Render("✓ Stored GitHub App removed"))
+		fmt.Println()
+		printGitHubApp(*resp.JSON200)
+		return nil
+	}
+
+	getResp, err := client.GetAdminGithubAppWithResponse(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get GitHub App: %w", err)
+	}
+
+	if getResp.StatusCode() != 200 {
+		return apiError("failed to get GitHub App", getResp.StatusCode(), getResp.ApplicationproblemJSONDefault)
+	}
+
+	if getResp.JSON200 == nil {
+		return fmt.Errorf("empty response body")
+	}
+
+	app := *getResp.JSON200
+
+	// Only flags given on the command line change a setting; the server keeps the
+	// stored private key and client secret unless new ones are given
+	body := nimbul.UpdateGitHubAppRequestBody{
+		Slug:     app.Slug,
+		ClientId: app.ClientId,
+	}
+	if app.AppId != nil {
+		body.AppId = *app.AppId
+	}
+
+	changed := false
+	if cmd.Flags().Changed("app-id") {
+		body.AppId, _ = cmd.Flags().GetInt64("app-id")
+		changed = true
+	}
+	if cmd.Flags().Changed("slug") {
+		body.Slug, _ = cmd.Flags().GetString("slug")
+		changed = true
+	}
+	if cmd.Flags().Changed("client-id") {
+		clientID, _ := cmd.Flags().GetString("client-id")
+		body.ClientId = &clientID
+		changed = true
+	}
+	if cmd.Flags().Changed("client-secret") {
+		clientSecret, _ := cmd.Flags().GetString("client-secret")
+		body.ClientSecret = &clientSecret
+		changed = true
+	}
+	if cmd.Flags().Changed("webhook-secret") {
+		webhookSecret, _ := cmd.Flags().GetString("webhook-secret")
+		body.WebhookSecret = &webhookSecret
+		changed = true
+	}
+	if cmd.Flags().Changed("private-key-file") {
+		path, _ := cmd.Flags().GetString("private-key-file")
+		privateKey, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read private key: %w", err)
+		}
+		key := string(privateKey)
+		body.PrivateKey = &key
+		changed = true
+	}
+
+	if changed {
+		putResp, err := client.PutAdminGithubAppWithResponse(ctx, nil, body)
+		if err != nil {
+			return fmt.Errorf("failed to update GitHub App: %w", err)
+		}
+
+		if putResp.StatusCode() != 200 {
+			return apiError("failed to update GitHub App", putResp.StatusCode(), putResp.ApplicationproblemJSONDefault)
+		}
+
+		if putResp.JSON200 != nil {
+			app = *putResp.JSON200
+		}
+
+		fmt.Println(successStyle.Render("✓ GitHub App updated"))
+		fmt.Println()
+	}
+
+	printGitHubApp(app)
+	return nil
+}
+
+// printGitHubApp shows the GitHub App configuration without its secrets
+func printGitHubApp(app nimbul.GitHubAppResponse) {
+	grayStyle := lipgloss.NewStyle().Foreground(grayColor)
+	notSet := grayStyle.Render("not set")
+
+	appID := notSet
+	if app.AppId != nil {
+		appID = strconv.FormatInt(*app.AppId, 10)
+	}
+	clientID := notSet
+	if app.ClientId != nil && *app.ClientId != "" {
+		clientID = *app.ClientId
+	}
+	secret := func(set bool) string {
+		if set {
+			return "set"
+		}
+		return notSet
+	}
+
+	fmt.Println(titleStyle.Render("GitHub App"))
+	fmt.Printf("%s %s\n", labelStyle.Render("App ID:        "), appID)
+	fmt.Printf("%s %s\n", labelStyle.Render("Slug:          "), app.Slug)
+	fmt.Printf("%s %s\n", labelStyle.Render("Private key:   "), secret(app.HasPrivateKey))
+	fmt.Printf("%s %s\n", labelStyle.Render("Client ID:     "), clientID)
+	fmt.Printf("%s %s\n", labelStyle.Render("Client secret: "), secret(app.HasClientSecret))
+	fmt.Printf("%s %s\n", labelStyle.Render("Webhook secret:"), secret(app.HasWebhookSecret))
+	source := "environment variables of the server"
+	if app.Source == nimbul.Database {
+		source = "stored by admins"
+		if app.UpdatedAt != nil {
+			source += ", updated " + app.UpdatedAt.Local().Format("2006-01-02 15:04")
+		}
+	}
+	fmt.Printf("%s %s\n", labelStyle.Render("Source:        "), source)
+}
@@ -0,0 +1,155 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/coding-cave-dev/nimbul/pkg/nimbul"
+	"github.com/spf13/cobra"
+)
+
+var agentCmd = &cobra.Command{
+	Use:   "agent",
+	Short: "Manage in-cluster agents for deploying to firewalled clusters",
+	Long: `Agents run inside your cluster and connect out to the Nimbul API to receive manifests,
+so the API never needs network access to the cluster itself.`,
+}
+
+var agentCreateCmd = &cobra.Command{
+	Use:   "create <name>",
+	Short: "Register a new agent and print its token",
+	Args:  cobra.ExactArgs(1),
+	RunE:  agentCreateExec,
+}
+
+var agentListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List your agents",
+	Args:  cobra.NoArgs,
+	RunE:  agentListExec,
+}
+
+var agentUseCmd = &cobra.Command{
+	Use:               "use <config-id> <agent-id>",
+	Short:             "Deploy a config through an agent",
+	Args:              cobra.ExactArgs(2),
+	ValidArgsFunction: completeFirstArg(completeConfigIDs),
+	RunE:              agentUseExec,
+}
+
+func init() {
+	agentCmd.AddCommand(agentCreateCmd)
+	agentCmd.AddCommand(agentListCmd)
+	agentCmd.AddCommand(agentUseCmd)
+	rootCmd.AddCommand(agentCmd)
+}
+
+// authenticatedClient returns an API client for commands that need a login. The client
+// sends the stored token with every request.
+func authenticatedClient() (*nimbul.ClientWithResponses, error) {
+	token, err := loadToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load token: %w", err)
+	}
+
+	if token == "" {
+		return nil, errNotLoggedIn
+	}
+
+	client, err := getSDKClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create API client: %w", err)
+	}
+
+	return client, nil
+}
+
+func agentCreateExec(cmd *cobra.Command, args []string) error {
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.PostAgentsWithResponse(context.Background(), nil, nimbul.CreateAgentRequestBody{
+		Name: args[0],
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create agent: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to create agent", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	if resp.JSON200 == nil {
+		return fmt.Errorf("empty response body")
+	}
+
+	fmt.Println(successStyle.Render(fmt.Sprintf("✓ Agent %s created", resp.JSON200.AgentId)))
+	fmt.Println()
+	fmt.Println("Run nimbul-agent in your cluster with:")
+	fmt.Printf("  NIMBUL_API_URL=%s\n", getAPIBaseURL())
+	fmt.Printf("  NIMBUL_AGENT_TOKEN=%s\n", resp.JSON200.Token)
+	fmt.Println()
+	fmt.Println(labelStyle.Render("This token will not be shown again."))
+
+	return nil
+}
+
+func agentListExec(cmd *cobra.Command, args []string) error {
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetAgentsWithResponse(context.Background(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to list agents: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to list agents", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	if resp.JSON200 == nil || resp.JSON200.Agents == nil || len(*resp.JSON200.Agents) == 0 {
+		fmt.Println("No agents yet. Create one with 'nimbul agent create <name>'")
+		return nil
+	}
+
+	grayStyle := lipgloss.NewStyle().Foreground(grayColor)
+
+	fmt.Println(titleStyle.Render("Agents"))
+	for _, agent := range *resp.JSON200.Agents {
+		lastSeen := "never"
+		if agent.LastSeenAt != nil {
+			lastSeen = agent.LastSeenAt.Local().Format("2006-01-02 15:04:05")
+		}
+		fmt.Printf("%s  %s  %s\n", agent.Id, agent.Name, grayStyle.Render("last seen "+lastSeen))
+	}
+
+	return nil
+}
+
+func agentUseExec(cmd *cobra.Command, args []string) error {
+	configID, agentID := args[0], args[1]
+
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.PatchConfigsByIdAgentWithResponse(context.Background(), configID, nil, nimbul.UpdateConfigAgentRequestBody{
+		AgentId: agentID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update config: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to update config", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	fmt.Println(successStyle.Render(fmt.Sprintf("✓ Config %s now deploys through agent %s", configID, agentID)))
+	return nil
+}
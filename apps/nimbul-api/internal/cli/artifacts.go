@@ -0,0 +1,115 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/coding-cave-dev/nimbul/pkg/nimbul"
+	"github.com/spf13/cobra"
+)
+
+var artifactsCmd = &cobra.Command{
+	Use:   "artifacts <build-id> [name]",
+	Short: "List or download the artifacts of a build",
+	Long: `List the artifacts a build stored, or download one of them by name.
+
+Builds store artifacts when their nimbul.yaml entry declares them:
+
+  artifacts:
+    stage: artifacts   # Dockerfile stage holding the files
+    paths:
+      - bin/api
+      - reports/coverage
+
+Directories are downloaded as .tar.gz archives. Build IDs are included in the
+build events delivered to hooks.`,
+	Args:              cobra.RangeArgs(1, 2),
+	ValidArgsFunction: completeFirstArg(completeBuildIDs),
+	RunE:              artifactsExec,
+}
+
+func init() {
+	artifactsCmd.Flags().StringP("output", "o", "", "File to write the artifact to (default: its name in the current directory)")
+	rootCmd.AddCommand(artifactsCmd)
+}
+
+func artifactsExec(cmd *cobra.Command, args []string) error {
+	buildID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return usageErrorf("invalid build ID %q", args[0])
+	}
+
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	if len(args) == 2 {
+		output, _ := cmd.Flags().GetString("output")
+		return downloadArtifact(client, buildID, args[1], output)
+	}
+
+	resp, err := client.GetBuildsByIdArtifactsWithResponse(context.Background(), buildID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list artifacts: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to list artifacts", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	if resp.JSON200 == nil || resp.JSON200.Artifacts == nil || len(*resp.JSON200.Artifacts) == 0 {
+		fmt.Printf("Build %d has no artifacts\n", buildID)
+		return nil
+	}
+
+	grayStyle := lipgloss.NewStyle().Foreground(grayColor)
+
+	fmt.Println(titleStyle.Render(fmt.Sprintf("Artifacts of build %d", buildID)))
+	for _, artifact := range *resp.JSON200.Artifacts {
+		fmt.Printf("%s  %s\n", artifact.Name, grayStyle.Render(fmt.Sprintf("%.1f MB", float64(artifact.SizeBytes)/(1024*1024))))
+	}
+
+	return nil
+}
+
+// downloadArtifact streams an artifact to output, or to a file named after it
+func downloadArtifact(client *nimbul.ClientWithResponses, buildID int64, name, output string) error {
+	resp, err := client.GetBuildsByIdArtifactsByName(context.Background(), buildID, name, nil)
+	if err != nil {
+		return fmt.Errorf("failed to download artifact: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		parsed, err := nimbul.ParseGetBuildsByIdArtifactsByNameResponse(resp)
+		if err != nil {
+			return fmt.Errorf("failed to download artifact: %s", resp.Status)
+		}
+		return apiError("failed to download artifact", resp.StatusCode, parsed.ApplicationproblemJSONDefault)
+	}
+
+	if output == "" {
+		output = filepath.Base(name)
+	}
+
+	file, err := os.Create(output)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", output, err)
+	}
+	defer file.Close()
+
+	written, err := io.Copy(file, resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to write %s: %w", output, err)
+	}
+
+	fmt.Println(successStyle.Render(fmt.Sprintf("✓ Downloaded %s (%.1f MB)", output, float64(written)/(1024*1024))))
+	return nil
+}
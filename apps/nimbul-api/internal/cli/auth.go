@@ -1,12 +1,16 @@
 package cli
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"os"
 	"path/filepath"
+	"runtime"
+	"strings"
 
-	"github.com/coding-cave-dev/nimbul/internal/sdk"
+	"github.com/coding-cave-dev/nimbul/internal/version"
+	"github.com/coding-cave-dev/nimbul/pkg/nimbul"
 	"gopkg.in/yaml.v3"
 )
 
@@ -16,36 +20,113 @@ type AuthResponse struct {
 		ID    string
 		Email string
 	}
+	// TwoFactorRequired means the login has to be repeated with a two-factor code
+	TwoFactorRequired bool
 }
 
 type Config struct {
 	APIURL string `yaml:"api_url"`
+	// TokenStore is where 'nimbul login' stores the token: file (default) or keyring
+	TokenStore string `yaml:"token_store"`
+	// TokenPath is the file the file store keeps the token in, next to the cached API data
+	TokenPath string `yaml:"token_path"`
+	// CredentialHelper is the Docker credential helper the keyring store uses, e.g.
+	// osxkeychain, wincred, secretservice or pass
+	CredentialHelper string `yaml:"credential_helper"`
+	// GitHubHosts are GitHub Enterprise hostnames whose remotes init recognizes
+	GitHubHosts []string `yaml:"github_hosts"`
+	// Notify is how waits for builds and rollouts end: off (default), bell or desktop
+	Notify string `yaml:"notify"`
 }
 
-func getAPIBaseURL() string {
-	// Check environment variable first
-	if apiURL := os.Getenv("NIMBUL_API_URL"); apiURL != "" {
-		return apiURL
-	}
+// Settings given on the command line, which win over the environment and the config file
+var (
+	configPathFlag string
+	apiURLFlag     string
+)
 
-	// Try to read from config file
+// configHelp documents where the CLI takes its settings from in 'nimbul --help'
+const configHelp = `Settings are taken from flags, then the environment, then the config file:
+  --api-url        NIMBUL_API_URL            api_url            (default http://localhost:8080)
+                   NIMBUL_TOKEN_STORE        token_store        (file or keyring, default file)
+                   NIMBUL_TOKEN_PATH         token_path
+                   NIMBUL_CREDENTIAL_HELPER  credential_helper  (default the system keyring's)
+                   NIMBUL_GITHUB_HOSTS       github_hosts       (comma separated in the environment)
+  --notify         NIMBUL_NOTIFY             notify             (off, bell or desktop, once --wait or --follow is over)
+NIMBUL_TOKEN authenticates without a stored token, e.g. on CI runners.
+The config file is config.yaml in the nimbul directory of the user config directory,
+e.g. ~/.config/nimbul/config.yaml, or the file of --config or NIMBUL_CONFIG.`
+
+// getConfigPath returns the path of the config file and whether it was chosen with
+// --config or NIMBUL_CONFIG rather than being the default one
+func getConfigPath() (string, bool) {
+	if path := cmp.Or(configPathFlag, os.Getenv("NIMBUL_CONFIG")); path != "" {
+		return path, true
+	}
 	configDir, err := os.UserConfigDir()
-	if err == nil {
-		configPath := filepath.Join(configDir, "nimbul", "config.yaml")
-		if data, err := os.ReadFile(configPath); err == nil {
-			var config Config
-			if err := yaml.Unmarshal(data, &config); err == nil && config.APIURL != "" {
-				return config.APIURL
+	if err != nil {
+		return "", false
+	}
+	return filepath.Join(configDir, "nimbul", "config.yaml"), false
+}
+
+// checkConfigFile reports a config file chosen with --config or NIMBUL_CONFIG that
+// cannot be read. The default config file is optional.
+func checkConfigFile() error {
+	path, explicit := getConfigPath()
+	if !explicit {
+		return nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return usageErrorf("failed to read config file: %v", err)
+	}
+	var config Config
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return usageErrorf("invalid config file %s: %v", path, err)
+	}
+	return nil
+}
+
+// loadConfig reads the config file, returning an empty config when there is none
+func loadConfig() Config {
+	var config Config
+	path, _ := getConfigPath()
+	if path == "" {
+		return config
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return config
+	}
+	_ = yaml.Unmarshal(data, &config)
+	return config
+}
+
+func getAPIBaseURL() string {
+	return cmp.Or(apiURLFlag, os.Getenv("NIMBUL_API_URL"), loadConfig().APIURL, "http://localhost:8080")
+}
+
+// getGitHubHosts returns the hostnames of GitHub remotes: github.com and the GitHub
+// Enterprise hosts of NIMBUL_GITHUB_HOSTS (comma separated) or the config file
+func getGitHubHosts() []string {
+	hosts := []string{"github.com"}
+	if env := os.Getenv("NIMBUL_GITHUB_HOSTS"); env != "" {
+		for _, host := range strings.Split(env, ",") {
+			if host = strings.TrimSpace(host); host != "" {
+				hosts = append(hosts, strings.ToLower(host))
 			}
 		}
+		return hosts
 	}
-
-	// Default fallback
-	return "http://localhost:8080"
+	for _, host := range loadConfig().GitHubHosts {
+		hosts = append(hosts, strings.ToLower(host))
+	}
+	return hosts
 }
 
 func getTokenPath() string {
-	if tokenPath := os.Getenv("NIMBUL_TOKEN_PATH"); tokenPath != "" {
+	if tokenPath := cmp.Or(os.Getenv("NIMBUL_TOKEN_PATH"), loadConfig().TokenPath); tokenPath != "" {
 		return tokenPath
 	}
 
@@ -60,57 +141,84 @@ func getTokenPath() string {
 }
 
 func saveToken(token string) error {
-	tokenPath := getTokenPath()
-	dir := filepath.Dir(tokenPath)
-
-	// Create directory if it doesn't exist
-	if err := os.MkdirAll(dir, 0700); err != nil {
-		return fmt.Errorf("failed to create token directory: %w", err)
+	store, err := getTokenStore()
+	if err != nil {
+		return err
 	}
-
-	// Write token with secure permissions
-	if err := os.WriteFile(tokenPath, []byte(token), 0600); err != nil {
-		return fmt.Errorf("failed to write token: %w", err)
+	if err := store.Save(token); err != nil {
+		return err
 	}
 
-	return nil
+	// The cached data may be of another user
+	return clearCache()
 }
 
 func loadToken() (string, error) {
-	tokenPath := getTokenPath()
-	data, err := os.ReadFile(tokenPath)
+	store, err := getTokenStore()
 	if err != nil {
-		if os.IsNotExist(err) {
-			return "", nil
-		}
-		return "", fmt.Errorf("failed to read token: %w", err)
+		return "", err
 	}
-	return string(data), nil
+	return store.Load()
 }
 
-func getSDKClient() (*sdk.ClientWithResponses, error) {
-	baseURL := getAPIBaseURL()
-	client, err := sdk.NewClientWithResponses(baseURL)
+// deleteToken forgets the stored token along with the data cached for its user
+func deleteToken() error {
+	store, err := getTokenStore()
+	if err != nil {
+		return err
+	}
+	if err := store.Delete(); err != nil {
+		return err
+	}
+	return clearCache()
+}
+
+// getSDKClient returns an API client that sends the stored token, if any, with every
+// request and retries requests the API could not handle
+func getSDKClient() (*nimbul.ClientWithResponses, error) {
+	client, err := nimbul.New(nimbul.Options{
+		BaseURL: getAPIBaseURL(),
+		Token:   loadToken,
+		// The user agent tells sessions apart in 'nimbul sessions'
+		UserAgent: fmt.Sprintf("nimbul-cli/%s (%s/%s)", version.Version, runtime.GOOS, runtime.GOARCH),
+		// Errors come back in the language of the user's locale when the API has it
+		AcceptLanguage: localeLanguage(),
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create SDK client: %w", err)
 	}
 	return client, nil
 }
 
-func makeAuthRequest(endpoint, email, password string) (*AuthResponse, error) {
+// localeLanguage returns the language of the user's locale as a language tag, e.g.
+// "de-DE" for LANG=de_DE.UTF-8, or "" for the C and POSIX locales
+func localeLanguage() string {
+	locale := cmp.Or(os.Getenv("LC_ALL"), os.Getenv("LC_MESSAGES"), os.Getenv("LANG"))
+	locale, _, _ = strings.Cut(locale, ".")
+	locale, _, _ = strings.Cut(locale, "@")
+	if locale == "" || locale == "C" || locale == "POSIX" {
+		return ""
+	}
+	return strings.ReplaceAll(locale, "_", "-")
+}
+
+// makeAuthRequest logs in or registers. code is the two-factor code sent with logins, if any.
+func makeAuthRequest(endpoint, email, password, code string) (*AuthResponse, error) {
 	client, err := getSDKClient()
 	if err != nil {
 		return nil, err
 	}
 
 	ctx := context.Background()
-	reqBody := sdk.LoginRequestBody{
+	reqBody := nimbul.LoginRequestBody{
 		Email:    email,
 		Password: password,
 	}
+	if code != "" {
+		reqBody.Code = &code
+	}
 
 	var resp *AuthResponse
-	var errMsg string
 
 	switch endpoint {
 	case "/login":
@@ -120,18 +228,7 @@ func makeAuthRequest(endpoint, email, password string) (*AuthResponse, error) {
 		}
 
 		if loginResp.StatusCode() != 200 {
-			if loginResp.ApplicationproblemJSONDefault != nil {
-				if loginResp.ApplicationproblemJSONDefault.Detail != nil {
-					errMsg = *loginResp.ApplicationproblemJSONDefault.Detail
-				} else if loginResp.ApplicationproblemJSONDefault.Title != nil {
-					errMsg = *loginResp.ApplicationproblemJSONDefault.Title
-				} else {
-					errMsg = fmt.Sprintf("request failed with status %d", loginResp.StatusCode())
-				}
-			} else {
-				errMsg = fmt.Sprintf("request failed with status %d", loginResp.StatusCode())
-			}
-			return nil, fmt.Errorf("%s", errMsg)
+			return nil, apiError("login failed", loginResp.StatusCode(), loginResp.ApplicationproblemJSONDefault)
 		}
 
 		if loginResp.JSON200 == nil {
@@ -147,10 +244,11 @@ func makeAuthRequest(endpoint, email, password string) (*AuthResponse, error) {
 				ID:    loginResp.JSON200.User.Id,
 				Email: loginResp.JSON200.User.Email,
 			},
+			TwoFactorRequired: loginResp.JSON200.TwoFactorRequired != nil && *loginResp.JSON200.TwoFactorRequired,
 		}
 
 	case "/register":
-		registerResp, err := client.PostRegisterWithResponse(ctx, sdk.RegisterRequestBody{
+		registerResp, err := client.PostRegisterWithResponse(ctx, nimbul.RegisterRequestBody{
 			Email:    email,
 			Password: password,
 		})
@@ -159,18 +257,7 @@ func makeAuthRequest(endpoint, email, password string) (*AuthResponse, error) {
 		}
 
 		if registerResp.StatusCode() != 200 {
-			if registerResp.ApplicationproblemJSONDefault != nil {
-				if registerResp.ApplicationproblemJSONDefault.Detail != nil {
-					errMsg = *registerResp.ApplicationproblemJSONDefault.Detail
-				} else if registerResp.ApplicationproblemJSONDefault.Title != nil {
-					errMsg = *registerResp.ApplicationproblemJSONDefault.Title
-				} else {
-					errMsg = fmt.Sprintf("request failed with status %d", registerResp.StatusCode())
-				}
-			} else {
-				errMsg = fmt.Sprintf("request failed with status %d", registerResp.StatusCode())
-			}
-			return nil, fmt.Errorf("%s", errMsg)
+			return nil, apiError("registration failed", registerResp.StatusCode(), registerResp.ApplicationproblemJSONDefault)
 		}
 
 		if registerResp.JSON200 == nil {
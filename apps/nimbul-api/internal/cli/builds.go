@@ -0,0 +1,168 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/coding-cave-dev/nimbul/pkg/nimbul"
+	"github.com/spf13/cobra"
+)
+
+var buildsCmd = &cobra.Command{
+	Use:   "builds",
+	Short: "Inspect builds",
+}
+
+var buildsShowCmd = &cobra.Command{
+	Use:   "show <build-id>",
+	Short: "Show a build and how long each part of it took",
+	Long: `Show a build with a breakdown of where its time went: cloning the repository,
+sending the build context, each Dockerfile stage, pushing the image and the
+deploys run after it. Stages running in parallel overlap, so the parts may add
+up to more than the whole build.`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeFirstArg(completeBuildIDs),
+	RunE:              buildsShowExec,
+}
+
+var buildsConfigCmd = &cobra.Command{
+	Use:               "config <build-id>",
+	Short:             "Print the nimbul.yaml a build ran with, its templates rendered",
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeFirstArg(completeBuildIDs),
+	RunE:              buildsConfigExec,
+}
+
+func init() {
+	buildsCmd.AddCommand(buildsShowCmd)
+	buildsCmd.AddCommand(buildsConfigCmd)
+	rootCmd.AddCommand(buildsCmd)
+}
+
+func buildsShowExec(cmd *cobra.Command, args []string) error {
+	buildID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return usageErrorf("invalid build ID %q", args[0])
+	}
+
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetBuildsByIdWithResponse(context.Background(), buildID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get build: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to get build", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	if resp.JSON200 == nil {
+		return fmt.Errorf("empty response body")
+	}
+
+	grayStyle := lipgloss.NewStyle().Foreground(grayColor)
+
+	build := resp.JSON200
+	commit := build.CommitSha
+	if len(commit) > 12 {
+		commit = commit[:12]
+	}
+	fmt.Println(titleStyle.Render(fmt.Sprintf("Build %d: %s", build.Id, build.Name)))
+	fmt.Printf("%s %s\n", stageIcon(build.Status), build.Status)
+	fmt.Println(grayStyle.Render(fmt.Sprintf("%s on %s, config %s", commit, build.Ref, build.ConfigId)))
+
+	end := time.Now()
+	if build.FinishedAt != nil {
+		end = *build.FinishedAt
+	}
+	fmt.Println(grayStyle.Render(fmt.Sprintf("Started %s, took %s", build.StartedAt.Local().Format(time.DateTime), end.Sub(build.StartedAt).Round(time.Second))))
+	if build.Error != nil {
+		fmt.Println(errorStyle.Render(*build.Error))
+	}
+
+	if build.Timings == nil || len(*build.Timings) == 0 {
+		fmt.Println()
+		fmt.Println(grayStyle.Render("No timings recorded"))
+		return nil
+	}
+
+	printBuildTimings(*build.Timings)
+	return nil
+}
+
+// timingBarWidth is the width of the bar of the longest part of a build
+const timingBarWidth = 30
+
+// printBuildTimings prints how long each part of a build took, with a bar relative to
+// the longest one
+func printBuildTimings(timings []nimbul.BuildTimingResponse) {
+	grayStyle := lipgloss.NewStyle().Foreground(grayColor)
+	barStyle := lipgloss.NewStyle().Foreground(orangeColor)
+
+	width := 0
+	var longest int64
+	for _, timing := range timings {
+		width = max(width, len(timing.Name))
+		longest = max(longest, timing.DurationMs)
+	}
+
+	fmt.Println()
+	fmt.Println(titleStyle.Render("Timings"))
+	for _, timing := range timings {
+		duration := time.Duration(timing.DurationMs) * time.Millisecond
+		bar := 0
+		if longest > 0 {
+			bar = int(timing.DurationMs * timingBarWidth / longest)
+		}
+		fmt.Printf("%-*s  %8s  %s %s\n",
+			width, timing.Name,
+			formatTimingDuration(duration),
+			barStyle.Render(strings.Repeat("█", max(bar, 1))),
+			grayStyle.Render(timing.Kind),
+		)
+	}
+}
+
+// formatTimingDuration rounds a duration to tenths of seconds, or seconds past a minute
+func formatTimingDuration(d time.Duration) string {
+	if d >= time.Minute {
+		return d.Round(time.Second).String()
+	}
+	return d.Round(100 * time.Millisecond).String()
+}
+
+func buildsConfigExec(cmd *cobra.Command, args []string) error {
+	buildID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return usageErrorf("invalid build ID %q", args[0])
+	}
+
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetBuildsByIdConfigWithResponse(context.Background(), buildID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get build config: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to get build config", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	if resp.JSON200 == nil {
+		return fmt.Errorf("empty response body")
+	}
+
+	// Printed as is, so it can be piped or saved
+	fmt.Print(resp.JSON200.Config)
+	return nil
+}
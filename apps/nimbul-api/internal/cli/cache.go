@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// cachedData is what a command last fetched from the API, shown when it cannot be reached
+type cachedData[T any] struct {
+	FetchedAt time.Time `json:"fetched_at"`
+	Data      T         `json:"data"`
+}
+
+// getCacheDir returns where the data fetched from the API at the current API URL is
+// cached, next to the stored token
+func getCacheDir() string {
+	sum := sha256.Sum256([]byte(getAPIBaseURL()))
+	return filepath.Join(filepath.Dir(getTokenPath()), "cache", hex.EncodeToString(sum[:8]))
+}
+
+// fetchCached calls fetch and caches its result under name. With offline, or when the
+// API cannot be reached, the cached result is returned instead, with the time it was
+// fetched; that time is zero for a fresh result.
+func fetchCached[T any](name string, offline bool, fetch func() (T, error)) (T, time.Time, error) {
+	if !offline {
+		data, err := fetch()
+		if err == nil {
+			writeCache(name, data)
+			return data, time.Time{}, nil
+		}
+		if exitCode(err) != exitNetwork {
+			return data, time.Time{}, err
+		}
+
+		cached, cacheErr := readCache[T](name)
+		if cacheErr != nil {
+			// Nothing cached to fall back to, so the API's error is what matters
+			return data, time.Time{}, err
+		}
+		return cached.Data, cached.FetchedAt, nil
+	}
+
+	cached, err := readCache[T](name)
+	if err != nil {
+		var zero T
+		if errors.Is(err, os.ErrNotExist) {
+			return zero, time.Time{}, fmt.Errorf("nothing cached yet, run the command once without --cached while the API is reachable")
+		}
+		return zero, time.Time{}, err
+	}
+	return cached.Data, cached.FetchedAt, nil
+}
+
+// printStale marks the output that follows as cached data fetched at fetchedAt
+func printStale(fetchedAt time.Time) {
+	style := lipgloss.NewStyle().Foreground(orangeColor)
+	fmt.Println(style.Render(fmt.Sprintf("⚠ Cached data from %s (%s ago), it may be out of date",
+		fetchedAt.Local().Format("2006-01-02 15:04:05"), formatAge(time.Since(fetchedAt)))))
+	fmt.Println()
+}
+
+// formatAge rounds an age to its largest unit, e.g. "3 hours"
+func formatAge(age time.Duration) string {
+	unit := func(n int, name string) string {
+		if n == 1 {
+			return "1 " + name
+		}
+		return fmt.Sprintf("%d %ss", n, name)
+	}
+	switch {
+	case age < time.Minute:
+		return "less than a minute"
+	case age < time.Hour:
+		return unit(int(age/time.Minute), "minute")
+	case age < 24*time.Hour:
+		return unit(int(age/time.Hour), "hour")
+	default:
+		return unit(int(age/(24*time.Hour)), "day")
+	}
+}
+
+// writeCache stores data under name. The cache is best effort, so failures are ignored.
+func writeCache(name string, data any) {
+	contents, err := json.Marshal(cachedData[any]{FetchedAt: time.Now(), Data: data})
+	if err != nil {
+		return
+	}
+
+	dir := getCacheDir()
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return
+	}
+	// Written to a temporary file first, so a reader never sees half of it
+	path := filepath.Join(dir, name+".json")
+	if err := os.WriteFile(path+".tmp", contents, 0600); err != nil {
+		return
+	}
+	_ = os.Rename(path+".tmp", path)
+}
+
+func readCache[T any](name string) (*cachedData[T], error) {
+	contents, err := os.ReadFile(filepath.Join(getCacheDir(), name+".json"))
+	if err != nil {
+		return nil, err
+	}
+
+	var cached cachedData[T]
+	if err := json.Unmarshal(contents, &cached); err != nil {
+		return nil, fmt.Errorf("failed to read cache: %w", err)
+	}
+	return &cached, nil
+}
+
+// clearCache removes the data cached for every API URL, e.g. when another user logs in
+func clearCache() error {
+	if err := os.RemoveAll(filepath.Join(filepath.Dir(getTokenPath()), "cache")); err != nil {
+		return fmt.Errorf("failed to clear cache: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package cli
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/coding-cave-dev/nimbul/pkg/nimbul"
+	"github.com/spf13/cobra"
+)
+
+var clusterCmd = &cobra.Command{
+	Use:   "cluster",
+	Short: "Manage the Kubernetes cluster your configs deploy to",
+}
+
+var clusterConnectCmd = &cobra.Command{
+	Use:   "connect [config-id]",
+	Short: "Deploy a config using your own cluster credentials",
+	Long: `Store a kubeconfig or service account token and use it for every deploy of the given config.
+Configs deploy only with their own cluster access, never with the Nimbul server's.
+
+Kubeconfigs must embed their credentials: exec and auth-provider plugins and
+references to files (tokenFile, client-certificate, client-key,
+certificate-authority) are rejected.`,
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeFirstArg(completeConfigIDs),
+	RunE:              clusterConnectExec,
+}
+
+var (
+	clusterKubeconfigPath string
+	clusterServer         string
+	clusterToken          string
+	clusterCAFile         string
+)
+
+func init() {
+	clusterConnectCmd.Flags().StringVar(&clusterKubeconfigPath, "kubeconfig", "", "Path to a kubeconfig file (defaults to $KUBECONFIG or ~/.kube/config)")
+	clusterConnectCmd.Flags().StringVar(&clusterServer, "server", "", "API server URL (service account token mode)")
+	clusterConnectCmd.Flags().StringVar(&clusterToken, "token", "", "Service account bearer token (service account token mode)")
+	clusterConnectCmd.Flags().StringVar(&clusterCAFile, "certificate-authority", "", "Path to the cluster CA certificate (service account token mode)")
+
+	clusterCmd.AddCommand(clusterConnectCmd)
+	rootCmd.AddCommand(clusterCmd)
+}
+
+// buildClusterCredential returns the token type and payload to store for the given flags
+func buildClusterCredential() (string, string, error) {
+	if clusterServer != "" || clusterToken != "" {
+		if clusterServer == "" || clusterToken == "" {
+			return "", "", usageErrorf("--server and --token must be used together")
+		}
+
+		credential := nimbul.ServiceAccountCredential{
+			Server: clusterServer,
+			Token:  clusterToken,
+		}
+		if clusterCAFile != "" {
+			caData, err := os.ReadFile(clusterCAFile)
+			if err != nil {
+				return "", "", fmt.Errorf("failed to read certificate authority: %w", err)
+			}
+			credential.CertificateAuthorityData = base64.StdEncoding.EncodeToString(caData)
+		}
+
+		data, err := json.Marshal(credential)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to encode credential: %w", err)
+		}
+		return nimbul.CredentialTypeServiceAccount, string(data), nil
+	}
+
+	path := clusterKubeconfigPath
+	if path == "" {
+		path = os.Getenv("KUBECONFIG")
+	}
+	if path == "" {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return "", "", fmt.Errorf("failed to locate kubeconfig: %w", err)
+		}
+		path = filepath.Join(homeDir, ".kube", "config")
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to read kubeconfig: %w", err)
+	}
+
+	return nimbul.CredentialTypeKubeconfig, string(data), nil
+}
+
+func clusterConnectExec(cmd *cobra.Command, args []string) error {
+	token, err := loadToken()
+	if err != nil {
+		return fmt.Errorf("failed to load token: %w", err)
+	}
+
+	if token == "" {
+		return errNotLoggedIn
+	}
+
+	tokenType, credential, err := buildClusterCredential()
+	if err != nil {
+		return err
+	}
+
+	client, err := getSDKClient()
+	if err != nil {
+		return fmt.Errorf("failed to create API client: %w", err)
+	}
+
+	configID, err := resolveConfigID(client, args)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	storeResp, err := client.PostCredentialsWithResponse(ctx, nil, nimbul.StoreCredentialRequestBody{
+		Provider:  "kubernetes",
+		TokenType: tokenType,
+		Token:     credential,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to store cluster credential: %w", err)
+	}
+
+	if storeResp.StatusCode() != 200 {
+		return apiError("failed to store cluster credential", storeResp.StatusCode(), storeResp.ApplicationproblemJSONDefault)
+	}
+
+	if storeResp.JSON200 == nil {
+		return fmt.Errorf("empty response body")
+	}
+
+	updateResp, err := client.PatchConfigsByIdClusterWithResponse(ctx, configID, nil, nimbul.UpdateConfigClusterRequestBody{
+		CredentialId: storeResp.JSON200.CredentialId,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update config: %w", err)
+	}
+
+	if updateResp.StatusCode() != 200 {
+		return apiError("failed to update config", updateResp.StatusCode(), updateResp.ApplicationproblemJSONDefault)
+	}
+
+	fmt.Println(successStyle.Render(fmt.Sprintf("✓ Config %s now deploys with your %s credential", configID, tokenType)))
+	return nil
+}
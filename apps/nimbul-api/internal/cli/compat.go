@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/coding-cave-dev/nimbul/internal/version"
+	"github.com/spf13/cobra"
+)
+
+// compatibilityTTL is how long the server's version is trusted before it is asked again
+const compatibilityTTL = time.Hour
+
+// serverVersion is what the server reports about the releases it works with
+type serverVersion struct {
+	Version       string `json:"version"` // empty for servers from before they reported it
+	MinCLIVersion string `json:"min_cli_version"`
+}
+
+// Commands that run without the API, or that must work against any server
+var skipCompatibilityCheck = map[string]bool{
+	"version":     true,
+	"self-update": true,
+	"help":        true,
+	"completion":  true,
+	// Shell completion runs through these hidden commands and must answer quickly
+	cobra.ShellCompRequestCmd:       true,
+	cobra.ShellCompNoDescRequestCmd: true,
+}
+
+// checkCompatibility refuses to run against a server that no longer supports this
+// CLI, rather than failing later on responses the CLI cannot read, and warns about
+// servers older than the CLI expects
+func checkCompatibility(cmd *cobra.Command, args []string) error {
+	for c := cmd; c != nil; c = c.Parent() {
+		if skipCompatibilityCheck[c.Name()] {
+			return nil
+		}
+	}
+
+	server := fetchServerVersion()
+	if server == nil {
+		return nil
+	}
+
+	if version.Older(version.Version, server.MinCLIVersion) {
+		return fmt.Errorf("this CLI (%s) is older than the oldest release the server at %s supports (%s). Run 'nimbul self-update' to update it",
+			version.Version, getAPIBaseURL(), server.MinCLIVersion)
+	}
+
+	warn := lipgloss.NewStyle().Foreground(orangeColor)
+	switch {
+	case server.Version == "":
+		fmt.Fprintln(os.Stderr, warn.Render("⚠ The server does not report its version, it may be older than this CLI supports"))
+	case version.Older(server.Version, version.MinServerVersion):
+		fmt.Fprintln(os.Stderr, warn.Render(fmt.Sprintf("⚠ The server runs %s, older than this CLI supports (%s); some commands may fail",
+			server.Version, version.MinServerVersion)))
+	}
+	return nil
+}
+
+// fetchServerVersion returns the version the server reported within compatibilityTTL,
+// or asks it again. It returns nil when the server cannot tell, leaving it to the
+// command to report an unreachable API.
+func fetchServerVersion() *serverVersion {
+	if cached, err := readCache[serverVersion]("version"); err == nil && time.Since(cached.FetchedAt) < compatibilityTTL {
+		return &cached.Data
+	}
+
+	client, err := getSDKClient()
+	if err != nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := client.GetVersionWithResponse(ctx)
+	if err != nil {
+		return nil
+	}
+
+	server := &serverVersion{}
+	switch {
+	case resp.StatusCode() == http.StatusNotFound:
+		// Servers from before /version existed
+	case resp.StatusCode() == http.StatusOK && resp.JSON200 != nil:
+		server.Version = resp.JSON200.Version
+		server.MinCLIVersion = resp.JSON200.MinCliVersion
+	default:
+		return nil
+	}
+
+	writeCache("version", server)
+	return server
+}
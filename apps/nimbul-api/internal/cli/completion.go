@@ -0,0 +1,190 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/pkg/nimbul"
+	"github.com/spf13/cobra"
+)
+
+// completionTTL is how long what shell completion fetched from the API is reused, so
+// pressing tab again does not wait for the API each time
+const completionTTL = 30 * time.Second
+
+// completionTimeout bounds how long shell completion waits for the API, since the
+// shell blocks until it answers
+const completionTimeout = 3 * time.Second
+
+// completionActivityLimit is how many recent builds and deployments are offered
+const completionActivityLimit = 50
+
+// fetchCompletions returns what was cached under name less than completionTTL ago, or
+// fetches and caches it. Completion has nowhere to report errors, so on failure
+// nothing is offered.
+func fetchCompletions[T any](name string, fetch func(ctx context.Context, client *nimbul.ClientWithResponses) (T, error)) (T, bool) {
+	name = "complete-" + name
+	if cached, err := readCache[T](name); err == nil && time.Since(cached.FetchedAt) < completionTTL {
+		return cached.Data, true
+	}
+
+	var zero T
+	client, err := getSDKClient()
+	if err != nil {
+		return zero, false
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), completionTimeout)
+	defer cancel()
+
+	data, err := fetch(ctx, client)
+	if err != nil {
+		return zero, false
+	}
+	writeCache(name, data)
+	return data, true
+}
+
+// completeFirstArg completes only the first positional argument with complete
+func completeFirstArg(complete cobra.CompletionFunc) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return complete(cmd, args, toComplete)
+	}
+}
+
+// completeConfigIDs offers the IDs of the user's configs, described by their repository
+func completeConfigIDs(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	configList, ok := fetchCompletions("configs", func(ctx context.Context, client *nimbul.ClientWithResponses) ([]nimbul.ConfigResponse, error) {
+		resp, err := client.GetConfigsWithResponse(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode() != 200 || resp.JSON200 == nil || resp.JSON200.Configs == nil {
+			return nil, fmt.Errorf("failed to list configs: %d", resp.StatusCode())
+		}
+		return *resp.JSON200.Configs, nil
+	})
+	if !ok {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	var completions []cobra.Completion
+	for _, config := range configList {
+		if strings.HasPrefix(config.Id, toComplete) {
+			completions = append(completions, cobra.CompletionWithDesc(config.Id, config.RepoFullName))
+		}
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// recentActivity returns the latest builds, deployments and rollbacks of the user's
+// configs, or of one
+func recentActivity(configID string) ([]nimbul.ActivityEntryResponse, bool) {
+	return fetchCompletions("activity-"+configID, func(ctx context.Context, client *nimbul.ClientWithResponses) ([]nimbul.ActivityEntryResponse, error) {
+		limit := int64(completionActivityLimit)
+		params := &nimbul.GetActivityParams{Limit: &limit}
+		if configID != "" {
+			params.ConfigId = &configID
+		}
+
+		resp, err := client.GetActivityWithResponse(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode() != 200 || resp.JSON200 == nil || resp.JSON200.Activity == nil {
+			return nil, fmt.Errorf("failed to get history: %d", resp.StatusCode())
+		}
+		return *resp.JSON200.Activity, nil
+	})
+}
+
+// completeActivityIDs offers the IDs of the recent entries of the given kinds, described
+// by what and where they ran
+func completeActivityIDs(configID, toComplete string, kinds ...nimbul.ActivityEntryResponseKind) ([]cobra.Completion, cobra.ShellCompDirective) {
+	entries, ok := recentActivity(configID)
+	if !ok {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	var completions []cobra.Completion
+	for _, entry := range entries {
+		id := strconv.FormatInt(entry.Id, 10)
+		if !strings.HasPrefix(id, toComplete) {
+			continue
+		}
+		for _, kind := range kinds {
+			if entry.Kind != kind {
+				continue
+			}
+			what := string(entry.Kind)
+			if entry.Name != nil && *entry.Name != "" {
+				what += " " + *entry.Name
+			}
+			description := fmt.Sprintf("%s of %s at %s, %s", what, entry.RepoFullName, shortCommit(entry.CommitSha), entry.Status)
+			completions = append(completions, cobra.CompletionWithDesc(id, description))
+		}
+	}
+	// Newest first, as the API returns them
+	return completions, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveKeepOrder
+}
+
+// completeBuildIDs offers the IDs of recent builds
+func completeBuildIDs(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	return completeActivityIDs("", toComplete, "build")
+}
+
+// completeDeploymentIDs offers the IDs of recent deployments and rollbacks
+func completeDeploymentIDs(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	return completeActivityIDs("", toComplete, "deployment", "rollback")
+}
+
+// completeConfigThenDeployment completes a config ID, then the ID of one of its
+// deployments
+func completeConfigThenDeployment(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	switch len(args) {
+	case 0:
+		return completeConfigIDs(cmd, args, toComplete)
+	case 1:
+		return completeActivityIDs(args[0], toComplete, "deployment", "rollback")
+	}
+	return nil, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeEnvironments offers the preview namespaces of the config given as the first
+// argument, described by the branch deployed there
+func completeEnvironments(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	if len(args) == 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	configID := args[0]
+
+	environments, ok := fetchCompletions("environments-"+configID, func(ctx context.Context, client *nimbul.ClientWithResponses) ([]nimbul.EnvironmentResponse, error) {
+		resp, err := client.GetConfigsByIdEnvironmentsWithResponse(ctx, configID, nil)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode() != 200 || resp.JSON200 == nil || resp.JSON200.Environments == nil {
+			return nil, fmt.Errorf("failed to get environments: %d", resp.StatusCode())
+		}
+		return *resp.JSON200.Environments, nil
+	})
+	if !ok {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	var completions []cobra.Completion
+	for _, environment := range environments {
+		// The namespaces the manifests name are not known here
+		if environment.Namespace == nil || !strings.HasPrefix(*environment.Namespace, toComplete) {
+			continue
+		}
+		completions = append(completions, cobra.CompletionWithDesc(*environment.Namespace, environment.Branch))
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
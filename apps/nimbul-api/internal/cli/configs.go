@@ -0,0 +1,438 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/coding-cave-dev/nimbul/pkg/nimbul"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+var configCmd = &cobra.Command{
+	Use:     "config",
+	Aliases: []string{"configs"},
+	Short:   "Inspect, back up and restore configs",
+}
+
+var configListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List your configs and their health",
+	Long: `List your configs with the result of the last check against GitHub. Configs are
+checked periodically; run 'nimbul config sync' to check them now.
+
+When the API cannot be reached, the configs last listed are shown, marked as cached.
+--cached shows them without contacting the API.`,
+	Args: cobra.NoArgs,
+	RunE: configListExec,
+}
+
+var configSyncCmd = &cobra.Command{
+	Use:   "sync",
+	Short: "Check your configs against GitHub now",
+	Long: `Check every config against GitHub: whether its repository still exists, the
+GitHub App is still installed and the webhook is still in place. Configs of renamed
+repositories move to the new name. Problems are shown with how to fix them.`,
+	Args: cobra.NoArgs,
+	RunE: configSyncExec,
+}
+
+var configShowCmd = &cobra.Command{
+	Use:   "show [config-id]",
+	Short: "Show a config with its webhook status, last build and last deployment",
+	Long: `Show a config with its webhook status, last build and last deployment. When the
+API cannot be reached, the config as last shown is shown, marked as cached. --cached
+shows it without contacting the API.`,
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeFirstArg(completeConfigIDs),
+	RunE:              configShowExec,
+}
+
+var configExportCmd = &cobra.Command{
+	Use:   "export [config-id]",
+	Short: "Export a config as a portable bundle",
+	Long: `Export a config as a YAML or JSON bundle that 'nimbul config import' can recreate
+on this or another Nimbul instance. Bundles never contain secrets: the webhook secret
+and cluster credentials are set up again on import.`,
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeFirstArg(completeConfigIDs),
+	RunE:              configExportExec,
+}
+
+var configImportCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Create a config from an exported bundle",
+	Long: `Create a config from a bundle written by 'nimbul config export', then create the
+GitHub webhook that triggers its builds. The bundle may be YAML or JSON.`,
+	Args: cobra.ExactArgs(1),
+	RunE: configImportExec,
+}
+
+func init() {
+	configListCmd.Flags().Bool("cached", false, "Show the configs last listed without contacting the API")
+	configShowCmd.Flags().Bool("cached", false, "Show the config as last shown without contacting the API")
+	configExportCmd.Flags().StringP("output", "o", "", "File to write the bundle to (default stdout)")
+	configExportCmd.Flags().String("format", "yaml", "Bundle format: yaml or json")
+	configCmd.AddCommand(configListCmd)
+	configCmd.AddCommand(configSyncCmd)
+	configCmd.AddCommand(configShowCmd)
+	configCmd.AddCommand(configExportCmd)
+	configCmd.AddCommand(configImportCmd)
+	rootCmd.AddCommand(configCmd)
+}
+
+func configShowExec(cmd *cobra.Command, args []string) error {
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	configID, err := resolveConfigID(client, args)
+	if err != nil {
+		return err
+	}
+
+	cached, _ := cmd.Flags().GetBool("cached")
+	details, fetchedAt, err := fetchCached("config-"+configID, cached, func() (*nimbul.GetConfigResponseBody, error) {
+		resp, err := client.GetConfigsByIdWithResponse(context.Background(), configID, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get config: %w", err)
+		}
+
+		if resp.StatusCode() != 200 {
+			return nil, apiError("failed to get config", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+		}
+
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("empty response body")
+		}
+		return resp.JSON200, nil
+	})
+	if err != nil {
+		return err
+	}
+	if !fetchedAt.IsZero() {
+		printStale(fetchedAt)
+	}
+
+	grayStyle := lipgloss.NewStyle().Foreground(grayColor)
+	config := details.Config
+
+	fmt.Println(titleStyle.Render(fmt.Sprintf("Config %s", config.Id)))
+	fmt.Printf("%s %s\n", grayStyle.Render("Repository:     "), config.RepoFullName)
+	if config.DockerfilePath != "" {
+		fmt.Printf("%s %s\n", grayStyle.Render("Dockerfile:     "), config.DockerfilePath)
+	}
+	switch {
+	case config.AgentId != nil:
+		fmt.Printf("%s agent %s\n", grayStyle.Render("Deploys with:   "), *config.AgentId)
+	case config.ClusterCredentialId != nil:
+		fmt.Printf("%s cluster credential %d\n", grayStyle.Render("Deploys with:   "), *config.ClusterCredentialId)
+	default:
+		fmt.Printf("%s nothing, connect a cluster with 'nimbul cluster connect'\n", grayStyle.Render("Deploys with:   "))
+	}
+	fmt.Printf("%s %s\n", grayStyle.Render("Created:        "), config.CreatedAt.Local().Format("2006-01-02 15:04:05"))
+	fmt.Printf("%s %s\n", grayStyle.Render("Health:         "), healthStyle(config.Health).Render(config.Health))
+	if config.HealthDetail != nil && *config.HealthDetail != "" {
+		fmt.Printf("%s %s\n", grayStyle.Render("                "), *config.HealthDetail)
+	}
+
+	webhook := details.Webhook
+	if webhook.GithubWebhookId != nil {
+		fmt.Printf("%s %d\n", grayStyle.Render("GitHub webhook: "), *webhook.GithubWebhookId)
+	} else {
+		fmt.Printf("%s %s\n", grayStyle.Render("GitHub webhook: "), lipgloss.NewStyle().Foreground(orangeColor).Render("not set up, pushes will not trigger builds"))
+	}
+	registryWebhook := "disabled"
+	if webhook.RegistryWebhook {
+		registryWebhook = "enabled"
+	}
+	fmt.Printf("%s %s\n", grayStyle.Render("Registry hook:  "), registryWebhook)
+	fmt.Println()
+
+	if build := details.LastBuild; build != nil {
+		fmt.Printf("%s %s %s of %s (%s, %s)\n",
+			grayStyle.Render("Last build:     "),
+			statusStyle(build.Status).Render(build.Status),
+			build.Name,
+			shortCommit(build.CommitSha),
+			strings.TrimPrefix(build.Ref, "refs/heads/"),
+			build.StartedAt.Local().Format("2006-01-02 15:04:05"),
+		)
+		if build.Error != nil && *build.Error != "" {
+			fmt.Printf("%s %s\n", grayStyle.Render("                "), *build.Error)
+		}
+	} else {
+		fmt.Printf("%s none yet\n", grayStyle.Render("Last build:     "))
+	}
+
+	if deployment := details.LastDeployment; deployment != nil {
+		fmt.Printf("%s %s deployment %d of %s (%s, %s)\n",
+			grayStyle.Render("Last deployment:"),
+			statusStyle(deployment.Status).Render(deployment.Status),
+			deployment.Id,
+			shortCommit(deployment.CommitSha),
+			strings.TrimPrefix(deployment.Ref, "refs/heads/"),
+			deployment.CreatedAt.Local().Format("2006-01-02 15:04:05"),
+		)
+		if deployment.Error != nil && *deployment.Error != "" {
+			fmt.Printf("%s %s\n", grayStyle.Render("                "), *deployment.Error)
+		}
+	} else {
+		fmt.Printf("%s none yet\n", grayStyle.Render("Last deployment:"))
+	}
+
+	return nil
+}
+
+func configListExec(cmd *cobra.Command, args []string) error {
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	cached, _ := cmd.Flags().GetBool("cached")
+	configList, fetchedAt, err := fetchCached("configs", cached, func() ([]nimbul.ConfigResponse, error) {
+		resp, err := client.GetConfigsWithResponse(context.Background(), nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list configs: %w", err)
+		}
+
+		if resp.StatusCode() != 200 {
+			return nil, apiError("failed to list configs", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+		}
+
+		if resp.JSON200 == nil || resp.JSON200.Configs == nil {
+			return nil, nil
+		}
+		return *resp.JSON200.Configs, nil
+	})
+	if err != nil {
+		return err
+	}
+	if !fetchedAt.IsZero() {
+		printStale(fetchedAt)
+	}
+
+	if len(configList) == 0 {
+		fmt.Println("No configs yet. Create one with 'nimbul init' in a repository")
+		return nil
+	}
+
+	fmt.Println(titleStyle.Render("Configs"))
+	for _, config := range configList {
+		printConfigHealth(config)
+	}
+
+	return nil
+}
+
+func configSyncExec(cmd *cobra.Command, args []string) error {
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.PostConfigsSyncWithResponse(context.Background(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to sync configs: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to sync configs", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	if resp.JSON200 == nil || resp.JSON200.Configs == nil || len(*resp.JSON200.Configs) == 0 {
+		fmt.Println("No configs yet. Create one with 'nimbul init' in a repository")
+		return nil
+	}
+
+	grayStyle := lipgloss.NewStyle().Foreground(grayColor)
+
+	unhealthy := 0
+	for _, result := range *resp.JSON200.Configs {
+		printConfigHealth(result.Config)
+		if result.RenamedFrom != nil {
+			fmt.Println(grayStyle.Render(fmt.Sprintf("    now follows the repository renamed from %s", *result.RenamedFrom)))
+		}
+		if result.Error != nil {
+			fmt.Println(errorStyle.Render(fmt.Sprintf("    could not check: %s", *result.Error)))
+		}
+		if result.Config.Health != "ok" || result.Error != nil {
+			unhealthy++
+		}
+	}
+
+	if unhealthy > 0 {
+		return failedErrorf("%d config(s) need attention", unhealthy)
+	}
+	fmt.Println(successStyle.Render("✓ All configs are healthy"))
+	return nil
+}
+
+// printConfigHealth prints a config with its health and, when unhealthy, how to fix it
+func printConfigHealth(config nimbul.ConfigResponse) {
+	fmt.Printf("%s  %s  %s\n", config.Id, config.RepoFullName, healthStyle(config.Health).Render(config.Health))
+	if config.HealthDetail != nil && *config.HealthDetail != "" {
+		fmt.Println(lipgloss.NewStyle().Foreground(grayColor).Render("    " + *config.HealthDetail))
+	}
+}
+
+// healthStyle colors the health of a config
+func healthStyle(health string) lipgloss.Style {
+	switch health {
+	case "ok":
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("#4CAF50"))
+	case "unknown":
+		return lipgloss.NewStyle().Foreground(grayColor)
+	}
+	return lipgloss.NewStyle().Foreground(lipgloss.Color("#F44336"))
+}
+
+func configExportExec(cmd *cobra.Command, args []string) error {
+	output, _ := cmd.Flags().GetString("output")
+	format, _ := cmd.Flags().GetString("format")
+	if format != "yaml" && format != "json" {
+		return fmt.Errorf("unknown format %q: use yaml or json", format)
+	}
+
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	configID, err := resolveConfigID(client, args)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetConfigsByIdExportWithResponse(context.Background(), configID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to export config: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to export config", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	if resp.JSON200 == nil {
+		return fmt.Errorf("empty response body")
+	}
+
+	bundle := *resp.JSON200
+	bundle.Schema = nil
+
+	var data []byte
+	if format == "json" {
+		data, err = json.MarshalIndent(bundle, "", "  ")
+		data = append(data, '\n')
+	} else {
+		data, err = bundleToYAML(bundle)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to encode bundle: %w", err)
+	}
+
+	if output == "" {
+		fmt.Print(string(data))
+		return nil
+	}
+
+	if err := os.WriteFile(output, data, 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", output, err)
+	}
+
+	fmt.Println(successStyle.Render(fmt.Sprintf("✓ Exported %s to %s", bundle.RepoFullName, output)))
+	return nil
+}
+
+func configImportExec(cmd *cobra.Command, args []string) error {
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to read bundle: %w", err)
+	}
+
+	bundle, err := parseBundle(data)
+	if err != nil {
+		return fmt.Errorf("failed to parse bundle: %w", err)
+	}
+
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	resp, err := client.PostConfigsImportWithResponse(ctx, nil, *bundle)
+	if err != nil {
+		return fmt.Errorf("failed to import config: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to import config", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	if resp.JSON200 == nil {
+		return fmt.Errorf("empty response body")
+	}
+
+	result := resp.JSON200
+	fmt.Println(successStyle.Render(fmt.Sprintf("✓ Config %s created for %s", result.ConfigId, bundle.RepoFullName)))
+
+	if _, err := setupGitHubWebhook(ctx, client, result.ConfigId); err != nil {
+		fmt.Println(errorStyle.Render(fmt.Sprintf("✗ Failed to create the GitHub webhook: %v", err)))
+		fmt.Println(lipgloss.NewStyle().Foreground(grayColor).Render("Builds will not trigger until a webhook is set up for the config."))
+	} else {
+		fmt.Println(successStyle.Render("✓ GitHub webhook created"))
+	}
+
+	if result.Warnings != nil && len(*result.Warnings) > 0 {
+		fmt.Println()
+		fmt.Println(labelStyle.Render("Finish setting up the config:"))
+		for _, warning := range *result.Warnings {
+			fmt.Printf("  - %s\n", warning)
+		}
+	}
+
+	return nil
+}
+
+// bundleToYAML encodes a bundle as YAML with the same keys as its JSON form
+func bundleToYAML(bundle nimbul.Bundle) ([]byte, error) {
+	data, err := json.Marshal(bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	return yaml.Marshal(fields)
+}
+
+// parseBundle decodes a YAML or JSON bundle; JSON is valid YAML, so both go through the YAML decoder
+func parseBundle(data []byte) (*nimbul.Bundle, error) {
+	var fields map[string]any
+	if err := yaml.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	jsonData, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	var bundle nimbul.Bundle
+	if err := json.Unmarshal(jsonData, &bundle); err != nil {
+		return nil, err
+	}
+
+	return &bundle, nil
+}
@@ -8,7 +8,7 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/coding-cave-dev/nimbul/internal/github"
-	"github.com/coding-cave-dev/nimbul/internal/sdk"
+	"github.com/coding-cave-dev/nimbul/pkg/nimbul"
 	"github.com/spf13/cobra"
 	"golang.org/x/oauth2"
 )
@@ -47,32 +47,27 @@ type appInstallationAuthTestMsg struct {
 }
 
 // saveTokensToAPI saves both access and refresh tokens to the credentials endpoint
-// The userID is extracted server-side from the authToken
-func saveTokensToAPI(client *sdk.ClientWithResponses, authToken, provider string, oauthToken oauth2.Token) error {
+// The userID is extracted server-side from the stored token
+func saveTokensToAPI(client *nimbul.ClientWithResponses, provider string, oauthToken oauth2.Token) error {
 	if client == nil {
 		return fmt.Errorf("SDK client is not available")
 	}
 
 	ctx := context.Background()
-	authHeader := fmt.Sprintf("Bearer %s", authToken)
 
 	// Calculate expiry times
 	accessExpiry := time.Now().Add(8 * time.Hour)            // 8 hours
 	refreshExpiry := time.Now().Add(6 * 30 * 24 * time.Hour) // 6 months (180 days)
 
-	params := &sdk.PostCredentialsParams{
-		Authorization: &authHeader,
-	}
-
 	// Save access token
-	accessTokenReq := sdk.StoreCredentialRequestBody{
+	accessTokenReq := nimbul.StoreCredentialRequestBody{
 		Provider:  provider,
 		TokenType: "oauth_access",
 		Token:     oauthToken.AccessToken,
 		ExpiresAt: accessExpiry,
 	}
 
-	accessResp, err := client.PostCredentialsWithResponse(ctx, params, accessTokenReq)
+	accessResp, err := client.PostCredentialsWithResponse(ctx, nil, accessTokenReq)
 	if err != nil {
 		return fmt.Errorf("failed to save access token: %w", err)
 	}
@@ -94,14 +89,14 @@ func saveTokensToAPI(client *sdk.ClientWithResponses, authToken, provider string
 
 	// Save refresh token (only if it exists)
 	if oauthToken.RefreshToken != "" {
-		refreshTokenReq := sdk.StoreCredentialRequestBody{
+		refreshTokenReq := nimbul.StoreCredentialRequestBody{
 			Provider:  provider,
 			TokenType: "oauth_refresh",
 			Token:     oauthToken.RefreshToken,
 			ExpiresAt: refreshExpiry,
 		}
 
-		refreshResp, err := client.PostCredentialsWithResponse(ctx, params, refreshTokenReq)
+		refreshResp, err := client.PostCredentialsWithResponse(ctx, nil, refreshTokenReq)
 		if err != nil {
 			return fmt.Errorf("failed to save refresh token: %w", err)
 		}
@@ -128,20 +123,26 @@ func saveTokensToAPI(client *sdk.ClientWithResponses, authToken, provider string
 type connectModal struct {
 	email            string
 	userID           string
-	authToken        string
+	app              githubApp
 	providers        []string
 	selectedProvider string
 	providerCursor   int
 }
 
+// githubApp is the GitHub App the server acts as
+type githubApp struct {
+	slug     string
+	clientID string
+}
+
 type connectGithubModal struct {
+	app                  githubApp
 	deviceAuthResponse   deviceAuthResponse
 	isPolling            bool
 	hasToken             bool
 	token                oauth2.Token
-	authToken            string
 	userID               string
-	client               *sdk.ClientWithResponses
+	client               *nimbul.ClientWithResponses
 	tokensSaved          bool
 	saveError            error
 	testInProgress       bool
@@ -164,7 +165,7 @@ func (m connectGithubModal) Init() tea.Cmd {
 }
 
 func (m connectGithubModal) startOauthFlow() tea.Msg {
-	config, err := github.NewOAuthConfig()
+	config, err := github.NewOAuthConfig(m.app.clientID)
 	if err != nil {
 		panic(err)
 	}
@@ -209,7 +210,7 @@ func (m connectGithubModal) checkAppInstallation() tea.Msg {
 	ctx := context.Background()
 	ghClient := github.NewClientWithToken(m.token.AccessToken)
 
-	info, err := github.CheckAppInstallation(ctx, ghClient, github.DefaultAppSlug)
+	info, err := github.CheckAppInstallation(ctx, ghClient, m.app.slug)
 	if err != nil {
 		return appInstallationCheckMsg{
 			installed: false,
@@ -228,7 +229,7 @@ func (m connectGithubModal) verifyAppInstallation() tea.Msg {
 	ctx := context.Background()
 	ghClient := github.NewClientWithToken(m.token.AccessToken)
 
-	installationID, err := github.VerifyAppInstallation(ctx, ghClient, github.DefaultAppSlug)
+	installationID, err := github.VerifyAppInstallation(ctx, ghClient, m.app.slug)
 	if err != nil {
 		return appInstallationVerifiedMsg{
 			installed: false,
@@ -243,19 +244,15 @@ func (m connectGithubModal) verifyAppInstallation() tea.Msg {
 }
 
 func (m connectGithubModal) testAppInstallationAuth() tea.Msg {
-	ctx := context.Background()
-
-	// Use shared GitHub app auth utility
-	appAuth, err := github.NewAppAuth(m.installationID)
-	if err != nil {
+	if m.client == nil {
 		return appInstallationAuthTestMsg{
 			success: false,
-			err:     err,
+			err:     fmt.Errorf("SDK client is not available"),
 		}
 	}
 
-	// Get installation client
-	installClient, err := appAuth.GetInstallationClient(ctx)
+	// The app's private key stays on the server, which authenticates as the installation
+	resp, err := m.client.PostGithubInstallationsByIdCheckWithResponse(context.Background(), m.installationID, nil)
 	if err != nil {
 		return appInstallationAuthTestMsg{
 			success: false,
@@ -263,15 +260,10 @@ func (m connectGithubModal) testAppInstallationAuth() tea.Msg {
 		}
 	}
 
-	// Get user client for testing
-	userClient := github.NewClientWithToken(m.token.AccessToken)
-
-	// Test installation auth
-	err = github.TestInstallationAuth(ctx, installClient, userClient)
-	if err != nil {
+	if resp.StatusCode() != 200 {
 		return appInstallationAuthTestMsg{
 			success: false,
-			err:     err,
+			err:     apiError("installation auth test failed", resp.StatusCode(), resp.ApplicationproblemJSONDefault),
 		}
 	}
 
@@ -313,7 +305,7 @@ func (m connectGithubModal) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		// Save tokens to API if not already saved
 		if !m.tokensSaved {
-			err := saveTokensToAPI(m.client, m.authToken, "github", msg.Token)
+			err := saveTokensToAPI(m.client, "github", msg.Token)
 			if err != nil {
 				m.saveError = err
 				// Log error but don't fail the flow
@@ -423,7 +415,7 @@ func (m connectGithubModal) View() string {
 		} else if m.appInstallError != nil {
 			s.WriteString(fmt.Sprintf("\n⚠ Failed to check app installation: %v\n", m.appInstallError))
 		} else if m.appInstalled {
-			s.WriteString("\n✓ GitHub app 'nimbul-coding-cave' is installed!\n")
+			s.WriteString(fmt.Sprintf("\n✓ GitHub app '%s' is installed!\n", m.app.slug))
 
 			// Show installation auth test
 			if m.testingInstallAuth {
@@ -431,10 +423,10 @@ func (m connectGithubModal) View() string {
 			} else if m.installAuthError != nil {
 				s.WriteString(fmt.Sprintf("\n⚠ Installation auth test failed: %v\n", m.installAuthError))
 			} else if m.installAuthSuccess {
-				s.WriteString("\n✓ Installation authentication verified! Can list webhooks using app auth.\n")
+				s.WriteString("\n✓ Installation authentication verified! The server can act as the app on your repositories.\n")
 			}
 		} else if m.waitingForInstall {
-			s.WriteString("\n⚠ GitHub app 'nimbul-coding-cave' is not installed.\n")
+			s.WriteString(fmt.Sprintf("\n⚠ GitHub app '%s' is not installed.\n", m.app.slug))
 			s.WriteString(fmt.Sprintf("\nPlease install the app at:\n%s\n\n", m.appInstallURL))
 			if m.verifyingInstall {
 				s.WriteString("Verifying installation...\n")
@@ -489,9 +481,9 @@ func (m connectModal) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					client = nil
 				}
 				modal := connectGithubModal{
-					authToken: m.authToken,
-					userID:    m.userID,
-					client:    client,
+					app:    m.app,
+					userID: m.userID,
+					client: client,
 				}
 				return modal, modal.startOauthFlow
 			}
@@ -532,7 +524,7 @@ func connectExec(cmd *cobra.Command, args []string) error {
 	}
 
 	if token == "" {
-		return fmt.Errorf("not logged in. Please run 'nimbul login' first")
+		return errNotLoggedIn
 	}
 
 	// Get SDK client
@@ -542,12 +534,8 @@ func connectExec(cmd *cobra.Command, args []string) error {
 	}
 
 	ctx := context.Background()
-	authHeader := fmt.Sprintf("Bearer %s", token)
-	params := &sdk.GetMeParams{
-		Authorization: &authHeader,
-	}
 
-	resp, err := client.GetMeWithResponse(ctx, params)
+	resp, err := client.GetMeWithResponse(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("failed to make request: %w", err)
 	}
@@ -571,13 +559,27 @@ func connectExec(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("empty response body")
 	}
 
+	// The server tells which GitHub App to sign in with and install
+	appResp, err := client.GetGithubAppWithResponse(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get GitHub App: %w", err)
+	}
+
+	if appResp.StatusCode() != 200 {
+		return apiError("failed to get GitHub App", appResp.StatusCode(), appResp.ApplicationproblemJSONDefault)
+	}
+
+	if appResp.JSON200 == nil {
+		return fmt.Errorf("empty response body")
+	}
+
 	p := tea.NewProgram(connectModal{
 		providers:        []string{"GitHub"},
 		selectedProvider: "",
 		providerCursor:   0,
 		userID:           resp.JSON200.Id,
-		authToken:        token,
 		email:            resp.JSON200.Email,
+		app:              githubApp{slug: appResp.JSON200.Slug, clientID: appResp.JSON200.ClientId},
 	})
 	if _, err := p.Run(); err != nil {
 		return err
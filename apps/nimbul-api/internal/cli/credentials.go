@@ -0,0 +1,65 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+)
+
+var credentialsCmd = &cobra.Command{
+	Use:   "credentials",
+	Short: "List stored credentials and when they were last used",
+	Long: `List the GitHub tokens, cluster credentials and registry logins stored for you,
+with when builds and deploys last used them. A credential not used in a long
+time is likely safe to delete.`,
+	Args: cobra.NoArgs,
+	RunE: credentialsExec,
+}
+
+func init() {
+	rootCmd.AddCommand(credentialsCmd)
+}
+
+func credentialsExec(cmd *cobra.Command, args []string) error {
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetCredentialsWithResponse(context.Background(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to list credentials: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to list credentials", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	if resp.JSON200 == nil || resp.JSON200.Credentials == nil || len(*resp.JSON200.Credentials) == 0 {
+		fmt.Println("No credentials stored")
+		return nil
+	}
+
+	grayStyle := lipgloss.NewStyle().Foreground(grayColor)
+	const timeFormat = "2006-01-02 15:04"
+
+	fmt.Println(titleStyle.Render("Credentials"))
+	for _, credential := range *resp.JSON200.Credentials {
+		lastUsed := "never used"
+		if credential.LastUsedAt != nil {
+			lastUsed = "last used " + credential.LastUsedAt.Local().Format(timeFormat)
+		}
+		status := ""
+		if credential.ExpiresAt != nil && credential.ExpiresAt.Before(time.Now()) {
+			status = "  " + errorStyle.Render("expired")
+		}
+
+		fmt.Printf("%-6d %s/%s  %s%s\n", credential.Id, credential.Provider, credential.TokenType,
+			grayStyle.Render(fmt.Sprintf("added %s, %s", credential.CreatedAt.Local().Format(timeFormat), lastUsed)), status)
+	}
+
+	return nil
+}
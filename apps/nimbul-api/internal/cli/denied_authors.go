@@ -0,0 +1,105 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/coding-cave-dev/nimbul/pkg/nimbul"
+	"github.com/spf13/cobra"
+)
+
+var deniedAuthorsCmd = &cobra.Command{
+	Use:   "denied-authors [config-id]",
+	Short: "Show or change the commit authors whose pushes don't trigger builds",
+	Long: `Show or change the commit authors whose pushes don't trigger builds of a
+config, so bots committing back to the repository don't rebuild it forever.
+Authors match the GitHub login, name or email of a push's head commit author or
+of the pusher, ignoring case.
+
+  --add dependabot[bot]     stop building pushes of dependabot
+  --remove dependabot[bot]  build them again
+
+A single commit can also opt out with [skip ci] or [nimbul skip] in its message.`,
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeFirstArg(completeConfigIDs),
+	RunE:              deniedAuthorsExec,
+}
+
+func init() {
+	deniedAuthorsCmd.Flags().StringSlice("add", nil, "Authors to deny")
+	deniedAuthorsCmd.Flags().StringSlice("remove", nil, "Authors to allow again")
+	rootCmd.AddCommand(deniedAuthorsCmd)
+}
+
+func deniedAuthorsExec(cmd *cobra.Command, args []string) error {
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	configID, err := resolveConfigID(client, args)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	getResp, err := client.GetConfigsByIdDeniedAuthorsWithResponse(ctx, configID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get denied authors: %w", err)
+	}
+
+	if getResp.StatusCode() != 200 {
+		return apiError("failed to get denied authors", getResp.StatusCode(), getResp.ApplicationproblemJSONDefault)
+	}
+
+	if getResp.JSON200 == nil {
+		return fmt.Errorf("empty response body")
+	}
+
+	authors := []string{}
+	if getResp.JSON200.Authors != nil {
+		authors = *getResp.JSON200.Authors
+	}
+
+	add, _ := cmd.Flags().GetStringSlice("add")
+	remove, _ := cmd.Flags().GetStringSlice("remove")
+	if len(add) > 0 || len(remove) > 0 {
+		authors = slices.DeleteFunc(authors, func(author string) bool {
+			return slices.ContainsFunc(remove, func(r string) bool { return strings.EqualFold(r, author) })
+		})
+		authors = append(authors, add...)
+
+		putResp, err := client.PutConfigsByIdDeniedAuthorsWithResponse(ctx, configID, nil, nimbul.PutConfigsByIdDeniedAuthorsJSONRequestBody{
+			Authors: &authors,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update denied authors: %w", err)
+		}
+
+		if putResp.StatusCode() != 200 {
+			return apiError("failed to update denied authors", putResp.StatusCode(), putResp.ApplicationproblemJSONDefault)
+		}
+
+		authors = nil
+		if putResp.JSON200 != nil && putResp.JSON200.Authors != nil {
+			authors = *putResp.JSON200.Authors
+		}
+
+		fmt.Println(successStyle.Render("✓ Denied authors updated"))
+		fmt.Println()
+	}
+
+	fmt.Println(titleStyle.Render("Denied authors"))
+	if len(authors) == 0 {
+		fmt.Println(lipgloss.NewStyle().Foreground(grayColor).Render("None, every push triggers a build"))
+		return nil
+	}
+	for _, author := range authors {
+		fmt.Println(author)
+	}
+	return nil
+}
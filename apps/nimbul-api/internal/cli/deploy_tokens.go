@@ -0,0 +1,151 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/coding-cave-dev/nimbul/pkg/nimbul"
+	"github.com/spf13/cobra"
+)
+
+var deployTokensCmd = &cobra.Command{
+	Use:   "deploy-tokens",
+	Short: "Manage the tokens external CI systems deploy a config with",
+	Long: `Deploy tokens let an external CI system deploy one config without holding your
+account token. They only work on POST /deploy/trigger, which builds and deploys the
+head of a branch, and GET /deploy/status, which shows the latest deployment of the
+config or of a commit:
+
+  curl -X POST "$NIMBUL_API_URL/deploy/trigger" \
+    -H "Authorization: Bearer $NIMBUL_DEPLOY_TOKEN" \
+    -H "Content-Type: application/json" \
+    -d "{\"branch\": \"main\", \"commit_sha\": \"$GIT_COMMIT\"}"
+
+  curl "$NIMBUL_API_URL/deploy/status?commit_sha=$GIT_COMMIT" \
+    -H "Authorization: Bearer $NIMBUL_DEPLOY_TOKEN"
+
+With commit_sha, the trigger fails with 409 when the branch moved on from the commit,
+so a CI run never deploys a commit it did not test. Transferring the config revokes
+its deploy tokens.`,
+}
+
+var deployTokensCreateCmd = &cobra.Command{
+	Use:               "create <config-id> <name>",
+	Short:             "Issue a deploy token for a config and print it",
+	Args:              cobra.ExactArgs(2),
+	ValidArgsFunction: completeFirstArg(completeConfigIDs),
+	RunE:              deployTokensCreateExec,
+}
+
+var deployTokensListCmd = &cobra.Command{
+	Use:               "list [config-id]",
+	Short:             "List the deploy tokens of a config and when they were last used",
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeFirstArg(completeConfigIDs),
+	RunE:              deployTokensListExec,
+}
+
+var deployTokensRevokeCmd = &cobra.Command{
+	Use:               "revoke <config-id> <token-id>",
+	Short:             "Revoke a deploy token",
+	Args:              cobra.ExactArgs(2),
+	ValidArgsFunction: completeFirstArg(completeConfigIDs),
+	RunE:              deployTokensRevokeExec,
+}
+
+func init() {
+	deployTokensCmd.AddCommand(deployTokensCreateCmd)
+	deployTokensCmd.AddCommand(deployTokensListCmd)
+	deployTokensCmd.AddCommand(deployTokensRevokeCmd)
+	rootCmd.AddCommand(deployTokensCmd)
+}
+
+func deployTokensCreateExec(cmd *cobra.Command, args []string) error {
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.PostConfigsByIdDeployTokensWithResponse(context.Background(), args[0], nil, nimbul.CreateDeployTokenRequestBody{
+		Name: args[1],
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create deploy token: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to create deploy token", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	if resp.JSON200 == nil {
+		return fmt.Errorf("empty response body")
+	}
+
+	fmt.Println(successStyle.Render(fmt.Sprintf("✓ Deploy token %s created for %s", resp.JSON200.Id, resp.JSON200.ConfigId)))
+	fmt.Println()
+	fmt.Printf("Token: %s\n", resp.JSON200.Token)
+	fmt.Println()
+	fmt.Println(labelStyle.Render("This token will not be shown again. Store it as a secret of your CI system."))
+
+	return nil
+}
+
+func deployTokensListExec(cmd *cobra.Command, args []string) error {
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	configID, err := resolveConfigID(client, args)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetConfigsByIdDeployTokensWithResponse(context.Background(), configID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list deploy tokens: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to list deploy tokens", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	if resp.JSON200 == nil || resp.JSON200.DeployTokens == nil || len(*resp.JSON200.DeployTokens) == 0 {
+		fmt.Printf("No deploy tokens yet. Issue one with 'nimbul deploy-tokens create %s <name>'\n", configID)
+		return nil
+	}
+
+	grayStyle := lipgloss.NewStyle().Foreground(grayColor)
+
+	fmt.Println(titleStyle.Render(fmt.Sprintf("Deploy tokens of %s", configID)))
+	for _, deployToken := range *resp.JSON200.DeployTokens {
+		lastUsed := "never used"
+		if deployToken.LastUsedAt != nil {
+			lastUsed = "last used " + formatAge(time.Since(*deployToken.LastUsedAt)) + " ago"
+		}
+		fmt.Printf("%s  %s  %s\n", deployToken.Id, deployToken.Name, grayStyle.Render(lastUsed))
+	}
+
+	return nil
+}
+
+func deployTokensRevokeExec(cmd *cobra.Command, args []string) error {
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.DeleteConfigsByIdDeployTokensByTokenIdWithResponse(context.Background(), args[0], args[1], nil)
+	if err != nil {
+		return fmt.Errorf("failed to revoke deploy token: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to revoke deploy token", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	fmt.Println(successStyle.Render(fmt.Sprintf("✓ Deploy token %s revoked", args[1])))
+	return nil
+}
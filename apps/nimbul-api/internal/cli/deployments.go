@@ -0,0 +1,372 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/coding-cave-dev/nimbul/pkg/nimbul"
+	"github.com/spf13/cobra"
+)
+
+var deploymentsCmd = &cobra.Command{
+	Use:   "deployments",
+	Short: "Inspect the deployment history of a config and roll back",
+	Long: `List the deployments of a config, show the resources a deployment applied and
+their live status, print the manifests it sent to the cluster, or roll back to an
+earlier deployment.
+
+Rolling back redeploys the commit of a succeeded deployment without building it
+again: its deploy stage references the images its builds pushed at the time.`,
+}
+
+var deploymentsListCmd = &cobra.Command{
+	Use:               "list [config-id]",
+	Short:             "List the most recent deployments of a config",
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeFirstArg(completeConfigIDs),
+	RunE:              deploymentsListExec,
+}
+
+var deploymentsShowCmd = &cobra.Command{
+	Use:               "show <deployment-id>",
+	Short:             "Show the resources a deployment applied and their status",
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeFirstArg(completeDeploymentIDs),
+	RunE:              deploymentsShowExec,
+}
+
+var deploymentsManifestsCmd = &cobra.Command{
+	Use:   "manifests <deployment-id>",
+	Short: "Print the manifests a deployment sent to the cluster",
+	Long: `Print the manifests a deployment sent to the cluster, with the overrides and
+templates of nimbul.yaml applied, as multi-document YAML. Each manifest file
+starts with a comment naming its path in the repository.`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeFirstArg(completeDeploymentIDs),
+	RunE:              deploymentsManifestsExec,
+}
+
+var deploymentsRollbackCmd = &cobra.Command{
+	Use:               "rollback <config-id> [deployment-id]",
+	Short:             "Redeploy an earlier deployment, picked interactively when no ID is given",
+	Args:              cobra.RangeArgs(1, 2),
+	ValidArgsFunction: completeConfigThenDeployment,
+	RunE:              deploymentsRollbackExec,
+}
+
+func init() {
+	deploymentsListCmd.Flags().Int32("limit", 20, "Number of deployments to list")
+	deploymentsRollbackCmd.Flags().Bool("wait", false, "Follow the rollout until every resource is ready")
+	addNotifyFlag(deploymentsRollbackCmd)
+	deploymentsCmd.AddCommand(deploymentsListCmd)
+	deploymentsCmd.AddCommand(deploymentsShowCmd)
+	deploymentsCmd.AddCommand(deploymentsManifestsCmd)
+	deploymentsCmd.AddCommand(deploymentsRollbackCmd)
+	rootCmd.AddCommand(deploymentsCmd)
+}
+
+func deploymentsListExec(cmd *cobra.Command, args []string) error {
+	limit, _ := cmd.Flags().GetInt32("limit")
+
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	configID, err := resolveConfigID(client, args)
+	if err != nil {
+		return err
+	}
+
+	deploymentList, err := listDeployments(client, configID, limit)
+	if err != nil {
+		return err
+	}
+
+	if len(deploymentList) == 0 {
+		fmt.Println("No deployments yet. Push to the repository to trigger one.")
+		return nil
+	}
+
+	grayStyle := lipgloss.NewStyle().Foreground(grayColor)
+
+	fmt.Println(titleStyle.Render(fmt.Sprintf("Deployments of %s", configID)))
+	fmt.Println(grayStyle.Render(fmt.Sprintf("%-8s %-19s  %-11s %-8s %s", "ID", "CREATED", "STATUS", "COMMIT", "REF")))
+	for _, deployment := range deploymentList {
+		fmt.Printf("%-8d %s  %s %-8s %s\n",
+			deployment.Id,
+			deployment.CreatedAt.Local().Format("2006-01-02 15:04:05"),
+			statusStyle(deployment.Status).Render(fmt.Sprintf("%-11s", deployment.Status)),
+			shortCommit(deployment.CommitSha),
+			strings.TrimPrefix(deployment.Ref, "refs/heads/"),
+		)
+		if deployment.Error != nil && *deployment.Error != "" {
+			fmt.Printf("         %s\n", errorStyle.Render(*deployment.Error))
+		}
+	}
+
+	return nil
+}
+
+func deploymentsShowExec(cmd *cobra.Command, args []string) error {
+	deploymentID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return usageErrorf("invalid deployment ID %q", args[0])
+	}
+
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetDeploymentsByIdResourcesWithResponse(context.Background(), deploymentID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get deployment: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to get deployment", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	if resp.JSON200 == nil {
+		return fmt.Errorf("empty response body")
+	}
+
+	printDeploymentStatus(resp.JSON200.Deployment, resp.JSON200.Resources)
+	return nil
+}
+
+func deploymentsManifestsExec(cmd *cobra.Command, args []string) error {
+	deploymentID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return usageErrorf("invalid deployment ID %q", args[0])
+	}
+
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetDeploymentsByIdManifestsWithResponse(context.Background(), deploymentID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get deployment manifests: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to get deployment manifests", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	if resp.JSON200 == nil {
+		return fmt.Errorf("empty response body")
+	}
+
+	if resp.JSON200.Manifests == nil || len(*resp.JSON200.Manifests) == 0 {
+		return fmt.Errorf("deployment %d has no recorded manifests, it ran before they were recorded", deploymentID)
+	}
+
+	// Printed as is, so it can be piped to kubectl or diffed
+	for i, manifest := range *resp.JSON200.Manifests {
+		if i > 0 {
+			fmt.Println("---")
+		}
+		fmt.Printf("# Source: %s\n", manifest.Path)
+		fmt.Print(strings.TrimSuffix(manifest.Content, "\n") + "\n")
+	}
+	return nil
+}
+
+func deploymentsRollbackExec(cmd *cobra.Command, args []string) error {
+	wait, _ := cmd.Flags().GetBool("wait")
+	if wait {
+		if err := checkNotifyMode(); err != nil {
+			return err
+		}
+	}
+
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	var deploymentID int64
+	if len(args) == 2 {
+		deploymentID, err = strconv.ParseInt(args[1], 10, 64)
+		if err != nil {
+			return usageErrorf("invalid deployment ID %q", args[1])
+		}
+	} else {
+		deploymentID, err = pickRollbackTarget(client, args[0])
+		if err != nil || deploymentID == 0 {
+			return err
+		}
+	}
+
+	fmt.Println(lipgloss.NewStyle().Foreground(grayColor).Render(fmt.Sprintf("Rolling back to deployment %d...", deploymentID)))
+
+	resp, err := client.PostDeploymentsByIdRollbackWithResponse(context.Background(), deploymentID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to roll back: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to roll back", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	if resp.JSON200 == nil {
+		return fmt.Errorf("empty response body")
+	}
+
+	target := resp.JSON200.RolledBackTo
+	fmt.Println(successStyle.Render(fmt.Sprintf("✓ Rolled back to %s of %s", shortCommit(target.CommitSha), strings.TrimPrefix(target.Ref, "refs/heads/"))))
+
+	deployment := resp.JSON200.Deployment
+	if deployment == nil {
+		fmt.Println("The deployment was queued for the config's agent.")
+		return nil
+	}
+
+	fmt.Printf("Deployment: %d\n", deployment.Id)
+	if wait {
+		err := waitForDeployment(deployment.Id)
+		notifyDone(fmt.Sprintf("Rollback %d", deployment.Id), err)
+		return err
+	}
+	return nil
+}
+
+// listDeployments returns up to limit of the most recent deployments of a config,
+// newest first
+func listDeployments(client *nimbul.ClientWithResponses, configID string, limit int32) ([]nimbul.DeploymentResponse, error) {
+	var deploymentList []nimbul.DeploymentResponse
+	// The API returns at most 100 deployments per page
+	for deployment, err := range client.ConfigDeployments(context.Background(), configID, min(limit, 100)) {
+		if err != nil {
+			return nil, fmt.Errorf("failed to get deployments: %w", err)
+		}
+		deploymentList = append(deploymentList, deployment)
+		if len(deploymentList) == int(limit) {
+			break
+		}
+	}
+	return deploymentList, nil
+}
+
+// pickRollbackTarget lets the user choose one of the earlier succeeded deployments of
+// a config. Returns 0 when the user cancels.
+func pickRollbackTarget(client *nimbul.ClientWithResponses, configID string) (int64, error) {
+	deploymentList, err := listDeployments(client, configID, 50)
+	if err != nil {
+		return 0, err
+	}
+
+	// The latest deployment is what is running, so it is not offered
+	var candidates []nimbul.DeploymentResponse
+	for i, deployment := range deploymentList {
+		if i > 0 && deployment.Status == "succeeded" {
+			candidates = append(candidates, deployment)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return 0, fmt.Errorf("config %s has no earlier succeeded deployment to roll back to", configID)
+	}
+
+	model := rollbackPickerModel{deployments: candidates}
+	final, err := tea.NewProgram(model).Run()
+	if err != nil {
+		return 0, err
+	}
+
+	picked := final.(rollbackPickerModel)
+	if picked.canceled {
+		fmt.Println("Rollback canceled")
+		return 0, nil
+	}
+	return picked.deployments[picked.cursor].Id, nil
+}
+
+type rollbackPickerModel struct {
+	deployments []nimbul.DeploymentResponse
+	cursor      int
+	canceled    bool
+}
+
+func (m rollbackPickerModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m rollbackPickerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	switch keyMsg.Type {
+	case tea.KeyCtrlC, tea.KeyEsc:
+		m.canceled = true
+		return m, tea.Quit
+	case tea.KeyUp:
+		if m.cursor > 0 {
+			m.cursor--
+		} else {
+			m.cursor = len(m.deployments) - 1
+		}
+	case tea.KeyDown:
+		if m.cursor < len(m.deployments)-1 {
+			m.cursor++
+		} else {
+			m.cursor = 0
+		}
+	case tea.KeyEnter:
+		return m, tea.Quit
+	}
+	return m, nil
+}
+
+func (m rollbackPickerModel) View() string {
+	var s strings.Builder
+
+	s.WriteString(titleStyle.Render("Roll back to\n\n"))
+	for i, deployment := range m.deployments {
+		line := fmt.Sprintf("%d  %s  %s %s",
+			deployment.Id,
+			deployment.CreatedAt.Local().Format("2006-01-02 15:04"),
+			shortCommit(deployment.CommitSha),
+			strings.TrimPrefix(deployment.Ref, "refs/heads/"),
+		)
+		if i == m.cursor {
+			s.WriteString(inputFocusedStyle.Render("  → " + line))
+		} else {
+			s.WriteString(labelStyle.Render("    " + line))
+		}
+		s.WriteString("\n")
+	}
+	s.WriteString("\n")
+	s.WriteString(lipgloss.NewStyle().Foreground(lightGray).Render("Use ↑↓ to navigate, Enter to roll back, Esc to cancel"))
+	s.WriteString("\n")
+
+	return s.String()
+}
+
+// statusStyle colors a build or deployment status
+func statusStyle(status string) lipgloss.Style {
+	switch status {
+	case "succeeded":
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("#4CAF50"))
+	case "failed":
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("#F44336"))
+	}
+	return lipgloss.NewStyle().Foreground(orangeColor)
+}
+
+// shortCommit abbreviates a commit SHA the way git does
+func shortCommit(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
+}
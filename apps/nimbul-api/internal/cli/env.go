@@ -0,0 +1,147 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/coding-cave-dev/nimbul/pkg/nimbul"
+	"github.com/spf13/cobra"
+)
+
+var envCmd = &cobra.Command{
+	Use:   "env",
+	Short: "Manage the environment variables of a config",
+	Long: `Manage non-secret variables of a config, so per-repo settings don't have to
+live in the repository. Builds receive each variable as a build arg, unless
+nimbul.yaml sets an arg of the same name, and nimbul.yaml templates can use
+them as {{ .ENV.<name> }}:
+
+  tags:
+    - "{{ .ENV.REGISTRY }}/api:{{ .COMMIT_SHORT }}"
+
+Values are stored and shown in plain text, so don't use them for secrets.`,
+}
+
+var envSetCmd = &cobra.Command{
+	Use:               "set <config-id> <NAME=value>...",
+	Short:             "Set environment variables of a config",
+	Args:              cobra.MinimumNArgs(2),
+	ValidArgsFunction: completeFirstArg(completeConfigIDs),
+	RunE:              envSetExec,
+}
+
+var envUnsetCmd = &cobra.Command{
+	Use:               "unset <config-id> <NAME>...",
+	Short:             "Remove environment variables of a config",
+	Args:              cobra.MinimumNArgs(2),
+	ValidArgsFunction: completeFirstArg(completeConfigIDs),
+	RunE:              envUnsetExec,
+}
+
+var envListCmd = &cobra.Command{
+	Use:               "list [config-id]",
+	Short:             "List the environment variables of a config",
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeFirstArg(completeConfigIDs),
+	RunE:              envListExec,
+}
+
+func init() {
+	envCmd.AddCommand(envSetCmd)
+	envCmd.AddCommand(envUnsetCmd)
+	envCmd.AddCommand(envListCmd)
+	rootCmd.AddCommand(envCmd)
+}
+
+func envSetExec(cmd *cobra.Command, args []string) error {
+	configID := args[0]
+
+	vars := make(map[string]string, len(args)-1)
+	for _, arg := range args[1:] {
+		name, value, ok := strings.Cut(arg, "=")
+		if !ok || name == "" {
+			return usageErrorf("invalid variable %q, expected NAME=value", arg)
+		}
+		vars[name] = value
+	}
+
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.PostConfigsByIdEnvWithResponse(context.Background(), configID, nil, nimbul.PostConfigsByIdEnvJSONRequestBody{
+		Vars: vars,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set environment variables: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to set environment variables", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	fmt.Println(successStyle.Render(fmt.Sprintf("✓ Set %d variable(s), used from the next build", len(vars))))
+	return nil
+}
+
+func envUnsetExec(cmd *cobra.Command, args []string) error {
+	configID := args[0]
+
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	for _, name := range args[1:] {
+		resp, err := client.DeleteConfigsByIdEnvByNameWithResponse(context.Background(), configID, name, nil)
+		if err != nil {
+			return fmt.Errorf("failed to unset %s: %w", name, err)
+		}
+
+		if resp.StatusCode() != 200 {
+			return apiError("failed to unset "+name, resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+		}
+
+		fmt.Println(successStyle.Render("✓ Unset " + name))
+	}
+
+	return nil
+}
+
+func envListExec(cmd *cobra.Command, args []string) error {
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	configID, err := resolveConfigID(client, args)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetConfigsByIdEnvWithResponse(context.Background(), configID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get environment variables: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to get environment variables", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	if resp.JSON200 == nil {
+		return fmt.Errorf("empty response body")
+	}
+
+	if resp.JSON200.Env == nil || len(*resp.JSON200.Env) == 0 {
+		fmt.Println(lipgloss.NewStyle().Foreground(grayColor).Render("No environment variables set"))
+		return nil
+	}
+
+	for _, v := range *resp.JSON200.Env {
+		fmt.Printf("%s=%s\n", v.Name, v.Value)
+	}
+	return nil
+}
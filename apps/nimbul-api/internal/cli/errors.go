@@ -0,0 +1,140 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/coding-cave-dev/nimbul/pkg/nimbul"
+	"github.com/spf13/cobra"
+)
+
+// Exit codes of nimbul. Scripts branch on them, so existing codes must keep their meaning.
+const (
+	exitError   = 1 // anything not covered below
+	exitUsage   = 2 // invalid arguments or flags, or input the API rejected
+	exitAuth    = 3 // not logged in, expired session, or not allowed
+	exitNetwork = 4 // the API could not be reached
+	exitFailed  = 5 // a build or deploy failed
+)
+
+// exitCodesHelp documents the exit codes in 'nimbul --help'
+const exitCodesHelp = `Exit codes:
+  0  success
+  1  other errors
+  2  invalid arguments or input
+  3  not logged in, session expired, or permission denied
+  4  the Nimbul API could not be reached
+  5  a build or deploy failed`
+
+// cliError is an error that exits nimbul with a specific code
+type cliError struct {
+	code int
+	err  error
+}
+
+func (e *cliError) Error() string {
+	return e.err.Error()
+}
+
+func (e *cliError) Unwrap() error {
+	return e.err
+}
+
+// usageErrorf reports invalid arguments or input
+func usageErrorf(format string, a ...any) error {
+	return &cliError{code: exitUsage, err: fmt.Errorf(format, a...)}
+}
+
+// authErrorf reports a missing or rejected login
+func authErrorf(format string, a ...any) error {
+	return &cliError{code: exitAuth, err: fmt.Errorf(format, a...)}
+}
+
+// failedErrorf reports a failed build or deploy
+func failedErrorf(format string, a ...any) error {
+	return &cliError{code: exitFailed, err: fmt.Errorf(format, a...)}
+}
+
+// errNotLoggedIn is returned by commands that need a stored token
+var errNotLoggedIn = authErrorf("not logged in. Please run 'nimbul login' first")
+
+// apiError turns an error response of the API into an error naming the action that
+// failed, as in "failed to list configs". exitCode picks its code from the status.
+func apiError(action string, statusCode int, problem *nimbul.ErrorModel) error {
+	err := nimbul.CheckResponse(statusCode, problem)
+	if err == nil {
+		// A success status the caller did not expect
+		err = &nimbul.Error{StatusCode: statusCode}
+	}
+	return fmt.Errorf("%s: %w", action, err)
+}
+
+// exitCode picks the exit code for an error returned by a command
+func exitCode(err error) int {
+	var cliErr *cliError
+	if errors.As(err, &cliErr) {
+		return cliErr.code
+	}
+
+	var apiErr *nimbul.Error
+	if errors.As(err, &apiErr) {
+		switch apiErr.StatusCode {
+		case http.StatusUnauthorized, http.StatusForbidden:
+			return exitAuth
+		case http.StatusBadRequest, http.StatusConflict, http.StatusUnprocessableEntity:
+			return exitUsage
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			// Proxies in front of the API answer these when it is down
+			return exitNetwork
+		}
+		return exitError
+	}
+
+	// Requests that never got a response
+	var urlErr *url.Error
+	var netErr net.Error
+	if errors.As(err, &urlErr) || errors.As(err, &netErr) {
+		return exitNetwork
+	}
+
+	return exitError
+}
+
+// renderError prints the error of a command to stderr
+func renderError(cmd *cobra.Command, err error) {
+	style := lipgloss.NewStyle().Foreground(orangeColor)
+	fmt.Fprintln(os.Stderr, style.Render("✗ "+err.Error()))
+
+	// The API links a page explaining how to fix many of its errors
+	var apiErr *nimbul.Error
+	if errors.As(err, &apiErr) && apiErr.HelpURL() != "" {
+		hint := lipgloss.NewStyle().Foreground(grayColor)
+		fmt.Fprintln(os.Stderr, hint.Render("See "+apiErr.HelpURL()))
+	}
+
+	if exitCode(err) == exitUsage && cmd != nil {
+		hint := lipgloss.NewStyle().Foreground(grayColor)
+		fmt.Fprintln(os.Stderr, hint.Render(fmt.Sprintf("Run '%s --help' for usage.", cmd.CommandPath())))
+	}
+}
+
+// wrapArgValidators makes argument errors of every command exit with exitUsage
+func wrapArgValidators(cmd *cobra.Command) {
+	if validate := cmd.Args; validate != nil {
+		cmd.Args = func(cmd *cobra.Command, args []string) error {
+			if err := validate(cmd, args); err != nil {
+				return &cliError{code: exitUsage, err: err}
+			}
+			return nil
+		}
+	}
+
+	for _, sub := range cmd.Commands() {
+		wrapArgValidators(sub)
+	}
+}
@@ -0,0 +1,103 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/coding-cave-dev/nimbul/pkg/nimbul"
+	"github.com/spf13/cobra"
+)
+
+var historyCmd = &cobra.Command{
+	Use:               "history [config-id]",
+	Short:             "Show a timeline of the builds, deployments and rollbacks of your configs, or of one",
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeFirstArg(completeConfigIDs),
+	RunE:              historyExec,
+}
+
+func init() {
+	historyCmd.Flags().Int64("limit", 50, "Number of entries to show")
+	rootCmd.AddCommand(historyCmd)
+}
+
+func historyExec(cmd *cobra.Command, args []string) error {
+	configID := ""
+	if len(args) == 1 {
+		configID = args[0]
+	}
+	limit, _ := cmd.Flags().GetInt64("limit")
+
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	params := &nimbul.GetActivityParams{Limit: &limit}
+	if configID != "" {
+		params.ConfigId = &configID
+	}
+
+	resp, err := client.GetActivityWithResponse(context.Background(), params)
+	if err != nil {
+		return fmt.Errorf("failed to get history: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to get history", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	if resp.JSON200 == nil || resp.JSON200.Activity == nil || len(*resp.JSON200.Activity) == 0 {
+		fmt.Println("Nothing happened yet. Push to a repository to trigger a build.")
+		return nil
+	}
+
+	grayStyle := lipgloss.NewStyle().Foreground(grayColor)
+
+	title := "History"
+	if configID != "" {
+		title = fmt.Sprintf("History of %s", configID)
+	}
+	fmt.Println(titleStyle.Render(title))
+	for _, entry := range *resp.JSON200.Activity {
+		var what string
+		switch entry.Kind {
+		case "build":
+			name := ""
+			if entry.Name != nil {
+				name = *entry.Name
+			}
+			what = fmt.Sprintf("build %s", name)
+		case "rollback":
+			what = fmt.Sprintf("rollback #%d", entry.Id)
+			if entry.RollbackOf != nil {
+				what += fmt.Sprintf(" to #%d", *entry.RollbackOf)
+			}
+		default:
+			what = fmt.Sprintf("deployment #%d", entry.Id)
+		}
+
+		details := []string{shortCommit(entry.CommitSha), strings.TrimPrefix(entry.Ref, "refs/heads/")}
+		if configID == "" {
+			details = append([]string{entry.RepoFullName}, details...)
+		}
+		if entry.Actor != nil && *entry.Actor != "" {
+			details = append(details, "by "+*entry.Actor)
+		}
+
+		fmt.Printf("%s %-28s %s %s\n",
+			grayStyle.Render(fmt.Sprintf("%-22s", formatAge(time.Since(entry.StartedAt))+" ago")),
+			what,
+			statusStyle(entry.Status).Render(fmt.Sprintf("%-10s", entry.Status)),
+			grayStyle.Render(strings.Join(details, "  ")),
+		)
+		if entry.Error != nil && *entry.Error != "" {
+			fmt.Printf("  %s\n", errorStyle.Render(*entry.Error))
+		}
+	}
+
+	return nil
+}
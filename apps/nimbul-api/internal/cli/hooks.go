@@ -0,0 +1,155 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/coding-cave-dev/nimbul/pkg/nimbul"
+	"github.com/spf13/cobra"
+)
+
+var hooksCmd = &cobra.Command{
+	Use:   "hooks",
+	Short: "Manage outgoing webhooks for build and deploy events",
+	Long: `Hooks receive a signed JSON POST for every build and deploy lifecycle event of your configs.
+Verify deliveries by comparing the X-Nimbul-Signature header with
+"sha256=" + hex(HMAC-SHA256(secret, body)).
+
+Event types: build.started, build.succeeded, build.failed,
+deploy.started, deploy.succeeded, deploy.failed`,
+}
+
+var hooksAddCmd = &cobra.Command{
+	Use:   "add <url>",
+	Short: "Register a hook and print its signing secret",
+	Args:  cobra.ExactArgs(1),
+	RunE:  hooksAddExec,
+}
+
+var hooksListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List your hooks and their last delivery",
+	Args:  cobra.NoArgs,
+	RunE:  hooksListExec,
+}
+
+var hooksRemoveCmd = &cobra.Command{
+	Use:   "remove <hook-id>",
+	Short: "Delete a hook",
+	Args:  cobra.ExactArgs(1),
+	RunE:  hooksRemoveExec,
+}
+
+func init() {
+	hooksAddCmd.Flags().StringSlice("event", nil, "Event type to deliver (repeatable, default all)")
+	hooksCmd.AddCommand(hooksAddCmd)
+	hooksCmd.AddCommand(hooksListCmd)
+	hooksCmd.AddCommand(hooksRemoveCmd)
+	rootCmd.AddCommand(hooksCmd)
+}
+
+func hooksAddExec(cmd *cobra.Command, args []string) error {
+	events, err := cmd.Flags().GetStringSlice("event")
+	if err != nil {
+		return err
+	}
+	if events == nil {
+		events = []string{}
+	}
+
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.PostHooksWithResponse(context.Background(), nil, nimbul.CreateHookRequestBody{
+		Url:    args[0],
+		Events: &events,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create hook: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to create hook", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	if resp.JSON200 == nil {
+		return fmt.Errorf("empty response body")
+	}
+
+	fmt.Println(successStyle.Render(fmt.Sprintf("✓ Hook %s created", resp.JSON200.HookId)))
+	fmt.Println()
+	fmt.Printf("Signing secret: %s\n", resp.JSON200.Secret)
+	fmt.Println()
+	fmt.Println(labelStyle.Render("This secret will not be shown again."))
+
+	return nil
+}
+
+func hooksListExec(cmd *cobra.Command, args []string) error {
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetHooksWithResponse(context.Background(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to list hooks: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to list hooks", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	if resp.JSON200 == nil || resp.JSON200.Hooks == nil || len(*resp.JSON200.Hooks) == 0 {
+		fmt.Println("No hooks yet. Add one with 'nimbul hooks add <url>'")
+		return nil
+	}
+
+	grayStyle := lipgloss.NewStyle().Foreground(grayColor)
+
+	fmt.Println(titleStyle.Render("Hooks"))
+	for _, hook := range *resp.JSON200.Hooks {
+		events := "all events"
+		if hook.Events != nil && len(*hook.Events) > 0 {
+			events = strings.Join(*hook.Events, ", ")
+		}
+
+		lastDelivery := "never delivered"
+		if hook.LastDeliveryAt != nil {
+			lastDelivery = "last delivered " + hook.LastDeliveryAt.Local().Format("2006-01-02 15:04:05")
+			if hook.LastStatusCode != nil {
+				lastDelivery += fmt.Sprintf(" (%d)", *hook.LastStatusCode)
+			}
+		}
+
+		fmt.Printf("%s  %s  %s\n", hook.Id, hook.Url, grayStyle.Render(events+", "+lastDelivery))
+		if hook.LastError != nil && *hook.LastError != "" {
+			fmt.Printf("  %s\n", errorStyle.Render(*hook.LastError))
+		}
+	}
+
+	return nil
+}
+
+func hooksRemoveExec(cmd *cobra.Command, args []string) error {
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.DeleteHooksByIdWithResponse(context.Background(), args[0], nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete hook: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to delete hook", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	fmt.Println(successStyle.Render(fmt.Sprintf("✓ Hook %s deleted", args[0])))
+	return nil
+}
@@ -0,0 +1,138 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/coding-cave-dev/nimbul/internal/nimbulconfig"
+	"github.com/spf13/cobra"
+)
+
+var importCmd = &cobra.Command{
+	Use:   "import [dir]",
+	Short: "Generate nimbul.yaml from existing CI configuration",
+	Long: `Generate a nimbul.yaml, and Kubernetes manifests for its deploy stage, from the
+first of these found in the repository:
+
+  1. docker/build-push-action steps or docker build commands in .github/workflows
+  2. services with a build section in docker-compose.yml
+  3. Dockerfiles in the repository
+
+Image tags found in the CI configuration are kept; other images are tagged below
+--registry, which defaults to ghcr.io/<owner> of the GitHub origin remote.
+Commit the generated files, then run 'nimbul init' to create the config.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: importExec,
+}
+
+func init() {
+	importCmd.Flags().String("registry", "", "Registry prefix for generated image tags, e.g. ghcr.io/acme")
+	importCmd.Flags().Bool("dry-run", false, "Print the generated files instead of writing them")
+	importCmd.Flags().Bool("force", false, "Overwrite existing nimbul.yaml and manifests")
+	rootCmd.AddCommand(importCmd)
+}
+
+func importExec(cmd *cobra.Command, args []string) error {
+	dir := "."
+	if len(args) == 1 {
+		dir = args[0]
+	}
+
+	registry, _ := cmd.Flags().GetString("registry")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	force, _ := cmd.Flags().GetBool("force")
+
+	if registry == "" {
+		registry = defaultRegistry(dir)
+	}
+
+	result, err := nimbulconfig.Import(dir, nimbulconfig.ImportOptions{Registry: registry})
+	if err != nil {
+		return err
+	}
+
+	configYAML, err := nimbulconfig.Marshal(result.Config)
+	if err != nil {
+		return err
+	}
+
+	files := map[string][]byte{"nimbul.yaml": configYAML}
+	for manifestPath, manifest := range result.Manifests {
+		files[manifestPath] = manifest
+	}
+
+	paths := make([]string, 0, len(files))
+	for p := range files {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	grayStyle := lipgloss.NewStyle().Foreground(grayColor)
+
+	if dryRun {
+		for _, p := range paths {
+			fmt.Println(grayStyle.Render("# " + p))
+			fmt.Println(string(files[p]))
+		}
+	} else {
+		if !force {
+			for _, p := range paths {
+				if _, err := os.Stat(filepath.Join(dir, p)); err == nil {
+					return fmt.Errorf("%s already exists; use --force to overwrite or --dry-run to preview", p)
+				}
+			}
+		}
+
+		for _, p := range paths {
+			fullPath := filepath.Join(dir, filepath.FromSlash(p))
+			if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+				return fmt.Errorf("failed to create directory for %s: %w", p, err)
+			}
+			if err := os.WriteFile(fullPath, files[p], 0644); err != nil {
+				return fmt.Errorf("failed to write %s: %w", p, err)
+			}
+		}
+
+		fmt.Println(successStyle.Render(fmt.Sprintf("✓ Imported %d build(s) from %s", len(result.Config.Build), result.Source)))
+		for _, p := range paths {
+			fmt.Printf("  %s\n", p)
+		}
+	}
+
+	if len(result.Notes) > 0 {
+		fmt.Println()
+		fmt.Println(labelStyle.Render("Review before committing:"))
+		for _, note := range result.Notes {
+			fmt.Printf("  - %s\n", note)
+		}
+	}
+
+	if !dryRun {
+		fmt.Println()
+		fmt.Println(grayStyle.Render("Commit and push these files, then run 'nimbul init' to create the config."))
+	}
+
+	return nil
+}
+
+// defaultRegistry returns ghcr.io/<owner> for the GitHub origin remote of dir, or "" when there is none
+func defaultRegistry(dir string) string {
+	gitCmd := exec.Command("git", "remote", "get-url", "origin")
+	gitCmd.Dir = dir
+	output, err := gitCmd.Output()
+	if err != nil {
+		return ""
+	}
+
+	owner, _ := parseGitHubRemote(strings.TrimSpace(string(output)))
+	if owner == "" {
+		return ""
+	}
+
+	return "ghcr.io/" + strings.ToLower(owner)
+}
@@ -3,29 +3,71 @@ package cli
 import (
 	"context"
 	"crypto/rand"
-	"encoding/base64"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"net/url"
 	"os"
 	"os/exec"
+	"slices"
+	"sort"
 	"strings"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/coding-cave-dev/nimbul/internal/github"
 	"github.com/coding-cave-dev/nimbul/internal/nimbulconfig"
-	"github.com/coding-cave-dev/nimbul/internal/sdk"
+	"github.com/coding-cave-dev/nimbul/pkg/nimbul"
 	"github.com/spf13/cobra"
 )
 
 var initCmd = &cobra.Command{
 	Use:   "init",
 	Short: "Initialize Nimbul for your repository",
-	Long:  `Initialize Nimbul to watch your repository and build Docker images on commits`,
-	RunE:  initExec,
+	Long: `Initialize Nimbul to watch your repository and build Docker images on commits.
+
+The repository is detected from the git remotes of the working directory; with
+several, init asks which one to use. Remotes on GitHub Enterprise hosts are
+recognized once listed in NIMBUL_GITHUB_HOSTS (comma separated) or under
+github_hosts in the config file.
+
+A repository has at most one config per user. When it already has one, init
+fails with its ID; pass --adopt to use that config instead, setting up its
+webhook if it has none.
+
+When init fails after creating the config, the config is remembered: --resume
+continues with its webhook, and --cleanup deletes the configs init left half
+set up.
+
+Before creating the config, init checks that nimbul.yaml and the Dockerfile of
+each of its builds exist on the default branch, or on the branch given with
+--branch when you deploy from another one. The first push is checked again.
+
+A repository needs a nimbul.yaml before init can create its config. --create-pr
+opens a pull request against the origin repository adding one, generated from its
+CI configuration or Dockerfiles like 'nimbul import' does, so it can go through
+code review; --dockerfile also adds a starter Dockerfile when there is none. Run
+init again once the pull request is merged.`,
+	RunE: initExec,
 }
 
+var (
+	initAdopt      bool
+	initResume     string
+	initCleanup    bool
+	initCreatePR   bool
+	initDockerfile bool
+	initBranch     string
+)
+
 func init() {
+	initCmd.Flags().BoolVar(&initAdopt, "adopt", false, "Use the repository's existing config instead of failing")
+	initCmd.Flags().StringVar(&initResume, "resume", "", "Finish setting up a config an earlier init created")
+	initCmd.Flags().BoolVar(&initCleanup, "cleanup", false, "Delete the configs earlier inits left without a webhook")
+	initCmd.Flags().StringVar(&initBranch, "branch", "", "Branch to check nimbul.yaml and the Dockerfiles on (default: the repository's default branch)")
+	initCmd.Flags().BoolVar(&initCreatePR, "create-pr", false, "Open a pull request adding a generated nimbul.yaml instead of creating a config")
+	initCmd.Flags().BoolVar(&initDockerfile, "dockerfile", false, "With --create-pr, also add a starter Dockerfile when the repository has none")
 	rootCmd.AddCommand(initCmd)
 }
 
@@ -33,34 +75,87 @@ type initState struct {
 	authToken           string
 	userID              string
 	providers           []string
+	providersLoaded     bool
 	currentRepo         *gitRepo
+	detectedRepos       []gitRepo // repositories of the git remotes of the working directory
+	gitDetected         bool
+	remoteCursor        int // index into detectedRepos, or len(detectedRepos) for another repository
 	availableRepos      []githubRepo
 	selectedRepo        *githubRepo
 	repoSelectionCursor int
 	confirmRepoCursor   int // 0 = Yes, 1 = No
 	nimbulConfig        *nimbulconfig.NimbulConfig
-	webhookSecret       string
 	configID            string
+	adopted             bool // configID is a config that existed before init ran
+	setupSteps          []setupStep
+	spinning            bool
+	spinnerFrame        int
+	webhookRetryCursor  int  // 0 = Retry, 1 = Skip
+	webhookSkipped      bool // the config was set up without its webhook
 	step                string
 	err                 error
 }
 
+// Steps shown while the config and its webhook are set up
+const (
+	setupStepConfig = iota
+	setupStepWebhook
+)
+
+type setupStepStatus int
+
+const (
+	setupPending setupStepStatus = iota
+	setupRunning
+	setupDone
+	setupFailed
+)
+
+type setupStep struct {
+	title  string
+	status setupStepStatus
+	err    error
+}
+
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+type spinnerTickMsg struct{}
+
 type gitRepo struct {
-	owner string
-	name  string
-	url   string
+	remote string
+	host   string
+	owner  string
+	name   string
+	url    string
 }
 
 type githubRepo = github.Repository
 
+// label names the repository, with its host unless it is github.com
+func (r *gitRepo) label() string {
+	if r.host == "github.com" {
+		return r.owner + "/" + r.name
+	}
+	return r.host + "/" + r.owner + "/" + r.name
+}
+
+func (r *gitRepo) githubRepo() *githubRepo {
+	return &githubRepo{
+		Owner:    r.owner,
+		Name:     r.name,
+		FullName: r.owner + "/" + r.name,
+		CloneURL: r.url,
+	}
+}
+
 type providersLoadedMsg struct {
 	providers []string
 	err       error
 }
 
 type gitRepoDetectedMsg struct {
-	repo *gitRepo
-	err  error
+	repos []gitRepo
+	err   error
 }
 
 type githubReposLoadedMsg struct {
@@ -82,6 +177,19 @@ type nimbulConfigValidatedMsg struct {
 }
 
 func initExec(cmd *cobra.Command, args []string) error {
+	if initCleanup && (initResume != "" || initAdopt) {
+		return usageErrorf("--cleanup cannot be combined with --resume or --adopt")
+	}
+	if initResume != "" && initAdopt {
+		return usageErrorf("--resume cannot be combined with --adopt")
+	}
+	if initCreatePR && (initCleanup || initResume != "" || initAdopt) {
+		return usageErrorf("--create-pr cannot be combined with --cleanup, --resume or --adopt")
+	}
+	if initDockerfile && !initCreatePR {
+		return usageErrorf("--dockerfile requires --create-pr")
+	}
+
 	// Check login
 	token, err := loadToken()
 	if err != nil {
@@ -89,7 +197,7 @@ func initExec(cmd *cobra.Command, args []string) error {
 	}
 
 	if token == "" {
-		return fmt.Errorf("not logged in. Please run 'nimbul login' first")
+		return errNotLoggedIn
 	}
 
 	// Get SDK client
@@ -99,12 +207,8 @@ func initExec(cmd *cobra.Command, args []string) error {
 	}
 
 	ctx := context.Background()
-	authHeader := fmt.Sprintf("Bearer %s", token)
-	params := &sdk.GetMeParams{
-		Authorization: &authHeader,
-	}
 
-	resp, err := client.GetMeWithResponse(ctx, params)
+	resp, err := client.GetMeWithResponse(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("failed to make request: %w", err)
 	}
@@ -117,12 +221,29 @@ func initExec(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("empty response body")
 	}
 
+	if initCleanup {
+		return initCleanupExec(client)
+	}
+
+	if initCreatePR {
+		return initCreatePRExec(client)
+	}
+
 	state := &initState{
 		authToken: token,
 		userID:    resp.JSON200.Id,
 		step:      "loading",
 	}
 
+	if initResume != "" {
+		done, err := resumeInitState(client, state, initResume)
+		if err != nil || done {
+			return err
+		}
+	} else {
+		printPendingInits()
+	}
+
 	p := tea.NewProgram(initModel{
 		state:  state,
 		client: client,
@@ -139,12 +260,77 @@ func initExec(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// initCreatePRExec opens a pull request adding a generated nimbul.yaml to the origin repository
+func initCreatePRExec(client *nimbul.ClientWithResponses) error {
+	gitCmd := exec.Command("git", "remote", "get-url", "origin")
+	output, err := gitCmd.Output()
+	if err != nil {
+		return usageErrorf("--create-pr needs a git repository with an origin remote")
+	}
+
+	owner, name := parseGitHubRemote(strings.TrimSpace(string(output)))
+	if owner == "" {
+		return usageErrorf("--create-pr needs an origin remote on github.com")
+	}
+
+	fmt.Printf("Generating nimbul.yaml for %s/%s...\n", owner, name)
+
+	resp, err := client.PostGithubReposByOwnerByRepoBootstrapWithResponse(context.Background(), owner, name, nil, nimbul.BootstrapRepositoryRequestBody{
+		Dockerfile: &initDockerfile,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to open pull request: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to open pull request", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	if resp.JSON200 == nil {
+		return fmt.Errorf("empty response body")
+	}
+
+	fmt.Println(successStyle.Render(fmt.Sprintf("✓ Opened pull request #%d", resp.JSON200.Number)))
+	fmt.Println(resp.JSON200.Url)
+	if resp.JSON200.Files != nil {
+		fmt.Println()
+		for _, file := range *resp.JSON200.Files {
+			fmt.Printf("  %s\n", file)
+		}
+	}
+
+	if resp.JSON200.Notes != nil && len(*resp.JSON200.Notes) > 0 {
+		fmt.Println()
+		fmt.Println(labelStyle.Render("Review before merging:"))
+		for _, note := range *resp.JSON200.Notes {
+			fmt.Printf("  - %s\n", note)
+		}
+	}
+
+	fmt.Println()
+	fmt.Println(lipgloss.NewStyle().Foreground(grayColor).Render("Merge the pull request, then run 'nimbul init' again to create the config."))
+	return nil
+}
+
+// initBranchLabel names the branch init checks files on
+func initBranchLabel() string {
+	if initBranch == "" {
+		return "the default branch"
+	}
+	return "branch " + initBranch
+}
+
 type initModel struct {
 	state  *initState
-	client *sdk.ClientWithResponses
+	client *nimbul.ClientWithResponses
 }
 
 func (m initModel) Init() tea.Cmd {
+	// A resumed init starts at the webhook
+	if m.state.step == "setup" {
+		return m.runSetupStep(setupStepWebhook, m.setupWebhook())
+	}
+
 	return tea.Batch(
 		m.loadProviders,
 		m.detectGitRepo,
@@ -153,12 +339,8 @@ func (m initModel) Init() tea.Cmd {
 
 func (m initModel) loadProviders() tea.Msg {
 	ctx := context.Background()
-	authHeader := fmt.Sprintf("Bearer %s", m.state.authToken)
-	params := &sdk.GetProvidersParams{
-		Authorization: &authHeader,
-	}
 
-	resp, err := m.client.GetProvidersWithResponse(ctx, params)
+	resp, err := m.client.GetProvidersWithResponse(ctx, nil)
 	if err != nil {
 		return providersLoadedMsg{err: fmt.Errorf("failed to load providers: %w", err)}
 	}
@@ -185,62 +367,104 @@ func (m initModel) loadProviders() tea.Msg {
 	return providersLoadedMsg{providers: *resp.JSON200.Providers}
 }
 
+// detectGitRepo finds the GitHub repositories of the git remotes of the working
+// directory, origin first
 func (m initModel) detectGitRepo() tea.Msg {
 	cwd, err := os.Getwd()
 	if err != nil {
 		return gitRepoDetectedMsg{err: err}
 	}
 
-	// Check if current directory is a git repo
-	cmd := exec.Command("git", "remote", "get-url", "origin")
+	cmd := exec.Command("git", "remote")
 	cmd.Dir = cwd
 	output, err := cmd.Output()
 	if err != nil {
-		// Not a git repo or no origin remote
+		// Not a git repo
 		return gitRepoDetectedMsg{}
 	}
 
-	remoteURL := strings.TrimSpace(string(output))
-	fmt.Println("remoteURL", remoteURL)
-
-	// Parse git URL to get owner/repo
-	// Handle both https://github.com/owner/repo.git and git@github.com:owner/repo.git
-	var owner, repo string
-	if strings.Contains(remoteURL, "github.com") {
-		parts := strings.Split(remoteURL, "github.com")
-		if len(parts) > 1 {
-			path := strings.Trim(parts[1], "/:")
-			path = strings.TrimSuffix(path, ".git")
-			pathParts := strings.Split(path, "/")
-			if len(pathParts) >= 2 {
-				owner = pathParts[0]
-				repo = pathParts[1]
-			}
+	remotes := strings.Fields(string(output))
+	sort.SliceStable(remotes, func(i, j int) bool {
+		return remotes[i] == "origin" && remotes[j] != "origin"
+	})
+
+	githubHosts := getGitHubHosts()
+	seen := make(map[string]bool)
+	var repos []gitRepo
+	for _, remote := range remotes {
+		cmd := exec.Command("git", "remote", "get-url", remote)
+		cmd.Dir = cwd
+		output, err := cmd.Output()
+		if err != nil {
+			continue
+		}
+
+		remoteURL := strings.TrimSpace(string(output))
+		host, owner, name := parseGitRemote(remoteURL)
+		if owner == "" || name == "" || !slices.Contains(githubHosts, host) {
+			continue
+		}
+
+		// Remotes of the same repository, e.g. over HTTPS and SSH, are offered once
+		key := strings.ToLower(host + "/" + owner + "/" + name)
+		if seen[key] {
+			continue
 		}
+		seen[key] = true
+
+		repos = append(repos, gitRepo{
+			remote: remote,
+			host:   host,
+			owner:  owner,
+			name:   name,
+			url:    remoteURL,
+		})
 	}
 
-	if owner != "" && repo != "" {
-		return gitRepoDetectedMsg{
-			repo: &gitRepo{
-				owner: owner,
-				name:  repo,
-				url:   remoteURL,
-			},
+	return gitRepoDetectedMsg{repos: repos}
+}
+
+// parseGitRemote extracts the host, owner and repo from a git remote URL. Handles
+// https://host/owner/repo.git, ssh://git@host:22/owner/repo.git and the scp-like
+// git@host:owner/repo.git.
+func parseGitRemote(remoteURL string) (host, owner, repo string) {
+	var path string
+	if u, err := url.Parse(remoteURL); err == nil && u.Scheme != "" && u.Host != "" {
+		host = u.Hostname()
+		path = u.Path
+	} else if at, rest, ok := strings.Cut(remoteURL, ":"); ok && !strings.Contains(at, "/") {
+		// scp-like syntax, with or without a user
+		_, host, _ = strings.Cut(at, "@")
+		if host == "" {
+			host = at
 		}
+		path = rest
+	} else {
+		return "", "", ""
 	}
 
-	return gitRepoDetectedMsg{}
+	path = strings.TrimSuffix(strings.Trim(path, "/"), ".git")
+	parts := strings.Split(path, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", ""
+	}
+	return strings.ToLower(host), parts[0], parts[1]
+}
+
+// parseGitHubRemote extracts owner and repo from a github.com remote URL
+func parseGitHubRemote(remoteURL string) (owner, repo string) {
+	host, owner, repo := parseGitRemote(remoteURL)
+	if host != "github.com" {
+		return "", ""
+	}
+	return owner, repo
 }
 
 func (m initModel) loadGitHubRepos() tea.Msg {
 	// Get GitHub token from API using SDK
 	ctx := context.Background()
-	authHeader := fmt.Sprintf("Bearer %s", m.state.authToken)
-	params := &sdk.GetCredentialsGithubTokenParams{
-		Authorization: &authHeader,
-	}
 
-	tokenResp, err := m.client.GetCredentialsGithubTokenWithResponse(ctx, params)
+	tokenResp, err := m.client.GetCredentialsGithubTokenWithResponse(ctx, nil)
 	if err != nil {
 		return githubReposLoadedMsg{err: fmt.Errorf("failed to get GitHub token: %w", err)}
 	}
@@ -278,12 +502,8 @@ func (m initModel) validateNimbulConfig() tea.Cmd {
 	return func() tea.Msg {
 		// Get GitHub token from API using SDK
 		ctx := context.Background()
-		authHeader := fmt.Sprintf("Bearer %s", m.state.authToken)
-		params := &sdk.GetCredentialsGithubTokenParams{
-			Authorization: &authHeader,
-		}
 
-		tokenResp, err := m.client.GetCredentialsGithubTokenWithResponse(ctx, params)
+		tokenResp, err := m.client.GetCredentialsGithubTokenWithResponse(ctx, nil)
 		if err != nil {
 			return nimbulConfigValidatedMsg{
 				err: fmt.Errorf("failed to get GitHub token: %w", err),
@@ -316,8 +536,13 @@ func (m initModel) validateNimbulConfig() tea.Cmd {
 		// Use GitHub package to fetch nimbul.yaml file
 		ghClient := github.NewClient(ctx, tokenResp.JSON200.Token)
 
+		// Files are checked on the branch deploys come from; GitHub reads the default
+		// branch when no ref is given
+		owner, name := m.state.selectedRepo.Owner, m.state.selectedRepo.Name
+		where := initBranchLabel()
+
 		// Check if nimbul.yaml exists
-		exists, err := github.FileExists(ctx, ghClient, m.state.selectedRepo.Owner, m.state.selectedRepo.Name, "nimbul.yaml", "")
+		exists, err := github.FileExists(ctx, ghClient, owner, name, "nimbul.yaml", initBranch)
 		if err != nil {
 			return nimbulConfigValidatedMsg{
 				err: fmt.Errorf("failed to check nimbul.yaml existence: %w", err),
@@ -325,26 +550,18 @@ func (m initModel) validateNimbulConfig() tea.Cmd {
 		}
 		if !exists {
 			return nimbulConfigValidatedMsg{
-				err: fmt.Errorf("nimbul.yaml not found in repository. Please create a nimbul.yaml file in the root of your repository"),
+				err: fmt.Errorf("nimbul.yaml not found on %s. Please create a nimbul.yaml file in the root of your repository, or open a pull request adding one with 'nimbul init --create-pr'", where),
 			}
 		}
 
 		// Fetch file contents
-		fileContent, _, _, err := ghClient.Repositories.GetContents(ctx, m.state.selectedRepo.Owner, m.state.selectedRepo.Name, "nimbul.yaml", nil)
+		content, err := github.GetFileContents(ctx, ghClient, owner, name, "nimbul.yaml", initBranch)
 		if err != nil {
 			return nimbulConfigValidatedMsg{
 				err: fmt.Errorf("failed to fetch nimbul.yaml: %w", err),
 			}
 		}
 
-		// Decode base64 content
-		content, err := base64.StdEncoding.DecodeString(*fileContent.Content)
-		if err != nil {
-			return nimbulConfigValidatedMsg{
-				err: fmt.Errorf("failed to decode nimbul.yaml content: %w", err),
-			}
-		}
-
 		// Parse config
 		config, err := nimbulconfig.ParseBytes(content)
 		if err != nil {
@@ -360,10 +577,26 @@ func (m initModel) validateNimbulConfig() tea.Cmd {
 			}
 		}
 
-		// Check that at least one build is defined
-		if len(config.Build) == 0 {
+		// Builds read their Dockerfile from nimbul.yaml, so each must exist next to it
+		var missing []string
+		for _, build := range config.Build {
+			// Paths with templates are only known once a push renders them
+			if strings.Contains(build.Dockerfile, "{{") {
+				continue
+			}
+			exists, err := github.FileExists(ctx, ghClient, owner, name, build.Dockerfile, initBranch)
+			if err != nil {
+				return nimbulConfigValidatedMsg{
+					err: fmt.Errorf("failed to check the Dockerfile of build %s: %w", build.Name, err),
+				}
+			}
+			if !exists {
+				missing = append(missing, fmt.Sprintf("%s (build %s)", build.Dockerfile, build.Name))
+			}
+		}
+		if len(missing) > 0 {
 			return nimbulConfigValidatedMsg{
-				err: fmt.Errorf("nimbul.yaml must define at least one build configuration"),
+				err: fmt.Errorf("nimbul.yaml names Dockerfiles that do not exist on %s: %s", where, strings.Join(missing, ", ")),
 			}
 		}
 
@@ -383,10 +616,16 @@ func (m initModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		// Handle keyboard input based on current step
 		switch m.state.step {
+		case "select_remote":
+			return m.handleRemoteSelectionKeys(msg)
 		case "confirm_repo":
 			return m.handleConfirmRepoKeys(msg)
 		case "select_repo":
 			return m.handleRepoSelectionKeys(msg)
+		case "setup":
+			if m.state.setupSteps[setupStepWebhook].status == setupFailed {
+				return m.handleWebhookRetryKeys(msg)
+			}
 		}
 
 		return m, nil
@@ -397,15 +636,14 @@ func (m initModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, tea.Quit
 		}
 		m.state.providers = msg.providers
+		m.state.providersLoaded = true
 		return m, m.checkProvidersAndContinue()
 
 	case gitRepoDetectedMsg:
-		if msg.err != nil {
-			// Ignore errors, just continue without current repo
-			return m, nil
-		}
-		m.state.currentRepo = msg.repo
-		return m, nil
+		// Errors are ignored, init continues without a detected repo
+		m.state.detectedRepos = msg.repos
+		m.state.gitDetected = true
+		return m, m.checkProvidersAndContinue()
 
 	case githubReposLoadedMsg:
 		if msg.err != nil {
@@ -429,12 +667,7 @@ func (m initModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case confirmRepoMsg:
 		if msg.useCurrent {
 			// Use current repo, convert to githubRepo format
-			m.state.selectedRepo = &githubRepo{
-				Owner:    m.state.currentRepo.owner,
-				Name:     m.state.currentRepo.name,
-				FullName: fmt.Sprintf("%s/%s", m.state.currentRepo.owner, m.state.currentRepo.name),
-				CloneURL: m.state.currentRepo.url,
-			}
+			m.state.selectedRepo = m.state.currentRepo.githubRepo()
 			m.state.step = "validating"
 			return m, m.validateNimbulConfig()
 		} else {
@@ -451,30 +684,70 @@ func (m initModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		// Config is valid, store it and proceed with config creation
 		m.state.nimbulConfig = msg.config
-		return m, m.createConfig()
+		m.state.step = "setup"
+		m.state.setupSteps = []setupStep{
+			{title: "Create config"},
+			{title: "Set up GitHub webhook"},
+		}
+		return m, m.runSetupStep(setupStepConfig, m.createConfig())
 
 	case configCreatedMsg:
 		if msg.err != nil {
+			m.state.setupSteps[setupStepConfig].status = setupFailed
 			m.state.err = msg.err
 			return m, tea.Quit
 		}
 		m.state.configID = msg.configID
-		m.state.webhookSecret = msg.webhookSecret
-		return m, m.setupWebhook()
+		m.state.adopted = msg.adopted
+		m.state.setupSteps[setupStepConfig].status = setupDone
+		// Remembered until the webhook is set up, so a failed init can be resumed. An
+		// adopted config predates init, so cleaning up after init must not delete it.
+		if !msg.adopted {
+			if err := recordInitProgress(msg.configID, m.state.selectedRepo.FullName); err != nil {
+				fmt.Printf("Warning: %v\n", err)
+			}
+		}
+		if msg.adopted {
+			m.state.setupSteps[setupStepConfig].title = "Use existing config"
+		}
+		return m, m.runSetupStep(setupStepWebhook, m.setupWebhook())
 
 	case webhookSetupMsg:
 		if msg.err != nil {
-			m.state.err = msg.err
-			return m, tea.Quit
+			// The config exists by now, so the user may retry or finish without the webhook
+			m.state.setupSteps[setupStepWebhook].status = setupFailed
+			m.state.setupSteps[setupStepWebhook].err = msg.err
+			m.state.webhookRetryCursor = 0
+			return m, nil
 		}
+		m.state.setupSteps[setupStepWebhook].status = setupDone
 		m.state.step = "complete"
+		if err := finishInitProgress(m.state.configID); err != nil {
+			fmt.Printf("Warning: %v\n", err)
+		}
 		return m, tea.Quit
+
+	case spinnerTickMsg:
+		for _, step := range m.state.setupSteps {
+			if step.status == setupRunning {
+				m.state.spinnerFrame = (m.state.spinnerFrame + 1) % len(spinnerFrames)
+				return m, spinnerTick()
+			}
+		}
+		m.state.spinning = false
+		return m, nil
 	}
 
 	return m, nil
 }
 
+// checkProvidersAndContinue moves on once the providers are loaded and the git
+// remotes are detected, whichever finishes last
 func (m initModel) checkProvidersAndContinue() tea.Cmd {
+	if !m.state.providersLoaded || !m.state.gitDetected {
+		return nil
+	}
+
 	// Check if GitHub is connected
 	hasGitHub := false
 	for _, p := range m.state.providers {
@@ -489,8 +762,16 @@ func (m initModel) checkProvidersAndContinue() tea.Cmd {
 		return tea.Quit
 	}
 
+	// With several remotes, let the user pick one of them
+	if len(m.state.detectedRepos) > 1 {
+		m.state.step = "select_remote"
+		m.state.remoteCursor = 0
+		return nil
+	}
+
 	// If we have a current repo, ask if they want to use it
-	if m.state.currentRepo != nil {
+	if len(m.state.detectedRepos) == 1 {
+		m.state.currentRepo = &m.state.detectedRepos[0]
 		m.state.step = "confirm_repo"
 		m.state.confirmRepoCursor = 0 // Default to Yes
 		return nil
@@ -502,6 +783,31 @@ func (m initModel) checkProvidersAndContinue() tea.Cmd {
 	}
 }
 
+func (m initModel) handleRemoteSelectionKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// The last option is another repository than those of the remotes
+	options := len(m.state.detectedRepos) + 1
+
+	switch msg.Type {
+	case tea.KeyUp:
+		m.state.remoteCursor = (m.state.remoteCursor + options - 1) % options
+		return m, nil
+	case tea.KeyDown:
+		m.state.remoteCursor = (m.state.remoteCursor + 1) % options
+		return m, nil
+	case tea.KeyEnter:
+		if m.state.remoteCursor < len(m.state.detectedRepos) {
+			m.state.currentRepo = &m.state.detectedRepos[m.state.remoteCursor]
+			return m, func() tea.Msg {
+				return confirmRepoMsg{useCurrent: true}
+			}
+		}
+		return m, func() tea.Msg {
+			return confirmRepoMsg{useCurrent: false}
+		}
+	}
+	return m, nil
+}
+
 func (m initModel) handleConfirmRepoKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.Type {
 	case tea.KeyUp, tea.KeyDown:
@@ -541,10 +847,91 @@ func (m initModel) handleRepoSelectionKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd)
 	return m, nil
 }
 
+func (m initModel) handleWebhookRetryKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyUp, tea.KeyDown:
+		m.state.webhookRetryCursor = 1 - m.state.webhookRetryCursor // Toggle between 0 and 1
+		return m, nil
+	case tea.KeyEnter:
+		if m.state.webhookRetryCursor == 0 {
+			m.state.setupSteps[setupStepWebhook].err = nil
+			return m, m.runSetupStep(setupStepWebhook, m.setupWebhook())
+		}
+		m.state.webhookSkipped = true
+		m.state.step = "complete"
+		return m, tea.Quit
+	}
+	return m, nil
+}
+
+// runSetupStep marks a setup step as running and starts it, along with the spinner
+// unless it is already turning
+func (m initModel) runSetupStep(index int, cmd tea.Cmd) tea.Cmd {
+	m.state.setupSteps[index].status = setupRunning
+	if m.state.spinning {
+		return cmd
+	}
+	m.state.spinning = true
+	return tea.Batch(cmd, spinnerTick())
+}
+
+func spinnerTick() tea.Cmd {
+	return tea.Tick(100*time.Millisecond, func(time.Time) tea.Msg {
+		return spinnerTickMsg{}
+	})
+}
+
+// resumeInitState prepares state to continue an init of configID at its webhook.
+// Reports whether there is nothing left to do.
+func resumeInitState(client *nimbul.ClientWithResponses, state *initState, configID string) (bool, error) {
+	resp, err := client.GetConfigsByIdWithResponse(context.Background(), configID, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to get config: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		err := apiError("failed to get config", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+		if errors.Is(err, nimbul.ErrNotFound) {
+			// Nothing to resume, and nothing to clean up either
+			_ = finishInitProgress(configID)
+		}
+		return false, err
+	}
+
+	if resp.JSON200 == nil {
+		return false, fmt.Errorf("empty response body")
+	}
+
+	config := resp.JSON200.Config
+	if resp.JSON200.Webhook.GithubWebhookId != nil {
+		if err := finishInitProgress(config.Id); err != nil {
+			return false, err
+		}
+		fmt.Println(successStyle.Render(fmt.Sprintf("✓ Config %s for %s is already set up", config.Id, config.RepoFullName)))
+		return true, nil
+	}
+
+	state.selectedRepo = &githubRepo{
+		Owner:    config.RepoOwner,
+		Name:     config.RepoName,
+		FullName: config.RepoFullName,
+		CloneURL: config.RepoCloneUrl,
+	}
+	state.configID = config.Id
+	// An earlier attempt may have created the webhook without the config learning of it
+	state.adopted = true
+	state.step = "setup"
+	state.setupSteps = []setupStep{
+		{title: "Resume config " + config.Id, status: setupDone},
+		{title: "Set up GitHub webhook"},
+	}
+	return false, nil
+}
+
 type configCreatedMsg struct {
-	configID      string
-	webhookSecret string
-	err           error
+	configID string
+	adopted  bool
+	err      error
 }
 
 func (m initModel) createConfig() tea.Cmd {
@@ -556,21 +943,16 @@ func (m initModel) createConfig() tea.Cmd {
 		}
 		webhookSecret := hex.EncodeToString(secretBytes)
 
-		// Extract DockerfilePath from first build config for backward compatibility
-		var dockerfilePath string
+		// Extract DockerfilePath from first build config for backward compatibility;
+		// deploy-only configs have none
+		var dockerfilePath *string
 		if m.state.nimbulConfig != nil && len(m.state.nimbulConfig.Build) > 0 {
-			dockerfilePath = m.state.nimbulConfig.Build[0].Dockerfile
-		} else {
-			return configCreatedMsg{err: fmt.Errorf("no build configuration found in nimbul.yaml")}
+			dockerfilePath = &m.state.nimbulConfig.Build[0].Dockerfile
 		}
 
 		ctx := context.Background()
-		authHeader := fmt.Sprintf("Bearer %s", m.state.authToken)
-		params := &sdk.PostConfigsParams{
-			Authorization: &authHeader,
-		}
 
-		reqBody := sdk.CreateConfigRequestBody{
+		reqBody := nimbul.CreateConfigRequestBody{
 			Provider:       "github",
 			RepoOwner:      m.state.selectedRepo.Owner,
 			RepoName:       m.state.selectedRepo.Name,
@@ -580,12 +962,20 @@ func (m initModel) createConfig() tea.Cmd {
 			WebhookSecret:  webhookSecret,
 		}
 
-		resp, err := m.client.PostConfigsWithResponse(ctx, params, reqBody)
+		resp, err := m.client.PostConfigsWithResponse(ctx, nil, reqBody)
 		if err != nil {
 			return configCreatedMsg{err: fmt.Errorf("failed to create config: %w", err)}
 		}
 
 		if resp.StatusCode() != 200 {
+			conflict := nimbul.CheckResponse(resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+			if existingID, ok := nimbul.ExistingConfigID(conflict); ok {
+				if initAdopt {
+					return configCreatedMsg{configID: existingID, adopted: true}
+				}
+				return configCreatedMsg{err: usageErrorf("%s already has config %s; run 'nimbul init --adopt' to use it", m.state.selectedRepo.FullName, existingID)}
+			}
+
 			var errMsg string
 			if resp.ApplicationproblemJSONDefault != nil {
 				if resp.ApplicationproblemJSONDefault.Detail != nil {
@@ -605,8 +995,7 @@ func (m initModel) createConfig() tea.Cmd {
 		}
 
 		return configCreatedMsg{
-			configID:      resp.JSON200.ConfigId,
-			webhookSecret: webhookSecret,
+			configID: resp.JSON200.ConfigId,
 		}
 	}
 }
@@ -618,93 +1007,40 @@ type webhookSetupMsg struct {
 
 func (m initModel) setupWebhook() tea.Cmd {
 	return func() tea.Msg {
-		// Get GitHub token using SDK
-		ctx := context.Background()
-		authHeader := fmt.Sprintf("Bearer %s", m.state.authToken)
-		params := &sdk.GetCredentialsGithubTokenParams{
-			Authorization: &authHeader,
-		}
-
-		tokenResp, err := m.client.GetCredentialsGithubTokenWithResponse(ctx, params)
+		webhookID, err := setupGitHubWebhook(context.Background(), m.client, m.state.configID)
 		if err != nil {
-			return webhookSetupMsg{err: fmt.Errorf("failed to get GitHub token: %w", err)}
-		}
-
-		if tokenResp.StatusCode() != 200 {
-			var errMsg string
-			if tokenResp.ApplicationproblemJSONDefault != nil {
-				if tokenResp.ApplicationproblemJSONDefault.Detail != nil {
-					errMsg = *tokenResp.ApplicationproblemJSONDefault.Detail
-				} else if tokenResp.ApplicationproblemJSONDefault.Title != nil {
-					errMsg = *tokenResp.ApplicationproblemJSONDefault.Title
-				}
-			}
-			if errMsg == "" {
-				errMsg = fmt.Sprintf("status %d", tokenResp.StatusCode())
+			// An adopted config may already have its webhook
+			if m.state.adopted && errors.Is(err, nimbul.ErrConflict) {
+				return webhookSetupMsg{}
 			}
-			return webhookSetupMsg{err: fmt.Errorf("failed to get GitHub token: %s", errMsg)}
-		}
-
-		if tokenResp.JSON200 == nil {
-			return webhookSetupMsg{err: fmt.Errorf("empty token response")}
-		}
-
-		// Get installation ID using user token
-		installationID, err := github.GetUserInstallationID(ctx, tokenResp.JSON200.Token)
-		if err != nil {
-			return webhookSetupMsg{err: fmt.Errorf("failed to get installation ID: %w", err)}
-		}
-
-		// Create GitHub app auth with installation ID
-		appAuth, err := github.NewAppAuth(installationID)
-		if err != nil {
-			return webhookSetupMsg{err: fmt.Errorf("failed to create app auth: %w", err)}
-		}
-
-		// Get installation client for creating webhook
-		installClient, err := appAuth.GetInstallationClient(ctx)
-		if err != nil {
-			return webhookSetupMsg{err: fmt.Errorf("failed to get installation client: %w", err)}
+			return webhookSetupMsg{err: err}
 		}
 
-		// Get API base URL for webhook URL
-		apiBaseURL := getAPIBaseURL()
-		webhookURL := fmt.Sprintf("%s/webhooks/github/%s", apiBaseURL, m.state.configID)
+		return webhookSetupMsg{webhookID: webhookID}
+	}
+}
 
-		// Setup webhook via GitHub API using app installation auth
-		webhookID, err := github.CreateWebhook(ctx, installClient, m.state.selectedRepo.Owner, m.state.selectedRepo.Name, webhookURL, m.state.webhookSecret)
-		if err != nil {
-			return webhookSetupMsg{err: err}
-		}
+// setupGitHubWebhook has the API create the GitHub webhook that triggers builds of a
+// config, with the GitHub App credentials only the server holds
+func setupGitHubWebhook(ctx context.Context, client *nimbul.ClientWithResponses, configID string) (int64, error) {
+	// The server delivers to its public URL when it knows it, otherwise to the one used here
+	apiURL := getAPIBaseURL()
+	resp, err := client.PostConfigsByIdWebhookWithResponse(ctx, configID, nil, nimbul.PostConfigsByIdWebhookJSONRequestBody{
+		ApiUrl: &apiURL,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to create webhook: %w", err)
+	}
 
-		// Update config with webhook ID using SDK
-		updateParams := &sdk.PatchConfigsByIdWebhookParams{
-			Authorization: &authHeader,
-		}
-		updateBody := sdk.UpdateConfigWebhookRequestBody{
-			WebhookId: webhookID,
-		}
-		updateResp, err := m.client.PatchConfigsByIdWebhookWithResponse(ctx, m.state.configID, updateParams, updateBody)
-		if err != nil {
-			// Log error but don't fail - webhook was created successfully
-			fmt.Fprintf(os.Stderr, "Warning: Failed to update webhook ID: %v\n", err)
-		} else if updateResp.StatusCode() != 200 {
-			var errMsg string
-			if updateResp.ApplicationproblemJSONDefault != nil {
-				if updateResp.ApplicationproblemJSONDefault.Detail != nil {
-					errMsg = *updateResp.ApplicationproblemJSONDefault.Detail
-				} else if updateResp.ApplicationproblemJSONDefault.Title != nil {
-					errMsg = *updateResp.ApplicationproblemJSONDefault.Title
-				}
-			}
-			if errMsg == "" {
-				errMsg = fmt.Sprintf("status %d", updateResp.StatusCode())
-			}
-			fmt.Fprintf(os.Stderr, "Warning: Failed to update webhook ID: %s\n", errMsg)
-		}
+	if resp.StatusCode() != 200 {
+		return 0, apiError("failed to create webhook", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
 
-		return webhookSetupMsg{webhookID: webhookID}
+	if resp.JSON200 == nil {
+		return 0, fmt.Errorf("empty response body")
 	}
+
+	return resp.JSON200.WebhookId, nil
 }
 
 func (m initModel) View() string {
@@ -714,9 +1050,31 @@ func (m initModel) View() string {
 	case "loading":
 		s.WriteString(loadingStyle.Render("Loading providers and detecting git repository...\n"))
 
+	case "select_remote":
+		s.WriteString(titleStyle.Render("Select Remote\n\n"))
+		s.WriteString("The repository has several remotes. Which one should Nimbul build?\n\n")
+		for i, repo := range m.state.detectedRepos {
+			line := fmt.Sprintf("%s  %s", repo.remote, repo.label())
+			if i == m.state.remoteCursor {
+				s.WriteString(inputFocusedStyle.Render("  → " + line))
+				s.WriteString(" ✓")
+			} else {
+				s.WriteString(labelStyle.Render("    " + line))
+			}
+			s.WriteString("\n")
+		}
+		if m.state.remoteCursor == len(m.state.detectedRepos) {
+			s.WriteString(inputFocusedStyle.Render("  → Another repository"))
+			s.WriteString(" ✓")
+		} else {
+			s.WriteString(labelStyle.Render("    Another repository"))
+		}
+		s.WriteString("\n\n")
+		s.WriteString(lipgloss.NewStyle().Foreground(lightGray).Render("Use ↑↓ to navigate, Enter to select"))
+
 	case "confirm_repo":
 		s.WriteString(titleStyle.Render("Repository Detected\n\n"))
-		s.WriteString(fmt.Sprintf("Detected repository: %s/%s\n\n", m.state.currentRepo.owner, m.state.currentRepo.name))
+		s.WriteString(fmt.Sprintf("Detected repository: %s (remote %s)\n\n", m.state.currentRepo.label(), m.state.currentRepo.remote))
 		s.WriteString("Use this repository?\n\n")
 
 		yesStyle := labelStyle
@@ -782,12 +1140,45 @@ func (m initModel) View() string {
 	case "validating":
 		s.WriteString(titleStyle.Render("Validating Configuration\n\n"))
 		s.WriteString(fmt.Sprintf("Repository: %s\n\n", m.state.selectedRepo.FullName))
-		s.WriteString(loadingStyle.Render("Validating nimbul.yaml...\n"))
+		s.WriteString(loadingStyle.Render(fmt.Sprintf("Validating nimbul.yaml and Dockerfiles on %s...\n", initBranchLabel())))
+
+	case "setup":
+		s.WriteString(titleStyle.Render("Setting Up Nimbul\n\n"))
+		s.WriteString(fmt.Sprintf("Repository: %s\n\n", m.state.selectedRepo.FullName))
+		m.viewSetupSteps(&s)
+
+		if webhook := m.state.setupSteps[setupStepWebhook]; webhook.status == setupFailed {
+			s.WriteString(errorStyle.Render(fmt.Sprintf("%v", webhook.err)))
+			s.WriteString("\n\n")
+
+			retryStyle := labelStyle
+			skipStyle := labelStyle
+			if m.state.webhookRetryCursor == 0 {
+				retryStyle = inputFocusedStyle
+			} else {
+				skipStyle = inputFocusedStyle
+			}
+			s.WriteString(retryStyle.Render("  → Retry"))
+			s.WriteString("\n")
+			s.WriteString(skipStyle.Render("  → Skip, builds will not trigger until the webhook is set up"))
+			s.WriteString("\n\n")
+			s.WriteString(lipgloss.NewStyle().Foreground(lightGray).Render("Use ↑↓ to select, Enter to confirm"))
+		}
 
 	case "complete":
+		m.viewSetupSteps(&s)
 		s.WriteString(successStyle.Render("✓ Nimbul initialized successfully!\n\n"))
-		s.WriteString(fmt.Sprintf("Config ID: %s\n", m.state.configID))
-		s.WriteString("Webhook has been set up. Commits to your repository will trigger builds.\n")
+		if m.state.adopted {
+			s.WriteString(fmt.Sprintf("Config ID: %s (existing config)\n", m.state.configID))
+		} else {
+			s.WriteString(fmt.Sprintf("Config ID: %s\n", m.state.configID))
+		}
+		if m.state.webhookSkipped {
+			s.WriteString("The webhook was not set up, so commits will not trigger builds yet.\n")
+			s.WriteString(fmt.Sprintf("Run 'nimbul init --resume %s' to set it up.\n", m.state.configID))
+		} else {
+			s.WriteString("Webhook has been set up. Commits to your repository will trigger builds.\n")
+		}
 
 	default:
 		if m.state.err != nil {
@@ -797,3 +1188,26 @@ func (m initModel) View() string {
 
 	return s.String()
 }
+
+// viewSetupSteps renders the setup steps with a spinner on the running one. The
+// shared styles have a top margin, so the steps are styled without one.
+func (m initModel) viewSetupSteps(s *strings.Builder) {
+	pendingStyle := lipgloss.NewStyle().Foreground(lightGray)
+	runningStyle := lipgloss.NewStyle().Foreground(grayColor)
+	doneStyle := lipgloss.NewStyle().Foreground(orangeColor).Bold(true)
+	failedStyle := lipgloss.NewStyle().Foreground(orangeColor).Italic(true)
+
+	for _, step := range m.state.setupSteps {
+		switch step.status {
+		case setupPending:
+			s.WriteString(pendingStyle.Render("  · " + step.title))
+		case setupRunning:
+			s.WriteString(runningStyle.Render(fmt.Sprintf("  %s %s...", spinnerFrames[m.state.spinnerFrame], step.title)))
+		case setupDone:
+			s.WriteString(doneStyle.Render("  ✓ " + step.title))
+		case setupFailed:
+			s.WriteString(failedStyle.Render("  ✗ " + step.title))
+		}
+		s.WriteString("\n")
+	}
+}
@@ -0,0 +1,217 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/coding-cave-dev/nimbul/pkg/nimbul"
+)
+
+// initProgress records a config 'nimbul init' created but did not finish setting up,
+// so it can be resumed or cleaned up later
+type initProgress struct {
+	ConfigID     string    `json:"config_id"`
+	RepoFullName string    `json:"repo_full_name"`
+	APIURL       string    `json:"api_url"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+func getInitProgressPath() string {
+	return filepath.Join(filepath.Dir(getTokenPath()), "init-progress.json")
+}
+
+// loadInitProgress returns the unfinished inits of every API URL
+func loadInitProgress() ([]initProgress, error) {
+	data, err := os.ReadFile(getInitProgressPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read init progress: %w", err)
+	}
+
+	var progress []initProgress
+	if err := json.Unmarshal(data, &progress); err != nil {
+		return nil, fmt.Errorf("failed to read init progress: %w", err)
+	}
+	return progress, nil
+}
+
+// pendingInits returns the unfinished inits against the current API URL
+func pendingInits() ([]initProgress, error) {
+	progress, err := loadInitProgress()
+	if err != nil {
+		return nil, err
+	}
+
+	apiURL := getAPIBaseURL()
+	var pending []initProgress
+	for _, p := range progress {
+		if p.APIURL == apiURL {
+			pending = append(pending, p)
+		}
+	}
+	return pending, nil
+}
+
+func saveInitProgress(progress []initProgress) error {
+	path := getInitProgressPath()
+	if len(progress) == 0 {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to write init progress: %w", err)
+		}
+		return nil
+	}
+
+	data, err := json.MarshalIndent(progress, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to write init progress: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("failed to write init progress: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write init progress: %w", err)
+	}
+	return nil
+}
+
+// recordInitProgress marks a config as created by init but not set up yet
+func recordInitProgress(configID, repoFullName string) error {
+	progress, err := loadInitProgress()
+	if err != nil {
+		return err
+	}
+
+	apiURL := getAPIBaseURL()
+	for _, p := range progress {
+		if p.ConfigID == configID && p.APIURL == apiURL {
+			return nil
+		}
+	}
+	progress = append(progress, initProgress{
+		ConfigID:     configID,
+		RepoFullName: repoFullName,
+		APIURL:       apiURL,
+		CreatedAt:    time.Now(),
+	})
+	return saveInitProgress(progress)
+}
+
+// finishInitProgress forgets a config once it is set up or gone
+func finishInitProgress(configID string) error {
+	progress, err := loadInitProgress()
+	if err != nil {
+		return err
+	}
+
+	apiURL := getAPIBaseURL()
+	kept := progress[:0]
+	for _, p := range progress {
+		if p.ConfigID != configID || p.APIURL != apiURL {
+			kept = append(kept, p)
+		}
+	}
+	return saveInitProgress(kept)
+}
+
+// printPendingInits points at configs earlier inits left half set up
+func printPendingInits() {
+	pending, err := pendingInits()
+	if err != nil || len(pending) == 0 {
+		return
+	}
+
+	style := lipgloss.NewStyle().Foreground(orangeColor)
+	for _, p := range pending {
+		fmt.Println(style.Render(fmt.Sprintf("⚠ Config %s for %s was created but its webhook was never set up", p.ConfigID, p.RepoFullName)))
+	}
+	fmt.Println(lipgloss.NewStyle().Foreground(grayColor).Render("Run 'nimbul init --resume <config-id>' to finish it, or 'nimbul init --cleanup' to delete it."))
+	fmt.Println()
+}
+
+// initCleanupExec deletes the configs earlier inits created but left without a webhook
+func initCleanupExec(client *nimbul.ClientWithResponses) error {
+	pending, err := pendingInits()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	var orphaned []initProgress
+	for _, p := range pending {
+		resp, err := client.GetConfigsByIdWithResponse(ctx, p.ConfigID, nil)
+		if err != nil {
+			return fmt.Errorf("failed to get config %s: %w", p.ConfigID, err)
+		}
+
+		if resp.StatusCode() != 200 {
+			err := apiError(fmt.Sprintf("failed to get config %s", p.ConfigID), resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+			if !errors.Is(err, nimbul.ErrNotFound) {
+				return err
+			}
+			// Deleted since, nothing left to clean up
+			if err := finishInitProgress(p.ConfigID); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if resp.JSON200 != nil && resp.JSON200.Webhook.GithubWebhookId != nil {
+			// Set up since, e.g. with 'nimbul init --adopt'
+			if err := finishInitProgress(p.ConfigID); err != nil {
+				return err
+			}
+			continue
+		}
+		orphaned = append(orphaned, p)
+	}
+
+	if len(orphaned) == 0 {
+		fmt.Println(successStyle.Render("✓ No half-initialized configs"))
+		return nil
+	}
+
+	fmt.Println(titleStyle.Render("Half-initialized configs"))
+	for _, p := range orphaned {
+		fmt.Printf("  %s  %s  %s\n", p.ConfigID, p.RepoFullName,
+			lipgloss.NewStyle().Foreground(grayColor).Render("created "+p.CreatedAt.Local().Format("2006-01-02 15:04")))
+	}
+	fmt.Println()
+	fmt.Print("Delete these configs? They stay in 'nimbul trash' until purged. [y/N]: ")
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil {
+		return fmt.Errorf("failed to read confirmation: %w", err)
+	}
+	if answer = strings.ToLower(strings.TrimSpace(answer)); answer != "y" && answer != "yes" {
+		return fmt.Errorf("cleanup aborted")
+	}
+
+	for _, p := range orphaned {
+		resp, err := client.DeleteConfigsByIdWithResponse(ctx, p.ConfigID, nil)
+		if err != nil {
+			return fmt.Errorf("failed to delete config %s: %w", p.ConfigID, err)
+		}
+		if resp.StatusCode() != 200 {
+			err := apiError(fmt.Sprintf("failed to delete config %s", p.ConfigID), resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+			if !errors.Is(err, nimbul.ErrNotFound) {
+				return err
+			}
+		}
+		if err := finishInitProgress(p.ConfigID); err != nil {
+			return err
+		}
+		fmt.Println(successStyle.Render(fmt.Sprintf("✓ Deleted config %s for %s", p.ConfigID, p.RepoFullName)))
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/coding-cave-dev/nimbul/pkg/nimbul"
+	"github.com/spf13/cobra"
+)
+
+var jobsCmd = &cobra.Command{
+	Use:   "jobs",
+	Short: "Inspect and requeue pipeline jobs that failed every attempt",
+	Long: `Pushes and image pushes run as jobs. A job failing for a reason retrying can fix,
+like GitHub or the cluster being unreachable, is retried; once every attempt
+failed it becomes a dead job, kept with its payload until it is requeued.
+Requeued jobs and rollbacks run before the jobs of webhooks waiting for a slot.
+
+Failed builds and invalid nimbul.yaml files are not retried; push a fix instead.`,
+}
+
+var jobsDeadCmd = &cobra.Command{
+	Use:   "dead",
+	Short: "List dead jobs of your configs",
+	Args:  cobra.NoArgs,
+	RunE:  jobsDeadExec,
+}
+
+var jobsRequeueCmd = &cobra.Command{
+	Use:   "requeue <job-id>",
+	Short: "Run a dead job again",
+	Args:  cobra.ExactArgs(1),
+	RunE:  jobsRequeueExec,
+}
+
+func init() {
+	jobsDeadCmd.Flags().Bool("all", false, "List the dead jobs of every user (admins only)")
+	jobsCmd.AddCommand(jobsDeadCmd)
+	jobsCmd.AddCommand(jobsRequeueCmd)
+	rootCmd.AddCommand(jobsCmd)
+}
+
+func jobsDeadExec(cmd *cobra.Command, args []string) error {
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	params := &nimbul.GetJobsDeadParams{}
+	if all, _ := cmd.Flags().GetBool("all"); all {
+		params.All = &all
+	}
+
+	resp, err := client.GetJobsDeadWithResponse(context.Background(), params)
+	if err != nil {
+		return fmt.Errorf("failed to list dead jobs: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to list dead jobs", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	if resp.JSON200 == nil || resp.JSON200.Jobs == nil || len(*resp.JSON200.Jobs) == 0 {
+		fmt.Println("No dead jobs")
+		return nil
+	}
+
+	grayStyle := lipgloss.NewStyle().Foreground(grayColor)
+
+	fmt.Println(titleStyle.Render("Dead jobs"))
+	for _, job := range *resp.JSON200.Jobs {
+		fmt.Printf("%-6d %-12s %s  %s\n", job.Id, job.Kind, job.ConfigId,
+			grayStyle.Render(fmt.Sprintf("failed %s after %d attempts", job.CreatedAt.Local().Format("2006-01-02 15:04:05"), job.Attempts)))
+		fmt.Printf("  %s\n", errorStyle.Render(job.Error))
+	}
+	fmt.Println()
+	fmt.Println(grayStyle.Render("Run 'nimbul jobs requeue <job-id>' to run one again"))
+
+	return nil
+}
+
+func jobsRequeueExec(cmd *cobra.Command, args []string) error {
+	jobID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return usageErrorf("invalid job ID %q", args[0])
+	}
+
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.PostJobsDeadByIdRequeueWithResponse(context.Background(), jobID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to requeue job: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to requeue job", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	fmt.Println(successStyle.Render(fmt.Sprintf("✓ Job %d requeued", jobID)))
+	fmt.Println(lipgloss.NewStyle().Foreground(grayColor).Render("It runs in the background; if it fails again it shows up in 'nimbul jobs dead' once more"))
+	return nil
+}
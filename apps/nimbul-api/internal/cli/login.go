@@ -1,18 +1,22 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/coding-cave-dev/nimbul/pkg/nimbul"
 	"github.com/spf13/cobra"
 )
 
 type loginModel struct {
 	email        string
 	password     string
-	focusedField int // 0 = email, 1 = password
+	focusedField int // 0 = email, 1 = password, 2 = two-factor code
+	code         string
+	twoFactor    bool // the account asked for a two-factor code
 	err          string
 	success      bool
 	loading      bool
@@ -24,6 +28,9 @@ type loginSuccessMsg struct {
 	token string
 }
 
+// loginTwoFactorMsg means the password was accepted and a two-factor code is needed
+type loginTwoFactorMsg struct{}
+
 type loginErrorMsg struct {
 	err string
 }
@@ -53,37 +60,55 @@ func (m loginModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				// Move to password field
 				m.focusedField = 1
 				return m, nil
+			} else if m.focusedField == 1 && m.twoFactor && msg.Type == tea.KeyTab {
+				// Move to two-factor code field
+				m.focusedField = 2
+				return m, nil
 			} else {
 				// Submit form
-				if m.email == "" || m.password == "" {
+				if m.email == "" || m.password == "" || (m.twoFactor && m.code == "") {
 					m.err = "Please fill in all fields"
 					return m, nil
 				}
 				m.loading = true
 				m.err = ""
-				return m, loginUser(m.email, m.password)
+				return m, loginUser(m.email, m.password, m.code)
 			}
 
 		case tea.KeyBackspace:
-			if m.focusedField == 0 {
+			switch m.focusedField {
+			case 0:
 				if len(m.email) > 0 {
 					m.email = m.email[:len(m.email)-1]
 				}
-			} else {
+			case 1:
 				if len(m.password) > 0 {
 					m.password = m.password[:len(m.password)-1]
 				}
+			case 2:
+				if len(m.code) > 0 {
+					m.code = m.code[:len(m.code)-1]
+				}
 			}
 
 		case tea.KeyRunes:
 			// Only process printable characters
-			if m.focusedField == 0 {
+			switch m.focusedField {
+			case 0:
 				m.email += string(msg.Runes)
-			} else {
+			case 1:
 				m.password += string(msg.Runes)
+			case 2:
+				m.code += string(msg.Runes)
 			}
 		}
 
+	case loginTwoFactorMsg:
+		m.loading = false
+		m.twoFactor = true
+		m.focusedField = 2
+		return m, nil
+
 	case loginSuccessMsg:
 		m.loading = false
 		m.success = true
@@ -141,6 +166,20 @@ func (m loginModel) View() string {
 	}
 	b.WriteString("\n")
 
+	// Two-factor code field, once the server asked for it
+	if m.twoFactor {
+		b.WriteString("\n")
+		b.WriteString(labelStyle.Render("Two-factor code (or recovery code):"))
+		b.WriteString("\n")
+
+		if m.focusedField == 2 {
+			b.WriteString(inputFocusedStyle.Render(m.code + "█"))
+		} else {
+			b.WriteString(inputStyle.Render(m.code))
+		}
+		b.WriteString("\n")
+	}
+
 	// Loading indicator
 	if m.loading {
 		b.WriteString(loadingStyle.Render("Logging in..."))
@@ -163,12 +202,15 @@ func (m loginModel) View() string {
 	return b.String()
 }
 
-func loginUser(email, password string) tea.Cmd {
+func loginUser(email, password, code string) tea.Cmd {
 	return func() tea.Msg {
-		resp, err := makeAuthRequest("/login", email, password)
+		resp, err := makeAuthRequest("/login", email, password, code)
 		if err != nil {
 			return loginErrorMsg{err: err.Error()}
 		}
+		if resp.TwoFactorRequired {
+			return loginTwoFactorMsg{}
+		}
 		return loginSuccessMsg{
 			email: resp.User.Email,
 			token: resp.Token,
@@ -179,15 +221,25 @@ func loginUser(email, password string) tea.Cmd {
 var loginCmd = &cobra.Command{
 	Use:   "login",
 	Short: "Login to your account",
-	Long:  `Login to your account with email and password. Your session token will be saved.`,
-	RunE:  loginExec,
+	Long: `Login to your account with email and password. Your session token will be saved.
+Accounts with two-factor authentication are asked for a code from their
+authenticator app, or one of their recovery codes.
+
+With --sso, sign in through the identity provider the server is configured with
+instead: approve the login in a browser and the session token is saved the same way.`,
+	RunE: loginExec,
 }
 
 func init() {
+	loginCmd.Flags().Bool("sso", false, "Sign in with the server's OIDC identity provider")
 	rootCmd.AddCommand(loginCmd)
 }
 
 func loginExec(cmd *cobra.Command, args []string) error {
+	if sso, _ := cmd.Flags().GetBool("sso"); sso {
+		return loginSSO()
+	}
+
 	model := loginModel{
 		focusedField: 0,
 	}
@@ -198,3 +250,59 @@ func loginExec(cmd *cobra.Command, args []string) error {
 	}
 	return nil
 }
+
+// loginSSO signs in with the device authorization grant of the server's identity provider
+func loginSSO() error {
+	client, err := getSDKClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	startResp, err := client.PostLoginOidcDeviceWithResponse(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start SSO login: %w", err)
+	}
+
+	if startResp.StatusCode() != 200 {
+		return apiError("failed to start SSO login", startResp.StatusCode(), startResp.ApplicationproblemJSONDefault)
+	}
+
+	if startResp.JSON200 == nil {
+		return fmt.Errorf("empty response body")
+	}
+
+	device := startResp.JSON200
+	fmt.Println(titleStyle.Render("Single sign-on"))
+	if device.VerificationUriComplete != nil && *device.VerificationUriComplete != "" {
+		fmt.Printf("Open %s and confirm the code %s\n", *device.VerificationUriComplete, device.UserCode)
+	} else {
+		fmt.Printf("Go to %s and enter code %s\n", device.VerificationUri, device.UserCode)
+	}
+	fmt.Println(lipgloss.NewStyle().Foreground(grayColor).Render("Waiting for you to approve the login..."))
+
+	tokenResp, err := client.PostLoginOidcTokenWithResponse(ctx, nimbul.CompleteOIDCLoginRequestBody{
+		DeviceCode: device.DeviceCode,
+		Interval:   device.Interval,
+		ExpiresAt:  device.ExpiresAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to complete SSO login: %w", err)
+	}
+
+	if tokenResp.StatusCode() != 200 {
+		return apiError("failed to complete SSO login", tokenResp.StatusCode(), tokenResp.ApplicationproblemJSONDefault)
+	}
+
+	if tokenResp.JSON200 == nil {
+		return fmt.Errorf("empty response body")
+	}
+
+	if err := saveToken(tokenResp.JSON200.Token); err != nil {
+		return fmt.Errorf("failed to save token: %w", err)
+	}
+
+	fmt.Println(successStyle.Render(fmt.Sprintf("✓ Successfully logged in! Email: %s\nToken saved.", tokenResp.JSON200.User.Email)))
+	return nil
+}
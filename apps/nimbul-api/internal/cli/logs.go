@@ -0,0 +1,218 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/coding-cave-dev/nimbul/pkg/nimbul"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+var logsCmd = &cobra.Command{
+	Use:   "logs <build-id>",
+	Short: "Show the log of a build",
+	Long: `Show the output of a build's steps. Logs are stored without ANSI escape
+sequences and up to a size limit; longer logs end with a truncation marker.
+
+With --follow, stream the log of a running build until it finishes, and exit
+non-zero unless it succeeded.
+
+In a terminal the log opens in a viewer that folds the output of each build step
+under a line with its state, searches the log (/, then n and N), jumps between
+failed steps (e and E) and saves the log to a file (s). --plain prints the log
+instead.`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeFirstArg(completeBuildIDs),
+	RunE:              logsExec,
+}
+
+var (
+	logsFollow bool
+	logsPlain  bool
+)
+
+func init() {
+	logsCmd.Flags().BoolVarP(&logsFollow, "follow", "f", false, "Stream the log until the build finishes")
+	logsCmd.Flags().BoolVar(&logsPlain, "plain", false, "Print the log instead of opening the viewer")
+	addNotifyFlag(logsCmd)
+	rootCmd.AddCommand(logsCmd)
+}
+
+func logsExec(cmd *cobra.Command, args []string) error {
+	buildID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return usageErrorf("invalid build ID %q", args[0])
+	}
+
+	interactive := !logsPlain && term.IsTerminal(int(os.Stdin.Fd())) && term.IsTerminal(int(os.Stdout.Fd()))
+
+	if logsFollow {
+		if err := checkNotifyMode(); err != nil {
+			return err
+		}
+		if interactive {
+			// The stream starts with the entries logged so far
+			return viewBuildLog(buildID, nil, true, true)
+		}
+		return followBuildLog(buildID)
+	}
+
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetBuildsByIdLogsWithResponse(context.Background(), buildID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get build log: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to get build log", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	if resp.JSON200 == nil {
+		return fmt.Errorf("empty response body")
+	}
+
+	if interactive {
+		var entries []nimbul.BuildLogEntryResponse
+		if resp.JSON200.Entries != nil {
+			entries = *resp.JSON200.Entries
+		}
+		return viewBuildLog(buildID, entries, resp.JSON200.Running, false)
+	}
+
+	if resp.JSON200.Entries != nil {
+		for _, entry := range *resp.JSON200.Entries {
+			printLogEntry(entry)
+		}
+	}
+	if resp.JSON200.Running {
+		fmt.Println(lipgloss.NewStyle().Foreground(grayColor).Render("Build is still running, follow it with --follow"))
+	}
+	return nil
+}
+
+// buildLogDone is the last event of a build log stream
+type buildLogDone struct {
+	Status    string `json:"status"`
+	Error     string `json:"error"`
+	Truncated bool   `json:"truncated"`
+}
+
+// followBuildLog prints the log stream of a build until the build finishes
+func followBuildLog(buildID int64) error {
+	done, err := streamBuildLog(context.Background(), buildID, printLogEntry)
+	if err == nil {
+		err = buildLogError(buildID, done)
+	}
+	notifyDone(fmt.Sprintf("Build %d", buildID), err)
+	if err != nil {
+		return err
+	}
+	fmt.Println(successStyle.Render("✓ Build succeeded"))
+	return nil
+}
+
+// buildLogError returns why a followed build did not succeed, nil when it did
+func buildLogError(buildID int64, done *buildLogDone) error {
+	switch done.Status {
+	case "succeeded":
+		return nil
+	case "":
+		return fmt.Errorf("build %d finished with an unknown status", buildID)
+	default:
+		return failedErrorf("build %d %s", buildID, done.Status)
+	}
+}
+
+// streamBuildLog passes each entry of the log stream of a build to onEntry until the
+// build finishes or ctx is canceled
+func streamBuildLog(ctx context.Context, buildID int64, onEntry func(nimbul.BuildLogEntryResponse)) (*buildLogDone, error) {
+	// The event stream is not part of the SDK, so the token is sent by hand
+	token, err := loadToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load token: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/builds/%d/logs/events", getAPIBaseURL(), buildID), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Accept", "text/event-stream")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to follow build log: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var problem nimbul.ErrorModel
+		if err := json.NewDecoder(resp.Body).Decode(&problem); err == nil {
+			return nil, apiError("failed to follow build log", resp.StatusCode, &problem)
+		}
+		return nil, fmt.Errorf("failed to follow build log: status %d", resp.StatusCode)
+	}
+
+	event := ""
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if name, ok := strings.CutPrefix(line, "event: "); ok {
+			event = name
+			continue
+		}
+		data, ok := strings.CutPrefix(line, "data: ")
+		if !ok {
+			continue
+		}
+
+		switch event {
+		case "log":
+			var entry nimbul.BuildLogEntryResponse
+			if err := json.Unmarshal([]byte(data), &entry); err == nil {
+				onEntry(entry)
+			}
+		case "done":
+			var done buildLogDone
+			if err := json.Unmarshal([]byte(data), &done); err != nil {
+				return nil, fmt.Errorf("invalid build log event: %w", err)
+			}
+			return &done, nil
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("lost the build log stream: %w", err)
+	}
+	return nil, fmt.Errorf("lost the build log stream")
+}
+
+func printLogEntry(entry nimbul.BuildLogEntryResponse) {
+	grayStyle := lipgloss.NewStyle().Foreground(grayColor)
+
+	switch entry.Stream {
+	case "status":
+		vertex := ""
+		if entry.Vertex != nil {
+			vertex = *entry.Vertex + " "
+		}
+		fmt.Println(grayStyle.Render("#" + vertex + entry.Message))
+	case "system":
+		fmt.Println(errorStyle.Render(entry.Message))
+	default:
+		fmt.Println(entry.Message)
+	}
+}
@@ -0,0 +1,548 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/coding-cave-dev/nimbul/pkg/nimbul"
+)
+
+// logViewHelp lists the keys of the log viewer
+const logViewHelp = "↑/↓ move · enter fold step · c/o fold/unfold all · / search · n/N next/previous match · e/E next/previous error · s save · q quit"
+
+var (
+	logViewCursorStyle = lipgloss.NewStyle().Foreground(orangeColor).Bold(true)
+	logViewStepStyle   = lipgloss.NewStyle().Bold(true)
+	logViewGrayStyle   = lipgloss.NewStyle().Foreground(grayColor)
+	logViewErrorStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("#F44336"))
+	logViewMatchStyle  = lipgloss.NewStyle().Reverse(true)
+)
+
+// logEntryMsg is an entry of the log stream of a followed build
+type logEntryMsg nimbul.BuildLogEntryResponse
+
+// logDoneMsg ends the log stream of a followed build
+type logDoneMsg struct {
+	done *buildLogDone
+	err  error
+}
+
+// viewBuildLog shows entries in the log viewer. With follow, the build's log stream is
+// added as it arrives, and the result is how the build finished.
+func viewBuildLog(buildID int64, entries []nimbul.BuildLogEntryResponse, running, follow bool) error {
+	model := newLogViewModel(buildID, entries)
+	model.running = running
+	model.following = follow
+
+	p := tea.NewProgram(model, tea.WithAltScreen())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if follow {
+		go func() {
+			done, err := streamBuildLog(ctx, buildID, func(entry nimbul.BuildLogEntryResponse) {
+				p.Send(logEntryMsg(entry))
+			})
+			p.Send(logDoneMsg{done: done, err: err})
+			// Notified while the viewer is still open, which is when the user is elsewhere
+			if ctx.Err() == nil {
+				if err == nil {
+					err = buildLogError(buildID, done)
+				}
+				notifyDone(fmt.Sprintf("Build %d", buildID), err)
+			}
+		}()
+	}
+
+	final, err := p.Run()
+	if err != nil {
+		return err
+	}
+
+	viewed := final.(logViewModel)
+	switch {
+	case viewed.streamErr != nil:
+		return viewed.streamErr
+	case viewed.done != nil:
+		if err := buildLogError(buildID, viewed.done); err != nil {
+			return err
+		}
+		fmt.Println(successStyle.Render("✓ Build succeeded"))
+	}
+	return nil
+}
+
+// logSection is the output of one build step, or an entry no build step logged
+type logSection struct {
+	vertex    string // empty for entries no build step logged
+	status    string // latest state of the build step, e.g. "done" or "error: ..."
+	entries   []int  // indexes into the viewer's entries, without state changes
+	collapsed bool
+}
+
+// logLine is a line of the log viewer: the header of a section or one of its entries
+type logLine struct {
+	section int
+	entry   int // index into the viewer's entries, -1 for the header
+}
+
+// logViewMode is what the keys typed into the log viewer go to
+type logViewMode int
+
+const (
+	logViewBrowse logViewMode = iota
+	logViewSearch
+	logViewSave
+)
+
+// logViewModel browses a build log, folding the output of each build step under a
+// header with its latest state
+type logViewModel struct {
+	buildID  int64
+	entries  []nimbul.BuildLogEntryResponse
+	sections []logSection
+	vertices map[string]int // section of each build step
+	lines    []logLine      // lines not folded away
+
+	cursor int // index into lines
+	offset int // first line shown
+	width  int
+	height int
+
+	mode    logViewMode
+	input   string
+	query   string
+	message string
+
+	running   bool // the build was still running when its log was fetched
+	following bool
+	done      *buildLogDone
+	streamErr error
+}
+
+func newLogViewModel(buildID int64, entries []nimbul.BuildLogEntryResponse) logViewModel {
+	m := logViewModel{buildID: buildID, vertices: make(map[string]int), height: 24, width: 80}
+	for _, entry := range entries {
+		m.addEntry(entry)
+	}
+	m.rebuild()
+	return m
+}
+
+// addEntry files an entry under the section of its build step
+func (m *logViewModel) addEntry(entry nimbul.BuildLogEntryResponse) {
+	m.entries = append(m.entries, entry)
+	index := len(m.entries) - 1
+
+	vertex := ""
+	if entry.Vertex != nil {
+		vertex = *entry.Vertex
+	}
+	if vertex == "" {
+		m.sections = append(m.sections, logSection{entries: []int{index}})
+		return
+	}
+
+	section, ok := m.vertices[vertex]
+	if !ok {
+		m.sections = append(m.sections, logSection{vertex: vertex})
+		section = len(m.sections) - 1
+		m.vertices[vertex] = section
+	}
+	if entry.Stream == "status" {
+		m.sections[section].status = entry.Message
+		return
+	}
+	m.sections[section].entries = append(m.sections[section].entries, index)
+}
+
+// allLines returns every line of the log, as if no section were folded
+func (m *logViewModel) allLines() []logLine {
+	var lines []logLine
+	for i, section := range m.sections {
+		if section.vertex != "" {
+			lines = append(lines, logLine{section: i, entry: -1})
+		}
+		for _, entry := range section.entries {
+			lines = append(lines, logLine{section: i, entry: entry})
+		}
+	}
+	return lines
+}
+
+// rebuild lists the lines not folded away, keeping the cursor on the line it was on
+func (m *logViewModel) rebuild() {
+	var current *logLine
+	if m.cursor < len(m.lines) {
+		line := m.lines[m.cursor]
+		current = &line
+	}
+	atEnd := len(m.lines) == 0 || m.cursor == len(m.lines)-1
+
+	m.lines = m.lines[:0]
+	for i, section := range m.sections {
+		if section.vertex != "" {
+			m.lines = append(m.lines, logLine{section: i, entry: -1})
+			if section.collapsed {
+				continue
+			}
+		}
+		for _, entry := range section.entries {
+			m.lines = append(m.lines, logLine{section: i, entry: entry})
+		}
+	}
+
+	switch {
+	case m.following && atEnd:
+		// Following the end of a running build's log
+		m.cursor = len(m.lines) - 1
+	case current != nil:
+		m.cursor = m.lineIndex(*current)
+	}
+	m.cursor = max(0, min(m.cursor, len(m.lines)-1))
+	m.scroll()
+}
+
+// lineIndex returns the index of line among the lines shown, or of the header of its
+// section when it is folded away
+func (m *logViewModel) lineIndex(line logLine) int {
+	for i, l := range m.lines {
+		if l == line {
+			return i
+		}
+	}
+	for i, l := range m.lines {
+		if l.section == line.section && l.entry == -1 {
+			return i
+		}
+	}
+	return m.cursor
+}
+
+// bodyHeight is how many lines of the log fit between the title and the footer
+func (m *logViewModel) bodyHeight() int {
+	return max(1, m.height-3)
+}
+
+// scroll keeps the cursor on screen
+func (m *logViewModel) scroll() {
+	if m.cursor < m.offset {
+		m.offset = m.cursor
+	}
+	if m.cursor >= m.offset+m.bodyHeight() {
+		m.offset = m.cursor - m.bodyHeight() + 1
+	}
+	m.offset = max(0, m.offset)
+}
+
+// jump moves the cursor to the next line matching in direction dir (1 or -1), wrapping
+// around and unfolding the section it lands in. Reports whether any line matched.
+func (m *logViewModel) jump(dir int, match func(line logLine) bool) bool {
+	all := m.allLines()
+	if len(all) == 0 {
+		return false
+	}
+
+	start := 0
+	if m.cursor < len(m.lines) {
+		current := m.lines[m.cursor]
+		for i, line := range all {
+			if line == current {
+				start = i
+				break
+			}
+		}
+	}
+
+	for step := 1; step <= len(all); step++ {
+		line := all[((start+dir*step)%len(all)+len(all))%len(all)]
+		if !match(line) {
+			continue
+		}
+		m.sections[line.section].collapsed = false
+		m.rebuild()
+		m.cursor = m.lineIndex(line)
+		m.scroll()
+		return true
+	}
+	return false
+}
+
+// matchesQuery reports whether a line contains the search query, ignoring case
+func (m *logViewModel) matchesQuery(line logLine) bool {
+	if m.query == "" {
+		return false
+	}
+	return strings.Contains(strings.ToLower(m.lineText(line)), strings.ToLower(m.query))
+}
+
+// isError reports whether a line is a failed build step or an error Nimbul logged
+func (m *logViewModel) isError(line logLine) bool {
+	if line.entry == -1 {
+		return strings.HasPrefix(m.sections[line.section].status, "error")
+	}
+	return m.entries[line.entry].Stream == "system"
+}
+
+// lineText is the unstyled text of a line
+func (m *logViewModel) lineText(line logLine) string {
+	if line.entry == -1 {
+		return m.sections[line.section].vertex
+	}
+	return m.entries[line.entry].Message
+}
+
+// save writes the whole log, unfolded, to a file
+func (m *logViewModel) save(path string) error {
+	var b strings.Builder
+	for _, entry := range m.entries {
+		if entry.Stream == "status" {
+			vertex := ""
+			if entry.Vertex != nil {
+				vertex = *entry.Vertex + " "
+			}
+			fmt.Fprintf(&b, "#%s%s\n", vertex, entry.Message)
+			continue
+		}
+		b.WriteString(entry.Message + "\n")
+	}
+	if err := os.WriteFile(path, []byte(b.String()), 0644); err != nil {
+		return fmt.Errorf("failed to save log: %w", err)
+	}
+	return nil
+}
+
+func (m logViewModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m logViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		m.scroll()
+		return m, nil
+	case logEntryMsg:
+		m.addEntry(nimbul.BuildLogEntryResponse(msg))
+		m.rebuild()
+		return m, nil
+	case logDoneMsg:
+		m.done, m.streamErr = msg.done, msg.err
+		m.running = false
+		if msg.err != nil {
+			m.message = msg.err.Error()
+		}
+		return m, nil
+	case tea.KeyMsg:
+		if m.mode != logViewBrowse {
+			return m.updateInput(msg)
+		}
+		return m.updateBrowse(msg)
+	}
+	return m, nil
+}
+
+// updateInput handles keys typed into the search or save prompt
+func (m logViewModel) updateInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyCtrlC:
+		return m, tea.Quit
+	case tea.KeyEsc:
+		m.mode = logViewBrowse
+	case tea.KeyEnter:
+		mode := m.mode
+		m.mode = logViewBrowse
+		switch mode {
+		case logViewSearch:
+			m.query = m.input
+			m.message = ""
+			if m.query != "" && !m.jump(1, m.matchesQuery) {
+				m.message = fmt.Sprintf("No line contains %q", m.query)
+			}
+		case logViewSave:
+			if err := m.save(m.input); err != nil {
+				m.message = err.Error()
+			} else {
+				m.message = fmt.Sprintf("✓ Saved %d lines to %s", len(m.entries), m.input)
+			}
+		}
+	case tea.KeyBackspace:
+		if m.input != "" {
+			runes := []rune(m.input)
+			m.input = string(runes[:len(runes)-1])
+		}
+	case tea.KeyRunes, tea.KeySpace:
+		m.input += string(msg.Runes)
+	}
+	return m, nil
+}
+
+// updateBrowse handles keys while browsing the log
+func (m logViewModel) updateBrowse(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	m.message = ""
+	switch msg.String() {
+	case "ctrl+c", "q", "esc":
+		return m, tea.Quit
+	case "up", "k":
+		m.cursor = max(0, m.cursor-1)
+	case "down", "j":
+		m.cursor = max(0, min(len(m.lines)-1, m.cursor+1))
+	case "pgup", "b":
+		m.cursor = max(0, m.cursor-m.bodyHeight())
+	case "pgdown", "f", " ":
+		m.cursor = max(0, min(len(m.lines)-1, m.cursor+m.bodyHeight()))
+	case "home", "g":
+		m.cursor = 0
+	case "end", "G":
+		m.cursor = max(0, len(m.lines)-1)
+	case "enter", "tab":
+		if m.cursor < len(m.lines) {
+			section := &m.sections[m.lines[m.cursor].section]
+			if section.vertex != "" {
+				section.collapsed = !section.collapsed
+				m.cursor = m.lineIndex(logLine{section: m.lines[m.cursor].section, entry: -1})
+				m.rebuild()
+			}
+		}
+	case "c", "o":
+		for i := range m.sections {
+			m.sections[i].collapsed = msg.String() == "c"
+		}
+		m.rebuild()
+	case "/":
+		m.mode, m.input = logViewSearch, ""
+	case "n", "N":
+		dir := 1
+		if msg.String() == "N" {
+			dir = -1
+		}
+		if m.query == "" {
+			m.message = "Search with / first"
+		} else if !m.jump(dir, m.matchesQuery) {
+			m.message = fmt.Sprintf("No line contains %q", m.query)
+		}
+	case "e", "E":
+		dir := 1
+		if msg.String() == "E" {
+			dir = -1
+		}
+		if !m.jump(dir, m.isError) {
+			m.message = "No errors in the log"
+		}
+	case "s":
+		m.mode, m.input = logViewSave, fmt.Sprintf("build-%d.log", m.buildID)
+	}
+	m.scroll()
+	return m, nil
+}
+
+func (m logViewModel) View() string {
+	var s strings.Builder
+
+	title := fmt.Sprintf("Log of build %d", m.buildID)
+	switch {
+	case m.done != nil:
+		title += " · " + statusStyle(m.done.Status).Render(m.done.Status)
+	case m.following && m.streamErr == nil:
+		title += logViewGrayStyle.Render(" · following")
+	case m.running:
+		title += logViewGrayStyle.Render(" · still running, follow it with --follow")
+	}
+	s.WriteString(logViewStepStyle.Foreground(orangeColor).Render(title))
+	s.WriteString("\n")
+
+	end := min(len(m.lines), m.offset+m.bodyHeight())
+	for i := m.offset; i < end; i++ {
+		s.WriteString(m.renderLine(m.lines[i], i == m.cursor))
+		s.WriteString("\n")
+	}
+	if len(m.lines) == 0 {
+		s.WriteString(logViewGrayStyle.Render("  The log is empty"))
+		s.WriteString("\n")
+		end++
+	}
+	for i := end - m.offset; i < m.bodyHeight(); i++ {
+		s.WriteString("\n")
+	}
+
+	s.WriteString("\n")
+	var footer string
+	switch {
+	case m.mode == logViewSearch:
+		footer = "/" + m.input + "█"
+	case m.mode == logViewSave:
+		footer = "Save to: " + m.input + "█"
+	case m.message != "":
+		footer = logViewCursorStyle.Render(m.message)
+	default:
+		footer = logViewGrayStyle.Render(logViewHelp)
+	}
+	s.WriteString(lipgloss.NewStyle().MaxWidth(max(1, m.width)).Render(footer))
+	return s.String()
+}
+
+// renderLine renders one line of the log, cut to the width of the terminal
+func (m logViewModel) renderLine(line logLine, selected bool) string {
+	prefix := "  "
+	if selected {
+		prefix = logViewCursorStyle.Render("› ")
+	}
+
+	var text string
+	if line.entry == -1 {
+		section := m.sections[line.section]
+		fold := "▾ "
+		if section.collapsed {
+			fold = "▸ "
+		}
+		text = fold + m.highlight(section.vertex, logViewStepStyle)
+		if section.status != "" {
+			statusStyle := logViewGrayStyle
+			if strings.HasPrefix(section.status, "error") {
+				statusStyle = logViewErrorStyle
+			}
+			text += "  " + statusStyle.Render(section.status)
+		}
+		if section.collapsed && len(section.entries) > 0 {
+			text += logViewGrayStyle.Render(fmt.Sprintf("  (%d lines)", len(section.entries)))
+		}
+	} else {
+		entry := m.entries[line.entry]
+		style := lipgloss.NewStyle()
+		if entry.Stream == "system" {
+			style = logViewErrorStyle
+		}
+		indent := ""
+		if m.sections[line.section].vertex != "" {
+			indent = "  "
+		}
+		text = indent + m.highlight(entry.Message, style)
+	}
+
+	return lipgloss.NewStyle().MaxWidth(max(1, m.width)).Render(prefix + text)
+}
+
+// highlight renders text with style, marking where it contains the search query
+func (m logViewModel) highlight(text string, style lipgloss.Style) string {
+	lower, query := strings.ToLower(text), strings.ToLower(m.query)
+	// Lowercasing changed the length, so the positions of matches are not known
+	if query == "" || len(lower) != len(text) {
+		return style.Render(text)
+	}
+
+	var b strings.Builder
+	for {
+		i := strings.Index(lower, query)
+		if i < 0 {
+			b.WriteString(style.Render(text))
+			return b.String()
+		}
+		b.WriteString(style.Render(text[:i]))
+		b.WriteString(logViewMatchStyle.Render(text[i : i+len(query)]))
+		text, lower = text[i+len(query):], lower[i+len(query):]
+	}
+}
@@ -5,15 +5,17 @@ import (
 	"fmt"
 
 	"github.com/charmbracelet/lipgloss"
-	"github.com/coding-cave-dev/nimbul/internal/sdk"
 	"github.com/spf13/cobra"
 )
 
 var meCmd = &cobra.Command{
 	Use:   "me",
 	Short: "Display current user information",
-	Long:  `Display information about the currently logged-in user.`,
-	RunE:  meExec,
+	Long: `Display information about the currently logged-in user.
+
+Change your email or password with 'nimbul me update', or delete your account
+with 'nimbul me delete'.`,
+	RunE: meExec,
 }
 
 func init() {
@@ -28,7 +30,7 @@ func meExec(cmd *cobra.Command, args []string) error {
 	}
 
 	if token == "" {
-		return fmt.Errorf("not logged in. Please run 'nimbul login' first")
+		return errNotLoggedIn
 	}
 
 	// Get SDK client
@@ -39,29 +41,14 @@ func meExec(cmd *cobra.Command, args []string) error {
 
 	// Make authenticated request
 	ctx := context.Background()
-	authHeader := fmt.Sprintf("Bearer %s", token)
-	params := &sdk.GetMeParams{
-		Authorization: &authHeader,
-	}
 
-	resp, err := client.GetMeWithResponse(ctx, params)
+	resp, err := client.GetMeWithResponse(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("failed to make request: %w", err)
 	}
 
 	if resp.StatusCode() != 200 {
-		if resp.ApplicationproblemJSONDefault != nil {
-			detail := ""
-			if resp.ApplicationproblemJSONDefault.Detail != nil {
-				detail = *resp.ApplicationproblemJSONDefault.Detail
-			} else if resp.ApplicationproblemJSONDefault.Title != nil {
-				detail = *resp.ApplicationproblemJSONDefault.Title
-			}
-			if detail != "" {
-				return fmt.Errorf("%s", detail)
-			}
-		}
-		return fmt.Errorf("request failed with status %d", resp.StatusCode())
+		return apiError("failed to get user", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
 	}
 
 	if resp.JSON200 == nil {
@@ -89,5 +76,18 @@ func meExec(cmd *cobra.Command, args []string) error {
 	fmt.Printf("%s%s\n", labelStyle.Render("Email:"), valueStyle.Render(resp.JSON200.Email))
 	fmt.Printf("%s%s\n", labelStyle.Render("ID:"), valueStyle.Render(resp.JSON200.Id))
 
+	// Warn about credentials that will stop webhook builds from working
+	if resp.JSON200.ExpiringCredentials != nil {
+		for _, credential := range *resp.JSON200.ExpiringCredentials {
+			state := "expires"
+			if credential.Expired {
+				state = "expired"
+			}
+			fmt.Println()
+			fmt.Println(errorStyle.Render(fmt.Sprintf("⚠ Your %s %s credential %s %s. Run 'nimbul connect' to renew it.",
+				credential.Provider, credential.TokenType, state, credential.ExpiresAt.Local().Format("2006-01-02 15:04"))))
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,198 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/coding-cave-dev/nimbul/pkg/nimbul"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+var notificationsCmd = &cobra.Command{
+	Use:   "notifications",
+	Short: "Show or change which notifications Nimbul sends you",
+	Long: `Show your notification preferences, or change them with flags:
+
+  nimbul notifications --build-failed=false
+  nimbul notifications --slack-webhook https://hooks.slack.com/services/...
+
+Notifications are emailed and, with a Slack webhook set, also posted to Slack.`,
+	Args: cobra.NoArgs,
+	RunE: notificationsExec,
+}
+
+var resetPasswordCmd = &cobra.Command{
+	Use:   "reset-password",
+	Short: "Reset a forgotten password",
+	Long: `Request a password reset email with --email, then set a new password with the
+token from that email:
+
+  nimbul reset-password --email you@example.com
+  nimbul reset-password --token <token>`,
+	Args: cobra.NoArgs,
+	RunE: resetPasswordExec,
+}
+
+func init() {
+	notificationsCmd.Flags().Bool("build-failed", true, "Email when a build fails")
+	notificationsCmd.Flags().Bool("deploy-failed", true, "Email when a deploy fails")
+	notificationsCmd.Flags().Bool("credential-expiry", true, "Email before a stored credential expires")
+	notificationsCmd.Flags().String("slack-webhook", "", "Slack incoming webhook URL to also notify (empty to remove)")
+	rootCmd.AddCommand(notificationsCmd)
+
+	resetPasswordCmd.Flags().String("email", "", "Email of the account to reset")
+	resetPasswordCmd.Flags().String("token", "", "Reset token from the password reset email")
+	resetPasswordCmd.MarkFlagsMutuallyExclusive("email", "token")
+	rootCmd.AddCommand(resetPasswordCmd)
+}
+
+func notificationsExec(cmd *cobra.Command, args []string) error {
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	getResp, err := client.GetMeNotificationsWithResponse(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get notification preferences: %w", err)
+	}
+
+	if getResp.StatusCode() != 200 {
+		return apiError("failed to get notification preferences", getResp.StatusCode(), getResp.ApplicationproblemJSONDefault)
+	}
+
+	if getResp.JSON200 == nil {
+		return fmt.Errorf("empty response body")
+	}
+
+	prefs := *getResp.JSON200
+
+	// Only flags given on the command line change a preference
+	flags := cmd.Flags()
+	if flags.Changed("build-failed") || flags.Changed("deploy-failed") || flags.Changed("credential-expiry") || flags.Changed("slack-webhook") {
+		if flags.Changed("build-failed") {
+			prefs.BuildFailed, _ = flags.GetBool("build-failed")
+		}
+		if flags.Changed("deploy-failed") {
+			prefs.DeployFailed, _ = flags.GetBool("deploy-failed")
+		}
+		if flags.Changed("credential-expiry") {
+			prefs.CredentialExpiry, _ = flags.GetBool("credential-expiry")
+		}
+		if flags.Changed("slack-webhook") {
+			slackWebhook, _ := flags.GetString("slack-webhook")
+			prefs.SlackWebhookUrl = &slackWebhook
+		}
+
+		putResp, err := client.PutMeNotificationsWithResponse(ctx, nil, nimbul.NotificationPreferencesBody{
+			BuildFailed:      prefs.BuildFailed,
+			DeployFailed:     prefs.DeployFailed,
+			CredentialExpiry: prefs.CredentialExpiry,
+			SlackWebhookUrl:  prefs.SlackWebhookUrl,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update notification preferences: %w", err)
+		}
+
+		if putResp.StatusCode() != 200 {
+			return apiError("failed to update notification preferences", putResp.StatusCode(), putResp.ApplicationproblemJSONDefault)
+		}
+
+		if putResp.JSON200 != nil {
+			prefs = *putResp.JSON200
+		}
+
+		fmt.Println(successStyle.Render("✓ Notification preferences updated"))
+		fmt.Println()
+	}
+
+	fmt.Println(titleStyle.Render("Notifications"))
+	fmt.Printf("%s %s\n", labelStyle.Render("Build failed:     "), onOff(prefs.BuildFailed))
+	fmt.Printf("%s %s\n", labelStyle.Render("Deploy failed:    "), onOff(prefs.DeployFailed))
+	fmt.Printf("%s %s\n", labelStyle.Render("Credential expiry:"), onOff(prefs.CredentialExpiry))
+
+	slack := "not set"
+	if prefs.SlackWebhookUrl != nil && *prefs.SlackWebhookUrl != "" {
+		slack = *prefs.SlackWebhookUrl
+	}
+	fmt.Printf("%s %s\n", labelStyle.Render("Slack webhook:    "), slack)
+
+	return nil
+}
+
+func resetPasswordExec(cmd *cobra.Command, args []string) error {
+	email, _ := cmd.Flags().GetString("email")
+	token, _ := cmd.Flags().GetString("token")
+	if email == "" && token == "" {
+		return usageErrorf("either --email or --token is required")
+	}
+
+	client, err := getSDKClient()
+	if err != nil {
+		return fmt.Errorf("failed to create API client: %w", err)
+	}
+
+	ctx := context.Background()
+
+	if email != "" {
+		resp, err := client.PostPasswordResetWithResponse(ctx, nimbul.RequestPasswordResetRequestBody{
+			Email: email,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to request password reset: %w", err)
+		}
+
+		if resp.StatusCode() != 200 {
+			return apiError("failed to request password reset", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+		}
+
+		fmt.Println(successStyle.Render("✓ If an account exists for " + email + ", a reset email is on its way"))
+		fmt.Println("Then run: nimbul reset-password --token <token>")
+		return nil
+	}
+
+	fmt.Print("New password: ")
+	password, err := term.ReadPassword(int(os.Stdin.Fd()))
+	fmt.Println()
+	if err != nil {
+		return fmt.Errorf("failed to read password: %w", err)
+	}
+
+	fmt.Print("Confirm password: ")
+	confirm, err := term.ReadPassword(int(os.Stdin.Fd()))
+	fmt.Println()
+	if err != nil {
+		return fmt.Errorf("failed to read password: %w", err)
+	}
+
+	if string(password) != string(confirm) {
+		return usageErrorf("passwords do not match")
+	}
+
+	resp, err := client.PostPasswordResetConfirmWithResponse(ctx, nimbul.ResetPasswordRequestBody{
+		Token:    token,
+		Password: string(password),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to reset password: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to reset password", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	fmt.Println(successStyle.Render("✓ Password updated. Log in with 'nimbul login'"))
+	return nil
+}
+
+// onOff renders a boolean preference
+func onOff(enabled bool) string {
+	if enabled {
+		return successStyle.Render("on")
+	}
+	return errorStyle.Render("off")
+}
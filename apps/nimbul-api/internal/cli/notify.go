@@ -0,0 +1,118 @@
+package cli
+
+import (
+	"cmp"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+)
+
+// How the user is told that a wait finished, picked with --notify, NIMBUL_NOTIFY or
+// notify in the config file
+const (
+	notifyOff     = "off"
+	notifyBell    = "bell"
+	notifyDesktop = "desktop" // a desktop notification and the bell
+)
+
+var notifyFlag string
+
+// addNotifyFlag adds --notify to a command that waits for a build or deployment.
+// --notify alone asks for a desktop notification.
+func addNotifyFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&notifyFlag, "notify", "", "Notify when the wait is over: bell, or desktop for a desktop notification and the bell")
+	cmd.Flags().Lookup("notify").NoOptDefVal = notifyDesktop
+}
+
+// getNotifyMode returns how the user is told that a wait finished
+func getNotifyMode() string {
+	return strings.ToLower(cmp.Or(notifyFlag, os.Getenv("NIMBUL_NOTIFY"), loadConfig().Notify, notifyOff))
+}
+
+// checkNotifyMode rejects an unknown notify setting before the wait starts rather than
+// after it
+func checkNotifyMode() error {
+	switch mode := getNotifyMode(); mode {
+	case notifyOff, notifyBell, notifyDesktop:
+		return nil
+	default:
+		return usageErrorf("unknown notify setting %q, use off, bell or desktop", mode)
+	}
+}
+
+// notifyDone tells the user that the wait for subject is over, e.g. "Build 42", and
+// how it went, so they can look elsewhere during long builds and rollouts
+func notifyDone(subject string, err error) {
+	mode := getNotifyMode()
+	if mode != notifyBell && mode != notifyDesktop {
+		return
+	}
+
+	fmt.Fprint(os.Stderr, "\a")
+	if mode != notifyDesktop {
+		return
+	}
+
+	message := subject + " succeeded"
+	if err != nil {
+		message = capitalize(err.Error())
+	}
+	if err := desktopNotification("Nimbul", message); err != nil {
+		fmt.Fprintln(os.Stderr, lipgloss.NewStyle().Foreground(grayColor).Render(fmt.Sprintf("Could not show a desktop notification: %v", err)))
+	}
+}
+
+// desktopNotification shows a notification with the tools the desktop comes with
+func desktopNotification(title, message string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		// Passed as arguments, so neither needs quoting for AppleScript
+		cmd = exec.Command("osascript",
+			"-e", "on run argv",
+			"-e", "display notification (item 2 of argv) with title (item 1 of argv)",
+			"-e", "end run",
+			title, message)
+	case "windows":
+		cmd = exec.Command("powershell", "-NoProfile", "-NonInteractive", "-Command", windowsNotificationScript)
+		cmd.Env = append(os.Environ(), "NIMBUL_NOTIFICATION_TITLE="+title, "NIMBUL_NOTIFICATION_MESSAGE="+message)
+		// The balloon tip is shown until the script exits, which the CLI does not wait for
+		if err := cmd.Start(); err != nil {
+			return err
+		}
+		return cmd.Process.Release()
+	default:
+		cmd = exec.Command("notify-send", "--app-name=nimbul", title, message)
+	}
+
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%s: %w: %s", cmd.Args[0], err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+// windowsNotificationScript shows a balloon tip with the title and message of the
+// environment for a few seconds
+const windowsNotificationScript = `Add-Type -AssemblyName System.Windows.Forms
+$icon = New-Object System.Windows.Forms.NotifyIcon
+$icon.Icon = [System.Drawing.SystemIcons]::Information
+$icon.Visible = $true
+$icon.ShowBalloonTip(10000, $env:NIMBUL_NOTIFICATION_TITLE, $env:NIMBUL_NOTIFICATION_MESSAGE, 'Info')
+Start-Sleep -Seconds 10
+$icon.Dispose()`
+
+// capitalize upper-cases the first letter of an error message for display on its own
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	r, size := utf8.DecodeRuneInString(s)
+	return string(unicode.ToUpper(r)) + s[size:]
+}
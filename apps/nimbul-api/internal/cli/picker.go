@@ -0,0 +1,224 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/coding-cave-dev/nimbul/pkg/nimbul"
+	"golang.org/x/term"
+)
+
+// configPickerHeight is how many configs the picker shows at once
+const configPickerHeight = 10
+
+// resolveConfigID returns the config ID given as the first argument of a command or,
+// without one, lets the user pick one of their configs
+func resolveConfigID(client *nimbul.ClientWithResponses, args []string) (string, error) {
+	if len(args) > 0 {
+		return args[0], nil
+	}
+
+	if !term.IsTerminal(int(os.Stdin.Fd())) || !term.IsTerminal(int(os.Stdout.Fd())) {
+		return "", usageErrorf("a config ID is required when not running in a terminal")
+	}
+
+	// Configs last listed are offered while the API cannot be reached
+	configList, _, err := fetchCached("configs", false, func() ([]nimbul.ConfigResponse, error) {
+		resp, err := client.GetConfigsWithResponse(context.Background(), nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list configs: %w", err)
+		}
+
+		if resp.StatusCode() != 200 {
+			return nil, apiError("failed to list configs", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+		}
+
+		if resp.JSON200 == nil || resp.JSON200.Configs == nil {
+			return nil, nil
+		}
+		return *resp.JSON200.Configs, nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	switch len(configList) {
+	case 0:
+		return "", fmt.Errorf("no configs yet. Create one with 'nimbul init' in a repository")
+	case 1:
+		config := configList[0]
+		fmt.Println(lipgloss.NewStyle().Foreground(grayColor).Render(fmt.Sprintf("Using config %s (%s)", config.Id, config.RepoFullName)))
+		return config.Id, nil
+	}
+
+	model := newConfigPickerModel(configList)
+	final, err := tea.NewProgram(model).Run()
+	if err != nil {
+		return "", err
+	}
+
+	picked := final.(configPickerModel)
+	if picked.canceled || len(picked.matches) == 0 {
+		return "", usageErrorf("no config picked")
+	}
+	return picked.configs[picked.matches[picked.cursor]].Id, nil
+}
+
+// configPickerModel lets the user pick a config, narrowing the list by typing part of
+// its repository name or ID
+type configPickerModel struct {
+	configs  []nimbul.ConfigResponse
+	query    string
+	matches  []int // indexes into configs, best match first
+	cursor   int
+	canceled bool
+}
+
+func newConfigPickerModel(configs []nimbul.ConfigResponse) configPickerModel {
+	m := configPickerModel{configs: configs}
+	m.filter()
+	return m
+}
+
+func (m configPickerModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m configPickerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	switch keyMsg.Type {
+	case tea.KeyCtrlC, tea.KeyEsc:
+		m.canceled = true
+		return m, tea.Quit
+	case tea.KeyUp:
+		if m.cursor > 0 {
+			m.cursor--
+		} else if len(m.matches) > 0 {
+			m.cursor = len(m.matches) - 1
+		}
+	case tea.KeyDown:
+		if m.cursor < len(m.matches)-1 {
+			m.cursor++
+		} else {
+			m.cursor = 0
+		}
+	case tea.KeyEnter:
+		if len(m.matches) > 0 {
+			return m, tea.Quit
+		}
+	case tea.KeyBackspace:
+		if m.query != "" {
+			runes := []rune(m.query)
+			m.query = string(runes[:len(runes)-1])
+			m.filter()
+		}
+	case tea.KeyRunes, tea.KeySpace:
+		m.query += string(keyMsg.Runes)
+		m.filter()
+	}
+	return m, nil
+}
+
+func (m configPickerModel) View() string {
+	var s strings.Builder
+
+	s.WriteString(titleStyle.Render("Pick a config\n\n"))
+	s.WriteString(labelStyle.Render("Search: "))
+	s.WriteString(inputFocusedStyle.Render(m.query + "█"))
+	s.WriteString("\n\n")
+
+	if len(m.matches) == 0 {
+		s.WriteString(labelStyle.Render("    No config matches"))
+		s.WriteString("\n")
+	}
+
+	// Only a window around the cursor fits on screen
+	start := max(0, min(m.cursor-configPickerHeight/2, len(m.matches)-configPickerHeight))
+	end := min(len(m.matches), start+configPickerHeight)
+	for i := start; i < end; i++ {
+		config := m.configs[m.matches[i]]
+		line := fmt.Sprintf("%s  %s", config.RepoFullName, lipgloss.NewStyle().Foreground(grayColor).Render(config.Id))
+		if i == m.cursor {
+			s.WriteString(inputFocusedStyle.Render("  → ") + line)
+		} else {
+			s.WriteString(labelStyle.Render("    ") + line)
+		}
+		s.WriteString("\n")
+	}
+	if len(m.matches) > end-start {
+		s.WriteString(labelStyle.Render(fmt.Sprintf("    %d of %d configs", end-start, len(m.matches))))
+		s.WriteString("\n")
+	}
+
+	s.WriteString("\n")
+	s.WriteString(lipgloss.NewStyle().Foreground(lightGray).Render("Type to search, use ↑↓ to navigate, Enter to pick, Esc to cancel"))
+	s.WriteString("\n")
+
+	return s.String()
+}
+
+// filter matches the configs against the query, best match first
+func (m *configPickerModel) filter() {
+	type match struct {
+		index int
+		score int
+	}
+
+	var found []match
+	for i, config := range m.configs {
+		if score, ok := fuzzyScore(m.query, config.RepoFullName+" "+config.Id); ok {
+			found = append(found, match{index: i, score: score})
+		}
+	}
+	sort.SliceStable(found, func(a, b int) bool {
+		return found[a].score > found[b].score
+	})
+
+	m.matches = make([]int, len(found))
+	for i, f := range found {
+		m.matches[i] = f.index
+	}
+	m.cursor = 0
+}
+
+// fuzzyScore reports whether the characters of query appear in order in text, ignoring
+// case and spaces, and scores the match: characters following each other or starting
+// a word score higher
+func fuzzyScore(query, text string) (int, bool) {
+	queryRunes := []rune(strings.ToLower(strings.ReplaceAll(query, " ", "")))
+	textRunes := []rune(strings.ToLower(text))
+
+	score := 0
+	matched := 0
+	previous := -2
+	for i, r := range textRunes {
+		if matched == len(queryRunes) {
+			break
+		}
+		if r != queryRunes[matched] {
+			continue
+		}
+
+		score++
+		if i == previous+1 {
+			score += 2
+		}
+		if i == 0 || !unicode.IsLetter(textRunes[i-1]) && !unicode.IsDigit(textRunes[i-1]) {
+			score += 3
+		}
+		previous = i
+		matched++
+	}
+
+	return score, matched == len(queryRunes)
+}
@@ -0,0 +1,119 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/coding-cave-dev/nimbul/pkg/nimbul"
+	"github.com/spf13/cobra"
+)
+
+var pipelineCmd = &cobra.Command{
+	Use:   "pipeline <build-id>",
+	Short: "Show the stages of the pipeline run a build belongs to",
+	Long: `Show the stages of the pipeline run a build belongs to, with their status and
+how long they took. A push clones the repository, runs the builds of nimbul.yaml
+and then its deploys; a deploy runs after the build it names, or after every build.`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeFirstArg(completeBuildIDs),
+	RunE:              pipelineExec,
+}
+
+func init() {
+	rootCmd.AddCommand(pipelineCmd)
+}
+
+func pipelineExec(cmd *cobra.Command, args []string) error {
+	buildID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return usageErrorf("invalid build ID %q", args[0])
+	}
+
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetBuildsByIdPipelineWithResponse(context.Background(), buildID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get pipeline: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to get pipeline", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	if resp.JSON200 == nil {
+		return fmt.Errorf("empty response body")
+	}
+
+	grayStyle := lipgloss.NewStyle().Foreground(grayColor)
+
+	pipeline := resp.JSON200
+	commit := pipeline.CommitSha
+	if len(commit) > 12 {
+		commit = commit[:12]
+	}
+	fmt.Println(titleStyle.Render(fmt.Sprintf("Pipeline %d: %s on %s", pipeline.Id, commit, pipeline.Ref)))
+	fmt.Printf("%s %s\n", stageIcon(pipeline.Status), pipelineStatus(pipeline.Status))
+
+	if pipeline.Stages == nil {
+		return nil
+	}
+
+	width := 0
+	for _, stage := range *pipeline.Stages {
+		width = max(width, len(stage.Name))
+	}
+
+	for _, stage := range *pipeline.Stages {
+		line := fmt.Sprintf("%s %-*s  %-10s %8s", stageIcon(stage.Status), width, stage.Name, stage.Status, stageDuration(stage))
+		if stage.Needs != nil && len(*stage.Needs) > 0 {
+			line += grayStyle.Render("  after " + strings.Join(*stage.Needs, ", "))
+		}
+		fmt.Println(line)
+		if stage.Error != nil {
+			fmt.Println(errorStyle.Render("    " + *stage.Error))
+		}
+	}
+
+	return nil
+}
+
+// pipelineStatus describes the status of a pipeline run
+func pipelineStatus(status string) string {
+	if status == "partial" {
+		return "partially failed: some stages failed, the stages independent of them ran"
+	}
+	return status
+}
+
+// stageIcon marks the status of a pipeline or one of its stages
+func stageIcon(status string) string {
+	switch status {
+	case "succeeded":
+		return successStyle.Render("✓")
+	case "failed", "partial":
+		return errorStyle.Render("✗")
+	case "running":
+		return "●"
+	default:
+		return "○"
+	}
+}
+
+// stageDuration is how long a stage took, or has been running
+func stageDuration(stage nimbul.PipelineStageResponse) string {
+	if stage.StartedAt == nil {
+		return ""
+	}
+	end := time.Now()
+	if stage.FinishedAt != nil {
+		end = *stage.FinishedAt
+	}
+	return end.Sub(*stage.StartedAt).Round(time.Second).String()
+}
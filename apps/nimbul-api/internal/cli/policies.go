@@ -0,0 +1,147 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/coding-cave-dev/nimbul/pkg/nimbul"
+	"github.com/spf13/cobra"
+)
+
+var policiesCmd = &cobra.Command{
+	Use:   "policies",
+	Short: "Manage the policies deploys are checked against",
+	Long: `Policies are checked against every rendered manifest of your configs' deploys before
+anything is applied. A policy is a CEL expression that is true for manifests that
+comply with it; the manifest is available as object and the repository of the config
+as repo. A policy that cannot be evaluated against a manifest, e.g. because it reads
+a field the manifest doesn't have, counts as violated, so guard such reads with has().
+
+Policies with --action warn let the deploy go ahead and report the violation in the
+deploy log and 'nimbul status --wait'. Those with --action block fail the deploy.
+
+  nimbul policies set no-latest --action block --message "images must not use :latest" \
+    --expression "object.kind != 'Deployment' || object.spec.template.spec.containers.all(c, !c.image.endsWith(':latest'))"
+
+  nimbul policies set limits --action warn --message "containers should set resource limits" \
+    --expression "object.kind != 'Deployment' || object.spec.template.spec.containers.all(c, has(c.resources.limits))"
+
+  nimbul policies set namespace --action block --message "deploy into your team's namespace" \
+    --expression "!has(object.metadata.namespace) || object.metadata.namespace == repo.split('/')[0]"`,
+}
+
+var policiesListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List your policies",
+	Args:  cobra.NoArgs,
+	RunE:  policiesListExec,
+}
+
+var policiesSetCmd = &cobra.Command{
+	Use:   "set <name>",
+	Short: "Create or replace a policy",
+	Args:  cobra.ExactArgs(1),
+	RunE:  policiesSetExec,
+}
+
+var policiesDeleteCmd = &cobra.Command{
+	Use:   "delete <name>",
+	Short: "Delete a policy",
+	Args:  cobra.ExactArgs(1),
+	RunE:  policiesDeleteExec,
+}
+
+func init() {
+	policiesSetCmd.Flags().String("expression", "", "CEL expression that is true for complying manifests")
+	policiesSetCmd.Flags().String("message", "", "Message reported for manifests that don't comply")
+	policiesSetCmd.Flags().String("action", "warn", "What happens to deploys violating the policy: warn or block")
+	_ = policiesSetCmd.MarkFlagRequired("expression")
+	policiesCmd.AddCommand(policiesListCmd)
+	policiesCmd.AddCommand(policiesSetCmd)
+	policiesCmd.AddCommand(policiesDeleteCmd)
+	rootCmd.AddCommand(policiesCmd)
+}
+
+func policiesListExec(cmd *cobra.Command, args []string) error {
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetPoliciesWithResponse(context.Background(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to list policies: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to list policies", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	if resp.JSON200 == nil || resp.JSON200.Policies == nil || len(*resp.JSON200.Policies) == 0 {
+		fmt.Println("No policies yet. Add one with 'nimbul policies set <name> --expression <cel>'")
+		return nil
+	}
+
+	grayStyle := lipgloss.NewStyle().Foreground(grayColor)
+
+	fmt.Println(titleStyle.Render("Policies"))
+	for _, policy := range *resp.JSON200.Policies {
+		fmt.Printf("%s  %s\n", policy.Name, grayStyle.Render(string(policy.Action)))
+		fmt.Printf("  %s\n", policy.Expression)
+		if policy.Message != "" {
+			fmt.Printf("  %s\n", grayStyle.Render(policy.Message))
+		}
+	}
+
+	return nil
+}
+
+func policiesSetExec(cmd *cobra.Command, args []string) error {
+	expression, _ := cmd.Flags().GetString("expression")
+	message, _ := cmd.Flags().GetString("message")
+	action, _ := cmd.Flags().GetString("action")
+	if action != "warn" && action != "block" {
+		return usageErrorf("invalid action %q, expected warn or block", action)
+	}
+
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.PutPoliciesByNameWithResponse(context.Background(), args[0], nil, nimbul.PutPoliciesByNameJSONRequestBody{
+		Expression: expression,
+		Message:    &message,
+		Action:     nimbul.SetPolicyRequestBodyAction(action),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set policy: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to set policy", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	fmt.Println(successStyle.Render(fmt.Sprintf("✓ Policy %s set, checked from the next deploy", args[0])))
+	return nil
+}
+
+func policiesDeleteExec(cmd *cobra.Command, args []string) error {
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.DeletePoliciesByNameWithResponse(context.Background(), args[0], nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete policy: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to delete policy", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	fmt.Println(successStyle.Render(fmt.Sprintf("✓ Policy %s deleted", args[0])))
+	return nil
+}
@@ -0,0 +1,206 @@
+package cli
+
+import (
+	"bufio"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/coding-cave-dev/nimbul/pkg/nimbul"
+	"github.com/spf13/cobra"
+)
+
+// portForwardProtocol must match the Upgrade protocol expected by the API
+const portForwardProtocol = "nimbul-port-forward"
+
+var portForwardCmd = &cobra.Command{
+	Use:   "port-forward <config-id> <service> <local-port>:<service-port>",
+	Short: "Forward a local port to a deployed service through the Nimbul API",
+	Long: `Forward connections on a local port to a service deployed by a config. Traffic is proxied
+through the Nimbul API, so no direct access to the cluster is needed.
+
+Example:
+  nimbul port-forward 01JH... web 8080:80`,
+	Args:              cobra.ExactArgs(3),
+	ValidArgsFunction: completeFirstArg(completeConfigIDs),
+	RunE:              portForwardExec,
+}
+
+var portForwardNamespace string
+
+func init() {
+	portForwardCmd.Flags().StringVarP(&portForwardNamespace, "namespace", "n", "", "Namespace the config deployed the service to (defaults to the first one it was deployed to)")
+	_ = portForwardCmd.RegisterFlagCompletionFunc("namespace", completeEnvironments)
+	rootCmd.AddCommand(portForwardCmd)
+}
+
+func portForwardExec(cmd *cobra.Command, args []string) error {
+	configID, service := args[0], args[1]
+
+	localPort, remotePort, err := parsePortMapping(args[2])
+	if err != nil {
+		return err
+	}
+
+	token, err := loadToken()
+	if err != nil {
+		return fmt.Errorf("failed to load token: %w", err)
+	}
+
+	if token == "" {
+		return errNotLoggedIn
+	}
+
+	baseURL, err := url.Parse(getAPIBaseURL())
+	if err != nil {
+		return fmt.Errorf("invalid API URL: %w", err)
+	}
+
+	query := url.Values{}
+	query.Set("service", service)
+	query.Set("port", strconv.Itoa(remotePort))
+	if portForwardNamespace != "" {
+		query.Set("namespace", portForwardNamespace)
+	}
+	target := *baseURL
+	target.Path = strings.TrimSuffix(baseURL.Path, "/") + "/configs/" + url.PathEscape(configID) + "/port-forward"
+	target.RawQuery = query.Encode()
+
+	listener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", localPort))
+	if err != nil {
+		return fmt.Errorf("failed to listen on port %d: %w", localPort, err)
+	}
+	defer listener.Close()
+
+	fmt.Println(successStyle.Render(fmt.Sprintf("Forwarding 127.0.0.1:%d -> %s:%d", localPort, service, remotePort)))
+	fmt.Println(labelStyle.Render("Press Ctrl+C to stop"))
+
+	// Stop accepting connections on interrupt
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-signals
+		listener.Close()
+	}()
+
+	for {
+		local, err := listener.Accept()
+		if err != nil {
+			return nil
+		}
+
+		go func() {
+			if err := forwardConnection(local, &target, token); err != nil {
+				fmt.Fprintln(os.Stderr, errorStyle.Render(fmt.Sprintf("Connection failed: %v", err)))
+			}
+		}()
+	}
+}
+
+// parsePortMapping parses "local:remote" (or a single port used for both)
+func parsePortMapping(mapping string) (int, int, error) {
+	localStr, remoteStr, found := strings.Cut(mapping, ":")
+	if !found {
+		remoteStr = localStr
+	}
+
+	localPort, err := strconv.Atoi(localStr)
+	if err != nil || localPort <= 0 || localPort > 65535 {
+		return 0, 0, usageErrorf("invalid local port %q", localStr)
+	}
+
+	remotePort, err := strconv.Atoi(remoteStr)
+	if err != nil || remotePort <= 0 || remotePort > 65535 {
+		return 0, 0, usageErrorf("invalid service port %q", remoteStr)
+	}
+
+	return localPort, remotePort, nil
+}
+
+// forwardConnection opens an upgraded connection to the API for one local connection
+// and copies bytes in both directions until either side closes
+func forwardConnection(local net.Conn, target *url.URL, token string) error {
+	defer local.Close()
+
+	remote, err := dialAPI(target)
+	if err != nil {
+		return err
+	}
+	defer remote.Close()
+
+	req, err := http.NewRequest(http.MethodGet, target.String(), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", portForwardProtocol)
+
+	if err := req.Write(remote); err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+
+	reader := bufio.NewReader(remote)
+	resp, err := http.ReadResponse(reader, req)
+	if err != nil {
+		return fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		defer resp.Body.Close()
+		var problem nimbul.ErrorModel
+		if err := json.NewDecoder(resp.Body).Decode(&problem); err == nil {
+			return apiError("failed to open port forward", resp.StatusCode, &problem)
+		}
+		return apiError("failed to open port forward", resp.StatusCode, nil)
+	}
+
+	done := make(chan struct{}, 2)
+	go func() {
+		io.Copy(remote, local)
+		done <- struct{}{}
+	}()
+	go func() {
+		// Read through the buffered reader, it may already hold forwarded bytes
+		io.Copy(local, reader)
+		done <- struct{}{}
+	}()
+	<-done
+
+	return nil
+}
+
+// dialAPI opens a raw connection to the API host, using TLS for https URLs
+func dialAPI(target *url.URL) (net.Conn, error) {
+	host := target.Host
+	if target.Port() == "" {
+		if target.Scheme == "https" {
+			host = net.JoinHostPort(target.Hostname(), "443")
+		} else {
+			host = net.JoinHostPort(target.Hostname(), "80")
+		}
+	}
+
+	if target.Scheme == "https" {
+		conn, err := tls.Dial("tcp", host, &tls.Config{ServerName: target.Hostname()})
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to API: %w", err)
+		}
+		return conn, nil
+	}
+
+	conn, err := net.Dial("tcp", host)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to API: %w", err)
+	}
+	return conn, nil
+}
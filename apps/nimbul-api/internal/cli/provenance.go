@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/coding-cave-dev/nimbul/pkg/nimbul"
+	"github.com/spf13/cobra"
+)
+
+var provenanceCmd = &cobra.Command{
+	Use:   "provenance <build-id>",
+	Short: "Show the SLSA provenance of the images a build pushed",
+	Long: `Show the SLSA provenance attestations BuildKit generated for the images a build
+pushed. Each attestation records the repository, commit and Nimbul builder the image
+was built from, and is also attached to the image in the registry.
+
+With --json the in-toto statements are printed one per line, the bundle format
+accepted by verification tools.`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeFirstArg(completeBuildIDs),
+	RunE:              provenanceExec,
+}
+
+func init() {
+	provenanceCmd.Flags().Bool("json", false, "Print the in-toto statements, one per line")
+	provenanceCmd.Flags().String("image", "", "Only show the attestation of this image reference")
+	rootCmd.AddCommand(provenanceCmd)
+}
+
+func provenanceExec(cmd *cobra.Command, args []string) error {
+	buildID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return usageErrorf("invalid build ID %q", args[0])
+	}
+
+	asJSON, _ := cmd.Flags().GetBool("json")
+	image, _ := cmd.Flags().GetString("image")
+
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetBuildsByIdProvenanceWithResponse(context.Background(), buildID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get provenance: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to get provenance", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	var attestations []nimbul.ProvenanceResponse
+	if resp.JSON200 != nil && resp.JSON200.Provenance != nil {
+		for _, attestation := range *resp.JSON200.Provenance {
+			if image == "" || attestation.Image == image {
+				attestations = append(attestations, attestation)
+			}
+		}
+	}
+
+	if len(attestations) == 0 {
+		return fmt.Errorf("no provenance recorded for build %d", buildID)
+	}
+
+	if asJSON {
+		for _, attestation := range attestations {
+			statement, err := json.Marshal(attestation.Statement)
+			if err != nil {
+				return fmt.Errorf("failed to encode statement: %w", err)
+			}
+			fmt.Println(string(statement))
+		}
+		return nil
+	}
+
+	grayStyle := lipgloss.NewStyle().Foreground(grayColor)
+
+	fmt.Println(titleStyle.Render(fmt.Sprintf("Provenance of build %d", buildID)))
+	for _, attestation := range attestations {
+		fmt.Println(attestation.Image)
+		fmt.Printf("  %s %s\n", labelStyle.Render("Digest:   "), attestation.Digest)
+		fmt.Printf("  %s %s\n", labelStyle.Render("Predicate:"), grayStyle.Render(attestation.PredicateType))
+	}
+
+	return nil
+}
@@ -206,7 +206,7 @@ func (m registerModel) View() string {
 
 func registerUser(email, password string) tea.Cmd {
 	return func() tea.Msg {
-		resp, err := makeAuthRequest("/register", email, password)
+		resp, err := makeAuthRequest("/register", email, password, "")
 		if err != nil {
 			return registerErrorMsg{err: err.Error()}
 		}
@@ -0,0 +1,168 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/coding-cave-dev/nimbul/pkg/nimbul"
+	"github.com/spf13/cobra"
+)
+
+var registryCmd = &cobra.Command{
+	Use:   "registry",
+	Short: "Manage the logins builds push images with",
+}
+
+var registryLoginCmd = &cobra.Command{
+	Use:   "login <server>",
+	Short: "Store a login for a container registry",
+	Long: `Store a username and password or access token for a container registry. Builds
+whose nimbul.yaml sets registry: <server> push with it, and builds that set no registry
+push with your default login, if you made one with --default.`,
+	Args: cobra.ExactArgs(1),
+	RunE: registryLoginExec,
+}
+
+var registryDefaultCmd = &cobra.Command{
+	Use:   "default [credential-id]",
+	Short: "Set or clear the login builds push with when they name no registry",
+	Args:  cobra.MaximumNArgs(1),
+	RunE:  registryDefaultExec,
+}
+
+var (
+	registryUsername      string
+	registryPasswordStdin bool
+	registryMakeDefault   bool
+	registryClearDefault  bool
+)
+
+func init() {
+	registryLoginCmd.Flags().StringVarP(&registryUsername, "username", "u", "", "Registry username")
+	registryLoginCmd.Flags().BoolVar(&registryPasswordStdin, "password-stdin", false, "Read the password or access token from stdin")
+	registryLoginCmd.Flags().BoolVar(&registryMakeDefault, "default", false, "Push builds that name no registry with this login")
+	registryDefaultCmd.Flags().BoolVar(&registryClearDefault, "clear", false, "Clear the default login")
+
+	registryCmd.AddCommand(registryLoginCmd)
+	registryCmd.AddCommand(registryDefaultCmd)
+	rootCmd.AddCommand(registryCmd)
+}
+
+func registryLoginExec(cmd *cobra.Command, args []string) error {
+	if registryUsername == "" {
+		return usageErrorf("--username is required")
+	}
+
+	var password string
+	if registryPasswordStdin {
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return fmt.Errorf("failed to read password: %w", err)
+		}
+		password = strings.TrimRight(string(data), "\r\n")
+	} else {
+		var err error
+		password, err = readPassword("Password: ")
+		if err != nil {
+			return err
+		}
+	}
+
+	// Validate locally before sending it to the API
+	login, err := nimbul.NewRegistryLogin(args[0], registryUsername, password)
+	if err != nil {
+		return usageErrorf("%v", err)
+	}
+	token, err := login.Token()
+	if err != nil {
+		return err
+	}
+
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	storeResp, err := client.PostCredentialsWithResponse(ctx, nil, nimbul.StoreCredentialRequestBody{
+		Provider:  login.Server,
+		TokenType: nimbul.CredentialTypeRegistryLogin,
+		Token:     token,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to store registry login: %w", err)
+	}
+
+	if storeResp.StatusCode() != 200 {
+		return apiError("failed to store registry login", storeResp.StatusCode(), storeResp.ApplicationproblemJSONDefault)
+	}
+
+	if storeResp.JSON200 == nil {
+		return fmt.Errorf("empty response body")
+	}
+
+	credentialID := storeResp.JSON200.CredentialId
+	fmt.Println(successStyle.Render(fmt.Sprintf("✓ Stored login for %s (credential %d)", login.Server, credentialID)))
+
+	if registryMakeDefault {
+		return setDefaultRegistryCredential(ctx, client, credentialID)
+	}
+	return nil
+}
+
+func registryDefaultExec(cmd *cobra.Command, args []string) error {
+	if registryClearDefault == (len(args) == 1) {
+		return usageErrorf("pass either a credential ID or --clear")
+	}
+
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	if registryClearDefault {
+		resp, err := client.DeleteMeRegistryCredentialWithResponse(ctx, nil)
+		if err != nil {
+			return fmt.Errorf("failed to clear default registry login: %w", err)
+		}
+
+		if resp.StatusCode() != 200 {
+			return apiError("failed to clear default registry login", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+		}
+
+		fmt.Println(successStyle.Render("✓ Builds that name no registry push without a login"))
+		return nil
+	}
+
+	credentialID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return usageErrorf("invalid credential ID %q", args[0])
+	}
+
+	return setDefaultRegistryCredential(ctx, client, credentialID)
+}
+
+// setDefaultRegistryCredential makes a stored login the one builds push with when they
+// name no registry
+func setDefaultRegistryCredential(ctx context.Context, client *nimbul.ClientWithResponses, credentialID int64) error {
+	resp, err := client.PutMeRegistryCredentialWithResponse(ctx, nil, nimbul.SetDefaultRegistryCredentialRequestBody{
+		CredentialId: credentialID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set default registry login: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to set default registry login", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	fmt.Println(successStyle.Render(fmt.Sprintf("✓ Builds that name no registry now push with credential %d", credentialID)))
+	return nil
+}
@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+)
+
+var registryWebhookCmd = &cobra.Command{
+	Use:   "registry-webhook [config-id]",
+	Short: "Redeploy a config when its images are pushed outside Nimbul",
+	Long: `Create the URL of a registry webhook that redeploys a config when an image tag its
+deploy stage references is pushed by another CI system. Nothing is built: the
+manifests of the default branch are applied with the pushed tag pinned to its digest.
+
+Add the URL as a webhook in Docker Hub, or as an HTTP endpoint in Harbor. Images on
+GitHub Container Registry need no URL: the repository webhook created by
+'nimbul init' receives package events (enable "Packages" on webhooks created earlier).
+
+Running the command again rotates the token; --disable turns the webhook off.`,
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeFirstArg(completeConfigIDs),
+	RunE:              registryWebhookExec,
+}
+
+func init() {
+	registryWebhookCmd.Flags().Bool("disable", false, "Disable the registry webhook")
+	rootCmd.AddCommand(registryWebhookCmd)
+}
+
+func registryWebhookExec(cmd *cobra.Command, args []string) error {
+	disable, _ := cmd.Flags().GetBool("disable")
+
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	configID, err := resolveConfigID(client, args)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	if disable {
+		resp, err := client.DeleteConfigsByIdRegistryWebhookWithResponse(ctx, configID, nil)
+		if err != nil {
+			return fmt.Errorf("failed to disable registry webhook: %w", err)
+		}
+
+		if resp.StatusCode() != 200 {
+			return apiError("failed to disable registry webhook", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+		}
+
+		fmt.Println(successStyle.Render("✓ Registry webhook disabled"))
+		return nil
+	}
+
+	resp, err := client.PostConfigsByIdRegistryWebhookWithResponse(ctx, configID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create registry webhook: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to create registry webhook", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	if resp.JSON200 == nil {
+		return fmt.Errorf("empty response body")
+	}
+
+	webhookURL := fmt.Sprintf("%s/webhooks/registry/%s?token=%s", getAPIBaseURL(), configID, resp.JSON200.Token)
+
+	fmt.Println(successStyle.Render("✓ Registry webhook created"))
+	fmt.Println()
+	fmt.Printf("%s %s\n", labelStyle.Render("URL:"), webhookURL)
+	fmt.Println()
+	fmt.Println(lipgloss.NewStyle().Foreground(grayColor).Render("Any previous URL of this config no longer works."))
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var retentionCmd = &cobra.Command{
+	Use:   "retention [config-id]",
+	Short: "Show or change the registry retention rules of a config",
+	Long: `Show or change which image tags a config's builds leave in the registry.
+Tags outside the rules are deleted from the registry by a periodic cleanup.
+
+  --keep-last 10         keep the tags of the newest 10 builds of each branch (0 keeps all)
+  --delete-previews      delete a branch's tags once the branch is deleted, e.g. after merging
+
+Registries delete manifests rather than single tags, so a tag pushed outside
+Nimbul is removed too when it points at an expired build's image.`,
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeFirstArg(completeConfigIDs),
+	RunE:              retentionExec,
+}
+
+func init() {
+	retentionCmd.Flags().Int("keep-last", 0, "Builds per branch whose tags are kept, 0 to keep all")
+	retentionCmd.Flags().Bool("delete-previews", false, "Delete the tags of deleted branches")
+	rootCmd.AddCommand(retentionCmd)
+}
+
+func retentionExec(cmd *cobra.Command, args []string) error {
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	configID, err := resolveConfigID(client, args)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	getResp, err := client.GetConfigsByIdRetentionWithResponse(ctx, configID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get retention rules: %w", err)
+	}
+
+	if getResp.StatusCode() != 200 {
+		return apiError("failed to get retention rules", getResp.StatusCode(), getResp.ApplicationproblemJSONDefault)
+	}
+
+	if getResp.JSON200 == nil {
+		return fmt.Errorf("empty response body")
+	}
+
+	rules := *getResp.JSON200
+
+	// Only flags given on the command line change a rule
+	changed := false
+	if cmd.Flags().Changed("keep-last") {
+		keepLast, _ := cmd.Flags().GetInt("keep-last")
+		rules.KeepLast = limitPtr(keepLast)
+		changed = true
+	}
+	if cmd.Flags().Changed("delete-previews") {
+		rules.DeletePreviews, _ = cmd.Flags().GetBool("delete-previews")
+		changed = true
+	}
+
+	if changed {
+		rules.Schema = nil
+		putResp, err := client.PutConfigsByIdRetentionWithResponse(ctx, configID, nil, rules)
+		if err != nil {
+			return fmt.Errorf("failed to update retention rules: %w", err)
+		}
+
+		if putResp.StatusCode() != 200 {
+			return apiError("failed to update retention rules", putResp.StatusCode(), putResp.ApplicationproblemJSONDefault)
+		}
+
+		if putResp.JSON200 != nil {
+			rules = *putResp.JSON200
+		}
+
+		fmt.Println(successStyle.Render("✓ Retention rules updated"))
+		fmt.Println()
+	}
+
+	keep := "all builds"
+	if rules.KeepLast != nil {
+		keep = fmt.Sprintf("newest %d builds per branch", *rules.KeepLast)
+	}
+	deletePreviews := "no"
+	if rules.DeletePreviews {
+		deletePreviews = "yes"
+	}
+
+	fmt.Println(titleStyle.Render("Registry retention"))
+	fmt.Printf("%s %s\n", labelStyle.Render("Keep tags of:         "), keep)
+	fmt.Printf("%s %s\n", labelStyle.Render("Delete deleted branch:"), deletePreviews)
+
+	return nil
+}
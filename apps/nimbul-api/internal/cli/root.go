@@ -5,6 +5,7 @@ package cli
 
 import (
 	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
 )
@@ -13,18 +14,46 @@ import (
 var rootCmd = &cobra.Command{
 	Use:   "nimbul",
 	Short: "Nimbul is a self-hosted, Kubernetes-native platform for deploying apps on your own infrastructure.",
-	Long:  `Nimbul is a self-hosted, Kubernetes-native platform for deploying apps on your own infrastructure.`,
+	Long: `Nimbul is a self-hosted, Kubernetes-native platform for deploying apps on your own infrastructure.
+
+` + configHelp + `
+
+` + exitCodesHelp,
+	// Errors are printed by Execute, which also picks the exit code
+	SilenceErrors: true,
+	SilenceUsage:  true,
+	// Runs before the hooks of subcommands, see init
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if err := checkConfigFile(); err != nil {
+			return err
+		}
+		return checkCompatibility(cmd, args)
+	},
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
-	err := rootCmd.Execute()
+	wrapArgValidators(rootCmd)
+
+	cmd, err := rootCmd.ExecuteC()
 	if err != nil {
-		os.Exit(1)
+		if strings.HasPrefix(err.Error(), "unknown command") {
+			err = &cliError{code: exitUsage, err: err}
+		}
+		renderError(cmd, err)
+		os.Exit(exitCode(err))
 	}
 }
 
 func init() {
+	// Subcommands with their own hooks, like admin, still check compatibility first
+	cobra.EnableTraverseRunHooks = true
+
+	rootCmd.PersistentFlags().StringVar(&configPathFlag, "config", "", "Config file to read settings from (default ~/.config/nimbul/config.yaml)")
+	rootCmd.PersistentFlags().StringVar(&apiURLFlag, "api-url", "", "URL of the Nimbul API")
 
+	rootCmd.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
+		return &cliError{code: exitUsage, err: err}
+	})
 }
@@ -0,0 +1,132 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/coding-cave-dev/nimbul/pkg/nimbul"
+	"github.com/spf13/cobra"
+)
+
+var secretScanCmd = &cobra.Command{
+	Use:   "secret-scan [config-id]",
+	Short: "Show or change scanning build contexts for secrets",
+	Long: `Show or change whether builds of a config scan their build context for secrets,
+such as private keys and cloud or GitHub tokens, before anything is built. A build
+whose context has one fails before its images are pushed, and its log lists the
+files. Files excluded by .dockerignore are not scanned, as they never reach the image.
+
+  --enable                  scan every build
+  --allow testdata          accept findings below testdata, relative to the repository
+  --allow private-key       accept a kind of secret everywhere
+  --disallow testdata       report findings below testdata again`,
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeFirstArg(completeConfigIDs),
+	RunE:              secretScanExec,
+}
+
+func init() {
+	secretScanCmd.Flags().Bool("enable", false, "Scan build contexts for secrets")
+	secretScanCmd.Flags().Bool("disable", false, "Stop scanning build contexts for secrets")
+	secretScanCmd.Flags().StringSlice("allow", nil, "Rule IDs or path patterns of findings to accept")
+	secretScanCmd.Flags().StringSlice("disallow", nil, "Allowlist entries to remove")
+	rootCmd.AddCommand(secretScanCmd)
+}
+
+func secretScanExec(cmd *cobra.Command, args []string) error {
+	enable, _ := cmd.Flags().GetBool("enable")
+	disable, _ := cmd.Flags().GetBool("disable")
+	if enable && disable {
+		return usageErrorf("--enable and --disable cannot be combined")
+	}
+
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	configID, err := resolveConfigID(client, args)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	getResp, err := client.GetConfigsByIdSecretScanWithResponse(ctx, configID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get secret scan: %w", err)
+	}
+
+	if getResp.StatusCode() != 200 {
+		return apiError("failed to get secret scan", getResp.StatusCode(), getResp.ApplicationproblemJSONDefault)
+	}
+
+	if getResp.JSON200 == nil {
+		return fmt.Errorf("empty response body")
+	}
+
+	settings := *getResp.JSON200
+	allowlist := []string{}
+	if settings.Allowlist != nil {
+		allowlist = *settings.Allowlist
+	}
+
+	allow, _ := cmd.Flags().GetStringSlice("allow")
+	disallow, _ := cmd.Flags().GetStringSlice("disallow")
+	if enable || disable || len(allow) > 0 || len(disallow) > 0 {
+		allowlist = slices.DeleteFunc(allowlist, func(entry string) bool {
+			return slices.Contains(disallow, entry)
+		})
+		allowlist = append(allowlist, allow...)
+
+		enabled := settings.Enabled
+		if enable || disable {
+			enabled = enable
+		}
+
+		putResp, err := client.PutConfigsByIdSecretScanWithResponse(ctx, configID, nil, nimbul.PutConfigsByIdSecretScanJSONRequestBody{
+			Enabled:   enabled,
+			Allowlist: &allowlist,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update secret scan: %w", err)
+		}
+
+		if putResp.StatusCode() != 200 {
+			return apiError("failed to update secret scan", putResp.StatusCode(), putResp.ApplicationproblemJSONDefault)
+		}
+
+		if putResp.JSON200 == nil {
+			return fmt.Errorf("empty response body")
+		}
+
+		settings = *putResp.JSON200
+		allowlist = nil
+		if settings.Allowlist != nil {
+			allowlist = *settings.Allowlist
+		}
+
+		fmt.Println(successStyle.Render("✓ Secret scan updated"))
+		fmt.Println()
+	}
+
+	fmt.Println(titleStyle.Render("Secret scan"))
+	if settings.Enabled {
+		fmt.Println("Builds fail when their context has possible secrets")
+	} else {
+		fmt.Println(lipgloss.NewStyle().Foreground(grayColor).Render("Off, enable it with --enable"))
+	}
+
+	fmt.Println()
+	fmt.Println(labelStyle.Render("Allowlist"))
+	if len(allowlist) == 0 {
+		fmt.Println(lipgloss.NewStyle().Foreground(grayColor).Render("None, every finding fails the build"))
+		return nil
+	}
+	for _, entry := range allowlist {
+		fmt.Println(entry)
+	}
+	return nil
+}
@@ -0,0 +1,241 @@
+package cli
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/version"
+	"github.com/spf13/cobra"
+	"golang.org/x/mod/semver"
+)
+
+// ReleasePublicKey is the base64 Ed25519 public key the manifests of releases are
+// signed with by scripts/sign-release.sh. Builds for another release key set it with
+// -ldflags "-X github.com/coding-cave-dev/nimbul/internal/cli.ReleasePublicKey=..."
+var ReleasePublicKey = "4z1FSbeXpKANNDjCrTwIB3ksQx9mya6H28p07hrYrmM="
+
+// Names of the release assets, see the releases package of the API
+const (
+	manifestAsset  = "manifest.json"
+	signatureAsset = "manifest.json.sig"
+)
+
+// releaseManifest lists the version and binaries of a release. It is signed as a
+// whole, so a release cannot be passed off as another version.
+type releaseManifest struct {
+	Version   string            `json:"version"`
+	Checksums map[string]string `json:"checksums"` // hex SHA-256 by binary name
+}
+
+var selfUpdateCmd = &cobra.Command{
+	Use:   "self-update",
+	Short: "Replace the CLI with the release the server points CLIs at",
+	Long: `Replace the CLI with the release the Nimbul server points CLIs at, the latest
+release unless the server pins one.
+
+The binary for this platform is only installed once the signature of the release
+manifest verifies against the key this CLI was built with, the manifest is of a
+newer version than this CLI, and the binary matches its checksum in the manifest.`,
+	Args: cobra.NoArgs,
+	RunE: selfUpdateExec,
+}
+
+func init() {
+	selfUpdateCmd.Flags().Bool("force", false, "Install the release even when it is not newer, e.g. to downgrade")
+	rootCmd.AddCommand(selfUpdateCmd)
+}
+
+func selfUpdateExec(cmd *cobra.Command, args []string) error {
+	force, _ := cmd.Flags().GetBool("force")
+
+	release, err := currentRelease()
+	if err != nil {
+		return err
+	}
+
+	if release.Version == version.Version || (!force && !isNewerVersion(version.Version, release.Version)) {
+		fmt.Printf("Already up to date with %s\n", release.Version)
+		if release.Version != version.Version {
+			fmt.Printf("The server points CLIs at %s; pass --force to switch to it.\n", release.Version)
+		}
+		return nil
+	}
+
+	binaryName := fmt.Sprintf("nimbul_%s_%s", runtime.GOOS, runtime.GOARCH)
+	if runtime.GOOS == "windows" {
+		binaryName += ".exe"
+	}
+
+	assets := make(map[string]string)
+	if release.Assets != nil {
+		for _, asset := range *release.Assets {
+			assets[asset.Name] = asset.Url
+		}
+	}
+	for _, name := range []string{binaryName, manifestAsset, signatureAsset} {
+		if assets[name] == "" {
+			return fmt.Errorf("release %s has no %s", release.Version, name)
+		}
+	}
+
+	httpClient := &http.Client{Timeout: 5 * time.Minute}
+
+	manifest, err := downloadManifest(httpClient, assets[manifestAsset], assets[signatureAsset])
+	if err != nil {
+		return err
+	}
+
+	// The server could point at an old release, signed as well, to bring back what a
+	// newer one fixed. Only the signed version counts.
+	if manifest.Version != release.Version {
+		return fmt.Errorf("the signed manifest is of %s, not %s, nothing was installed", manifest.Version, release.Version)
+	}
+	if !semver.IsValid(manifest.Version) {
+		return fmt.Errorf("the signed manifest has no valid version, nothing was installed: %q", manifest.Version)
+	}
+	if !force && !isNewerVersion(version.Version, manifest.Version) {
+		return fmt.Errorf("%s is not newer than %s, nothing was installed; pass --force to downgrade", manifest.Version, version.Version)
+	}
+
+	expected := strings.ToLower(manifest.Checksums[binaryName])
+	if expected == "" {
+		return fmt.Errorf("the release manifest does not list %s", binaryName)
+	}
+
+	executable, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("failed to locate the CLI binary: %w", err)
+	}
+	if executable, err = filepath.EvalSymlinks(executable); err != nil {
+		return fmt.Errorf("failed to locate the CLI binary: %w", err)
+	}
+
+	fmt.Printf("Downloading %s %s...\n", binaryName, release.Version)
+
+	// Written next to the binary so it is renamed over it on the same filesystem
+	tmp, err := os.CreateTemp(filepath.Dir(executable), ".nimbul-update-*")
+	if err != nil {
+		return fmt.Errorf("failed to create the new binary, is %s writable? %w", filepath.Dir(executable), err)
+	}
+	defer os.Remove(tmp.Name())
+
+	sum, err := downloadBinary(httpClient, assets[binaryName], tmp)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	if sum != expected {
+		return fmt.Errorf("checksum of %s does not match the release, nothing was installed: got %s, want %s", binaryName, sum, expected)
+	}
+
+	if err := os.Chmod(tmp.Name(), 0755); err != nil {
+		return fmt.Errorf("failed to make the new binary executable: %w", err)
+	}
+
+	// A running binary cannot be replaced on Windows, but it can be moved aside
+	if runtime.GOOS == "windows" {
+		old := executable + ".old"
+		os.Remove(old)
+		if err := os.Rename(executable, old); err != nil {
+			return fmt.Errorf("failed to move the old binary aside: %w", err)
+		}
+	}
+	if err := os.Rename(tmp.Name(), executable); err != nil {
+		return fmt.Errorf("failed to replace %s: %w", executable, err)
+	}
+
+	fmt.Println(successStyle.Render(fmt.Sprintf("✓ Updated nimbul from %s to %s", version.Version, release.Version)))
+	return nil
+}
+
+// downloadAsset fetches a small release asset, like the manifest or its signature
+func downloadAsset(httpClient *http.Client, url string) ([]byte, error) {
+	resp, err := httpClient.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download %s: %s", url, resp.Status)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %s: %w", url, err)
+	}
+	return data, nil
+}
+
+// downloadBinary writes a release binary to w and returns its hex SHA-256
+func downloadBinary(httpClient *http.Client, url string, w io.Writer) (string, error) {
+	resp, err := httpClient.Get(url)
+	if err != nil {
+		return "", fmt.Errorf("failed to download %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to download %s: %s", url, resp.Status)
+	}
+
+	hash := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(w, hash), resp.Body); err != nil {
+		return "", fmt.Errorf("failed to download %s: %w", url, err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// downloadManifest fetches the release manifest and returns it once its signature
+// verifies against ReleasePublicKey
+func downloadManifest(httpClient *http.Client, manifestURL, signatureURL string) (*releaseManifest, error) {
+	data, err := downloadAsset(httpClient, manifestURL)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := downloadAsset(httpClient, signatureURL)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyManifestSignature(data, signature); err != nil {
+		return nil, err
+	}
+
+	var manifest releaseManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse the release manifest: %w", err)
+	}
+	return &manifest, nil
+}
+
+// verifyManifestSignature checks the base64 Ed25519 signature of the release manifest
+// against ReleasePublicKey
+func verifyManifestSignature(manifest, signature []byte) error {
+	key, err := base64.StdEncoding.DecodeString(ReleasePublicKey)
+	if err != nil || len(key) != ed25519.PublicKeySize {
+		return fmt.Errorf("the release key this CLI was built with is not a base64 Ed25519 public key")
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(signature)))
+	if err != nil {
+		return fmt.Errorf("the release signature is not base64: %w", err)
+	}
+
+	if !ed25519.Verify(ed25519.PublicKey(key), manifest, sig) {
+		return fmt.Errorf("the release manifest is not signed by the release key, nothing was installed")
+	}
+	return nil
+}
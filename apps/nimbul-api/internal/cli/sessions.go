@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+)
+
+var sessionsCmd = &cobra.Command{
+	Use:   "sessions",
+	Short: "List where you are logged in",
+	Long: `List the sessions of your account, one per login, with the client and address
+they were started from. Revoke a session you do not recognise, or one on a lost
+device, with 'nimbul sessions revoke <id>'; its token stops working immediately.`,
+	Args: cobra.NoArgs,
+	RunE: sessionsListExec,
+}
+
+var sessionsRevokeCmd = &cobra.Command{
+	Use:   "revoke <session-id>",
+	Short: "Log a session out",
+	Args:  cobra.ExactArgs(1),
+	RunE:  sessionsRevokeExec,
+}
+
+func init() {
+	sessionsCmd.AddCommand(sessionsRevokeCmd)
+	rootCmd.AddCommand(sessionsCmd)
+}
+
+func sessionsListExec(cmd *cobra.Command, args []string) error {
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetSessionsWithResponse(context.Background(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to list sessions", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	if resp.JSON200 == nil || resp.JSON200.Sessions == nil {
+		return fmt.Errorf("empty response body")
+	}
+
+	grayStyle := lipgloss.NewStyle().Foreground(grayColor)
+
+	fmt.Println(titleStyle.Render("Sessions"))
+	for _, session := range *resp.JSON200.Sessions {
+		userAgent := session.UserAgent
+		if userAgent == "" {
+			userAgent = "unknown client"
+		}
+
+		line := fmt.Sprintf("%s  %s  %s", session.Id, userAgent, session.Ip)
+		if session.Current {
+			line += successStyle.Render("  (this session)")
+		}
+		fmt.Println(line)
+		fmt.Println(grayStyle.Render(fmt.Sprintf("    logged in %s, last used %s",
+			session.CreatedAt.Local().Format("2006-01-02 15:04"),
+			session.LastUsedAt.Local().Format("2006-01-02 15:04"),
+		)))
+	}
+
+	return nil
+}
+
+func sessionsRevokeExec(cmd *cobra.Command, args []string) error {
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.DeleteSessionsByIdWithResponse(context.Background(), args[0], nil)
+	if err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to revoke session", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	fmt.Println(successStyle.Render(fmt.Sprintf("✓ Session %s revoked", args[0])))
+	return nil
+}
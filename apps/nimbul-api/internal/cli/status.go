@@ -0,0 +1,393 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/coding-cave-dev/nimbul/pkg/nimbul"
+	"github.com/spf13/cobra"
+)
+
+var statusCmd = &cobra.Command{
+	Use:   "status [config-id]",
+	Short: "Show the live status of a config's deployed resources",
+	Long: `Show ready replicas, conditions and recent events for every resource applied by the
+latest deployment of a config, or of a specific deployment with --deployment.
+
+With --environments, show what is live in each environment instead: the commit,
+branch and builds of the deployment last succeeded in the namespaces the manifests
+name and in every preview namespace.
+
+With --wait, follow the deployment as it applies its resources and rolls out, and
+exit non-zero unless every resource becomes ready.
+
+When the API cannot be reached, the status last shown is shown, marked as cached.
+--cached shows it without contacting the API.
+
+Without a config ID, one of your configs is picked interactively.`,
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeFirstArg(completeConfigIDs),
+	RunE:              statusExec,
+}
+
+var (
+	statusDeploymentID int64
+	statusWait         bool
+	statusCached       bool
+	statusEnvironments bool
+)
+
+func init() {
+	statusCmd.Flags().Int64Var(&statusDeploymentID, "deployment", 0, "Show a specific deployment instead of the latest one")
+	statusCmd.Flags().BoolVar(&statusWait, "wait", false, "Follow the rollout until every resource is ready")
+	addNotifyFlag(statusCmd)
+	statusCmd.Flags().BoolVar(&statusCached, "cached", false, "Show the status last shown without contacting the API")
+	statusCmd.Flags().BoolVar(&statusEnvironments, "environments", false, "Show the deployment live in each environment")
+	rootCmd.AddCommand(statusCmd)
+}
+
+// deploymentStatus is what 'nimbul status' shows, cached for when the API cannot be reached
+type deploymentStatus struct {
+	Deployment nimbul.DeploymentResponse `json:"deployment"`
+	Resources  *[]nimbul.ResourceStatus  `json:"resources"`
+}
+
+func statusExec(cmd *cobra.Command, args []string) error {
+	if statusWait && statusCached {
+		return usageErrorf("--wait follows the rollout through the API and cannot be combined with --cached")
+	}
+	if statusEnvironments && (statusWait || statusDeploymentID != 0) {
+		return usageErrorf("--environments cannot be combined with --wait or --deployment")
+	}
+	if statusWait {
+		if err := checkNotifyMode(); err != nil {
+			return err
+		}
+	}
+
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	configID, err := resolveConfigID(client, args)
+	if err != nil {
+		return err
+	}
+
+	if statusEnvironments {
+		return statusEnvironmentsExec(client, configID)
+	}
+
+	ctx := context.Background()
+
+	cacheName := "status-" + configID
+	if statusDeploymentID != 0 {
+		cacheName = fmt.Sprintf("deployment-%d", statusDeploymentID)
+	}
+
+	var waitErr error
+	status, fetchedAt, err := fetchCached(cacheName, statusCached, func() (*deploymentStatus, error) {
+		deploymentID := statusDeploymentID
+		if deploymentID == 0 {
+			limit := int32(1)
+			listResp, err := client.GetConfigsByIdDeploymentsWithResponse(ctx, configID, &nimbul.GetConfigsByIdDeploymentsParams{
+				Limit: &limit,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to get deployments: %w", err)
+			}
+
+			if listResp.StatusCode() != 200 {
+				return nil, apiError("failed to get deployments", listResp.StatusCode(), listResp.ApplicationproblemJSONDefault)
+			}
+
+			if listResp.JSON200 == nil || listResp.JSON200.Deployments == nil || len(*listResp.JSON200.Deployments) == 0 {
+				return nil, nil
+			}
+
+			deploymentID = (*listResp.JSON200.Deployments)[0].Id
+		}
+
+		if statusWait {
+			waitErr = waitForDeployment(deploymentID)
+			notifyDone(fmt.Sprintf("Deployment %d", deploymentID), waitErr)
+			fmt.Println()
+		}
+
+		resp, err := client.GetDeploymentsByIdResourcesWithResponse(ctx, deploymentID, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get deployment status: %w", err)
+		}
+
+		if resp.StatusCode() != 200 {
+			return nil, apiError("failed to get deployment status", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+		}
+
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("empty response body")
+		}
+
+		return &deploymentStatus{Deployment: resp.JSON200.Deployment, Resources: resp.JSON200.Resources}, nil
+	})
+	if err != nil {
+		return err
+	}
+	if !fetchedAt.IsZero() {
+		printStale(fetchedAt)
+	}
+
+	if status == nil {
+		fmt.Println("No deployments yet. Push to the repository to trigger one.")
+		return nil
+	}
+
+	printDeploymentStatus(status.Deployment, status.Resources)
+	return waitErr
+}
+
+// statusEnvironmentsExec shows the deployment live in each environment of a config
+func statusEnvironmentsExec(client *nimbul.ClientWithResponses, configID string) error {
+	environments, fetchedAt, err := fetchCached("environments-"+configID, statusCached, func() ([]nimbul.EnvironmentResponse, error) {
+		resp, err := client.GetConfigsByIdEnvironmentsWithResponse(context.Background(), configID, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get environments: %w", err)
+		}
+
+		if resp.StatusCode() != 200 {
+			return nil, apiError("failed to get environments", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+		}
+
+		if resp.JSON200 == nil || resp.JSON200.Environments == nil {
+			return nil, nil
+		}
+		return *resp.JSON200.Environments, nil
+	})
+	if err != nil {
+		return err
+	}
+	if !fetchedAt.IsZero() {
+		printStale(fetchedAt)
+	}
+
+	if len(environments) == 0 {
+		fmt.Println("Nothing is live yet. Push to the repository to trigger a deployment.")
+		return nil
+	}
+
+	grayStyle := lipgloss.NewStyle().Foreground(grayColor)
+
+	fmt.Println(titleStyle.Render(fmt.Sprintf("Environments of %s", configID)))
+	for _, environment := range environments {
+		name := "main"
+		if environment.Namespace != nil && *environment.Namespace != "" {
+			name = *environment.Namespace
+		}
+
+		deployment := environment.Deployment
+		fmt.Printf("%s  %s %s  deployment %d, %s ago\n",
+			labelStyle.Render(name),
+			shortCommit(deployment.CommitSha),
+			environment.Branch,
+			deployment.Id,
+			formatAge(time.Since(environment.UpdatedAt)),
+		)
+
+		details := make([]string, 0, len(environment.Builds)+1)
+		if deployment.DeployedBy != nil && *deployment.DeployedBy != "" {
+			details = append(details, "by "+*deployment.DeployedBy)
+		}
+		names := make([]string, 0, len(environment.Builds))
+		for buildName := range environment.Builds {
+			names = append(names, buildName)
+		}
+		sort.Strings(names)
+		for _, buildName := range names {
+			details = append(details, fmt.Sprintf("%s build %d", buildName, environment.Builds[buildName]))
+		}
+		if len(details) > 0 {
+			fmt.Printf("  %s\n", grayStyle.Render(strings.Join(details, ", ")))
+		}
+	}
+
+	return nil
+}
+
+// progressEvent is an event of the deployment progress stream
+type progressEvent struct {
+	Type     string `json:"type"`
+	Resource *struct {
+		Kind      string `json:"kind"`
+		Namespace string `json:"namespace"`
+		Name      string `json:"name"`
+	} `json:"resource"`
+	Resources []nimbul.ResourceStatus `json:"resources"`
+	Status    string                  `json:"status"`
+	Ready     bool                    `json:"ready"`
+	Error     string                  `json:"error"`
+	Message   string                  `json:"message"`
+}
+
+// waitForDeployment follows the progress stream of a deployment, printing each step,
+// until the rollout is ready or has failed
+func waitForDeployment(deploymentID int64) error {
+	// The event stream is not part of the SDK, so the token is sent by hand
+	token, err := loadToken()
+	if err != nil {
+		return fmt.Errorf("failed to load token: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/deployments/%d/events", getAPIBaseURL(), deploymentID), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Accept", "text/event-stream")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to follow deployment: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var problem nimbul.ErrorModel
+		if err := json.NewDecoder(resp.Body).Decode(&problem); err == nil {
+			return apiError("failed to follow deployment", resp.StatusCode, &problem)
+		}
+		return fmt.Errorf("failed to follow deployment: status %d", resp.StatusCode)
+	}
+
+	grayStyle := lipgloss.NewStyle().Foreground(grayColor)
+	lastReady := -1
+
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		data, ok := strings.CutPrefix(scanner.Text(), "data: ")
+		if !ok {
+			continue
+		}
+
+		var event progressEvent
+		if err := json.Unmarshal([]byte(data), &event); err != nil {
+			continue
+		}
+
+		switch event.Type {
+		case "deployment":
+			fmt.Println(grayStyle.Render(fmt.Sprintf("Following deployment %d (%s)", deploymentID, event.Status)))
+		case "resource.applied":
+			name := event.Resource.Name
+			if event.Resource.Namespace != "" {
+				name = event.Resource.Namespace + "/" + name
+			}
+			fmt.Printf("✓ Applied %s %s\n", event.Resource.Kind, name)
+		case "policy.warning":
+			fmt.Println(lipgloss.NewStyle().Foreground(orangeColor).Render("⚠ " + event.Message))
+		case "deploy.succeeded":
+			fmt.Println(grayStyle.Render("Manifests applied, waiting for the rollout"))
+		case "deploy.failed":
+			fmt.Println(errorStyle.Render("✗ Deploy failed: " + event.Error))
+		case "resources":
+			ready := 0
+			for _, resource := range event.Resources {
+				if resource.Ready {
+					ready++
+				}
+			}
+			if ready != lastReady {
+				lastReady = ready
+				fmt.Println(grayStyle.Render(fmt.Sprintf("%d/%d resources ready", ready, len(event.Resources))))
+			}
+		case "done":
+			if event.Ready {
+				fmt.Println(successStyle.Render("✓ Rollout complete"))
+				return nil
+			}
+			if event.Error != "" {
+				return failedErrorf("deployment %d did not become ready: %s", deploymentID, event.Error)
+			}
+			return failedErrorf("deployment %d did not become ready", deploymentID)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("lost the deployment progress stream: %w", err)
+	}
+	return fmt.Errorf("lost the deployment progress stream")
+}
+
+func printDeploymentStatus(deployment nimbul.DeploymentResponse, resources *[]nimbul.ResourceStatus) {
+	grayStyle := lipgloss.NewStyle().Foreground(grayColor)
+	readyStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#4CAF50")).Bold(true)
+	notReadyStyle := lipgloss.NewStyle().Foreground(orangeColor).Bold(true)
+
+	fmt.Println(titleStyle.Render(fmt.Sprintf("Deployment %d", deployment.Id)))
+	fmt.Printf("%s %s\n", grayStyle.Render("Ref:    "), deployment.Ref)
+	fmt.Printf("%s %s\n", grayStyle.Render("Commit: "), deployment.CommitSha)
+	fmt.Printf("%s %s\n", grayStyle.Render("Status: "), deployment.Status)
+	if deployment.Error != nil {
+		fmt.Printf("%s %s\n", grayStyle.Render("Error:  "), *deployment.Error)
+	}
+	fmt.Println()
+
+	if resources == nil || len(*resources) == 0 {
+		fmt.Println("No resources were applied by this deployment.")
+		return
+	}
+
+	for _, resource := range *resources {
+		name := resource.Name
+		if resource.Namespace != nil && *resource.Namespace != "" {
+			name = *resource.Namespace + "/" + resource.Name
+		}
+
+		state := notReadyStyle.Render("NOT READY")
+		switch {
+		case !resource.Exists:
+			state = notReadyStyle.Render("MISSING")
+		case resource.Ready:
+			state = readyStyle.Render("READY")
+		}
+
+		line := fmt.Sprintf("%s %s %s", state, resource.Kind, name)
+		if resource.Replicas != nil && resource.ReadyReplicas != nil {
+			line += grayStyle.Render(fmt.Sprintf(" (%d/%d replicas ready)", *resource.ReadyReplicas, *resource.Replicas))
+		}
+		fmt.Println(line)
+
+		if resource.Error != nil {
+			fmt.Printf("    %s\n", grayStyle.Render("error: "+*resource.Error))
+		}
+
+		if resource.Conditions != nil {
+			for _, condition := range *resource.Conditions {
+				if condition.Status == "True" {
+					continue
+				}
+				message := condition.Type + "=" + condition.Status
+				if condition.Reason != nil {
+					message += " " + *condition.Reason
+				}
+				if condition.Message != nil {
+					message += ": " + *condition.Message
+				}
+				fmt.Printf("    %s\n", grayStyle.Render(message))
+			}
+		}
+
+		if resource.Events != nil {
+			for _, event := range *resource.Events {
+				fmt.Printf("    %s\n", grayStyle.Render(fmt.Sprintf("%s %s %s: %s", event.LastSeen.Local().Format("15:04:05"), event.Type, event.Reason, event.Message)))
+			}
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package cli
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/docker/docker-credential-helpers/client"
+	"github.com/docker/docker-credential-helpers/credentials"
+)
+
+// tokenStore keeps the token of the logged in user
+type tokenStore interface {
+	// Load returns the stored token, or "" when there is none
+	Load() (string, error)
+	Save(token string) error
+	Delete() error
+}
+
+// Token stores picked with NIMBUL_TOKEN_STORE or token_store
+const (
+	tokenStoreFile    = "file"
+	tokenStoreKeyring = "keyring"
+)
+
+// getTokenStore returns where the token is kept. NIMBUL_TOKEN wins over any store, so
+// CI runners can authenticate without writing files.
+func getTokenStore() (tokenStore, error) {
+	if token := os.Getenv("NIMBUL_TOKEN"); token != "" {
+		return envTokenStore{token: token}, nil
+	}
+
+	config := loadConfig()
+	switch store := cmp.Or(os.Getenv("NIMBUL_TOKEN_STORE"), config.TokenStore, tokenStoreFile); store {
+	case tokenStoreFile:
+		return fileTokenStore{path: getTokenPath()}, nil
+	case tokenStoreKeyring:
+		helper := cmp.Or(os.Getenv("NIMBUL_CREDENTIAL_HELPER"), config.CredentialHelper, defaultCredentialHelper())
+		if helper == "" {
+			return nil, usageErrorf("no keyring credential helper is known for %s, set credential_helper", runtime.GOOS)
+		}
+		return keyringTokenStore{helper: helper, serverURL: getAPIBaseURL()}, nil
+	default:
+		return nil, usageErrorf("unknown token store %q, use %s or %s", store, tokenStoreFile, tokenStoreKeyring)
+	}
+}
+
+// fileTokenStore keeps the token in a file only the user can read
+type fileTokenStore struct {
+	path string
+}
+
+func (s fileTokenStore) Load() (string, error) {
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to read token: %w", err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+func (s fileTokenStore) Save(token string) error {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0700); err != nil {
+		return fmt.Errorf("failed to create token directory: %w", err)
+	}
+	if err := os.WriteFile(s.path, []byte(token), 0600); err != nil {
+		return fmt.Errorf("failed to write token: %w", err)
+	}
+	return nil
+}
+
+func (s fileTokenStore) Delete() error {
+	if err := os.Remove(s.path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove token: %w", err)
+	}
+	return nil
+}
+
+// keyringTokenStore keeps the token in the system keyring through a Docker credential
+// helper, e.g. docker-credential-osxkeychain, under the URL of the API
+type keyringTokenStore struct {
+	helper    string // name of the helper without the docker-credential- prefix
+	serverURL string
+}
+
+func (s keyringTokenStore) program() client.ProgramFunc {
+	return client.NewShellProgramFunc("docker-credential-" + s.helper)
+}
+
+func (s keyringTokenStore) Load() (string, error) {
+	creds, err := client.Get(s.program(), s.serverURL)
+	if err != nil {
+		if credentials.IsErrCredentialsNotFound(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to read token from keyring: %w", err)
+	}
+	return creds.Secret, nil
+}
+
+func (s keyringTokenStore) Save(token string) error {
+	err := client.Store(s.program(), &credentials.Credentials{
+		ServerURL: s.serverURL,
+		Username:  "nimbul",
+		Secret:    token,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write token to keyring: %w", err)
+	}
+	return nil
+}
+
+func (s keyringTokenStore) Delete() error {
+	if err := client.Erase(s.program(), s.serverURL); err != nil && !credentials.IsErrCredentialsNotFound(err) {
+		return fmt.Errorf("failed to remove token from keyring: %w", err)
+	}
+	return nil
+}
+
+// envTokenStore is the token of NIMBUL_TOKEN, which the CLI cannot change
+type envTokenStore struct {
+	token string
+}
+
+var errEnvToken = errors.New("the token is set by NIMBUL_TOKEN, unset it to log in or out")
+
+func (s envTokenStore) Load() (string, error) {
+	return strings.TrimSpace(s.token), nil
+}
+
+func (s envTokenStore) Save(token string) error {
+	return errEnvToken
+}
+
+func (s envTokenStore) Delete() error {
+	return errEnvToken
+}
+
+// defaultCredentialHelper returns the credential helper of the system keyring
+func defaultCredentialHelper() string {
+	switch runtime.GOOS {
+	case "darwin":
+		return "osxkeychain"
+	case "windows":
+		return "wincred"
+	case "linux":
+		return "secretservice"
+	}
+	return ""
+}
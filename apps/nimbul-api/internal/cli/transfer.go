@@ -0,0 +1,204 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/coding-cave-dev/nimbul/pkg/nimbul"
+	"github.com/spf13/cobra"
+)
+
+var transferCmd = &cobra.Command{
+	Use:   "transfer",
+	Short: "Hand configs over to another user",
+	Long: `Offer a config to another Nimbul user, for when the person who set it up leaves the
+team. The config keeps its owner until the recipient accepts; its builds and
+deployments move with it.
+
+The previous owner's cluster credential and agent are not transferred, so the
+recipient connects their own before the config deploys again.`,
+}
+
+var transferStartCmd = &cobra.Command{
+	Use:               "start <config-id> <email>",
+	Short:             "Offer a config to the user with the given email",
+	Args:              cobra.ExactArgs(2),
+	ValidArgsFunction: completeFirstArg(completeConfigIDs),
+	RunE:              transferStartExec,
+}
+
+var transferCancelCmd = &cobra.Command{
+	Use:               "cancel [config-id]",
+	Short:             "Withdraw the pending transfer of a config",
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeFirstArg(completeConfigIDs),
+	RunE:              transferCancelExec,
+}
+
+var transferListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the configs offered to you",
+	Args:  cobra.NoArgs,
+	RunE:  transferListExec,
+}
+
+var transferAcceptCmd = &cobra.Command{
+	Use:   "accept <transfer-id>",
+	Short: "Accept a transfer and become the owner of its config",
+	Args:  cobra.ExactArgs(1),
+	RunE:  transferAcceptExec,
+}
+
+var transferDeclineCmd = &cobra.Command{
+	Use:   "decline <transfer-id>",
+	Short: "Decline a transfer",
+	Args:  cobra.ExactArgs(1),
+	RunE:  transferDeclineExec,
+}
+
+func init() {
+	transferCmd.AddCommand(transferStartCmd)
+	transferCmd.AddCommand(transferCancelCmd)
+	transferCmd.AddCommand(transferListCmd)
+	transferCmd.AddCommand(transferAcceptCmd)
+	transferCmd.AddCommand(transferDeclineCmd)
+	rootCmd.AddCommand(transferCmd)
+}
+
+func transferStartExec(cmd *cobra.Command, args []string) error {
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.PostConfigsByIdTransferWithResponse(context.Background(), args[0], nil, nimbul.StartConfigTransferRequestBody{
+		Email: args[1],
+	})
+	if err != nil {
+		return fmt.Errorf("failed to start transfer: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to start transfer", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	if resp.JSON200 == nil {
+		return fmt.Errorf("empty response body")
+	}
+
+	transfer := resp.JSON200.Transfer
+	fmt.Println(successStyle.Render(fmt.Sprintf("✓ Offered %s to %s", transfer.RepoFullName, transfer.ToEmail)))
+	fmt.Println(lipgloss.NewStyle().Foreground(grayColor).Render(fmt.Sprintf("They can accept it with 'nimbul transfer accept %s'", transfer.Id)))
+	return nil
+}
+
+func transferCancelExec(cmd *cobra.Command, args []string) error {
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	configID, err := resolveConfigID(client, args)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.DeleteConfigsByIdTransferWithResponse(context.Background(), configID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to cancel transfer: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to cancel transfer", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	fmt.Println(successStyle.Render(fmt.Sprintf("✓ Transfer of %s canceled", configID)))
+	return nil
+}
+
+func transferListExec(cmd *cobra.Command, args []string) error {
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetTransfersWithResponse(context.Background(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to list transfers: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to list transfers", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	if resp.JSON200 == nil || resp.JSON200.Transfers == nil || len(*resp.JSON200.Transfers) == 0 {
+		fmt.Println("No configs are waiting for you to accept them")
+		return nil
+	}
+
+	grayStyle := lipgloss.NewStyle().Foreground(grayColor)
+
+	fmt.Println(titleStyle.Render("Pending transfers"))
+	for _, transfer := range *resp.JSON200.Transfers {
+		fmt.Printf("%s  %s  %s\n",
+			transfer.Id,
+			transfer.RepoFullName,
+			grayStyle.Render(fmt.Sprintf("from %s, %s", transfer.FromEmail, transfer.CreatedAt.Local().Format("2006-01-02 15:04"))),
+		)
+	}
+
+	return nil
+}
+
+func transferAcceptExec(cmd *cobra.Command, args []string) error {
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.PostTransfersByIdAcceptWithResponse(context.Background(), args[0], nil)
+	if err != nil {
+		return fmt.Errorf("failed to accept transfer: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to accept transfer", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	if resp.JSON200 == nil {
+		return fmt.Errorf("empty response body")
+	}
+
+	config := resp.JSON200.Config
+	fmt.Println(successStyle.Render(fmt.Sprintf("✓ You now own config %s for %s", config.Id, config.RepoFullName)))
+
+	if resp.JSON200.Warnings != nil && len(*resp.JSON200.Warnings) > 0 {
+		fmt.Println()
+		fmt.Println(labelStyle.Render("Finish setting up the config:"))
+		for _, warning := range *resp.JSON200.Warnings {
+			fmt.Printf("  - %s\n", warning)
+		}
+	}
+
+	return nil
+}
+
+func transferDeclineExec(cmd *cobra.Command, args []string) error {
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.PostTransfersByIdDeclineWithResponse(context.Background(), args[0], nil)
+	if err != nil {
+		return fmt.Errorf("failed to decline transfer: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to decline transfer", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	fmt.Println(successStyle.Render("✓ Transfer declined"))
+	return nil
+}
@@ -0,0 +1,122 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+)
+
+var trashCmd = &cobra.Command{
+	Use:   "trash",
+	Short: "List deleted configs and credentials that can still be restored",
+	Long: `Deleted configs and credentials go to the trash. A config in the trash keeps its
+deployments, environment and webhook secret, but pushes no longer build it. Both are
+deleted for good once they have been in the trash for the retention window of the
+server, 30 days by default.`,
+	Args: cobra.NoArgs,
+	RunE: trashExec,
+}
+
+var trashRestoreCmd = &cobra.Command{
+	Use:   "restore <config|credential> <id>",
+	Short: "Take a config or credential out of the trash",
+	Args:  cobra.ExactArgs(2),
+	RunE:  trashRestoreExec,
+}
+
+func init() {
+	trashCmd.AddCommand(trashRestoreCmd)
+	rootCmd.AddCommand(trashCmd)
+}
+
+func trashExec(cmd *cobra.Command, args []string) error {
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetTrashWithResponse(context.Background(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to list trash: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to list trash", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	if resp.JSON200 == nil || (resp.JSON200.Configs == nil || len(*resp.JSON200.Configs) == 0) &&
+		(resp.JSON200.Credentials == nil || len(*resp.JSON200.Credentials) == 0) {
+		fmt.Println("The trash is empty")
+		return nil
+	}
+
+	grayStyle := lipgloss.NewStyle().Foreground(grayColor)
+	const timeFormat = "2006-01-02 15:04"
+
+	if resp.JSON200.Configs != nil && len(*resp.JSON200.Configs) > 0 {
+		fmt.Println(titleStyle.Render("Configs"))
+		for _, config := range *resp.JSON200.Configs {
+			fmt.Printf("%-28s %s  %s\n", config.Id, config.RepoFullName,
+				grayStyle.Render(fmt.Sprintf("deleted %s, purged %s", config.DeletedAt.Local().Format(timeFormat), config.PurgeAt.Local().Format(timeFormat))))
+		}
+		fmt.Println()
+	}
+
+	if resp.JSON200.Credentials != nil && len(*resp.JSON200.Credentials) > 0 {
+		fmt.Println(titleStyle.Render("Credentials"))
+		for _, credential := range *resp.JSON200.Credentials {
+			fmt.Printf("%-6d %s/%s  %s\n", credential.Id, credential.Provider, credential.TokenType,
+				grayStyle.Render(fmt.Sprintf("deleted %s, purged %s", credential.DeletedAt.Local().Format(timeFormat), credential.PurgeAt.Local().Format(timeFormat))))
+		}
+		fmt.Println()
+	}
+
+	fmt.Println(grayStyle.Render("Run 'nimbul trash restore <config|credential> <id>' to restore one"))
+	return nil
+}
+
+func trashRestoreExec(cmd *cobra.Command, args []string) error {
+	kind, id := args[0], args[1]
+	if kind != "config" && kind != "credential" {
+		return usageErrorf("unknown kind %q, expected config or credential", kind)
+	}
+
+	var credentialID int64
+	if kind == "credential" {
+		var err error
+		credentialID, err = strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			return usageErrorf("invalid credential ID %q", id)
+		}
+	}
+
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	if kind == "config" {
+		resp, err := client.PostConfigsByIdRestoreWithResponse(context.Background(), id, nil)
+		if err != nil {
+			return fmt.Errorf("failed to restore config: %w", err)
+		}
+		if resp.StatusCode() != 200 {
+			return apiError("failed to restore config", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+		}
+		fmt.Println(successStyle.Render(fmt.Sprintf("✓ Config %s restored", id)))
+		return nil
+	}
+
+	resp, err := client.PostCredentialsByIdRestoreWithResponse(context.Background(), credentialID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to restore credential: %w", err)
+	}
+	if resp.StatusCode() != 200 {
+		return apiError("failed to restore credential", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+	fmt.Println(successStyle.Render(fmt.Sprintf("✓ Credential %d restored", credentialID)))
+	return nil
+}
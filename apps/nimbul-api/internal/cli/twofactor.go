@@ -0,0 +1,132 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/coding-cave-dev/nimbul/pkg/nimbul"
+	"github.com/spf13/cobra"
+)
+
+var twoFactorCmd = &cobra.Command{
+	Use:   "2fa",
+	Short: "Manage two-factor authentication",
+	Long: `Require a code from an authenticator app, on top of your password, to log in.
+
+Run 'nimbul 2fa enable', add the secret to your authenticator app, then confirm
+it with 'nimbul 2fa confirm <code>'. Keep the recovery codes you get somewhere
+safe: each one logs you in once if you lose the authenticator.`,
+}
+
+var twoFactorEnableCmd = &cobra.Command{
+	Use:   "enable",
+	Short: "Generate a secret for your authenticator app",
+	Args:  cobra.NoArgs,
+	RunE:  twoFactorEnableExec,
+}
+
+var twoFactorConfirmCmd = &cobra.Command{
+	Use:   "confirm <code>",
+	Short: "Turn on two-factor authentication with a code from your authenticator app",
+	Args:  cobra.ExactArgs(1),
+	RunE:  twoFactorConfirmExec,
+}
+
+var twoFactorDisableCmd = &cobra.Command{
+	Use:   "disable <code>",
+	Short: "Turn off two-factor authentication, given a current code or a recovery code",
+	Args:  cobra.ExactArgs(1),
+	RunE:  twoFactorDisableExec,
+}
+
+func init() {
+	twoFactorCmd.AddCommand(twoFactorEnableCmd)
+	twoFactorCmd.AddCommand(twoFactorConfirmCmd)
+	twoFactorCmd.AddCommand(twoFactorDisableCmd)
+	rootCmd.AddCommand(twoFactorCmd)
+}
+
+func twoFactorEnableExec(cmd *cobra.Command, args []string) error {
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.PostMe2FaWithResponse(context.Background(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to set up two-factor authentication: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to set up two-factor authentication", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	if resp.JSON200 == nil {
+		return fmt.Errorf("empty response body")
+	}
+
+	grayStyle := lipgloss.NewStyle().Foreground(grayColor)
+
+	fmt.Println(titleStyle.Render("Two-factor authentication"))
+	fmt.Println("Add this account to your authenticator app, from a QR code of the URI or by entering the secret:")
+	fmt.Println()
+	fmt.Printf("%s %s\n", labelStyle.Render("URI:"), resp.JSON200.ProvisioningUri)
+	fmt.Printf("%s %s\n", labelStyle.Render("Secret:"), resp.JSON200.Secret)
+	fmt.Println()
+	fmt.Println(grayStyle.Render("Then turn it on with 'nimbul 2fa confirm <code>'"))
+	return nil
+}
+
+func twoFactorConfirmExec(cmd *cobra.Command, args []string) error {
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.PostMe2FaConfirmWithResponse(context.Background(), nil, nimbul.ConfirmTwoFactorRequestBody{
+		Code: args[0],
+	})
+	if err != nil {
+		return fmt.Errorf("failed to enable two-factor authentication: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to enable two-factor authentication", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	if resp.JSON200 == nil {
+		return fmt.Errorf("empty response body")
+	}
+
+	fmt.Println(successStyle.Render("✓ Two-factor authentication enabled"))
+	fmt.Println()
+	fmt.Println(labelStyle.Render("Recovery codes (each works once, they will not be shown again):"))
+	if resp.JSON200.RecoveryCodes != nil {
+		for _, code := range *resp.JSON200.RecoveryCodes {
+			fmt.Printf("  %s\n", code)
+		}
+	}
+	return nil
+}
+
+func twoFactorDisableExec(cmd *cobra.Command, args []string) error {
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.PostMe2FaDisableWithResponse(context.Background(), nil, nimbul.DisableTwoFactorRequestBody{
+		Code: args[0],
+	})
+	if err != nil {
+		return fmt.Errorf("failed to disable two-factor authentication: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to disable two-factor authentication", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	fmt.Println(successStyle.Render("✓ Two-factor authentication disabled"))
+	return nil
+}
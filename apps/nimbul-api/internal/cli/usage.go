@@ -0,0 +1,99 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/coding-cave-dev/nimbul/pkg/nimbul"
+	"github.com/spf13/cobra"
+)
+
+var usageCmd = &cobra.Command{
+	Use:   "usage",
+	Short: "Show your build minutes, deploys and storage this month",
+	Long: `Show what you used in the current calendar month, in total and per config, and
+how it compares with the quotas of this instance. Past a hard quota, new builds are
+blocked until the next month; past a config's quota, only the builds of that config.
+
+Admins can look at another user's usage with --user <id or email>.`,
+	Args: cobra.NoArgs,
+	RunE: usageExec,
+}
+
+func init() {
+	usageCmd.Flags().String("user", "", "User ID or email to report on (admins only)")
+	rootCmd.AddCommand(usageCmd)
+}
+
+func usageExec(cmd *cobra.Command, args []string) error {
+	client, err := authenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	params := &nimbul.GetUsageParams{}
+
+	if user, _ := cmd.Flags().GetString("user"); user != "" {
+		userID, err := resolveAdminUser(user)
+		if err != nil {
+			return err
+		}
+		params.UserId = &userID
+	}
+
+	resp, err := client.GetUsageWithResponse(context.Background(), params)
+	if err != nil {
+		return fmt.Errorf("failed to get usage: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return apiError("failed to get usage", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	if resp.JSON200 == nil {
+		return fmt.Errorf("empty response body")
+	}
+
+	usage := resp.JSON200
+
+	fmt.Println(titleStyle.Render(fmt.Sprintf("Usage %s – %s", usage.PeriodStart.Local().Format("Jan 2"), usage.PeriodEnd.Local().AddDate(0, 0, -1).Format("Jan 2, 2006"))))
+	fmt.Printf("%s %d\n", labelStyle.Render("Builds:       "), usage.Builds)
+	fmt.Printf("%s %.1f\n", labelStyle.Render("Build minutes:"), usage.BuildMinutes)
+	fmt.Printf("%s %d\n", labelStyle.Render("Deploys:      "), usage.Deploys)
+	fmt.Printf("%s %.1f MB\n", labelStyle.Render("Storage:      "), float64(usage.StorageBytes)/(1024*1024))
+
+	if usage.Quotas != nil {
+		printQuotaStates(*usage.Quotas, "")
+	}
+
+	if usage.Configs == nil || len(*usage.Configs) == 0 {
+		return nil
+	}
+
+	fmt.Println()
+	fmt.Println(titleStyle.Render("Per config"))
+	for _, config := range *usage.Configs {
+		fmt.Printf("%s  %d builds, %.1f build minutes, %.1f MB\n", config.RepoFullName, config.Builds, config.BuildMinutes, float64(config.StorageBytes)/(1024*1024))
+	}
+	for _, config := range *usage.Configs {
+		if config.Quotas != nil {
+			printQuotaStates(*config.Quotas, " of "+config.RepoFullName)
+		}
+	}
+
+	return nil
+}
+
+// printQuotaStates reports the quotas that were reached, scope saying whose they are
+func printQuotaStates(quotas []nimbul.QuotaStatusResponse, scope string) {
+	for _, quota := range quotas {
+		switch quota.State {
+		case nimbul.Exceeded:
+			fmt.Println()
+			fmt.Println(errorStyle.Render(fmt.Sprintf("✗ Hard %s quota%s of %d reached: new builds are blocked", quota.Metric, scope, *quota.Hard)))
+		case nimbul.Warning:
+			fmt.Println()
+			fmt.Println(errorStyle.Render(fmt.Sprintf("! Soft %s quota%s of %d reached", quota.Metric, scope, *quota.Soft)))
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+
+	"github.com/coding-cave-dev/nimbul/internal/version"
+	"github.com/coding-cave-dev/nimbul/pkg/nimbul"
+	"github.com/spf13/cobra"
+	"golang.org/x/mod/semver"
+)
+
+var versionCmd = &cobra.Command{
+	Use:   "version",
+	Short: "Show the version of the CLI",
+	Long: `Show the version of the CLI. With --check, also ask the Nimbul server which
+release its CLIs should run and whether this one is behind it.`,
+	Args: cobra.NoArgs,
+	RunE: versionExec,
+}
+
+func init() {
+	versionCmd.Flags().Bool("check", false, "Check for a newer release")
+	rootCmd.AddCommand(versionCmd)
+}
+
+func versionExec(cmd *cobra.Command, args []string) error {
+	check, _ := cmd.Flags().GetBool("check")
+
+	fmt.Printf("nimbul %s %s/%s\n", version.Version, runtime.GOOS, runtime.GOARCH)
+	if !check {
+		return nil
+	}
+
+	release, err := currentRelease()
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case release.Version == version.Version:
+		fmt.Println(successStyle.Render("✓ Up to date"))
+	case isNewerVersion(version.Version, release.Version):
+		fmt.Printf("A newer release is available: %s\n", release.Version)
+		fmt.Println("Run 'nimbul self-update' to install it.")
+	default:
+		fmt.Printf("The server points CLIs at %s; run 'nimbul self-update --force' to switch to it.\n", release.Version)
+	}
+	return nil
+}
+
+// currentRelease asks the server which CLI release to run. No login is needed.
+func currentRelease() (*nimbul.GetCLIReleaseResponseBody, error) {
+	client, err := getSDKClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create API client: %w", err)
+	}
+
+	resp, err := client.GetCliReleaseWithResponse(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get CLI release: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return nil, apiError("failed to get CLI release", resp.StatusCode(), resp.ApplicationproblemJSONDefault)
+	}
+
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("empty response body")
+	}
+
+	return resp.JSON200, nil
+}
+
+// isNewerVersion reports whether release is a later version than current. Builds that
+// are not a release, like dev, are behind every release.
+func isNewerVersion(current, release string) bool {
+	if !semver.IsValid(current) {
+		return semver.IsValid(release)
+	}
+	return semver.Compare(release, current) > 0
+}
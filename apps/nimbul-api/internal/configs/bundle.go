@@ -0,0 +1,149 @@
+package configs
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// BundleVersion is the format version of config bundles
+const BundleVersion = "1"
+
+// Deploy targets recorded in a bundle
+const (
+	DeployTargetServer  = "server"             // none yet; deploys fail until one is connected
+	DeployTargetCluster = "cluster_credential" // a stored cluster credential
+	DeployTargetAgent   = "agent"              // an in-cluster agent
+)
+
+var (
+	ErrInvalidBundle = errors.New("invalid config bundle")
+	ErrConfigExists  = errors.New("a config for this repository already exists")
+)
+
+// Bundle is a portable description of a config, for backups and for recreating configs
+// on another Nimbul instance. It never contains secrets: the webhook secret, stored
+// credentials and instance-specific IDs are left out and set up again on import.
+type Bundle struct {
+	Version        string       `json:"version" yaml:"version"`
+	ExportedAt     time.Time    `json:"exported_at" yaml:"exported_at"`
+	Provider       string       `json:"provider" yaml:"provider"`
+	RepoOwner      string       `json:"repo_owner" yaml:"repo_owner"`
+	RepoName       string       `json:"repo_name" yaml:"repo_name"`
+	RepoFullName   string       `json:"repo_full_name" yaml:"repo_full_name"`
+	RepoCloneURL   string       `json:"repo_clone_url" yaml:"repo_clone_url"`
+	DockerfilePath string       `json:"dockerfile_path" yaml:"dockerfile_path"`
+	Deploy         BundleDeploy `json:"deploy" yaml:"deploy"`
+}
+
+// BundleDeploy records where a config deploys to. Agents are referenced by name,
+// since their IDs differ between instances.
+type BundleDeploy struct {
+	Target    string `json:"target" yaml:"target" enum:"server,cluster_credential,agent"`
+	AgentName string `json:"agent_name,omitempty" yaml:"agent_name,omitempty"`
+}
+
+type ImportBundleResult struct {
+	ConfigID string
+	// WebhookSecret is generated for the new config; the caller registers it with the provider
+	WebhookSecret string
+}
+
+// NewBundle describes config as a bundle. agentName is the name of the config's agent, if any.
+func NewBundle(config *Config, agentName string) *Bundle {
+	deploy := BundleDeploy{Target: DeployTargetServer}
+	switch {
+	case config.AgentID != nil:
+		deploy = BundleDeploy{Target: DeployTargetAgent, AgentName: agentName}
+	case config.ClusterCredentialID != nil:
+		deploy = BundleDeploy{Target: DeployTargetCluster}
+	}
+
+	return &Bundle{
+		Version:        BundleVersion,
+		ExportedAt:     time.Now().UTC(),
+		Provider:       config.Provider,
+		RepoOwner:      config.RepoOwner,
+		RepoName:       config.RepoName,
+		RepoFullName:   config.RepoFullName,
+		RepoCloneURL:   config.RepoCloneURL,
+		DockerfilePath: config.DockerfilePath,
+		Deploy:         deploy,
+	}
+}
+
+// ValidateBundle checks that a bundle can be imported
+func ValidateBundle(bundle *Bundle) error {
+	if bundle.Version != BundleVersion {
+		return fmt.Errorf("%w: unsupported version %q (expected %q)", ErrInvalidBundle, bundle.Version, BundleVersion)
+	}
+
+	required := map[string]string{
+		"provider":       bundle.Provider,
+		"repo_owner":     bundle.RepoOwner,
+		"repo_name":      bundle.RepoName,
+		"repo_full_name": bundle.RepoFullName,
+		"repo_clone_url": bundle.RepoCloneURL,
+	}
+	for field, value := range required {
+		if value == "" {
+			return fmt.Errorf("%w: %s is required", ErrInvalidBundle, field)
+		}
+	}
+
+	switch bundle.Deploy.Target {
+	case DeployTargetServer, DeployTargetCluster:
+	case DeployTargetAgent:
+		if bundle.Deploy.AgentName == "" {
+			return fmt.Errorf("%w: deploy.agent_name is required for agent deploys", ErrInvalidBundle)
+		}
+	default:
+		return fmt.Errorf("%w: unknown deploy target %q", ErrInvalidBundle, bundle.Deploy.Target)
+	}
+
+	return nil
+}
+
+// ImportBundle creates a config for ownerID from a bundle, with a new webhook secret.
+// Deploy targets are not restored here; they reference credentials and agents the caller resolves.
+func (s *Service) ImportBundle(ctx context.Context, ownerID string, bundle *Bundle) (*ImportBundleResult, error) {
+	if err := ValidateBundle(bundle); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.GetConfigByRepo(ctx, ownerID, bundle.RepoFullName)
+	if err == nil {
+		return nil, &ConfigExistsError{ConfigID: existing.ID}
+	}
+	if !errors.Is(err, ErrConfigNotFound) {
+		return nil, err
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	webhookSecret := hex.EncodeToString(secret)
+
+	result, err := s.CreateConfig(ctx, CreateConfigParams{
+		OwnerID:        ownerID,
+		Provider:       bundle.Provider,
+		RepoOwner:      bundle.RepoOwner,
+		RepoName:       bundle.RepoName,
+		RepoFullName:   bundle.RepoFullName,
+		RepoCloneURL:   bundle.RepoCloneURL,
+		DockerfilePath: bundle.DockerfilePath,
+		WebhookSecret:  webhookSecret,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ImportBundleResult{
+		ConfigID:      result.ConfigID,
+		WebhookSecret: webhookSecret,
+	}, nil
+}
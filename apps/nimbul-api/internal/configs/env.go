@@ -0,0 +1,96 @@
+package configs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/db"
+)
+
+var (
+	ErrEnvNotFound    = errors.New("environment variable not found")
+	ErrInvalidEnvName = errors.New("environment variable names must start with a letter or underscore and contain only letters, digits and underscores")
+)
+
+// envName matches the names of build args, which config environment variables become
+var envName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// EnvVar is a non-secret variable of a config. Builds receive it as a build arg and
+// nimbul.yaml templates as {{ .ENV.<name> }}.
+type EnvVar struct {
+	Name      string
+	Value     string
+	UpdatedAt time.Time
+}
+
+// SetEnv sets environment variables of a config, replacing the values of those already set
+func (s *Service) SetEnv(ctx context.Context, configID string, vars map[string]string) error {
+	for name := range vars {
+		if !envName.MatchString(name) {
+			return fmt.Errorf("%w: %q", ErrInvalidEnvName, name)
+		}
+	}
+
+	for name, value := range vars {
+		err := s.queries.SetConfigEnv(ctx, db.SetConfigEnvParams{
+			ConfigID: configID,
+			Name:     name,
+			Value:    value,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to set environment variable %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// UnsetEnv removes an environment variable of a config
+func (s *Service) UnsetEnv(ctx context.Context, configID, name string) error {
+	deleted, err := s.queries.DeleteConfigEnv(ctx, db.DeleteConfigEnvParams{
+		ConfigID: configID,
+		Name:     name,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to unset environment variable: %w", err)
+	}
+	if deleted == 0 {
+		return ErrEnvNotFound
+	}
+	return nil
+}
+
+// GetEnv retrieves the environment variables of a config, sorted by name
+func (s *Service) GetEnv(ctx context.Context, configID string) ([]EnvVar, error) {
+	dbVars, err := s.queries.GetConfigEnv(ctx, configID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get environment variables: %w", err)
+	}
+
+	vars := make([]EnvVar, len(dbVars))
+	for i, dbVar := range dbVars {
+		vars[i] = EnvVar{
+			Name:      dbVar.Name,
+			Value:     dbVar.Value,
+			UpdatedAt: dbVar.UpdatedAt.Time,
+		}
+	}
+	return vars, nil
+}
+
+// EnvMap returns the environment variables of a config by name
+func (s *Service) EnvMap(ctx context.Context, configID string) (map[string]string, error) {
+	vars, err := s.GetEnv(ctx, configID)
+	if err != nil {
+		return nil, err
+	}
+
+	env := make(map[string]string, len(vars))
+	for _, v := range vars {
+		env[v.Name] = v.Value
+	}
+	return env, nil
+}
@@ -0,0 +1,74 @@
+package configs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/coding-cave-dev/nimbul/internal/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Health states of a config, from cross-checking it against GitHub
+const (
+	HealthUnknown        = "unknown"         // not checked yet
+	HealthOK             = "ok"              // repository, app installation and webhook are in place
+	HealthAppUninstalled = "app_uninstalled" // the GitHub App was removed from the repository's account
+	HealthRepoNotFound   = "repo_not_found"  // the repository was deleted, or the app lost access to it
+	HealthWebhookMissing = "webhook_missing" // pushes do not reach Nimbul
+)
+
+// SetHealth records the result of checking a config; detail says what is wrong and how
+// to fix it
+func (s *Service) SetHealth(ctx context.Context, configID, health, detail string) error {
+	err := s.queries.UpdateConfigHealth(ctx, db.UpdateConfigHealthParams{
+		ID:           configID,
+		Health:       health,
+		HealthDetail: detail,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update config health: %w", err)
+	}
+
+	return nil
+}
+
+// RenameRepository points a config at the new name of its renamed repository. Returns
+// ErrConfigExists when the owner already has a config for the new name.
+func (s *Service) RenameRepository(ctx context.Context, configID, repoOwner, repoName, repoFullName, repoCloneURL string) (*Config, error) {
+	config, err := s.queries.UpdateConfigRepository(ctx, db.UpdateConfigRepositoryParams{
+		ID:           configID,
+		RepoOwner:    repoOwner,
+		RepoName:     repoName,
+		RepoFullName: repoFullName,
+		RepoCloneUrl: repoCloneURL,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrConfigNotFound
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return nil, ErrConfigExists
+		}
+		return nil, fmt.Errorf("failed to rename config repository: %w", err)
+	}
+
+	return dbConfigToConfig(config), nil
+}
+
+// ClearWebhookID forgets the GitHub webhook of a config once it was removed on GitHub,
+// so a new one can be created. A webhook other than webhookID is kept.
+func (s *Service) ClearWebhookID(ctx context.Context, configID string, webhookID int64) error {
+	_, err := s.queries.ClearConfigWebhookID(ctx, db.ClearConfigWebhookIDParams{
+		ID:        configID,
+		WebhookID: pgtype.Int8{Int64: webhookID, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to clear webhook ID: %w", err)
+	}
+
+	return nil
+}
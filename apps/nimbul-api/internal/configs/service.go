@@ -2,13 +2,29 @@ package configs
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/coding-cave-dev/nimbul/internal/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/oklog/ulid/v2"
 )
 
+var (
+	ErrInvalidRetention = errors.New("keep_last must be at least 1")
+	ErrConfigNotFound   = errors.New("config not found")
+	ErrExternalIDTaken  = errors.New("external_id is already used by a config with different settings")
+	// ErrVersionMismatch means the config changed since the client read the version it expects
+	ErrVersionMismatch = errors.New("config was changed since it was read")
+)
+
 type Service struct {
 	queries *db.Queries
 }
@@ -28,10 +44,15 @@ type CreateConfigParams struct {
 	RepoCloneURL   string
 	DockerfilePath string
 	WebhookSecret  string
+	// ExternalID is chosen by the client; creating a config with one that is already
+	// used returns the existing config instead of a duplicate
+	ExternalID string
 }
 
 type CreateConfigResult struct {
 	ConfigID string
+	// Existing is set when an earlier create with the same ExternalID made the config
+	Existing bool
 }
 
 type Config struct {
@@ -45,12 +66,72 @@ type Config struct {
 	DockerfilePath string
 	WebhookSecret  string
 	WebhookID      *int64
-	CreatedAt      pgtype.Timestamptz
-	UpdatedAt      pgtype.Timestamptz
+	// ClusterCredentialID references the stored cluster credential used for deploys.
+	// When nil, the config can only deploy through an agent.
+	ClusterCredentialID *int64
+	// AgentID references the in-cluster agent that applies this config's manifests.
+	// When set, deploys are queued for the agent instead of applied directly.
+	AgentID *string
+	// Retention rules for the tags this config's builds push.
+	// RetentionKeepLast is how many builds per branch keep their tags; nil keeps all.
+	// RetentionDeletePreviews deletes a branch's tags once the branch is deleted.
+	RetentionKeepLast       *int
+	RetentionDeletePreviews bool
+	// RegistryWebhookToken authenticates inbound registry webhooks; nil disables them.
+	RegistryWebhookToken *string
+	ExternalID           *string
+	// DeniedAuthors are the commit authors whose pushes don't trigger builds, matched
+	// against their GitHub login, name or email
+	DeniedAuthors []string
+	// SecretScan blocks builds whose context has files that look like secrets, unless
+	// SecretScanAllowlist accepts them by rule ID or path pattern
+	SecretScan          bool
+	SecretScanAllowlist []string
+	// Health is the result of the last check against GitHub, one of the Health
+	// constants; HealthDetail says what is wrong and how to fix it
+	Health          string
+	HealthDetail    string
+	HealthCheckedAt pgtype.Timestamptz
+	// Version is bumped on every change, for optimistic locking
+	Version   int32
+	CreatedAt pgtype.Timestamptz
+	UpdatedAt pgtype.Timestamptz
+	// DeletedAt is set while the config is in the trash
+	DeletedAt pgtype.Timestamptz
+}
+
+// ConfigExistsError is returned when the owner already has a config for a repository.
+// It matches ErrConfigExists.
+type ConfigExistsError struct {
+	ConfigID string // the existing config
+}
+
+func (e *ConfigExistsError) Error() string {
+	return fmt.Sprintf("%v: %s", ErrConfigExists, e.ConfigID)
 }
 
-// CreateConfig creates a new repo configuration
+func (e *ConfigExistsError) Unwrap() error {
+	return ErrConfigExists
+}
+
+// CreateConfig creates a new repo configuration. Returns a *ConfigExistsError when the
+// owner already has a config for the repository, and ErrExternalIDTaken when
+// params.ExternalID belongs to a config with different settings.
 func (s *Service) CreateConfig(ctx context.Context, params CreateConfigParams) (*CreateConfigResult, error) {
+	// A retried create returns the config the first attempt made
+	if params.ExternalID != "" {
+		existing, err := s.GetConfigByExternalID(ctx, params.OwnerID, params.ExternalID)
+		if err == nil {
+			if !existing.Matches(params) {
+				return nil, ErrExternalIDTaken
+			}
+			return &CreateConfigResult{ConfigID: existing.ID, Existing: true}, nil
+		}
+		if !errors.Is(err, ErrConfigNotFound) {
+			return nil, err
+		}
+	}
+
 	// Generate ULID for config ID
 	configID := ulid.Make().String()
 
@@ -66,8 +147,17 @@ func (s *Service) CreateConfig(ctx context.Context, params CreateConfigParams) (
 		DockerfilePath: params.DockerfilePath,
 		WebhookSecret:  params.WebhookSecret,
 		WebhookID:      pgtype.Int8{Valid: false}, // Will be set after webhook creation
+		ExternalID:     pgtype.Text{String: params.ExternalID, Valid: params.ExternalID != ""},
 	})
 	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			if pgErr.ConstraintName == "repo_configs_external_id_unique" {
+				// A concurrent create with the same external ID won
+				return nil, ErrExternalIDTaken
+			}
+			return nil, s.configExists(ctx, params.OwnerID, params.RepoFullName)
+		}
 		return nil, fmt.Errorf("failed to create config: %w", err)
 	}
 
@@ -76,16 +166,234 @@ func (s *Service) CreateConfig(ctx context.Context, params CreateConfigParams) (
 	}, nil
 }
 
+// Matches reports whether the config was created with params, ignoring the webhook ID
+// and everything set after creation
+func (c *Config) Matches(params CreateConfigParams) bool {
+	return c.OwnerID == params.OwnerID &&
+		c.Provider == params.Provider &&
+		c.RepoOwner == params.RepoOwner &&
+		c.RepoName == params.RepoName &&
+		c.RepoFullName == params.RepoFullName &&
+		c.RepoCloneURL == params.RepoCloneURL &&
+		c.DockerfilePath == params.DockerfilePath &&
+		c.WebhookSecret == params.WebhookSecret
+}
+
 // GetConfigByID retrieves a config by its ID
 func (s *Service) GetConfigByID(ctx context.Context, id string) (*Config, error) {
 	config, err := s.queries.GetConfigByID(ctx, id)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrConfigNotFound
+		}
 		return nil, fmt.Errorf("failed to get config: %w", err)
 	}
 
 	return dbConfigToConfig(config), nil
 }
 
+// GetConfigByIDAndOwner retrieves a config of ownerID; configs of other owners are not
+// found
+func (s *Service) GetConfigByIDAndOwner(ctx context.Context, id, ownerID string) (*Config, error) {
+	config, err := s.queries.GetConfigByIDAndOwner(ctx, db.GetConfigByIDAndOwnerParams{
+		ID:      id,
+		OwnerID: ownerID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrConfigNotFound
+		}
+		return nil, fmt.Errorf("failed to get config: %w", err)
+	}
+
+	return dbConfigToConfig(config), nil
+}
+
+// GetConfigByExternalID retrieves the config an owner created with an external ID
+func (s *Service) GetConfigByExternalID(ctx context.Context, ownerID, externalID string) (*Config, error) {
+	config, err := s.queries.GetConfigByOwnerIDAndExternalID(ctx, db.GetConfigByOwnerIDAndExternalIDParams{
+		OwnerID:    ownerID,
+		ExternalID: pgtype.Text{String: externalID, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrConfigNotFound
+		}
+		return nil, fmt.Errorf("failed to get config: %w", err)
+	}
+
+	return dbConfigToConfig(config), nil
+}
+
+// GetConfigByRepo returns the config of an owner for a repository, whose name is
+// matched case-insensitively like GitHub does
+func (s *Service) GetConfigByRepo(ctx context.Context, ownerID, repoFullName string) (*Config, error) {
+	config, err := s.queries.GetConfigByOwnerIDAndRepoFullName(ctx, db.GetConfigByOwnerIDAndRepoFullNameParams{
+		OwnerID:      ownerID,
+		RepoFullName: repoFullName,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrConfigNotFound
+		}
+		return nil, fmt.Errorf("failed to get config: %w", err)
+	}
+
+	return dbConfigToConfig(config), nil
+}
+
+// configExists returns the error for a repository the owner already has a config for,
+// naming that config when it can be found
+func (s *Service) configExists(ctx context.Context, ownerID, repoFullName string) error {
+	existing, err := s.GetConfigByRepo(ctx, ownerID, repoFullName)
+	if err != nil {
+		return ErrConfigExists
+	}
+	return &ConfigExistsError{ConfigID: existing.ID}
+}
+
+type UpdateConfigParams struct {
+	ID             string
+	RepoCloneURL   *string
+	DockerfilePath *string
+	WebhookSecret  *string
+	// ExpectedVersion makes the update fail with ErrVersionMismatch when the config
+	// has changed since; nil updates whatever the version
+	ExpectedVersion *int32
+}
+
+// UpdateConfig changes the settings of a config; nil fields keep their value
+func (s *Service) UpdateConfig(ctx context.Context, params UpdateConfigParams) (*Config, error) {
+	config, err := s.queries.UpdateConfig(ctx, db.UpdateConfigParams{
+		ID:              params.ID,
+		RepoCloneUrl:    optionalText(params.RepoCloneURL),
+		DockerfilePath:  optionalText(params.DockerfilePath),
+		WebhookSecret:   optionalText(params.WebhookSecret),
+		ExpectedVersion: optionalVersion(params.ExpectedVersion),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, s.missingOrChanged(ctx, params.ID)
+		}
+		return nil, fmt.Errorf("failed to update config: %w", err)
+	}
+
+	return dbConfigToConfig(config), nil
+}
+
+// DeleteConfig moves a config to the trash, where it keeps its deployments and
+// environment until it is purged; its pending transfer is cancelled. expectedVersion works as in
+// UpdateConfigParams.
+func (s *Service) DeleteConfig(ctx context.Context, id string, expectedVersion *int32) error {
+	deleted, err := s.queries.DeleteConfig(ctx, db.DeleteConfigParams{
+		ID:              id,
+		ExpectedVersion: optionalVersion(expectedVersion),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete config: %w", err)
+	}
+	if deleted == 0 {
+		return s.missingOrChanged(ctx, id)
+	}
+
+	if _, err := s.queries.DeleteConfigTransferByConfigID(ctx, id); err != nil {
+		return fmt.Errorf("failed to cancel transfer of deleted config: %w", err)
+	}
+
+	return nil
+}
+
+// GetDeletedConfigByIDAndOwner retrieves a config of ownerID in the trash
+func (s *Service) GetDeletedConfigByIDAndOwner(ctx context.Context, id, ownerID string) (*Config, error) {
+	config, err := s.queries.GetDeletedConfigByIDAndOwner(ctx, db.GetDeletedConfigByIDAndOwnerParams{
+		ID:      id,
+		OwnerID: ownerID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrConfigNotFound
+		}
+		return nil, fmt.Errorf("failed to get deleted config: %w", err)
+	}
+
+	return dbConfigToConfig(config), nil
+}
+
+// GetDeletedConfigsByOwnerID retrieves the configs of an owner in the trash, most
+// recently deleted first
+func (s *Service) GetDeletedConfigsByOwnerID(ctx context.Context, ownerID string) ([]Config, error) {
+	dbConfigs, err := s.queries.GetDeletedConfigsByOwnerID(ctx, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deleted configs: %w", err)
+	}
+
+	result := make([]Config, len(dbConfigs))
+	for i, dbConfig := range dbConfigs {
+		result[i] = *dbConfigToConfig(dbConfig)
+	}
+	return result, nil
+}
+
+// RestoreConfig takes a config of ownerID out of the trash. Returns a *ConfigExistsError
+// when the owner configured the repository again in the meantime.
+func (s *Service) RestoreConfig(ctx context.Context, id, ownerID string) (*Config, error) {
+	config, err := s.queries.RestoreConfig(ctx, db.RestoreConfigParams{
+		ID:      id,
+		OwnerID: ownerID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrConfigNotFound
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			if pgErr.ConstraintName == "repo_configs_external_id_unique" {
+				return nil, ErrExternalIDTaken
+			}
+			deleted, getErr := s.GetDeletedConfigByIDAndOwner(ctx, id, ownerID)
+			if getErr != nil {
+				return nil, ErrConfigExists
+			}
+			return nil, s.configExists(ctx, ownerID, deleted.RepoFullName)
+		}
+		return nil, fmt.Errorf("failed to restore config: %w", err)
+	}
+
+	return dbConfigToConfig(config), nil
+}
+
+// PurgeDeletedConfigs deletes the configs that went to the trash before the given time,
+// with their deployments; builds keep their usage. Returns how many were purged.
+func (s *Service) PurgeDeletedConfigs(ctx context.Context, before time.Time) (int64, error) {
+	purged, err := s.queries.PurgeDeletedConfigs(ctx, pgtype.Timestamptz{Time: before, Valid: true})
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge deleted configs: %w", err)
+	}
+	return purged, nil
+}
+
+// missingOrChanged tells why a versioned write to a config matched no row
+func (s *Service) missingOrChanged(ctx context.Context, id string) error {
+	if _, err := s.GetConfigByID(ctx, id); err != nil {
+		return err
+	}
+	return ErrVersionMismatch
+}
+
+func optionalText(value *string) pgtype.Text {
+	if value == nil {
+		return pgtype.Text{}
+	}
+	return pgtype.Text{String: *value, Valid: true}
+}
+
+func optionalVersion(version *int32) pgtype.Int4 {
+	if version == nil {
+		return pgtype.Int4{}
+	}
+	return pgtype.Int4{Int32: *version, Valid: true}
+}
+
 // GetConfigByWebhookID retrieves a config by its webhook ID
 func (s *Service) GetConfigByWebhookID(ctx context.Context, webhookID int64) (*Config, error) {
 	config, err := s.queries.GetConfigByWebhookID(ctx, pgtype.Int8{Int64: webhookID, Valid: true})
@@ -111,19 +419,182 @@ func (s *Service) GetConfigsByOwnerID(ctx context.Context, ownerID string) ([]Co
 	return result, nil
 }
 
-// UpdateWebhookID updates the webhook ID for a config
-func (s *Service) UpdateWebhookID(ctx context.Context, configID string, webhookID int64) error {
+// GetAllConfigs retrieves the configs of every user
+func (s *Service) GetAllConfigs(ctx context.Context) ([]Config, error) {
+	configs, err := s.queries.GetAllConfigs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get configs: %w", err)
+	}
+
+	result := make([]Config, len(configs))
+	for i, c := range configs {
+		result[i] = *dbConfigToConfig(c)
+	}
+
+	return result, nil
+}
+
+// UpdateWebhookID updates the webhook ID for a config of ownerID
+func (s *Service) UpdateWebhookID(ctx context.Context, configID, ownerID string, webhookID int64) error {
 	_, err := s.queries.UpdateConfigWebhookID(ctx, db.UpdateConfigWebhookIDParams{
 		ID:        configID,
+		OwnerID:   ownerID,
 		WebhookID: pgtype.Int8{Int64: webhookID, Valid: true},
 	})
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrConfigNotFound
+		}
 		return fmt.Errorf("failed to update webhook ID: %w", err)
 	}
 
 	return nil
 }
 
+// UpdateClusterCredentialID sets the cluster credential used when deploying a config
+func (s *Service) UpdateClusterCredentialID(ctx context.Context, configID string, credentialID int64) error {
+	_, err := s.queries.UpdateConfigClusterCredentialID(ctx, db.UpdateConfigClusterCredentialIDParams{
+		ID:                  configID,
+		ClusterCredentialID: pgtype.Int8{Int64: credentialID, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update cluster credential: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateAgentID sets the agent that applies a config's manifests
+func (s *Service) UpdateAgentID(ctx context.Context, configID string, agentID string) error {
+	_, err := s.queries.UpdateConfigAgentID(ctx, db.UpdateConfigAgentIDParams{
+		ID:      configID,
+		AgentID: pgtype.Text{String: agentID, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update agent: %w", err)
+	}
+
+	return nil
+}
+
+// GetConfigsWithRetention retrieves the configs that have registry retention rules
+func (s *Service) GetConfigsWithRetention(ctx context.Context) ([]Config, error) {
+	configs, err := s.queries.GetConfigsWithRetention(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get configs: %w", err)
+	}
+
+	result := make([]Config, len(configs))
+	for i, c := range configs {
+		result[i] = *dbConfigToConfig(c)
+	}
+
+	return result, nil
+}
+
+// UpdateRetention sets the registry retention rules of a config. A nil keepLast keeps
+// the tags of every build.
+func (s *Service) UpdateRetention(ctx context.Context, configID string, keepLast *int, deletePreviews bool) error {
+	if keepLast != nil && *keepLast < 1 {
+		return ErrInvalidRetention
+	}
+
+	keepLastValue := pgtype.Int4{}
+	if keepLast != nil {
+		keepLastValue = pgtype.Int4{Int32: int32(*keepLast), Valid: true}
+	}
+
+	_, err := s.queries.UpdateConfigRetention(ctx, db.UpdateConfigRetentionParams{
+		ID:                      configID,
+		RetentionKeepLast:       keepLastValue,
+		RetentionDeletePreviews: deletePreviews,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update retention: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateDeniedAuthors sets the commit authors whose pushes don't trigger builds of a
+// config, dropping blank and repeated entries
+func (s *Service) UpdateDeniedAuthors(ctx context.Context, configID string, authors []string) ([]string, error) {
+	denied := make([]string, 0, len(authors))
+	for _, author := range authors {
+		author = strings.TrimSpace(author)
+		if author == "" || slices.ContainsFunc(denied, func(d string) bool { return strings.EqualFold(d, author) }) {
+			continue
+		}
+		denied = append(denied, author)
+	}
+
+	config, err := s.queries.UpdateConfigDeniedAuthors(ctx, db.UpdateConfigDeniedAuthorsParams{
+		ID:            configID,
+		DeniedAuthors: denied,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update denied authors: %w", err)
+	}
+
+	return config.DeniedAuthors, nil
+}
+
+// UpdateSecretScan turns scanning the build contexts of a config for secrets on or
+// off and sets the findings it accepts, dropping blank and repeated entries
+func (s *Service) UpdateSecretScan(ctx context.Context, configID string, enabled bool, allowlist []string) (*Config, error) {
+	allowed := make([]string, 0, len(allowlist))
+	for _, entry := range allowlist {
+		entry = strings.TrimSpace(entry)
+		if entry == "" || slices.Contains(allowed, entry) {
+			continue
+		}
+		allowed = append(allowed, entry)
+	}
+
+	config, err := s.queries.UpdateConfigSecretScan(ctx, db.UpdateConfigSecretScanParams{
+		ID:                  configID,
+		SecretScan:          enabled,
+		SecretScanAllowlist: allowed,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update secret scan: %w", err)
+	}
+
+	return dbConfigToConfig(config), nil
+}
+
+// RotateRegistryWebhookToken generates a new token for the registry webhook of a config,
+// invalidating the previous one
+func (s *Service) RotateRegistryWebhookToken(ctx context.Context, configID string) (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("failed to generate registry webhook token: %w", err)
+	}
+	token := hex.EncodeToString(secret)
+
+	_, err := s.queries.UpdateConfigRegistryWebhookToken(ctx, db.UpdateConfigRegistryWebhookTokenParams{
+		ID:                   configID,
+		RegistryWebhookToken: pgtype.Text{String: token, Valid: true},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to update registry webhook token: %w", err)
+	}
+
+	return token, nil
+}
+
+// DisableRegistryWebhook removes the registry webhook token of a config
+func (s *Service) DisableRegistryWebhook(ctx context.Context, configID string) error {
+	_, err := s.queries.UpdateConfigRegistryWebhookToken(ctx, db.UpdateConfigRegistryWebhookTokenParams{
+		ID: configID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update registry webhook token: %w", err)
+	}
+
+	return nil
+}
+
 // dbConfigToConfig converts a db.RepoConfig to a configs.Config
 func dbConfigToConfig(dbConfig db.RepoConfig) *Config {
 	var webhookID *int64
@@ -131,18 +602,58 @@ func dbConfigToConfig(dbConfig db.RepoConfig) *Config {
 		webhookID = &dbConfig.WebhookID.Int64
 	}
 
+	var clusterCredentialID *int64
+	if dbConfig.ClusterCredentialID.Valid {
+		clusterCredentialID = &dbConfig.ClusterCredentialID.Int64
+	}
+
+	var agentID *string
+	if dbConfig.AgentID.Valid {
+		agentID = &dbConfig.AgentID.String
+	}
+
+	var retentionKeepLast *int
+	if dbConfig.RetentionKeepLast.Valid {
+		keepLast := int(dbConfig.RetentionKeepLast.Int32)
+		retentionKeepLast = &keepLast
+	}
+
+	var registryWebhookToken *string
+	if dbConfig.RegistryWebhookToken.Valid {
+		registryWebhookToken = &dbConfig.RegistryWebhookToken.String
+	}
+
+	var externalID *string
+	if dbConfig.ExternalID.Valid {
+		externalID = &dbConfig.ExternalID.String
+	}
+
 	return &Config{
-		ID:             dbConfig.ID,
-		OwnerID:        dbConfig.OwnerID,
-		Provider:       dbConfig.Provider,
-		RepoOwner:      dbConfig.RepoOwner,
-		RepoName:       dbConfig.RepoName,
-		RepoFullName:   dbConfig.RepoFullName,
-		RepoCloneURL:   dbConfig.RepoCloneUrl,
-		DockerfilePath: dbConfig.DockerfilePath,
-		WebhookSecret:  dbConfig.WebhookSecret,
-		WebhookID:      webhookID,
-		CreatedAt:      dbConfig.CreatedAt,
-		UpdatedAt:      dbConfig.UpdatedAt,
+		ID:                      dbConfig.ID,
+		OwnerID:                 dbConfig.OwnerID,
+		Provider:                dbConfig.Provider,
+		RepoOwner:               dbConfig.RepoOwner,
+		RepoName:                dbConfig.RepoName,
+		RepoFullName:            dbConfig.RepoFullName,
+		RepoCloneURL:            dbConfig.RepoCloneUrl,
+		DockerfilePath:          dbConfig.DockerfilePath,
+		WebhookSecret:           dbConfig.WebhookSecret,
+		WebhookID:               webhookID,
+		ClusterCredentialID:     clusterCredentialID,
+		AgentID:                 agentID,
+		RetentionKeepLast:       retentionKeepLast,
+		RetentionDeletePreviews: dbConfig.RetentionDeletePreviews,
+		RegistryWebhookToken:    registryWebhookToken,
+		ExternalID:              externalID,
+		DeniedAuthors:           dbConfig.DeniedAuthors,
+		SecretScan:              dbConfig.SecretScan,
+		SecretScanAllowlist:     dbConfig.SecretScanAllowlist,
+		Health:                  dbConfig.Health,
+		HealthDetail:            dbConfig.HealthDetail,
+		HealthCheckedAt:         dbConfig.HealthCheckedAt,
+		Version:                 dbConfig.Version,
+		CreatedAt:               dbConfig.CreatedAt,
+		UpdatedAt:               dbConfig.UpdatedAt,
+		DeletedAt:               dbConfig.DeletedAt,
 	}
 }
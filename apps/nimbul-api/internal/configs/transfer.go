@@ -0,0 +1,157 @@
+package configs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/oklog/ulid/v2"
+)
+
+var (
+	ErrTransferNotFound          = errors.New("transfer not found")
+	ErrTransferRecipientNotFound = errors.New("no user with that email")
+	ErrTransferToSelf            = errors.New("the config already belongs to that user")
+	ErrTransferRepoTaken         = errors.New("the recipient already has a config for this repository")
+)
+
+// Transfer is a pending transfer of a config to another user
+type Transfer struct {
+	ID           string
+	ConfigID     string
+	RepoFullName string
+	FromUserID   string
+	FromEmail    string
+	ToUserID     string
+	ToEmail      string
+	CreatedAt    time.Time
+}
+
+// StartTransfer offers a config to the user with toEmail. The config keeps its owner
+// until the recipient accepts. Starting a transfer replaces the pending one, if any.
+func (s *Service) StartTransfer(ctx context.Context, config *Config, toEmail string) (*Transfer, error) {
+	recipient, err := s.queries.GetUserByEmail(ctx, toEmail)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrTransferRecipientNotFound
+		}
+		return nil, fmt.Errorf("failed to get recipient: %w", err)
+	}
+
+	if recipient.ID == config.OwnerID {
+		return nil, ErrTransferToSelf
+	}
+
+	transfer, err := s.queries.CreateConfigTransfer(ctx, db.CreateConfigTransferParams{
+		ID:         ulid.Make().String(),
+		ConfigID:   config.ID,
+		FromUserID: config.OwnerID,
+		ToUserID:   recipient.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create transfer: %w", err)
+	}
+
+	return s.GetTransferByID(ctx, transfer.ID)
+}
+
+// CancelTransfer withdraws the pending transfer of a config
+func (s *Service) CancelTransfer(ctx context.Context, configID string) error {
+	deleted, err := s.queries.DeleteConfigTransferByConfigID(ctx, configID)
+	if err != nil {
+		return fmt.Errorf("failed to cancel transfer: %w", err)
+	}
+	if deleted == 0 {
+		return ErrTransferNotFound
+	}
+	return nil
+}
+
+// GetTransferByID retrieves a pending transfer by its ID
+func (s *Service) GetTransferByID(ctx context.Context, id string) (*Transfer, error) {
+	transfer, err := s.queries.GetConfigTransferByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrTransferNotFound
+		}
+		return nil, fmt.Errorf("failed to get transfer: %w", err)
+	}
+
+	return dbTransferToTransfer(db.GetConfigTransfersByToUserIDRow(transfer)), nil
+}
+
+// GetIncomingTransfers retrieves the transfers waiting for a user to accept them, newest first
+func (s *Service) GetIncomingTransfers(ctx context.Context, userID string) ([]Transfer, error) {
+	transfers, err := s.queries.GetConfigTransfersByToUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transfers: %w", err)
+	}
+
+	result := make([]Transfer, len(transfers))
+	for i, t := range transfers {
+		result[i] = *dbTransferToTransfer(t)
+	}
+	return result, nil
+}
+
+// AcceptTransfer makes the recipient of a transfer the owner of its config. Builds and
+// deployments belong to the config and move with it. The cluster credential and agent
+// the config deployed with belong to the previous owner, so they are unset, and the
+// deploy tokens they issued are revoked along with the transfer. Returns how many were.
+func (s *Service) AcceptTransfer(ctx context.Context, transfer *Transfer) (*Config, int64, error) {
+	_, err := s.queries.GetConfigByOwnerIDAndRepoFullName(ctx, db.GetConfigByOwnerIDAndRepoFullNameParams{
+		OwnerID:      transfer.ToUserID,
+		RepoFullName: transfer.RepoFullName,
+	})
+	if err == nil {
+		return nil, 0, ErrTransferRepoTaken
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, 0, fmt.Errorf("failed to check recipient configs: %w", err)
+	}
+
+	accepted, err := s.queries.AcceptConfigTransfer(ctx, db.AcceptConfigTransferParams{
+		ID:       transfer.ID,
+		ToUserID: transfer.ToUserID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, 0, ErrTransferNotFound
+		}
+		return nil, 0, fmt.Errorf("failed to accept transfer: %w", err)
+	}
+
+	return dbConfigToConfig(accepted.RepoConfig), accepted.RevokedDeployTokens, nil
+}
+
+// DeclineTransfer deletes a transfer offered to userID
+func (s *Service) DeclineTransfer(ctx context.Context, id, userID string) error {
+	deleted, err := s.queries.DeleteConfigTransferForRecipient(ctx, db.DeleteConfigTransferForRecipientParams{
+		ID:       id,
+		ToUserID: userID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to decline transfer: %w", err)
+	}
+	if deleted == 0 {
+		return ErrTransferNotFound
+	}
+	return nil
+}
+
+// dbTransferToTransfer converts a transfer row to a configs.Transfer
+func dbTransferToTransfer(transfer db.GetConfigTransfersByToUserIDRow) *Transfer {
+	return &Transfer{
+		ID:           transfer.ID,
+		ConfigID:     transfer.ConfigID,
+		RepoFullName: transfer.RepoFullName,
+		FromUserID:   transfer.FromUserID,
+		FromEmail:    transfer.FromEmail,
+		ToUserID:     transfer.ToUserID,
+		ToEmail:      transfer.ToEmail,
+		CreatedAt:    transfer.CreatedAt.Time,
+	}
+}
@@ -0,0 +1,142 @@
+package credentials
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/db"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	// DefaultExpiryWarningDays is how many days before expiry owners are warned,
+	// unless NIMBUL_CREDENTIAL_EXPIRY_WARNING_DAYS is set
+	DefaultExpiryWarningDays = 7
+	// expiryCheckInterval is how often the watcher looks for expiring credentials
+	expiryCheckInterval = time.Hour
+)
+
+// ExpiringCredential is a stored credential that expires soon or has expired.
+// Short-lived OAuth access tokens are never reported; they are refreshed automatically.
+type ExpiringCredential struct {
+	ID        int64
+	OwnerID   string
+	Provider  string
+	TokenType string
+	ExpiresAt time.Time
+}
+
+// ExpiryWarningWindow returns how long before expiry owners are warned
+func ExpiryWarningWindow() time.Duration {
+	days := DefaultExpiryWarningDays
+	if value := os.Getenv("NIMBUL_CREDENTIAL_EXPIRY_WARNING_DAYS"); value != "" {
+		if parsed, err := strconv.Atoi(value); err == nil && parsed > 0 {
+			days = parsed
+		} else {
+			fmt.Printf("Warning: Invalid NIMBUL_CREDENTIAL_EXPIRY_WARNING_DAYS %q, using %d\n", value, days)
+		}
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// GetExpiringCredentials retrieves the credentials of ownerID that expire within window,
+// including those that already expired
+func (s *Service) GetExpiringCredentials(ctx context.Context, ownerID string, window time.Duration) ([]ExpiringCredential, error) {
+	credentials, err := s.queries.GetCredentialsExpiringBeforeByOwnerID(ctx, db.GetCredentialsExpiringBeforeByOwnerIDParams{
+		OwnerID:   ownerID,
+		ExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(window), Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get expiring credentials: %w", err)
+	}
+
+	return dbCredentialsToExpiring(credentials), nil
+}
+
+// ExpiryNotifyFunc notifies an owner about their expiring credentials
+type ExpiryNotifyFunc func(ctx context.Context, ownerID string, expiring []ExpiringCredential) error
+
+// ExpiryWatcher periodically warns owners about credentials that are about to expire.
+// Each owner is warned once per expiry date; storing a renewed credential re-arms the warning.
+type ExpiryWatcher struct {
+	credentialsService *Service
+	notify             ExpiryNotifyFunc
+	window             time.Duration
+}
+
+func NewExpiryWatcher(credentialsService *Service, notify ExpiryNotifyFunc) *ExpiryWatcher {
+	return &ExpiryWatcher{
+		credentialsService: credentialsService,
+		notify:             notify,
+		window:             ExpiryWarningWindow(),
+	}
+}
+
+// Run checks for expiring credentials every hour until ctx is cancelled
+func (w *ExpiryWatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(expiryCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.Check(ctx)
+		}
+	}
+}
+
+// Check warns the owners of credentials expiring within the warning window that
+// were not warned about yet. Failed notifications are retried on the next check.
+func (w *ExpiryWatcher) Check(ctx context.Context) {
+	queries := w.credentialsService.queries
+
+	credentials, err := queries.GetUnnotifiedCredentialsExpiringBefore(ctx, pgtype.Timestamptz{Time: time.Now().Add(w.window), Valid: true})
+	if err != nil {
+		fmt.Printf("Warning: Failed to check for expiring credentials: %v\n", err)
+		return
+	}
+
+	// Group by owner so each owner gets a single notification
+	byOwner := make(map[string][]ExpiringCredential)
+	var owners []string
+	for _, credential := range dbCredentialsToExpiring(credentials) {
+		if _, ok := byOwner[credential.OwnerID]; !ok {
+			owners = append(owners, credential.OwnerID)
+		}
+		byOwner[credential.OwnerID] = append(byOwner[credential.OwnerID], credential)
+	}
+
+	for _, ownerID := range owners {
+		expiring := byOwner[ownerID]
+		if err := w.notify(ctx, ownerID, expiring); err != nil {
+			fmt.Printf("Warning: Failed to notify %s about expiring credentials: %v\n", ownerID, err)
+			continue
+		}
+
+		for _, credential := range expiring {
+			if err := queries.MarkCredentialExpiryNotified(ctx, credential.ID); err != nil {
+				fmt.Printf("Warning: Failed to mark credential %d as notified: %v\n", credential.ID, err)
+			}
+		}
+	}
+}
+
+// dbCredentialsToExpiring converts db.Credentials to ExpiringCredentials
+func dbCredentialsToExpiring(credentials []db.Credential) []ExpiringCredential {
+	result := make([]ExpiringCredential, len(credentials))
+	for i, c := range credentials {
+		result[i] = ExpiringCredential{
+			ID:        c.ID,
+			OwnerID:   c.OwnerID,
+			Provider:  c.Provider,
+			TokenType: c.TokenType,
+			ExpiresAt: c.ExpiresAt.Time,
+		}
+	}
+	return result
+}
@@ -0,0 +1,250 @@
+package credentials
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var (
+	ErrCredentialNotFound = errors.New("credential not found")
+	ErrCredentialExists   = errors.New("a credential of this provider and token type already exists")
+	ErrExternalIDTaken    = errors.New("external_id is already used by a credential with different settings")
+	ErrCredentialInUse    = errors.New("credential is used by a config")
+	// ErrVersionMismatch means the credential changed since the client read the version it expects
+	ErrVersionMismatch = errors.New("credential was changed since it was read")
+)
+
+// Credential describes a stored credential without its token
+type Credential struct {
+	ID         int64
+	Provider   string
+	TokenType  string
+	ExternalID *string
+	// Version is bumped on every change, for optimistic locking
+	Version    int32
+	CreatedAt  time.Time
+	LastUsedAt *time.Time
+	ExpiresAt  *time.Time
+	// DeletedAt is set while the credential is in the trash
+	DeletedAt *time.Time
+}
+
+// ListCredentials returns the credentials of an owner, oldest first
+func (s *Service) ListCredentials(ctx context.Context, ownerID string) ([]Credential, error) {
+	credentials, err := s.queries.GetCredentialsByOwnerID(ctx, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list credentials: %w", err)
+	}
+
+	result := make([]Credential, len(credentials))
+	for i, credential := range credentials {
+		result[i] = *dbCredentialToCredential(credential)
+	}
+	return result, nil
+}
+
+// GetCredential returns a credential of an owner
+func (s *Service) GetCredential(ctx context.Context, ownerID string, id int64) (*Credential, error) {
+	credential, err := s.queries.GetCredentialByIDAndOwnerID(ctx, db.GetCredentialByIDAndOwnerIDParams{
+		ID:      id,
+		OwnerID: ownerID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCredentialNotFound
+		}
+		return nil, fmt.Errorf("failed to get credential: %w", err)
+	}
+	return dbCredentialToCredential(credential), nil
+}
+
+type ReplaceCredentialParams struct {
+	OwnerID   string
+	ID        int64
+	Token     string
+	ExpiresAt time.Time
+	// ExpectedVersion makes the replace fail with ErrVersionMismatch when the credential
+	// has changed since; nil replaces whatever the version
+	ExpectedVersion *int32
+}
+
+// ReplaceCredential swaps the token of a credential for a new one, encrypted with a new DEK
+func (s *Service) ReplaceCredential(ctx context.Context, params ReplaceCredentialParams) (*Credential, error) {
+	sealed, err := s.Seal([]byte(params.Token))
+	if err != nil {
+		return nil, err
+	}
+
+	credential, err := s.queries.UpdateCredentialByID(ctx, db.UpdateCredentialByIDParams{
+		ID:              params.ID,
+		OwnerID:         params.OwnerID,
+		Ciphertext:      sealed.Ciphertext,
+		TokenNonce:      sealed.TokenNonce,
+		WrappedDek:      sealed.WrappedDEK,
+		DekNonce:        sealed.DEKNonce,
+		ExpiresAt:       expiresAt(params.ExpiresAt),
+		ExpectedVersion: optionalVersion(params.ExpectedVersion),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, s.missingOrChanged(ctx, params.OwnerID, params.ID)
+		}
+		return nil, fmt.Errorf("failed to update credential: %w", err)
+	}
+
+	return dbCredentialToCredential(credential), nil
+}
+
+// DeleteCredential moves a credential no config deploys with to the trash, where it
+// stays until it is purged. expectedVersion works as in ReplaceCredentialParams.
+func (s *Service) DeleteCredential(ctx context.Context, ownerID string, id int64, expectedVersion *int32) error {
+	// Deleting it would leave these configs without a cluster to deploy to
+	inUse, err := s.queries.CountConfigsByClusterCredentialID(ctx, pgtype.Int8{Int64: id, Valid: true})
+	if err != nil {
+		return fmt.Errorf("failed to check credential usage: %w", err)
+	}
+	if inUse > 0 {
+		if _, err := s.GetCredential(ctx, ownerID, id); err != nil {
+			return err
+		}
+		return ErrCredentialInUse
+	}
+
+	deleted, err := s.queries.DeleteCredential(ctx, db.DeleteCredentialParams{
+		ID:              id,
+		OwnerID:         ownerID,
+		ExpectedVersion: optionalVersion(expectedVersion),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete credential: %w", err)
+	}
+	if deleted == 0 {
+		return s.missingOrChanged(ctx, ownerID, id)
+	}
+	return nil
+}
+
+// ListDeletedCredentials returns the credentials of an owner in the trash, most
+// recently deleted first
+func (s *Service) ListDeletedCredentials(ctx context.Context, ownerID string) ([]Credential, error) {
+	credentials, err := s.queries.GetDeletedCredentialsByOwnerID(ctx, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deleted credentials: %w", err)
+	}
+
+	result := make([]Credential, len(credentials))
+	for i, credential := range credentials {
+		result[i] = *dbCredentialToCredential(credential)
+	}
+	return result, nil
+}
+
+// RestoreCredential takes a credential of ownerID out of the trash. Returns
+// ErrCredentialExists when the owner stored a credential of the same provider and token
+// type in the meantime.
+func (s *Service) RestoreCredential(ctx context.Context, ownerID string, id int64) (*Credential, error) {
+	credential, err := s.queries.RestoreCredential(ctx, db.RestoreCredentialParams{
+		ID:      id,
+		OwnerID: ownerID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCredentialNotFound
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			if pgErr.ConstraintName == "credentials_external_id_unique" {
+				return nil, ErrExternalIDTaken
+			}
+			return nil, ErrCredentialExists
+		}
+		return nil, fmt.Errorf("failed to restore credential: %w", err)
+	}
+
+	return dbCredentialToCredential(credential), nil
+}
+
+// PurgeDeletedCredentials deletes the credentials that went to the trash before the
+// given time. Returns how many were purged.
+func (s *Service) PurgeDeletedCredentials(ctx context.Context, before time.Time) (int64, error) {
+	purged, err := s.queries.PurgeDeletedCredentials(ctx, pgtype.Timestamptz{Time: before, Valid: true})
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge deleted credentials: %w", err)
+	}
+	return purged, nil
+}
+
+// findRetriedStore returns the credential an earlier store with the same external ID
+// made, nil when the owner has no credential of the provider and token type yet. The expiry is not
+// compared, it is not what identifies a credential.
+func (s *Service) findRetriedStore(ctx context.Context, params StoreCredentialParams) (*StoreCredentialResult, error) {
+	existing, err := s.queries.GetCredentialByOwnerIDProviderAndTokenType(ctx, db.GetCredentialByOwnerIDProviderAndTokenTypeParams{
+		OwnerID:   params.OwnerID,
+		Provider:  params.Provider,
+		TokenType: params.TokenType,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get credential: %w", err)
+	}
+	if !existing.ExternalID.Valid || existing.ExternalID.String != params.ExternalID {
+		return nil, ErrCredentialExists
+	}
+
+	token, err := s.decryptCredential(existing)
+	if err != nil {
+		return nil, err
+	}
+	if token != params.Token {
+		return nil, ErrExternalIDTaken
+	}
+
+	return &StoreCredentialResult{CredentialID: existing.ID, Existing: true}, nil
+}
+
+// missingOrChanged tells why a versioned write to a credential matched no row
+func (s *Service) missingOrChanged(ctx context.Context, ownerID string, id int64) error {
+	if _, err := s.GetCredential(ctx, ownerID, id); err != nil {
+		return err
+	}
+	return ErrVersionMismatch
+}
+
+func optionalVersion(version *int32) pgtype.Int4 {
+	if version == nil {
+		return pgtype.Int4{}
+	}
+	return pgtype.Int4{Int32: *version, Valid: true}
+}
+
+func dbCredentialToCredential(credential db.Credential) *Credential {
+	result := &Credential{
+		ID:        credential.ID,
+		Provider:  credential.Provider,
+		TokenType: credential.TokenType,
+		Version:   credential.Version,
+		CreatedAt: credential.CreatedAt.Time,
+	}
+	if credential.ExternalID.Valid {
+		result.ExternalID = &credential.ExternalID.String
+	}
+	if credential.LastUsedAt.Valid {
+		result.LastUsedAt = &credential.LastUsedAt.Time
+	}
+	if credential.ExpiresAt.Valid {
+		result.ExpiresAt = &credential.ExpiresAt.Time
+	}
+	if credential.DeletedAt.Valid {
+		result.DeletedAt = &credential.DeletedAt.Time
+	}
+	return result
+}
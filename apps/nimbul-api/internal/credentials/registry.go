@@ -0,0 +1,62 @@
+package credentials
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// SetDefaultRegistryCredential makes a stored credential the registry login that builds
+// of ownerID push with when nimbul.yaml names no registry. A nil credentialID clears it.
+// The caller checks that the credential is a registry login of the owner.
+func (s *Service) SetDefaultRegistryCredential(ctx context.Context, ownerID string, credentialID *int64) error {
+	id := pgtype.Int8{}
+	if credentialID != nil {
+		id = pgtype.Int8{Int64: *credentialID, Valid: true}
+	}
+
+	err := s.queries.SetDefaultRegistryCredential(ctx, db.SetDefaultRegistryCredentialParams{
+		ID:                          ownerID,
+		DefaultRegistryCredentialID: id,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set default registry credential: %w", err)
+	}
+
+	return nil
+}
+
+// GetDefaultRegistryCredential retrieves and decrypts the default registry login of
+// ownerID. Returns ErrCredentialNotFound when the owner has none, ErrTokenExpired if it
+// has expired.
+func (s *Service) GetDefaultRegistryCredential(ctx context.Context, ownerID string) (*DecryptedCredential, error) {
+	credential, err := s.queries.GetDefaultRegistryCredential(ctx, ownerID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCredentialNotFound
+		}
+		return nil, fmt.Errorf("failed to get default registry credential: %w", err)
+	}
+
+	if credential.ExpiresAt.Valid && time.Now().After(credential.ExpiresAt.Time) {
+		return nil, ErrTokenExpired
+	}
+
+	token, err := s.decryptCredential(credential)
+	if err != nil {
+		return nil, err
+	}
+
+	s.touch(credential)
+	return &DecryptedCredential{
+		ID:        credential.ID,
+		Provider:  credential.Provider,
+		TokenType: credential.TokenType,
+		Token:     token,
+	}, nil
+}
@@ -0,0 +1,66 @@
+package credentials
+
+import (
+	"crypto/rand"
+	"fmt"
+)
+
+// Sealed is a secret encrypted the way credentials are: with its own DEK, wrapped by
+// the master key. Other secrets Nimbul stores use it so they share the master key.
+type Sealed struct {
+	Ciphertext []byte
+	TokenNonce []byte
+	WrappedDEK []byte
+	DEKNonce   []byte
+}
+
+// Seal encrypts plaintext with a new DEK
+func (s *Service) Seal(plaintext []byte) (*Sealed, error) {
+	// Generate random 32-byte DEK for AES-256
+	dek := make([]byte, 32)
+	if _, err := rand.Read(dek); err != nil {
+		return nil, fmt.Errorf("failed to generate DEK: %w", err)
+	}
+
+	tokenNonce := make([]byte, 12)
+	if _, err := rand.Read(tokenNonce); err != nil {
+		return nil, fmt.Errorf("failed to generate token nonce: %w", err)
+	}
+
+	dekNonce := make([]byte, 12)
+	if _, err := rand.Read(dekNonce); err != nil {
+		return nil, fmt.Errorf("failed to generate DEK nonce: %w", err)
+	}
+
+	ciphertext, err := s.encryptWithGCM(dek, tokenNonce, plaintext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt secret: %w", err)
+	}
+
+	wrappedDEK, err := s.encryptWithGCM(s.masterKey, dekNonce, dek)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wrap DEK: %w", err)
+	}
+
+	return &Sealed{
+		Ciphertext: ciphertext,
+		TokenNonce: tokenNonce,
+		WrappedDEK: wrappedDEK,
+		DEKNonce:   dekNonce,
+	}, nil
+}
+
+// Open decrypts a sealed secret
+func (s *Service) Open(sealed *Sealed) ([]byte, error) {
+	dek, err := s.decryptWithGCM(s.masterKey, sealed.DEKNonce, sealed.WrappedDEK)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt DEK: %w", err)
+	}
+
+	plaintext, err := s.decryptWithGCM(dek, sealed.TokenNonce, sealed.Ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt secret: %w", err)
+	}
+
+	return plaintext, nil
+}
@@ -17,6 +17,8 @@ import (
 	"time"
 
 	"github.com/coding-cave-dev/nimbul/internal/db"
+	"github.com/coding-cave-dev/nimbul/internal/github"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
@@ -25,6 +27,10 @@ var (
 	ErrRefreshTokenExpired = errors.New("refresh token expired")
 )
 
+// credentialTouchInterval limits how often last use is recorded, so credentials used
+// by every build do not write to the database each time
+const credentialTouchInterval = time.Minute
+
 type Service struct {
 	queries   *db.Queries
 	masterKey []byte
@@ -57,10 +63,15 @@ type StoreCredentialParams struct {
 	TokenType string
 	Token     string // Plaintext token to encrypt
 	ExpiresAt time.Time
+	// ExternalID is chosen by the client; storing a credential with one that is already
+	// used returns the existing credential instead of failing
+	ExternalID string
 }
 
 type StoreCredentialResult struct {
 	CredentialID int64
+	// Existing is set when an earlier store with the same ExternalID made the credential
+	Existing bool
 }
 
 // StoreCredential encrypts and stores a credential using envelope encryption:
@@ -68,7 +79,17 @@ type StoreCredentialResult struct {
 // 2. Encrypts the token with the DEK using AES-GCM
 // 3. Wraps the DEK with the master key using AES-GCM
 // 4. Stores everything in the database
+//
+// An owner has one credential per provider and token type: storing another returns ErrCredentialExists,
+// unless it is a retry of the store that made it.
 func (s *Service) StoreCredential(ctx context.Context, params StoreCredentialParams) (*StoreCredentialResult, error) {
+	if params.ExternalID != "" {
+		existing, err := s.findRetriedStore(ctx, params)
+		if err != nil || existing != nil {
+			return existing, err
+		}
+	}
+
 	// Generate random 32-byte DEK for AES-256
 	dek := make([]byte, 32)
 	if _, err := rand.Read(dek); err != nil {
@@ -108,9 +129,17 @@ func (s *Service) StoreCredential(ctx context.Context, params StoreCredentialPar
 		TokenNonce: tokenNonce,
 		WrappedDek: wrappedDEK,
 		DekNonce:   dekNonce,
-		ExpiresAt:  pgtype.Timestamptz{Time: params.ExpiresAt, Valid: true},
+		ExpiresAt:  expiresAt(params.ExpiresAt),
+		ExternalID: pgtype.Text{String: params.ExternalID, Valid: params.ExternalID != ""},
 	})
 	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			if pgErr.ConstraintName == "credentials_external_id_unique" {
+				return nil, ErrExternalIDTaken
+			}
+			return nil, ErrCredentialExists
+		}
 		return nil, fmt.Errorf("failed to store credential: %w", err)
 	}
 
@@ -169,7 +198,7 @@ func (s *Service) UpdateCredential(ctx context.Context, params UpdateCredentialP
 		TokenNonce: tokenNonce,
 		WrappedDek: wrappedDEK,
 		DekNonce:   dekNonce,
-		ExpiresAt:  pgtype.Timestamptz{Time: params.ExpiresAt, Valid: true},
+		ExpiresAt:  expiresAt(params.ExpiresAt),
 	})
 	if err != nil {
 		return fmt.Errorf("failed to update credential: %w", err)
@@ -182,19 +211,15 @@ func (s *Service) UpdateCredential(ctx context.Context, params UpdateCredentialP
 // Returns ErrTokenExpired if the token has expired
 func (s *Service) GetDecryptedToken(ctx context.Context, ownerID, provider, tokenType string) (string, error) {
 	// Get credential from database
-	credential, err := s.queries.GetCredentialByOwnerIDAndTokenType(ctx, db.GetCredentialByOwnerIDAndTokenTypeParams{
+	credential, err := s.queries.GetCredentialByOwnerIDProviderAndTokenType(ctx, db.GetCredentialByOwnerIDProviderAndTokenTypeParams{
 		OwnerID:   ownerID,
+		Provider:  provider,
 		TokenType: tokenType,
 	})
 	if err != nil {
 		return "", fmt.Errorf("failed to get credential: %w", err)
 	}
 
-	// Verify provider matches
-	if credential.Provider != provider {
-		return "", fmt.Errorf("provider mismatch: expected %s, got %s", provider, credential.Provider)
-	}
-
 	// Check if token is expired
 	if credential.ExpiresAt.Valid {
 		if time.Now().After(credential.ExpiresAt.Time) {
@@ -202,6 +227,67 @@ func (s *Service) GetDecryptedToken(ctx context.Context, ownerID, provider, toke
 		}
 	}
 
+	token, err := s.decryptCredential(credential)
+	if err != nil {
+		return "", err
+	}
+
+	s.touch(credential)
+	return token, nil
+}
+
+type DecryptedCredential struct {
+	ID        int64
+	Provider  string
+	TokenType string
+	Token     string
+}
+
+// GetDecryptedCredentialByID retrieves and decrypts a credential owned by ownerID
+// Returns ErrTokenExpired if the credential has expired
+func (s *Service) GetDecryptedCredentialByID(ctx context.Context, ownerID string, credentialID int64) (*DecryptedCredential, error) {
+	credential, err := s.queries.GetCredentialByIDAndOwnerID(ctx, db.GetCredentialByIDAndOwnerIDParams{
+		ID:      credentialID,
+		OwnerID: ownerID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get credential: %w", err)
+	}
+
+	if credential.ExpiresAt.Valid && time.Now().After(credential.ExpiresAt.Time) {
+		return nil, ErrTokenExpired
+	}
+
+	token, err := s.decryptCredential(credential)
+	if err != nil {
+		return nil, err
+	}
+
+	s.touch(credential)
+	return &DecryptedCredential{
+		ID:        credential.ID,
+		Provider:  credential.Provider,
+		TokenType: credential.TokenType,
+		Token:     token,
+	}, nil
+}
+
+// touch records that a credential was used, in the background so that using it is not
+// held up by the write
+func (s *Service) touch(credential db.Credential) {
+	if credential.LastUsedAt.Valid && time.Since(credential.LastUsedAt.Time) < credentialTouchInterval {
+		return
+	}
+
+	go func() {
+		if err := s.queries.TouchCredential(context.Background(), credential.ID); err != nil {
+			fmt.Printf("Warning: Failed to record use of credential %d: %v\n", credential.ID, err)
+		}
+	}()
+}
+
+// decryptCredential unwraps the DEK with the master key and decrypts the token
+func (s *Service) decryptCredential(credential db.Credential) (string, error) {
 	// Decrypt wrapped DEK with master key
 	dek, err := s.decryptWithGCM(s.masterKey, credential.DekNonce, credential.WrappedDek)
 	if err != nil {
@@ -225,20 +311,18 @@ type RefreshTokenResult struct {
 
 // RefreshGitHubToken refreshes a GitHub OAuth access token using the refresh token
 func (s *Service) RefreshGitHubToken(ctx context.Context, refreshToken string) (*RefreshTokenResult, error) {
-	clientID := os.Getenv("GITHUB_CLIENT_ID")
-	if clientID == "" {
-		return nil, fmt.Errorf("GITHUB_CLIENT_ID environment variable is not set")
+	appConfig, err := github.LoadAppConfig(ctx)
+	if err != nil {
+		return nil, err
 	}
-
-	clientSecret := os.Getenv("GITHUB_CLIENT_SECRET")
-	if clientSecret == "" {
-		return nil, fmt.Errorf("GITHUB_CLIENT_SECRET environment variable is not set")
+	if appConfig.ClientID == "" || appConfig.ClientSecret == "" {
+		return nil, fmt.Errorf("the GitHub App has no client ID and secret configured")
 	}
 
 	// Prepare form data
 	data := url.Values{}
-	data.Set("client_id", clientID)
-	data.Set("client_secret", clientSecret)
+	data.Set("client_id", appConfig.ClientID)
+	data.Set("client_secret", appConfig.ClientSecret)
 	data.Set("grant_type", "refresh_token")
 	data.Set("refresh_token", refreshToken)
 
@@ -312,6 +396,11 @@ func (s *Service) RefreshGitHubToken(ctx context.Context, refreshToken string) (
 	}, nil
 }
 
+// expiresAt converts an expiry time to a nullable timestamp; a zero time means the credential never expires
+func expiresAt(t time.Time) pgtype.Timestamptz {
+	return pgtype.Timestamptz{Time: t, Valid: !t.IsZero()}
+}
+
 // encryptWithGCM encrypts plaintext using AES-GCM with the given key and nonce
 func (s *Service) encryptWithGCM(key, nonce, plaintext []byte) ([]byte, error) {
 	block, err := aes.NewCipher(key)
@@ -0,0 +1,40 @@
+package dashboard
+
+import (
+	"embed"
+	"io/fs"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/filesystem"
+)
+
+// Path is the URL prefix the dashboard is served under
+const Path = "/app"
+
+//go:embed static
+var static embed.FS
+
+// Register serves the embedded web dashboard under Path. The dashboard is a static
+// single-page app that talks to the same JSON API as the CLI, so it needs no
+// server-side state of its own.
+func Register(app *fiber.App) {
+	root, err := fs.Sub(static, "static")
+	if err != nil {
+		panic(err)
+	}
+
+	// Assets are referenced relative to the index, so it must be served from Path + "/"
+	app.Use(Path, func(c *fiber.Ctx) error {
+		if c.Path() == Path {
+			return c.Redirect(Path+"/", fiber.StatusMovedPermanently)
+		}
+		return c.Next()
+	})
+
+	app.Use(Path, filesystem.New(filesystem.Config{
+		Root:   http.FS(root),
+		Index:  "index.html",
+		MaxAge: 300,
+	}))
+}
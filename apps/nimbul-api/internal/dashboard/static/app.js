@@ -0,0 +1,287 @@
+// Nimbul dashboard: a small single-page app on top of the Nimbul JSON API.
+// Views are selected by the URL hash so the server only serves static files.
+(function () {
+  "use strict";
+
+  var TOKEN_KEY = "nimbul.token";
+  var REFRESH_MS = 5000;
+
+  var view = document.getElementById("view");
+  var userLabel = document.getElementById("user");
+  var logoutButton = document.getElementById("logout");
+  var banner = document.getElementById("banner");
+  var refreshTimer = null;
+
+  function token() {
+    return localStorage.getItem(TOKEN_KEY);
+  }
+
+  function escapeHTML(value) {
+    return String(value == null ? "" : value)
+      .replace(/&/g, "&amp;")
+      .replace(/</g, "&lt;")
+      .replace(/>/g, "&gt;")
+      .replace(/"/g, "&quot;");
+  }
+
+  function formatTime(value) {
+    return value ? new Date(value).toLocaleString() : "";
+  }
+
+  // api calls the Nimbul API and returns the decoded JSON body. A 401 drops the
+  // stored token and sends the user back to the login form.
+  function api(method, path, body) {
+    var headers = { "Content-Type": "application/json" };
+    if (token()) {
+      headers.Authorization = "Bearer " + token();
+    }
+
+    return fetch(path, {
+      method: method,
+      headers: headers,
+      body: body ? JSON.stringify(body) : undefined,
+    }).then(function (res) {
+      return res.json().catch(function () { return {}; }).then(function (data) {
+        if (res.status === 401 && path !== "/login") {
+          logout();
+        }
+        if (!res.ok) {
+          throw new Error(data.detail || data.title || "Request failed (" + res.status + ")");
+        }
+        return data;
+      });
+    });
+  }
+
+  function logout() {
+    localStorage.removeItem(TOKEN_KEY);
+    userLabel.textContent = "";
+    banner.hidden = true;
+    logoutButton.hidden = true;
+    location.hash = "#/";
+    render();
+  }
+
+  // autoRefresh re-runs load every REFRESH_MS until the view changes
+  function autoRefresh(load) {
+    load();
+    refreshTimer = setInterval(load, REFRESH_MS);
+  }
+
+  function showError(err) {
+    view.innerHTML = '<p class="error">' + escapeHTML(err.message) + "</p>";
+  }
+
+  // renderLogin asks for the email and password, and for a two-factor or recovery
+  // code once the API answers that the account needs one
+  function renderLogin() {
+    view.innerHTML =
+      "<h1>Log in</h1>" +
+      '<form class="login">' +
+      '<input name="email" type="email" placeholder="Email" required>' +
+      '<input name="password" type="password" placeholder="Password" required>' +
+      '<input name="code" placeholder="Two-factor or recovery code" autocomplete="one-time-code" hidden>' +
+      "<button>Log in</button>" +
+      '<p class="error" id="login-error"></p>' +
+      "</form>";
+
+    view.querySelector("form").addEventListener("submit", function (event) {
+      event.preventDefault();
+      var form = event.target;
+      var errorLabel = document.getElementById("login-error");
+      var body = { email: form.email.value, password: form.password.value };
+      if (!form.code.hidden) {
+        body.code = form.code.value.trim();
+      }
+
+      api("POST", "/login", body)
+        .then(function (data) {
+          if (data.two_factor_required) {
+            form.code.hidden = false;
+            form.code.required = true;
+            form.code.focus();
+            errorLabel.textContent = body.code ? "" : "Enter the code from your authenticator app or a recovery code.";
+            return;
+          }
+          if (!data.token) {
+            throw new Error("The login response has no token");
+          }
+          localStorage.setItem(TOKEN_KEY, data.token);
+          render();
+        })
+        .catch(function (err) {
+          errorLabel.textContent = err.message;
+        });
+    });
+  }
+
+  function renderConfigs() {
+    view.innerHTML = "<h1>Configs</h1><p class=\"muted\">Loading…</p>";
+    api("GET", "/configs").then(function (data) {
+      if (!data.configs || data.configs.length === 0) {
+        view.innerHTML = '<h1>Configs</h1><p class="muted">No configs yet. Create one with <code>nimbul init</code> in your repository.</p>';
+        return;
+      }
+
+      var rows = data.configs.map(function (config) {
+        var target = config.agent_id ? "agent " + config.agent_id : config.cluster_credential_id ? "cluster credential " + config.cluster_credential_id : "nothing connected";
+        return (
+          "<tr>" +
+          '<td><a href="#/configs/' + encodeURIComponent(config.id) + '">' + escapeHTML(config.repo_full_name) + "</a></td>" +
+          "<td><code>" + escapeHTML(config.id) + "</code></td>" +
+          "<td>" + escapeHTML(target) + "</td>" +
+          "<td>" + escapeHTML(formatTime(config.created_at)) + "</td>" +
+          "</tr>"
+        );
+      });
+
+      view.innerHTML =
+        "<h1>Configs</h1>" +
+        "<table><tr><th>Repository</th><th>ID</th><th>Deploys to</th><th>Created</th></tr>" +
+        rows.join("") +
+        "</table>";
+    }).catch(showError);
+  }
+
+  function renderDeployments(configID) {
+    var heading = '<p><a href="#/">← Configs</a></p><h1>Deployments of <code>' + escapeHTML(configID) + "</code></h1>";
+    view.innerHTML = heading + '<p class="muted">Loading…</p>';
+
+    autoRefresh(function () {
+      api("GET", "/configs/" + encodeURIComponent(configID) + "/deployments?limit=50").then(function (data) {
+        if (!data.deployments || data.deployments.length === 0) {
+          view.innerHTML = heading + '<p class="muted">No deployments yet. Push to the repository to trigger one.</p>';
+          return;
+        }
+
+        var rows = data.deployments.map(function (deployment) {
+          return (
+            "<tr>" +
+            '<td><a href="#/deployments/' + deployment.id + '">#' + deployment.id + "</a></td>" +
+            "<td>" + escapeHTML(deployment.ref) + "</td>" +
+            "<td><code>" + escapeHTML(deployment.commit_sha.slice(0, 12)) + "</code></td>" +
+            '<td class="status-' + escapeHTML(deployment.status) + '">' + escapeHTML(deployment.status) + "</td>" +
+            "<td>" + escapeHTML(formatTime(deployment.created_at)) + "</td>" +
+            '<td class="error">' + escapeHTML(deployment.error) + "</td>" +
+            "</tr>"
+          );
+        });
+
+        view.innerHTML =
+          heading +
+          "<table><tr><th>ID</th><th>Ref</th><th>Commit</th><th>Status</th><th>Started</th><th>Error</th></tr>" +
+          rows.join("") +
+          "</table>";
+      }).catch(showError);
+    });
+  }
+
+  function resourceState(resource) {
+    if (resource.error) {
+      return { label: resource.error, className: "status-failed" };
+    }
+    if (!resource.exists) {
+      return { label: "missing", className: "status-missing" };
+    }
+    var label = resource.ready ? "ready" : "not ready";
+    if (resource.replicas != null) {
+      label += " (" + (resource.ready_replicas || 0) + "/" + resource.replicas + ")";
+    }
+    return { label: label, className: resource.ready ? "status-ready" : "status-not-ready" };
+  }
+
+  function renderDeployment(id) {
+    view.innerHTML = '<p class="muted">Loading…</p>';
+
+    autoRefresh(function () {
+      api("GET", "/deployments/" + encodeURIComponent(id) + "/resources").then(function (data) {
+        var deployment = data.deployment;
+        var heading =
+          '<p><a href="#/configs/' + encodeURIComponent(deployment.config_id) + '">← Deployments</a></p>' +
+          "<h1>Deployment #" + deployment.id + "</h1>" +
+          "<p>" + escapeHTML(deployment.ref) + " @ <code>" + escapeHTML(deployment.commit_sha) + "</code> · " +
+          '<span class="status-' + escapeHTML(deployment.status) + '">' + escapeHTML(deployment.status) + "</span> · " +
+          escapeHTML(formatTime(deployment.updated_at)) + "</p>" +
+          (deployment.error ? '<p class="error">' + escapeHTML(deployment.error) + "</p>" : "");
+
+        var resources = data.resources || [];
+        if (resources.length === 0) {
+          view.innerHTML = heading + '<p class="muted">This deployment has no recorded resources.</p>';
+          return;
+        }
+
+        var rows = resources.map(function (resource) {
+          var state = resourceState(resource);
+          var events = (resource.events || []).map(function (event) {
+            return "<li>" + escapeHTML(event.reason) + ": " + escapeHTML(event.message) + "</li>";
+          });
+          return (
+            "<tr>" +
+            "<td>" + escapeHTML(resource.kind) + "</td>" +
+            "<td>" + escapeHTML(resource.namespace ? resource.namespace + "/" + resource.name : resource.name) + "</td>" +
+            '<td class="' + state.className + '">' + escapeHTML(state.label) +
+            (events.length ? '<ul class="events">' + events.join("") + "</ul>" : "") +
+            "</td>" +
+            "</tr>"
+          );
+        });
+
+        view.innerHTML =
+          heading +
+          "<h2>Resources</h2>" +
+          "<table><tr><th>Kind</th><th>Name</th><th>State</th></tr>" +
+          rows.join("") +
+          "</table>";
+      }).catch(showError);
+    });
+  }
+
+  // renderBanner warns about credentials that will stop webhook builds from working
+  function renderBanner(expiring) {
+    if (expiring.length === 0) {
+      banner.hidden = true;
+      return;
+    }
+
+    banner.innerHTML = expiring.map(function (credential) {
+      return (
+        "⚠ Your " + escapeHTML(credential.provider) + " " + escapeHTML(credential.token_type) + " credential " +
+        (credential.expired ? "expired" : "expires") + " " + escapeHTML(formatTime(credential.expires_at)) +
+        ". Run <code>nimbul connect</code> to renew it."
+      );
+    }).join("<br>");
+    banner.hidden = false;
+  }
+
+  function render() {
+    clearInterval(refreshTimer);
+    refreshTimer = null;
+
+    if (!token()) {
+      renderLogin();
+      return;
+    }
+
+    logoutButton.hidden = false;
+    if (!userLabel.textContent) {
+      api("GET", "/me").then(function (user) {
+        userLabel.textContent = user.email;
+        renderBanner(user.expiring_credentials || []);
+      }).catch(function () {});
+    }
+
+    var route = location.hash.replace(/^#/, "") || "/";
+    var match;
+    if ((match = route.match(/^\/configs\/([^/]+)$/))) {
+      renderDeployments(decodeURIComponent(match[1]));
+    } else if ((match = route.match(/^\/deployments\/(\d+)$/))) {
+      renderDeployment(match[1]);
+    } else {
+      renderConfigs();
+    }
+  }
+
+  logoutButton.addEventListener("click", logout);
+  window.addEventListener("hashchange", render);
+  render();
+})();
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table repo_configs
+add column if not exists cluster_credential_id bigint references credentials (id) on delete set null; -- kubeconfig / service account credential used for deploys
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+alter table repo_configs
+drop column if exists cluster_credential_id;
+
+-- +goose StatementEnd
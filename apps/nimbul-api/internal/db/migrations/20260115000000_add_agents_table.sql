@@ -0,0 +1,50 @@
+-- +goose Up
+-- +goose StatementBegin
+create table
+    if not exists agents (
+        id char(26) primary key, -- ULID
+        owner_id char(26) not null references users (id),
+        name text not null,
+        token_hash text not null, -- sha256 of the agent token, the token itself is never stored
+        last_seen_at timestamptz,
+        created_at timestamptz not null default now ()
+    );
+
+create unique index agents_token_hash_unique on agents (token_hash);
+
+create unique index agents_owner_name_unique on agents (owner_id, name);
+
+create table
+    if not exists agent_deployments (
+        id bigserial primary key,
+        agent_id char(26) not null references agents (id) on delete cascade,
+        config_id char(26) not null references repo_configs (id) on delete cascade,
+        manifests text not null, -- rendered multi-document YAML
+        status text not null default 'pending', -- 'pending' | 'delivered' | 'applied' | 'failed'
+        error text,
+        created_at timestamptz not null default now (),
+        updated_at timestamptz not null default now ()
+    );
+
+create index agent_deployments_agent_status_idx on agent_deployments (agent_id, status);
+
+alter table repo_configs
+add column if not exists agent_id char(26) references agents (id) on delete set null;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+alter table repo_configs
+drop column if exists agent_id;
+
+drop index if exists agent_deployments_agent_status_idx;
+
+drop table if exists agent_deployments;
+
+drop index if exists agents_owner_name_unique;
+
+drop index if exists agents_token_hash_unique;
+
+drop table if exists agents;
+
+-- +goose StatementEnd
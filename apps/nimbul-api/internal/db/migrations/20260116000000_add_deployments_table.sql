@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+create table
+    if not exists deployments (
+        id bigserial primary key,
+        config_id char(26) not null references repo_configs (id) on delete cascade,
+        ref text not null, -- git ref that triggered the deploy
+        commit_sha text not null,
+        status text not null default 'in_progress', -- 'in_progress' | 'succeeded' | 'failed'
+        resources jsonb not null default '[]', -- resources applied to the cluster
+        error text,
+        created_at timestamptz not null default now (),
+        updated_at timestamptz not null default now ()
+    );
+
+create index deployments_config_id_idx on deployments (config_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists deployments_config_id_idx;
+
+drop table if exists deployments;
+
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+create table
+    if not exists hooks (
+        id char(26) primary key, -- ULID
+        owner_id char(26) not null references users (id) on delete cascade,
+        url text not null,
+        secret text not null, -- HMAC-SHA256 key used to sign deliveries
+        events text[] not null default '{}', -- event types to deliver, empty for all
+        last_delivery_at timestamptz,
+        last_status_code integer, -- HTTP status of the last delivery, null if it did not get a response
+        last_error text,
+        created_at timestamptz not null default now ()
+    );
+
+create index hooks_owner_id_idx on hooks (owner_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists hooks_owner_id_idx;
+
+drop table if exists hooks;
+
+-- +goose StatementEnd
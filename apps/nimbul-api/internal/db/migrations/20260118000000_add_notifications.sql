@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+create table
+    if not exists notification_preferences (
+        user_id char(26) primary key references users (id) on delete cascade,
+        build_failed boolean not null default true, -- email when a build fails
+        deploy_failed boolean not null default true, -- email when a deploy fails
+        credential_expiry boolean not null default true, -- email before a stored credential expires
+        updated_at timestamptz not null default now ()
+    );
+
+create table
+    if not exists password_resets (
+        token_hash text primary key, -- sha256 of the reset token, the token itself is only emailed
+        user_id char(26) not null references users (id) on delete cascade,
+        expires_at timestamptz not null,
+        used_at timestamptz,
+        created_at timestamptz not null default now ()
+    );
+
+create index password_resets_user_id_idx on password_resets (user_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists password_resets_user_id_idx;
+
+drop table if exists password_resets;
+
+drop table if exists notification_preferences;
+
+-- +goose StatementEnd
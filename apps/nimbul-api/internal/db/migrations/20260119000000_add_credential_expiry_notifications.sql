@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table credentials
+add column if not exists expiry_notified_at timestamptz; -- when the owner was warned about the current expires_at
+
+alter table notification_preferences
+add column if not exists slack_webhook_url text; -- Slack incoming webhook that receives the same notifications as email
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+alter table notification_preferences
+drop column if exists slack_webhook_url;
+
+alter table credentials
+drop column if exists expiry_notified_at;
+
+-- +goose StatementEnd
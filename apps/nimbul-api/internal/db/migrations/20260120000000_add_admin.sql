@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table users
+add column if not exists role text not null default 'user'; -- 'user' | 'admin'
+
+alter table users
+add column if not exists disabled_at timestamptz; -- disabled accounts cannot log in or trigger builds
+
+create table
+    if not exists instance_limits (
+        id boolean primary key default true check (id), -- single row
+        max_configs_per_user integer, -- null for unlimited
+        max_build_minutes integer, -- longest a single build may run, null for unlimited
+        updated_at timestamptz not null default now ()
+    );
+
+insert into instance_limits (id) values (true) on conflict do nothing;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists instance_limits;
+
+alter table users
+drop column if exists disabled_at;
+
+alter table users
+drop column if exists role;
+
+-- +goose StatementEnd
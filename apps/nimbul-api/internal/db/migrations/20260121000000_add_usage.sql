@@ -0,0 +1,50 @@
+-- +goose Up
+-- +goose StatementBegin
+create table
+    if not exists builds (
+        id bigserial primary key,
+        config_id char(26) references repo_configs (id) on delete set null, -- kept for metering after the config is deleted
+        owner_id char(26) not null references users (id) on delete cascade, -- owner of the config at build time
+        name text not null, -- build name from nimbul.yaml
+        ref text not null,
+        commit_sha text not null,
+        status text not null default 'running', -- 'running' | 'succeeded' | 'failed'
+        error text,
+        log_bytes bigint not null default 0, -- size of stored build logs
+        artifact_bytes bigint not null default 0, -- size of stored build artifacts
+        started_at timestamptz not null default now (),
+        finished_at timestamptz
+    );
+
+create index builds_owner_id_started_at_idx on builds (owner_id, started_at);
+
+create index builds_config_id_idx on builds (config_id);
+
+-- Usage quotas per owner. Exceeding a soft quota warns, exceeding a hard quota blocks new builds.
+-- Build minutes and deploys are counted per calendar month (UTC), storage in total. Null for no quota.
+alter table instance_limits
+add column if not exists build_minutes_soft_quota integer,
+add column if not exists build_minutes_hard_quota integer,
+add column if not exists deploys_soft_quota integer,
+add column if not exists deploys_hard_quota integer,
+add column if not exists storage_mb_soft_quota integer,
+add column if not exists storage_mb_hard_quota integer;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+alter table instance_limits
+drop column if exists storage_mb_hard_quota,
+drop column if exists storage_mb_soft_quota,
+drop column if exists deploys_hard_quota,
+drop column if exists deploys_soft_quota,
+drop column if exists build_minutes_hard_quota,
+drop column if exists build_minutes_soft_quota;
+
+drop index if exists builds_config_id_idx;
+
+drop index if exists builds_owner_id_started_at_idx;
+
+drop table if exists builds;
+
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+create table
+    if not exists build_artifacts (
+        id bigserial primary key,
+        build_id bigint not null references builds (id) on delete cascade,
+        name text not null, -- download name, the base name of the declared path
+        storage_key text not null, -- key of the file in object storage
+        size_bytes bigint not null,
+        created_at timestamptz not null default now (),
+        unique (build_id, name)
+    );
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists build_artifacts;
+
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+create table
+    if not exists build_provenance (
+        id bigserial primary key,
+        build_id bigint not null references builds (id) on delete cascade,
+        image text not null, -- pushed image reference, e.g. ghcr.io/acme/api:abc123
+        digest text not null, -- digest of the pushed image index
+        predicate_type text not null, -- e.g. https://slsa.dev/provenance/v0.2
+        statement jsonb not null, -- in-toto statement generated by BuildKit
+        created_at timestamptz not null default now (),
+        unique (build_id, image)
+    );
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists build_provenance;
+
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Registry retention rules per config. Null keeps every build's tags.
+alter table repo_configs
+add column if not exists retention_keep_last integer, -- builds whose tags are kept per branch
+add column if not exists retention_delete_previews boolean not null default false; -- delete a branch's tags once it is deleted
+
+create table
+    if not exists build_images (
+        id bigserial primary key,
+        build_id bigint not null references builds (id) on delete cascade,
+        image text not null, -- pushed image reference, e.g. ghcr.io/acme/api:abc123
+        digest text not null,
+        branch_deleted_at timestamptz, -- the build's branch was deleted, e.g. after merging
+        deleted_at timestamptz, -- removed from the registry by the retention cleanup
+        created_at timestamptz not null default now ()
+    );
+
+create index build_images_build_id_idx on build_images (build_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists build_images_build_id_idx;
+
+drop table if exists build_images;
+
+alter table repo_configs
+drop column if exists retention_delete_previews,
+drop column if exists retention_keep_last;
+
+-- +goose StatementEnd
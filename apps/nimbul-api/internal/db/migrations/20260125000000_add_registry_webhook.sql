@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Token authenticating inbound registry webhooks of a config. Null disables them.
+alter table repo_configs
+add column if not exists registry_webhook_token text;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+alter table repo_configs
+drop column if exists registry_webhook_token;
+
+-- +goose StatementEnd
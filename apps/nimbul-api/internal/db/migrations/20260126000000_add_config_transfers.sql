@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Pending transfers of a config to another user. The config changes owner once the
+-- recipient accepts; accepting or declining deletes the transfer.
+create table
+    if not exists config_transfers (
+        id char(26) primary key, -- ULID
+        config_id char(26) not null references repo_configs (id) on delete cascade,
+        from_user_id char(26) not null references users (id) on delete cascade,
+        to_user_id char(26) not null references users (id) on delete cascade,
+        created_at timestamptz not null default now ()
+    );
+
+create unique index config_transfers_config_id_unique on config_transfers (config_id); -- one pending transfer per config
+
+create index config_transfers_to_user_id_idx on config_transfers (to_user_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists config_transfers_to_user_id_idx;
+
+drop index if exists config_transfers_config_id_unique;
+
+drop table if exists config_transfers;
+
+-- +goose StatementEnd
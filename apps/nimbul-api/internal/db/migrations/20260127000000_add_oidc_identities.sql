@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table users
+add column if not exists oidc_issuer text; -- identity provider of users signing in with OIDC
+
+alter table users
+add column if not exists oidc_subject text; -- "sub" claim of the user at oidc_issuer
+
+create unique index users_oidc_identity_unique on users (oidc_issuer, oidc_subject)
+where
+    oidc_subject is not null;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists users_oidc_identity_unique;
+
+alter table users
+drop column if exists oidc_subject;
+
+alter table users
+drop column if exists oidc_issuer;
+
+-- +goose StatementEnd
@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+create table
+    if not exists user_totp (
+        user_id char(26) primary key references users (id) on delete cascade,
+        ciphertext bytea not null, -- TOTP secret, encrypted like credentials
+        token_nonce bytea not null,
+        wrapped_dek bytea not null,
+        dek_nonce bytea not null,
+        enabled_at timestamptz, -- null until the first code confirms the enrollment
+        last_used_step bigint not null default 0, -- time step of the last accepted code, codes are single-use
+        created_at timestamptz not null default now ()
+    );
+
+create table
+    if not exists user_recovery_codes (
+        code_hash text primary key, -- sha256 of the recovery code, the code itself is only shown once
+        user_id char(26) not null references users (id) on delete cascade,
+        used_at timestamptz,
+        created_at timestamptz not null default now ()
+    );
+
+create index user_recovery_codes_user_id_idx on user_recovery_codes (user_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists user_recovery_codes_user_id_idx;
+
+drop table if exists user_recovery_codes;
+
+drop table if exists user_totp;
+
+-- +goose StatementEnd
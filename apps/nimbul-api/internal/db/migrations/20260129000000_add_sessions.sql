@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+create table
+    if not exists sessions (
+        id char(26) primary key, -- carried in the token's sid claim
+        user_id char(26) not null references users (id) on delete cascade,
+        user_agent text not null default '', -- client that logged in, shown to tell sessions apart
+        ip text not null default '', -- address the login came from
+        created_at timestamptz not null default now (),
+        last_used_at timestamptz not null default now (),
+        expires_at timestamptz not null
+    );
+
+create index sessions_user_id_idx on sessions (user_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists sessions_user_id_idx;
+
+drop table if exists sessions;
+
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table users
+add column if not exists deleted_at timestamptz; -- deleted accounts are anonymized, their builds are kept for usage metering
+
+create table
+    if not exists email_changes (
+        token_hash text primary key, -- sha256 of the confirmation token, the token itself is only emailed
+        user_id char(26) not null references users (id) on delete cascade,
+        new_email text not null,
+        expires_at timestamptz not null,
+        used_at timestamptz,
+        created_at timestamptz not null default now ()
+    );
+
+create index email_changes_user_id_idx on email_changes (user_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists email_changes_user_id_idx;
+
+drop table if exists email_changes;
+
+alter table users
+drop column if exists deleted_at;
+
+-- +goose StatementEnd
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table repo_configs
+add column if not exists external_id text, -- chosen by the client, makes retried creates return the first config
+add column if not exists version integer not null default 1; -- bumped on every change, sent as the ETag
+
+create unique index if not exists repo_configs_external_id_unique on repo_configs (owner_id, external_id);
+
+alter table credentials
+add column if not exists external_id text, -- chosen by the client, makes retried creates return the first credential
+add column if not exists version integer not null default 1; -- bumped on every change, sent as the ETag
+
+create unique index if not exists credentials_external_id_unique on credentials (owner_id, external_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists credentials_external_id_unique;
+
+alter table credentials
+drop column if exists version,
+drop column if exists external_id;
+
+drop index if exists repo_configs_external_id_unique;
+
+alter table repo_configs
+drop column if exists version,
+drop column if exists external_id;
+
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Inbound webhook requests, captured when NIMBUL_CAPTURE_WEBHOOK_PAYLOADS is "true"
+create table
+    if not exists webhook_deliveries (
+        id bigserial primary key,
+        source text not null, -- 'github' | 'registry'
+        config_id text not null, -- {id} from the webhook URL, not checked against repo_configs
+        event_type text, -- X-GitHub-Event, null for registries
+        headers jsonb not null, -- request headers, without Authorization
+        payload bytea not null,
+        status_code integer not null, -- status Nimbul answered with
+        response text, -- problem details when the delivery was rejected
+        created_at timestamptz not null default now ()
+    );
+
+create index webhook_deliveries_config_id_idx on webhook_deliveries (config_id, created_at);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists webhook_deliveries_config_id_idx;
+
+drop table if exists webhook_deliveries;
+
+-- +goose StatementEnd
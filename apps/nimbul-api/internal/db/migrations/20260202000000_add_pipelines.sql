@@ -0,0 +1,46 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Pipeline run started by a push, with its stages forming a DAG
+create table
+    if not exists pipelines (
+        id bigserial primary key,
+        config_id char(26) not null references repo_configs (id) on delete cascade,
+        ref text not null,
+        commit_sha text not null,
+        created_at timestamptz not null default now ()
+    );
+
+create index pipelines_config_id_idx on pipelines (config_id);
+
+create table
+    if not exists pipeline_stages (
+        id bigserial primary key,
+        pipeline_id bigint not null references pipelines (id) on delete cascade,
+        name text not null, -- e.g. 'clone', 'build:api', 'deploy:production'
+        kind text not null, -- 'clone' | 'build' | 'test' | 'deploy'
+        needs text[] not null default '{}', -- names of the stages that run before it
+        status text not null default 'pending', -- 'pending' | 'running' | 'succeeded' | 'failed' | 'canceled' | 'skipped'
+        error text,
+        build_id bigint references builds (id) on delete set null,
+        deployment_id bigint references deployments (id) on delete set null,
+        started_at timestamptz,
+        finished_at timestamptz,
+        unique (pipeline_id, name)
+    );
+
+alter table builds
+add column if not exists pipeline_id bigint references pipelines (id) on delete set null;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+alter table builds
+drop column if exists pipeline_id;
+
+drop table if exists pipeline_stages;
+
+drop index if exists pipelines_config_id_idx;
+
+drop table if exists pipelines;
+
+-- +goose StatementEnd
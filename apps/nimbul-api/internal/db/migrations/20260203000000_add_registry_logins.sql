@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Registry logins are stored per registry, with the registry as their provider
+drop index if exists credentials_unique;
+
+create unique index credentials_unique on credentials (owner_id, provider, token_type);
+
+-- Registry login that builds without a registry in nimbul.yaml push with
+alter table users
+add column if not exists default_registry_credential_id bigint references credentials (id) on delete set null;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+alter table users
+drop column if exists default_registry_credential_id;
+
+drop index if exists credentials_unique;
+
+create unique index credentials_unique on credentials (owner_id, token_type);
+
+-- +goose StatementEnd
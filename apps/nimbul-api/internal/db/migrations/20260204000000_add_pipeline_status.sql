@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Outcome of a pipeline run; 'partial' when some stages failed and the stages
+-- independent of them succeeded
+alter table pipelines
+add column if not exists status text not null default 'running', -- 'running' | 'succeeded' | 'partial' | 'failed' | 'canceled'
+add column if not exists finished_at timestamptz;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+alter table pipelines
+drop column if exists finished_at,
+drop column if exists status;
+
+-- +goose StatementEnd
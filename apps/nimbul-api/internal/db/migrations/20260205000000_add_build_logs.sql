@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Log of a build, stored once it finishes. Entries are capped in size, truncated
+-- logs end with a marker entry.
+create table
+    if not exists build_logs (
+        build_id bigint primary key references builds (id) on delete cascade,
+        entries jsonb not null default '[]', -- [{time, vertex, stream, message}]
+        truncated boolean not null default false,
+        created_at timestamptz not null default now ()
+    );
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists build_logs;
+
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- How long each part of a build took: cloning, the build context, each Dockerfile
+-- stage, pushing and the deploys run after it
+alter table builds
+add column if not exists timings jsonb not null default '[]'; -- [{name, kind, started, duration}]
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+alter table builds
+drop column if exists timings;
+
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Non-secret variables of a config, passed to its builds as build args and available
+-- to nimbul.yaml templates as .ENV
+create table
+    if not exists config_env (
+        config_id char(26) not null references repo_configs (id) on delete cascade,
+        name text not null,
+        value text not null,
+        created_at timestamptz not null default now (),
+        updated_at timestamptz not null default now (),
+        primary key (config_id, name)
+    );
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists config_env;
+
+-- +goose StatementEnd
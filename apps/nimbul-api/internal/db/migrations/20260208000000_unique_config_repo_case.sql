@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- GitHub repository names are case-insensitive, so "Acme/API" and "acme/api" are the
+-- same repository and may not get a config each
+drop index if exists repo_configs_repo_unique;
+
+create unique index repo_configs_repo_unique on repo_configs (owner_id, lower(repo_full_name));
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists repo_configs_repo_unique;
+
+create unique index repo_configs_repo_unique on repo_configs (owner_id, repo_full_name);
+
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Result of the last cross-check of a config against GitHub
+alter table repo_configs
+add column if not exists health text not null default 'unknown', -- ok, app_uninstalled, repo_not_found or webhook_missing
+add column if not exists health_detail text not null default '', -- what is wrong and how to fix it
+add column if not exists health_checked_at timestamptz;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+alter table repo_configs
+drop column if exists health_checked_at,
+drop column if exists health_detail,
+drop column if exists health;
+
+-- +goose StatementEnd
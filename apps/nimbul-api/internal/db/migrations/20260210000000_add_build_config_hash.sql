@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Lets a push of an already built commit reuse the build instead of building it again
+alter table builds
+add column if not exists config_hash text not null default ''; -- hash of the rendered nimbul.yaml build, '' for builds before it was recorded
+
+create index if not exists builds_reuse_idx on builds (config_id, commit_sha, name);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists builds_reuse_idx;
+
+alter table builds
+drop column if exists config_hash;
+
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Commit authors whose pushes don't trigger builds, e.g. bots committing generated files
+alter table repo_configs
+add column if not exists denied_authors text[] not null default '{}'; -- GitHub logins, names or emails
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+alter table repo_configs
+drop column if exists denied_authors;
+
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Limits of a single build, and usage quotas per config so one repository cannot use up
+-- its owner's quotas. Build minutes are counted per calendar month (UTC), storage in
+-- total. Null for no limit.
+alter table instance_limits
+add column if not exists max_log_mb integer, -- log a build may store
+add column if not exists max_artifact_mb integer, -- artifacts a build may store
+add column if not exists config_build_minutes_soft_quota integer,
+add column if not exists config_build_minutes_hard_quota integer,
+add column if not exists config_storage_mb_soft_quota integer,
+add column if not exists config_storage_mb_hard_quota integer;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+alter table instance_limits
+drop column if exists config_storage_mb_hard_quota,
+drop column if exists config_storage_mb_soft_quota,
+drop column if exists config_build_minutes_hard_quota,
+drop column if exists config_build_minutes_soft_quota,
+drop column if exists max_artifact_mb,
+drop column if exists max_log_mb;
+
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Pipeline jobs that failed every attempt, kept so they can be requeued
+create table
+    if not exists dead_jobs (
+        id bigserial primary key,
+        kind text not null, -- 'github_push' | 'image_push'
+        config_id char(26) not null references repo_configs (id) on delete cascade,
+        payload bytea not null, -- what the job was started with, e.g. the push event
+        error text not null, -- error of the last attempt
+        attempts integer not null,
+        created_at timestamptz not null default now (),
+        requeued_at timestamptz -- set once requeued; a failed requeue is dead-lettered again
+    );
+
+create index dead_jobs_config_id_idx on dead_jobs (config_id, created_at);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists dead_jobs_config_id_idx;
+
+drop table if exists dead_jobs;
+
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Jobs waiting for a nimbul-worker, used when NIMBUL_JOB_RUNNER is "worker"
+create table
+    if not exists queued_jobs (
+        id bigserial primary key,
+        kind text not null, -- 'github_push' | 'image_push'
+        config_id char(26) not null references repo_configs (id) on delete cascade,
+        payload bytea not null,
+        priority integer not null default 0, -- higher runs first
+        attempts integer not null default 0, -- attempts started so far
+        run_after timestamptz not null default now (), -- delays retries
+        claimed_until timestamptz, -- lease of the worker running the job, renewed while it runs
+        created_at timestamptz not null default now ()
+    );
+
+create index queued_jobs_next_idx on queued_jobs (priority desc, id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists queued_jobs_next_idx;
+
+drop table if exists queued_jobs;
+
+-- +goose StatementEnd
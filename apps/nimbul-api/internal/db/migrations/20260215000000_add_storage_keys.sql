@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Build logs, provenance statements and agent manifests are kept in object storage
+-- under storage_key; rows without one still hold them inline
+alter table build_logs
+add column if not exists storage_key text; -- entries is '[]' when set
+
+alter table build_provenance
+add column if not exists storage_key text,
+alter column statement set default 'null'; -- statement is null when storage_key is set
+
+alter table agent_deployments
+add column if not exists storage_key text,
+alter column manifests set default ''; -- manifests is '' when storage_key is set
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+alter table agent_deployments
+alter column manifests drop default,
+drop column if exists storage_key;
+
+alter table build_provenance
+alter column statement drop default,
+drop column if exists storage_key;
+
+alter table build_logs
+drop column if exists storage_key;
+
+-- +goose StatementEnd
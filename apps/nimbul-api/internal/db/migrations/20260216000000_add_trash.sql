@@ -0,0 +1,82 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Deleted configs and credentials stay in the trash, restorable, until they are purged
+-- after the retention window. Only live rows take part in the unique indexes, so a
+-- trashed config does not block configuring the repository again.
+alter table repo_configs
+add column if not exists deleted_at timestamptz; -- null while live
+
+alter table credentials
+add column if not exists deleted_at timestamptz; -- null while live
+
+drop index if exists repo_configs_repo_unique;
+
+create unique index repo_configs_repo_unique on repo_configs (owner_id, lower(repo_full_name))
+where
+    deleted_at is null;
+
+drop index if exists repo_configs_external_id_unique;
+
+create unique index repo_configs_external_id_unique on repo_configs (owner_id, external_id)
+where
+    deleted_at is null;
+
+drop index if exists credentials_unique;
+
+create unique index credentials_unique on credentials (owner_id, provider, token_type)
+where
+    deleted_at is null;
+
+drop index if exists credentials_external_id_unique;
+
+create unique index credentials_external_id_unique on credentials (owner_id, external_id)
+where
+    deleted_at is null;
+
+create index if not exists repo_configs_deleted_at_idx on repo_configs (deleted_at)
+where
+    deleted_at is not null;
+
+create index if not exists credentials_deleted_at_idx on credentials (deleted_at)
+where
+    deleted_at is not null;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+-- Trashed rows are purged, they would break the full unique indexes
+delete from repo_configs
+where
+    deleted_at is not null;
+
+delete from credentials
+where
+    deleted_at is not null;
+
+drop index if exists credentials_deleted_at_idx;
+
+drop index if exists repo_configs_deleted_at_idx;
+
+drop index if exists credentials_external_id_unique;
+
+create unique index credentials_external_id_unique on credentials (owner_id, external_id);
+
+drop index if exists credentials_unique;
+
+create unique index credentials_unique on credentials (owner_id, provider, token_type);
+
+drop index if exists repo_configs_external_id_unique;
+
+create unique index repo_configs_external_id_unique on repo_configs (owner_id, external_id);
+
+drop index if exists repo_configs_repo_unique;
+
+create unique index repo_configs_repo_unique on repo_configs (owner_id, lower(repo_full_name));
+
+alter table credentials
+drop column if exists deleted_at;
+
+alter table repo_configs
+drop column if exists deleted_at;
+
+-- +goose StatementEnd
@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+-- How long finished builds and their logs are kept, instance-wide with overrides per
+-- owner. Null keeps them forever, or uses the instance setting for owners.
+alter table instance_limits
+add column if not exists build_retention_days integer,
+add column if not exists log_retention_days integer;
+
+alter table users
+add column if not exists build_retention_days integer,
+add column if not exists log_retention_days integer;
+
+-- Runs of the data retention cleanup that deleted something, for reporting the space
+-- it reclaimed
+create table
+    if not exists retention_runs (
+        id bigserial primary key,
+        builds_deleted integer not null default 0,
+        logs_deleted integer not null default 0,
+        bytes_reclaimed bigint not null default 0, -- logs, artifacts and provenance removed from storage
+        started_at timestamptz not null,
+        finished_at timestamptz not null default now ()
+    );
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists retention_runs;
+
+alter table users
+drop column if exists log_retention_days,
+drop column if exists build_retention_days;
+
+alter table instance_limits
+drop column if exists log_retention_days,
+drop column if exists build_retention_days;
+
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The GitHub App Nimbul acts as, set by admins. Without a row the GITHUB_APP_* and
+-- GITHUB_CLIENT_* environment variables are used.
+create table
+    if not exists github_app (
+        id boolean primary key default true check (id), -- single row
+        app_id bigint not null,
+        slug text not null, -- from the app's URL, github.com/apps/<slug>
+        client_id text not null,
+        ciphertext bytea not null, -- private key and client secret, encrypted like credentials
+        token_nonce bytea not null,
+        wrapped_dek bytea not null,
+        dek_nonce bytea not null,
+        updated_at timestamptz not null default now ()
+    );
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists github_app;
+
+-- +goose StatementEnd
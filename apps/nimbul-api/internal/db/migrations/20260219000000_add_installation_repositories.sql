@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Accounts the GitHub App is installed on, kept in sync from the app's installation
+-- events and a periodic refresh. Uninstalled installations are kept so builds of their
+-- repositories fail with the reason.
+create table
+    if not exists github_installations (
+        id bigint primary key, -- installation ID on GitHub
+        account_login text not null,
+        suspended_at timestamptz,
+        removed_at timestamptz, -- the app was uninstalled from the account
+        synced_at timestamptz not null default now (),
+        created_at timestamptz not null default now ()
+    );
+
+create index if not exists github_installations_account_idx on github_installations (lower(account_login));
+
+-- Repositories each installation can access
+create table
+    if not exists installation_repositories (
+        installation_id bigint not null references github_installations (id) on delete cascade,
+        repo_full_name text not null, -- lowercase, GitHub names are case-insensitive
+        primary key (installation_id, repo_full_name)
+    );
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists installation_repositories;
+
+drop table if exists github_installations;
+
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Scanning build contexts for secrets before their images are built and pushed
+alter table repo_configs
+add column if not exists secret_scan boolean not null default false,
+add column if not exists secret_scan_allowlist text[] not null default '{}'; -- rule IDs or path patterns of accepted findings
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+alter table repo_configs
+drop column if exists secret_scan_allowlist,
+drop column if exists secret_scan;
+
+-- +goose StatementEnd
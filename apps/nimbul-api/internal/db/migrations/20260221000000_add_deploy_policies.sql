@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- CEL expressions every rendered manifest of an owner's deploys is checked against
+-- before it is applied
+create table
+    if not exists deploy_policies (
+        owner_id char(26) not null references users (id) on delete cascade,
+        name text not null,
+        expression text not null, -- true when a manifest complies, e.g. !object.spec.template.spec.containers.exists(c, c.image.endsWith(':latest'))
+        message text not null, -- shown for manifests that don't comply
+        action text not null check (action in ('warn', 'block')),
+        created_at timestamptz not null default now (),
+        updated_at timestamptz not null default now (),
+        primary key (owner_id, name)
+    );
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists deploy_policies;
+
+-- +goose StatementEnd
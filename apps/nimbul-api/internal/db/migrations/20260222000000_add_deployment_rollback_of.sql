@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Who started a deployment, and the deployment a rollback went back to, for the
+-- activity timeline
+alter table deployments
+add column if not exists deployed_by text not null default '', -- GitHub or registry user who pushed, or Nimbul user rolling back
+add column if not exists rollback_of bigint references deployments (id) on delete set null;
+
+create index if not exists deployments_created_at_idx on deployments (created_at);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists deployments_created_at_idx;
+
+alter table deployments
+drop column if exists rollback_of,
+drop column if exists deployed_by;
+
+-- +goose StatementEnd
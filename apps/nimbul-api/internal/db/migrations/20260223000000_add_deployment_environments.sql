@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The deployment currently live in each environment of a config: the namespaces its
+-- manifests name, or the preview namespace of a branch
+create table
+    if not exists deployment_environments (
+        config_id char(26) not null references repo_configs (id) on delete cascade,
+        namespace text not null, -- preview namespace, '' for the namespaces the manifests name
+        branch text not null, -- branch last deployed there
+        deployment_id bigint not null references deployments (id) on delete cascade,
+        builds jsonb not null default '{}', -- build ID of each build name the deployment used
+        updated_at timestamptz not null default now (),
+        primary key (config_id, namespace)
+    );
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists deployment_environments;
+
+-- +goose StatementEnd
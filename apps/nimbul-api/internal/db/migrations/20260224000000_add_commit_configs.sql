@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The validated nimbul.yaml of each commit a config ran, so retries and rollbacks of
+-- a commit neither fetch nor parse it again
+create table
+    if not exists commit_configs (
+        config_id char(26) not null references repo_configs (id) on delete cascade,
+        commit_sha text not null,
+        content text not null, -- nimbul.yaml as committed
+        created_at timestamptz not null default now (),
+        primary key (config_id, commit_sha)
+    );
+
+-- The nimbul.yaml a pipeline ran with its templates rendered
+alter table pipelines
+add column if not exists rendered_config text not null default '';
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+alter table pipelines
+drop column if exists rendered_config;
+
+drop table if exists commit_configs;
+
+-- +goose StatementEnd
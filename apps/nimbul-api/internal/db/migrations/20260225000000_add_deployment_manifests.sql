@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The manifests a deployment applied, as sent to the cluster after overrides and
+-- templates were applied
+create table
+    if not exists deployment_manifests (
+        deployment_id bigint not null references deployments (id) on delete cascade,
+        position int not null, -- order the manifests were applied in
+        path text not null, -- manifest path in the repository
+        content text not null,
+        primary key (deployment_id, position)
+    );
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists deployment_manifests;
+
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Tokens external CI systems trigger and follow the deploys of one config with,
+-- instead of an account token of its owner
+create table
+    if not exists deploy_tokens (
+        id char(26) primary key, -- ULID
+        config_id char(26) not null references repo_configs (id) on delete cascade,
+        name text not null,
+        token_hash text not null, -- sha256 of the deploy token, the token itself is never stored
+        created_by char(26) not null references users (id),
+        last_used_at timestamptz,
+        created_at timestamptz not null default now ()
+    );
+
+create unique index deploy_tokens_token_hash_unique on deploy_tokens (token_hash);
+
+create unique index deploy_tokens_config_name_unique on deploy_tokens (config_id, name);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists deploy_tokens;
+
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table user_totp
+add column if not exists failed_attempts integer not null default 0, -- code attempts since the last accepted code
+add column if not exists locked_until timestamptz; -- codes are refused until then after too many failed attempts
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+alter table user_totp
+drop column if exists locked_until,
+drop column if exists failed_attempts;
+
+-- +goose StatementEnd
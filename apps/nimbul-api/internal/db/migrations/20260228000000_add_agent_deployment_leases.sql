@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A delivered deployment is leased to its agent until it reports the outcome; one whose
+-- lease ran out, e.g. because the agent crashed, is delivered again
+alter table agent_deployments
+add column if not exists claimed_until timestamptz,
+add column if not exists deliveries integer not null default 0;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+alter table agent_deployments
+drop column if exists deliveries,
+drop column if exists claimed_until;
+
+-- +goose StatementEnd
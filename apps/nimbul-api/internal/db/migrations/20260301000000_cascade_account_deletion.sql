@@ -0,0 +1,48 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Deleting a user deletes everything it owns. Builds are checked at commit instead, so
+-- account deletion can put back an anonymized row for them in the same transaction
+alter table repo_configs
+drop constraint if exists repo_configs_owner_id_fkey,
+add constraint repo_configs_owner_id_fkey foreign key (owner_id) references users (id) on delete cascade;
+
+alter table agents
+drop constraint if exists agents_owner_id_fkey,
+add constraint agents_owner_id_fkey foreign key (owner_id) references users (id) on delete cascade;
+
+alter table credentials
+drop constraint if exists credentials_owner_id_fkey,
+add constraint credentials_owner_id_fkey foreign key (owner_id) references users (id) on delete cascade;
+
+alter table deploy_tokens
+drop constraint if exists deploy_tokens_created_by_fkey,
+add constraint deploy_tokens_created_by_fkey foreign key (created_by) references users (id) on delete cascade;
+
+alter table builds
+drop constraint if exists builds_owner_id_fkey,
+add constraint builds_owner_id_fkey foreign key (owner_id) references users (id) deferrable initially deferred;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+alter table builds
+drop constraint if exists builds_owner_id_fkey,
+add constraint builds_owner_id_fkey foreign key (owner_id) references users (id) on delete cascade;
+
+alter table deploy_tokens
+drop constraint if exists deploy_tokens_created_by_fkey,
+add constraint deploy_tokens_created_by_fkey foreign key (created_by) references users (id);
+
+alter table credentials
+drop constraint if exists credentials_owner_id_fkey,
+add constraint credentials_owner_id_fkey foreign key (owner_id) references users (id);
+
+alter table agents
+drop constraint if exists agents_owner_id_fkey,
+add constraint agents_owner_id_fkey foreign key (owner_id) references users (id);
+
+alter table repo_configs
+drop constraint if exists repo_configs_owner_id_fkey,
+add constraint repo_configs_owner_id_fkey foreign key (owner_id) references users (id);
+
+-- +goose StatementEnd
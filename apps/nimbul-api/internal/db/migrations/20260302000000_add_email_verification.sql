@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table users
+add column if not exists email_verified_at timestamptz; -- set once the user proved they receive mail at the address, by a password reset, a confirmed email change or a verified OIDC email
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+alter table users
+drop column if exists email_verified_at;
+
+-- +goose StatementEnd
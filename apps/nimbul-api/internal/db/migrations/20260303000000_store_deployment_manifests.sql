@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Deployment manifests are kept in object storage under storage_key; rows without one
+-- still hold them inline
+alter table deployment_manifests
+add column if not exists storage_key text,
+alter column content set default ''; -- content is '' when storage_key is set
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+alter table deployment_manifests
+alter column content drop default,
+drop column if exists storage_key;
+
+-- +goose StatementEnd
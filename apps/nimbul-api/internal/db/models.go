@@ -8,39 +8,403 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type Agent struct {
+	ID         string
+	OwnerID    string
+	Name       string
+	TokenHash  string
+	LastSeenAt pgtype.Timestamptz
+	CreatedAt  pgtype.Timestamptz
+}
+
+type AgentDeployment struct {
+	ID           int64
+	AgentID      string
+	ConfigID     string
+	Manifests    string
+	Status       string
+	Error        pgtype.Text
+	CreatedAt    pgtype.Timestamptz
+	UpdatedAt    pgtype.Timestamptz
+	StorageKey   pgtype.Text
+	ClaimedUntil pgtype.Timestamptz
+	Deliveries   int32
+}
+
+type Build struct {
+	ID            int64
+	ConfigID      pgtype.Text
+	OwnerID       string
+	Name          string
+	Ref           string
+	CommitSha     string
+	Status        string
+	Error         pgtype.Text
+	LogBytes      int64
+	ArtifactBytes int64
+	StartedAt     pgtype.Timestamptz
+	FinishedAt    pgtype.Timestamptz
+	PipelineID    pgtype.Int8
+	Timings       []byte
+	ConfigHash    string
+}
+
+type BuildArtifact struct {
+	ID         int64
+	BuildID    int64
+	Name       string
+	StorageKey string
+	SizeBytes  int64
+	CreatedAt  pgtype.Timestamptz
+}
+
+type BuildImage struct {
+	ID              int64
+	BuildID         int64
+	Image           string
+	Digest          string
+	BranchDeletedAt pgtype.Timestamptz
+	DeletedAt       pgtype.Timestamptz
+	CreatedAt       pgtype.Timestamptz
+}
+
+type BuildLog struct {
+	BuildID    int64
+	Entries    []byte
+	Truncated  bool
+	CreatedAt  pgtype.Timestamptz
+	StorageKey pgtype.Text
+}
+
+type BuildProvenance struct {
+	ID            int64
+	BuildID       int64
+	Image         string
+	Digest        string
+	PredicateType string
+	Statement     []byte
+	CreatedAt     pgtype.Timestamptz
+	StorageKey    pgtype.Text
+}
+
+type CommitConfig struct {
+	ConfigID  string
+	CommitSha string
+	Content   string
+	CreatedAt pgtype.Timestamptz
+}
+
+type ConfigEnv struct {
+	ConfigID  string
+	Name      string
+	Value     string
+	CreatedAt pgtype.Timestamptz
+	UpdatedAt pgtype.Timestamptz
+}
+
+type ConfigTransfer struct {
+	ID         string
+	ConfigID   string
+	FromUserID string
+	ToUserID   string
+	CreatedAt  pgtype.Timestamptz
+}
+
 type Credential struct {
+	ID               int64
+	OwnerID          string
+	Provider         string
+	TokenType        string
+	Ciphertext       []byte
+	TokenNonce       []byte
+	WrappedDek       []byte
+	DekNonce         []byte
+	CreatedAt        pgtype.Timestamptz
+	LastUsedAt       pgtype.Timestamptz
+	ExpiresAt        pgtype.Timestamptz
+	ExpiryNotifiedAt pgtype.Timestamptz
+	ExternalID       pgtype.Text
+	Version          int32
+	DeletedAt        pgtype.Timestamptz
+}
+
+type DeadJob struct {
 	ID         int64
+	Kind       string
+	ConfigID   string
+	Payload    []byte
+	Error      string
+	Attempts   int32
+	CreatedAt  pgtype.Timestamptz
+	RequeuedAt pgtype.Timestamptz
+}
+
+type DeployPolicy struct {
 	OwnerID    string
-	Provider   string
-	TokenType  string
+	Name       string
+	Expression string
+	Message    string
+	Action     string
+	CreatedAt  pgtype.Timestamptz
+	UpdatedAt  pgtype.Timestamptz
+}
+
+type DeployToken struct {
+	ID         string
+	ConfigID   string
+	Name       string
+	TokenHash  string
+	CreatedBy  string
+	LastUsedAt pgtype.Timestamptz
+	CreatedAt  pgtype.Timestamptz
+}
+
+type Deployment struct {
+	ID         int64
+	ConfigID   string
+	Ref        string
+	CommitSha  string
+	Status     string
+	Resources  []byte
+	Error      pgtype.Text
+	CreatedAt  pgtype.Timestamptz
+	UpdatedAt  pgtype.Timestamptz
+	DeployedBy string
+	RollbackOf pgtype.Int8
+}
+
+type DeploymentEnvironment struct {
+	ConfigID     string
+	Namespace    string
+	Branch       string
+	DeploymentID int64
+	Builds       []byte
+	UpdatedAt    pgtype.Timestamptz
+}
+
+type DeploymentManifest struct {
+	DeploymentID int64
+	Position     int32
+	Path         string
+	Content      string
+	StorageKey   pgtype.Text
+}
+
+type EmailChange struct {
+	TokenHash string
+	UserID    string
+	NewEmail  string
+	ExpiresAt pgtype.Timestamptz
+	UsedAt    pgtype.Timestamptz
+	CreatedAt pgtype.Timestamptz
+}
+
+type GithubApp struct {
+	ID         bool
+	AppID      int64
+	Slug       string
+	ClientID   string
 	Ciphertext []byte
 	TokenNonce []byte
 	WrappedDek []byte
 	DekNonce   []byte
-	CreatedAt  pgtype.Timestamptz
-	LastUsedAt pgtype.Timestamptz
-	ExpiresAt  pgtype.Timestamptz
+	UpdatedAt  pgtype.Timestamptz
 }
 
-type RepoConfig struct {
+type GithubInstallation struct {
+	ID           int64
+	AccountLogin string
+	SuspendedAt  pgtype.Timestamptz
+	RemovedAt    pgtype.Timestamptz
+	SyncedAt     pgtype.Timestamptz
+	CreatedAt    pgtype.Timestamptz
+}
+
+type Hook struct {
 	ID             string
 	OwnerID        string
-	Provider       string
-	RepoOwner      string
-	RepoName       string
+	Url            string
+	Secret         string
+	Events         []string
+	LastDeliveryAt pgtype.Timestamptz
+	LastStatusCode pgtype.Int4
+	LastError      pgtype.Text
+	CreatedAt      pgtype.Timestamptz
+}
+
+type InstallationRepository struct {
+	InstallationID int64
 	RepoFullName   string
-	RepoCloneUrl   string
-	DockerfilePath string
-	WebhookSecret  string
-	WebhookID      pgtype.Int8
+}
+
+type InstanceLimit struct {
+	ID                          bool
+	MaxConfigsPerUser           pgtype.Int4
+	MaxBuildMinutes             pgtype.Int4
+	UpdatedAt                   pgtype.Timestamptz
+	BuildMinutesSoftQuota       pgtype.Int4
+	BuildMinutesHardQuota       pgtype.Int4
+	DeploysSoftQuota            pgtype.Int4
+	DeploysHardQuota            pgtype.Int4
+	StorageMbSoftQuota          pgtype.Int4
+	StorageMbHardQuota          pgtype.Int4
+	MaxLogMb                    pgtype.Int4
+	MaxArtifactMb               pgtype.Int4
+	ConfigBuildMinutesSoftQuota pgtype.Int4
+	ConfigBuildMinutesHardQuota pgtype.Int4
+	ConfigStorageMbSoftQuota    pgtype.Int4
+	ConfigStorageMbHardQuota    pgtype.Int4
+	BuildRetentionDays          pgtype.Int4
+	LogRetentionDays            pgtype.Int4
+}
+
+type NotificationPreference struct {
+	UserID           string
+	BuildFailed      bool
+	DeployFailed     bool
+	CredentialExpiry bool
+	UpdatedAt        pgtype.Timestamptz
+	SlackWebhookUrl  pgtype.Text
+}
+
+type PasswordReset struct {
+	TokenHash string
+	UserID    string
+	ExpiresAt pgtype.Timestamptz
+	UsedAt    pgtype.Timestamptz
+	CreatedAt pgtype.Timestamptz
+}
+
+type Pipeline struct {
+	ID             int64
+	ConfigID       string
+	Ref            string
+	CommitSha      string
 	CreatedAt      pgtype.Timestamptz
-	UpdatedAt      pgtype.Timestamptz
+	Status         string
+	FinishedAt     pgtype.Timestamptz
+	RenderedConfig string
 }
 
-type User struct {
-	ID           string
-	Email        string
-	PasswordHash string
+type PipelineStage struct {
+	ID           int64
+	PipelineID   int64
+	Name         string
+	Kind         string
+	Needs        []string
+	Status       string
+	Error        pgtype.Text
+	BuildID      pgtype.Int8
+	DeploymentID pgtype.Int8
+	StartedAt    pgtype.Timestamptz
+	FinishedAt   pgtype.Timestamptz
+}
+
+type QueuedJob struct {
+	ID           int64
+	Kind         string
+	ConfigID     string
+	Payload      []byte
+	Priority     int32
+	Attempts     int32
+	RunAfter     pgtype.Timestamptz
+	ClaimedUntil pgtype.Timestamptz
 	CreatedAt    pgtype.Timestamptz
-	UpdatedAt    pgtype.Timestamptz
+}
+
+type RepoConfig struct {
+	ID                      string
+	OwnerID                 string
+	Provider                string
+	RepoOwner               string
+	RepoName                string
+	RepoFullName            string
+	RepoCloneUrl            string
+	DockerfilePath          string
+	WebhookSecret           string
+	WebhookID               pgtype.Int8
+	CreatedAt               pgtype.Timestamptz
+	UpdatedAt               pgtype.Timestamptz
+	ClusterCredentialID     pgtype.Int8
+	AgentID                 pgtype.Text
+	RetentionKeepLast       pgtype.Int4
+	RetentionDeletePreviews bool
+	RegistryWebhookToken    pgtype.Text
+	ExternalID              pgtype.Text
+	Version                 int32
+	Health                  string
+	HealthDetail            string
+	HealthCheckedAt         pgtype.Timestamptz
+	DeniedAuthors           []string
+	DeletedAt               pgtype.Timestamptz
+	SecretScan              bool
+	SecretScanAllowlist     []string
+}
+
+type RetentionRun struct {
+	ID             int64
+	BuildsDeleted  int32
+	LogsDeleted    int32
+	BytesReclaimed int64
+	StartedAt      pgtype.Timestamptz
+	FinishedAt     pgtype.Timestamptz
+}
+
+type Session struct {
+	ID         string
+	UserID     string
+	UserAgent  string
+	Ip         string
+	CreatedAt  pgtype.Timestamptz
+	LastUsedAt pgtype.Timestamptz
+	ExpiresAt  pgtype.Timestamptz
+}
+
+type User struct {
+	ID                          string
+	Email                       string
+	PasswordHash                string
+	CreatedAt                   pgtype.Timestamptz
+	UpdatedAt                   pgtype.Timestamptz
+	Role                        string
+	DisabledAt                  pgtype.Timestamptz
+	OidcIssuer                  pgtype.Text
+	OidcSubject                 pgtype.Text
+	DeletedAt                   pgtype.Timestamptz
+	DefaultRegistryCredentialID pgtype.Int8
+	BuildRetentionDays          pgtype.Int4
+	LogRetentionDays            pgtype.Int4
+	EmailVerifiedAt             pgtype.Timestamptz
+}
+
+type UserRecoveryCode struct {
+	CodeHash  string
+	UserID    string
+	UsedAt    pgtype.Timestamptz
+	CreatedAt pgtype.Timestamptz
+}
+
+type UserTotp struct {
+	UserID         string
+	Ciphertext     []byte
+	TokenNonce     []byte
+	WrappedDek     []byte
+	DekNonce       []byte
+	EnabledAt      pgtype.Timestamptz
+	LastUsedStep   int64
+	CreatedAt      pgtype.Timestamptz
+	FailedAttempts int32
+	LockedUntil    pgtype.Timestamptz
+}
+
+type WebhookDelivery struct {
+	ID         int64
+	Source     string
+	ConfigID   string
+	EventType  pgtype.Text
+	Headers    []byte
+	Payload    []byte
+	StatusCode int32
+	Response   pgtype.Text
+	CreatedAt  pgtype.Timestamptz
 }
@@ -11,14 +11,525 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const acceptConfigTransfer = `-- name: AcceptConfigTransfer :one
+WITH transfer AS (
+    DELETE FROM config_transfers
+    WHERE config_transfers.id = $1 AND config_transfers.to_user_id = $2
+    RETURNING config_transfers.config_id, config_transfers.to_user_id
+), revoked AS (
+    DELETE FROM deploy_tokens
+    WHERE deploy_tokens.config_id IN (SELECT config_id FROM transfer)
+    RETURNING deploy_tokens.id
+)
+UPDATE repo_configs
+SET owner_id = transfer.to_user_id, cluster_credential_id = NULL, agent_id = NULL, version = version + 1, updated_at = NOW()
+FROM transfer
+WHERE repo_configs.id = transfer.config_id
+RETURNING repo_configs.id, repo_configs.owner_id, repo_configs.provider, repo_configs.repo_owner, repo_configs.repo_name, repo_configs.repo_full_name, repo_configs.repo_clone_url, repo_configs.dockerfile_path, repo_configs.webhook_secret, repo_configs.webhook_id, repo_configs.created_at, repo_configs.updated_at, repo_configs.cluster_credential_id, repo_configs.agent_id, repo_configs.retention_keep_last, repo_configs.retention_delete_previews, repo_configs.registry_webhook_token, repo_configs.external_id, repo_configs.version, repo_configs.health, repo_configs.health_detail, repo_configs.health_checked_at, repo_configs.denied_authors, repo_configs.deleted_at, repo_configs.secret_scan, repo_configs.secret_scan_allowlist, (SELECT COUNT(*) FROM revoked) AS revoked_deploy_tokens
+`
+
+type AcceptConfigTransferParams struct {
+	ID       string
+	ToUserID string
+}
+
+type AcceptConfigTransferRow struct {
+	RepoConfig          RepoConfig
+	RevokedDeployTokens int64
+}
+
+// Cluster credentials and agents belong to the previous owner, so the config stops
+// using them. The deploy tokens the previous owner issued are revoked in the same
+// statement, so they never work for the new owner's config.
+func (q *Queries) AcceptConfigTransfer(ctx context.Context, arg AcceptConfigTransferParams) (AcceptConfigTransferRow, error) {
+	row := q.db.QueryRow(ctx, acceptConfigTransfer, arg.ID, arg.ToUserID)
+	var i AcceptConfigTransferRow
+	err := row.Scan(
+		&i.RepoConfig.ID,
+		&i.RepoConfig.OwnerID,
+		&i.RepoConfig.Provider,
+		&i.RepoConfig.RepoOwner,
+		&i.RepoConfig.RepoName,
+		&i.RepoConfig.RepoFullName,
+		&i.RepoConfig.RepoCloneUrl,
+		&i.RepoConfig.DockerfilePath,
+		&i.RepoConfig.WebhookSecret,
+		&i.RepoConfig.WebhookID,
+		&i.RepoConfig.CreatedAt,
+		&i.RepoConfig.UpdatedAt,
+		&i.RepoConfig.ClusterCredentialID,
+		&i.RepoConfig.AgentID,
+		&i.RepoConfig.RetentionKeepLast,
+		&i.RepoConfig.RetentionDeletePreviews,
+		&i.RepoConfig.RegistryWebhookToken,
+		&i.RepoConfig.ExternalID,
+		&i.RepoConfig.Version,
+		&i.RepoConfig.Health,
+		&i.RepoConfig.HealthDetail,
+		&i.RepoConfig.HealthCheckedAt,
+		&i.RepoConfig.DeniedAuthors,
+		&i.RepoConfig.DeletedAt,
+		&i.RepoConfig.SecretScan,
+		&i.RepoConfig.SecretScanAllowlist,
+		&i.RevokedDeployTokens,
+	)
+	return i, err
+}
+
+const addBuildArtifactBytes = `-- name: AddBuildArtifactBytes :exec
+UPDATE builds
+SET artifact_bytes = artifact_bytes + $2
+WHERE id = $1
+`
+
+type AddBuildArtifactBytesParams struct {
+	ID            int64
+	ArtifactBytes int64
+}
+
+func (q *Queries) AddBuildArtifactBytes(ctx context.Context, arg AddBuildArtifactBytesParams) error {
+	_, err := q.db.Exec(ctx, addBuildArtifactBytes, arg.ID, arg.ArtifactBytes)
+	return err
+}
+
+const addBuildLogBytes = `-- name: AddBuildLogBytes :exec
+UPDATE builds
+SET log_bytes = log_bytes + $2
+WHERE id = $1
+`
+
+type AddBuildLogBytesParams struct {
+	ID       int64
+	LogBytes int64
+}
+
+func (q *Queries) AddBuildLogBytes(ctx context.Context, arg AddBuildLogBytesParams) error {
+	_, err := q.db.Exec(ctx, addBuildLogBytes, arg.ID, arg.LogBytes)
+	return err
+}
+
+const addBuildTimings = `-- name: AddBuildTimings :exec
+UPDATE builds
+SET timings = timings || $1::jsonb
+WHERE id = $2
+`
+
+type AddBuildTimingsParams struct {
+	Timings []byte
+	ID      int64
+}
+
+func (q *Queries) AddBuildTimings(ctx context.Context, arg AddBuildTimingsParams) error {
+	_, err := q.db.Exec(ctx, addBuildTimings, arg.Timings, arg.ID)
+	return err
+}
+
+const addInstallationRepositories = `-- name: AddInstallationRepositories :exec
+INSERT INTO installation_repositories (installation_id, repo_full_name)
+SELECT $1, lower(name)
+FROM unnest($2::text[]) AS name
+ON CONFLICT DO NOTHING
+`
+
+type AddInstallationRepositoriesParams struct {
+	InstallationID int64
+	RepoFullNames  []string
+}
+
+func (q *Queries) AddInstallationRepositories(ctx context.Context, arg AddInstallationRepositoriesParams) error {
+	_, err := q.db.Exec(ctx, addInstallationRepositories, arg.InstallationID, arg.RepoFullNames)
+	return err
+}
+
+const claimEmailChange = `-- name: ClaimEmailChange :one
+UPDATE email_changes
+SET used_at = NOW()
+WHERE token_hash = $1 AND used_at IS NULL AND expires_at > NOW()
+RETURNING token_hash, user_id, new_email, expires_at, used_at, created_at
+`
+
+func (q *Queries) ClaimEmailChange(ctx context.Context, tokenHash string) (EmailChange, error) {
+	row := q.db.QueryRow(ctx, claimEmailChange, tokenHash)
+	var i EmailChange
+	err := row.Scan(
+		&i.TokenHash,
+		&i.UserID,
+		&i.NewEmail,
+		&i.ExpiresAt,
+		&i.UsedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const claimNextAgentDeployment = `-- name: ClaimNextAgentDeployment :one
+UPDATE agent_deployments
+SET status = 'delivered',
+    claimed_until = NOW() + make_interval(secs => $1::integer),
+    deliveries = deliveries + 1,
+    updated_at = NOW()
+WHERE id = (
+  SELECT pending.id FROM agent_deployments pending
+  WHERE pending.agent_id = $2
+    AND (pending.status = 'pending' OR (pending.status = 'delivered' AND pending.claimed_until < NOW()))
+  ORDER BY pending.id
+  LIMIT 1
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING id, agent_id, config_id, manifests, status, error, created_at, updated_at, storage_key, claimed_until, deliveries
+`
+
+type ClaimNextAgentDeploymentParams struct {
+	LeaseSeconds int32
+	AgentID      string
+}
+
+// Takes the oldest pending deployment of the agent, or a delivered one whose lease ran
+// out without the agent reporting its outcome
+func (q *Queries) ClaimNextAgentDeployment(ctx context.Context, arg ClaimNextAgentDeploymentParams) (AgentDeployment, error) {
+	row := q.db.QueryRow(ctx, claimNextAgentDeployment, arg.LeaseSeconds, arg.AgentID)
+	var i AgentDeployment
+	err := row.Scan(
+		&i.ID,
+		&i.AgentID,
+		&i.ConfigID,
+		&i.Manifests,
+		&i.Status,
+		&i.Error,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.StorageKey,
+		&i.ClaimedUntil,
+		&i.Deliveries,
+	)
+	return i, err
+}
+
+const claimNextJob = `-- name: ClaimNextJob :one
+UPDATE queued_jobs
+SET claimed_until = NOW() + make_interval(secs => $1::integer),
+    attempts = attempts + 1
+WHERE id = (
+  SELECT id FROM queued_jobs
+  WHERE run_after <= NOW() AND (claimed_until IS NULL OR claimed_until < NOW())
+  ORDER BY priority DESC, id
+  LIMIT 1
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING id, kind, config_id, payload, priority, attempts, run_after, claimed_until, created_at
+`
+
+// Takes the queued job of the highest priority that is due and not leased by another
+// worker; a worker that died lets its lease run out and the job is claimed again
+func (q *Queries) ClaimNextJob(ctx context.Context, leaseSeconds int32) (QueuedJob, error) {
+	row := q.db.QueryRow(ctx, claimNextJob, leaseSeconds)
+	var i QueuedJob
+	err := row.Scan(
+		&i.ID,
+		&i.Kind,
+		&i.ConfigID,
+		&i.Payload,
+		&i.Priority,
+		&i.Attempts,
+		&i.RunAfter,
+		&i.ClaimedUntil,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const claimPasswordReset = `-- name: ClaimPasswordReset :one
+UPDATE password_resets
+SET used_at = NOW()
+WHERE token_hash = $1 AND used_at IS NULL AND expires_at > NOW()
+RETURNING token_hash, user_id, expires_at, used_at, created_at
+`
+
+func (q *Queries) ClaimPasswordReset(ctx context.Context, tokenHash string) (PasswordReset, error) {
+	row := q.db.QueryRow(ctx, claimPasswordReset, tokenHash)
+	var i PasswordReset
+	err := row.Scan(
+		&i.TokenHash,
+		&i.UserID,
+		&i.ExpiresAt,
+		&i.UsedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const clearConfigWebhookID = `-- name: ClearConfigWebhookID :execrows
+UPDATE repo_configs
+SET webhook_id = NULL, version = version + 1, updated_at = NOW()
+WHERE id = $1 AND webhook_id = $2
+`
+
+type ClearConfigWebhookIDParams struct {
+	ID        string
+	WebhookID pgtype.Int8
+}
+
+// Only clears the webhook the caller found to be gone, not one created since
+func (q *Queries) ClearConfigWebhookID(ctx context.Context, arg ClearConfigWebhookIDParams) (int64, error) {
+	result, err := q.db.Exec(ctx, clearConfigWebhookID, arg.ID, arg.WebhookID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const createAgent = `-- name: CreateAgent :one
+INSERT INTO agents (
+  id, owner_id, name, token_hash
+) VALUES (
+  $1, $2, $3, $4
+)
+RETURNING id, owner_id, name, token_hash, last_seen_at, created_at
+`
+
+type CreateAgentParams struct {
+	ID        string
+	OwnerID   string
+	Name      string
+	TokenHash string
+}
+
+func (q *Queries) CreateAgent(ctx context.Context, arg CreateAgentParams) (Agent, error) {
+	row := q.db.QueryRow(ctx, createAgent,
+		arg.ID,
+		arg.OwnerID,
+		arg.Name,
+		arg.TokenHash,
+	)
+	var i Agent
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Name,
+		&i.TokenHash,
+		&i.LastSeenAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createAgentDeployment = `-- name: CreateAgentDeployment :one
+INSERT INTO agent_deployments (
+  agent_id, config_id, storage_key
+) VALUES (
+  $1, $2, $3
+)
+RETURNING id, agent_id, config_id, manifests, status, error, created_at, updated_at, storage_key, claimed_until, deliveries
+`
+
+type CreateAgentDeploymentParams struct {
+	AgentID    string
+	ConfigID   string
+	StorageKey pgtype.Text
+}
+
+func (q *Queries) CreateAgentDeployment(ctx context.Context, arg CreateAgentDeploymentParams) (AgentDeployment, error) {
+	row := q.db.QueryRow(ctx, createAgentDeployment, arg.AgentID, arg.ConfigID, arg.StorageKey)
+	var i AgentDeployment
+	err := row.Scan(
+		&i.ID,
+		&i.AgentID,
+		&i.ConfigID,
+		&i.Manifests,
+		&i.Status,
+		&i.Error,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.StorageKey,
+		&i.ClaimedUntil,
+		&i.Deliveries,
+	)
+	return i, err
+}
+
+const createBuild = `-- name: CreateBuild :one
+INSERT INTO builds (
+  config_id, owner_id, name, ref, commit_sha, pipeline_id, config_hash
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7
+)
+RETURNING id, config_id, owner_id, name, ref, commit_sha, status, error, log_bytes, artifact_bytes, started_at, finished_at, pipeline_id, timings, config_hash
+`
+
+type CreateBuildParams struct {
+	ConfigID   pgtype.Text
+	OwnerID    string
+	Name       string
+	Ref        string
+	CommitSha  string
+	PipelineID pgtype.Int8
+	ConfigHash string
+}
+
+func (q *Queries) CreateBuild(ctx context.Context, arg CreateBuildParams) (Build, error) {
+	row := q.db.QueryRow(ctx, createBuild,
+		arg.ConfigID,
+		arg.OwnerID,
+		arg.Name,
+		arg.Ref,
+		arg.CommitSha,
+		arg.PipelineID,
+		arg.ConfigHash,
+	)
+	var i Build
+	err := row.Scan(
+		&i.ID,
+		&i.ConfigID,
+		&i.OwnerID,
+		&i.Name,
+		&i.Ref,
+		&i.CommitSha,
+		&i.Status,
+		&i.Error,
+		&i.LogBytes,
+		&i.ArtifactBytes,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.PipelineID,
+		&i.Timings,
+		&i.ConfigHash,
+	)
+	return i, err
+}
+
+const createBuildArtifact = `-- name: CreateBuildArtifact :one
+INSERT INTO build_artifacts (
+  build_id, name, storage_key, size_bytes
+) VALUES (
+  $1, $2, $3, $4
+)
+RETURNING id, build_id, name, storage_key, size_bytes, created_at
+`
+
+type CreateBuildArtifactParams struct {
+	BuildID    int64
+	Name       string
+	StorageKey string
+	SizeBytes  int64
+}
+
+func (q *Queries) CreateBuildArtifact(ctx context.Context, arg CreateBuildArtifactParams) (BuildArtifact, error) {
+	row := q.db.QueryRow(ctx, createBuildArtifact,
+		arg.BuildID,
+		arg.Name,
+		arg.StorageKey,
+		arg.SizeBytes,
+	)
+	var i BuildArtifact
+	err := row.Scan(
+		&i.ID,
+		&i.BuildID,
+		&i.Name,
+		&i.StorageKey,
+		&i.SizeBytes,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createBuildImage = `-- name: CreateBuildImage :one
+INSERT INTO build_images (
+  build_id, image, digest
+) VALUES (
+  $1, $2, $3
+)
+RETURNING id, build_id, image, digest, branch_deleted_at, deleted_at, created_at
+`
+
+type CreateBuildImageParams struct {
+	BuildID int64
+	Image   string
+	Digest  string
+}
+
+func (q *Queries) CreateBuildImage(ctx context.Context, arg CreateBuildImageParams) (BuildImage, error) {
+	row := q.db.QueryRow(ctx, createBuildImage, arg.BuildID, arg.Image, arg.Digest)
+	var i BuildImage
+	err := row.Scan(
+		&i.ID,
+		&i.BuildID,
+		&i.Image,
+		&i.Digest,
+		&i.BranchDeletedAt,
+		&i.DeletedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createBuildLog = `-- name: CreateBuildLog :exec
+INSERT INTO build_logs (
+  build_id, storage_key, truncated
+) VALUES (
+  $1, $2, $3
+)
+ON CONFLICT (build_id) DO UPDATE
+SET entries = '[]', storage_key = EXCLUDED.storage_key, truncated = EXCLUDED.truncated
+`
+
+type CreateBuildLogParams struct {
+	BuildID    int64
+	StorageKey pgtype.Text
+	Truncated  bool
+}
+
+func (q *Queries) CreateBuildLog(ctx context.Context, arg CreateBuildLogParams) error {
+	_, err := q.db.Exec(ctx, createBuildLog, arg.BuildID, arg.StorageKey, arg.Truncated)
+	return err
+}
+
+const createBuildProvenance = `-- name: CreateBuildProvenance :one
+INSERT INTO build_provenance (
+  build_id, image, digest, predicate_type, storage_key
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+RETURNING id, build_id, image, digest, predicate_type, statement, created_at, storage_key
+`
+
+type CreateBuildProvenanceParams struct {
+	BuildID       int64
+	Image         string
+	Digest        string
+	PredicateType string
+	StorageKey    pgtype.Text
+}
+
+func (q *Queries) CreateBuildProvenance(ctx context.Context, arg CreateBuildProvenanceParams) (BuildProvenance, error) {
+	row := q.db.QueryRow(ctx, createBuildProvenance,
+		arg.BuildID,
+		arg.Image,
+		arg.Digest,
+		arg.PredicateType,
+		arg.StorageKey,
+	)
+	var i BuildProvenance
+	err := row.Scan(
+		&i.ID,
+		&i.BuildID,
+		&i.Image,
+		&i.Digest,
+		&i.PredicateType,
+		&i.Statement,
+		&i.CreatedAt,
+		&i.StorageKey,
+	)
+	return i, err
+}
+
 const createConfig = `-- name: CreateConfig :one
 INSERT INTO repo_configs (
     id, owner_id, provider, repo_owner, repo_name, repo_full_name, 
-    repo_clone_url, dockerfile_path, webhook_secret, webhook_id
+    repo_clone_url, dockerfile_path, webhook_secret, webhook_id, external_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
 )
-RETURNING id, owner_id, provider, repo_owner, repo_name, repo_full_name, repo_clone_url, dockerfile_path, webhook_secret, webhook_id, created_at, updated_at
+RETURNING id, owner_id, provider, repo_owner, repo_name, repo_full_name, repo_clone_url, dockerfile_path, webhook_secret, webhook_id, created_at, updated_at, cluster_credential_id, agent_id, retention_keep_last, retention_delete_previews, registry_webhook_token, external_id, version, health, health_detail, health_checked_at, denied_authors, deleted_at, secret_scan, secret_scan_allowlist
 `
 
 type CreateConfigParams struct {
@@ -32,6 +543,7 @@ type CreateConfigParams struct {
 	DockerfilePath string
 	WebhookSecret  string
 	WebhookID      pgtype.Int8
+	ExternalID     pgtype.Text
 }
 
 func (q *Queries) CreateConfig(ctx context.Context, arg CreateConfigParams) (RepoConfig, error) {
@@ -46,6 +558,7 @@ func (q *Queries) CreateConfig(ctx context.Context, arg CreateConfigParams) (Rep
 		arg.DockerfilePath,
 		arg.WebhookSecret,
 		arg.WebhookID,
+		arg.ExternalID,
 	)
 	var i RepoConfig
 	err := row.Scan(
@@ -61,17 +574,68 @@ func (q *Queries) CreateConfig(ctx context.Context, arg CreateConfigParams) (Rep
 		&i.WebhookID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ClusterCredentialID,
+		&i.AgentID,
+		&i.RetentionKeepLast,
+		&i.RetentionDeletePreviews,
+		&i.RegistryWebhookToken,
+		&i.ExternalID,
+		&i.Version,
+		&i.Health,
+		&i.HealthDetail,
+		&i.HealthCheckedAt,
+		&i.DeniedAuthors,
+		&i.DeletedAt,
+		&i.SecretScan,
+		&i.SecretScanAllowlist,
+	)
+	return i, err
+}
+
+const createConfigTransfer = `-- name: CreateConfigTransfer :one
+INSERT INTO config_transfers (
+    id, config_id, from_user_id, to_user_id
+) VALUES (
+    $1, $2, $3, $4
+)
+ON CONFLICT (config_id) DO UPDATE
+SET id = EXCLUDED.id, from_user_id = EXCLUDED.from_user_id, to_user_id = EXCLUDED.to_user_id, created_at = NOW()
+RETURNING id, config_id, from_user_id, to_user_id, created_at
+`
+
+type CreateConfigTransferParams struct {
+	ID         string
+	ConfigID   string
+	FromUserID string
+	ToUserID   string
+}
+
+// Starting a transfer replaces the pending transfer of the config, if any
+func (q *Queries) CreateConfigTransfer(ctx context.Context, arg CreateConfigTransferParams) (ConfigTransfer, error) {
+	row := q.db.QueryRow(ctx, createConfigTransfer,
+		arg.ID,
+		arg.ConfigID,
+		arg.FromUserID,
+		arg.ToUserID,
+	)
+	var i ConfigTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.ConfigID,
+		&i.FromUserID,
+		&i.ToUserID,
+		&i.CreatedAt,
 	)
 	return i, err
 }
 
 const createCredential = `-- name: CreateCredential :one
 INSERT INTO credentials (
-  owner_id, provider, token_type, ciphertext, token_nonce, wrapped_dek, dek_nonce, expires_at 
+  owner_id, provider, token_type, ciphertext, token_nonce, wrapped_dek, dek_nonce, expires_at, external_id
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8
+  $1, $2, $3, $4, $5, $6, $7, $8, $9
 )
-RETURNING id, owner_id, provider, token_type, ciphertext, token_nonce, wrapped_dek, dek_nonce, created_at, last_used_at, expires_at
+RETURNING id, owner_id, provider, token_type, ciphertext, token_nonce, wrapped_dek, dek_nonce, created_at, last_used_at, expires_at, expiry_notified_at, external_id, version, deleted_at
 `
 
 type CreateCredentialParams struct {
@@ -83,6 +647,7 @@ type CreateCredentialParams struct {
 	WrappedDek []byte
 	DekNonce   []byte
 	ExpiresAt  pgtype.Timestamptz
+	ExternalID pgtype.Text
 }
 
 func (q *Queries) CreateCredential(ctx context.Context, arg CreateCredentialParams) (Credential, error) {
@@ -95,6 +660,7 @@ func (q *Queries) CreateCredential(ctx context.Context, arg CreateCredentialPara
 		arg.WrappedDek,
 		arg.DekNonce,
 		arg.ExpiresAt,
+		arg.ExternalID,
 	)
 	var i Credential
 	err := row.Scan(
@@ -109,81 +675,2118 @@ func (q *Queries) CreateCredential(ctx context.Context, arg CreateCredentialPara
 		&i.CreatedAt,
 		&i.LastUsedAt,
 		&i.ExpiresAt,
+		&i.ExpiryNotifiedAt,
+		&i.ExternalID,
+		&i.Version,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
-const createUser = `-- name: CreateUser :one
-INSERT INTO users (
-  id, email, password_hash
+const createDeadJob = `-- name: CreateDeadJob :one
+INSERT INTO dead_jobs (
+  kind, config_id, payload, error, attempts
 ) VALUES (
-  $1, $2, $3
+  $1, $2, $3, $4, $5
 )
-RETURNING id, email, password_hash, created_at, updated_at
+RETURNING id, kind, config_id, payload, error, attempts, created_at, requeued_at
 `
 
-type CreateUserParams struct {
-	ID           string
-	Email        string
-	PasswordHash string
+type CreateDeadJobParams struct {
+	Kind     string
+	ConfigID string
+	Payload  []byte
+	Error    string
+	Attempts int32
 }
 
-func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
-	row := q.db.QueryRow(ctx, createUser, arg.ID, arg.Email, arg.PasswordHash)
-	var i User
+func (q *Queries) CreateDeadJob(ctx context.Context, arg CreateDeadJobParams) (DeadJob, error) {
+	row := q.db.QueryRow(ctx, createDeadJob,
+		arg.Kind,
+		arg.ConfigID,
+		arg.Payload,
+		arg.Error,
+		arg.Attempts,
+	)
+	var i DeadJob
 	err := row.Scan(
 		&i.ID,
-		&i.Email,
-		&i.PasswordHash,
+		&i.Kind,
+		&i.ConfigID,
+		&i.Payload,
+		&i.Error,
+		&i.Attempts,
 		&i.CreatedAt,
-		&i.UpdatedAt,
+		&i.RequeuedAt,
 	)
 	return i, err
 }
 
-const updateConfigWebhookID = `-- name: UpdateConfigWebhookID :one
-UPDATE repo_configs
-SET webhook_id = $2, updated_at = NOW()
-WHERE id = $1
-RETURNING id, owner_id, provider, repo_owner, repo_name, repo_full_name, repo_clone_url, dockerfile_path, webhook_secret, webhook_id, created_at, updated_at
+const createDeletedUser = `-- name: CreateDeletedUser :exec
+INSERT INTO users (
+  id, email, password_hash, role, created_at, disabled_at, deleted_at,
+  build_retention_days, log_retention_days
+) VALUES (
+  $1, 'deleted-' || $1::text || '@deleted.invalid', '', $2, $3, NOW(), NOW(),
+  $4, $5
+)
 `
 
-type UpdateConfigWebhookIDParams struct {
+type CreateDeletedUserParams struct {
+	ID                 string
+	Role               string
+	CreatedAt          pgtype.Timestamptz
+	BuildRetentionDays pgtype.Int4
+	LogRetentionDays   pgtype.Int4
+}
+
+// Deleted accounts keep a row so builds stay attributed for usage metering and expire
+// with the user's retention, but nothing identifies the person or lets anyone log in
+func (q *Queries) CreateDeletedUser(ctx context.Context, arg CreateDeletedUserParams) error {
+	_, err := q.db.Exec(ctx, createDeletedUser,
+		arg.ID,
+		arg.Role,
+		arg.CreatedAt,
+		arg.BuildRetentionDays,
+		arg.LogRetentionDays,
+	)
+	return err
+}
+
+const createDeployToken = `-- name: CreateDeployToken :one
+INSERT INTO deploy_tokens (
+  id, config_id, name, token_hash, created_by
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+RETURNING id, config_id, name, token_hash, created_by, last_used_at, created_at
+`
+
+type CreateDeployTokenParams struct {
 	ID        string
-	WebhookID pgtype.Int8
+	ConfigID  string
+	Name      string
+	TokenHash string
+	CreatedBy string
 }
 
-func (q *Queries) UpdateConfigWebhookID(ctx context.Context, arg UpdateConfigWebhookIDParams) (RepoConfig, error) {
-	row := q.db.QueryRow(ctx, updateConfigWebhookID, arg.ID, arg.WebhookID)
-	var i RepoConfig
+func (q *Queries) CreateDeployToken(ctx context.Context, arg CreateDeployTokenParams) (DeployToken, error) {
+	row := q.db.QueryRow(ctx, createDeployToken,
+		arg.ID,
+		arg.ConfigID,
+		arg.Name,
+		arg.TokenHash,
+		arg.CreatedBy,
+	)
+	var i DeployToken
 	err := row.Scan(
 		&i.ID,
-		&i.OwnerID,
-		&i.Provider,
-		&i.RepoOwner,
-		&i.RepoName,
-		&i.RepoFullName,
-		&i.RepoCloneUrl,
-		&i.DockerfilePath,
-		&i.WebhookSecret,
-		&i.WebhookID,
+		&i.ConfigID,
+		&i.Name,
+		&i.TokenHash,
+		&i.CreatedBy,
+		&i.LastUsedAt,
 		&i.CreatedAt,
-		&i.UpdatedAt,
 	)
 	return i, err
 }
 
-const updateCredential = `-- name: UpdateCredential :one
-UPDATE credentials
-SET 
-  ciphertext = $4,
+const createDeployment = `-- name: CreateDeployment :one
+INSERT INTO deployments (
+  config_id, ref, commit_sha, deployed_by, rollback_of
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+RETURNING id, config_id, ref, commit_sha, status, resources, error, created_at, updated_at, deployed_by, rollback_of
+`
+
+type CreateDeploymentParams struct {
+	ConfigID   string
+	Ref        string
+	CommitSha  string
+	DeployedBy string
+	RollbackOf pgtype.Int8
+}
+
+func (q *Queries) CreateDeployment(ctx context.Context, arg CreateDeploymentParams) (Deployment, error) {
+	row := q.db.QueryRow(ctx, createDeployment,
+		arg.ConfigID,
+		arg.Ref,
+		arg.CommitSha,
+		arg.DeployedBy,
+		arg.RollbackOf,
+	)
+	var i Deployment
+	err := row.Scan(
+		&i.ID,
+		&i.ConfigID,
+		&i.Ref,
+		&i.CommitSha,
+		&i.Status,
+		&i.Resources,
+		&i.Error,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeployedBy,
+		&i.RollbackOf,
+	)
+	return i, err
+}
+
+const createDeploymentManifest = `-- name: CreateDeploymentManifest :exec
+INSERT INTO deployment_manifests (
+  deployment_id, position, path, storage_key
+) VALUES (
+  $1, $2, $3, $4
+)
+`
+
+type CreateDeploymentManifestParams struct {
+	DeploymentID int64
+	Position     int32
+	Path         string
+	StorageKey   pgtype.Text
+}
+
+func (q *Queries) CreateDeploymentManifest(ctx context.Context, arg CreateDeploymentManifestParams) error {
+	_, err := q.db.Exec(ctx, createDeploymentManifest,
+		arg.DeploymentID,
+		arg.Position,
+		arg.Path,
+		arg.StorageKey,
+	)
+	return err
+}
+
+const createEmailChange = `-- name: CreateEmailChange :exec
+INSERT INTO email_changes (
+  token_hash, user_id, new_email, expires_at
+) VALUES (
+  $1, $2, $3, $4
+)
+`
+
+type CreateEmailChangeParams struct {
+	TokenHash string
+	UserID    string
+	NewEmail  string
+	ExpiresAt pgtype.Timestamptz
+}
+
+func (q *Queries) CreateEmailChange(ctx context.Context, arg CreateEmailChangeParams) error {
+	_, err := q.db.Exec(ctx, createEmailChange,
+		arg.TokenHash,
+		arg.UserID,
+		arg.NewEmail,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const createHook = `-- name: CreateHook :one
+INSERT INTO hooks (
+  id, owner_id, url, secret, events
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+RETURNING id, owner_id, url, secret, events, last_delivery_at, last_status_code, last_error, created_at
+`
+
+type CreateHookParams struct {
+	ID      string
+	OwnerID string
+	Url     string
+	Secret  string
+	Events  []string
+}
+
+func (q *Queries) CreateHook(ctx context.Context, arg CreateHookParams) (Hook, error) {
+	row := q.db.QueryRow(ctx, createHook,
+		arg.ID,
+		arg.OwnerID,
+		arg.Url,
+		arg.Secret,
+		arg.Events,
+	)
+	var i Hook
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Url,
+		&i.Secret,
+		&i.Events,
+		&i.LastDeliveryAt,
+		&i.LastStatusCode,
+		&i.LastError,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createOIDCUser = `-- name: CreateOIDCUser :one
+INSERT INTO users (
+  id, email, password_hash, oidc_issuer, oidc_subject
+) VALUES (
+  $1, $2, '', $3, $4
+)
+RETURNING id, email, password_hash, created_at, updated_at, role, disabled_at, oidc_issuer, oidc_subject, deleted_at, default_registry_credential_id, build_retention_days, log_retention_days, email_verified_at
+`
+
+type CreateOIDCUserParams struct {
+	ID          string
+	Email       string
+	OidcIssuer  pgtype.Text
+	OidcSubject pgtype.Text
+}
+
+// Users created by OIDC sign-in have no password until they reset one
+func (q *Queries) CreateOIDCUser(ctx context.Context, arg CreateOIDCUserParams) (User, error) {
+	row := q.db.QueryRow(ctx, createOIDCUser,
+		arg.ID,
+		arg.Email,
+		arg.OidcIssuer,
+		arg.OidcSubject,
+	)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.PasswordHash,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Role,
+		&i.DisabledAt,
+		&i.OidcIssuer,
+		&i.OidcSubject,
+		&i.DeletedAt,
+		&i.DefaultRegistryCredentialID,
+		&i.BuildRetentionDays,
+		&i.LogRetentionDays,
+		&i.EmailVerifiedAt,
+	)
+	return i, err
+}
+
+const createPasswordReset = `-- name: CreatePasswordReset :exec
+INSERT INTO password_resets (
+  token_hash, user_id, expires_at
+) VALUES (
+  $1, $2, $3
+)
+`
+
+type CreatePasswordResetParams struct {
+	TokenHash string
+	UserID    string
+	ExpiresAt pgtype.Timestamptz
+}
+
+func (q *Queries) CreatePasswordReset(ctx context.Context, arg CreatePasswordResetParams) error {
+	_, err := q.db.Exec(ctx, createPasswordReset, arg.TokenHash, arg.UserID, arg.ExpiresAt)
+	return err
+}
+
+const createPipeline = `-- name: CreatePipeline :one
+INSERT INTO pipelines (
+  config_id, ref, commit_sha
+) VALUES (
+  $1, $2, $3
+)
+RETURNING id, config_id, ref, commit_sha, created_at, status, finished_at, rendered_config
+`
+
+type CreatePipelineParams struct {
+	ConfigID  string
+	Ref       string
+	CommitSha string
+}
+
+func (q *Queries) CreatePipeline(ctx context.Context, arg CreatePipelineParams) (Pipeline, error) {
+	row := q.db.QueryRow(ctx, createPipeline, arg.ConfigID, arg.Ref, arg.CommitSha)
+	var i Pipeline
+	err := row.Scan(
+		&i.ID,
+		&i.ConfigID,
+		&i.Ref,
+		&i.CommitSha,
+		&i.CreatedAt,
+		&i.Status,
+		&i.FinishedAt,
+		&i.RenderedConfig,
+	)
+	return i, err
+}
+
+const createPipelineStage = `-- name: CreatePipelineStage :one
+INSERT INTO pipeline_stages (
+  pipeline_id, name, kind, needs
+) VALUES (
+  $1, $2, $3, $4
+)
+RETURNING id, pipeline_id, name, kind, needs, status, error, build_id, deployment_id, started_at, finished_at
+`
+
+type CreatePipelineStageParams struct {
+	PipelineID int64
+	Name       string
+	Kind       string
+	Needs      []string
+}
+
+func (q *Queries) CreatePipelineStage(ctx context.Context, arg CreatePipelineStageParams) (PipelineStage, error) {
+	row := q.db.QueryRow(ctx, createPipelineStage,
+		arg.PipelineID,
+		arg.Name,
+		arg.Kind,
+		arg.Needs,
+	)
+	var i PipelineStage
+	err := row.Scan(
+		&i.ID,
+		&i.PipelineID,
+		&i.Name,
+		&i.Kind,
+		&i.Needs,
+		&i.Status,
+		&i.Error,
+		&i.BuildID,
+		&i.DeploymentID,
+		&i.StartedAt,
+		&i.FinishedAt,
+	)
+	return i, err
+}
+
+const createRecoveryCode = `-- name: CreateRecoveryCode :exec
+INSERT INTO user_recovery_codes (
+  code_hash, user_id
+) VALUES (
+  $1, $2
+)
+`
+
+type CreateRecoveryCodeParams struct {
+	CodeHash string
+	UserID   string
+}
+
+func (q *Queries) CreateRecoveryCode(ctx context.Context, arg CreateRecoveryCodeParams) error {
+	_, err := q.db.Exec(ctx, createRecoveryCode, arg.CodeHash, arg.UserID)
+	return err
+}
+
+const createRetentionRun = `-- name: CreateRetentionRun :exec
+INSERT INTO retention_runs (
+  builds_deleted, logs_deleted, bytes_reclaimed, started_at
+) VALUES (
+  $1, $2, $3, $4
+)
+`
+
+type CreateRetentionRunParams struct {
+	BuildsDeleted  int32
+	LogsDeleted    int32
+	BytesReclaimed int64
+	StartedAt      pgtype.Timestamptz
+}
+
+func (q *Queries) CreateRetentionRun(ctx context.Context, arg CreateRetentionRunParams) error {
+	_, err := q.db.Exec(ctx, createRetentionRun,
+		arg.BuildsDeleted,
+		arg.LogsDeleted,
+		arg.BytesReclaimed,
+		arg.StartedAt,
+	)
+	return err
+}
+
+const createSession = `-- name: CreateSession :one
+INSERT INTO sessions (
+  id, user_id, user_agent, ip, expires_at
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+RETURNING id, user_id, user_agent, ip, created_at, last_used_at, expires_at
+`
+
+type CreateSessionParams struct {
+	ID        string
+	UserID    string
+	UserAgent string
+	Ip        string
+	ExpiresAt pgtype.Timestamptz
+}
+
+func (q *Queries) CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error) {
+	row := q.db.QueryRow(ctx, createSession,
+		arg.ID,
+		arg.UserID,
+		arg.UserAgent,
+		arg.Ip,
+		arg.ExpiresAt,
+	)
+	var i Session
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.UserAgent,
+		&i.Ip,
+		&i.CreatedAt,
+		&i.LastUsedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const createUser = `-- name: CreateUser :one
+INSERT INTO users (
+  id, email, password_hash
+) VALUES (
+  $1, $2, $3
+)
+RETURNING id, email, password_hash, created_at, updated_at, role, disabled_at, oidc_issuer, oidc_subject, deleted_at, default_registry_credential_id, build_retention_days, log_retention_days, email_verified_at
+`
+
+type CreateUserParams struct {
+	ID           string
+	Email        string
+	PasswordHash string
+}
+
+func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
+	row := q.db.QueryRow(ctx, createUser, arg.ID, arg.Email, arg.PasswordHash)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.PasswordHash,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Role,
+		&i.DisabledAt,
+		&i.OidcIssuer,
+		&i.OidcSubject,
+		&i.DeletedAt,
+		&i.DefaultRegistryCredentialID,
+		&i.BuildRetentionDays,
+		&i.LogRetentionDays,
+		&i.EmailVerifiedAt,
+	)
+	return i, err
+}
+
+const createWebhookDelivery = `-- name: CreateWebhookDelivery :exec
+INSERT INTO webhook_deliveries (
+  source, config_id, event_type, headers, payload, status_code, response
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7
+)
+`
+
+type CreateWebhookDeliveryParams struct {
+	Source     string
+	ConfigID   string
+	EventType  pgtype.Text
+	Headers    []byte
+	Payload    []byte
+	StatusCode int32
+	Response   pgtype.Text
+}
+
+func (q *Queries) CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) error {
+	_, err := q.db.Exec(ctx, createWebhookDelivery,
+		arg.Source,
+		arg.ConfigID,
+		arg.EventType,
+		arg.Headers,
+		arg.Payload,
+		arg.StatusCode,
+		arg.Response,
+	)
+	return err
+}
+
+const deleteBuild = `-- name: DeleteBuild :exec
+DELETE FROM builds
+WHERE id = $1
+`
+
+// Its log, artifacts, provenance and images are deleted with it
+func (q *Queries) DeleteBuild(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, deleteBuild, id)
+	return err
+}
+
+const deleteBuildLog = `-- name: DeleteBuildLog :exec
+WITH deleted AS (
+  DELETE FROM build_logs
+  WHERE build_logs.build_id = $1
+  RETURNING build_logs.build_id
+)
+UPDATE builds
+SET log_bytes = 0
+FROM deleted
+WHERE builds.id = deleted.build_id
+`
+
+// The log no longer counts towards the owner's storage
+func (q *Queries) DeleteBuildLog(ctx context.Context, buildID int64) error {
+	_, err := q.db.Exec(ctx, deleteBuildLog, buildID)
+	return err
+}
+
+const deleteConfig = `-- name: DeleteConfig :execrows
+UPDATE repo_configs
+SET deleted_at = NOW(), version = version + 1, updated_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL
+  AND ($2::integer IS NULL OR version = $2)
+`
+
+type DeleteConfigParams struct {
+	ID              string
+	ExpectedVersion pgtype.Int4
+}
+
+// Moves the config to the trash, keeping its deployments until it is purged
+func (q *Queries) DeleteConfig(ctx context.Context, arg DeleteConfigParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteConfig, arg.ID, arg.ExpectedVersion)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteConfigEnv = `-- name: DeleteConfigEnv :execrows
+DELETE FROM config_env
+WHERE config_id = $1 AND name = $2
+`
+
+type DeleteConfigEnvParams struct {
+	ConfigID string
+	Name     string
+}
+
+func (q *Queries) DeleteConfigEnv(ctx context.Context, arg DeleteConfigEnvParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteConfigEnv, arg.ConfigID, arg.Name)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteConfigTransferByConfigID = `-- name: DeleteConfigTransferByConfigID :execrows
+DELETE FROM config_transfers
+WHERE config_id = $1
+`
+
+func (q *Queries) DeleteConfigTransferByConfigID(ctx context.Context, configID string) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteConfigTransferByConfigID, configID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteConfigTransferForRecipient = `-- name: DeleteConfigTransferForRecipient :execrows
+DELETE FROM config_transfers
+WHERE id = $1 AND to_user_id = $2
+`
+
+type DeleteConfigTransferForRecipientParams struct {
+	ID       string
+	ToUserID string
+}
+
+func (q *Queries) DeleteConfigTransferForRecipient(ctx context.Context, arg DeleteConfigTransferForRecipientParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteConfigTransferForRecipient, arg.ID, arg.ToUserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteCredential = `-- name: DeleteCredential :execrows
+UPDATE credentials
+SET deleted_at = NOW(), version = version + 1
+WHERE id = $1 AND owner_id = $2 AND deleted_at IS NULL
+  AND ($3::integer IS NULL OR version = $3)
+`
+
+type DeleteCredentialParams struct {
+	ID              int64
+	OwnerID         string
+	ExpectedVersion pgtype.Int4
+}
+
+// Moves the credential to the trash until it is purged
+func (q *Queries) DeleteCredential(ctx context.Context, arg DeleteCredentialParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteCredential, arg.ID, arg.OwnerID, arg.ExpectedVersion)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteDeployPolicy = `-- name: DeleteDeployPolicy :execrows
+DELETE FROM deploy_policies
+WHERE owner_id = $1 AND name = $2
+`
+
+type DeleteDeployPolicyParams struct {
+	OwnerID string
+	Name    string
+}
+
+func (q *Queries) DeleteDeployPolicy(ctx context.Context, arg DeleteDeployPolicyParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteDeployPolicy, arg.OwnerID, arg.Name)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteDeployToken = `-- name: DeleteDeployToken :execrows
+DELETE FROM deploy_tokens
+WHERE id = $1 AND config_id = $2
+`
+
+type DeleteDeployTokenParams struct {
+	ID       string
+	ConfigID string
+}
+
+func (q *Queries) DeleteDeployToken(ctx context.Context, arg DeleteDeployTokenParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteDeployToken, arg.ID, arg.ConfigID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteDeployment = `-- name: DeleteDeployment :exec
+DELETE FROM deployments
+WHERE id = $1
+`
+
+func (q *Queries) DeleteDeployment(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, deleteDeployment, id)
+	return err
+}
+
+const deleteDeploymentEnvironment = `-- name: DeleteDeploymentEnvironment :exec
+DELETE FROM deployment_environments
+WHERE config_id = $1 AND namespace = $2
+`
+
+type DeleteDeploymentEnvironmentParams struct {
+	ConfigID  string
+	Namespace string
+}
+
+func (q *Queries) DeleteDeploymentEnvironment(ctx context.Context, arg DeleteDeploymentEnvironmentParams) error {
+	_, err := q.db.Exec(ctx, deleteDeploymentEnvironment, arg.ConfigID, arg.Namespace)
+	return err
+}
+
+const deleteExpiredSessions = `-- name: DeleteExpiredSessions :exec
+DELETE FROM sessions
+WHERE user_id = $1 AND expires_at <= NOW()
+`
+
+func (q *Queries) DeleteExpiredSessions(ctx context.Context, userID string) error {
+	_, err := q.db.Exec(ctx, deleteExpiredSessions, userID)
+	return err
+}
+
+const deleteGitHubApp = `-- name: DeleteGitHubApp :execrows
+DELETE FROM github_app
+`
+
+func (q *Queries) DeleteGitHubApp(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteGitHubApp)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteHook = `-- name: DeleteHook :execrows
+DELETE FROM hooks
+WHERE id = $1 AND owner_id = $2
+`
+
+type DeleteHookParams struct {
+	ID      string
+	OwnerID string
+}
+
+func (q *Queries) DeleteHook(ctx context.Context, arg DeleteHookParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteHook, arg.ID, arg.OwnerID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteInstallationRepositories = `-- name: DeleteInstallationRepositories :execrows
+DELETE FROM installation_repositories
+WHERE installation_id = $1
+`
+
+func (q *Queries) DeleteInstallationRepositories(ctx context.Context, installationID int64) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteInstallationRepositories, installationID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteInstallationRepositoriesExcept = `-- name: DeleteInstallationRepositoriesExcept :execrows
+DELETE FROM installation_repositories
+WHERE installation_id = $1 AND repo_full_name <> ALL($2::text[])
+`
+
+type DeleteInstallationRepositoriesExceptParams struct {
+	InstallationID int64
+	RepoFullNames  []string
+}
+
+func (q *Queries) DeleteInstallationRepositoriesExcept(ctx context.Context, arg DeleteInstallationRepositoriesExceptParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteInstallationRepositoriesExcept, arg.InstallationID, arg.RepoFullNames)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteOtherSessions = `-- name: DeleteOtherSessions :exec
+DELETE FROM sessions
+WHERE user_id = $1 AND id <> $2
+`
+
+type DeleteOtherSessionsParams struct {
+	UserID string
+	ID     string
+}
+
+func (q *Queries) DeleteOtherSessions(ctx context.Context, arg DeleteOtherSessionsParams) error {
+	_, err := q.db.Exec(ctx, deleteOtherSessions, arg.UserID, arg.ID)
+	return err
+}
+
+const deleteQueuedJob = `-- name: DeleteQueuedJob :exec
+DELETE FROM queued_jobs
+WHERE id = $1
+`
+
+func (q *Queries) DeleteQueuedJob(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, deleteQueuedJob, id)
+	return err
+}
+
+const deleteRecoveryCodes = `-- name: DeleteRecoveryCodes :exec
+DELETE FROM user_recovery_codes
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteRecoveryCodes(ctx context.Context, userID string) error {
+	_, err := q.db.Exec(ctx, deleteRecoveryCodes, userID)
+	return err
+}
+
+const deleteSession = `-- name: DeleteSession :execrows
+DELETE FROM sessions
+WHERE id = $1 AND user_id = $2
+`
+
+type DeleteSessionParams struct {
+	ID     string
+	UserID string
+}
+
+func (q *Queries) DeleteSession(ctx context.Context, arg DeleteSessionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteSession, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteSessionsByUserID = `-- name: DeleteSessionsByUserID :exec
+DELETE FROM sessions
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteSessionsByUserID(ctx context.Context, userID string) error {
+	_, err := q.db.Exec(ctx, deleteSessionsByUserID, userID)
+	return err
+}
+
+const deleteUser = `-- name: DeleteUser :execrows
+DELETE FROM users
+WHERE id = $1
+`
+
+// Cascades to everything the user owns. Builds are only checked at commit, see
+// CreateDeletedUser
+func (q *Queries) DeleteUser(ctx context.Context, id string) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteUser, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteUserTOTP = `-- name: DeleteUserTOTP :exec
+DELETE FROM user_totp
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteUserTOTP(ctx context.Context, userID string) error {
+	_, err := q.db.Exec(ctx, deleteUserTOTP, userID)
+	return err
+}
+
+const enableUserTOTP = `-- name: EnableUserTOTP :exec
+UPDATE user_totp
+SET enabled_at = NOW()
+WHERE user_id = $1
+`
+
+func (q *Queries) EnableUserTOTP(ctx context.Context, userID string) error {
+	_, err := q.db.Exec(ctx, enableUserTOTP, userID)
+	return err
+}
+
+const enqueueJob = `-- name: EnqueueJob :exec
+INSERT INTO queued_jobs (
+  kind, config_id, payload, priority
+) VALUES (
+  $1, $2, $3, $4
+)
+`
+
+type EnqueueJobParams struct {
+	Kind     string
+	ConfigID string
+	Payload  []byte
+	Priority int32
+}
+
+func (q *Queries) EnqueueJob(ctx context.Context, arg EnqueueJobParams) error {
+	_, err := q.db.Exec(ctx, enqueueJob,
+		arg.Kind,
+		arg.ConfigID,
+		arg.Payload,
+		arg.Priority,
+	)
+	return err
+}
+
+const failUnreportedAgentDeployments = `-- name: FailUnreportedAgentDeployments :exec
+UPDATE agent_deployments
+SET status = 'failed', error = $1, claimed_until = NULL, updated_at = NOW()
+WHERE agent_id = $2 AND status = 'delivered' AND claimed_until < NOW()
+  AND deliveries >= $3::integer
+`
+
+type FailUnreportedAgentDeploymentsParams struct {
+	Error         pgtype.Text
+	AgentID       string
+	MaxDeliveries int32
+}
+
+// Gives up on deployments delivered max_deliveries times without an outcome
+func (q *Queries) FailUnreportedAgentDeployments(ctx context.Context, arg FailUnreportedAgentDeploymentsParams) error {
+	_, err := q.db.Exec(ctx, failUnreportedAgentDeployments, arg.Error, arg.AgentID, arg.MaxDeliveries)
+	return err
+}
+
+const finishBuild = `-- name: FinishBuild :one
+UPDATE builds
+SET status = $2, error = $3, finished_at = NOW()
+WHERE id = $1
+RETURNING id, config_id, owner_id, name, ref, commit_sha, status, error, log_bytes, artifact_bytes, started_at, finished_at, pipeline_id, timings, config_hash
+`
+
+type FinishBuildParams struct {
+	ID     int64
+	Status string
+	Error  pgtype.Text
+}
+
+func (q *Queries) FinishBuild(ctx context.Context, arg FinishBuildParams) (Build, error) {
+	row := q.db.QueryRow(ctx, finishBuild, arg.ID, arg.Status, arg.Error)
+	var i Build
+	err := row.Scan(
+		&i.ID,
+		&i.ConfigID,
+		&i.OwnerID,
+		&i.Name,
+		&i.Ref,
+		&i.CommitSha,
+		&i.Status,
+		&i.Error,
+		&i.LogBytes,
+		&i.ArtifactBytes,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.PipelineID,
+		&i.Timings,
+		&i.ConfigHash,
+	)
+	return i, err
+}
+
+const finishPipeline = `-- name: FinishPipeline :exec
+UPDATE pipelines
+SET status = $2, finished_at = NOW()
+WHERE id = $1
+`
+
+type FinishPipelineParams struct {
+	ID     int64
+	Status string
+}
+
+func (q *Queries) FinishPipeline(ctx context.Context, arg FinishPipelineParams) error {
+	_, err := q.db.Exec(ctx, finishPipeline, arg.ID, arg.Status)
+	return err
+}
+
+const finishPipelineStage = `-- name: FinishPipelineStage :exec
+UPDATE pipeline_stages
+SET status = $2, error = $3, finished_at = NOW()
+WHERE id = $1
+`
+
+type FinishPipelineStageParams struct {
+	ID     int64
+	Status string
+	Error  pgtype.Text
+}
+
+func (q *Queries) FinishPipelineStage(ctx context.Context, arg FinishPipelineStageParams) error {
+	_, err := q.db.Exec(ctx, finishPipelineStage, arg.ID, arg.Status, arg.Error)
+	return err
+}
+
+const linkUserOIDCIdentity = `-- name: LinkUserOIDCIdentity :one
+UPDATE users
+SET oidc_issuer = $2, oidc_subject = $3, updated_at = NOW()
+WHERE id = $1 AND oidc_subject IS NULL
+RETURNING id, email, password_hash, created_at, updated_at, role, disabled_at, oidc_issuer, oidc_subject, deleted_at, default_registry_credential_id, build_retention_days, log_retention_days, email_verified_at
+`
+
+type LinkUserOIDCIdentityParams struct {
+	ID          string
+	OidcIssuer  pgtype.Text
+	OidcSubject pgtype.Text
+}
+
+func (q *Queries) LinkUserOIDCIdentity(ctx context.Context, arg LinkUserOIDCIdentityParams) (User, error) {
+	row := q.db.QueryRow(ctx, linkUserOIDCIdentity, arg.ID, arg.OidcIssuer, arg.OidcSubject)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.PasswordHash,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Role,
+		&i.DisabledAt,
+		&i.OidcIssuer,
+		&i.OidcSubject,
+		&i.DeletedAt,
+		&i.DefaultRegistryCredentialID,
+		&i.BuildRetentionDays,
+		&i.LogRetentionDays,
+		&i.EmailVerifiedAt,
+	)
+	return i, err
+}
+
+const lockUserTOTP = `-- name: LockUserTOTP :exec
+UPDATE user_totp
+SET locked_until = $2
+WHERE user_id = $1
+`
+
+type LockUserTOTPParams struct {
+	UserID      string
+	LockedUntil pgtype.Timestamptz
+}
+
+func (q *Queries) LockUserTOTP(ctx context.Context, arg LockUserTOTPParams) error {
+	_, err := q.db.Exec(ctx, lockUserTOTP, arg.UserID, arg.LockedUntil)
+	return err
+}
+
+const markCredentialExpiryNotified = `-- name: MarkCredentialExpiryNotified :exec
+UPDATE credentials
+SET expiry_notified_at = NOW()
+WHERE id = $1
+`
+
+func (q *Queries) MarkCredentialExpiryNotified(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, markCredentialExpiryNotified, id)
+	return err
+}
+
+const markDeadJobRequeued = `-- name: MarkDeadJobRequeued :execrows
+UPDATE dead_jobs
+SET requeued_at = NOW()
+WHERE id = $1 AND requeued_at IS NULL
+`
+
+// Matches no row when the job was requeued already
+func (q *Queries) MarkDeadJobRequeued(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.Exec(ctx, markDeadJobRequeued, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const markUserEmailVerified = `-- name: MarkUserEmailVerified :one
+UPDATE users
+SET email_verified_at = COALESCE(email_verified_at, NOW()), updated_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING id, email, password_hash, created_at, updated_at, role, disabled_at, oidc_issuer, oidc_subject, deleted_at, default_registry_credential_id, build_retention_days, log_retention_days, email_verified_at
+`
+
+func (q *Queries) MarkUserEmailVerified(ctx context.Context, id string) (User, error) {
+	row := q.db.QueryRow(ctx, markUserEmailVerified, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.PasswordHash,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Role,
+		&i.DisabledAt,
+		&i.OidcIssuer,
+		&i.OidcSubject,
+		&i.DeletedAt,
+		&i.DefaultRegistryCredentialID,
+		&i.BuildRetentionDays,
+		&i.LogRetentionDays,
+		&i.EmailVerifiedAt,
+	)
+	return i, err
+}
+
+const purgeDeletedConfigs = `-- name: PurgeDeletedConfigs :execrows
+DELETE FROM repo_configs
+WHERE deleted_at < $1
+`
+
+// Deployments and transfers are deleted with the config; builds keep their usage
+func (q *Queries) PurgeDeletedConfigs(ctx context.Context, deletedAt pgtype.Timestamptz) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeDeletedConfigs, deletedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const purgeDeletedCredentials = `-- name: PurgeDeletedCredentials :execrows
+DELETE FROM credentials
+WHERE deleted_at < $1
+`
+
+// Configs deploying with a purged credential cannot deploy until one is connected
+func (q *Queries) PurgeDeletedCredentials(ctx context.Context, deletedAt pgtype.Timestamptz) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeDeletedCredentials, deletedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const removeGitHubInstallation = `-- name: RemoveGitHubInstallation :execrows
+UPDATE github_installations
+SET removed_at = NOW()
+WHERE id = $1 AND removed_at IS NULL
+`
+
+func (q *Queries) RemoveGitHubInstallation(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.Exec(ctx, removeGitHubInstallation, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const removeGitHubInstallationsExcept = `-- name: RemoveGitHubInstallationsExcept :execrows
+UPDATE github_installations
+SET removed_at = NOW()
+WHERE removed_at IS NULL AND id <> ALL($1::bigint[])
+`
+
+// Installations the app no longer lists were uninstalled while their events were missed
+func (q *Queries) RemoveGitHubInstallationsExcept(ctx context.Context, ids []int64) (int64, error) {
+	result, err := q.db.Exec(ctx, removeGitHubInstallationsExcept, ids)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const removeInstallationRepositories = `-- name: RemoveInstallationRepositories :execrows
+DELETE FROM installation_repositories
+WHERE installation_id = $1 AND repo_full_name = ANY($2::text[])
+`
+
+type RemoveInstallationRepositoriesParams struct {
+	InstallationID int64
+	RepoFullNames  []string
+}
+
+func (q *Queries) RemoveInstallationRepositories(ctx context.Context, arg RemoveInstallationRepositoriesParams) (int64, error) {
+	result, err := q.db.Exec(ctx, removeInstallationRepositories, arg.InstallationID, arg.RepoFullNames)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const renewJobClaim = `-- name: RenewJobClaim :execrows
+UPDATE queued_jobs
+SET claimed_until = NOW() + make_interval(secs => $2::integer)
+WHERE id = $1
+`
+
+type RenewJobClaimParams struct {
+	ID           int64
+	LeaseSeconds int32
+}
+
+// Matches no row once the job was removed from the queue
+func (q *Queries) RenewJobClaim(ctx context.Context, arg RenewJobClaimParams) (int64, error) {
+	result, err := q.db.Exec(ctx, renewJobClaim, arg.ID, arg.LeaseSeconds)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const resetUserTOTPAttempts = `-- name: ResetUserTOTPAttempts :exec
+UPDATE user_totp
+SET failed_attempts = 0
+WHERE user_id = $1
+`
+
+func (q *Queries) ResetUserTOTPAttempts(ctx context.Context, userID string) error {
+	_, err := q.db.Exec(ctx, resetUserTOTPAttempts, userID)
+	return err
+}
+
+const restoreConfig = `-- name: RestoreConfig :one
+UPDATE repo_configs
+SET deleted_at = NULL, version = version + 1, updated_at = NOW()
+WHERE id = $1 AND owner_id = $2 AND deleted_at IS NOT NULL
+RETURNING id, owner_id, provider, repo_owner, repo_name, repo_full_name, repo_clone_url, dockerfile_path, webhook_secret, webhook_id, created_at, updated_at, cluster_credential_id, agent_id, retention_keep_last, retention_delete_previews, registry_webhook_token, external_id, version, health, health_detail, health_checked_at, denied_authors, deleted_at, secret_scan, secret_scan_allowlist
+`
+
+type RestoreConfigParams struct {
+	ID      string
+	OwnerID string
+}
+
+func (q *Queries) RestoreConfig(ctx context.Context, arg RestoreConfigParams) (RepoConfig, error) {
+	row := q.db.QueryRow(ctx, restoreConfig, arg.ID, arg.OwnerID)
+	var i RepoConfig
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Provider,
+		&i.RepoOwner,
+		&i.RepoName,
+		&i.RepoFullName,
+		&i.RepoCloneUrl,
+		&i.DockerfilePath,
+		&i.WebhookSecret,
+		&i.WebhookID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ClusterCredentialID,
+		&i.AgentID,
+		&i.RetentionKeepLast,
+		&i.RetentionDeletePreviews,
+		&i.RegistryWebhookToken,
+		&i.ExternalID,
+		&i.Version,
+		&i.Health,
+		&i.HealthDetail,
+		&i.HealthCheckedAt,
+		&i.DeniedAuthors,
+		&i.DeletedAt,
+		&i.SecretScan,
+		&i.SecretScanAllowlist,
+	)
+	return i, err
+}
+
+const restoreCredential = `-- name: RestoreCredential :one
+UPDATE credentials
+SET deleted_at = NULL, version = version + 1
+WHERE id = $1 AND owner_id = $2 AND deleted_at IS NOT NULL
+RETURNING id, owner_id, provider, token_type, ciphertext, token_nonce, wrapped_dek, dek_nonce, created_at, last_used_at, expires_at, expiry_notified_at, external_id, version, deleted_at
+`
+
+type RestoreCredentialParams struct {
+	ID      int64
+	OwnerID string
+}
+
+func (q *Queries) RestoreCredential(ctx context.Context, arg RestoreCredentialParams) (Credential, error) {
+	row := q.db.QueryRow(ctx, restoreCredential, arg.ID, arg.OwnerID)
+	var i Credential
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Provider,
+		&i.TokenType,
+		&i.Ciphertext,
+		&i.TokenNonce,
+		&i.WrappedDek,
+		&i.DekNonce,
+		&i.CreatedAt,
+		&i.LastUsedAt,
+		&i.ExpiresAt,
+		&i.ExpiryNotifiedAt,
+		&i.ExternalID,
+		&i.Version,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const retryQueuedJob = `-- name: RetryQueuedJob :exec
+UPDATE queued_jobs
+SET claimed_until = NULL, run_after = NOW() + make_interval(secs => $2::integer)
+WHERE id = $1
+`
+
+type RetryQueuedJobParams struct {
+	ID           int64
+	DelaySeconds int32
+}
+
+func (q *Queries) RetryQueuedJob(ctx context.Context, arg RetryQueuedJobParams) error {
+	_, err := q.db.Exec(ctx, retryQueuedJob, arg.ID, arg.DelaySeconds)
+	return err
+}
+
+const saveCommitConfig = `-- name: SaveCommitConfig :exec
+INSERT INTO commit_configs (
+  config_id, commit_sha, content
+) VALUES (
+  $1, $2, $3
+)
+ON CONFLICT (config_id, commit_sha) DO NOTHING
+`
+
+type SaveCommitConfigParams struct {
+	ConfigID  string
+	CommitSha string
+	Content   string
+}
+
+func (q *Queries) SaveCommitConfig(ctx context.Context, arg SaveCommitConfigParams) error {
+	_, err := q.db.Exec(ctx, saveCommitConfig, arg.ConfigID, arg.CommitSha, arg.Content)
+	return err
+}
+
+const setBuildImagesBranchDeleted = `-- name: SetBuildImagesBranchDeleted :exec
+UPDATE build_images
+SET branch_deleted_at = NOW()
+FROM builds
+WHERE builds.id = build_images.build_id
+  AND builds.config_id = $1
+  AND builds.ref = $2
+  AND build_images.branch_deleted_at IS NULL
+`
+
+type SetBuildImagesBranchDeletedParams struct {
+	ConfigID pgtype.Text
+	Ref      string
+}
+
+func (q *Queries) SetBuildImagesBranchDeleted(ctx context.Context, arg SetBuildImagesBranchDeletedParams) error {
+	_, err := q.db.Exec(ctx, setBuildImagesBranchDeleted, arg.ConfigID, arg.Ref)
+	return err
+}
+
+const setBuildImagesDeleted = `-- name: SetBuildImagesDeleted :exec
+UPDATE build_images
+SET deleted_at = NOW()
+WHERE id = ANY($1::bigint[])
+`
+
+func (q *Queries) SetBuildImagesDeleted(ctx context.Context, ids []int64) error {
+	_, err := q.db.Exec(ctx, setBuildImagesDeleted, ids)
+	return err
+}
+
+const setConfigEnv = `-- name: SetConfigEnv :exec
+INSERT INTO config_env (
+  config_id, name, value
+) VALUES (
+  $1, $2, $3
+)
+ON CONFLICT (config_id, name) DO UPDATE
+SET value = EXCLUDED.value, updated_at = NOW()
+`
+
+type SetConfigEnvParams struct {
+	ConfigID string
+	Name     string
+	Value    string
+}
+
+func (q *Queries) SetConfigEnv(ctx context.Context, arg SetConfigEnvParams) error {
+	_, err := q.db.Exec(ctx, setConfigEnv, arg.ConfigID, arg.Name, arg.Value)
+	return err
+}
+
+const setDefaultRegistryCredential = `-- name: SetDefaultRegistryCredential :exec
+UPDATE users
+SET default_registry_credential_id = $2
+WHERE id = $1
+`
+
+type SetDefaultRegistryCredentialParams struct {
+	ID                          string
+	DefaultRegistryCredentialID pgtype.Int8
+}
+
+func (q *Queries) SetDefaultRegistryCredential(ctx context.Context, arg SetDefaultRegistryCredentialParams) error {
+	_, err := q.db.Exec(ctx, setDefaultRegistryCredential, arg.ID, arg.DefaultRegistryCredentialID)
+	return err
+}
+
+const setGitHubInstallationSuspended = `-- name: SetGitHubInstallationSuspended :execrows
+UPDATE github_installations
+SET suspended_at = CASE WHEN $1::boolean THEN COALESCE(suspended_at, NOW()) END
+WHERE id = $2
+`
+
+type SetGitHubInstallationSuspendedParams struct {
+	Suspended bool
+	ID        int64
+}
+
+func (q *Queries) SetGitHubInstallationSuspended(ctx context.Context, arg SetGitHubInstallationSuspendedParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setGitHubInstallationSuspended, arg.Suspended, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setLiveDeployment = `-- name: SetLiveDeployment :exec
+INSERT INTO deployment_environments (
+  config_id, namespace, branch, deployment_id, builds
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+ON CONFLICT (config_id, namespace) DO UPDATE
+SET branch = EXCLUDED.branch,
+    deployment_id = EXCLUDED.deployment_id,
+    builds = EXCLUDED.builds,
+    updated_at = NOW()
+`
+
+type SetLiveDeploymentParams struct {
+	ConfigID     string
+	Namespace    string
+	Branch       string
+	DeploymentID int64
+	Builds       []byte
+}
+
+func (q *Queries) SetLiveDeployment(ctx context.Context, arg SetLiveDeploymentParams) error {
+	_, err := q.db.Exec(ctx, setLiveDeployment,
+		arg.ConfigID,
+		arg.Namespace,
+		arg.Branch,
+		arg.DeploymentID,
+		arg.Builds,
+	)
+	return err
+}
+
+const setPipelineRenderedConfig = `-- name: SetPipelineRenderedConfig :exec
+UPDATE pipelines
+SET rendered_config = $2
+WHERE id = $1
+`
+
+type SetPipelineRenderedConfigParams struct {
+	ID             int64
+	RenderedConfig string
+}
+
+func (q *Queries) SetPipelineRenderedConfig(ctx context.Context, arg SetPipelineRenderedConfigParams) error {
+	_, err := q.db.Exec(ctx, setPipelineRenderedConfig, arg.ID, arg.RenderedConfig)
+	return err
+}
+
+const setPipelineStageBuild = `-- name: SetPipelineStageBuild :exec
+UPDATE pipeline_stages
+SET build_id = $2
+WHERE id = $1
+`
+
+type SetPipelineStageBuildParams struct {
+	ID      int64
+	BuildID pgtype.Int8
+}
+
+func (q *Queries) SetPipelineStageBuild(ctx context.Context, arg SetPipelineStageBuildParams) error {
+	_, err := q.db.Exec(ctx, setPipelineStageBuild, arg.ID, arg.BuildID)
+	return err
+}
+
+const setPipelineStageDeployment = `-- name: SetPipelineStageDeployment :exec
+UPDATE pipeline_stages
+SET deployment_id = $2
+WHERE id = $1
+`
+
+type SetPipelineStageDeploymentParams struct {
+	ID           int64
+	DeploymentID pgtype.Int8
+}
+
+func (q *Queries) SetPipelineStageDeployment(ctx context.Context, arg SetPipelineStageDeploymentParams) error {
+	_, err := q.db.Exec(ctx, setPipelineStageDeployment, arg.ID, arg.DeploymentID)
+	return err
+}
+
+const setUserRetention = `-- name: SetUserRetention :one
+UPDATE users
+SET build_retention_days = $2, log_retention_days = $3
+WHERE id = $1
+RETURNING id, email, password_hash, created_at, updated_at, role, disabled_at, oidc_issuer, oidc_subject, deleted_at, default_registry_credential_id, build_retention_days, log_retention_days, email_verified_at
+`
+
+type SetUserRetentionParams struct {
+	ID                 string
+	BuildRetentionDays pgtype.Int4
+	LogRetentionDays   pgtype.Int4
+}
+
+func (q *Queries) SetUserRetention(ctx context.Context, arg SetUserRetentionParams) (User, error) {
+	row := q.db.QueryRow(ctx, setUserRetention, arg.ID, arg.BuildRetentionDays, arg.LogRetentionDays)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.PasswordHash,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Role,
+		&i.DisabledAt,
+		&i.OidcIssuer,
+		&i.OidcSubject,
+		&i.DeletedAt,
+		&i.DefaultRegistryCredentialID,
+		&i.BuildRetentionDays,
+		&i.LogRetentionDays,
+		&i.EmailVerifiedAt,
+	)
+	return i, err
+}
+
+const skipPendingPipelineStages = `-- name: SkipPendingPipelineStages :exec
+UPDATE pipeline_stages
+SET status = 'skipped'
+WHERE pipeline_id = $1 AND status = 'pending'
+`
+
+func (q *Queries) SkipPendingPipelineStages(ctx context.Context, pipelineID int64) error {
+	_, err := q.db.Exec(ctx, skipPendingPipelineStages, pipelineID)
+	return err
+}
+
+const startPipelineStage = `-- name: StartPipelineStage :exec
+UPDATE pipeline_stages
+SET status = 'running', started_at = NOW()
+WHERE id = $1
+`
+
+func (q *Queries) StartPipelineStage(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, startPipelineStage, id)
+	return err
+}
+
+const startUserTOTPAttempt = `-- name: StartUserTOTPAttempt :one
+UPDATE user_totp
+SET failed_attempts = CASE WHEN locked_until IS NULL THEN failed_attempts + 1 ELSE 1 END,
+    locked_until = NULL
+WHERE user_id = $1 AND (locked_until IS NULL OR locked_until <= NOW())
+RETURNING failed_attempts
+`
+
+// Counts a code attempt before the code is checked, so concurrent guesses count too.
+// Returns no row while the enrollment is locked; a lock that ran out starts a new count.
+func (q *Queries) StartUserTOTPAttempt(ctx context.Context, userID string) (int32, error) {
+	row := q.db.QueryRow(ctx, startUserTOTPAttempt, userID)
+	var failed_attempts int32
+	err := row.Scan(&failed_attempts)
+	return failed_attempts, err
+}
+
+const touchCredential = `-- name: TouchCredential :exec
+UPDATE credentials
+SET last_used_at = NOW()
+WHERE id = $1
+`
+
+func (q *Queries) TouchCredential(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, touchCredential, id)
+	return err
+}
+
+const touchSession = `-- name: TouchSession :exec
+UPDATE sessions
+SET last_used_at = NOW()
+WHERE id = $1
+`
+
+func (q *Queries) TouchSession(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, touchSession, id)
+	return err
+}
+
+const updateAgentDeploymentStatus = `-- name: UpdateAgentDeploymentStatus :one
+UPDATE agent_deployments
+SET status = $3, error = $4, claimed_until = NULL, updated_at = NOW()
+WHERE id = $1 AND agent_id = $2
+RETURNING id, agent_id, config_id, manifests, status, error, created_at, updated_at, storage_key, claimed_until, deliveries
+`
+
+type UpdateAgentDeploymentStatusParams struct {
+	ID      int64
+	AgentID string
+	Status  string
+	Error   pgtype.Text
+}
+
+func (q *Queries) UpdateAgentDeploymentStatus(ctx context.Context, arg UpdateAgentDeploymentStatusParams) (AgentDeployment, error) {
+	row := q.db.QueryRow(ctx, updateAgentDeploymentStatus,
+		arg.ID,
+		arg.AgentID,
+		arg.Status,
+		arg.Error,
+	)
+	var i AgentDeployment
+	err := row.Scan(
+		&i.ID,
+		&i.AgentID,
+		&i.ConfigID,
+		&i.Manifests,
+		&i.Status,
+		&i.Error,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.StorageKey,
+		&i.ClaimedUntil,
+		&i.Deliveries,
+	)
+	return i, err
+}
+
+const updateAgentLastSeen = `-- name: UpdateAgentLastSeen :exec
+UPDATE agents
+SET last_seen_at = NOW()
+WHERE id = $1
+`
+
+func (q *Queries) UpdateAgentLastSeen(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, updateAgentLastSeen, id)
+	return err
+}
+
+const updateConfig = `-- name: UpdateConfig :one
+UPDATE repo_configs
+SET
+  repo_clone_url = COALESCE($1, repo_clone_url),
+  dockerfile_path = COALESCE($2, dockerfile_path),
+  webhook_secret = COALESCE($3, webhook_secret),
+  version = version + 1,
+  updated_at = NOW()
+WHERE id = $4
+  AND deleted_at IS NULL
+  AND ($5::integer IS NULL OR version = $5)
+RETURNING id, owner_id, provider, repo_owner, repo_name, repo_full_name, repo_clone_url, dockerfile_path, webhook_secret, webhook_id, created_at, updated_at, cluster_credential_id, agent_id, retention_keep_last, retention_delete_previews, registry_webhook_token, external_id, version, health, health_detail, health_checked_at, denied_authors, deleted_at, secret_scan, secret_scan_allowlist
+`
+
+type UpdateConfigParams struct {
+	RepoCloneUrl    pgtype.Text
+	DockerfilePath  pgtype.Text
+	WebhookSecret   pgtype.Text
+	ID              string
+	ExpectedVersion pgtype.Int4
+}
+
+// Unset fields keep their value. Matches no row when expected_version is set and stale.
+func (q *Queries) UpdateConfig(ctx context.Context, arg UpdateConfigParams) (RepoConfig, error) {
+	row := q.db.QueryRow(ctx, updateConfig,
+		arg.RepoCloneUrl,
+		arg.DockerfilePath,
+		arg.WebhookSecret,
+		arg.ID,
+		arg.ExpectedVersion,
+	)
+	var i RepoConfig
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Provider,
+		&i.RepoOwner,
+		&i.RepoName,
+		&i.RepoFullName,
+		&i.RepoCloneUrl,
+		&i.DockerfilePath,
+		&i.WebhookSecret,
+		&i.WebhookID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ClusterCredentialID,
+		&i.AgentID,
+		&i.RetentionKeepLast,
+		&i.RetentionDeletePreviews,
+		&i.RegistryWebhookToken,
+		&i.ExternalID,
+		&i.Version,
+		&i.Health,
+		&i.HealthDetail,
+		&i.HealthCheckedAt,
+		&i.DeniedAuthors,
+		&i.DeletedAt,
+		&i.SecretScan,
+		&i.SecretScanAllowlist,
+	)
+	return i, err
+}
+
+const updateConfigAgentID = `-- name: UpdateConfigAgentID :one
+UPDATE repo_configs
+SET agent_id = $2, version = version + 1, updated_at = NOW()
+WHERE id = $1
+RETURNING id, owner_id, provider, repo_owner, repo_name, repo_full_name, repo_clone_url, dockerfile_path, webhook_secret, webhook_id, created_at, updated_at, cluster_credential_id, agent_id, retention_keep_last, retention_delete_previews, registry_webhook_token, external_id, version, health, health_detail, health_checked_at, denied_authors, deleted_at, secret_scan, secret_scan_allowlist
+`
+
+type UpdateConfigAgentIDParams struct {
+	ID      string
+	AgentID pgtype.Text
+}
+
+func (q *Queries) UpdateConfigAgentID(ctx context.Context, arg UpdateConfigAgentIDParams) (RepoConfig, error) {
+	row := q.db.QueryRow(ctx, updateConfigAgentID, arg.ID, arg.AgentID)
+	var i RepoConfig
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Provider,
+		&i.RepoOwner,
+		&i.RepoName,
+		&i.RepoFullName,
+		&i.RepoCloneUrl,
+		&i.DockerfilePath,
+		&i.WebhookSecret,
+		&i.WebhookID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ClusterCredentialID,
+		&i.AgentID,
+		&i.RetentionKeepLast,
+		&i.RetentionDeletePreviews,
+		&i.RegistryWebhookToken,
+		&i.ExternalID,
+		&i.Version,
+		&i.Health,
+		&i.HealthDetail,
+		&i.HealthCheckedAt,
+		&i.DeniedAuthors,
+		&i.DeletedAt,
+		&i.SecretScan,
+		&i.SecretScanAllowlist,
+	)
+	return i, err
+}
+
+const updateConfigClusterCredentialID = `-- name: UpdateConfigClusterCredentialID :one
+UPDATE repo_configs
+SET cluster_credential_id = $2, version = version + 1, updated_at = NOW()
+WHERE id = $1
+RETURNING id, owner_id, provider, repo_owner, repo_name, repo_full_name, repo_clone_url, dockerfile_path, webhook_secret, webhook_id, created_at, updated_at, cluster_credential_id, agent_id, retention_keep_last, retention_delete_previews, registry_webhook_token, external_id, version, health, health_detail, health_checked_at, denied_authors, deleted_at, secret_scan, secret_scan_allowlist
+`
+
+type UpdateConfigClusterCredentialIDParams struct {
+	ID                  string
+	ClusterCredentialID pgtype.Int8
+}
+
+func (q *Queries) UpdateConfigClusterCredentialID(ctx context.Context, arg UpdateConfigClusterCredentialIDParams) (RepoConfig, error) {
+	row := q.db.QueryRow(ctx, updateConfigClusterCredentialID, arg.ID, arg.ClusterCredentialID)
+	var i RepoConfig
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Provider,
+		&i.RepoOwner,
+		&i.RepoName,
+		&i.RepoFullName,
+		&i.RepoCloneUrl,
+		&i.DockerfilePath,
+		&i.WebhookSecret,
+		&i.WebhookID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ClusterCredentialID,
+		&i.AgentID,
+		&i.RetentionKeepLast,
+		&i.RetentionDeletePreviews,
+		&i.RegistryWebhookToken,
+		&i.ExternalID,
+		&i.Version,
+		&i.Health,
+		&i.HealthDetail,
+		&i.HealthCheckedAt,
+		&i.DeniedAuthors,
+		&i.DeletedAt,
+		&i.SecretScan,
+		&i.SecretScanAllowlist,
+	)
+	return i, err
+}
+
+const updateConfigDeniedAuthors = `-- name: UpdateConfigDeniedAuthors :one
+UPDATE repo_configs
+SET denied_authors = $2, version = version + 1, updated_at = NOW()
+WHERE id = $1
+RETURNING id, owner_id, provider, repo_owner, repo_name, repo_full_name, repo_clone_url, dockerfile_path, webhook_secret, webhook_id, created_at, updated_at, cluster_credential_id, agent_id, retention_keep_last, retention_delete_previews, registry_webhook_token, external_id, version, health, health_detail, health_checked_at, denied_authors, deleted_at, secret_scan, secret_scan_allowlist
+`
+
+type UpdateConfigDeniedAuthorsParams struct {
+	ID            string
+	DeniedAuthors []string
+}
+
+func (q *Queries) UpdateConfigDeniedAuthors(ctx context.Context, arg UpdateConfigDeniedAuthorsParams) (RepoConfig, error) {
+	row := q.db.QueryRow(ctx, updateConfigDeniedAuthors, arg.ID, arg.DeniedAuthors)
+	var i RepoConfig
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Provider,
+		&i.RepoOwner,
+		&i.RepoName,
+		&i.RepoFullName,
+		&i.RepoCloneUrl,
+		&i.DockerfilePath,
+		&i.WebhookSecret,
+		&i.WebhookID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ClusterCredentialID,
+		&i.AgentID,
+		&i.RetentionKeepLast,
+		&i.RetentionDeletePreviews,
+		&i.RegistryWebhookToken,
+		&i.ExternalID,
+		&i.Version,
+		&i.Health,
+		&i.HealthDetail,
+		&i.HealthCheckedAt,
+		&i.DeniedAuthors,
+		&i.DeletedAt,
+		&i.SecretScan,
+		&i.SecretScanAllowlist,
+	)
+	return i, err
+}
+
+const updateConfigHealth = `-- name: UpdateConfigHealth :exec
+UPDATE repo_configs
+SET health = $1, health_detail = $2, health_checked_at = NOW()
+WHERE id = $3
+`
+
+type UpdateConfigHealthParams struct {
+	Health       string
+	HealthDetail string
+	ID           string
+}
+
+// Health is observed rather than configured, so it leaves the version alone
+func (q *Queries) UpdateConfigHealth(ctx context.Context, arg UpdateConfigHealthParams) error {
+	_, err := q.db.Exec(ctx, updateConfigHealth, arg.Health, arg.HealthDetail, arg.ID)
+	return err
+}
+
+const updateConfigRegistryWebhookToken = `-- name: UpdateConfigRegistryWebhookToken :one
+UPDATE repo_configs
+SET registry_webhook_token = $2, version = version + 1, updated_at = NOW()
+WHERE id = $1
+RETURNING id, owner_id, provider, repo_owner, repo_name, repo_full_name, repo_clone_url, dockerfile_path, webhook_secret, webhook_id, created_at, updated_at, cluster_credential_id, agent_id, retention_keep_last, retention_delete_previews, registry_webhook_token, external_id, version, health, health_detail, health_checked_at, denied_authors, deleted_at, secret_scan, secret_scan_allowlist
+`
+
+type UpdateConfigRegistryWebhookTokenParams struct {
+	ID                   string
+	RegistryWebhookToken pgtype.Text
+}
+
+func (q *Queries) UpdateConfigRegistryWebhookToken(ctx context.Context, arg UpdateConfigRegistryWebhookTokenParams) (RepoConfig, error) {
+	row := q.db.QueryRow(ctx, updateConfigRegistryWebhookToken, arg.ID, arg.RegistryWebhookToken)
+	var i RepoConfig
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Provider,
+		&i.RepoOwner,
+		&i.RepoName,
+		&i.RepoFullName,
+		&i.RepoCloneUrl,
+		&i.DockerfilePath,
+		&i.WebhookSecret,
+		&i.WebhookID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ClusterCredentialID,
+		&i.AgentID,
+		&i.RetentionKeepLast,
+		&i.RetentionDeletePreviews,
+		&i.RegistryWebhookToken,
+		&i.ExternalID,
+		&i.Version,
+		&i.Health,
+		&i.HealthDetail,
+		&i.HealthCheckedAt,
+		&i.DeniedAuthors,
+		&i.DeletedAt,
+		&i.SecretScan,
+		&i.SecretScanAllowlist,
+	)
+	return i, err
+}
+
+const updateConfigRepository = `-- name: UpdateConfigRepository :one
+UPDATE repo_configs
+SET repo_owner = $1, repo_name = $2, repo_full_name = $3,
+  repo_clone_url = $4, version = version + 1, updated_at = NOW()
+WHERE id = $5
+RETURNING id, owner_id, provider, repo_owner, repo_name, repo_full_name, repo_clone_url, dockerfile_path, webhook_secret, webhook_id, created_at, updated_at, cluster_credential_id, agent_id, retention_keep_last, retention_delete_previews, registry_webhook_token, external_id, version, health, health_detail, health_checked_at, denied_authors, deleted_at, secret_scan, secret_scan_allowlist
+`
+
+type UpdateConfigRepositoryParams struct {
+	RepoOwner    string
+	RepoName     string
+	RepoFullName string
+	RepoCloneUrl string
+	ID           string
+}
+
+// Follows a rename of the repository on GitHub
+func (q *Queries) UpdateConfigRepository(ctx context.Context, arg UpdateConfigRepositoryParams) (RepoConfig, error) {
+	row := q.db.QueryRow(ctx, updateConfigRepository,
+		arg.RepoOwner,
+		arg.RepoName,
+		arg.RepoFullName,
+		arg.RepoCloneUrl,
+		arg.ID,
+	)
+	var i RepoConfig
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Provider,
+		&i.RepoOwner,
+		&i.RepoName,
+		&i.RepoFullName,
+		&i.RepoCloneUrl,
+		&i.DockerfilePath,
+		&i.WebhookSecret,
+		&i.WebhookID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ClusterCredentialID,
+		&i.AgentID,
+		&i.RetentionKeepLast,
+		&i.RetentionDeletePreviews,
+		&i.RegistryWebhookToken,
+		&i.ExternalID,
+		&i.Version,
+		&i.Health,
+		&i.HealthDetail,
+		&i.HealthCheckedAt,
+		&i.DeniedAuthors,
+		&i.DeletedAt,
+		&i.SecretScan,
+		&i.SecretScanAllowlist,
+	)
+	return i, err
+}
+
+const updateConfigRetention = `-- name: UpdateConfigRetention :one
+UPDATE repo_configs
+SET retention_keep_last = $2, retention_delete_previews = $3, version = version + 1, updated_at = NOW()
+WHERE id = $1
+RETURNING id, owner_id, provider, repo_owner, repo_name, repo_full_name, repo_clone_url, dockerfile_path, webhook_secret, webhook_id, created_at, updated_at, cluster_credential_id, agent_id, retention_keep_last, retention_delete_previews, registry_webhook_token, external_id, version, health, health_detail, health_checked_at, denied_authors, deleted_at, secret_scan, secret_scan_allowlist
+`
+
+type UpdateConfigRetentionParams struct {
+	ID                      string
+	RetentionKeepLast       pgtype.Int4
+	RetentionDeletePreviews bool
+}
+
+func (q *Queries) UpdateConfigRetention(ctx context.Context, arg UpdateConfigRetentionParams) (RepoConfig, error) {
+	row := q.db.QueryRow(ctx, updateConfigRetention, arg.ID, arg.RetentionKeepLast, arg.RetentionDeletePreviews)
+	var i RepoConfig
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Provider,
+		&i.RepoOwner,
+		&i.RepoName,
+		&i.RepoFullName,
+		&i.RepoCloneUrl,
+		&i.DockerfilePath,
+		&i.WebhookSecret,
+		&i.WebhookID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ClusterCredentialID,
+		&i.AgentID,
+		&i.RetentionKeepLast,
+		&i.RetentionDeletePreviews,
+		&i.RegistryWebhookToken,
+		&i.ExternalID,
+		&i.Version,
+		&i.Health,
+		&i.HealthDetail,
+		&i.HealthCheckedAt,
+		&i.DeniedAuthors,
+		&i.DeletedAt,
+		&i.SecretScan,
+		&i.SecretScanAllowlist,
+	)
+	return i, err
+}
+
+const updateConfigSecretScan = `-- name: UpdateConfigSecretScan :one
+UPDATE repo_configs
+SET secret_scan = $2, secret_scan_allowlist = $3, version = version + 1, updated_at = NOW()
+WHERE id = $1
+RETURNING id, owner_id, provider, repo_owner, repo_name, repo_full_name, repo_clone_url, dockerfile_path, webhook_secret, webhook_id, created_at, updated_at, cluster_credential_id, agent_id, retention_keep_last, retention_delete_previews, registry_webhook_token, external_id, version, health, health_detail, health_checked_at, denied_authors, deleted_at, secret_scan, secret_scan_allowlist
+`
+
+type UpdateConfigSecretScanParams struct {
+	ID                  string
+	SecretScan          bool
+	SecretScanAllowlist []string
+}
+
+func (q *Queries) UpdateConfigSecretScan(ctx context.Context, arg UpdateConfigSecretScanParams) (RepoConfig, error) {
+	row := q.db.QueryRow(ctx, updateConfigSecretScan, arg.ID, arg.SecretScan, arg.SecretScanAllowlist)
+	var i RepoConfig
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Provider,
+		&i.RepoOwner,
+		&i.RepoName,
+		&i.RepoFullName,
+		&i.RepoCloneUrl,
+		&i.DockerfilePath,
+		&i.WebhookSecret,
+		&i.WebhookID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ClusterCredentialID,
+		&i.AgentID,
+		&i.RetentionKeepLast,
+		&i.RetentionDeletePreviews,
+		&i.RegistryWebhookToken,
+		&i.ExternalID,
+		&i.Version,
+		&i.Health,
+		&i.HealthDetail,
+		&i.HealthCheckedAt,
+		&i.DeniedAuthors,
+		&i.DeletedAt,
+		&i.SecretScan,
+		&i.SecretScanAllowlist,
+	)
+	return i, err
+}
+
+const updateConfigWebhookID = `-- name: UpdateConfigWebhookID :one
+UPDATE repo_configs
+SET webhook_id = $3, version = version + 1, updated_at = NOW()
+WHERE id = $1 AND owner_id = $2 AND deleted_at IS NULL
+RETURNING id, owner_id, provider, repo_owner, repo_name, repo_full_name, repo_clone_url, dockerfile_path, webhook_secret, webhook_id, created_at, updated_at, cluster_credential_id, agent_id, retention_keep_last, retention_delete_previews, registry_webhook_token, external_id, version, health, health_detail, health_checked_at, denied_authors, deleted_at, secret_scan, secret_scan_allowlist
+`
+
+type UpdateConfigWebhookIDParams struct {
+	ID        string
+	OwnerID   string
+	WebhookID pgtype.Int8
+}
+
+func (q *Queries) UpdateConfigWebhookID(ctx context.Context, arg UpdateConfigWebhookIDParams) (RepoConfig, error) {
+	row := q.db.QueryRow(ctx, updateConfigWebhookID, arg.ID, arg.OwnerID, arg.WebhookID)
+	var i RepoConfig
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Provider,
+		&i.RepoOwner,
+		&i.RepoName,
+		&i.RepoFullName,
+		&i.RepoCloneUrl,
+		&i.DockerfilePath,
+		&i.WebhookSecret,
+		&i.WebhookID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ClusterCredentialID,
+		&i.AgentID,
+		&i.RetentionKeepLast,
+		&i.RetentionDeletePreviews,
+		&i.RegistryWebhookToken,
+		&i.ExternalID,
+		&i.Version,
+		&i.Health,
+		&i.HealthDetail,
+		&i.HealthCheckedAt,
+		&i.DeniedAuthors,
+		&i.DeletedAt,
+		&i.SecretScan,
+		&i.SecretScanAllowlist,
+	)
+	return i, err
+}
+
+const updateCredential = `-- name: UpdateCredential :one
+UPDATE credentials
+SET 
+  ciphertext = $4,
   token_nonce = $5,
   wrapped_dek = $6,
   dek_nonce = $7,
   expires_at = $8,
-  last_used_at = NOW()
-WHERE owner_id = $1 AND provider = $2 AND token_type = $3
-RETURNING id, owner_id, provider, token_type, ciphertext, token_nonce, wrapped_dek, dek_nonce, created_at, last_used_at, expires_at
+  expiry_notified_at = CASE WHEN expires_at IS DISTINCT FROM $8 THEN NULL ELSE expiry_notified_at END,
+  version = version + 1
+WHERE owner_id = $1 AND provider = $2 AND token_type = $3 AND deleted_at IS NULL
+RETURNING id, owner_id, provider, token_type, ciphertext, token_nonce, wrapped_dek, dek_nonce, created_at, last_used_at, expires_at, expiry_notified_at, external_id, version, deleted_at
 `
 
 type UpdateCredentialParams struct {
@@ -221,6 +2824,607 @@ func (q *Queries) UpdateCredential(ctx context.Context, arg UpdateCredentialPara
 		&i.CreatedAt,
 		&i.LastUsedAt,
 		&i.ExpiresAt,
+		&i.ExpiryNotifiedAt,
+		&i.ExternalID,
+		&i.Version,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const updateCredentialByID = `-- name: UpdateCredentialByID :one
+UPDATE credentials
+SET
+  ciphertext = $1,
+  token_nonce = $2,
+  wrapped_dek = $3,
+  dek_nonce = $4,
+  expires_at = $5,
+  expiry_notified_at = CASE WHEN expires_at IS DISTINCT FROM $5 THEN NULL ELSE expiry_notified_at END,
+  version = version + 1
+WHERE id = $6 AND owner_id = $7 AND deleted_at IS NULL
+  AND ($8::integer IS NULL OR version = $8)
+RETURNING id, owner_id, provider, token_type, ciphertext, token_nonce, wrapped_dek, dek_nonce, created_at, last_used_at, expires_at, expiry_notified_at, external_id, version, deleted_at
+`
+
+type UpdateCredentialByIDParams struct {
+	Ciphertext      []byte
+	TokenNonce      []byte
+	WrappedDek      []byte
+	DekNonce        []byte
+	ExpiresAt       pgtype.Timestamptz
+	ID              int64
+	OwnerID         string
+	ExpectedVersion pgtype.Int4
+}
+
+// Replaces the token of a credential. Matches no row when expected_version is set and stale.
+func (q *Queries) UpdateCredentialByID(ctx context.Context, arg UpdateCredentialByIDParams) (Credential, error) {
+	row := q.db.QueryRow(ctx, updateCredentialByID,
+		arg.Ciphertext,
+		arg.TokenNonce,
+		arg.WrappedDek,
+		arg.DekNonce,
+		arg.ExpiresAt,
+		arg.ID,
+		arg.OwnerID,
+		arg.ExpectedVersion,
+	)
+	var i Credential
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Provider,
+		&i.TokenType,
+		&i.Ciphertext,
+		&i.TokenNonce,
+		&i.WrappedDek,
+		&i.DekNonce,
+		&i.CreatedAt,
+		&i.LastUsedAt,
+		&i.ExpiresAt,
+		&i.ExpiryNotifiedAt,
+		&i.ExternalID,
+		&i.Version,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const updateDeployTokenLastUsed = `-- name: UpdateDeployTokenLastUsed :exec
+UPDATE deploy_tokens
+SET last_used_at = NOW()
+WHERE id = $1
+`
+
+func (q *Queries) UpdateDeployTokenLastUsed(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, updateDeployTokenLastUsed, id)
+	return err
+}
+
+const updateDeploymentResult = `-- name: UpdateDeploymentResult :one
+UPDATE deployments
+SET status = $2, resources = $3, error = $4, updated_at = NOW()
+WHERE id = $1
+RETURNING id, config_id, ref, commit_sha, status, resources, error, created_at, updated_at, deployed_by, rollback_of
+`
+
+type UpdateDeploymentResultParams struct {
+	ID        int64
+	Status    string
+	Resources []byte
+	Error     pgtype.Text
+}
+
+func (q *Queries) UpdateDeploymentResult(ctx context.Context, arg UpdateDeploymentResultParams) (Deployment, error) {
+	row := q.db.QueryRow(ctx, updateDeploymentResult,
+		arg.ID,
+		arg.Status,
+		arg.Resources,
+		arg.Error,
+	)
+	var i Deployment
+	err := row.Scan(
+		&i.ID,
+		&i.ConfigID,
+		&i.Ref,
+		&i.CommitSha,
+		&i.Status,
+		&i.Resources,
+		&i.Error,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeployedBy,
+		&i.RollbackOf,
+	)
+	return i, err
+}
+
+const updateHookDelivery = `-- name: UpdateHookDelivery :exec
+UPDATE hooks
+SET last_delivery_at = NOW(), last_status_code = $2, last_error = $3
+WHERE id = $1
+`
+
+type UpdateHookDeliveryParams struct {
+	ID             string
+	LastStatusCode pgtype.Int4
+	LastError      pgtype.Text
+}
+
+func (q *Queries) UpdateHookDelivery(ctx context.Context, arg UpdateHookDeliveryParams) error {
+	_, err := q.db.Exec(ctx, updateHookDelivery, arg.ID, arg.LastStatusCode, arg.LastError)
+	return err
+}
+
+const updateInstanceLimits = `-- name: UpdateInstanceLimits :one
+INSERT INTO instance_limits (
+  id, max_configs_per_user, max_build_minutes,
+  build_minutes_soft_quota, build_minutes_hard_quota,
+  deploys_soft_quota, deploys_hard_quota,
+  storage_mb_soft_quota, storage_mb_hard_quota,
+  max_log_mb, max_artifact_mb,
+  config_build_minutes_soft_quota, config_build_minutes_hard_quota,
+  config_storage_mb_soft_quota, config_storage_mb_hard_quota,
+  build_retention_days, log_retention_days
+) VALUES (
+  true, $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16
+)
+ON CONFLICT (id) DO UPDATE
+SET max_configs_per_user = EXCLUDED.max_configs_per_user,
+    max_build_minutes = EXCLUDED.max_build_minutes,
+    build_minutes_soft_quota = EXCLUDED.build_minutes_soft_quota,
+    build_minutes_hard_quota = EXCLUDED.build_minutes_hard_quota,
+    deploys_soft_quota = EXCLUDED.deploys_soft_quota,
+    deploys_hard_quota = EXCLUDED.deploys_hard_quota,
+    storage_mb_soft_quota = EXCLUDED.storage_mb_soft_quota,
+    storage_mb_hard_quota = EXCLUDED.storage_mb_hard_quota,
+    max_log_mb = EXCLUDED.max_log_mb,
+    max_artifact_mb = EXCLUDED.max_artifact_mb,
+    config_build_minutes_soft_quota = EXCLUDED.config_build_minutes_soft_quota,
+    config_build_minutes_hard_quota = EXCLUDED.config_build_minutes_hard_quota,
+    config_storage_mb_soft_quota = EXCLUDED.config_storage_mb_soft_quota,
+    config_storage_mb_hard_quota = EXCLUDED.config_storage_mb_hard_quota,
+    build_retention_days = EXCLUDED.build_retention_days,
+    log_retention_days = EXCLUDED.log_retention_days,
+    updated_at = NOW()
+RETURNING id, max_configs_per_user, max_build_minutes, updated_at, build_minutes_soft_quota, build_minutes_hard_quota, deploys_soft_quota, deploys_hard_quota, storage_mb_soft_quota, storage_mb_hard_quota, max_log_mb, max_artifact_mb, config_build_minutes_soft_quota, config_build_minutes_hard_quota, config_storage_mb_soft_quota, config_storage_mb_hard_quota, build_retention_days, log_retention_days
+`
+
+type UpdateInstanceLimitsParams struct {
+	MaxConfigsPerUser           pgtype.Int4
+	MaxBuildMinutes             pgtype.Int4
+	BuildMinutesSoftQuota       pgtype.Int4
+	BuildMinutesHardQuota       pgtype.Int4
+	DeploysSoftQuota            pgtype.Int4
+	DeploysHardQuota            pgtype.Int4
+	StorageMbSoftQuota          pgtype.Int4
+	StorageMbHardQuota          pgtype.Int4
+	MaxLogMb                    pgtype.Int4
+	MaxArtifactMb               pgtype.Int4
+	ConfigBuildMinutesSoftQuota pgtype.Int4
+	ConfigBuildMinutesHardQuota pgtype.Int4
+	ConfigStorageMbSoftQuota    pgtype.Int4
+	ConfigStorageMbHardQuota    pgtype.Int4
+	BuildRetentionDays          pgtype.Int4
+	LogRetentionDays            pgtype.Int4
+}
+
+func (q *Queries) UpdateInstanceLimits(ctx context.Context, arg UpdateInstanceLimitsParams) (InstanceLimit, error) {
+	row := q.db.QueryRow(ctx, updateInstanceLimits,
+		arg.MaxConfigsPerUser,
+		arg.MaxBuildMinutes,
+		arg.BuildMinutesSoftQuota,
+		arg.BuildMinutesHardQuota,
+		arg.DeploysSoftQuota,
+		arg.DeploysHardQuota,
+		arg.StorageMbSoftQuota,
+		arg.StorageMbHardQuota,
+		arg.MaxLogMb,
+		arg.MaxArtifactMb,
+		arg.ConfigBuildMinutesSoftQuota,
+		arg.ConfigBuildMinutesHardQuota,
+		arg.ConfigStorageMbSoftQuota,
+		arg.ConfigStorageMbHardQuota,
+		arg.BuildRetentionDays,
+		arg.LogRetentionDays,
+	)
+	var i InstanceLimit
+	err := row.Scan(
+		&i.ID,
+		&i.MaxConfigsPerUser,
+		&i.MaxBuildMinutes,
+		&i.UpdatedAt,
+		&i.BuildMinutesSoftQuota,
+		&i.BuildMinutesHardQuota,
+		&i.DeploysSoftQuota,
+		&i.DeploysHardQuota,
+		&i.StorageMbSoftQuota,
+		&i.StorageMbHardQuota,
+		&i.MaxLogMb,
+		&i.MaxArtifactMb,
+		&i.ConfigBuildMinutesSoftQuota,
+		&i.ConfigBuildMinutesHardQuota,
+		&i.ConfigStorageMbSoftQuota,
+		&i.ConfigStorageMbHardQuota,
+		&i.BuildRetentionDays,
+		&i.LogRetentionDays,
+	)
+	return i, err
+}
+
+const updateUserDisabled = `-- name: UpdateUserDisabled :one
+UPDATE users
+SET disabled_at = CASE WHEN $1::boolean THEN COALESCE(disabled_at, NOW()) ELSE NULL END,
+    updated_at = NOW()
+WHERE id = $2 AND deleted_at IS NULL
+RETURNING id, email, password_hash, created_at, updated_at, role, disabled_at, oidc_issuer, oidc_subject, deleted_at, default_registry_credential_id, build_retention_days, log_retention_days, email_verified_at
+`
+
+type UpdateUserDisabledParams struct {
+	Disabled bool
+	ID       string
+}
+
+func (q *Queries) UpdateUserDisabled(ctx context.Context, arg UpdateUserDisabledParams) (User, error) {
+	row := q.db.QueryRow(ctx, updateUserDisabled, arg.Disabled, arg.ID)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.PasswordHash,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Role,
+		&i.DisabledAt,
+		&i.OidcIssuer,
+		&i.OidcSubject,
+		&i.DeletedAt,
+		&i.DefaultRegistryCredentialID,
+		&i.BuildRetentionDays,
+		&i.LogRetentionDays,
+		&i.EmailVerifiedAt,
+	)
+	return i, err
+}
+
+const updateUserEmail = `-- name: UpdateUserEmail :one
+UPDATE users
+SET email = $2, email_verified_at = NOW(), updated_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING id, email, password_hash, created_at, updated_at, role, disabled_at, oidc_issuer, oidc_subject, deleted_at, default_registry_credential_id, build_retention_days, log_retention_days, email_verified_at
+`
+
+type UpdateUserEmailParams struct {
+	ID    string
+	Email string
+}
+
+// Only called with a confirmed address, which is verified with it
+func (q *Queries) UpdateUserEmail(ctx context.Context, arg UpdateUserEmailParams) (User, error) {
+	row := q.db.QueryRow(ctx, updateUserEmail, arg.ID, arg.Email)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.PasswordHash,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Role,
+		&i.DisabledAt,
+		&i.OidcIssuer,
+		&i.OidcSubject,
+		&i.DeletedAt,
+		&i.DefaultRegistryCredentialID,
+		&i.BuildRetentionDays,
+		&i.LogRetentionDays,
+		&i.EmailVerifiedAt,
+	)
+	return i, err
+}
+
+const updateUserPassword = `-- name: UpdateUserPassword :exec
+UPDATE users
+SET password_hash = $2, updated_at = NOW()
+WHERE id = $1
+`
+
+type UpdateUserPasswordParams struct {
+	ID           string
+	PasswordHash string
+}
+
+func (q *Queries) UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) error {
+	_, err := q.db.Exec(ctx, updateUserPassword, arg.ID, arg.PasswordHash)
+	return err
+}
+
+const updateUserRole = `-- name: UpdateUserRole :one
+UPDATE users
+SET role = $2, updated_at = NOW()
+WHERE id = $1
+RETURNING id, email, password_hash, created_at, updated_at, role, disabled_at, oidc_issuer, oidc_subject, deleted_at, default_registry_credential_id, build_retention_days, log_retention_days, email_verified_at
+`
+
+type UpdateUserRoleParams struct {
+	ID   string
+	Role string
+}
+
+func (q *Queries) UpdateUserRole(ctx context.Context, arg UpdateUserRoleParams) (User, error) {
+	row := q.db.QueryRow(ctx, updateUserRole, arg.ID, arg.Role)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.PasswordHash,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Role,
+		&i.DisabledAt,
+		&i.OidcIssuer,
+		&i.OidcSubject,
+		&i.DeletedAt,
+		&i.DefaultRegistryCredentialID,
+		&i.BuildRetentionDays,
+		&i.LogRetentionDays,
+		&i.EmailVerifiedAt,
+	)
+	return i, err
+}
+
+const upsertDeployPolicy = `-- name: UpsertDeployPolicy :one
+INSERT INTO deploy_policies (
+  owner_id, name, expression, message, action
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+ON CONFLICT (owner_id, name) DO UPDATE
+SET expression = EXCLUDED.expression, message = EXCLUDED.message, action = EXCLUDED.action, updated_at = NOW()
+RETURNING owner_id, name, expression, message, action, created_at, updated_at
+`
+
+type UpsertDeployPolicyParams struct {
+	OwnerID    string
+	Name       string
+	Expression string
+	Message    string
+	Action     string
+}
+
+func (q *Queries) UpsertDeployPolicy(ctx context.Context, arg UpsertDeployPolicyParams) (DeployPolicy, error) {
+	row := q.db.QueryRow(ctx, upsertDeployPolicy,
+		arg.OwnerID,
+		arg.Name,
+		arg.Expression,
+		arg.Message,
+		arg.Action,
+	)
+	var i DeployPolicy
+	err := row.Scan(
+		&i.OwnerID,
+		&i.Name,
+		&i.Expression,
+		&i.Message,
+		&i.Action,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertGitHubApp = `-- name: UpsertGitHubApp :one
+INSERT INTO github_app (
+  id, app_id, slug, client_id, ciphertext, token_nonce, wrapped_dek, dek_nonce
+) VALUES (
+  true, $1, $2, $3, $4, $5, $6, $7
+)
+ON CONFLICT (id) DO UPDATE
+SET app_id = EXCLUDED.app_id,
+    slug = EXCLUDED.slug,
+    client_id = EXCLUDED.client_id,
+    ciphertext = EXCLUDED.ciphertext,
+    token_nonce = EXCLUDED.token_nonce,
+    wrapped_dek = EXCLUDED.wrapped_dek,
+    dek_nonce = EXCLUDED.dek_nonce,
+    updated_at = NOW()
+RETURNING id, app_id, slug, client_id, ciphertext, token_nonce, wrapped_dek, dek_nonce, updated_at
+`
+
+type UpsertGitHubAppParams struct {
+	AppID      int64
+	Slug       string
+	ClientID   string
+	Ciphertext []byte
+	TokenNonce []byte
+	WrappedDek []byte
+	DekNonce   []byte
+}
+
+func (q *Queries) UpsertGitHubApp(ctx context.Context, arg UpsertGitHubAppParams) (GithubApp, error) {
+	row := q.db.QueryRow(ctx, upsertGitHubApp,
+		arg.AppID,
+		arg.Slug,
+		arg.ClientID,
+		arg.Ciphertext,
+		arg.TokenNonce,
+		arg.WrappedDek,
+		arg.DekNonce,
+	)
+	var i GithubApp
+	err := row.Scan(
+		&i.ID,
+		&i.AppID,
+		&i.Slug,
+		&i.ClientID,
+		&i.Ciphertext,
+		&i.TokenNonce,
+		&i.WrappedDek,
+		&i.DekNonce,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertGitHubInstallation = `-- name: UpsertGitHubInstallation :one
+INSERT INTO github_installations (
+  id, account_login, suspended_at
+) VALUES (
+  $1, $2, CASE WHEN $3::boolean THEN NOW() END
+)
+ON CONFLICT (id) DO UPDATE
+SET account_login = EXCLUDED.account_login,
+    suspended_at = CASE WHEN $3::boolean THEN COALESCE(github_installations.suspended_at, NOW()) END,
+    removed_at = NULL,
+    synced_at = NOW()
+RETURNING id, account_login, suspended_at, removed_at, synced_at, created_at
+`
+
+type UpsertGitHubInstallationParams struct {
+	ID           int64
+	AccountLogin string
+	Suspended    bool
+}
+
+func (q *Queries) UpsertGitHubInstallation(ctx context.Context, arg UpsertGitHubInstallationParams) (GithubInstallation, error) {
+	row := q.db.QueryRow(ctx, upsertGitHubInstallation, arg.ID, arg.AccountLogin, arg.Suspended)
+	var i GithubInstallation
+	err := row.Scan(
+		&i.ID,
+		&i.AccountLogin,
+		&i.SuspendedAt,
+		&i.RemovedAt,
+		&i.SyncedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const upsertNotificationPreferences = `-- name: UpsertNotificationPreferences :one
+INSERT INTO notification_preferences (
+  user_id, build_failed, deploy_failed, credential_expiry, slack_webhook_url
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+ON CONFLICT (user_id) DO UPDATE
+SET build_failed = EXCLUDED.build_failed,
+    deploy_failed = EXCLUDED.deploy_failed,
+    credential_expiry = EXCLUDED.credential_expiry,
+    slack_webhook_url = EXCLUDED.slack_webhook_url,
+    updated_at = NOW()
+RETURNING user_id, build_failed, deploy_failed, credential_expiry, updated_at, slack_webhook_url
+`
+
+type UpsertNotificationPreferencesParams struct {
+	UserID           string
+	BuildFailed      bool
+	DeployFailed     bool
+	CredentialExpiry bool
+	SlackWebhookUrl  pgtype.Text
+}
+
+func (q *Queries) UpsertNotificationPreferences(ctx context.Context, arg UpsertNotificationPreferencesParams) (NotificationPreference, error) {
+	row := q.db.QueryRow(ctx, upsertNotificationPreferences,
+		arg.UserID,
+		arg.BuildFailed,
+		arg.DeployFailed,
+		arg.CredentialExpiry,
+		arg.SlackWebhookUrl,
+	)
+	var i NotificationPreference
+	err := row.Scan(
+		&i.UserID,
+		&i.BuildFailed,
+		&i.DeployFailed,
+		&i.CredentialExpiry,
+		&i.UpdatedAt,
+		&i.SlackWebhookUrl,
+	)
+	return i, err
+}
+
+const upsertPendingUserTOTP = `-- name: UpsertPendingUserTOTP :one
+INSERT INTO user_totp (
+  user_id, ciphertext, token_nonce, wrapped_dek, dek_nonce
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+ON CONFLICT (user_id) DO UPDATE
+SET ciphertext = EXCLUDED.ciphertext, token_nonce = EXCLUDED.token_nonce,
+    wrapped_dek = EXCLUDED.wrapped_dek, dek_nonce = EXCLUDED.dek_nonce,
+    last_used_step = 0, created_at = NOW()
+WHERE user_totp.enabled_at IS NULL
+RETURNING user_id, ciphertext, token_nonce, wrapped_dek, dek_nonce, enabled_at, last_used_step, created_at, failed_attempts, locked_until
+`
+
+type UpsertPendingUserTOTPParams struct {
+	UserID     string
+	Ciphertext []byte
+	TokenNonce []byte
+	WrappedDek []byte
+	DekNonce   []byte
+}
+
+// Enrolling again replaces a pending enrollment; enabled TOTP is never replaced
+func (q *Queries) UpsertPendingUserTOTP(ctx context.Context, arg UpsertPendingUserTOTPParams) (UserTotp, error) {
+	row := q.db.QueryRow(ctx, upsertPendingUserTOTP,
+		arg.UserID,
+		arg.Ciphertext,
+		arg.TokenNonce,
+		arg.WrappedDek,
+		arg.DekNonce,
+	)
+	var i UserTotp
+	err := row.Scan(
+		&i.UserID,
+		&i.Ciphertext,
+		&i.TokenNonce,
+		&i.WrappedDek,
+		&i.DekNonce,
+		&i.EnabledAt,
+		&i.LastUsedStep,
+		&i.CreatedAt,
+		&i.FailedAttempts,
+		&i.LockedUntil,
 	)
 	return i, err
 }
+
+const useRecoveryCode = `-- name: UseRecoveryCode :execrows
+UPDATE user_recovery_codes
+SET used_at = NOW()
+WHERE code_hash = $1 AND user_id = $2 AND used_at IS NULL
+`
+
+type UseRecoveryCodeParams struct {
+	CodeHash string
+	UserID   string
+}
+
+func (q *Queries) UseRecoveryCode(ctx context.Context, arg UseRecoveryCodeParams) (int64, error) {
+	result, err := q.db.Exec(ctx, useRecoveryCode, arg.CodeHash, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const useUserTOTPStep = `-- name: UseUserTOTPStep :execrows
+UPDATE user_totp
+SET last_used_step = $2
+WHERE user_id = $1 AND last_used_step < $2
+`
+
+type UseUserTOTPStepParams struct {
+	UserID       string
+	LastUsedStep int64
+}
+
+// Accepts a code only once: the step must be newer than the last accepted one
+func (q *Queries) UseUserTOTPStep(ctx context.Context, arg UseUserTOTPStepParams) (int64, error) {
+	result, err := q.db.Exec(ctx, useUserTOTPStep, arg.UserID, arg.LastUsedStep)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -11,114 +11,2390 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countBuildImagesByDigest = `-- name: CountBuildImagesByDigest :one
+SELECT COUNT(*) FROM build_images
+WHERE digest = $1
+`
+
+func (q *Queries) CountBuildImagesByDigest(ctx context.Context, digest string) (int64, error) {
+	row := q.db.QueryRow(ctx, countBuildImagesByDigest, digest)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countConfigsByClusterCredentialID = `-- name: CountConfigsByClusterCredentialID :one
+SELECT COUNT(*) FROM repo_configs
+WHERE cluster_credential_id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) CountConfigsByClusterCredentialID(ctx context.Context, clusterCredentialID pgtype.Int8) (int64, error) {
+	row := q.db.QueryRow(ctx, countConfigsByClusterCredentialID, clusterCredentialID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countConfigsByOwnerID = `-- name: CountConfigsByOwnerID :one
+SELECT COUNT(*) FROM repo_configs
+WHERE owner_id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) CountConfigsByOwnerID(ctx context.Context, ownerID string) (int64, error) {
+	row := q.db.QueryRow(ctx, countConfigsByOwnerID, ownerID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countDeploymentsByOwnerIDSince = `-- name: CountDeploymentsByOwnerIDSince :one
+SELECT COUNT(*) FROM deployments
+JOIN repo_configs ON repo_configs.id = deployments.config_id
+WHERE repo_configs.owner_id = $1 AND deployments.created_at >= $2
+`
+
+type CountDeploymentsByOwnerIDSinceParams struct {
+	OwnerID   string
+	CreatedAt pgtype.Timestamptz
+}
+
+func (q *Queries) CountDeploymentsByOwnerIDSince(ctx context.Context, arg CountDeploymentsByOwnerIDSinceParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countDeploymentsByOwnerIDSince, arg.OwnerID, arg.CreatedAt)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countUnusedRecoveryCodes = `-- name: CountUnusedRecoveryCodes :one
+SELECT COUNT(*) FROM user_recovery_codes
+WHERE user_id = $1 AND used_at IS NULL
+`
+
+func (q *Queries) CountUnusedRecoveryCodes(ctx context.Context, userID string) (int64, error) {
+	row := q.db.QueryRow(ctx, countUnusedRecoveryCodes, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const getActiveSession = `-- name: GetActiveSession :one
+SELECT id, user_id, user_agent, ip, created_at, last_used_at, expires_at FROM sessions
+WHERE id = $1 AND user_id = $2 AND expires_at > NOW()
+LIMIT 1
+`
+
+type GetActiveSessionParams struct {
+	ID     string
+	UserID string
+}
+
+func (q *Queries) GetActiveSession(ctx context.Context, arg GetActiveSessionParams) (Session, error) {
+	row := q.db.QueryRow(ctx, getActiveSession, arg.ID, arg.UserID)
+	var i Session
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.UserAgent,
+		&i.Ip,
+		&i.CreatedAt,
+		&i.LastUsedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const getActiveSessionsByUserID = `-- name: GetActiveSessionsByUserID :many
+SELECT id, user_id, user_agent, ip, created_at, last_used_at, expires_at FROM sessions
+WHERE user_id = $1 AND expires_at > NOW()
+ORDER BY last_used_at DESC
+`
+
+func (q *Queries) GetActiveSessionsByUserID(ctx context.Context, userID string) ([]Session, error) {
+	rows, err := q.db.Query(ctx, getActiveSessionsByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Session
+	for rows.Next() {
+		var i Session
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.UserAgent,
+			&i.Ip,
+			&i.CreatedAt,
+			&i.LastUsedAt,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getActivityByOwnerID = `-- name: GetActivityByOwnerID :many
+SELECT kind, id, config_id, repo_full_name, name, ref, commit_sha, status, error, actor, rollback_of, started_at, finished_at FROM (
+  SELECT
+    'build'::text AS kind,
+    builds.id,
+    repo_configs.id::text AS config_id,
+    repo_configs.repo_full_name,
+    builds.name,
+    builds.ref,
+    builds.commit_sha,
+    builds.status,
+    COALESCE(builds.error, '')::text AS error,
+    ''::text AS actor,
+    NULL::bigint AS rollback_of,
+    builds.started_at AS started_at,
+    builds.finished_at AS finished_at
+  FROM builds
+  JOIN repo_configs ON repo_configs.id = builds.config_id
+  WHERE repo_configs.owner_id = $1 AND repo_configs.deleted_at IS NULL
+    AND ($2::text IS NULL OR repo_configs.id = $2)
+  UNION ALL
+  SELECT
+    CASE WHEN deployments.rollback_of IS NULL THEN 'deployment' ELSE 'rollback' END,
+    deployments.id,
+    repo_configs.id::text,
+    repo_configs.repo_full_name,
+    '',
+    deployments.ref,
+    deployments.commit_sha,
+    deployments.status,
+    COALESCE(deployments.error, ''),
+    deployments.deployed_by,
+    deployments.rollback_of,
+    deployments.created_at,
+    CASE WHEN deployments.status = 'in_progress' THEN NULL ELSE deployments.updated_at END
+  FROM deployments
+  JOIN repo_configs ON repo_configs.id = deployments.config_id
+  WHERE repo_configs.owner_id = $1 AND repo_configs.deleted_at IS NULL
+    AND ($2::text IS NULL OR repo_configs.id = $2)
+) activity
+ORDER BY started_at DESC, id DESC
+LIMIT $3
+`
+
+type GetActivityByOwnerIDParams struct {
+	OwnerID    string
+	ConfigID   pgtype.Text
+	MaxResults int32
+}
+
+type GetActivityByOwnerIDRow struct {
+	Kind         string
+	ID           int64
+	ConfigID     string
+	RepoFullName string
+	Name         string
+	Ref          string
+	CommitSha    string
+	Status       string
+	Error        string
+	Actor        string
+	RollbackOf   pgtype.Int8
+	StartedAt    pgtype.Timestamptz
+	FinishedAt   pgtype.Timestamptz
+}
+
+// Builds and deployments of an owner's configs, newest first. Rollbacks are the
+// deployments going back to an earlier one.
+func (q *Queries) GetActivityByOwnerID(ctx context.Context, arg GetActivityByOwnerIDParams) ([]GetActivityByOwnerIDRow, error) {
+	rows, err := q.db.Query(ctx, getActivityByOwnerID, arg.OwnerID, arg.ConfigID, arg.MaxResults)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetActivityByOwnerIDRow
+	for rows.Next() {
+		var i GetActivityByOwnerIDRow
+		if err := rows.Scan(
+			&i.Kind,
+			&i.ID,
+			&i.ConfigID,
+			&i.RepoFullName,
+			&i.Name,
+			&i.Ref,
+			&i.CommitSha,
+			&i.Status,
+			&i.Error,
+			&i.Actor,
+			&i.RollbackOf,
+			&i.StartedAt,
+			&i.FinishedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAgentByID = `-- name: GetAgentByID :one
+SELECT id, owner_id, name, token_hash, last_seen_at, created_at FROM agents
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetAgentByID(ctx context.Context, id string) (Agent, error) {
+	row := q.db.QueryRow(ctx, getAgentByID, id)
+	var i Agent
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Name,
+		&i.TokenHash,
+		&i.LastSeenAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getAgentByIDAndOwner = `-- name: GetAgentByIDAndOwner :one
+SELECT id, owner_id, name, token_hash, last_seen_at, created_at FROM agents
+WHERE id = $1 AND owner_id = $2 LIMIT 1
+`
+
+type GetAgentByIDAndOwnerParams struct {
+	ID      string
+	OwnerID string
+}
+
+func (q *Queries) GetAgentByIDAndOwner(ctx context.Context, arg GetAgentByIDAndOwnerParams) (Agent, error) {
+	row := q.db.QueryRow(ctx, getAgentByIDAndOwner, arg.ID, arg.OwnerID)
+	var i Agent
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Name,
+		&i.TokenHash,
+		&i.LastSeenAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getAgentByTokenHash = `-- name: GetAgentByTokenHash :one
+SELECT id, owner_id, name, token_hash, last_seen_at, created_at FROM agents
+WHERE token_hash = $1 LIMIT 1
+`
+
+func (q *Queries) GetAgentByTokenHash(ctx context.Context, tokenHash string) (Agent, error) {
+	row := q.db.QueryRow(ctx, getAgentByTokenHash, tokenHash)
+	var i Agent
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Name,
+		&i.TokenHash,
+		&i.LastSeenAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getAgentsByOwnerID = `-- name: GetAgentsByOwnerID :many
+SELECT id, owner_id, name, token_hash, last_seen_at, created_at FROM agents
+WHERE owner_id = $1
+ORDER BY created_at DESC
+`
+
+func (q *Queries) GetAgentsByOwnerID(ctx context.Context, ownerID string) ([]Agent, error) {
+	rows, err := q.db.Query(ctx, getAgentsByOwnerID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Agent
+	for rows.Next() {
+		var i Agent
+		if err := rows.Scan(
+			&i.ID,
+			&i.OwnerID,
+			&i.Name,
+			&i.TokenHash,
+			&i.LastSeenAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllConfigs = `-- name: GetAllConfigs :many
+SELECT id, owner_id, provider, repo_owner, repo_name, repo_full_name, repo_clone_url, dockerfile_path, webhook_secret, webhook_id, created_at, updated_at, cluster_credential_id, agent_id, retention_keep_last, retention_delete_previews, registry_webhook_token, external_id, version, health, health_detail, health_checked_at, denied_authors, deleted_at, secret_scan, secret_scan_allowlist FROM repo_configs
+WHERE deleted_at IS NULL
+ORDER BY created_at
+`
+
+func (q *Queries) GetAllConfigs(ctx context.Context) ([]RepoConfig, error) {
+	rows, err := q.db.Query(ctx, getAllConfigs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RepoConfig
+	for rows.Next() {
+		var i RepoConfig
+		if err := rows.Scan(
+			&i.ID,
+			&i.OwnerID,
+			&i.Provider,
+			&i.RepoOwner,
+			&i.RepoName,
+			&i.RepoFullName,
+			&i.RepoCloneUrl,
+			&i.DockerfilePath,
+			&i.WebhookSecret,
+			&i.WebhookID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ClusterCredentialID,
+			&i.AgentID,
+			&i.RetentionKeepLast,
+			&i.RetentionDeletePreviews,
+			&i.RegistryWebhookToken,
+			&i.ExternalID,
+			&i.Version,
+			&i.Health,
+			&i.HealthDetail,
+			&i.HealthCheckedAt,
+			&i.DeniedAuthors,
+			&i.DeletedAt,
+			&i.SecretScan,
+			&i.SecretScanAllowlist,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllDeadJobs = `-- name: GetAllDeadJobs :many
+SELECT dead_jobs.id, dead_jobs.kind, dead_jobs.config_id, dead_jobs.payload, dead_jobs.error, dead_jobs.attempts, dead_jobs.created_at, dead_jobs.requeued_at, repo_configs.owner_id FROM dead_jobs
+JOIN repo_configs ON repo_configs.id = dead_jobs.config_id
+WHERE dead_jobs.requeued_at IS NULL
+ORDER BY dead_jobs.created_at DESC
+`
+
+type GetAllDeadJobsRow struct {
+	ID         int64
+	Kind       string
+	ConfigID   string
+	Payload    []byte
+	Error      string
+	Attempts   int32
+	CreatedAt  pgtype.Timestamptz
+	RequeuedAt pgtype.Timestamptz
+	OwnerID    string
+}
+
+func (q *Queries) GetAllDeadJobs(ctx context.Context) ([]GetAllDeadJobsRow, error) {
+	rows, err := q.db.Query(ctx, getAllDeadJobs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAllDeadJobsRow
+	for rows.Next() {
+		var i GetAllDeadJobsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Kind,
+			&i.ConfigID,
+			&i.Payload,
+			&i.Error,
+			&i.Attempts,
+			&i.CreatedAt,
+			&i.RequeuedAt,
+			&i.OwnerID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getBuildArtifact = `-- name: GetBuildArtifact :one
+SELECT id, build_id, name, storage_key, size_bytes, created_at FROM build_artifacts
+WHERE build_id = $1 AND name = $2 LIMIT 1
+`
+
+type GetBuildArtifactParams struct {
+	BuildID int64
+	Name    string
+}
+
+func (q *Queries) GetBuildArtifact(ctx context.Context, arg GetBuildArtifactParams) (BuildArtifact, error) {
+	row := q.db.QueryRow(ctx, getBuildArtifact, arg.BuildID, arg.Name)
+	var i BuildArtifact
+	err := row.Scan(
+		&i.ID,
+		&i.BuildID,
+		&i.Name,
+		&i.StorageKey,
+		&i.SizeBytes,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getBuildArtifactsByBuildID = `-- name: GetBuildArtifactsByBuildID :many
+SELECT id, build_id, name, storage_key, size_bytes, created_at FROM build_artifacts
+WHERE build_id = $1
+ORDER BY name
+`
+
+func (q *Queries) GetBuildArtifactsByBuildID(ctx context.Context, buildID int64) ([]BuildArtifact, error) {
+	rows, err := q.db.Query(ctx, getBuildArtifactsByBuildID, buildID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []BuildArtifact
+	for rows.Next() {
+		var i BuildArtifact
+		if err := rows.Scan(
+			&i.ID,
+			&i.BuildID,
+			&i.Name,
+			&i.StorageKey,
+			&i.SizeBytes,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getBuildByID = `-- name: GetBuildByID :one
+SELECT id, config_id, owner_id, name, ref, commit_sha, status, error, log_bytes, artifact_bytes, started_at, finished_at, pipeline_id, timings, config_hash FROM builds
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetBuildByID(ctx context.Context, id int64) (Build, error) {
+	row := q.db.QueryRow(ctx, getBuildByID, id)
+	var i Build
+	err := row.Scan(
+		&i.ID,
+		&i.ConfigID,
+		&i.OwnerID,
+		&i.Name,
+		&i.Ref,
+		&i.CommitSha,
+		&i.Status,
+		&i.Error,
+		&i.LogBytes,
+		&i.ArtifactBytes,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.PipelineID,
+		&i.Timings,
+		&i.ConfigHash,
+	)
+	return i, err
+}
+
+const getBuildByIDAndOwner = `-- name: GetBuildByIDAndOwner :one
+SELECT id, config_id, owner_id, name, ref, commit_sha, status, error, log_bytes, artifact_bytes, started_at, finished_at, pipeline_id, timings, config_hash FROM builds
+WHERE id = $1 AND owner_id = $2 LIMIT 1
+`
+
+type GetBuildByIDAndOwnerParams struct {
+	ID      int64
+	OwnerID string
+}
+
+func (q *Queries) GetBuildByIDAndOwner(ctx context.Context, arg GetBuildByIDAndOwnerParams) (Build, error) {
+	row := q.db.QueryRow(ctx, getBuildByIDAndOwner, arg.ID, arg.OwnerID)
+	var i Build
+	err := row.Scan(
+		&i.ID,
+		&i.ConfigID,
+		&i.OwnerID,
+		&i.Name,
+		&i.Ref,
+		&i.CommitSha,
+		&i.Status,
+		&i.Error,
+		&i.LogBytes,
+		&i.ArtifactBytes,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.PipelineID,
+		&i.Timings,
+		&i.ConfigHash,
+	)
+	return i, err
+}
+
+const getBuildImagesByBuildIDs = `-- name: GetBuildImagesByBuildIDs :many
+SELECT
+  build_images.id, build_images.build_id, build_images.image, build_images.digest, build_images.branch_deleted_at, build_images.deleted_at, build_images.created_at,
+  builds.ref,
+  builds.commit_sha,
+  builds.started_at AS build_started_at
+FROM build_images
+JOIN builds ON builds.id = build_images.build_id
+WHERE build_images.build_id = ANY($1::bigint[]) AND build_images.deleted_at IS NULL
+ORDER BY build_images.id
+`
+
+type GetBuildImagesByBuildIDsRow struct {
+	ID              int64
+	BuildID         int64
+	Image           string
+	Digest          string
+	BranchDeletedAt pgtype.Timestamptz
+	DeletedAt       pgtype.Timestamptz
+	CreatedAt       pgtype.Timestamptz
+	Ref             string
+	CommitSha       string
+	BuildStartedAt  pgtype.Timestamptz
+}
+
+func (q *Queries) GetBuildImagesByBuildIDs(ctx context.Context, buildIds []int64) ([]GetBuildImagesByBuildIDsRow, error) {
+	rows, err := q.db.Query(ctx, getBuildImagesByBuildIDs, buildIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetBuildImagesByBuildIDsRow
+	for rows.Next() {
+		var i GetBuildImagesByBuildIDsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.BuildID,
+			&i.Image,
+			&i.Digest,
+			&i.BranchDeletedAt,
+			&i.DeletedAt,
+			&i.CreatedAt,
+			&i.Ref,
+			&i.CommitSha,
+			&i.BuildStartedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getBuildLog = `-- name: GetBuildLog :one
+SELECT build_id, entries, truncated, created_at, storage_key FROM build_logs
+WHERE build_id = $1
+`
+
+func (q *Queries) GetBuildLog(ctx context.Context, buildID int64) (BuildLog, error) {
+	row := q.db.QueryRow(ctx, getBuildLog, buildID)
+	var i BuildLog
+	err := row.Scan(
+		&i.BuildID,
+		&i.Entries,
+		&i.Truncated,
+		&i.CreatedAt,
+		&i.StorageKey,
+	)
+	return i, err
+}
+
+const getBuildProvenanceByBuildID = `-- name: GetBuildProvenanceByBuildID :many
+SELECT id, build_id, image, digest, predicate_type, statement, created_at, storage_key FROM build_provenance
+WHERE build_id = $1
+ORDER BY image
+`
+
+func (q *Queries) GetBuildProvenanceByBuildID(ctx context.Context, buildID int64) ([]BuildProvenance, error) {
+	rows, err := q.db.Query(ctx, getBuildProvenanceByBuildID, buildID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []BuildProvenance
+	for rows.Next() {
+		var i BuildProvenance
+		if err := rows.Scan(
+			&i.ID,
+			&i.BuildID,
+			&i.Image,
+			&i.Digest,
+			&i.PredicateType,
+			&i.Statement,
+			&i.CreatedAt,
+			&i.StorageKey,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getBuildUsageByOwnerIDSince = `-- name: GetBuildUsageByOwnerIDSince :one
+SELECT
+  COUNT(*) AS builds,
+  COALESCE(SUM(EXTRACT(EPOCH FROM (COALESCE(finished_at, NOW()) - started_at))), 0)::bigint AS build_seconds
+FROM builds
+WHERE owner_id = $1 AND started_at >= $2
+`
+
+type GetBuildUsageByOwnerIDSinceParams struct {
+	OwnerID   string
+	StartedAt pgtype.Timestamptz
+}
+
+type GetBuildUsageByOwnerIDSinceRow struct {
+	Builds       int64
+	BuildSeconds int64
+}
+
+func (q *Queries) GetBuildUsageByOwnerIDSince(ctx context.Context, arg GetBuildUsageByOwnerIDSinceParams) (GetBuildUsageByOwnerIDSinceRow, error) {
+	row := q.db.QueryRow(ctx, getBuildUsageByOwnerIDSince, arg.OwnerID, arg.StartedAt)
+	var i GetBuildUsageByOwnerIDSinceRow
+	err := row.Scan(&i.Builds, &i.BuildSeconds)
+	return i, err
+}
+
+const getCommitConfig = `-- name: GetCommitConfig :one
+SELECT content FROM commit_configs
+WHERE config_id = $1 AND commit_sha = $2 LIMIT 1
+`
+
+type GetCommitConfigParams struct {
+	ConfigID  string
+	CommitSha string
+}
+
+func (q *Queries) GetCommitConfig(ctx context.Context, arg GetCommitConfigParams) (string, error) {
+	row := q.db.QueryRow(ctx, getCommitConfig, arg.ConfigID, arg.CommitSha)
+	var content string
+	err := row.Scan(&content)
+	return content, err
+}
+
 const getConfigByID = `-- name: GetConfigByID :one
-SELECT id, owner_id, provider, repo_owner, repo_name, repo_full_name, repo_clone_url, dockerfile_path, webhook_secret, webhook_id, created_at, updated_at FROM repo_configs
+SELECT id, owner_id, provider, repo_owner, repo_name, repo_full_name, repo_clone_url, dockerfile_path, webhook_secret, webhook_id, created_at, updated_at, cluster_credential_id, agent_id, retention_keep_last, retention_delete_previews, registry_webhook_token, external_id, version, health, health_detail, health_checked_at, denied_authors, deleted_at, secret_scan, secret_scan_allowlist FROM repo_configs
+WHERE id = $1 AND deleted_at IS NULL LIMIT 1
+`
+
+func (q *Queries) GetConfigByID(ctx context.Context, id string) (RepoConfig, error) {
+	row := q.db.QueryRow(ctx, getConfigByID, id)
+	var i RepoConfig
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Provider,
+		&i.RepoOwner,
+		&i.RepoName,
+		&i.RepoFullName,
+		&i.RepoCloneUrl,
+		&i.DockerfilePath,
+		&i.WebhookSecret,
+		&i.WebhookID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ClusterCredentialID,
+		&i.AgentID,
+		&i.RetentionKeepLast,
+		&i.RetentionDeletePreviews,
+		&i.RegistryWebhookToken,
+		&i.ExternalID,
+		&i.Version,
+		&i.Health,
+		&i.HealthDetail,
+		&i.HealthCheckedAt,
+		&i.DeniedAuthors,
+		&i.DeletedAt,
+		&i.SecretScan,
+		&i.SecretScanAllowlist,
+	)
+	return i, err
+}
+
+const getConfigByIDAndOwner = `-- name: GetConfigByIDAndOwner :one
+SELECT id, owner_id, provider, repo_owner, repo_name, repo_full_name, repo_clone_url, dockerfile_path, webhook_secret, webhook_id, created_at, updated_at, cluster_credential_id, agent_id, retention_keep_last, retention_delete_previews, registry_webhook_token, external_id, version, health, health_detail, health_checked_at, denied_authors, deleted_at, secret_scan, secret_scan_allowlist FROM repo_configs
+WHERE id = $1 AND owner_id = $2 AND deleted_at IS NULL LIMIT 1
+`
+
+type GetConfigByIDAndOwnerParams struct {
+	ID      string
+	OwnerID string
+}
+
+// Configs of other owners are not found, same as missing ones
+func (q *Queries) GetConfigByIDAndOwner(ctx context.Context, arg GetConfigByIDAndOwnerParams) (RepoConfig, error) {
+	row := q.db.QueryRow(ctx, getConfigByIDAndOwner, arg.ID, arg.OwnerID)
+	var i RepoConfig
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Provider,
+		&i.RepoOwner,
+		&i.RepoName,
+		&i.RepoFullName,
+		&i.RepoCloneUrl,
+		&i.DockerfilePath,
+		&i.WebhookSecret,
+		&i.WebhookID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ClusterCredentialID,
+		&i.AgentID,
+		&i.RetentionKeepLast,
+		&i.RetentionDeletePreviews,
+		&i.RegistryWebhookToken,
+		&i.ExternalID,
+		&i.Version,
+		&i.Health,
+		&i.HealthDetail,
+		&i.HealthCheckedAt,
+		&i.DeniedAuthors,
+		&i.DeletedAt,
+		&i.SecretScan,
+		&i.SecretScanAllowlist,
+	)
+	return i, err
+}
+
+const getConfigByOwnerIDAndExternalID = `-- name: GetConfigByOwnerIDAndExternalID :one
+SELECT id, owner_id, provider, repo_owner, repo_name, repo_full_name, repo_clone_url, dockerfile_path, webhook_secret, webhook_id, created_at, updated_at, cluster_credential_id, agent_id, retention_keep_last, retention_delete_previews, registry_webhook_token, external_id, version, health, health_detail, health_checked_at, denied_authors, deleted_at, secret_scan, secret_scan_allowlist FROM repo_configs
+WHERE owner_id = $1 AND external_id = $2 AND deleted_at IS NULL LIMIT 1
+`
+
+type GetConfigByOwnerIDAndExternalIDParams struct {
+	OwnerID    string
+	ExternalID pgtype.Text
+}
+
+func (q *Queries) GetConfigByOwnerIDAndExternalID(ctx context.Context, arg GetConfigByOwnerIDAndExternalIDParams) (RepoConfig, error) {
+	row := q.db.QueryRow(ctx, getConfigByOwnerIDAndExternalID, arg.OwnerID, arg.ExternalID)
+	var i RepoConfig
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Provider,
+		&i.RepoOwner,
+		&i.RepoName,
+		&i.RepoFullName,
+		&i.RepoCloneUrl,
+		&i.DockerfilePath,
+		&i.WebhookSecret,
+		&i.WebhookID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ClusterCredentialID,
+		&i.AgentID,
+		&i.RetentionKeepLast,
+		&i.RetentionDeletePreviews,
+		&i.RegistryWebhookToken,
+		&i.ExternalID,
+		&i.Version,
+		&i.Health,
+		&i.HealthDetail,
+		&i.HealthCheckedAt,
+		&i.DeniedAuthors,
+		&i.DeletedAt,
+		&i.SecretScan,
+		&i.SecretScanAllowlist,
+	)
+	return i, err
+}
+
+const getConfigByOwnerIDAndRepoFullName = `-- name: GetConfigByOwnerIDAndRepoFullName :one
+SELECT id, owner_id, provider, repo_owner, repo_name, repo_full_name, repo_clone_url, dockerfile_path, webhook_secret, webhook_id, created_at, updated_at, cluster_credential_id, agent_id, retention_keep_last, retention_delete_previews, registry_webhook_token, external_id, version, health, health_detail, health_checked_at, denied_authors, deleted_at, secret_scan, secret_scan_allowlist FROM repo_configs
+WHERE owner_id = $1 AND lower(repo_full_name) = lower($2::text) AND deleted_at IS NULL LIMIT 1
+`
+
+type GetConfigByOwnerIDAndRepoFullNameParams struct {
+	OwnerID      string
+	RepoFullName string
+}
+
+func (q *Queries) GetConfigByOwnerIDAndRepoFullName(ctx context.Context, arg GetConfigByOwnerIDAndRepoFullNameParams) (RepoConfig, error) {
+	row := q.db.QueryRow(ctx, getConfigByOwnerIDAndRepoFullName, arg.OwnerID, arg.RepoFullName)
+	var i RepoConfig
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Provider,
+		&i.RepoOwner,
+		&i.RepoName,
+		&i.RepoFullName,
+		&i.RepoCloneUrl,
+		&i.DockerfilePath,
+		&i.WebhookSecret,
+		&i.WebhookID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ClusterCredentialID,
+		&i.AgentID,
+		&i.RetentionKeepLast,
+		&i.RetentionDeletePreviews,
+		&i.RegistryWebhookToken,
+		&i.ExternalID,
+		&i.Version,
+		&i.Health,
+		&i.HealthDetail,
+		&i.HealthCheckedAt,
+		&i.DeniedAuthors,
+		&i.DeletedAt,
+		&i.SecretScan,
+		&i.SecretScanAllowlist,
+	)
+	return i, err
+}
+
+const getConfigByWebhookID = `-- name: GetConfigByWebhookID :one
+SELECT id, owner_id, provider, repo_owner, repo_name, repo_full_name, repo_clone_url, dockerfile_path, webhook_secret, webhook_id, created_at, updated_at, cluster_credential_id, agent_id, retention_keep_last, retention_delete_previews, registry_webhook_token, external_id, version, health, health_detail, health_checked_at, denied_authors, deleted_at, secret_scan, secret_scan_allowlist FROM repo_configs
+WHERE webhook_id = $1 AND deleted_at IS NULL LIMIT 1
+`
+
+func (q *Queries) GetConfigByWebhookID(ctx context.Context, webhookID pgtype.Int8) (RepoConfig, error) {
+	row := q.db.QueryRow(ctx, getConfigByWebhookID, webhookID)
+	var i RepoConfig
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Provider,
+		&i.RepoOwner,
+		&i.RepoName,
+		&i.RepoFullName,
+		&i.RepoCloneUrl,
+		&i.DockerfilePath,
+		&i.WebhookSecret,
+		&i.WebhookID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ClusterCredentialID,
+		&i.AgentID,
+		&i.RetentionKeepLast,
+		&i.RetentionDeletePreviews,
+		&i.RegistryWebhookToken,
+		&i.ExternalID,
+		&i.Version,
+		&i.Health,
+		&i.HealthDetail,
+		&i.HealthCheckedAt,
+		&i.DeniedAuthors,
+		&i.DeletedAt,
+		&i.SecretScan,
+		&i.SecretScanAllowlist,
+	)
+	return i, err
+}
+
+const getConfigEnv = `-- name: GetConfigEnv :many
+SELECT config_id, name, value, created_at, updated_at FROM config_env
+WHERE config_id = $1
+ORDER BY name
+`
+
+func (q *Queries) GetConfigEnv(ctx context.Context, configID string) ([]ConfigEnv, error) {
+	rows, err := q.db.Query(ctx, getConfigEnv, configID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ConfigEnv
+	for rows.Next() {
+		var i ConfigEnv
+		if err := rows.Scan(
+			&i.ConfigID,
+			&i.Name,
+			&i.Value,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getConfigTransferByID = `-- name: GetConfigTransferByID :one
+SELECT
+  config_transfers.id, config_transfers.config_id, config_transfers.from_user_id, config_transfers.to_user_id, config_transfers.created_at,
+  repo_configs.repo_full_name,
+  from_user.email AS from_email,
+  to_user.email AS to_email
+FROM config_transfers
+JOIN repo_configs ON repo_configs.id = config_transfers.config_id
+JOIN users from_user ON from_user.id = config_transfers.from_user_id
+JOIN users to_user ON to_user.id = config_transfers.to_user_id
+WHERE config_transfers.id = $1 LIMIT 1
+`
+
+type GetConfigTransferByIDRow struct {
+	ID           string
+	ConfigID     string
+	FromUserID   string
+	ToUserID     string
+	CreatedAt    pgtype.Timestamptz
+	RepoFullName string
+	FromEmail    string
+	ToEmail      string
+}
+
+func (q *Queries) GetConfigTransferByID(ctx context.Context, id string) (GetConfigTransferByIDRow, error) {
+	row := q.db.QueryRow(ctx, getConfigTransferByID, id)
+	var i GetConfigTransferByIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.ConfigID,
+		&i.FromUserID,
+		&i.ToUserID,
+		&i.CreatedAt,
+		&i.RepoFullName,
+		&i.FromEmail,
+		&i.ToEmail,
+	)
+	return i, err
+}
+
+const getConfigTransfersByToUserID = `-- name: GetConfigTransfersByToUserID :many
+SELECT
+  config_transfers.id, config_transfers.config_id, config_transfers.from_user_id, config_transfers.to_user_id, config_transfers.created_at,
+  repo_configs.repo_full_name,
+  from_user.email AS from_email,
+  to_user.email AS to_email
+FROM config_transfers
+JOIN repo_configs ON repo_configs.id = config_transfers.config_id
+JOIN users from_user ON from_user.id = config_transfers.from_user_id
+JOIN users to_user ON to_user.id = config_transfers.to_user_id
+WHERE config_transfers.to_user_id = $1
+ORDER BY config_transfers.created_at DESC
+`
+
+type GetConfigTransfersByToUserIDRow struct {
+	ID           string
+	ConfigID     string
+	FromUserID   string
+	ToUserID     string
+	CreatedAt    pgtype.Timestamptz
+	RepoFullName string
+	FromEmail    string
+	ToEmail      string
+}
+
+func (q *Queries) GetConfigTransfersByToUserID(ctx context.Context, toUserID string) ([]GetConfigTransfersByToUserIDRow, error) {
+	rows, err := q.db.Query(ctx, getConfigTransfersByToUserID, toUserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetConfigTransfersByToUserIDRow
+	for rows.Next() {
+		var i GetConfigTransfersByToUserIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ConfigID,
+			&i.FromUserID,
+			&i.ToUserID,
+			&i.CreatedAt,
+			&i.RepoFullName,
+			&i.FromEmail,
+			&i.ToEmail,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getConfigUsageByOwnerIDSince = `-- name: GetConfigUsageByOwnerIDSince :many
+SELECT
+  repo_configs.id AS config_id,
+  repo_configs.repo_full_name,
+  COUNT(builds.id) FILTER (WHERE builds.started_at >= $2) AS builds,
+  COALESCE(SUM(EXTRACT(EPOCH FROM (COALESCE(builds.finished_at, NOW()) - builds.started_at))) FILTER (WHERE builds.started_at >= $2), 0)::bigint AS build_seconds,
+  COALESCE(SUM(builds.log_bytes + builds.artifact_bytes), 0)::bigint AS storage_bytes
+FROM repo_configs
+LEFT JOIN builds ON builds.config_id = repo_configs.id
+WHERE repo_configs.owner_id = $1
+GROUP BY repo_configs.id
+ORDER BY repo_configs.repo_full_name
+`
+
+type GetConfigUsageByOwnerIDSinceParams struct {
+	OwnerID   string
+	StartedAt pgtype.Timestamptz
+}
+
+type GetConfigUsageByOwnerIDSinceRow struct {
+	ConfigID     string
+	RepoFullName string
+	Builds       int64
+	BuildSeconds int64
+	StorageBytes int64
+}
+
+func (q *Queries) GetConfigUsageByOwnerIDSince(ctx context.Context, arg GetConfigUsageByOwnerIDSinceParams) ([]GetConfigUsageByOwnerIDSinceRow, error) {
+	rows, err := q.db.Query(ctx, getConfigUsageByOwnerIDSince, arg.OwnerID, arg.StartedAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetConfigUsageByOwnerIDSinceRow
+	for rows.Next() {
+		var i GetConfigUsageByOwnerIDSinceRow
+		if err := rows.Scan(
+			&i.ConfigID,
+			&i.RepoFullName,
+			&i.Builds,
+			&i.BuildSeconds,
+			&i.StorageBytes,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getConfigsByOwnerID = `-- name: GetConfigsByOwnerID :many
+SELECT id, owner_id, provider, repo_owner, repo_name, repo_full_name, repo_clone_url, dockerfile_path, webhook_secret, webhook_id, created_at, updated_at, cluster_credential_id, agent_id, retention_keep_last, retention_delete_previews, registry_webhook_token, external_id, version, health, health_detail, health_checked_at, denied_authors, deleted_at, secret_scan, secret_scan_allowlist FROM repo_configs
+WHERE owner_id = $1 AND deleted_at IS NULL
+ORDER BY created_at DESC
+`
+
+func (q *Queries) GetConfigsByOwnerID(ctx context.Context, ownerID string) ([]RepoConfig, error) {
+	rows, err := q.db.Query(ctx, getConfigsByOwnerID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RepoConfig
+	for rows.Next() {
+		var i RepoConfig
+		if err := rows.Scan(
+			&i.ID,
+			&i.OwnerID,
+			&i.Provider,
+			&i.RepoOwner,
+			&i.RepoName,
+			&i.RepoFullName,
+			&i.RepoCloneUrl,
+			&i.DockerfilePath,
+			&i.WebhookSecret,
+			&i.WebhookID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ClusterCredentialID,
+			&i.AgentID,
+			&i.RetentionKeepLast,
+			&i.RetentionDeletePreviews,
+			&i.RegistryWebhookToken,
+			&i.ExternalID,
+			&i.Version,
+			&i.Health,
+			&i.HealthDetail,
+			&i.HealthCheckedAt,
+			&i.DeniedAuthors,
+			&i.DeletedAt,
+			&i.SecretScan,
+			&i.SecretScanAllowlist,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getConfigsWithRetention = `-- name: GetConfigsWithRetention :many
+SELECT id, owner_id, provider, repo_owner, repo_name, repo_full_name, repo_clone_url, dockerfile_path, webhook_secret, webhook_id, created_at, updated_at, cluster_credential_id, agent_id, retention_keep_last, retention_delete_previews, registry_webhook_token, external_id, version, health, health_detail, health_checked_at, denied_authors, deleted_at, secret_scan, secret_scan_allowlist FROM repo_configs
+WHERE (retention_keep_last IS NOT NULL OR retention_delete_previews) AND deleted_at IS NULL
+ORDER BY created_at
+`
+
+func (q *Queries) GetConfigsWithRetention(ctx context.Context) ([]RepoConfig, error) {
+	rows, err := q.db.Query(ctx, getConfigsWithRetention)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RepoConfig
+	for rows.Next() {
+		var i RepoConfig
+		if err := rows.Scan(
+			&i.ID,
+			&i.OwnerID,
+			&i.Provider,
+			&i.RepoOwner,
+			&i.RepoName,
+			&i.RepoFullName,
+			&i.RepoCloneUrl,
+			&i.DockerfilePath,
+			&i.WebhookSecret,
+			&i.WebhookID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ClusterCredentialID,
+			&i.AgentID,
+			&i.RetentionKeepLast,
+			&i.RetentionDeletePreviews,
+			&i.RegistryWebhookToken,
+			&i.ExternalID,
+			&i.Version,
+			&i.Health,
+			&i.HealthDetail,
+			&i.HealthCheckedAt,
+			&i.DeniedAuthors,
+			&i.DeletedAt,
+			&i.SecretScan,
+			&i.SecretScanAllowlist,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCredentialByIDAndOwnerID = `-- name: GetCredentialByIDAndOwnerID :one
+SELECT id, owner_id, provider, token_type, ciphertext, token_nonce, wrapped_dek, dek_nonce, created_at, last_used_at, expires_at, expiry_notified_at, external_id, version, deleted_at FROM credentials
+WHERE id = $1 AND owner_id = $2 AND deleted_at IS NULL LIMIT 1
+`
+
+type GetCredentialByIDAndOwnerIDParams struct {
+	ID      int64
+	OwnerID string
+}
+
+func (q *Queries) GetCredentialByIDAndOwnerID(ctx context.Context, arg GetCredentialByIDAndOwnerIDParams) (Credential, error) {
+	row := q.db.QueryRow(ctx, getCredentialByIDAndOwnerID, arg.ID, arg.OwnerID)
+	var i Credential
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Provider,
+		&i.TokenType,
+		&i.Ciphertext,
+		&i.TokenNonce,
+		&i.WrappedDek,
+		&i.DekNonce,
+		&i.CreatedAt,
+		&i.LastUsedAt,
+		&i.ExpiresAt,
+		&i.ExpiryNotifiedAt,
+		&i.ExternalID,
+		&i.Version,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getCredentialByOwnerIDProviderAndTokenType = `-- name: GetCredentialByOwnerIDProviderAndTokenType :one
+SELECT id, owner_id, provider, token_type, ciphertext, token_nonce, wrapped_dek, dek_nonce, created_at, last_used_at, expires_at, expiry_notified_at, external_id, version, deleted_at FROM credentials
+WHERE owner_id = $1 AND provider = $2 AND token_type = $3 AND deleted_at IS NULL LIMIT 1
+`
+
+type GetCredentialByOwnerIDProviderAndTokenTypeParams struct {
+	OwnerID   string
+	Provider  string
+	TokenType string
+}
+
+func (q *Queries) GetCredentialByOwnerIDProviderAndTokenType(ctx context.Context, arg GetCredentialByOwnerIDProviderAndTokenTypeParams) (Credential, error) {
+	row := q.db.QueryRow(ctx, getCredentialByOwnerIDProviderAndTokenType, arg.OwnerID, arg.Provider, arg.TokenType)
+	var i Credential
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Provider,
+		&i.TokenType,
+		&i.Ciphertext,
+		&i.TokenNonce,
+		&i.WrappedDek,
+		&i.DekNonce,
+		&i.CreatedAt,
+		&i.LastUsedAt,
+		&i.ExpiresAt,
+		&i.ExpiryNotifiedAt,
+		&i.ExternalID,
+		&i.Version,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getCredentialsByOwnerID = `-- name: GetCredentialsByOwnerID :many
+SELECT id, owner_id, provider, token_type, ciphertext, token_nonce, wrapped_dek, dek_nonce, created_at, last_used_at, expires_at, expiry_notified_at, external_id, version, deleted_at FROM credentials
+WHERE owner_id = $1 AND deleted_at IS NULL
+ORDER BY created_at
+`
+
+func (q *Queries) GetCredentialsByOwnerID(ctx context.Context, ownerID string) ([]Credential, error) {
+	rows, err := q.db.Query(ctx, getCredentialsByOwnerID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Credential
+	for rows.Next() {
+		var i Credential
+		if err := rows.Scan(
+			&i.ID,
+			&i.OwnerID,
+			&i.Provider,
+			&i.TokenType,
+			&i.Ciphertext,
+			&i.TokenNonce,
+			&i.WrappedDek,
+			&i.DekNonce,
+			&i.CreatedAt,
+			&i.LastUsedAt,
+			&i.ExpiresAt,
+			&i.ExpiryNotifiedAt,
+			&i.ExternalID,
+			&i.Version,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCredentialsExpiringBeforeByOwnerID = `-- name: GetCredentialsExpiringBeforeByOwnerID :many
+SELECT id, owner_id, provider, token_type, ciphertext, token_nonce, wrapped_dek, dek_nonce, created_at, last_used_at, expires_at, expiry_notified_at, external_id, version, deleted_at FROM credentials
+WHERE owner_id = $1 AND expires_at IS NOT NULL AND expires_at <= $2
+  AND token_type <> 'oauth_access'
+  AND deleted_at IS NULL
+ORDER BY expires_at
+`
+
+type GetCredentialsExpiringBeforeByOwnerIDParams struct {
+	OwnerID   string
+	ExpiresAt pgtype.Timestamptz
+}
+
+func (q *Queries) GetCredentialsExpiringBeforeByOwnerID(ctx context.Context, arg GetCredentialsExpiringBeforeByOwnerIDParams) ([]Credential, error) {
+	rows, err := q.db.Query(ctx, getCredentialsExpiringBeforeByOwnerID, arg.OwnerID, arg.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Credential
+	for rows.Next() {
+		var i Credential
+		if err := rows.Scan(
+			&i.ID,
+			&i.OwnerID,
+			&i.Provider,
+			&i.TokenType,
+			&i.Ciphertext,
+			&i.TokenNonce,
+			&i.WrappedDek,
+			&i.DekNonce,
+			&i.CreatedAt,
+			&i.LastUsedAt,
+			&i.ExpiresAt,
+			&i.ExpiryNotifiedAt,
+			&i.ExternalID,
+			&i.Version,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDeadJobByID = `-- name: GetDeadJobByID :one
+SELECT dead_jobs.id, dead_jobs.kind, dead_jobs.config_id, dead_jobs.payload, dead_jobs.error, dead_jobs.attempts, dead_jobs.created_at, dead_jobs.requeued_at, repo_configs.owner_id FROM dead_jobs
+JOIN repo_configs ON repo_configs.id = dead_jobs.config_id
+WHERE dead_jobs.id = $1 LIMIT 1
+`
+
+type GetDeadJobByIDRow struct {
+	ID         int64
+	Kind       string
+	ConfigID   string
+	Payload    []byte
+	Error      string
+	Attempts   int32
+	CreatedAt  pgtype.Timestamptz
+	RequeuedAt pgtype.Timestamptz
+	OwnerID    string
+}
+
+func (q *Queries) GetDeadJobByID(ctx context.Context, id int64) (GetDeadJobByIDRow, error) {
+	row := q.db.QueryRow(ctx, getDeadJobByID, id)
+	var i GetDeadJobByIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.Kind,
+		&i.ConfigID,
+		&i.Payload,
+		&i.Error,
+		&i.Attempts,
+		&i.CreatedAt,
+		&i.RequeuedAt,
+		&i.OwnerID,
+	)
+	return i, err
+}
+
+const getDeadJobsByOwnerID = `-- name: GetDeadJobsByOwnerID :many
+SELECT dead_jobs.id, dead_jobs.kind, dead_jobs.config_id, dead_jobs.payload, dead_jobs.error, dead_jobs.attempts, dead_jobs.created_at, dead_jobs.requeued_at, repo_configs.owner_id FROM dead_jobs
+JOIN repo_configs ON repo_configs.id = dead_jobs.config_id
+WHERE repo_configs.owner_id = $1 AND dead_jobs.requeued_at IS NULL
+ORDER BY dead_jobs.created_at DESC
+`
+
+type GetDeadJobsByOwnerIDRow struct {
+	ID         int64
+	Kind       string
+	ConfigID   string
+	Payload    []byte
+	Error      string
+	Attempts   int32
+	CreatedAt  pgtype.Timestamptz
+	RequeuedAt pgtype.Timestamptz
+	OwnerID    string
+}
+
+// Requeued jobs are left out
+func (q *Queries) GetDeadJobsByOwnerID(ctx context.Context, ownerID string) ([]GetDeadJobsByOwnerIDRow, error) {
+	rows, err := q.db.Query(ctx, getDeadJobsByOwnerID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetDeadJobsByOwnerIDRow
+	for rows.Next() {
+		var i GetDeadJobsByOwnerIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Kind,
+			&i.ConfigID,
+			&i.Payload,
+			&i.Error,
+			&i.Attempts,
+			&i.CreatedAt,
+			&i.RequeuedAt,
+			&i.OwnerID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDefaultRegistryCredential = `-- name: GetDefaultRegistryCredential :one
+SELECT credentials.id, credentials.owner_id, credentials.provider, credentials.token_type, credentials.ciphertext, credentials.token_nonce, credentials.wrapped_dek, credentials.dek_nonce, credentials.created_at, credentials.last_used_at, credentials.expires_at, credentials.expiry_notified_at, credentials.external_id, credentials.version, credentials.deleted_at FROM credentials
+JOIN users ON users.default_registry_credential_id = credentials.id
+WHERE users.id = $1 AND credentials.deleted_at IS NULL LIMIT 1
+`
+
+func (q *Queries) GetDefaultRegistryCredential(ctx context.Context, id string) (Credential, error) {
+	row := q.db.QueryRow(ctx, getDefaultRegistryCredential, id)
+	var i Credential
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Provider,
+		&i.TokenType,
+		&i.Ciphertext,
+		&i.TokenNonce,
+		&i.WrappedDek,
+		&i.DekNonce,
+		&i.CreatedAt,
+		&i.LastUsedAt,
+		&i.ExpiresAt,
+		&i.ExpiryNotifiedAt,
+		&i.ExternalID,
+		&i.Version,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getDeletedConfigByIDAndOwner = `-- name: GetDeletedConfigByIDAndOwner :one
+SELECT id, owner_id, provider, repo_owner, repo_name, repo_full_name, repo_clone_url, dockerfile_path, webhook_secret, webhook_id, created_at, updated_at, cluster_credential_id, agent_id, retention_keep_last, retention_delete_previews, registry_webhook_token, external_id, version, health, health_detail, health_checked_at, denied_authors, deleted_at, secret_scan, secret_scan_allowlist FROM repo_configs
+WHERE id = $1 AND owner_id = $2 AND deleted_at IS NOT NULL LIMIT 1
+`
+
+type GetDeletedConfigByIDAndOwnerParams struct {
+	ID      string
+	OwnerID string
+}
+
+func (q *Queries) GetDeletedConfigByIDAndOwner(ctx context.Context, arg GetDeletedConfigByIDAndOwnerParams) (RepoConfig, error) {
+	row := q.db.QueryRow(ctx, getDeletedConfigByIDAndOwner, arg.ID, arg.OwnerID)
+	var i RepoConfig
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Provider,
+		&i.RepoOwner,
+		&i.RepoName,
+		&i.RepoFullName,
+		&i.RepoCloneUrl,
+		&i.DockerfilePath,
+		&i.WebhookSecret,
+		&i.WebhookID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ClusterCredentialID,
+		&i.AgentID,
+		&i.RetentionKeepLast,
+		&i.RetentionDeletePreviews,
+		&i.RegistryWebhookToken,
+		&i.ExternalID,
+		&i.Version,
+		&i.Health,
+		&i.HealthDetail,
+		&i.HealthCheckedAt,
+		&i.DeniedAuthors,
+		&i.DeletedAt,
+		&i.SecretScan,
+		&i.SecretScanAllowlist,
+	)
+	return i, err
+}
+
+const getDeletedConfigsByOwnerID = `-- name: GetDeletedConfigsByOwnerID :many
+SELECT id, owner_id, provider, repo_owner, repo_name, repo_full_name, repo_clone_url, dockerfile_path, webhook_secret, webhook_id, created_at, updated_at, cluster_credential_id, agent_id, retention_keep_last, retention_delete_previews, registry_webhook_token, external_id, version, health, health_detail, health_checked_at, denied_authors, deleted_at, secret_scan, secret_scan_allowlist FROM repo_configs
+WHERE owner_id = $1 AND deleted_at IS NOT NULL
+ORDER BY deleted_at DESC
+`
+
+func (q *Queries) GetDeletedConfigsByOwnerID(ctx context.Context, ownerID string) ([]RepoConfig, error) {
+	rows, err := q.db.Query(ctx, getDeletedConfigsByOwnerID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RepoConfig
+	for rows.Next() {
+		var i RepoConfig
+		if err := rows.Scan(
+			&i.ID,
+			&i.OwnerID,
+			&i.Provider,
+			&i.RepoOwner,
+			&i.RepoName,
+			&i.RepoFullName,
+			&i.RepoCloneUrl,
+			&i.DockerfilePath,
+			&i.WebhookSecret,
+			&i.WebhookID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ClusterCredentialID,
+			&i.AgentID,
+			&i.RetentionKeepLast,
+			&i.RetentionDeletePreviews,
+			&i.RegistryWebhookToken,
+			&i.ExternalID,
+			&i.Version,
+			&i.Health,
+			&i.HealthDetail,
+			&i.HealthCheckedAt,
+			&i.DeniedAuthors,
+			&i.DeletedAt,
+			&i.SecretScan,
+			&i.SecretScanAllowlist,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDeletedCredentialsByOwnerID = `-- name: GetDeletedCredentialsByOwnerID :many
+SELECT id, owner_id, provider, token_type, ciphertext, token_nonce, wrapped_dek, dek_nonce, created_at, last_used_at, expires_at, expiry_notified_at, external_id, version, deleted_at FROM credentials
+WHERE owner_id = $1 AND deleted_at IS NOT NULL
+ORDER BY deleted_at DESC
+`
+
+func (q *Queries) GetDeletedCredentialsByOwnerID(ctx context.Context, ownerID string) ([]Credential, error) {
+	rows, err := q.db.Query(ctx, getDeletedCredentialsByOwnerID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Credential
+	for rows.Next() {
+		var i Credential
+		if err := rows.Scan(
+			&i.ID,
+			&i.OwnerID,
+			&i.Provider,
+			&i.TokenType,
+			&i.Ciphertext,
+			&i.TokenNonce,
+			&i.WrappedDek,
+			&i.DekNonce,
+			&i.CreatedAt,
+			&i.LastUsedAt,
+			&i.ExpiresAt,
+			&i.ExpiryNotifiedAt,
+			&i.ExternalID,
+			&i.Version,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDeployPoliciesByOwnerID = `-- name: GetDeployPoliciesByOwnerID :many
+SELECT owner_id, name, expression, message, action, created_at, updated_at FROM deploy_policies
+WHERE owner_id = $1
+ORDER BY name
+`
+
+func (q *Queries) GetDeployPoliciesByOwnerID(ctx context.Context, ownerID string) ([]DeployPolicy, error) {
+	rows, err := q.db.Query(ctx, getDeployPoliciesByOwnerID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []DeployPolicy
+	for rows.Next() {
+		var i DeployPolicy
+		if err := rows.Scan(
+			&i.OwnerID,
+			&i.Name,
+			&i.Expression,
+			&i.Message,
+			&i.Action,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDeployTokenByTokenHash = `-- name: GetDeployTokenByTokenHash :one
+SELECT id, config_id, name, token_hash, created_by, last_used_at, created_at FROM deploy_tokens
+WHERE token_hash = $1 LIMIT 1
+`
+
+func (q *Queries) GetDeployTokenByTokenHash(ctx context.Context, tokenHash string) (DeployToken, error) {
+	row := q.db.QueryRow(ctx, getDeployTokenByTokenHash, tokenHash)
+	var i DeployToken
+	err := row.Scan(
+		&i.ID,
+		&i.ConfigID,
+		&i.Name,
+		&i.TokenHash,
+		&i.CreatedBy,
+		&i.LastUsedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getDeployTokensByConfigID = `-- name: GetDeployTokensByConfigID :many
+SELECT id, config_id, name, token_hash, created_by, last_used_at, created_at FROM deploy_tokens
+WHERE config_id = $1
+ORDER BY created_at DESC
+`
+
+func (q *Queries) GetDeployTokensByConfigID(ctx context.Context, configID string) ([]DeployToken, error) {
+	rows, err := q.db.Query(ctx, getDeployTokensByConfigID, configID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []DeployToken
+	for rows.Next() {
+		var i DeployToken
+		if err := rows.Scan(
+			&i.ID,
+			&i.ConfigID,
+			&i.Name,
+			&i.TokenHash,
+			&i.CreatedBy,
+			&i.LastUsedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDeploymentByID = `-- name: GetDeploymentByID :one
+SELECT id, config_id, ref, commit_sha, status, resources, error, created_at, updated_at, deployed_by, rollback_of FROM deployments
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetDeploymentByID(ctx context.Context, id int64) (Deployment, error) {
+	row := q.db.QueryRow(ctx, getDeploymentByID, id)
+	var i Deployment
+	err := row.Scan(
+		&i.ID,
+		&i.ConfigID,
+		&i.Ref,
+		&i.CommitSha,
+		&i.Status,
+		&i.Resources,
+		&i.Error,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeployedBy,
+		&i.RollbackOf,
+	)
+	return i, err
+}
+
+const getDeploymentByIDAndOwner = `-- name: GetDeploymentByIDAndOwner :one
+SELECT deployments.id, deployments.config_id, deployments.ref, deployments.commit_sha, deployments.status, deployments.resources, deployments.error, deployments.created_at, deployments.updated_at, deployments.deployed_by, deployments.rollback_of FROM deployments
+JOIN repo_configs ON repo_configs.id = deployments.config_id
+WHERE deployments.id = $1 AND repo_configs.owner_id = $2 AND repo_configs.deleted_at IS NULL
+LIMIT 1
+`
+
+type GetDeploymentByIDAndOwnerParams struct {
+	ID      int64
+	OwnerID string
+}
+
+// Deployments belong to the owner of their config
+func (q *Queries) GetDeploymentByIDAndOwner(ctx context.Context, arg GetDeploymentByIDAndOwnerParams) (Deployment, error) {
+	row := q.db.QueryRow(ctx, getDeploymentByIDAndOwner, arg.ID, arg.OwnerID)
+	var i Deployment
+	err := row.Scan(
+		&i.ID,
+		&i.ConfigID,
+		&i.Ref,
+		&i.CommitSha,
+		&i.Status,
+		&i.Resources,
+		&i.Error,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeployedBy,
+		&i.RollbackOf,
+	)
+	return i, err
+}
+
+const getDeploymentEnvironmentsByConfigID = `-- name: GetDeploymentEnvironmentsByConfigID :many
+SELECT deployment_environments.namespace, deployment_environments.branch,
+  deployment_environments.builds, deployment_environments.updated_at,
+  deployments.id, deployments.config_id, deployments.ref, deployments.commit_sha, deployments.status, deployments.resources, deployments.error, deployments.created_at, deployments.updated_at, deployments.deployed_by, deployments.rollback_of
+FROM deployment_environments
+JOIN deployments ON deployments.id = deployment_environments.deployment_id
+WHERE deployment_environments.config_id = $1
+ORDER BY deployment_environments.namespace
+`
+
+type GetDeploymentEnvironmentsByConfigIDRow struct {
+	Namespace  string
+	Branch     string
+	Builds     []byte
+	UpdatedAt  pgtype.Timestamptz
+	Deployment Deployment
+}
+
+func (q *Queries) GetDeploymentEnvironmentsByConfigID(ctx context.Context, configID string) ([]GetDeploymentEnvironmentsByConfigIDRow, error) {
+	rows, err := q.db.Query(ctx, getDeploymentEnvironmentsByConfigID, configID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetDeploymentEnvironmentsByConfigIDRow
+	for rows.Next() {
+		var i GetDeploymentEnvironmentsByConfigIDRow
+		if err := rows.Scan(
+			&i.Namespace,
+			&i.Branch,
+			&i.Builds,
+			&i.UpdatedAt,
+			&i.Deployment.ID,
+			&i.Deployment.ConfigID,
+			&i.Deployment.Ref,
+			&i.Deployment.CommitSha,
+			&i.Deployment.Status,
+			&i.Deployment.Resources,
+			&i.Deployment.Error,
+			&i.Deployment.CreatedAt,
+			&i.Deployment.UpdatedAt,
+			&i.Deployment.DeployedBy,
+			&i.Deployment.RollbackOf,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDeploymentManifestsByDeploymentID = `-- name: GetDeploymentManifestsByDeploymentID :many
+SELECT deployment_id, position, path, content, storage_key FROM deployment_manifests
+WHERE deployment_id = $1
+ORDER BY position
+`
+
+func (q *Queries) GetDeploymentManifestsByDeploymentID(ctx context.Context, deploymentID int64) ([]DeploymentManifest, error) {
+	rows, err := q.db.Query(ctx, getDeploymentManifestsByDeploymentID, deploymentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []DeploymentManifest
+	for rows.Next() {
+		var i DeploymentManifest
+		if err := rows.Scan(
+			&i.DeploymentID,
+			&i.Position,
+			&i.Path,
+			&i.Content,
+			&i.StorageKey,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDeploymentsByConfigID = `-- name: GetDeploymentsByConfigID :many
+SELECT id, config_id, ref, commit_sha, status, resources, error, created_at, updated_at, deployed_by, rollback_of FROM deployments
+WHERE config_id = $1
+ORDER BY created_at DESC
+LIMIT $2
+`
+
+type GetDeploymentsByConfigIDParams struct {
+	ConfigID string
+	Limit    int32
+}
+
+func (q *Queries) GetDeploymentsByConfigID(ctx context.Context, arg GetDeploymentsByConfigIDParams) ([]Deployment, error) {
+	rows, err := q.db.Query(ctx, getDeploymentsByConfigID, arg.ConfigID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Deployment
+	for rows.Next() {
+		var i Deployment
+		if err := rows.Scan(
+			&i.ID,
+			&i.ConfigID,
+			&i.Ref,
+			&i.CommitSha,
+			&i.Status,
+			&i.Resources,
+			&i.Error,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeployedBy,
+			&i.RollbackOf,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getExpiredBuildLogs = `-- name: GetExpiredBuildLogs :many
+SELECT build_logs.build_id, build_logs.entries, build_logs.truncated, build_logs.created_at, build_logs.storage_key FROM build_logs
+JOIN builds ON builds.id = build_logs.build_id
+JOIN users ON users.id = builds.owner_id
+CROSS JOIN instance_limits
+WHERE COALESCE(users.log_retention_days, instance_limits.log_retention_days) IS NOT NULL
+  AND build_logs.created_at < NOW() - make_interval(days => COALESCE(users.log_retention_days, instance_limits.log_retention_days))
+ORDER BY build_logs.build_id
+LIMIT $1
+`
+
+// Stored logs older than the log retention of their build's owner, or of the instance
+func (q *Queries) GetExpiredBuildLogs(ctx context.Context, limit int32) ([]BuildLog, error) {
+	rows, err := q.db.Query(ctx, getExpiredBuildLogs, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []BuildLog
+	for rows.Next() {
+		var i BuildLog
+		if err := rows.Scan(
+			&i.BuildID,
+			&i.Entries,
+			&i.Truncated,
+			&i.CreatedAt,
+			&i.StorageKey,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getExpiredBuilds = `-- name: GetExpiredBuilds :many
+SELECT builds.id, builds.config_id, builds.owner_id, builds.name, builds.ref, builds.commit_sha, builds.status, builds.error, builds.log_bytes, builds.artifact_bytes, builds.started_at, builds.finished_at, builds.pipeline_id, builds.timings, builds.config_hash FROM builds
+JOIN users ON users.id = builds.owner_id
+CROSS JOIN instance_limits
+WHERE builds.status <> 'running'
+  AND COALESCE(users.build_retention_days, instance_limits.build_retention_days) IS NOT NULL
+  AND builds.started_at < NOW() - make_interval(days => COALESCE(users.build_retention_days, instance_limits.build_retention_days))
+ORDER BY builds.id
+LIMIT $1
+`
+
+// Finished builds older than the build retention of their owner, or of the instance
+func (q *Queries) GetExpiredBuilds(ctx context.Context, limit int32) ([]Build, error) {
+	rows, err := q.db.Query(ctx, getExpiredBuilds, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Build
+	for rows.Next() {
+		var i Build
+		if err := rows.Scan(
+			&i.ID,
+			&i.ConfigID,
+			&i.OwnerID,
+			&i.Name,
+			&i.Ref,
+			&i.CommitSha,
+			&i.Status,
+			&i.Error,
+			&i.LogBytes,
+			&i.ArtifactBytes,
+			&i.StartedAt,
+			&i.FinishedAt,
+			&i.PipelineID,
+			&i.Timings,
+			&i.ConfigHash,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getExpiredDeployments = `-- name: GetExpiredDeployments :many
+SELECT deployments.id, deployments.config_id, deployments.ref, deployments.commit_sha, deployments.status, deployments.resources, deployments.error, deployments.created_at, deployments.updated_at, deployments.deployed_by, deployments.rollback_of FROM deployments
+JOIN repo_configs ON repo_configs.id = deployments.config_id
+JOIN users ON users.id = repo_configs.owner_id
+CROSS JOIN instance_limits
+WHERE deployments.status <> 'in_progress'
+  AND COALESCE(users.build_retention_days, instance_limits.build_retention_days) IS NOT NULL
+  AND deployments.created_at < NOW() - make_interval(days => COALESCE(users.build_retention_days, instance_limits.build_retention_days))
+  AND NOT EXISTS (
+    SELECT 1 FROM deployment_environments
+    WHERE deployment_environments.deployment_id = deployments.id
+  )
+  AND deployments.id <> (
+    SELECT MAX(latest.id) FROM deployments latest
+    WHERE latest.config_id = deployments.config_id
+  )
+ORDER BY deployments.id
+LIMIT $1
+`
+
+// Finished deployments older than the build retention of their config's owner, or of
+// the instance. Deployments live in an environment and the latest of each config are kept.
+func (q *Queries) GetExpiredDeployments(ctx context.Context, limit int32) ([]Deployment, error) {
+	rows, err := q.db.Query(ctx, getExpiredDeployments, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Deployment
+	for rows.Next() {
+		var i Deployment
+		if err := rows.Scan(
+			&i.ID,
+			&i.ConfigID,
+			&i.Ref,
+			&i.CommitSha,
+			&i.Status,
+			&i.Resources,
+			&i.Error,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeployedBy,
+			&i.RollbackOf,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getGitHubApp = `-- name: GetGitHubApp :one
+SELECT id, app_id, slug, client_id, ciphertext, token_nonce, wrapped_dek, dek_nonce, updated_at FROM github_app
+LIMIT 1
+`
+
+func (q *Queries) GetGitHubApp(ctx context.Context) (GithubApp, error) {
+	row := q.db.QueryRow(ctx, getGitHubApp)
+	var i GithubApp
+	err := row.Scan(
+		&i.ID,
+		&i.AppID,
+		&i.Slug,
+		&i.ClientID,
+		&i.Ciphertext,
+		&i.TokenNonce,
+		&i.WrappedDek,
+		&i.DekNonce,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getGitHubInstallationByAccount = `-- name: GetGitHubInstallationByAccount :one
+SELECT id, account_login, suspended_at, removed_at, synced_at, created_at FROM github_installations
+WHERE lower(account_login) = lower($1)
+ORDER BY removed_at IS NULL DESC, synced_at DESC
+LIMIT 1
+`
+
+// An account reinstalling the app gets a new installation; the current one comes first
+func (q *Queries) GetGitHubInstallationByAccount(ctx context.Context, lower string) (GithubInstallation, error) {
+	row := q.db.QueryRow(ctx, getGitHubInstallationByAccount, lower)
+	var i GithubInstallation
+	err := row.Scan(
+		&i.ID,
+		&i.AccountLogin,
+		&i.SuspendedAt,
+		&i.RemovedAt,
+		&i.SyncedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getHookByID = `-- name: GetHookByID :one
+SELECT id, owner_id, url, secret, events, last_delivery_at, last_status_code, last_error, created_at FROM hooks
 WHERE id = $1 LIMIT 1
 `
 
-func (q *Queries) GetConfigByID(ctx context.Context, id string) (RepoConfig, error) {
-	row := q.db.QueryRow(ctx, getConfigByID, id)
-	var i RepoConfig
+func (q *Queries) GetHookByID(ctx context.Context, id string) (Hook, error) {
+	row := q.db.QueryRow(ctx, getHookByID, id)
+	var i Hook
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Url,
+		&i.Secret,
+		&i.Events,
+		&i.LastDeliveryAt,
+		&i.LastStatusCode,
+		&i.LastError,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getHooksByOwnerID = `-- name: GetHooksByOwnerID :many
+SELECT id, owner_id, url, secret, events, last_delivery_at, last_status_code, last_error, created_at FROM hooks
+WHERE owner_id = $1
+ORDER BY created_at DESC
+`
+
+func (q *Queries) GetHooksByOwnerID(ctx context.Context, ownerID string) ([]Hook, error) {
+	rows, err := q.db.Query(ctx, getHooksByOwnerID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Hook
+	for rows.Next() {
+		var i Hook
+		if err := rows.Scan(
+			&i.ID,
+			&i.OwnerID,
+			&i.Url,
+			&i.Secret,
+			&i.Events,
+			&i.LastDeliveryAt,
+			&i.LastStatusCode,
+			&i.LastError,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getInstanceLimits = `-- name: GetInstanceLimits :one
+SELECT id, max_configs_per_user, max_build_minutes, updated_at, build_minutes_soft_quota, build_minutes_hard_quota, deploys_soft_quota, deploys_hard_quota, storage_mb_soft_quota, storage_mb_hard_quota, max_log_mb, max_artifact_mb, config_build_minutes_soft_quota, config_build_minutes_hard_quota, config_storage_mb_soft_quota, config_storage_mb_hard_quota, build_retention_days, log_retention_days FROM instance_limits
+LIMIT 1
+`
+
+func (q *Queries) GetInstanceLimits(ctx context.Context) (InstanceLimit, error) {
+	row := q.db.QueryRow(ctx, getInstanceLimits)
+	var i InstanceLimit
+	err := row.Scan(
+		&i.ID,
+		&i.MaxConfigsPerUser,
+		&i.MaxBuildMinutes,
+		&i.UpdatedAt,
+		&i.BuildMinutesSoftQuota,
+		&i.BuildMinutesHardQuota,
+		&i.DeploysSoftQuota,
+		&i.DeploysHardQuota,
+		&i.StorageMbSoftQuota,
+		&i.StorageMbHardQuota,
+		&i.MaxLogMb,
+		&i.MaxArtifactMb,
+		&i.ConfigBuildMinutesSoftQuota,
+		&i.ConfigBuildMinutesHardQuota,
+		&i.ConfigStorageMbSoftQuota,
+		&i.ConfigStorageMbHardQuota,
+		&i.BuildRetentionDays,
+		&i.LogRetentionDays,
+	)
+	return i, err
+}
+
+const getLatestBuildByConfigID = `-- name: GetLatestBuildByConfigID :one
+SELECT id, config_id, owner_id, name, ref, commit_sha, status, error, log_bytes, artifact_bytes, started_at, finished_at, pipeline_id, timings, config_hash FROM builds
+WHERE config_id = $1
+ORDER BY started_at DESC, id DESC
+LIMIT 1
+`
+
+func (q *Queries) GetLatestBuildByConfigID(ctx context.Context, configID pgtype.Text) (Build, error) {
+	row := q.db.QueryRow(ctx, getLatestBuildByConfigID, configID)
+	var i Build
+	err := row.Scan(
+		&i.ID,
+		&i.ConfigID,
+		&i.OwnerID,
+		&i.Name,
+		&i.Ref,
+		&i.CommitSha,
+		&i.Status,
+		&i.Error,
+		&i.LogBytes,
+		&i.ArtifactBytes,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.PipelineID,
+		&i.Timings,
+		&i.ConfigHash,
+	)
+	return i, err
+}
+
+const getLiveBuildImagesByConfigID = `-- name: GetLiveBuildImagesByConfigID :many
+SELECT
+  build_images.id, build_images.build_id, build_images.image, build_images.digest, build_images.branch_deleted_at, build_images.deleted_at, build_images.created_at,
+  builds.ref,
+  builds.commit_sha,
+  builds.started_at AS build_started_at
+FROM build_images
+JOIN builds ON builds.id = build_images.build_id
+WHERE builds.config_id = $1 AND build_images.deleted_at IS NULL
+ORDER BY builds.started_at DESC, build_images.id
+`
+
+type GetLiveBuildImagesByConfigIDRow struct {
+	ID              int64
+	BuildID         int64
+	Image           string
+	Digest          string
+	BranchDeletedAt pgtype.Timestamptz
+	DeletedAt       pgtype.Timestamptz
+	CreatedAt       pgtype.Timestamptz
+	Ref             string
+	CommitSha       string
+	BuildStartedAt  pgtype.Timestamptz
+}
+
+func (q *Queries) GetLiveBuildImagesByConfigID(ctx context.Context, configID pgtype.Text) ([]GetLiveBuildImagesByConfigIDRow, error) {
+	rows, err := q.db.Query(ctx, getLiveBuildImagesByConfigID, configID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetLiveBuildImagesByConfigIDRow
+	for rows.Next() {
+		var i GetLiveBuildImagesByConfigIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.BuildID,
+			&i.Image,
+			&i.Digest,
+			&i.BranchDeletedAt,
+			&i.DeletedAt,
+			&i.CreatedAt,
+			&i.Ref,
+			&i.CommitSha,
+			&i.BuildStartedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getNotificationPreferences = `-- name: GetNotificationPreferences :one
+SELECT user_id, build_failed, deploy_failed, credential_expiry, updated_at, slack_webhook_url FROM notification_preferences
+WHERE user_id = $1 LIMIT 1
+`
+
+func (q *Queries) GetNotificationPreferences(ctx context.Context, userID string) (NotificationPreference, error) {
+	row := q.db.QueryRow(ctx, getNotificationPreferences, userID)
+	var i NotificationPreference
 	err := row.Scan(
-		&i.ID,
-		&i.OwnerID,
-		&i.Provider,
-		&i.RepoOwner,
-		&i.RepoName,
-		&i.RepoFullName,
-		&i.RepoCloneUrl,
-		&i.DockerfilePath,
-		&i.WebhookSecret,
-		&i.WebhookID,
-		&i.CreatedAt,
+		&i.UserID,
+		&i.BuildFailed,
+		&i.DeployFailed,
+		&i.CredentialExpiry,
 		&i.UpdatedAt,
+		&i.SlackWebhookUrl,
 	)
 	return i, err
 }
 
-const getConfigByOwnerIDAndRepoFullName = `-- name: GetConfigByOwnerIDAndRepoFullName :one
-SELECT id, owner_id, provider, repo_owner, repo_name, repo_full_name, repo_clone_url, dockerfile_path, webhook_secret, webhook_id, created_at, updated_at FROM repo_configs
-WHERE owner_id = $1 AND repo_full_name = $2 LIMIT 1
+const getPipelineByID = `-- name: GetPipelineByID :one
+SELECT id, config_id, ref, commit_sha, created_at, status, finished_at, rendered_config FROM pipelines
+WHERE id = $1 LIMIT 1
 `
 
-type GetConfigByOwnerIDAndRepoFullNameParams struct {
-	OwnerID      string
-	RepoFullName string
-}
-
-func (q *Queries) GetConfigByOwnerIDAndRepoFullName(ctx context.Context, arg GetConfigByOwnerIDAndRepoFullNameParams) (RepoConfig, error) {
-	row := q.db.QueryRow(ctx, getConfigByOwnerIDAndRepoFullName, arg.OwnerID, arg.RepoFullName)
-	var i RepoConfig
+func (q *Queries) GetPipelineByID(ctx context.Context, id int64) (Pipeline, error) {
+	row := q.db.QueryRow(ctx, getPipelineByID, id)
+	var i Pipeline
 	err := row.Scan(
 		&i.ID,
-		&i.OwnerID,
-		&i.Provider,
-		&i.RepoOwner,
-		&i.RepoName,
-		&i.RepoFullName,
-		&i.RepoCloneUrl,
-		&i.DockerfilePath,
-		&i.WebhookSecret,
-		&i.WebhookID,
+		&i.ConfigID,
+		&i.Ref,
+		&i.CommitSha,
 		&i.CreatedAt,
-		&i.UpdatedAt,
+		&i.Status,
+		&i.FinishedAt,
+		&i.RenderedConfig,
 	)
 	return i, err
 }
 
-const getConfigByWebhookID = `-- name: GetConfigByWebhookID :one
-SELECT id, owner_id, provider, repo_owner, repo_name, repo_full_name, repo_clone_url, dockerfile_path, webhook_secret, webhook_id, created_at, updated_at FROM repo_configs
-WHERE webhook_id = $1 LIMIT 1
+const getPipelineStagesByPipelineID = `-- name: GetPipelineStagesByPipelineID :many
+SELECT id, pipeline_id, name, kind, needs, status, error, build_id, deployment_id, started_at, finished_at FROM pipeline_stages
+WHERE pipeline_id = $1
+ORDER BY id
 `
 
-func (q *Queries) GetConfigByWebhookID(ctx context.Context, webhookID pgtype.Int8) (RepoConfig, error) {
-	row := q.db.QueryRow(ctx, getConfigByWebhookID, webhookID)
-	var i RepoConfig
+func (q *Queries) GetPipelineStagesByPipelineID(ctx context.Context, pipelineID int64) ([]PipelineStage, error) {
+	rows, err := q.db.Query(ctx, getPipelineStagesByPipelineID, pipelineID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PipelineStage
+	for rows.Next() {
+		var i PipelineStage
+		if err := rows.Scan(
+			&i.ID,
+			&i.PipelineID,
+			&i.Name,
+			&i.Kind,
+			&i.Needs,
+			&i.Status,
+			&i.Error,
+			&i.BuildID,
+			&i.DeploymentID,
+			&i.StartedAt,
+			&i.FinishedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getQueuedJobByID = `-- name: GetQueuedJobByID :one
+SELECT id, kind, config_id, payload, priority, attempts, run_after, claimed_until, created_at FROM queued_jobs
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetQueuedJobByID(ctx context.Context, id int64) (QueuedJob, error) {
+	row := q.db.QueryRow(ctx, getQueuedJobByID, id)
+	var i QueuedJob
 	err := row.Scan(
 		&i.ID,
-		&i.OwnerID,
-		&i.Provider,
-		&i.RepoOwner,
-		&i.RepoName,
-		&i.RepoFullName,
-		&i.RepoCloneUrl,
-		&i.DockerfilePath,
-		&i.WebhookSecret,
-		&i.WebhookID,
+		&i.Kind,
+		&i.ConfigID,
+		&i.Payload,
+		&i.Priority,
+		&i.Attempts,
+		&i.RunAfter,
+		&i.ClaimedUntil,
 		&i.CreatedAt,
-		&i.UpdatedAt,
 	)
 	return i, err
 }
 
-const getConfigsByOwnerID = `-- name: GetConfigsByOwnerID :many
-SELECT id, owner_id, provider, repo_owner, repo_name, repo_full_name, repo_clone_url, dockerfile_path, webhook_secret, webhook_id, created_at, updated_at FROM repo_configs
-WHERE owner_id = $1
-ORDER BY created_at DESC
+const getRecentDeployments = `-- name: GetRecentDeployments :many
+SELECT deployments.id, deployments.config_id, deployments.ref, deployments.commit_sha, deployments.status, deployments.resources, deployments.error, deployments.created_at, deployments.updated_at, deployments.deployed_by, deployments.rollback_of, repo_configs.repo_full_name, repo_configs.owner_id, users.email AS owner_email
+FROM deployments
+JOIN repo_configs ON repo_configs.id = deployments.config_id
+JOIN users ON users.id = repo_configs.owner_id
+ORDER BY deployments.created_at DESC
+LIMIT $1
 `
 
-func (q *Queries) GetConfigsByOwnerID(ctx context.Context, ownerID string) ([]RepoConfig, error) {
-	rows, err := q.db.Query(ctx, getConfigsByOwnerID, ownerID)
+type GetRecentDeploymentsRow struct {
+	ID           int64
+	ConfigID     string
+	Ref          string
+	CommitSha    string
+	Status       string
+	Resources    []byte
+	Error        pgtype.Text
+	CreatedAt    pgtype.Timestamptz
+	UpdatedAt    pgtype.Timestamptz
+	DeployedBy   string
+	RollbackOf   pgtype.Int8
+	RepoFullName string
+	OwnerID      string
+	OwnerEmail   string
+}
+
+func (q *Queries) GetRecentDeployments(ctx context.Context, limit int32) ([]GetRecentDeploymentsRow, error) {
+	rows, err := q.db.Query(ctx, getRecentDeployments, limit)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []RepoConfig
+	var items []GetRecentDeploymentsRow
 	for rows.Next() {
-		var i RepoConfig
+		var i GetRecentDeploymentsRow
 		if err := rows.Scan(
 			&i.ID,
-			&i.OwnerID,
-			&i.Provider,
-			&i.RepoOwner,
-			&i.RepoName,
-			&i.RepoFullName,
-			&i.RepoCloneUrl,
-			&i.DockerfilePath,
-			&i.WebhookSecret,
-			&i.WebhookID,
+			&i.ConfigID,
+			&i.Ref,
+			&i.CommitSha,
+			&i.Status,
+			&i.Resources,
+			&i.Error,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.DeployedBy,
+			&i.RollbackOf,
+			&i.RepoFullName,
+			&i.OwnerID,
+			&i.OwnerEmail,
 		); err != nil {
 			return nil, err
 		}
@@ -130,38 +2406,133 @@ func (q *Queries) GetConfigsByOwnerID(ctx context.Context, ownerID string) ([]Re
 	return items, nil
 }
 
-const getCredentialByOwnerIDAndTokenType = `-- name: GetCredentialByOwnerIDAndTokenType :one
-SELECT id, owner_id, provider, token_type, ciphertext, token_nonce, wrapped_dek, dek_nonce, created_at, last_used_at, expires_at FROM credentials
-WHERE owner_id = $1 AND token_type = $2 LIMIT 1
+const getRecentRetentionRuns = `-- name: GetRecentRetentionRuns :many
+SELECT id, builds_deleted, logs_deleted, bytes_reclaimed, started_at, finished_at FROM retention_runs
+ORDER BY id DESC
+LIMIT $1
 `
 
-type GetCredentialByOwnerIDAndTokenTypeParams struct {
-	OwnerID   string
-	TokenType string
+func (q *Queries) GetRecentRetentionRuns(ctx context.Context, limit int32) ([]RetentionRun, error) {
+	rows, err := q.db.Query(ctx, getRecentRetentionRuns, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RetentionRun
+	for rows.Next() {
+		var i RetentionRun
+		if err := rows.Scan(
+			&i.ID,
+			&i.BuildsDeleted,
+			&i.LogsDeleted,
+			&i.BytesReclaimed,
+			&i.StartedAt,
+			&i.FinishedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-func (q *Queries) GetCredentialByOwnerIDAndTokenType(ctx context.Context, arg GetCredentialByOwnerIDAndTokenTypeParams) (Credential, error) {
-	row := q.db.QueryRow(ctx, getCredentialByOwnerIDAndTokenType, arg.OwnerID, arg.TokenType)
-	var i Credential
+const getRetentionTotals = `-- name: GetRetentionTotals :one
+SELECT
+  COUNT(*) AS runs,
+  COALESCE(SUM(builds_deleted), 0)::bigint AS builds_deleted,
+  COALESCE(SUM(logs_deleted), 0)::bigint AS logs_deleted,
+  COALESCE(SUM(bytes_reclaimed), 0)::bigint AS bytes_reclaimed,
+  MAX(finished_at)::timestamptz AS last_run_at
+FROM retention_runs
+`
+
+type GetRetentionTotalsRow struct {
+	Runs           int64
+	BuildsDeleted  int64
+	LogsDeleted    int64
+	BytesReclaimed int64
+	LastRunAt      pgtype.Timestamptz
+}
+
+func (q *Queries) GetRetentionTotals(ctx context.Context) (GetRetentionTotalsRow, error) {
+	row := q.db.QueryRow(ctx, getRetentionTotals)
+	var i GetRetentionTotalsRow
+	err := row.Scan(
+		&i.Runs,
+		&i.BuildsDeleted,
+		&i.LogsDeleted,
+		&i.BytesReclaimed,
+		&i.LastRunAt,
+	)
+	return i, err
+}
+
+const getReusableBuild = `-- name: GetReusableBuild :one
+SELECT id, config_id, owner_id, name, ref, commit_sha, status, error, log_bytes, artifact_bytes, started_at, finished_at, pipeline_id, timings, config_hash FROM builds
+WHERE config_id = $1 AND commit_sha = $2 AND name = $3 AND config_hash = $4
+  AND config_hash <> '' AND status = 'succeeded'
+  AND NOT EXISTS (
+    SELECT 1 FROM build_images
+    WHERE build_images.build_id = builds.id AND build_images.deleted_at IS NOT NULL
+  )
+ORDER BY started_at DESC, id DESC
+LIMIT 1
+`
+
+type GetReusableBuildParams struct {
+	ConfigID   pgtype.Text
+	CommitSha  string
+	Name       string
+	ConfigHash string
+}
+
+func (q *Queries) GetReusableBuild(ctx context.Context, arg GetReusableBuildParams) (Build, error) {
+	row := q.db.QueryRow(ctx, getReusableBuild,
+		arg.ConfigID,
+		arg.CommitSha,
+		arg.Name,
+		arg.ConfigHash,
+	)
+	var i Build
 	err := row.Scan(
 		&i.ID,
+		&i.ConfigID,
 		&i.OwnerID,
-		&i.Provider,
-		&i.TokenType,
-		&i.Ciphertext,
-		&i.TokenNonce,
-		&i.WrappedDek,
-		&i.DekNonce,
-		&i.CreatedAt,
-		&i.LastUsedAt,
-		&i.ExpiresAt,
+		&i.Name,
+		&i.Ref,
+		&i.CommitSha,
+		&i.Status,
+		&i.Error,
+		&i.LogBytes,
+		&i.ArtifactBytes,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.PipelineID,
+		&i.Timings,
+		&i.ConfigHash,
 	)
 	return i, err
 }
 
+const getStorageUsageByOwnerID = `-- name: GetStorageUsageByOwnerID :one
+SELECT COALESCE(SUM(log_bytes + artifact_bytes), 0)::bigint AS storage_bytes
+FROM builds
+WHERE owner_id = $1
+`
+
+func (q *Queries) GetStorageUsageByOwnerID(ctx context.Context, ownerID string) (int64, error) {
+	row := q.db.QueryRow(ctx, getStorageUsageByOwnerID, ownerID)
+	var storage_bytes int64
+	err := row.Scan(&storage_bytes)
+	return storage_bytes, err
+}
+
 const getUniqueProvidersByOwnerID = `-- name: GetUniqueProvidersByOwnerID :many
 SELECT DISTINCT provider FROM credentials 
-WHERE owner_id = $1 AND (expires_at IS NULL OR expires_at > NOW())
+WHERE owner_id = $1 AND (expires_at IS NULL OR expires_at > NOW()) AND deleted_at IS NULL
 `
 
 func (q *Queries) GetUniqueProvidersByOwnerID(ctx context.Context, ownerID string) ([]string, error) {
@@ -184,8 +2555,53 @@ func (q *Queries) GetUniqueProvidersByOwnerID(ctx context.Context, ownerID strin
 	return items, nil
 }
 
+const getUnnotifiedCredentialsExpiringBefore = `-- name: GetUnnotifiedCredentialsExpiringBefore :many
+SELECT id, owner_id, provider, token_type, ciphertext, token_nonce, wrapped_dek, dek_nonce, created_at, last_used_at, expires_at, expiry_notified_at, external_id, version, deleted_at FROM credentials
+WHERE expires_at IS NOT NULL AND expires_at <= $1
+  AND expiry_notified_at IS NULL
+  AND token_type <> 'oauth_access'
+  AND deleted_at IS NULL
+ORDER BY owner_id, expires_at
+`
+
+func (q *Queries) GetUnnotifiedCredentialsExpiringBefore(ctx context.Context, expiresAt pgtype.Timestamptz) ([]Credential, error) {
+	rows, err := q.db.Query(ctx, getUnnotifiedCredentialsExpiringBefore, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Credential
+	for rows.Next() {
+		var i Credential
+		if err := rows.Scan(
+			&i.ID,
+			&i.OwnerID,
+			&i.Provider,
+			&i.TokenType,
+			&i.Ciphertext,
+			&i.TokenNonce,
+			&i.WrappedDek,
+			&i.DekNonce,
+			&i.CreatedAt,
+			&i.LastUsedAt,
+			&i.ExpiresAt,
+			&i.ExpiryNotifiedAt,
+			&i.ExternalID,
+			&i.Version,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, email, password_hash, created_at, updated_at FROM users
+SELECT id, email, password_hash, created_at, updated_at, role, disabled_at, oidc_issuer, oidc_subject, deleted_at, default_registry_credential_id, build_retention_days, log_retention_days, email_verified_at FROM users
 WHERE email = $1 LIMIT 1
 `
 
@@ -198,12 +2614,21 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error
 		&i.PasswordHash,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Role,
+		&i.DisabledAt,
+		&i.OidcIssuer,
+		&i.OidcSubject,
+		&i.DeletedAt,
+		&i.DefaultRegistryCredentialID,
+		&i.BuildRetentionDays,
+		&i.LogRetentionDays,
+		&i.EmailVerifiedAt,
 	)
 	return i, err
 }
 
 const getUserByID = `-- name: GetUserByID :one
-SELECT id, email, password_hash, created_at, updated_at FROM users
+SELECT id, email, password_hash, created_at, updated_at, role, disabled_at, oidc_issuer, oidc_subject, deleted_at, default_registry_credential_id, build_retention_days, log_retention_days, email_verified_at FROM users
 WHERE id = $1 LIMIT 1
 `
 
@@ -216,6 +2641,176 @@ func (q *Queries) GetUserByID(ctx context.Context, id string) (User, error) {
 		&i.PasswordHash,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Role,
+		&i.DisabledAt,
+		&i.OidcIssuer,
+		&i.OidcSubject,
+		&i.DeletedAt,
+		&i.DefaultRegistryCredentialID,
+		&i.BuildRetentionDays,
+		&i.LogRetentionDays,
+		&i.EmailVerifiedAt,
+	)
+	return i, err
+}
+
+const getUserByOIDCIdentity = `-- name: GetUserByOIDCIdentity :one
+SELECT id, email, password_hash, created_at, updated_at, role, disabled_at, oidc_issuer, oidc_subject, deleted_at, default_registry_credential_id, build_retention_days, log_retention_days, email_verified_at FROM users
+WHERE oidc_issuer = $1 AND oidc_subject = $2 LIMIT 1
+`
+
+type GetUserByOIDCIdentityParams struct {
+	OidcIssuer  pgtype.Text
+	OidcSubject pgtype.Text
+}
+
+func (q *Queries) GetUserByOIDCIdentity(ctx context.Context, arg GetUserByOIDCIdentityParams) (User, error) {
+	row := q.db.QueryRow(ctx, getUserByOIDCIdentity, arg.OidcIssuer, arg.OidcSubject)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.PasswordHash,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Role,
+		&i.DisabledAt,
+		&i.OidcIssuer,
+		&i.OidcSubject,
+		&i.DeletedAt,
+		&i.DefaultRegistryCredentialID,
+		&i.BuildRetentionDays,
+		&i.LogRetentionDays,
+		&i.EmailVerifiedAt,
+	)
+	return i, err
+}
+
+const getUserTOTP = `-- name: GetUserTOTP :one
+SELECT user_id, ciphertext, token_nonce, wrapped_dek, dek_nonce, enabled_at, last_used_step, created_at, failed_attempts, locked_until FROM user_totp
+WHERE user_id = $1 LIMIT 1
+`
+
+func (q *Queries) GetUserTOTP(ctx context.Context, userID string) (UserTotp, error) {
+	row := q.db.QueryRow(ctx, getUserTOTP, userID)
+	var i UserTotp
+	err := row.Scan(
+		&i.UserID,
+		&i.Ciphertext,
+		&i.TokenNonce,
+		&i.WrappedDek,
+		&i.DekNonce,
+		&i.EnabledAt,
+		&i.LastUsedStep,
+		&i.CreatedAt,
+		&i.FailedAttempts,
+		&i.LockedUntil,
 	)
 	return i, err
 }
+
+const getUsers = `-- name: GetUsers :many
+SELECT id, email, password_hash, created_at, updated_at, role, disabled_at, oidc_issuer, oidc_subject, deleted_at, default_registry_credential_id, build_retention_days, log_retention_days, email_verified_at FROM users
+WHERE deleted_at IS NULL
+ORDER BY created_at DESC
+`
+
+func (q *Queries) GetUsers(ctx context.Context) ([]User, error) {
+	rows, err := q.db.Query(ctx, getUsers)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []User
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.Email,
+			&i.PasswordHash,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Role,
+			&i.DisabledAt,
+			&i.OidcIssuer,
+			&i.OidcSubject,
+			&i.DeletedAt,
+			&i.DefaultRegistryCredentialID,
+			&i.BuildRetentionDays,
+			&i.LogRetentionDays,
+			&i.EmailVerifiedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const hasInstallationRepository = `-- name: HasInstallationRepository :one
+SELECT EXISTS (
+  SELECT 1 FROM installation_repositories
+  WHERE installation_id = $1 AND repo_full_name = lower($2::text)
+)
+`
+
+type HasInstallationRepositoryParams struct {
+	InstallationID int64
+	RepoFullName   string
+}
+
+func (q *Queries) HasInstallationRepository(ctx context.Context, arg HasInstallationRepositoryParams) (bool, error) {
+	row := q.db.QueryRow(ctx, hasInstallationRepository, arg.InstallationID, arg.RepoFullName)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const listDeploymentsByConfigID = `-- name: ListDeploymentsByConfigID :many
+SELECT id, config_id, ref, commit_sha, status, resources, error, created_at, updated_at, deployed_by, rollback_of FROM deployments
+WHERE config_id = $1
+  AND ($2::bigint IS NULL OR id < $2)
+ORDER BY id DESC
+LIMIT $3
+`
+
+type ListDeploymentsByConfigIDParams struct {
+	ConfigID   string
+	Before     pgtype.Int8
+	MaxResults int32
+}
+
+func (q *Queries) ListDeploymentsByConfigID(ctx context.Context, arg ListDeploymentsByConfigIDParams) ([]Deployment, error) {
+	rows, err := q.db.Query(ctx, listDeploymentsByConfigID, arg.ConfigID, arg.Before, arg.MaxResults)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Deployment
+	for rows.Next() {
+		var i Deployment
+		if err := rows.Scan(
+			&i.ID,
+			&i.ConfigID,
+			&i.Ref,
+			&i.CommitSha,
+			&i.Status,
+			&i.Resources,
+			&i.Error,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeployedBy,
+			&i.RollbackOf,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,44 @@
+-- name: GetActivityByOwnerID :many
+-- Builds and deployments of an owner's configs, newest first. Rollbacks are the
+-- deployments going back to an earlier one.
+SELECT * FROM (
+  SELECT
+    'build'::text AS kind,
+    builds.id,
+    repo_configs.id::text AS config_id,
+    repo_configs.repo_full_name,
+    builds.name,
+    builds.ref,
+    builds.commit_sha,
+    builds.status,
+    COALESCE(builds.error, '')::text AS error,
+    ''::text AS actor,
+    NULL::bigint AS rollback_of,
+    builds.started_at AS started_at,
+    builds.finished_at AS finished_at
+  FROM builds
+  JOIN repo_configs ON repo_configs.id = builds.config_id
+  WHERE repo_configs.owner_id = @owner_id AND repo_configs.deleted_at IS NULL
+    AND (sqlc.narg('config_id')::text IS NULL OR repo_configs.id = sqlc.narg('config_id'))
+  UNION ALL
+  SELECT
+    CASE WHEN deployments.rollback_of IS NULL THEN 'deployment' ELSE 'rollback' END,
+    deployments.id,
+    repo_configs.id::text,
+    repo_configs.repo_full_name,
+    '',
+    deployments.ref,
+    deployments.commit_sha,
+    deployments.status,
+    COALESCE(deployments.error, ''),
+    deployments.deployed_by,
+    deployments.rollback_of,
+    deployments.created_at,
+    CASE WHEN deployments.status = 'in_progress' THEN NULL ELSE deployments.updated_at END
+  FROM deployments
+  JOIN repo_configs ON repo_configs.id = deployments.config_id
+  WHERE repo_configs.owner_id = @owner_id AND repo_configs.deleted_at IS NULL
+    AND (sqlc.narg('config_id')::text IS NULL OR repo_configs.id = sqlc.narg('config_id'))
+) activity
+ORDER BY started_at DESC, id DESC
+LIMIT @max_results;
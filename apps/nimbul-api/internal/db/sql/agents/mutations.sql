@@ -0,0 +1,51 @@
+-- name: CreateAgent :one
+INSERT INTO agents (
+  id, owner_id, name, token_hash
+) VALUES (
+  $1, $2, $3, $4
+)
+RETURNING *;
+
+-- name: UpdateAgentLastSeen :exec
+UPDATE agents
+SET last_seen_at = NOW()
+WHERE id = $1;
+
+-- name: CreateAgentDeployment :one
+INSERT INTO agent_deployments (
+  agent_id, config_id, storage_key
+) VALUES (
+  $1, $2, $3
+)
+RETURNING *;
+
+-- name: ClaimNextAgentDeployment :one
+-- Takes the oldest pending deployment of the agent, or a delivered one whose lease ran
+-- out without the agent reporting its outcome
+UPDATE agent_deployments
+SET status = 'delivered',
+    claimed_until = NOW() + make_interval(secs => sqlc.arg(lease_seconds)::integer),
+    deliveries = deliveries + 1,
+    updated_at = NOW()
+WHERE id = (
+  SELECT pending.id FROM agent_deployments pending
+  WHERE pending.agent_id = sqlc.arg(agent_id)
+    AND (pending.status = 'pending' OR (pending.status = 'delivered' AND pending.claimed_until < NOW()))
+  ORDER BY pending.id
+  LIMIT 1
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: FailUnreportedAgentDeployments :exec
+-- Gives up on deployments delivered max_deliveries times without an outcome
+UPDATE agent_deployments
+SET status = 'failed', error = sqlc.arg(error), claimed_until = NULL, updated_at = NOW()
+WHERE agent_id = sqlc.arg(agent_id) AND status = 'delivered' AND claimed_until < NOW()
+  AND deliveries >= sqlc.arg(max_deliveries)::integer;
+
+-- name: UpdateAgentDeploymentStatus :one
+UPDATE agent_deployments
+SET status = $3, error = $4, claimed_until = NULL, updated_at = NOW()
+WHERE id = $1 AND agent_id = $2
+RETURNING *;
@@ -0,0 +1,16 @@
+-- name: GetAgentByID :one
+SELECT * FROM agents
+WHERE id = $1 LIMIT 1;
+
+-- name: GetAgentByIDAndOwner :one
+SELECT * FROM agents
+WHERE id = $1 AND owner_id = $2 LIMIT 1;
+
+-- name: GetAgentByTokenHash :one
+SELECT * FROM agents
+WHERE token_hash = $1 LIMIT 1;
+
+-- name: GetAgentsByOwnerID :many
+SELECT * FROM agents
+WHERE owner_id = $1
+ORDER BY created_at DESC;
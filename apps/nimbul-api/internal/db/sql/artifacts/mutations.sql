@@ -0,0 +1,7 @@
+-- name: CreateBuildArtifact :one
+INSERT INTO build_artifacts (
+  build_id, name, storage_key, size_bytes
+) VALUES (
+  $1, $2, $3, $4
+)
+RETURNING *;
@@ -0,0 +1,8 @@
+-- name: GetBuildArtifact :one
+SELECT * FROM build_artifacts
+WHERE build_id = $1 AND name = $2 LIMIT 1;
+
+-- name: GetBuildArtifactsByBuildID :many
+SELECT * FROM build_artifacts
+WHERE build_id = $1
+ORDER BY name;
@@ -0,0 +1,143 @@
+-- name: CreateBuild :one
+INSERT INTO builds (
+  config_id, owner_id, name, ref, commit_sha, pipeline_id, config_hash
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7
+)
+RETURNING *;
+
+-- name: FinishBuild :one
+UPDATE builds
+SET status = $2, error = $3, finished_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: AddBuildArtifactBytes :exec
+UPDATE builds
+SET artifact_bytes = artifact_bytes + $2
+WHERE id = $1;
+
+-- name: CreateBuildProvenance :one
+INSERT INTO build_provenance (
+  build_id, image, digest, predicate_type, storage_key
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+RETURNING *;
+
+-- name: CreateBuildImage :one
+INSERT INTO build_images (
+  build_id, image, digest
+) VALUES (
+  $1, $2, $3
+)
+RETURNING *;
+
+-- name: SetBuildImagesBranchDeleted :exec
+UPDATE build_images
+SET branch_deleted_at = NOW()
+FROM builds
+WHERE builds.id = build_images.build_id
+  AND builds.config_id = $1
+  AND builds.ref = $2
+  AND build_images.branch_deleted_at IS NULL;
+
+-- name: SetBuildImagesDeleted :exec
+UPDATE build_images
+SET deleted_at = NOW()
+WHERE id = ANY(@ids::bigint[]);
+
+-- name: CreatePipeline :one
+INSERT INTO pipelines (
+  config_id, ref, commit_sha
+) VALUES (
+  $1, $2, $3
+)
+RETURNING *;
+
+-- name: CreatePipelineStage :one
+INSERT INTO pipeline_stages (
+  pipeline_id, name, kind, needs
+) VALUES (
+  $1, $2, $3, $4
+)
+RETURNING *;
+
+-- name: StartPipelineStage :exec
+UPDATE pipeline_stages
+SET status = 'running', started_at = NOW()
+WHERE id = $1;
+
+-- name: FinishPipelineStage :exec
+UPDATE pipeline_stages
+SET status = $2, error = $3, finished_at = NOW()
+WHERE id = $1;
+
+-- name: SetPipelineStageBuild :exec
+UPDATE pipeline_stages
+SET build_id = $2
+WHERE id = $1;
+
+-- name: SetPipelineStageDeployment :exec
+UPDATE pipeline_stages
+SET deployment_id = $2
+WHERE id = $1;
+
+-- name: SkipPendingPipelineStages :exec
+UPDATE pipeline_stages
+SET status = 'skipped'
+WHERE pipeline_id = $1 AND status = 'pending';
+
+-- name: FinishPipeline :exec
+UPDATE pipelines
+SET status = $2, finished_at = NOW()
+WHERE id = $1;
+
+-- name: CreateBuildLog :exec
+INSERT INTO build_logs (
+  build_id, storage_key, truncated
+) VALUES (
+  $1, $2, $3
+)
+ON CONFLICT (build_id) DO UPDATE
+SET entries = '[]', storage_key = EXCLUDED.storage_key, truncated = EXCLUDED.truncated;
+
+-- name: AddBuildLogBytes :exec
+UPDATE builds
+SET log_bytes = log_bytes + $2
+WHERE id = $1;
+
+-- name: AddBuildTimings :exec
+UPDATE builds
+SET timings = timings || @timings::jsonb
+WHERE id = @id;
+
+-- name: DeleteBuild :exec
+-- Its log, artifacts, provenance and images are deleted with it
+DELETE FROM builds
+WHERE id = $1;
+
+-- name: DeleteBuildLog :exec
+-- The log no longer counts towards the owner's storage
+WITH deleted AS (
+  DELETE FROM build_logs
+  WHERE build_logs.build_id = $1
+  RETURNING build_logs.build_id
+)
+UPDATE builds
+SET log_bytes = 0
+FROM deleted
+WHERE builds.id = deleted.build_id;
+
+-- name: SetPipelineRenderedConfig :exec
+UPDATE pipelines
+SET rendered_config = $2
+WHERE id = $1;
+
+-- name: SaveCommitConfig :exec
+INSERT INTO commit_configs (
+  config_id, commit_sha, content
+) VALUES (
+  $1, $2, $3
+)
+ON CONFLICT (config_id, commit_sha) DO NOTHING;
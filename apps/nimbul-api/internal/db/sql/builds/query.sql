@@ -0,0 +1,94 @@
+-- name: GetBuildByID :one
+SELECT * FROM builds
+WHERE id = $1 LIMIT 1;
+
+-- name: GetBuildByIDAndOwner :one
+SELECT * FROM builds
+WHERE id = $1 AND owner_id = $2 LIMIT 1;
+
+-- name: GetBuildProvenanceByBuildID :many
+SELECT * FROM build_provenance
+WHERE build_id = $1
+ORDER BY image;
+
+-- name: GetLiveBuildImagesByConfigID :many
+SELECT
+  build_images.*,
+  builds.ref,
+  builds.commit_sha,
+  builds.started_at AS build_started_at
+FROM build_images
+JOIN builds ON builds.id = build_images.build_id
+WHERE builds.config_id = $1 AND build_images.deleted_at IS NULL
+ORDER BY builds.started_at DESC, build_images.id;
+
+-- name: GetBuildImagesByBuildIDs :many
+SELECT
+  build_images.*,
+  builds.ref,
+  builds.commit_sha,
+  builds.started_at AS build_started_at
+FROM build_images
+JOIN builds ON builds.id = build_images.build_id
+WHERE build_images.build_id = ANY(@build_ids::bigint[]) AND build_images.deleted_at IS NULL
+ORDER BY build_images.id;
+
+-- name: CountBuildImagesByDigest :one
+SELECT COUNT(*) FROM build_images
+WHERE digest = $1;
+
+-- name: GetLatestBuildByConfigID :one
+SELECT * FROM builds
+WHERE config_id = $1
+ORDER BY started_at DESC, id DESC
+LIMIT 1;
+
+-- name: GetReusableBuild :one
+SELECT * FROM builds
+WHERE config_id = $1 AND commit_sha = $2 AND name = $3 AND config_hash = $4
+  AND config_hash <> '' AND status = 'succeeded'
+  AND NOT EXISTS (
+    SELECT 1 FROM build_images
+    WHERE build_images.build_id = builds.id AND build_images.deleted_at IS NOT NULL
+  )
+ORDER BY started_at DESC, id DESC
+LIMIT 1;
+
+-- name: GetPipelineByID :one
+SELECT * FROM pipelines
+WHERE id = $1 LIMIT 1;
+
+-- name: GetPipelineStagesByPipelineID :many
+SELECT * FROM pipeline_stages
+WHERE pipeline_id = $1
+ORDER BY id;
+
+-- name: GetBuildLog :one
+SELECT * FROM build_logs
+WHERE build_id = $1;
+
+-- name: GetExpiredBuilds :many
+-- Finished builds older than the build retention of their owner, or of the instance
+SELECT builds.* FROM builds
+JOIN users ON users.id = builds.owner_id
+CROSS JOIN instance_limits
+WHERE builds.status <> 'running'
+  AND COALESCE(users.build_retention_days, instance_limits.build_retention_days) IS NOT NULL
+  AND builds.started_at < NOW() - make_interval(days => COALESCE(users.build_retention_days, instance_limits.build_retention_days))
+ORDER BY builds.id
+LIMIT $1;
+
+-- name: GetExpiredBuildLogs :many
+-- Stored logs older than the log retention of their build's owner, or of the instance
+SELECT build_logs.* FROM build_logs
+JOIN builds ON builds.id = build_logs.build_id
+JOIN users ON users.id = builds.owner_id
+CROSS JOIN instance_limits
+WHERE COALESCE(users.log_retention_days, instance_limits.log_retention_days) IS NOT NULL
+  AND build_logs.created_at < NOW() - make_interval(days => COALESCE(users.log_retention_days, instance_limits.log_retention_days))
+ORDER BY build_logs.build_id
+LIMIT $1;
+
+-- name: GetCommitConfig :one
+SELECT content FROM commit_configs
+WHERE config_id = $1 AND commit_sha = $2 LIMIT 1;
@@ -1,15 +1,153 @@
 -- name: CreateConfig :one
 INSERT INTO repo_configs (
     id, owner_id, provider, repo_owner, repo_name, repo_full_name, 
-    repo_clone_url, dockerfile_path, webhook_secret, webhook_id
+    repo_clone_url, dockerfile_path, webhook_secret, webhook_id, external_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
 )
 RETURNING *;
 
 -- name: UpdateConfigWebhookID :one
 UPDATE repo_configs
-SET webhook_id = $2, updated_at = NOW()
+SET webhook_id = $3, version = version + 1, updated_at = NOW()
+WHERE id = $1 AND owner_id = $2 AND deleted_at IS NULL
+RETURNING *;
+
+-- name: UpdateConfigClusterCredentialID :one
+UPDATE repo_configs
+SET cluster_credential_id = $2, version = version + 1, updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: UpdateConfigAgentID :one
+UPDATE repo_configs
+SET agent_id = $2, version = version + 1, updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: UpdateConfigRetention :one
+UPDATE repo_configs
+SET retention_keep_last = $2, retention_delete_previews = $3, version = version + 1, updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: UpdateConfigDeniedAuthors :one
+UPDATE repo_configs
+SET denied_authors = $2, version = version + 1, updated_at = NOW()
 WHERE id = $1
 RETURNING *;
 
+-- name: UpdateConfigSecretScan :one
+UPDATE repo_configs
+SET secret_scan = $2, secret_scan_allowlist = $3, version = version + 1, updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: UpdateConfigRegistryWebhookToken :one
+UPDATE repo_configs
+SET registry_webhook_token = $2, version = version + 1, updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: CreateConfigTransfer :one
+-- Starting a transfer replaces the pending transfer of the config, if any
+INSERT INTO config_transfers (
+    id, config_id, from_user_id, to_user_id
+) VALUES (
+    $1, $2, $3, $4
+)
+ON CONFLICT (config_id) DO UPDATE
+SET id = EXCLUDED.id, from_user_id = EXCLUDED.from_user_id, to_user_id = EXCLUDED.to_user_id, created_at = NOW()
+RETURNING *;
+
+-- name: DeleteConfigTransferByConfigID :execrows
+DELETE FROM config_transfers
+WHERE config_id = $1;
+
+-- name: DeleteConfigTransferForRecipient :execrows
+DELETE FROM config_transfers
+WHERE id = $1 AND to_user_id = $2;
+
+-- name: AcceptConfigTransfer :one
+-- Cluster credentials and agents belong to the previous owner, so the config stops
+-- using them. The deploy tokens the previous owner issued are revoked in the same
+-- statement, so they never work for the new owner's config.
+WITH transfer AS (
+    DELETE FROM config_transfers
+    WHERE config_transfers.id = $1 AND config_transfers.to_user_id = $2
+    RETURNING config_transfers.config_id, config_transfers.to_user_id
+), revoked AS (
+    DELETE FROM deploy_tokens
+    WHERE deploy_tokens.config_id IN (SELECT config_id FROM transfer)
+    RETURNING deploy_tokens.id
+)
+UPDATE repo_configs
+SET owner_id = transfer.to_user_id, cluster_credential_id = NULL, agent_id = NULL, version = version + 1, updated_at = NOW()
+FROM transfer
+WHERE repo_configs.id = transfer.config_id
+RETURNING sqlc.embed(repo_configs), (SELECT COUNT(*) FROM revoked) AS revoked_deploy_tokens;
+
+-- name: UpdateConfig :one
+-- Unset fields keep their value. Matches no row when expected_version is set and stale.
+UPDATE repo_configs
+SET
+  repo_clone_url = COALESCE(sqlc.narg('repo_clone_url'), repo_clone_url),
+  dockerfile_path = COALESCE(sqlc.narg('dockerfile_path'), dockerfile_path),
+  webhook_secret = COALESCE(sqlc.narg('webhook_secret'), webhook_secret),
+  version = version + 1,
+  updated_at = NOW()
+WHERE id = @id
+  AND deleted_at IS NULL
+  AND (sqlc.narg('expected_version')::integer IS NULL OR version = sqlc.narg('expected_version'))
+RETURNING *;
+
+-- name: DeleteConfig :execrows
+-- Moves the config to the trash, keeping its deployments until it is purged
+UPDATE repo_configs
+SET deleted_at = NOW(), version = version + 1, updated_at = NOW()
+WHERE id = @id AND deleted_at IS NULL
+  AND (sqlc.narg('expected_version')::integer IS NULL OR version = sqlc.narg('expected_version'));
+
+-- name: RestoreConfig :one
+UPDATE repo_configs
+SET deleted_at = NULL, version = version + 1, updated_at = NOW()
+WHERE id = $1 AND owner_id = $2 AND deleted_at IS NOT NULL
+RETURNING *;
+
+-- name: PurgeDeletedConfigs :execrows
+-- Deployments and transfers are deleted with the config; builds keep their usage
+DELETE FROM repo_configs
+WHERE deleted_at < $1;
+
+-- name: SetConfigEnv :exec
+INSERT INTO config_env (
+  config_id, name, value
+) VALUES (
+  $1, $2, $3
+)
+ON CONFLICT (config_id, name) DO UPDATE
+SET value = EXCLUDED.value, updated_at = NOW();
+
+-- name: DeleteConfigEnv :execrows
+DELETE FROM config_env
+WHERE config_id = $1 AND name = $2;
+
+-- name: UpdateConfigHealth :exec
+-- Health is observed rather than configured, so it leaves the version alone
+UPDATE repo_configs
+SET health = @health, health_detail = @health_detail, health_checked_at = NOW()
+WHERE id = @id;
+
+-- name: UpdateConfigRepository :one
+-- Follows a rename of the repository on GitHub
+UPDATE repo_configs
+SET repo_owner = @repo_owner, repo_name = @repo_name, repo_full_name = @repo_full_name,
+  repo_clone_url = @repo_clone_url, version = version + 1, updated_at = NOW()
+WHERE id = @id
+RETURNING *;
+
+-- name: ClearConfigWebhookID :execrows
+-- Only clears the webhook the caller found to be gone, not one created since
+UPDATE repo_configs
+SET webhook_id = NULL, version = version + 1, updated_at = NOW()
+WHERE id = @id AND webhook_id = @webhook_id;
@@ -1,17 +1,83 @@
 -- name: GetConfigByID :one
 SELECT * FROM repo_configs
-WHERE id = $1 LIMIT 1;
+WHERE id = $1 AND deleted_at IS NULL LIMIT 1;
+
+-- name: GetConfigByIDAndOwner :one
+-- Configs of other owners are not found, same as missing ones
+SELECT * FROM repo_configs
+WHERE id = $1 AND owner_id = $2 AND deleted_at IS NULL LIMIT 1;
 
 -- name: GetConfigsByOwnerID :many
 SELECT * FROM repo_configs
-WHERE owner_id = $1
+WHERE owner_id = $1 AND deleted_at IS NULL
 ORDER BY created_at DESC;
 
 -- name: GetConfigByOwnerIDAndRepoFullName :one
 SELECT * FROM repo_configs
-WHERE owner_id = $1 AND repo_full_name = $2 LIMIT 1;
+WHERE owner_id = $1 AND lower(repo_full_name) = lower(@repo_full_name::text) AND deleted_at IS NULL LIMIT 1;
+
+-- name: GetConfigByOwnerIDAndExternalID :one
+SELECT * FROM repo_configs
+WHERE owner_id = $1 AND external_id = $2 AND deleted_at IS NULL LIMIT 1;
+
+-- name: CountConfigsByClusterCredentialID :one
+SELECT COUNT(*) FROM repo_configs
+WHERE cluster_credential_id = $1 AND deleted_at IS NULL;
 
 -- name: GetConfigByWebhookID :one
 SELECT * FROM repo_configs
-WHERE webhook_id = $1 LIMIT 1;
+WHERE webhook_id = $1 AND deleted_at IS NULL LIMIT 1;
+
+
+-- name: GetAllConfigs :many
+SELECT * FROM repo_configs
+WHERE deleted_at IS NULL
+ORDER BY created_at;
+
+-- name: CountConfigsByOwnerID :one
+SELECT COUNT(*) FROM repo_configs
+WHERE owner_id = $1 AND deleted_at IS NULL;
+
+-- name: GetConfigsWithRetention :many
+SELECT * FROM repo_configs
+WHERE (retention_keep_last IS NOT NULL OR retention_delete_previews) AND deleted_at IS NULL
+ORDER BY created_at;
+
+-- name: GetDeletedConfigByIDAndOwner :one
+SELECT * FROM repo_configs
+WHERE id = $1 AND owner_id = $2 AND deleted_at IS NOT NULL LIMIT 1;
+
+-- name: GetDeletedConfigsByOwnerID :many
+SELECT * FROM repo_configs
+WHERE owner_id = $1 AND deleted_at IS NOT NULL
+ORDER BY deleted_at DESC;
+
+-- name: GetConfigTransferByID :one
+SELECT
+  config_transfers.*,
+  repo_configs.repo_full_name,
+  from_user.email AS from_email,
+  to_user.email AS to_email
+FROM config_transfers
+JOIN repo_configs ON repo_configs.id = config_transfers.config_id
+JOIN users from_user ON from_user.id = config_transfers.from_user_id
+JOIN users to_user ON to_user.id = config_transfers.to_user_id
+WHERE config_transfers.id = $1 LIMIT 1;
+
+-- name: GetConfigTransfersByToUserID :many
+SELECT
+  config_transfers.*,
+  repo_configs.repo_full_name,
+  from_user.email AS from_email,
+  to_user.email AS to_email
+FROM config_transfers
+JOIN repo_configs ON repo_configs.id = config_transfers.config_id
+JOIN users from_user ON from_user.id = config_transfers.from_user_id
+JOIN users to_user ON to_user.id = config_transfers.to_user_id
+WHERE config_transfers.to_user_id = $1
+ORDER BY config_transfers.created_at DESC;
 
+-- name: GetConfigEnv :many
+SELECT * FROM config_env
+WHERE config_id = $1
+ORDER BY name;
@@ -1,8 +1,8 @@
 -- name: CreateCredential :one
 INSERT INTO credentials (
-  owner_id, provider, token_type, ciphertext, token_nonce, wrapped_dek, dek_nonce, expires_at 
+  owner_id, provider, token_type, ciphertext, token_nonce, wrapped_dek, dek_nonce, expires_at, external_id
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8
+  $1, $2, $3, $4, $5, $6, $7, $8, $9
 )
 RETURNING *;
 
@@ -14,6 +14,55 @@ SET
   wrapped_dek = $6,
   dek_nonce = $7,
   expires_at = $8,
-  last_used_at = NOW()
-WHERE owner_id = $1 AND provider = $2 AND token_type = $3
+  expiry_notified_at = CASE WHEN expires_at IS DISTINCT FROM $8 THEN NULL ELSE expiry_notified_at END,
+  version = version + 1
+WHERE owner_id = $1 AND provider = $2 AND token_type = $3 AND deleted_at IS NULL
 RETURNING *;
+
+-- name: MarkCredentialExpiryNotified :exec
+UPDATE credentials
+SET expiry_notified_at = NOW()
+WHERE id = $1;
+
+-- name: UpdateCredentialByID :one
+-- Replaces the token of a credential. Matches no row when expected_version is set and stale.
+UPDATE credentials
+SET
+  ciphertext = @ciphertext,
+  token_nonce = @token_nonce,
+  wrapped_dek = @wrapped_dek,
+  dek_nonce = @dek_nonce,
+  expires_at = @expires_at,
+  expiry_notified_at = CASE WHEN expires_at IS DISTINCT FROM @expires_at THEN NULL ELSE expiry_notified_at END,
+  version = version + 1
+WHERE id = @id AND owner_id = @owner_id AND deleted_at IS NULL
+  AND (sqlc.narg('expected_version')::integer IS NULL OR version = sqlc.narg('expected_version'))
+RETURNING *;
+
+-- name: DeleteCredential :execrows
+-- Moves the credential to the trash until it is purged
+UPDATE credentials
+SET deleted_at = NOW(), version = version + 1
+WHERE id = @id AND owner_id = @owner_id AND deleted_at IS NULL
+  AND (sqlc.narg('expected_version')::integer IS NULL OR version = sqlc.narg('expected_version'));
+
+-- name: RestoreCredential :one
+UPDATE credentials
+SET deleted_at = NULL, version = version + 1
+WHERE id = $1 AND owner_id = $2 AND deleted_at IS NOT NULL
+RETURNING *;
+
+-- name: PurgeDeletedCredentials :execrows
+-- Configs deploying with a purged credential cannot deploy until one is connected
+DELETE FROM credentials
+WHERE deleted_at < $1;
+
+-- name: SetDefaultRegistryCredential :exec
+UPDATE users
+SET default_registry_credential_id = $2
+WHERE id = $1;
+
+-- name: TouchCredential :exec
+UPDATE credentials
+SET last_used_at = NOW()
+WHERE id = $1;
@@ -1,7 +1,42 @@
--- name: GetCredentialByOwnerIDAndTokenType :one
+-- name: GetCredentialByOwnerIDProviderAndTokenType :one
 SELECT * FROM credentials
-WHERE owner_id = $1 AND token_type = $2 LIMIT 1;
+WHERE owner_id = $1 AND provider = $2 AND token_type = $3 AND deleted_at IS NULL LIMIT 1;
+
+-- name: GetCredentialsByOwnerID :many
+SELECT * FROM credentials
+WHERE owner_id = $1 AND deleted_at IS NULL
+ORDER BY created_at;
 
 -- name: GetUniqueProvidersByOwnerID :many
 SELECT DISTINCT provider FROM credentials 
-WHERE owner_id = $1 AND (expires_at IS NULL OR expires_at > NOW());
\ No newline at end of file
+WHERE owner_id = $1 AND (expires_at IS NULL OR expires_at > NOW()) AND deleted_at IS NULL;
+
+-- name: GetCredentialByIDAndOwnerID :one
+SELECT * FROM credentials
+WHERE id = $1 AND owner_id = $2 AND deleted_at IS NULL LIMIT 1;
+
+
+-- name: GetUnnotifiedCredentialsExpiringBefore :many
+SELECT * FROM credentials
+WHERE expires_at IS NOT NULL AND expires_at <= $1
+  AND expiry_notified_at IS NULL
+  AND token_type <> 'oauth_access'
+  AND deleted_at IS NULL
+ORDER BY owner_id, expires_at;
+
+-- name: GetCredentialsExpiringBeforeByOwnerID :many
+SELECT * FROM credentials
+WHERE owner_id = $1 AND expires_at IS NOT NULL AND expires_at <= $2
+  AND token_type <> 'oauth_access'
+  AND deleted_at IS NULL
+ORDER BY expires_at;
+
+-- name: GetDefaultRegistryCredential :one
+SELECT credentials.* FROM credentials
+JOIN users ON users.default_registry_credential_id = credentials.id
+WHERE users.id = $1 AND credentials.deleted_at IS NULL LIMIT 1;
+
+-- name: GetDeletedCredentialsByOwnerID :many
+SELECT * FROM credentials
+WHERE owner_id = $1 AND deleted_at IS NOT NULL
+ORDER BY deleted_at DESC;
@@ -0,0 +1,6 @@
+-- name: CreateWebhookDelivery :exec
+INSERT INTO webhook_deliveries (
+  source, config_id, event_type, headers, payload, status_code, response
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7
+);
@@ -0,0 +1,40 @@
+-- name: CreateDeployment :one
+INSERT INTO deployments (
+  config_id, ref, commit_sha, deployed_by, rollback_of
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+RETURNING *;
+
+-- name: UpdateDeploymentResult :one
+UPDATE deployments
+SET status = $2, resources = $3, error = $4, updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: SetLiveDeployment :exec
+INSERT INTO deployment_environments (
+  config_id, namespace, branch, deployment_id, builds
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+ON CONFLICT (config_id, namespace) DO UPDATE
+SET branch = EXCLUDED.branch,
+    deployment_id = EXCLUDED.deployment_id,
+    builds = EXCLUDED.builds,
+    updated_at = NOW();
+
+-- name: DeleteDeploymentEnvironment :exec
+DELETE FROM deployment_environments
+WHERE config_id = $1 AND namespace = $2;
+
+-- name: CreateDeploymentManifest :exec
+INSERT INTO deployment_manifests (
+  deployment_id, position, path, storage_key
+) VALUES (
+  $1, $2, $3, $4
+);
+
+-- name: DeleteDeployment :exec
+DELETE FROM deployments
+WHERE id = $1;
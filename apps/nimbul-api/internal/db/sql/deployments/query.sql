@@ -0,0 +1,66 @@
+-- name: GetDeploymentByID :one
+SELECT * FROM deployments
+WHERE id = $1 LIMIT 1;
+
+-- name: GetDeploymentByIDAndOwner :one
+-- Deployments belong to the owner of their config
+SELECT deployments.* FROM deployments
+JOIN repo_configs ON repo_configs.id = deployments.config_id
+WHERE deployments.id = $1 AND repo_configs.owner_id = $2 AND repo_configs.deleted_at IS NULL
+LIMIT 1;
+
+-- name: GetDeploymentsByConfigID :many
+SELECT * FROM deployments
+WHERE config_id = $1
+ORDER BY created_at DESC
+LIMIT $2;
+
+-- name: ListDeploymentsByConfigID :many
+SELECT * FROM deployments
+WHERE config_id = @config_id
+  AND (sqlc.narg('before')::bigint IS NULL OR id < sqlc.narg('before'))
+ORDER BY id DESC
+LIMIT @max_results;
+
+-- name: GetRecentDeployments :many
+SELECT deployments.*, repo_configs.repo_full_name, repo_configs.owner_id, users.email AS owner_email
+FROM deployments
+JOIN repo_configs ON repo_configs.id = deployments.config_id
+JOIN users ON users.id = repo_configs.owner_id
+ORDER BY deployments.created_at DESC
+LIMIT $1;
+
+-- name: GetDeploymentEnvironmentsByConfigID :many
+SELECT deployment_environments.namespace, deployment_environments.branch,
+  deployment_environments.builds, deployment_environments.updated_at,
+  sqlc.embed(deployments)
+FROM deployment_environments
+JOIN deployments ON deployments.id = deployment_environments.deployment_id
+WHERE deployment_environments.config_id = $1
+ORDER BY deployment_environments.namespace;
+
+-- name: GetDeploymentManifestsByDeploymentID :many
+SELECT * FROM deployment_manifests
+WHERE deployment_id = $1
+ORDER BY position;
+
+-- name: GetExpiredDeployments :many
+-- Finished deployments older than the build retention of their config's owner, or of
+-- the instance. Deployments live in an environment and the latest of each config are kept.
+SELECT deployments.* FROM deployments
+JOIN repo_configs ON repo_configs.id = deployments.config_id
+JOIN users ON users.id = repo_configs.owner_id
+CROSS JOIN instance_limits
+WHERE deployments.status <> 'in_progress'
+  AND COALESCE(users.build_retention_days, instance_limits.build_retention_days) IS NOT NULL
+  AND deployments.created_at < NOW() - make_interval(days => COALESCE(users.build_retention_days, instance_limits.build_retention_days))
+  AND NOT EXISTS (
+    SELECT 1 FROM deployment_environments
+    WHERE deployment_environments.deployment_id = deployments.id
+  )
+  AND deployments.id <> (
+    SELECT MAX(latest.id) FROM deployments latest
+    WHERE latest.config_id = deployments.config_id
+  )
+ORDER BY deployments.id
+LIMIT $1;
@@ -0,0 +1,16 @@
+-- name: CreateDeployToken :one
+INSERT INTO deploy_tokens (
+  id, config_id, name, token_hash, created_by
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+RETURNING *;
+
+-- name: UpdateDeployTokenLastUsed :exec
+UPDATE deploy_tokens
+SET last_used_at = NOW()
+WHERE id = $1;
+
+-- name: DeleteDeployToken :execrows
+DELETE FROM deploy_tokens
+WHERE id = $1 AND config_id = $2;
@@ -0,0 +1,8 @@
+-- name: GetDeployTokensByConfigID :many
+SELECT * FROM deploy_tokens
+WHERE config_id = $1
+ORDER BY created_at DESC;
+
+-- name: GetDeployTokenByTokenHash :one
+SELECT * FROM deploy_tokens
+WHERE token_hash = $1 LIMIT 1;
@@ -0,0 +1,19 @@
+-- name: UpsertGitHubApp :one
+INSERT INTO github_app (
+  id, app_id, slug, client_id, ciphertext, token_nonce, wrapped_dek, dek_nonce
+) VALUES (
+  true, $1, $2, $3, $4, $5, $6, $7
+)
+ON CONFLICT (id) DO UPDATE
+SET app_id = EXCLUDED.app_id,
+    slug = EXCLUDED.slug,
+    client_id = EXCLUDED.client_id,
+    ciphertext = EXCLUDED.ciphertext,
+    token_nonce = EXCLUDED.token_nonce,
+    wrapped_dek = EXCLUDED.wrapped_dek,
+    dek_nonce = EXCLUDED.dek_nonce,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: DeleteGitHubApp :execrows
+DELETE FROM github_app;
@@ -0,0 +1,3 @@
+-- name: GetGitHubApp :one
+SELECT * FROM github_app
+LIMIT 1;
@@ -0,0 +1,16 @@
+-- name: CreateHook :one
+INSERT INTO hooks (
+  id, owner_id, url, secret, events
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+RETURNING *;
+
+-- name: UpdateHookDelivery :exec
+UPDATE hooks
+SET last_delivery_at = NOW(), last_status_code = $2, last_error = $3
+WHERE id = $1;
+
+-- name: DeleteHook :execrows
+DELETE FROM hooks
+WHERE id = $1 AND owner_id = $2;
@@ -0,0 +1,8 @@
+-- name: GetHookByID :one
+SELECT * FROM hooks
+WHERE id = $1 LIMIT 1;
+
+-- name: GetHooksByOwnerID :many
+SELECT * FROM hooks
+WHERE owner_id = $1
+ORDER BY created_at DESC;
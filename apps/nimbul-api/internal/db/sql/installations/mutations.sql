@@ -0,0 +1,46 @@
+-- name: UpsertGitHubInstallation :one
+INSERT INTO github_installations (
+  id, account_login, suspended_at
+) VALUES (
+  @id, @account_login, CASE WHEN @suspended::boolean THEN NOW() END
+)
+ON CONFLICT (id) DO UPDATE
+SET account_login = EXCLUDED.account_login,
+    suspended_at = CASE WHEN @suspended::boolean THEN COALESCE(github_installations.suspended_at, NOW()) END,
+    removed_at = NULL,
+    synced_at = NOW()
+RETURNING *;
+
+-- name: SetGitHubInstallationSuspended :execrows
+UPDATE github_installations
+SET suspended_at = CASE WHEN @suspended::boolean THEN COALESCE(suspended_at, NOW()) END
+WHERE id = @id;
+
+-- name: RemoveGitHubInstallation :execrows
+UPDATE github_installations
+SET removed_at = NOW()
+WHERE id = $1 AND removed_at IS NULL;
+
+-- name: RemoveGitHubInstallationsExcept :execrows
+-- Installations the app no longer lists were uninstalled while their events were missed
+UPDATE github_installations
+SET removed_at = NOW()
+WHERE removed_at IS NULL AND id <> ALL(@ids::bigint[]);
+
+-- name: AddInstallationRepositories :exec
+INSERT INTO installation_repositories (installation_id, repo_full_name)
+SELECT @installation_id, lower(name)
+FROM unnest(@repo_full_names::text[]) AS name
+ON CONFLICT DO NOTHING;
+
+-- name: RemoveInstallationRepositories :execrows
+DELETE FROM installation_repositories
+WHERE installation_id = @installation_id AND repo_full_name = ANY(@repo_full_names::text[]);
+
+-- name: DeleteInstallationRepositoriesExcept :execrows
+DELETE FROM installation_repositories
+WHERE installation_id = @installation_id AND repo_full_name <> ALL(@repo_full_names::text[]);
+
+-- name: DeleteInstallationRepositories :execrows
+DELETE FROM installation_repositories
+WHERE installation_id = $1;
@@ -0,0 +1,12 @@
+-- name: GetGitHubInstallationByAccount :one
+-- An account reinstalling the app gets a new installation; the current one comes first
+SELECT * FROM github_installations
+WHERE lower(account_login) = lower($1)
+ORDER BY removed_at IS NULL DESC, synced_at DESC
+LIMIT 1;
+
+-- name: HasInstallationRepository :one
+SELECT EXISTS (
+  SELECT 1 FROM installation_repositories
+  WHERE installation_id = $1 AND repo_full_name = lower(@repo_full_name::text)
+);
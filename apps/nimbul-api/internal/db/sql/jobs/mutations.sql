@@ -0,0 +1,50 @@
+-- name: CreateDeadJob :one
+INSERT INTO dead_jobs (
+  kind, config_id, payload, error, attempts
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+RETURNING *;
+
+-- name: MarkDeadJobRequeued :execrows
+-- Matches no row when the job was requeued already
+UPDATE dead_jobs
+SET requeued_at = NOW()
+WHERE id = $1 AND requeued_at IS NULL;
+
+-- name: EnqueueJob :exec
+INSERT INTO queued_jobs (
+  kind, config_id, payload, priority
+) VALUES (
+  $1, $2, $3, $4
+);
+
+-- name: ClaimNextJob :one
+-- Takes the queued job of the highest priority that is due and not leased by another
+-- worker; a worker that died lets its lease run out and the job is claimed again
+UPDATE queued_jobs
+SET claimed_until = NOW() + make_interval(secs => sqlc.arg(lease_seconds)::integer),
+    attempts = attempts + 1
+WHERE id = (
+  SELECT id FROM queued_jobs
+  WHERE run_after <= NOW() AND (claimed_until IS NULL OR claimed_until < NOW())
+  ORDER BY priority DESC, id
+  LIMIT 1
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: RenewJobClaim :execrows
+-- Matches no row once the job was removed from the queue
+UPDATE queued_jobs
+SET claimed_until = NOW() + make_interval(secs => sqlc.arg(lease_seconds)::integer)
+WHERE id = $1;
+
+-- name: RetryQueuedJob :exec
+UPDATE queued_jobs
+SET claimed_until = NULL, run_after = NOW() + make_interval(secs => sqlc.arg(delay_seconds)::integer)
+WHERE id = $1;
+
+-- name: DeleteQueuedJob :exec
+DELETE FROM queued_jobs
+WHERE id = $1;
@@ -0,0 +1,21 @@
+-- name: GetDeadJobByID :one
+SELECT dead_jobs.*, repo_configs.owner_id FROM dead_jobs
+JOIN repo_configs ON repo_configs.id = dead_jobs.config_id
+WHERE dead_jobs.id = $1 LIMIT 1;
+
+-- name: GetDeadJobsByOwnerID :many
+-- Requeued jobs are left out
+SELECT dead_jobs.*, repo_configs.owner_id FROM dead_jobs
+JOIN repo_configs ON repo_configs.id = dead_jobs.config_id
+WHERE repo_configs.owner_id = $1 AND dead_jobs.requeued_at IS NULL
+ORDER BY dead_jobs.created_at DESC;
+
+-- name: GetAllDeadJobs :many
+SELECT dead_jobs.*, repo_configs.owner_id FROM dead_jobs
+JOIN repo_configs ON repo_configs.id = dead_jobs.config_id
+WHERE dead_jobs.requeued_at IS NULL
+ORDER BY dead_jobs.created_at DESC;
+
+-- name: GetQueuedJobByID :one
+SELECT * FROM queued_jobs
+WHERE id = $1 LIMIT 1;
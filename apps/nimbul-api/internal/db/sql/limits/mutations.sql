@@ -0,0 +1,45 @@
+-- name: UpdateInstanceLimits :one
+INSERT INTO instance_limits (
+  id, max_configs_per_user, max_build_minutes,
+  build_minutes_soft_quota, build_minutes_hard_quota,
+  deploys_soft_quota, deploys_hard_quota,
+  storage_mb_soft_quota, storage_mb_hard_quota,
+  max_log_mb, max_artifact_mb,
+  config_build_minutes_soft_quota, config_build_minutes_hard_quota,
+  config_storage_mb_soft_quota, config_storage_mb_hard_quota,
+  build_retention_days, log_retention_days
+) VALUES (
+  true, $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16
+)
+ON CONFLICT (id) DO UPDATE
+SET max_configs_per_user = EXCLUDED.max_configs_per_user,
+    max_build_minutes = EXCLUDED.max_build_minutes,
+    build_minutes_soft_quota = EXCLUDED.build_minutes_soft_quota,
+    build_minutes_hard_quota = EXCLUDED.build_minutes_hard_quota,
+    deploys_soft_quota = EXCLUDED.deploys_soft_quota,
+    deploys_hard_quota = EXCLUDED.deploys_hard_quota,
+    storage_mb_soft_quota = EXCLUDED.storage_mb_soft_quota,
+    storage_mb_hard_quota = EXCLUDED.storage_mb_hard_quota,
+    max_log_mb = EXCLUDED.max_log_mb,
+    max_artifact_mb = EXCLUDED.max_artifact_mb,
+    config_build_minutes_soft_quota = EXCLUDED.config_build_minutes_soft_quota,
+    config_build_minutes_hard_quota = EXCLUDED.config_build_minutes_hard_quota,
+    config_storage_mb_soft_quota = EXCLUDED.config_storage_mb_soft_quota,
+    config_storage_mb_hard_quota = EXCLUDED.config_storage_mb_hard_quota,
+    build_retention_days = EXCLUDED.build_retention_days,
+    log_retention_days = EXCLUDED.log_retention_days,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: SetUserRetention :one
+UPDATE users
+SET build_retention_days = $2, log_retention_days = $3
+WHERE id = $1
+RETURNING *;
+
+-- name: CreateRetentionRun :exec
+INSERT INTO retention_runs (
+  builds_deleted, logs_deleted, bytes_reclaimed, started_at
+) VALUES (
+  $1, $2, $3, $4
+);
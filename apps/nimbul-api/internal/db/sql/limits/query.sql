@@ -0,0 +1,17 @@
+-- name: GetInstanceLimits :one
+SELECT * FROM instance_limits
+LIMIT 1;
+
+-- name: GetRetentionTotals :one
+SELECT
+  COUNT(*) AS runs,
+  COALESCE(SUM(builds_deleted), 0)::bigint AS builds_deleted,
+  COALESCE(SUM(logs_deleted), 0)::bigint AS logs_deleted,
+  COALESCE(SUM(bytes_reclaimed), 0)::bigint AS bytes_reclaimed,
+  MAX(finished_at)::timestamptz AS last_run_at
+FROM retention_runs;
+
+-- name: GetRecentRetentionRuns :many
+SELECT * FROM retention_runs
+ORDER BY id DESC
+LIMIT $1;
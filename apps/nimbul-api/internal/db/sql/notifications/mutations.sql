@@ -0,0 +1,13 @@
+-- name: UpsertNotificationPreferences :one
+INSERT INTO notification_preferences (
+  user_id, build_failed, deploy_failed, credential_expiry, slack_webhook_url
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+ON CONFLICT (user_id) DO UPDATE
+SET build_failed = EXCLUDED.build_failed,
+    deploy_failed = EXCLUDED.deploy_failed,
+    credential_expiry = EXCLUDED.credential_expiry,
+    slack_webhook_url = EXCLUDED.slack_webhook_url,
+    updated_at = NOW()
+RETURNING *;
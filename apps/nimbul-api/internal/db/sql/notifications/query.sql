@@ -0,0 +1,3 @@
+-- name: GetNotificationPreferences :one
+SELECT * FROM notification_preferences
+WHERE user_id = $1 LIMIT 1;
@@ -0,0 +1,13 @@
+-- name: UpsertDeployPolicy :one
+INSERT INTO deploy_policies (
+  owner_id, name, expression, message, action
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+ON CONFLICT (owner_id, name) DO UPDATE
+SET expression = EXCLUDED.expression, message = EXCLUDED.message, action = EXCLUDED.action, updated_at = NOW()
+RETURNING *;
+
+-- name: DeleteDeployPolicy :execrows
+DELETE FROM deploy_policies
+WHERE owner_id = $1 AND name = $2;
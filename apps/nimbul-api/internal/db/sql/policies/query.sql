@@ -0,0 +1,4 @@
+-- name: GetDeployPoliciesByOwnerID :many
+SELECT * FROM deploy_policies
+WHERE owner_id = $1
+ORDER BY name;
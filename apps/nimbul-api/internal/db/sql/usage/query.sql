@@ -0,0 +1,29 @@
+-- name: GetBuildUsageByOwnerIDSince :one
+SELECT
+  COUNT(*) AS builds,
+  COALESCE(SUM(EXTRACT(EPOCH FROM (COALESCE(finished_at, NOW()) - started_at))), 0)::bigint AS build_seconds
+FROM builds
+WHERE owner_id = $1 AND started_at >= $2;
+
+-- name: GetStorageUsageByOwnerID :one
+SELECT COALESCE(SUM(log_bytes + artifact_bytes), 0)::bigint AS storage_bytes
+FROM builds
+WHERE owner_id = $1;
+
+-- name: CountDeploymentsByOwnerIDSince :one
+SELECT COUNT(*) FROM deployments
+JOIN repo_configs ON repo_configs.id = deployments.config_id
+WHERE repo_configs.owner_id = $1 AND deployments.created_at >= $2;
+
+-- name: GetConfigUsageByOwnerIDSince :many
+SELECT
+  repo_configs.id AS config_id,
+  repo_configs.repo_full_name,
+  COUNT(builds.id) FILTER (WHERE builds.started_at >= $2) AS builds,
+  COALESCE(SUM(EXTRACT(EPOCH FROM (COALESCE(builds.finished_at, NOW()) - builds.started_at))) FILTER (WHERE builds.started_at >= $2), 0)::bigint AS build_seconds,
+  COALESCE(SUM(builds.log_bytes + builds.artifact_bytes), 0)::bigint AS storage_bytes
+FROM repo_configs
+LEFT JOIN builds ON builds.config_id = repo_configs.id
+WHERE repo_configs.owner_id = $1
+GROUP BY repo_configs.id
+ORDER BY repo_configs.repo_full_name;
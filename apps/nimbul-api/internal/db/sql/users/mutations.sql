@@ -5,3 +5,185 @@ INSERT INTO users (
   $1, $2, $3
 )
 RETURNING *;
+
+-- name: UpdateUserPassword :exec
+UPDATE users
+SET password_hash = $2, updated_at = NOW()
+WHERE id = $1;
+
+-- name: CreatePasswordReset :exec
+INSERT INTO password_resets (
+  token_hash, user_id, expires_at
+) VALUES (
+  $1, $2, $3
+);
+
+-- name: ClaimPasswordReset :one
+UPDATE password_resets
+SET used_at = NOW()
+WHERE token_hash = $1 AND used_at IS NULL AND expires_at > NOW()
+RETURNING *;
+
+-- name: UpdateUserRole :one
+UPDATE users
+SET role = $2, updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: UpdateUserDisabled :one
+UPDATE users
+SET disabled_at = CASE WHEN @disabled::boolean THEN COALESCE(disabled_at, NOW()) ELSE NULL END,
+    updated_at = NOW()
+WHERE id = @id AND deleted_at IS NULL
+RETURNING *;
+
+-- name: CreateOIDCUser :one
+-- Users created by OIDC sign-in have no password until they reset one
+INSERT INTO users (
+  id, email, password_hash, oidc_issuer, oidc_subject
+) VALUES (
+  $1, $2, '', $3, $4
+)
+RETURNING *;
+
+-- name: LinkUserOIDCIdentity :one
+UPDATE users
+SET oidc_issuer = $2, oidc_subject = $3, updated_at = NOW()
+WHERE id = $1 AND oidc_subject IS NULL
+RETURNING *;
+
+-- name: UpsertPendingUserTOTP :one
+-- Enrolling again replaces a pending enrollment; enabled TOTP is never replaced
+INSERT INTO user_totp (
+  user_id, ciphertext, token_nonce, wrapped_dek, dek_nonce
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+ON CONFLICT (user_id) DO UPDATE
+SET ciphertext = EXCLUDED.ciphertext, token_nonce = EXCLUDED.token_nonce,
+    wrapped_dek = EXCLUDED.wrapped_dek, dek_nonce = EXCLUDED.dek_nonce,
+    last_used_step = 0, created_at = NOW()
+WHERE user_totp.enabled_at IS NULL
+RETURNING *;
+
+-- name: EnableUserTOTP :exec
+UPDATE user_totp
+SET enabled_at = NOW()
+WHERE user_id = $1;
+
+-- name: UseUserTOTPStep :execrows
+-- Accepts a code only once: the step must be newer than the last accepted one
+UPDATE user_totp
+SET last_used_step = $2
+WHERE user_id = $1 AND last_used_step < $2;
+
+-- name: StartUserTOTPAttempt :one
+-- Counts a code attempt before the code is checked, so concurrent guesses count too.
+-- Returns no row while the enrollment is locked; a lock that ran out starts a new count.
+UPDATE user_totp
+SET failed_attempts = CASE WHEN locked_until IS NULL THEN failed_attempts + 1 ELSE 1 END,
+    locked_until = NULL
+WHERE user_id = $1 AND (locked_until IS NULL OR locked_until <= NOW())
+RETURNING failed_attempts;
+
+-- name: ResetUserTOTPAttempts :exec
+UPDATE user_totp
+SET failed_attempts = 0
+WHERE user_id = $1;
+
+-- name: LockUserTOTP :exec
+UPDATE user_totp
+SET locked_until = $2
+WHERE user_id = $1;
+
+-- name: DeleteUserTOTP :exec
+DELETE FROM user_totp
+WHERE user_id = $1;
+
+-- name: CreateRecoveryCode :exec
+INSERT INTO user_recovery_codes (
+  code_hash, user_id
+) VALUES (
+  $1, $2
+);
+
+-- name: DeleteRecoveryCodes :exec
+DELETE FROM user_recovery_codes
+WHERE user_id = $1;
+
+-- name: UseRecoveryCode :execrows
+UPDATE user_recovery_codes
+SET used_at = NOW()
+WHERE code_hash = $1 AND user_id = $2 AND used_at IS NULL;
+
+-- name: CreateSession :one
+INSERT INTO sessions (
+  id, user_id, user_agent, ip, expires_at
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+RETURNING *;
+
+-- name: TouchSession :exec
+UPDATE sessions
+SET last_used_at = NOW()
+WHERE id = $1;
+
+-- name: DeleteSession :execrows
+DELETE FROM sessions
+WHERE id = $1 AND user_id = $2;
+
+-- name: DeleteSessionsByUserID :exec
+DELETE FROM sessions
+WHERE user_id = $1;
+
+-- name: DeleteExpiredSessions :exec
+DELETE FROM sessions
+WHERE user_id = $1 AND expires_at <= NOW();
+
+-- name: DeleteOtherSessions :exec
+DELETE FROM sessions
+WHERE user_id = $1 AND id <> $2;
+
+-- name: CreateEmailChange :exec
+INSERT INTO email_changes (
+  token_hash, user_id, new_email, expires_at
+) VALUES (
+  $1, $2, $3, $4
+);
+
+-- name: ClaimEmailChange :one
+UPDATE email_changes
+SET used_at = NOW()
+WHERE token_hash = $1 AND used_at IS NULL AND expires_at > NOW()
+RETURNING *;
+
+-- name: UpdateUserEmail :one
+-- Only called with a confirmed address, which is verified with it
+UPDATE users
+SET email = $2, email_verified_at = NOW(), updated_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING *;
+
+-- name: MarkUserEmailVerified :one
+UPDATE users
+SET email_verified_at = COALESCE(email_verified_at, NOW()), updated_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING *;
+
+-- name: DeleteUser :execrows
+-- Cascades to everything the user owns. Builds are only checked at commit, see
+-- CreateDeletedUser
+DELETE FROM users
+WHERE id = $1;
+
+-- name: CreateDeletedUser :exec
+-- Deleted accounts keep a row so builds stay attributed for usage metering and expire
+-- with the user's retention, but nothing identifies the person or lets anyone log in
+INSERT INTO users (
+  id, email, password_hash, role, created_at, disabled_at, deleted_at,
+  build_retention_days, log_retention_days
+) VALUES (
+  @id, 'deleted-' || @id::text || '@deleted.invalid', '', @role, @created_at, NOW(), NOW(),
+  @build_retention_days, @log_retention_days
+);
@@ -4,4 +4,30 @@ WHERE email = $1 LIMIT 1;
 
 -- name: GetUserByID :one
 SELECT * FROM users
-WHERE id = $1 LIMIT 1;
\ No newline at end of file
+WHERE id = $1 LIMIT 1;
+-- name: GetUsers :many
+SELECT * FROM users
+WHERE deleted_at IS NULL
+ORDER BY created_at DESC;
+
+-- name: GetUserByOIDCIdentity :one
+SELECT * FROM users
+WHERE oidc_issuer = $1 AND oidc_subject = $2 LIMIT 1;
+
+-- name: GetUserTOTP :one
+SELECT * FROM user_totp
+WHERE user_id = $1 LIMIT 1;
+
+-- name: CountUnusedRecoveryCodes :one
+SELECT COUNT(*) FROM user_recovery_codes
+WHERE user_id = $1 AND used_at IS NULL;
+
+-- name: GetActiveSession :one
+SELECT * FROM sessions
+WHERE id = $1 AND user_id = $2 AND expires_at > NOW()
+LIMIT 1;
+
+-- name: GetActiveSessionsByUserID :many
+SELECT * FROM sessions
+WHERE user_id = $1 AND expires_at > NOW()
+ORDER BY last_used_at DESC;
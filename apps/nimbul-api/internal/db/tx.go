@@ -0,0 +1,33 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// beginner is implemented by pgxpool.Pool and pgx.Conn, and by pgx.Tx with a savepoint
+type beginner interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// InTx runs fn with queries in a transaction, which is committed when fn returns nil and
+// rolled back otherwise
+func (q *Queries) InTx(ctx context.Context, fn func(*Queries) error) error {
+	conn, ok := q.db.(beginner)
+	if !ok {
+		return errors.New("db: the connection cannot begin a transaction")
+	}
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := fn(q.WithTx(tx)); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
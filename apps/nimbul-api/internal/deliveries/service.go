@@ -0,0 +1,76 @@
+package deliveries
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/coding-cave-dev/nimbul/internal/db"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Webhook sources
+const (
+	SourceGitHub   = "github"
+	SourceRegistry = "registry"
+)
+
+type Service struct {
+	queries *db.Queries
+	capture bool
+}
+
+// NewService creates the service recording inbound webhook payloads. Capturing is
+// off unless NIMBUL_CAPTURE_WEBHOOK_PAYLOADS is "true", since payloads can hold
+// source code metadata and are kept until removed by hand.
+func NewService(queries *db.Queries) *Service {
+	return &Service{
+		queries: queries,
+		capture: os.Getenv("NIMBUL_CAPTURE_WEBHOOK_PAYLOADS") == "true",
+	}
+}
+
+// Enabled reports whether webhook payloads are captured
+func (s *Service) Enabled() bool {
+	return s.capture
+}
+
+type Delivery struct {
+	Source     string
+	ConfigID   string
+	EventType  string
+	Headers    map[string]string
+	Payload    []byte
+	StatusCode int
+	// Response is the body Nimbul answered a rejected delivery with
+	Response string
+}
+
+// Record stores an inbound webhook request and how it was answered. It does nothing
+// when capturing is off.
+func (s *Service) Record(ctx context.Context, delivery Delivery) error {
+	if !s.capture {
+		return nil
+	}
+
+	headers, err := json.Marshal(delivery.Headers)
+	if err != nil {
+		return fmt.Errorf("failed to encode delivery headers: %w", err)
+	}
+
+	err = s.queries.CreateWebhookDelivery(ctx, db.CreateWebhookDeliveryParams{
+		Source:     delivery.Source,
+		ConfigID:   delivery.ConfigID,
+		EventType:  pgtype.Text{String: delivery.EventType, Valid: delivery.EventType != ""},
+		Headers:    headers,
+		Payload:    delivery.Payload,
+		StatusCode: int32(delivery.StatusCode),
+		Response:   pgtype.Text{String: delivery.Response, Valid: delivery.Response != ""},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record webhook delivery: %w", err)
+	}
+
+	return nil
+}
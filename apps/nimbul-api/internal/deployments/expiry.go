@@ -0,0 +1,42 @@
+package deployments
+
+import (
+	"context"
+	"fmt"
+)
+
+// ExpiredBatch is how many expired deployments a single call deletes
+const ExpiredBatch = 100
+
+// DeleteExpiredDeployments deletes up to ExpiredBatch finished deployments older than
+// the build retention of their config's owner, with their manifests. Deployments live
+// in an environment and the latest deployment of each config are kept, so rollbacks
+// and port forwards keep working. Returns how many deployments were deleted.
+func (s *Service) DeleteExpiredDeployments(ctx context.Context) (int, error) {
+	expired, err := s.queries.GetExpiredDeployments(ctx, ExpiredBatch)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get expired deployments: %w", err)
+	}
+
+	deleted := 0
+	for _, deployment := range expired {
+		manifests, err := s.queries.GetDeploymentManifestsByDeploymentID(ctx, deployment.ID)
+		if err != nil {
+			return deleted, fmt.Errorf("failed to get manifests of deployment %d: %w", deployment.ID, err)
+		}
+		for _, manifest := range manifests {
+			if !manifest.StorageKey.Valid {
+				continue
+			}
+			if err := s.store.Delete(ctx, manifest.StorageKey.String); err != nil {
+				return deleted, fmt.Errorf("failed to delete manifest %s of deployment %d: %w", manifest.Path, deployment.ID, err)
+			}
+		}
+		if err := s.queries.DeleteDeployment(ctx, deployment.ID); err != nil {
+			return deleted, fmt.Errorf("failed to delete deployment %d: %w", deployment.ID, err)
+		}
+		deleted++
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,79 @@
+package deployments
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/coding-cave-dev/nimbul/internal/db"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Manifest is a manifest file of the repository as a deployment sent it to the
+// cluster, with overrides and templates applied
+type Manifest struct {
+	Path    string
+	Content string
+}
+
+// SaveManifests records the rendered manifests of a deployment, in the order they are
+// applied. Their contents are kept in storage.
+func (s *Service) SaveManifests(ctx context.Context, deploymentID int64, manifests []Manifest) error {
+	for i, manifest := range manifests {
+		key := manifestKey(deploymentID, i)
+		if err := s.store.Put(ctx, key, bytes.NewReader([]byte(manifest.Content)), int64(len(manifest.Content))); err != nil {
+			return fmt.Errorf("failed to store manifest %s: %w", manifest.Path, err)
+		}
+
+		err := s.queries.CreateDeploymentManifest(ctx, db.CreateDeploymentManifestParams{
+			DeploymentID: deploymentID,
+			Position:     int32(i),
+			Path:         manifest.Path,
+			StorageKey:   pgtype.Text{String: key, Valid: true},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record manifest %s: %w", manifest.Path, err)
+		}
+	}
+	return nil
+}
+
+// ListManifests returns the rendered manifests of a deployment in the order they were
+// applied. Deployments from before manifests were recorded have none.
+func (s *Service) ListManifests(ctx context.Context, deploymentID int64) ([]Manifest, error) {
+	rows, err := s.queries.GetDeploymentManifestsByDeploymentID(ctx, deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deployment manifests: %w", err)
+	}
+
+	manifests := make([]Manifest, len(rows))
+	for i, row := range rows {
+		// Manifests recorded before they were kept in storage are stored inline
+		content := row.Content
+		if row.StorageKey.Valid {
+			data, err := s.readObject(ctx, row.StorageKey.String)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read manifest %s: %w", row.Path, err)
+			}
+			content = string(data)
+		}
+		manifests[i] = Manifest{Path: row.Path, Content: content}
+	}
+	return manifests, nil
+}
+
+// manifestKey is the storage key of the manifest a deployment applied at position
+func manifestKey(deploymentID int64, position int) string {
+	return fmt.Sprintf("deployments/%d/manifests/%d.yaml", deploymentID, position)
+}
+
+// readObject reads an object the service keeps in storage
+func (s *Service) readObject(ctx context.Context, key string) ([]byte, error) {
+	contents, err := s.store.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer contents.Close()
+	return io.ReadAll(contents)
+}
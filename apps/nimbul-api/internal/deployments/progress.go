@@ -0,0 +1,83 @@
+package deployments
+
+import (
+	"sync"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/k8s"
+)
+
+// Progress event types streamed while a deployment rolls out. Pipeline events such as
+// deploy.started and deploy.failed are streamed with their hook event type.
+const (
+	ProgressDeployment      = "deployment" // status of the deployment when the stream starts
+	ProgressResourceApplied = "resource.applied"
+	ProgressPolicyWarning   = "policy.warning" // a manifest violates a policy that only warns
+	ProgressResources       = "resources"      // live status of every applied resource
+	ProgressDone            = "done"
+)
+
+// progressBuffer is how many events a slow subscriber may fall behind before events
+// are dropped for it
+const progressBuffer = 64
+
+// ProgressEvent is a step of a deployment's rollout
+type ProgressEvent struct {
+	Type      string               `json:"type"`
+	Time      time.Time            `json:"time"`
+	Resource  *k8s.ResourceRef     `json:"resource,omitempty"`
+	Resources []k8s.ResourceStatus `json:"resources,omitempty"`
+	Status    string               `json:"status,omitempty"`
+	Ready     bool                 `json:"ready,omitempty"`
+	Error     string               `json:"error,omitempty"`
+	Message   string               `json:"message,omitempty"`
+}
+
+// progress fans out the events of running deployments to their subscribers. Deploys
+// run in the API server process, so subscribers of this process see every event.
+type progress struct {
+	mu          sync.Mutex
+	subscribers map[int64]map[chan ProgressEvent]struct{}
+}
+
+// PublishProgress sends an event to the subscribers of a deployment without blocking
+func (s *Service) PublishProgress(deploymentID int64, event ProgressEvent) {
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+
+	s.progress.mu.Lock()
+	defer s.progress.mu.Unlock()
+
+	for ch := range s.progress.subscribers[deploymentID] {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+// SubscribeProgress returns the events published for a deployment from now on, and a
+// function to stop receiving them
+func (s *Service) SubscribeProgress(deploymentID int64) (<-chan ProgressEvent, func()) {
+	ch := make(chan ProgressEvent, progressBuffer)
+
+	s.progress.mu.Lock()
+	if s.progress.subscribers[deploymentID] == nil {
+		s.progress.subscribers[deploymentID] = make(map[chan ProgressEvent]struct{})
+	}
+	s.progress.subscribers[deploymentID][ch] = struct{}{}
+	s.progress.mu.Unlock()
+
+	unsubscribe := func() {
+		s.progress.mu.Lock()
+		defer s.progress.mu.Unlock()
+
+		delete(s.progress.subscribers[deploymentID], ch)
+		if len(s.progress.subscribers[deploymentID]) == 0 {
+			delete(s.progress.subscribers, deploymentID)
+		}
+	}
+
+	return ch, unsubscribe
+}
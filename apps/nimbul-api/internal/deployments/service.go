@@ -0,0 +1,291 @@
+package deployments
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/db"
+	"github.com/coding-cave-dev/nimbul/internal/k8s"
+	"github.com/coding-cave-dev/nimbul/internal/storage"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Deployment statuses
+const (
+	StatusInProgress = "in_progress"
+	StatusSucceeded  = "succeeded"
+	StatusFailed     = "failed"
+)
+
+var ErrDeploymentNotFound = errors.New("deployment not found")
+
+type Service struct {
+	queries  *db.Queries
+	store    storage.Store
+	progress *progress
+}
+
+func NewService(queries *db.Queries, store storage.Store) *Service {
+	return &Service{
+		queries:  queries,
+		store:    store,
+		progress: &progress{subscribers: make(map[int64]map[chan ProgressEvent]struct{})},
+	}
+}
+
+type Deployment struct {
+	ID         int64
+	ConfigID   string
+	Ref        string
+	CommitSHA  string
+	Status     string
+	Resources  []k8s.ResourceRef
+	Error      string
+	DeployedBy string
+	RollbackOf *int64 // deployment a rollback went back to
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type CreateDeploymentParams struct {
+	ConfigID   string
+	Ref        string
+	CommitSHA  string
+	DeployedBy string
+	RollbackOf int64 // 0 unless the deploy is a rollback
+}
+
+// CreateDeployment records the start of a deploy for a config
+func (s *Service) CreateDeployment(ctx context.Context, params CreateDeploymentParams) (*Deployment, error) {
+	deployment, err := s.queries.CreateDeployment(ctx, db.CreateDeploymentParams{
+		ConfigID:   params.ConfigID,
+		Ref:        params.Ref,
+		CommitSha:  params.CommitSHA,
+		DeployedBy: params.DeployedBy,
+		RollbackOf: pgtype.Int8{Int64: params.RollbackOf, Valid: params.RollbackOf != 0},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create deployment: %w", err)
+	}
+
+	return dbDeploymentToDeployment(deployment)
+}
+
+// CompleteDeployment records the outcome of a deploy and the resources it applied.
+// A nil deployErr marks the deployment as succeeded.
+func (s *Service) CompleteDeployment(ctx context.Context, id int64, resources []k8s.ResourceRef, deployErr error) error {
+	if resources == nil {
+		resources = []k8s.ResourceRef{}
+	}
+
+	resourcesJSON, err := json.Marshal(resources)
+	if err != nil {
+		return fmt.Errorf("failed to encode deployment resources: %w", err)
+	}
+
+	status := StatusSucceeded
+	errText := pgtype.Text{}
+	if deployErr != nil {
+		status = StatusFailed
+		errText = pgtype.Text{String: deployErr.Error(), Valid: true}
+	}
+
+	_, err = s.queries.UpdateDeploymentResult(ctx, db.UpdateDeploymentResultParams{
+		ID:        id,
+		Status:    status,
+		Resources: resourcesJSON,
+		Error:     errText,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update deployment: %w", err)
+	}
+
+	return nil
+}
+
+// Environment is where a config is deployed and the deployment live there
+type Environment struct {
+	Namespace  string // preview namespace, empty for the namespaces the manifests name
+	Branch     string // branch last deployed there
+	Deployment Deployment
+	Builds     map[string]int64 // build ID of each build name the deployment used
+	UpdatedAt  time.Time
+}
+
+// SetLiveDeployment records a succeeded deployment as the one live in namespace,
+// empty for the namespaces the manifests name
+func (s *Service) SetLiveDeployment(ctx context.Context, configID, namespace, branch string, deploymentID int64, builds map[string]int64) error {
+	if builds == nil {
+		builds = map[string]int64{}
+	}
+
+	buildsJSON, err := json.Marshal(builds)
+	if err != nil {
+		return fmt.Errorf("failed to encode deployment builds: %w", err)
+	}
+
+	err = s.queries.SetLiveDeployment(ctx, db.SetLiveDeploymentParams{
+		ConfigID:     configID,
+		Namespace:    namespace,
+		Branch:       branch,
+		DeploymentID: deploymentID,
+		Builds:       buildsJSON,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record live deployment: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteEnvironment forgets the environment of a preview namespace once it is deleted
+func (s *Service) DeleteEnvironment(ctx context.Context, configID, namespace string) error {
+	err := s.queries.DeleteDeploymentEnvironment(ctx, db.DeleteDeploymentEnvironmentParams{
+		ConfigID:  configID,
+		Namespace: namespace,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete environment: %w", err)
+	}
+
+	return nil
+}
+
+// ListEnvironments returns the environments of a config with the deployment live in
+// each, the namespaces the manifests name first
+func (s *Service) ListEnvironments(ctx context.Context, configID string) ([]Environment, error) {
+	rows, err := s.queries.GetDeploymentEnvironmentsByConfigID(ctx, configID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get environments: %w", err)
+	}
+
+	environments := make([]Environment, 0, len(rows))
+	for _, row := range rows {
+		deployment, err := dbDeploymentToDeployment(row.Deployment)
+		if err != nil {
+			return nil, err
+		}
+
+		var builds map[string]int64
+		if err := json.Unmarshal(row.Builds, &builds); err != nil {
+			return nil, fmt.Errorf("failed to decode deployment builds: %w", err)
+		}
+
+		environments = append(environments, Environment{
+			Namespace:  row.Namespace,
+			Branch:     row.Branch,
+			Deployment: *deployment,
+			Builds:     builds,
+			UpdatedAt:  row.UpdatedAt.Time,
+		})
+	}
+
+	return environments, nil
+}
+
+// GetDeploymentByID retrieves a deployment by its ID
+func (s *Service) GetDeploymentByID(ctx context.Context, id int64) (*Deployment, error) {
+	deployment, err := s.queries.GetDeploymentByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrDeploymentNotFound
+		}
+		return nil, fmt.Errorf("failed to get deployment: %w", err)
+	}
+
+	return dbDeploymentToDeployment(deployment)
+}
+
+// GetDeploymentByIDAndOwner retrieves a deployment of a config of ownerID; deployments
+// of other owners' configs are not found
+func (s *Service) GetDeploymentByIDAndOwner(ctx context.Context, id int64, ownerID string) (*Deployment, error) {
+	deployment, err := s.queries.GetDeploymentByIDAndOwner(ctx, db.GetDeploymentByIDAndOwnerParams{
+		ID:      id,
+		OwnerID: ownerID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrDeploymentNotFound
+		}
+		return nil, fmt.Errorf("failed to get deployment: %w", err)
+	}
+
+	return dbDeploymentToDeployment(deployment)
+}
+
+// GetDeploymentsByConfigID retrieves the most recent deployments of a config, newest first
+func (s *Service) GetDeploymentsByConfigID(ctx context.Context, configID string, limit int32) ([]Deployment, error) {
+	deployments, err := s.queries.GetDeploymentsByConfigID(ctx, db.GetDeploymentsByConfigIDParams{
+		ConfigID: configID,
+		Limit:    limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deployments: %w", err)
+	}
+
+	result := make([]Deployment, len(deployments))
+	for i, d := range deployments {
+		deployment, err := dbDeploymentToDeployment(d)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = *deployment
+	}
+
+	return result, nil
+}
+
+// ListDeployments returns a page of the deployments of a config, newest first. before
+// is the ID of the last deployment of the previous page, or 0 for the first page.
+func (s *Service) ListDeployments(ctx context.Context, configID string, before int64, limit int32) ([]Deployment, error) {
+	deployments, err := s.queries.ListDeploymentsByConfigID(ctx, db.ListDeploymentsByConfigIDParams{
+		ConfigID:   configID,
+		Before:     pgtype.Int8{Int64: before, Valid: before > 0},
+		MaxResults: limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deployments: %w", err)
+	}
+
+	result := make([]Deployment, len(deployments))
+	for i, d := range deployments {
+		deployment, err := dbDeploymentToDeployment(d)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = *deployment
+	}
+
+	return result, nil
+}
+
+// dbDeploymentToDeployment converts a db.Deployment to a deployments.Deployment
+func dbDeploymentToDeployment(dbDeployment db.Deployment) (*Deployment, error) {
+	var resources []k8s.ResourceRef
+	if err := json.Unmarshal(dbDeployment.Resources, &resources); err != nil {
+		return nil, fmt.Errorf("failed to decode deployment resources: %w", err)
+	}
+
+	var rollbackOf *int64
+	if dbDeployment.RollbackOf.Valid {
+		rollbackOf = &dbDeployment.RollbackOf.Int64
+	}
+
+	return &Deployment{
+		ID:         dbDeployment.ID,
+		ConfigID:   dbDeployment.ConfigID,
+		Ref:        dbDeployment.Ref,
+		CommitSHA:  dbDeployment.CommitSha,
+		Status:     dbDeployment.Status,
+		Resources:  resources,
+		Error:      dbDeployment.Error.String,
+		DeployedBy: dbDeployment.DeployedBy,
+		RollbackOf: rollbackOf,
+		CreatedAt:  dbDeployment.CreatedAt.Time,
+		UpdatedAt:  dbDeployment.UpdatedAt.Time,
+	}, nil
+}
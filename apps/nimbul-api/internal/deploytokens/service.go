@@ -0,0 +1,165 @@
+package deploytokens
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/oklog/ulid/v2"
+)
+
+// tokenPrefix makes deploy tokens recognisable (and distinguishable from user JWTs and
+// agent tokens)
+const tokenPrefix = "nbl_deploy_"
+
+// tokenTouchInterval limits how often last use is recorded, so CI systems polling the
+// deploy status do not write to the database on every request
+const tokenTouchInterval = time.Minute
+
+var (
+	ErrInvalidToken  = errors.New("invalid deploy token")
+	ErrTokenNotFound = errors.New("deploy token not found")
+	ErrNameTaken     = errors.New("the config already has a deploy token with this name")
+)
+
+type Service struct {
+	queries *db.Queries
+}
+
+func NewService(queries *db.Queries) *Service {
+	return &Service{
+		queries: queries,
+	}
+}
+
+// DeployToken lets an external CI system trigger and follow the deploys of one config
+type DeployToken struct {
+	ID         string
+	ConfigID   string
+	Name       string
+	CreatedBy  string // user who created the token
+	LastUsedAt *time.Time
+	CreatedAt  time.Time
+}
+
+type CreateResult struct {
+	DeployToken
+	// Token is only returned once, at creation; the database stores its hash
+	Token string
+}
+
+// Create issues a deploy token for configID, created by userID
+func (s *Service) Create(ctx context.Context, configID, userID, name string) (*CreateResult, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("failed to generate deploy token: %w", err)
+	}
+	token := tokenPrefix + hex.EncodeToString(secret)
+
+	deployToken, err := s.queries.CreateDeployToken(ctx, db.CreateDeployTokenParams{
+		ID:        ulid.Make().String(),
+		ConfigID:  configID,
+		Name:      name,
+		TokenHash: hashToken(token),
+		CreatedBy: userID,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return nil, ErrNameTaken
+		}
+		return nil, fmt.Errorf("failed to create deploy token: %w", err)
+	}
+
+	return &CreateResult{
+		DeployToken: *dbDeployTokenToDeployToken(deployToken),
+		Token:       token,
+	}, nil
+}
+
+// List returns the deploy tokens of a config, newest first
+func (s *Service) List(ctx context.Context, configID string) ([]DeployToken, error) {
+	deployTokens, err := s.queries.GetDeployTokensByConfigID(ctx, configID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deploy tokens: %w", err)
+	}
+
+	result := make([]DeployToken, len(deployTokens))
+	for i, t := range deployTokens {
+		result[i] = *dbDeployTokenToDeployToken(t)
+	}
+	return result, nil
+}
+
+// Delete revokes a deploy token of a config; tokens of other configs are not found
+func (s *Service) Delete(ctx context.Context, configID, id string) error {
+	rows, err := s.queries.DeleteDeployToken(ctx, db.DeleteDeployTokenParams{
+		ID:       id,
+		ConfigID: configID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete deploy token: %w", err)
+	}
+	if rows == 0 {
+		return ErrTokenNotFound
+	}
+	return nil
+}
+
+// Authenticate resolves the deploy token whose secret is token and records that it was used
+func (s *Service) Authenticate(ctx context.Context, token string) (*DeployToken, error) {
+	deployToken, err := s.queries.GetDeployTokenByTokenHash(ctx, hashToken(token))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInvalidToken
+		}
+		return nil, fmt.Errorf("failed to get deploy token: %w", err)
+	}
+
+	s.touch(deployToken)
+
+	return dbDeployTokenToDeployToken(deployToken), nil
+}
+
+// touch records that a deploy token was used, in the background so that requests are
+// not held up by the write
+func (s *Service) touch(deployToken db.DeployToken) {
+	if deployToken.LastUsedAt.Valid && time.Since(deployToken.LastUsedAt.Time) < tokenTouchInterval {
+		return
+	}
+
+	go func() {
+		if err := s.queries.UpdateDeployTokenLastUsed(context.Background(), deployToken.ID); err != nil {
+			fmt.Printf("Warning: Failed to record use of deploy token %s: %v\n", deployToken.ID, err)
+		}
+	}()
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// dbDeployTokenToDeployToken converts a db.DeployToken to a deploytokens.DeployToken
+func dbDeployTokenToDeployToken(dbDeployToken db.DeployToken) *DeployToken {
+	var lastUsedAt *time.Time
+	if dbDeployToken.LastUsedAt.Valid {
+		lastUsedAt = &dbDeployToken.LastUsedAt.Time
+	}
+
+	return &DeployToken{
+		ID:         dbDeployToken.ID,
+		ConfigID:   dbDeployToken.ConfigID,
+		Name:       dbDeployToken.Name,
+		CreatedBy:  dbDeployToken.CreatedBy,
+		LastUsedAt: lastUsedAt,
+		CreatedAt:  dbDeployToken.CreatedAt.Time,
+	}
+}
@@ -0,0 +1,88 @@
+package email
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"os"
+	"strings"
+	"time"
+)
+
+var ErrNotConfigured = errors.New("email is not configured (set SMTP_HOST)")
+
+type Sender struct {
+	Host     string // e.g. smtp.example.com; empty disables email
+	Port     string // e.g. 587; the connection is upgraded with STARTTLS when offered
+	Username string // optional, enables PLAIN auth
+	Password string
+	From     string // e.g. "Nimbul <nimbul@example.com>"
+}
+
+func NewFromEnv() *Sender {
+	port := os.Getenv("SMTP_PORT")
+	if port == "" {
+		port = "587"
+	}
+	from := os.Getenv("SMTP_FROM")
+	if from == "" {
+		from = os.Getenv("SMTP_USERNAME")
+	}
+	return &Sender{
+		Host:     os.Getenv("SMTP_HOST"),
+		Port:     port,
+		Username: os.Getenv("SMTP_USERNAME"),
+		Password: os.Getenv("SMTP_PASSWORD"),
+		From:     from,
+	}
+}
+
+// Enabled reports whether an SMTP server is configured
+func (s *Sender) Enabled() bool {
+	return s.Host != ""
+}
+
+// Send sends a plain-text email to a single recipient
+func (s *Sender) Send(to, subject, body string) error {
+	if !s.Enabled() {
+		return ErrNotConfigured
+	}
+
+	// Header injection guard: recipients and subjects must be single lines
+	if strings.ContainsAny(to, "\r\n") || strings.ContainsAny(subject, "\r\n") {
+		return fmt.Errorf("invalid email header value")
+	}
+
+	var auth smtp.Auth
+	if s.Username != "" {
+		auth = smtp.PlainAuth("", s.Username, s.Password, s.Host)
+	}
+
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "From: %s\r\n", s.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", to)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", subject)
+	fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	msg.WriteString("\r\n")
+	msg.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+
+	addr := net.JoinHostPort(s.Host, s.Port)
+	if err := smtp.SendMail(addr, auth, envelopeAddress(s.From), []string{to}, []byte(msg.String())); err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+
+	return nil
+}
+
+// envelopeAddress extracts the bare address from a "Name <address>" header value
+func envelopeAddress(from string) string {
+	if start := strings.LastIndex(from, "<"); start != -1 {
+		if end := strings.LastIndex(from, ">"); end > start {
+			return from[start+1 : end]
+		}
+	}
+	return from
+}
@@ -3,11 +3,7 @@ package github
 import (
 	"context"
 	"crypto/rsa"
-	"crypto/x509"
-	"encoding/pem"
 	"fmt"
-	"os"
-	"strconv"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -21,46 +17,23 @@ type AppAuth struct {
 	installationID int64
 }
 
-// NewAppAuth creates a new AppAuth instance from environment variables
-func NewAppAuth(installationID int64) (*AppAuth, error) {
-	// Get app credentials from environment
-	appIDStr := os.Getenv("GITHUB_APP_ID")
-	if appIDStr == "" {
-		return nil, fmt.Errorf("GITHUB_APP_ID environment variable is not set")
-	}
-
-	appID, err := strconv.ParseInt(appIDStr, 10, 64)
+// NewAppAuth creates a new AppAuth instance from the app configuration
+func NewAppAuth(ctx context.Context, installationID int64) (*AppAuth, error) {
+	config, err := LoadAppConfig(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("invalid GITHUB_APP_ID: %w", err)
-	}
-
-	privateKeyPEM := os.Getenv("GITHUB_APP_PRIVATE_KEY")
-	if privateKeyPEM == "" {
-		return nil, fmt.Errorf("GITHUB_APP_PRIVATE_KEY environment variable is not set")
+		return nil, err
 	}
-
-	// Parse private key
-	block, _ := pem.Decode([]byte(privateKeyPEM))
-	if block == nil {
-		return nil, fmt.Errorf("failed to parse private key PEM")
+	if config.AppID == 0 || config.PrivateKey == "" {
+		return nil, ErrAppNotConfigured
 	}
 
-	privateKey, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	privateKey, err := ParsePrivateKey(config.PrivateKey)
 	if err != nil {
-		// Try PKCS8 format
-		key, err2 := x509.ParsePKCS8PrivateKey(block.Bytes)
-		if err2 != nil {
-			return nil, fmt.Errorf("failed to parse private key: %w", err)
-		}
-		var ok bool
-		privateKey, ok = key.(*rsa.PrivateKey)
-		if !ok {
-			return nil, fmt.Errorf("private key is not RSA")
-		}
+		return nil, err
 	}
 
 	return &AppAuth{
-		appID:          appID,
+		appID:          config.AppID,
 		privateKey:     privateKey,
 		installationID: installationID,
 	}, nil
@@ -68,7 +41,45 @@ func NewAppAuth(installationID int64) (*AppAuth, error) {
 
 // GetInstallationToken generates a JWT and exchanges it for an installation token
 func (a *AppAuth) GetInstallationToken(ctx context.Context) (string, error) {
-	// Generate JWT for app authentication
+	installationToken, err := a.createInstallationToken(ctx, &github.InstallationTokenOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	return installationToken.GetToken(), nil
+}
+
+// GetRepositoryToken creates an installation token that can only access one repository
+// of the installation. It expires after an hour.
+func (a *AppAuth) GetRepositoryToken(ctx context.Context, repo string) (token string, expiresAt time.Time, err error) {
+	installationToken, err := a.createInstallationToken(ctx, &github.InstallationTokenOptions{
+		Repositories: []string{repo},
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	return installationToken.GetToken(), installationToken.GetExpiresAt().Time, nil
+}
+
+// createInstallationToken generates a JWT and exchanges it for an installation token
+func (a *AppAuth) createInstallationToken(ctx context.Context, opts *github.InstallationTokenOptions) (*github.InstallationToken, error) {
+	appClient, err := a.appClient()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get installation token
+	installationToken, _, err := appClient.Apps.CreateInstallationToken(ctx, a.installationID, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create installation token: %w", err)
+	}
+
+	return installationToken, nil
+}
+
+// appClient creates a GitHub client authenticated as the app itself with a JWT
+func (a *AppAuth) appClient() (*github.Client, error) {
 	now := time.Now()
 	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
 		"iat": now.Add(-60 * time.Second).Unix(), // Issued at time (60 seconds ago to account for clock skew)
@@ -78,19 +89,111 @@ func (a *AppAuth) GetInstallationToken(ctx context.Context) (string, error) {
 
 	jwtToken, err := token.SignedString(a.privateKey)
 	if err != nil {
-		return "", fmt.Errorf("failed to sign JWT: %w", err)
+		return nil, fmt.Errorf("failed to sign JWT: %w", err)
 	}
 
-	// Create GitHub client with app JWT
-	appClient := github.NewClient(nil).WithAuthToken(jwtToken)
+	return github.NewClient(nil).WithAuthToken(jwtToken), nil
+}
 
-	// Get installation token
-	installationToken, _, err := appClient.Apps.CreateInstallationToken(ctx, a.installationID, &github.InstallationTokenOptions{})
+// HasAccountInstallation reports whether the app is installed on a user or organization
+// account, whatever repositories the installation has access to
+func (a *AppAuth) HasAccountInstallation(ctx context.Context, account string) (bool, error) {
+	appClient, err := a.appClient()
 	if err != nil {
-		return "", fmt.Errorf("failed to create installation token: %w", err)
+		return false, err
 	}
 
-	return installationToken.GetToken(), nil
+	_, _, err = appClient.Apps.FindUserInstallation(ctx, account)
+	if IsNotFound(err) {
+		_, _, err = appClient.Apps.FindOrganizationInstallation(ctx, account)
+	}
+	switch {
+	case err == nil:
+		return true, nil
+	case IsNotFound(err):
+		return false, nil
+	default:
+		return false, fmt.Errorf("failed to find installation of %s: %w", account, err)
+	}
+}
+
+// AppInstallation is an account the app is installed on
+type AppInstallation struct {
+	ID        int64
+	Account   string
+	Suspended bool
+}
+
+// ListInstallations lists every account the app is installed on
+func (a *AppAuth) ListInstallations(ctx context.Context) ([]AppInstallation, error) {
+	appClient, err := a.appClient()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []AppInstallation
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		installations, resp, err := appClient.Apps.ListInstallations(ctx, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list app installations: %w", err)
+		}
+		for _, installation := range installations {
+			result = append(result, toAppInstallation(installation))
+		}
+		if resp.NextPage == 0 {
+			return result, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// GetInstallation gets the installation of a's installation ID
+func (a *AppAuth) GetInstallation(ctx context.Context) (*AppInstallation, error) {
+	appClient, err := a.appClient()
+	if err != nil {
+		return nil, err
+	}
+
+	installation, _, err := appClient.Apps.GetInstallation(ctx, a.installationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get app installation: %w", err)
+	}
+
+	result := toAppInstallation(installation)
+	return &result, nil
+}
+
+// ListRepositories lists the full names of the repositories the installation can access
+func (a *AppAuth) ListRepositories(ctx context.Context) ([]string, error) {
+	client, err := a.GetInstallationClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		repos, resp, err := client.Apps.ListRepos(ctx, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list installation repositories: %w", err)
+		}
+		for _, repo := range repos.Repositories {
+			names = append(names, repo.GetFullName())
+		}
+		if resp.NextPage == 0 {
+			return names, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+func toAppInstallation(installation *github.Installation) AppInstallation {
+	return AppInstallation{
+		ID:        installation.GetID(),
+		Account:   installation.GetAccount().GetLogin(),
+		Suspended: installation.SuspendedAt != nil,
+	}
 }
 
 // GetInstallationClient creates a GitHub client authenticated with the installation token
@@ -103,8 +206,13 @@ func (a *AppAuth) GetInstallationClient(ctx context.Context) (*github.Client, er
 	return github.NewClient(nil).WithAuthToken(token), nil
 }
 
-// GetUserInstallationID finds the installation ID for the nimbul-coding-cave app for a given user token
+// GetUserInstallationID finds the installation ID of the configured app for a given user token
 func GetUserInstallationID(ctx context.Context, userToken string) (int64, error) {
+	config, err := LoadAppConfig(ctx)
+	if err != nil {
+		return 0, err
+	}
+
 	ghClient := github.NewClient(nil).WithAuthToken(userToken)
 
 	// Get user's app installations
@@ -113,8 +221,7 @@ func GetUserInstallationID(ctx context.Context, userToken string) (int64, error)
 		return 0, fmt.Errorf("failed to list app installations: %w", err)
 	}
 
-	// Check if nimbul-coding-cave app is installed
-	appSlug := "nimbul-coding-cave"
+	appSlug := config.Slug
 	for _, installation := range installations {
 		if installation.GetAppSlug() == appSlug {
 			return installation.GetID(), nil
@@ -2,6 +2,8 @@ package github
 
 import (
 	"context"
+	"errors"
+	"net/http"
 
 	"github.com/google/go-github/v81/github"
 	"golang.org/x/oauth2"
@@ -20,3 +22,10 @@ func NewClient(ctx context.Context, accessToken string) *github.Client {
 func NewClientWithToken(token string) *github.Client {
 	return github.NewClient(nil).WithAuthToken(token)
 }
+
+// IsNotFound reports whether err is a 404 response of the GitHub API, which it also
+// answers for resources the caller has no access to
+func IsNotFound(err error) bool {
+	var ghErr *github.ErrorResponse
+	return errors.As(err, &ghErr) && ghErr.Response != nil && ghErr.Response.StatusCode == http.StatusNotFound
+}
@@ -9,11 +9,15 @@ import (
 	"strings"
 )
 
+// CommandFunc prepares a command, like exec.CommandContext
+type CommandFunc func(ctx context.Context, name string, args ...string) *exec.Cmd
+
 // CloneRepository clones a GitHub repository to the specified destination path
-// Uses installation token for authentication (works for both public and private repos)
-func CloneRepository(ctx context.Context, installationID int64, owner, repo, ref, destPath string) error {
+// Uses installation token for authentication (works for both public and private repos).
+// The git commands are prepared by command, or exec.CommandContext when it is nil.
+func CloneRepository(ctx context.Context, installationID int64, owner, repo, ref, destPath string, command CommandFunc) error {
 	// Get installation token
-	appAuth, err := NewAppAuth(installationID)
+	appAuth, err := NewAppAuth(ctx, installationID)
 	if err != nil {
 		return fmt.Errorf("failed to create app auth: %w", err)
 	}
@@ -23,6 +27,16 @@ func CloneRepository(ctx context.Context, installationID int64, owner, repo, ref
 		return fmt.Errorf("failed to get installation token: %w", err)
 	}
 
+	return CloneRepositoryWithToken(ctx, token, owner, repo, ref, destPath, command)
+}
+
+// CloneRepositoryWithToken clones a GitHub repository like CloneRepository, authenticating
+// with token, e.g. a user's access token so only repositories the user can read are cloned
+func CloneRepositoryWithToken(ctx context.Context, token, owner, repo, ref, destPath string, command CommandFunc) error {
+	if command == nil {
+		command = exec.CommandContext
+	}
+
 	// Format clone URL with token authentication
 	cloneURL := fmt.Sprintf("https://x-access-token:%s@github.com/%s/%s.git", token, owner, repo)
 
@@ -33,7 +47,7 @@ func CloneRepository(ctx context.Context, installationID int64, owner, repo, ref
 
 	// Clone repository (shallow clone for faster operation)
 	// First clone, then checkout the specific ref
-	cloneCmd := exec.CommandContext(ctx, "git", "clone", "--depth", "1", cloneURL, destPath)
+	cloneCmd := command(ctx, "git", "clone", "--depth", "1", cloneURL, destPath)
 	cloneCmd.Stdout = os.Stdout
 	cloneCmd.Stderr = os.Stderr
 	if err := cloneCmd.Run(); err != nil {
@@ -51,12 +65,12 @@ func CloneRepository(ctx context.Context, installationID int64, owner, repo, ref
 		}
 
 		// Fetch the specific ref if it's not the default branch
-		fetchCmd := exec.CommandContext(ctx, "git", "-C", destPath, "fetch", "origin", checkoutRef)
+		fetchCmd := command(ctx, "git", "-C", destPath, "fetch", "origin", checkoutRef)
 		fetchCmd.Stdout = os.Stdout
 		fetchCmd.Stderr = os.Stderr
 		if err := fetchCmd.Run(); err != nil {
 			// Try fetching by SHA if branch/tag fetch fails
-			fetchCmd = exec.CommandContext(ctx, "git", "-C", destPath, "fetch", "origin", ref)
+			fetchCmd = command(ctx, "git", "-C", destPath, "fetch", "origin", ref)
 			fetchCmd.Stdout = os.Stdout
 			fetchCmd.Stderr = os.Stderr
 			if err := fetchCmd.Run(); err != nil {
@@ -66,7 +80,7 @@ func CloneRepository(ctx context.Context, installationID int64, owner, repo, ref
 		}
 
 		// Checkout the ref
-		checkoutCmd := exec.CommandContext(ctx, "git", "-C", destPath, "checkout", checkoutRef)
+		checkoutCmd := command(ctx, "git", "-C", destPath, "checkout", checkoutRef)
 		checkoutCmd.Stdout = os.Stdout
 		checkoutCmd.Stderr = os.Stderr
 		if err := checkoutCmd.Run(); err != nil {
@@ -86,3 +100,23 @@ func CleanupRepository(destPath string) error {
 func GetDockerfileParentDir(dockerfilePath string) string {
 	return filepath.Dir(dockerfilePath)
 }
+
+// CurrentRef returns the branch and commit checked out in a cloned repository, running
+// git like CloneRepository
+func CurrentRef(ctx context.Context, repoPath string, command CommandFunc) (branch, commitSHA string, err error) {
+	if command == nil {
+		command = exec.CommandContext
+	}
+
+	branchOutput, err := command(ctx, "git", "-C", repoPath, "symbolic-ref", "--short", "HEAD").Output()
+	if err != nil {
+		return "", "", fmt.Errorf("failed to read checked out branch: %w", err)
+	}
+
+	shaOutput, err := command(ctx, "git", "-C", repoPath, "rev-parse", "HEAD").Output()
+	if err != nil {
+		return "", "", fmt.Errorf("failed to read checked out commit: %w", err)
+	}
+
+	return strings.TrimSpace(string(branchOutput)), strings.TrimSpace(string(shaOutput)), nil
+}
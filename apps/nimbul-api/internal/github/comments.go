@@ -0,0 +1,46 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v81/github"
+)
+
+// UpsertIssueComment posts body as a comment on an issue or pull request, or edits the
+// comment posted before when one contains marker, so repeated pushes keep a single
+// comment up to date. marker should be invisible, e.g. an HTML comment, and is added
+// to body when missing.
+func UpsertIssueComment(ctx context.Context, client *github.Client, owner, repo string, number int, marker, body string) error {
+	if !strings.Contains(body, marker) {
+		body = marker + "\n" + body
+	}
+
+	opts := &github.IssueListCommentsOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		comments, resp, err := client.Issues.ListComments(ctx, owner, repo, number, opts)
+		if err != nil {
+			return fmt.Errorf("failed to list comments: %w", err)
+		}
+
+		for _, comment := range comments {
+			if strings.Contains(comment.GetBody(), marker) {
+				if _, _, err := client.Issues.EditComment(ctx, owner, repo, comment.GetID(), &github.IssueComment{Body: &body}); err != nil {
+					return fmt.Errorf("failed to update comment: %w", err)
+				}
+				return nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	if _, _, err := client.Issues.CreateComment(ctx, owner, repo, number, &github.IssueComment{Body: &body}); err != nil {
+		return fmt.Errorf("failed to create comment: %w", err)
+	}
+	return nil
+}
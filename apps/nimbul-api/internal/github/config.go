@@ -0,0 +1,96 @@
+package github
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+)
+
+// ErrAppNotConfigured means neither admins nor the environment set up the GitHub App
+var ErrAppNotConfigured = errors.New("GitHub App is not configured: set it with 'nimbul admin github-app' or GITHUB_APP_ID and GITHUB_APP_PRIVATE_KEY")
+
+// AppConfig identifies the GitHub App Nimbul acts as
+type AppConfig struct {
+	AppID         int64
+	Slug          string // from the app's URL, github.com/apps/<slug>
+	PrivateKey    string // PEM encoded
+	ClientID      string // of the app's OAuth client, for the device flow
+	ClientSecret  string
+	WebhookSecret string // signs the installation events GitHub sends to the app's webhook
+}
+
+// AppConfigSource returns the app configuration stored by admins, or nil when there
+// is none
+type AppConfigSource func(ctx context.Context) (*AppConfig, error)
+
+var appConfigSource AppConfigSource
+
+// SetAppConfigSource makes LoadAppConfig prefer the configuration source returns over
+// the environment. The API server sets it to the configuration stored in the database.
+func SetAppConfigSource(source AppConfigSource) {
+	appConfigSource = source
+}
+
+// LoadAppConfig returns the app configuration stored by admins or, without one, the
+// one in GITHUB_APP_ID, GITHUB_APP_SLUG, GITHUB_APP_PRIVATE_KEY, GITHUB_CLIENT_ID,
+// GITHUB_CLIENT_SECRET and GITHUB_APP_WEBHOOK_SECRET
+func LoadAppConfig(ctx context.Context) (*AppConfig, error) {
+	if appConfigSource != nil {
+		config, err := appConfigSource(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load GitHub App configuration: %w", err)
+		}
+		if config != nil {
+			return config, nil
+		}
+	}
+
+	config := &AppConfig{
+		Slug:          os.Getenv("GITHUB_APP_SLUG"),
+		PrivateKey:    os.Getenv("GITHUB_APP_PRIVATE_KEY"),
+		ClientID:      os.Getenv("GITHUB_CLIENT_ID"),
+		ClientSecret:  os.Getenv("GITHUB_CLIENT_SECRET"),
+		WebhookSecret: os.Getenv("GITHUB_APP_WEBHOOK_SECRET"),
+	}
+	if config.Slug == "" {
+		config.Slug = DefaultAppSlug
+	}
+	if appIDStr := os.Getenv("GITHUB_APP_ID"); appIDStr != "" {
+		appID, err := strconv.ParseInt(appIDStr, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid GITHUB_APP_ID: %w", err)
+		}
+		config.AppID = appID
+	}
+
+	return config, nil
+}
+
+// ParsePrivateKey parses the PEM encoded private key of an app, in PKCS1 or PKCS8 format
+func ParsePrivateKey(privateKeyPEM string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(privateKeyPEM))
+	if block == nil {
+		return nil, fmt.Errorf("failed to parse private key PEM")
+	}
+
+	privateKey, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		// Try PKCS8 format
+		key, err2 := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err2 != nil {
+			return nil, fmt.Errorf("failed to parse private key: %w", err)
+		}
+		var ok bool
+		privateKey, ok = key.(*rsa.PrivateKey)
+		if !ok {
+			return nil, fmt.Errorf("private key is not RSA")
+		}
+	}
+
+	return privateKey, nil
+}
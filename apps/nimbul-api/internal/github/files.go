@@ -28,3 +28,22 @@ func FileExists(ctx context.Context, client *github.Client, owner, repo, path, r
 	// File exists
 	return true, nil
 }
+
+// GetFileContents returns the contents of a file in a GitHub repository at ref
+func GetFileContents(ctx context.Context, client *github.Client, owner, repo, path, ref string) ([]byte, error) {
+	opts := &github.RepositoryContentGetOptions{Ref: ref}
+
+	file, _, _, err := client.Repositories.GetContents(ctx, owner, repo, path, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s: %w", path, err)
+	}
+	if file == nil {
+		return nil, fmt.Errorf("%s is a directory", path)
+	}
+
+	content, err := file.GetContent()
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", path, err)
+	}
+	return []byte(content), nil
+}
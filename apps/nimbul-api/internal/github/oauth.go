@@ -3,7 +3,6 @@ package github
 import (
 	"context"
 	"fmt"
-	"os"
 
 	"golang.org/x/oauth2"
 	oauth2github "golang.org/x/oauth2/github"
@@ -14,11 +13,10 @@ type OAuthConfig struct {
 	config *oauth2.Config
 }
 
-// NewOAuthConfig creates a new OAuth configuration for GitHub
-func NewOAuthConfig() (*OAuthConfig, error) {
-	clientID := os.Getenv("GITHUB_CLIENT_ID")
+// NewOAuthConfig creates a new OAuth configuration for the OAuth client of the GitHub App
+func NewOAuthConfig(clientID string) (*OAuthConfig, error) {
 	if clientID == "" {
-		return nil, fmt.Errorf("GITHUB_CLIENT_ID environment variable is not set")
+		return nil, fmt.Errorf("the GitHub App has no client ID configured")
 	}
 
 	config := &oauth2.Config{
@@ -0,0 +1,99 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/google/go-github/v81/github"
+)
+
+// ErrBranchExists is returned when the branch a pull request would be opened from already exists
+var ErrBranchExists = errors.New("branch already exists")
+
+// PullRequest is a pull request opened on a repository
+type PullRequest struct {
+	Number int
+	URL    string
+}
+
+// NewPullRequest describes a pull request adding files to a repository
+type NewPullRequest struct {
+	Base    string // branch the pull request targets, e.g. the default branch
+	Branch  string // branch created for the files
+	Title   string
+	Body    string
+	Message string            // commit message
+	Files   map[string][]byte // contents by path, relative to the repository root
+}
+
+// CreatePullRequestWithFiles commits files on a new branch created from the head of the
+// base branch, in a single commit, and opens a pull request from it
+func CreatePullRequestWithFiles(ctx context.Context, client *github.Client, owner, repo string, pr NewPullRequest) (*PullRequest, error) {
+	baseRef, _, err := client.Git.GetRef(ctx, owner, repo, "refs/heads/"+pr.Base)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get branch %s: %w", pr.Base, err)
+	}
+	baseSHA := baseRef.GetObject().GetSHA()
+
+	baseCommit, _, err := client.Git.GetCommit(ctx, owner, repo, baseSHA)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commit %s: %w", baseSHA, err)
+	}
+
+	paths := make([]string, 0, len(pr.Files))
+	for path := range pr.Files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	entries := make([]*github.TreeEntry, len(paths))
+	for i, path := range paths {
+		entries[i] = &github.TreeEntry{
+			Path:    github.Ptr(path),
+			Mode:    github.Ptr("100644"),
+			Type:    github.Ptr("blob"),
+			Content: github.Ptr(string(pr.Files[path])),
+		}
+	}
+
+	tree, _, err := client.Git.CreateTree(ctx, owner, repo, baseCommit.GetTree().GetSHA(), entries)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tree: %w", err)
+	}
+
+	commit, _, err := client.Git.CreateCommit(ctx, owner, repo, github.Commit{
+		Message: github.Ptr(pr.Message),
+		Tree:    tree,
+		Parents: []*github.Commit{{SHA: github.Ptr(baseSHA)}},
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create commit: %w", err)
+	}
+
+	_, resp, err := client.Git.CreateRef(ctx, owner, repo, github.CreateRef{
+		Ref: "refs/heads/" + pr.Branch,
+		SHA: commit.GetSHA(),
+	})
+	if err != nil {
+		// GitHub answers 422 for a reference that already exists
+		if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity {
+			return nil, fmt.Errorf("%w: %s", ErrBranchExists, pr.Branch)
+		}
+		return nil, fmt.Errorf("failed to create branch %s: %w", pr.Branch, err)
+	}
+
+	pull, _, err := client.PullRequests.Create(ctx, owner, repo, &github.NewPullRequest{
+		Title: github.Ptr(pr.Title),
+		Head:  github.Ptr(pr.Branch),
+		Base:  github.Ptr(pr.Base),
+		Body:  github.Ptr(pr.Body),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pull request: %w", err)
+	}
+
+	return &PullRequest{Number: pull.GetNumber(), URL: pull.GetHTMLURL()}, nil
+}
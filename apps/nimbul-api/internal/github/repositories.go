@@ -15,6 +15,22 @@ type Repository struct {
 	CloneURL string
 }
 
+// GetRepository gets a repository. GitHub answers for a renamed repository under its
+// old name, so the result has the current name.
+func GetRepository(ctx context.Context, client *github.Client, owner, repo string) (*Repository, error) {
+	repository, _, err := client.Repositories.Get(ctx, owner, repo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository: %w", err)
+	}
+
+	return &Repository{
+		Owner:    repository.GetOwner().GetLogin(),
+		Name:     repository.GetName(),
+		FullName: repository.GetFullName(),
+		CloneURL: repository.GetCloneURL(),
+	}, nil
+}
+
 // ListRepositories lists all repositories accessible to the authenticated user
 func ListRepositories(ctx context.Context, client *github.Client, perPage int) ([]Repository, error) {
 	opts := &github.RepositoryListOptions{
@@ -63,3 +79,21 @@ func ListRepositoriesByAuthenticatedUser(ctx context.Context, client *github.Cli
 
 	return result, nil
 }
+
+// FindPullRequestNumber returns the number of the open pull request from a branch of
+// the repository, or 0 when there is none
+func FindPullRequestNumber(ctx context.Context, client *github.Client, owner, repo, branch string) (int, error) {
+	pulls, _, err := client.PullRequests.List(ctx, owner, repo, &github.PullRequestListOptions{
+		State:       "open",
+		Head:        owner + ":" + branch,
+		ListOptions: github.ListOptions{PerPage: 1},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list pull requests: %w", err)
+	}
+
+	if len(pulls) == 0 {
+		return 0, nil
+	}
+	return pulls[0].GetNumber(), nil
+}
@@ -3,16 +3,22 @@ package github
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/google/go-github/v81/github"
 )
 
+// WebhookEvents are the events the webhook of a config subscribes to: pushes trigger
+// builds, package pushes redeploy, and renames and transfers of the repository move
+// the config to its new name
+var WebhookEvents = []string{"push", "registry_package", "repository"}
+
 // CreateWebhook creates a webhook for a repository using installation authentication
 func CreateWebhook(ctx context.Context, client *github.Client, owner, repo, webhookURL, secret string) (int64, error) {
 	hook := &github.Hook{
 		Name:   github.String("web"),
 		Active: github.Bool(true),
-		Events: []string{"push"},
+		Events: WebhookEvents,
 		Config: &github.HookConfig{
 			URL:         github.String(webhookURL),
 			ContentType: github.String("json"),
@@ -27,3 +33,30 @@ func CreateWebhook(ctx context.Context, client *github.Client, owner, repo, webh
 
 	return createdHook.GetID(), nil
 }
+
+// SyncHook reports whether a repository still has a webhook, and subscribes it to the
+// WebhookEvents it is missing, for webhooks created before Nimbul handled them
+func SyncHook(ctx context.Context, client *github.Client, owner, repo string, hookID int64) (bool, error) {
+	hook, _, err := client.Repositories.GetHook(ctx, owner, repo, hookID)
+	if err != nil {
+		if IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get webhook: %w", err)
+	}
+
+	events := hook.Events
+	for _, event := range WebhookEvents {
+		if !slices.Contains(events, event) {
+			events = append(events, event)
+		}
+	}
+	if len(events) == len(hook.Events) {
+		return true, nil
+	}
+
+	if _, _, err := client.Repositories.EditHook(ctx, owner, repo, hookID, &github.Hook{Events: events}); err != nil {
+		return true, fmt.Errorf("failed to update webhook events: %w", err)
+	}
+	return true, nil
+}
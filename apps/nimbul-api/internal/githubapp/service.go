@@ -0,0 +1,264 @@
+package githubapp
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/credentials"
+	"github.com/coding-cave-dev/nimbul/internal/db"
+	"github.com/coding-cave-dev/nimbul/internal/github"
+	"github.com/jackc/pgx/v5"
+)
+
+// Sources of the app configuration in effect
+const (
+	SourceDatabase    = "database"
+	SourceEnvironment = "environment"
+)
+
+// cacheTTL is how long Load keeps the stored configuration. Set and Delete clear the
+// cache of their process right away; other API servers and workers see the change once
+// their cache expires.
+const cacheTTL = time.Minute
+
+var (
+	ErrInvalidPrivateKey  = errors.New("invalid GitHub App private key")
+	ErrPrivateKeyRequired = errors.New("a private key is required to configure the GitHub App")
+)
+
+// Service stores the GitHub App configuration set by admins. The private key and
+// secrets are encrypted with the master key, like credentials.
+type Service struct {
+	queries     *db.Queries
+	credentials *credentials.Service
+
+	mu       sync.Mutex
+	cached   *github.AppConfig // nil with loadedAt set when admins did not set one
+	loadedAt time.Time
+}
+
+func NewService(queries *db.Queries, credentials *credentials.Service) *Service {
+	return &Service{
+		queries:     queries,
+		credentials: credentials,
+	}
+}
+
+// Settings describes the app configuration in effect without its secrets
+type Settings struct {
+	AppID            int64
+	Slug             string
+	ClientID         string
+	HasPrivateKey    bool
+	HasClientSecret  bool
+	HasWebhookSecret bool
+	Source           string
+	UpdatedAt        *time.Time // only set for configurations stored in the database
+}
+
+// SetParams configures the app. An empty PrivateKey, ClientSecret or WebhookSecret
+// keeps the stored one.
+type SetParams struct {
+	AppID         int64
+	Slug          string
+	ClientID      string
+	PrivateKey    string
+	ClientSecret  string
+	WebhookSecret string
+}
+
+// secrets is the encrypted part of a stored configuration
+type secrets struct {
+	PrivateKey    string `json:"private_key"`
+	ClientSecret  string `json:"client_secret"`
+	WebhookSecret string `json:"webhook_secret,omitempty"`
+}
+
+// Load returns the stored app configuration, or nil when admins did not set one. It
+// is the github.AppConfigSource of the API server and workers, and is cached for
+// cacheTTL since every GitHub call loads it.
+func (s *Service) Load(ctx context.Context) (*github.AppConfig, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.loadedAt.IsZero() && time.Since(s.loadedAt) < cacheTTL {
+		return s.cached, nil
+	}
+
+	config, err := s.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.cached = config
+	s.loadedAt = time.Now()
+	return config, nil
+}
+
+// load reads and decrypts the stored app configuration
+func (s *Service) load(ctx context.Context) (*github.AppConfig, error) {
+	app, err := s.queries.GetGitHubApp(ctx)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get GitHub App: %w", err)
+	}
+
+	stored, err := s.open(app)
+	if err != nil {
+		return nil, err
+	}
+
+	return &github.AppConfig{
+		AppID:         app.AppID,
+		Slug:          app.Slug,
+		PrivateKey:    stored.PrivateKey,
+		ClientID:      app.ClientID,
+		ClientSecret:  stored.ClientSecret,
+		WebhookSecret: stored.WebhookSecret,
+	}, nil
+}
+
+// invalidate makes the next Load read the stored configuration again
+func (s *Service) invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cached = nil
+	s.loadedAt = time.Time{}
+}
+
+// Get returns the app configuration in effect, stored or from the environment
+func (s *Service) Get(ctx context.Context) (*Settings, error) {
+	app, err := s.queries.GetGitHubApp(ctx)
+	if err == nil {
+		stored, err := s.open(app)
+		if err != nil {
+			return nil, err
+		}
+		return &Settings{
+			AppID:            app.AppID,
+			Slug:             app.Slug,
+			ClientID:         app.ClientID,
+			HasPrivateKey:    stored.PrivateKey != "",
+			HasClientSecret:  stored.ClientSecret != "",
+			HasWebhookSecret: stored.WebhookSecret != "",
+			Source:           SourceDatabase,
+			UpdatedAt:        &app.UpdatedAt.Time,
+		}, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("failed to get GitHub App: %w", err)
+	}
+
+	// Without a stored configuration this is the environment
+	config, err := github.LoadAppConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Settings{
+		AppID:            config.AppID,
+		Slug:             config.Slug,
+		ClientID:         config.ClientID,
+		HasPrivateKey:    config.PrivateKey != "",
+		HasClientSecret:  config.ClientSecret != "",
+		HasWebhookSecret: config.WebhookSecret != "",
+		Source:           SourceEnvironment,
+	}, nil
+}
+
+// Set stores the app configuration, which takes precedence over the environment
+func (s *Service) Set(ctx context.Context, params SetParams) (*Settings, error) {
+	var previous secrets
+	app, err := s.queries.GetGitHubApp(ctx)
+	if err == nil {
+		stored, err := s.open(app)
+		if err != nil {
+			return nil, err
+		}
+		previous = *stored
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("failed to get GitHub App: %w", err)
+	}
+
+	next := secrets{
+		PrivateKey:    cmp.Or(params.PrivateKey, previous.PrivateKey),
+		ClientSecret:  cmp.Or(params.ClientSecret, previous.ClientSecret),
+		WebhookSecret: cmp.Or(params.WebhookSecret, previous.WebhookSecret),
+	}
+
+	if next.PrivateKey == "" {
+		return nil, ErrPrivateKeyRequired
+	}
+	if _, err := github.ParsePrivateKey(next.PrivateKey); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPrivateKey, err)
+	}
+
+	plaintext, err := json.Marshal(next)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode GitHub App secrets: %w", err)
+	}
+	sealed, err := s.credentials.Seal(plaintext)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err = s.queries.UpsertGitHubApp(ctx, db.UpsertGitHubAppParams{
+		AppID:      params.AppID,
+		Slug:       params.Slug,
+		ClientID:   params.ClientID,
+		Ciphertext: sealed.Ciphertext,
+		TokenNonce: sealed.TokenNonce,
+		WrappedDek: sealed.WrappedDEK,
+		DekNonce:   sealed.DEKNonce,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to store GitHub App: %w", err)
+	}
+	s.invalidate()
+
+	return &Settings{
+		AppID:            app.AppID,
+		Slug:             app.Slug,
+		ClientID:         app.ClientID,
+		HasPrivateKey:    true,
+		HasClientSecret:  next.ClientSecret != "",
+		HasWebhookSecret: next.WebhookSecret != "",
+		Source:           SourceDatabase,
+		UpdatedAt:        &app.UpdatedAt.Time,
+	}, nil
+}
+
+// Delete removes the stored app configuration, falling back to the environment.
+// Reports whether there was one.
+func (s *Service) Delete(ctx context.Context) (bool, error) {
+	rows, err := s.queries.DeleteGitHubApp(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete GitHub App: %w", err)
+	}
+	s.invalidate()
+	return rows > 0, nil
+}
+
+// open decrypts the secrets of a stored configuration
+func (s *Service) open(app db.GithubApp) (*secrets, error) {
+	plaintext, err := s.credentials.Open(&credentials.Sealed{
+		Ciphertext: app.Ciphertext,
+		TokenNonce: app.TokenNonce,
+		WrappedDEK: app.WrappedDek,
+		DEKNonce:   app.DekNonce,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var stored secrets
+	if err := json.Unmarshal(plaintext, &stored); err != nil {
+		return nil, fmt.Errorf("failed to decode GitHub App secrets: %w", err)
+	}
+	return &stored, nil
+}
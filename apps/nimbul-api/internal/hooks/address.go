@@ -0,0 +1,103 @@
+package hooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+// ErrPrivateAddress rejects hooks that would make the server call itself, its cluster
+// or the cloud metadata service on behalf of a user
+var ErrPrivateAddress = errors.New("hook URL must not point to a private, loopback or link-local address")
+
+// nonPublicPrefixes are ranges netip does not classify as private or local but that
+// still reach infrastructure rather than the internet
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),     // "this network"
+	netip.MustParsePrefix("100.64.0.0/10"), // carrier-grade NAT, used by some clusters
+	netip.MustParsePrefix("192.0.0.0/24"),  // IETF protocol assignments
+	netip.MustParsePrefix("198.18.0.0/15"), // benchmarking
+	netip.MustParsePrefix("64:ff9b::/96"),  // NAT64, embeds IPv4 addresses
+}
+
+// isPublicAddress reports whether hooks may be delivered to addr
+func isPublicAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() || addr.IsUnspecified() || addr.IsLoopback() || addr.IsPrivate() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() || addr.IsInterfaceLocalMulticast() ||
+		addr.IsMulticast() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateURL checks a hook URL, or another URL the server will post to on behalf of a
+// user, when it is registered. Every address its host resolves to has to be public;
+// deliveries check the address they connect to again, since DNS can change in between.
+func ValidateURL(ctx context.Context, hookURL string) error {
+	parsed, err := url.Parse(hookURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Hostname() == "" {
+		return ErrInvalidURL
+	}
+
+	host := parsed.Hostname()
+	if addr, err := netip.ParseAddr(host); err == nil {
+		if !isPublicAddress(addr) {
+			return ErrPrivateAddress
+		}
+		return nil
+	}
+
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil || len(addrs) == 0 {
+		return fmt.Errorf("%w: %s does not resolve", ErrInvalidURL, host)
+	}
+	for _, addr := range addrs {
+		if !isPublicAddress(addr) {
+			return ErrPrivateAddress
+		}
+	}
+	return nil
+}
+
+// publicOnlyControl refuses connections to non-public addresses. It runs after DNS
+// resolution, for every address dialed, redirects included.
+func publicOnlyControl(network, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("unexpected address %q: %w", address, err)
+	}
+	if !isPublicAddress(addrPort.Addr()) {
+		return fmt.Errorf("%w: %s", ErrPrivateAddress, addrPort.Addr())
+	}
+	return nil
+}
+
+// NewDeliveryClient returns the HTTP client deliveries to user-supplied URLs are made
+// with, which only connects to public addresses
+func NewDeliveryClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   deliveryTimeout,
+		KeepAlive: 30 * time.Second,
+		Control:   publicOnlyControl,
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// A proxy would connect on our behalf, past the check of the dialer
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Timeout:   deliveryTimeout,
+		Transport: transport,
+	}
+}
@@ -0,0 +1,74 @@
+package hooks
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+)
+
+func TestIsPublicAddress(t *testing.T) {
+	tests := []struct {
+		addr   string
+		public bool
+	}{
+		{"93.184.216.34", true},
+		{"2606:2800:220:1:248:1893:25c8:1946", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false},
+		{"100.64.0.1", false},
+		{"0.0.0.0", false},
+		{"::", false},
+		{"fd00::1", false},
+		{"fe80::1", false},
+		{"::ffff:127.0.0.1", false},
+		{"::ffff:10.0.0.1", false},
+		{"64:ff9b::a00:1", false},
+	}
+
+	for _, tt := range tests {
+		if got := isPublicAddress(netip.MustParseAddr(tt.addr)); got != tt.public {
+			t.Errorf("isPublicAddress(%s) = %v, want %v", tt.addr, got, tt.public)
+		}
+	}
+}
+
+func TestValidateURL(t *testing.T) {
+	tests := []struct {
+		url     string
+		wantErr error
+	}{
+		{"https://93.184.216.34/hook", nil},
+		{"ftp://93.184.216.34/hook", ErrInvalidURL},
+		{"/hook", ErrInvalidURL},
+		{"http://127.0.0.1:8080/hook", ErrPrivateAddress},
+		{"http://[::1]/hook", ErrPrivateAddress},
+		{"http://169.254.169.254/latest/meta-data", ErrPrivateAddress},
+		{"http://localhost/hook", ErrPrivateAddress},
+	}
+
+	for _, tt := range tests {
+		err := ValidateURL(context.Background(), tt.url)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("ValidateURL(%q) = %v, want %v", tt.url, err, tt.wantErr)
+		}
+	}
+}
+
+func TestDeliveryClientRefusesLoopback(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("delivery reached a loopback server")
+	}))
+	defer server.Close()
+
+	_, err := NewDeliveryClient().Post(server.URL, "application/json", nil)
+	if !errors.Is(err, ErrPrivateAddress) {
+		t.Fatalf("Post(%s) error = %v, want %v", server.URL, err, ErrPrivateAddress)
+	}
+}
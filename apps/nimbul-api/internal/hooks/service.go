@@ -0,0 +1,298 @@
+package hooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/db"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/oklog/ulid/v2"
+)
+
+// Pipeline event types delivered to hooks
+const (
+	EventBuildStarted    = "build.started"
+	EventBuildSucceeded  = "build.succeeded"
+	EventBuildFailed     = "build.failed"
+	EventBuildCanceled   = "build.canceled"
+	EventDeployStarted   = "deploy.started"
+	EventDeploySucceeded = "deploy.succeeded"
+	EventDeployFailed    = "deploy.failed"
+)
+
+// EventTypes lists every event type a hook can subscribe to
+var EventTypes = []string{
+	EventBuildStarted,
+	EventBuildSucceeded,
+	EventBuildFailed,
+	EventBuildCanceled,
+	EventDeployStarted,
+	EventDeploySucceeded,
+	EventDeployFailed,
+}
+
+// Headers sent with every delivery
+const (
+	HeaderEvent     = "X-Nimbul-Event"
+	HeaderDelivery  = "X-Nimbul-Delivery"
+	HeaderSignature = "X-Nimbul-Signature" // "sha256=" + hex HMAC-SHA256 of the body
+)
+
+// secretPrefix makes hook signing secrets recognisable
+const secretPrefix = "nbl_whsec_"
+
+// Delivery attempts are retried with these delays when the receiver errors or returns non-2xx
+var retryDelays = []time.Duration{5 * time.Second, 30 * time.Second, 2 * time.Minute}
+
+const deliveryTimeout = 10 * time.Second
+
+var (
+	ErrHookNotFound     = errors.New("hook not found")
+	ErrInvalidURL       = errors.New("hook URL must be an absolute http or https URL")
+	ErrInvalidEventType = errors.New("invalid event type")
+)
+
+type Service struct {
+	queries *db.Queries
+	client  *http.Client
+}
+
+func NewService(queries *db.Queries) *Service {
+	return &Service{
+		queries: queries,
+		client:  NewDeliveryClient(),
+	}
+}
+
+type Hook struct {
+	ID             string
+	OwnerID        string
+	URL            string
+	Events         []string
+	LastDeliveryAt *time.Time
+	LastStatusCode *int
+	LastError      string
+	CreatedAt      time.Time
+}
+
+type CreateHookResult struct {
+	HookID string
+	// Secret is only returned once, at creation
+	Secret string
+}
+
+// Event is the JSON payload delivered to hooks. Fields that do not apply to an
+// event type are omitted.
+type Event struct {
+	ID           string    `json:"id"`
+	Type         string    `json:"type"`
+	CreatedAt    time.Time `json:"created_at"`
+	ConfigID     string    `json:"config_id"`
+	Repository   string    `json:"repository"`
+	Ref          string    `json:"ref,omitempty"`
+	CommitSHA    string    `json:"commit_sha,omitempty"`
+	Build        string    `json:"build,omitempty"`
+	BuildID      int64     `json:"build_id,omitempty"`
+	Images       []string  `json:"images,omitempty"`
+	DeploymentID int64     `json:"deployment_id,omitempty"`
+	Error        string    `json:"error,omitempty"`
+}
+
+// CreateHook registers a hook for ownerID and generates its signing secret.
+// An empty events list subscribes to every event type.
+func (s *Service) CreateHook(ctx context.Context, ownerID, hookURL string, events []string) (*CreateHookResult, error) {
+	if err := ValidateURL(ctx, hookURL); err != nil {
+		return nil, err
+	}
+
+	for _, event := range events {
+		if !slices.Contains(EventTypes, event) {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidEventType, event)
+		}
+	}
+	if events == nil {
+		events = []string{}
+	}
+
+	random := make([]byte, 32)
+	if _, err := rand.Read(random); err != nil {
+		return nil, fmt.Errorf("failed to generate hook secret: %w", err)
+	}
+	secret := secretPrefix + hex.EncodeToString(random)
+
+	hook, err := s.queries.CreateHook(ctx, db.CreateHookParams{
+		ID:      ulid.Make().String(),
+		OwnerID: ownerID,
+		Url:     hookURL,
+		Secret:  secret,
+		Events:  events,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create hook: %w", err)
+	}
+
+	return &CreateHookResult{
+		HookID: hook.ID,
+		Secret: secret,
+	}, nil
+}
+
+// GetHooksByOwnerID retrieves all hooks for a user
+func (s *Service) GetHooksByOwnerID(ctx context.Context, ownerID string) ([]Hook, error) {
+	hooks, err := s.queries.GetHooksByOwnerID(ctx, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hooks: %w", err)
+	}
+
+	result := make([]Hook, len(hooks))
+	for i, h := range hooks {
+		result[i] = *dbHookToHook(h)
+	}
+
+	return result, nil
+}
+
+// DeleteHook removes a hook owned by ownerID
+func (s *Service) DeleteHook(ctx context.Context, ownerID, id string) error {
+	rows, err := s.queries.DeleteHook(ctx, db.DeleteHookParams{
+		ID:      id,
+		OwnerID: ownerID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete hook: %w", err)
+	}
+
+	if rows == 0 {
+		return ErrHookNotFound
+	}
+
+	return nil
+}
+
+// Publish delivers an event to every hook of ownerID subscribed to its type.
+// Deliveries happen in the background so a slow receiver never holds up a pipeline;
+// failures are retried and recorded on the hook.
+func (s *Service) Publish(ctx context.Context, ownerID string, event Event) {
+	hooks, err := s.queries.GetHooksByOwnerID(ctx, ownerID)
+	if err != nil {
+		fmt.Printf("Warning: Failed to load hooks for %s event: %v\n", event.Type, err)
+		return
+	}
+
+	event.ID = ulid.Make().String()
+	event.CreatedAt = time.Now().UTC()
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		fmt.Printf("Warning: Failed to encode %s event: %v\n", event.Type, err)
+		return
+	}
+
+	for _, hook := range hooks {
+		if len(hook.Events) > 0 && !slices.Contains(hook.Events, event.Type) {
+			continue
+		}
+		go s.deliver(hook, event, payload)
+	}
+}
+
+// deliver posts payload to a hook, retrying failed attempts, and records the outcome
+func (s *Service) deliver(hook db.Hook, event Event, payload []byte) {
+	var statusCode int
+	var deliveryErr error
+
+	for attempt := 0; ; attempt++ {
+		statusCode, deliveryErr = s.post(hook, event, payload)
+		if deliveryErr == nil || attempt == len(retryDelays) {
+			break
+		}
+		time.Sleep(retryDelays[attempt])
+	}
+
+	errText := pgtype.Text{}
+	if deliveryErr != nil {
+		errText = pgtype.Text{String: deliveryErr.Error(), Valid: true}
+		fmt.Printf("Warning: Failed to deliver %s event to hook %s: %v\n", event.Type, hook.ID, deliveryErr)
+	}
+
+	err := s.queries.UpdateHookDelivery(context.Background(), db.UpdateHookDeliveryParams{
+		ID:             hook.ID,
+		LastStatusCode: pgtype.Int4{Int32: int32(statusCode), Valid: statusCode != 0},
+		LastError:      errText,
+	})
+	if err != nil {
+		fmt.Printf("Warning: Failed to record delivery to hook %s: %v\n", hook.ID, err)
+	}
+}
+
+// post makes a single signed delivery attempt. Returns the response status code
+// (0 when there was no response) and an error unless the receiver returned 2xx.
+func (s *Service) post(hook db.Hook, event Event, payload []byte) (int, error) {
+	req, err := http.NewRequest(http.MethodPost, hook.Url, bytes.NewReader(payload))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Nimbul-Hooks")
+	req.Header.Set(HeaderEvent, event.Type)
+	req.Header.Set(HeaderDelivery, event.ID)
+	req.Header.Set(HeaderSignature, Sign([]byte(hook.Secret), payload))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("receiver returned %s", resp.Status)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// Sign returns the signature header value for payload: "sha256=" followed by the
+// hex-encoded HMAC-SHA256 of payload keyed with secret
+func Sign(secret, payload []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// dbHookToHook converts a db.Hook to a hooks.Hook
+func dbHookToHook(dbHook db.Hook) *Hook {
+	var lastDeliveryAt *time.Time
+	if dbHook.LastDeliveryAt.Valid {
+		lastDeliveryAt = &dbHook.LastDeliveryAt.Time
+	}
+
+	var lastStatusCode *int
+	if dbHook.LastStatusCode.Valid {
+		code := int(dbHook.LastStatusCode.Int32)
+		lastStatusCode = &code
+	}
+
+	return &Hook{
+		ID:             dbHook.ID,
+		OwnerID:        dbHook.OwnerID,
+		URL:            dbHook.Url,
+		Events:         dbHook.Events,
+		LastDeliveryAt: lastDeliveryAt,
+		LastStatusCode: lastStatusCode,
+		LastError:      dbHook.LastError.String,
+		CreatedAt:      dbHook.CreatedAt.Time,
+	}
+}
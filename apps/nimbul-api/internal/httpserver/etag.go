@@ -0,0 +1,36 @@
+package httpserver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+// etag formats the version of a resource as its ETag
+func etag(version int32) string {
+	return fmt.Sprintf(`"%d"`, version)
+}
+
+// ifMatchVersion parses an If-Match header into the version a write expects. Returns
+// nil when the header is unset or "*", which accept any version.
+func ifMatchVersion(header string) (*int32, error) {
+	header = strings.TrimSpace(header)
+	if header == "" || header == "*" {
+		return nil, nil
+	}
+
+	// Only strong ETags issued by etag can match
+	unquoted, err := strconv.Unquote(header)
+	if err != nil || !strings.HasPrefix(header, `"`) {
+		return nil, huma.Error412PreconditionFailed("If-Match does not match the current ETag")
+	}
+	version, err := strconv.ParseInt(unquoted, 10, 32)
+	if err != nil {
+		return nil, huma.Error412PreconditionFailed("If-Match does not match the current ETag")
+	}
+
+	expected := int32(version)
+	return &expected, nil
+}
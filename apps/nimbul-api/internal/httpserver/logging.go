@@ -0,0 +1,96 @@
+package httpserver
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/deliveries"
+	"github.com/gofiber/fiber/v2"
+)
+
+const requestInfoKey contextKey = "requestInfo"
+
+// requestInfo collects what handlers learn about a request for its log line. The
+// user is only known once a handler has validated the token, after the middleware
+// has handed the request on.
+type requestInfo struct {
+	userID string
+}
+
+// LoggingMiddleware logs the method, path, status, latency and user of every request.
+// When webhook payload capturing is on, it also records the requests to /webhooks
+// with their response, to troubleshoot events that Nimbul rejects.
+func LoggingMiddleware(deliveriesService *deliveries.Service) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		start := time.Now()
+		info := &requestInfo{}
+		c.SetUserContext(context.WithValue(c.UserContext(), requestInfoKey, info))
+
+		// Write the error response now so its status is logged
+		if err := c.Next(); err != nil {
+			if err := c.App().Config().ErrorHandler(c, err); err != nil {
+				_ = c.SendStatus(fiber.StatusInternalServerError)
+			}
+		}
+
+		status := c.Response().StatusCode()
+		userID := info.userID
+		if userID == "" {
+			userID = "-"
+		}
+		// The path leaves out the query string, registry webhooks pass their token in it
+		fmt.Printf("%s %s %d %s user=%s\n", c.Method(), c.Path(), status, time.Since(start).Round(time.Microsecond), userID)
+
+		if deliveriesService.Enabled() && c.Method() == fiber.MethodPost && strings.HasPrefix(c.Path(), "/webhooks/") {
+			captureWebhook(c, deliveriesService, status)
+		}
+
+		return nil
+	}
+}
+
+// captureWebhook records a webhook request for NIMBUL_CAPTURE_WEBHOOK_PAYLOADS
+func captureWebhook(c *fiber.Ctx, deliveriesService *deliveries.Service, status int) {
+	// /webhooks/{source}/{id}
+	parts := strings.Split(strings.TrimPrefix(c.Path(), "/webhooks/"), "/")
+	if len(parts) != 2 {
+		return
+	}
+
+	headers := make(map[string]string)
+	for name, values := range c.GetReqHeaders() {
+		if strings.EqualFold(name, fiber.HeaderAuthorization) {
+			continue
+		}
+		headers[name] = strings.Join(values, ", ")
+	}
+
+	delivery := deliveries.Delivery{
+		Source:     parts[0],
+		ConfigID:   parts[1],
+		Headers:    headers,
+		Payload:    bytes.Clone(c.Body()),
+		StatusCode: status,
+	}
+	if parts[0] == deliveries.SourceGitHub {
+		delivery.EventType = c.Get("X-GitHub-Event")
+	}
+	if status >= http.StatusBadRequest {
+		delivery.Response = string(c.Response().Body())
+	}
+
+	if err := deliveriesService.Record(c.UserContext(), delivery); err != nil {
+		fmt.Printf("Warning: Failed to capture webhook payload for %s: %v\n", c.Path(), err)
+	}
+}
+
+// setRequestUser records the authenticated user for the request log
+func setRequestUser(ctx context.Context, userID string) {
+	if info, ok := ctx.Value(requestInfoKey).(*requestInfo); ok {
+		info.userID = userID
+	}
+}
@@ -2,17 +2,29 @@ package httpserver
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
 	"strings"
 
+	"github.com/coding-cave-dev/nimbul/internal/agents"
 	"github.com/coding-cave-dev/nimbul/internal/auth"
+	"github.com/coding-cave-dev/nimbul/internal/deploytokens"
 	"github.com/danielgtaylor/huma/v2"
 )
 
 type contextKey string
 
 const (
-	userIDKey contextKey = "userID"
-	emailKey  contextKey = "email"
+	userIDKey  contextKey = "userID"
+	emailKey   contextKey = "email"
+	roleKey    contextKey = "role"
+	agentIDKey contextKey = "agentID"
+	sessionKey contextKey = "sessionID"
+	clientKey  contextKey = "client"
+
+	deployTokenKey contextKey = "deployToken"
 )
 
 // AuthResolver is a reusable resolver that extracts and validates JWT tokens
@@ -22,7 +34,9 @@ type AuthResolver struct {
 }
 
 // ValidateAuth validates the JWT token from the Authorization header and injects
-// user information into the context. Returns an error if the token is missing or invalid.
+// user information into the context. Returns an error if the token is missing or invalid,
+// if its session was revoked, or if the account was disabled or deleted since the token
+// was issued.
 func ValidateAuth(ctx context.Context, authHeader string, authService *auth.Service) (context.Context, error) {
 	if authHeader == "" {
 		return ctx, huma.Error401Unauthorized("Missing Authorization header")
@@ -37,14 +51,51 @@ func ValidateAuth(ctx context.Context, authHeader string, authService *auth.Serv
 	token := parts[1]
 
 	// Validate token and get user ID
-	userID, email, err := authService.ValidateToken(token)
+	userID, email, sessionID, err := authService.ValidateToken(token)
 	if err != nil {
 		return ctx, huma.Error401Unauthorized("Invalid or expired token")
 	}
 
+	role, err := authService.Authorize(ctx, userID)
+	if err != nil {
+		if errors.Is(err, auth.ErrAccountDisabled) {
+			return ctx, huma.Error403Forbidden("Account is disabled")
+		}
+		if errors.Is(err, auth.ErrUserNotFound) {
+			return ctx, huma.Error401Unauthorized("Invalid or expired token")
+		}
+		return ctx, huma.Error500InternalServerError("Failed to authorize user", err)
+	}
+
+	// Sessions can be revoked before their token expires
+	if err := authService.CheckSession(ctx, userID, sessionID); err != nil {
+		if errors.Is(err, auth.ErrInvalidToken) {
+			return ctx, huma.Error401Unauthorized("Invalid or expired token")
+		}
+		return ctx, huma.Error500InternalServerError("Failed to check session", err)
+	}
+
 	// Inject user info into context
 	ctx = context.WithValue(ctx, userIDKey, userID)
 	ctx = context.WithValue(ctx, emailKey, email)
+	ctx = context.WithValue(ctx, roleKey, role)
+	ctx = context.WithValue(ctx, sessionKey, sessionID)
+	setRequestUser(ctx, userID)
+
+	return ctx, nil
+}
+
+// ValidateAdminAuth validates the JWT token like ValidateAuth and additionally requires
+// the user to be an admin.
+func ValidateAdminAuth(ctx context.Context, authHeader string, authService *auth.Service) (context.Context, error) {
+	ctx, err := ValidateAuth(ctx, authHeader, authService)
+	if err != nil {
+		return ctx, err
+	}
+
+	if GetUserRole(ctx) != auth.RoleAdmin {
+		return ctx, huma.Error403Forbidden("Admin role required")
+	}
 
 	return ctx, nil
 }
@@ -64,3 +115,117 @@ func GetUserEmail(ctx context.Context) string {
 	}
 	return ""
 }
+
+// GetUserRole extracts the user's role from the context set by ValidateAuth.
+func GetUserRole(ctx context.Context) string {
+	if role, ok := ctx.Value(roleKey).(string); ok {
+		return role
+	}
+	return ""
+}
+
+// GetSessionID extracts the ID of the session the request was made with, set by ValidateAuth.
+func GetSessionID(ctx context.Context) string {
+	if sessionID, ok := ctx.Value(sessionKey).(string); ok {
+		return sessionID
+	}
+	return ""
+}
+
+// ClientMiddleware records the user agent and address of the client in the context,
+// for the sessions started by logins. X-Forwarded-For is only trusted when
+// NIMBUL_TRUST_PROXY_HEADERS is "true", since clients can set it to anything.
+func ClientMiddleware(ctx huma.Context, next func(huma.Context)) {
+	ip := ctx.RemoteAddr()
+	if host, _, err := net.SplitHostPort(ip); err == nil {
+		ip = host
+	}
+
+	if os.Getenv("NIMBUL_TRUST_PROXY_HEADERS") == "true" {
+		// The first address is the client, the rest are proxies
+		if forwarded := ctx.Header("X-Forwarded-For"); forwarded != "" {
+			ip = strings.TrimSpace(strings.Split(forwarded, ",")[0])
+		}
+	}
+
+	next(huma.WithValue(ctx, clientKey, auth.Client{
+		UserAgent: ctx.Header("User-Agent"),
+		IP:        ip,
+	}))
+}
+
+// GetClient extracts the client information set by ClientMiddleware.
+func GetClient(ctx context.Context) auth.Client {
+	if client, ok := ctx.Value(clientKey).(auth.Client); ok {
+		return client
+	}
+	return auth.Client{}
+}
+
+// ValidateAgentAuth validates an agent token from the Authorization header and injects
+// the agent ID into the context. Agents authenticate with their own tokens, not user JWTs.
+func ValidateAgentAuth(ctx context.Context, authHeader string, agentsService *agents.Service) (context.Context, error) {
+	if authHeader == "" {
+		return ctx, huma.Error401Unauthorized("Missing Authorization header")
+	}
+
+	// Extract Bearer token
+	parts := strings.Split(authHeader, " ")
+	if len(parts) != 2 || parts[0] != "Bearer" {
+		return ctx, huma.Error401Unauthorized("Invalid Authorization header format")
+	}
+
+	agent, err := agentsService.Authenticate(ctx, parts[1])
+	if err != nil {
+		return ctx, huma.Error401Unauthorized("Invalid agent token")
+	}
+
+	ctx = context.WithValue(ctx, agentIDKey, agent.ID)
+
+	return ctx, nil
+}
+
+// GetAgentID extracts the agent ID from the context set by ValidateAgentAuth.
+func GetAgentID(ctx context.Context) string {
+	if agentID, ok := ctx.Value(agentIDKey).(string); ok {
+		return agentID
+	}
+	return ""
+}
+
+// ValidateDeployTokenAuth validates a deploy token from the Authorization header and
+// injects it into the context. Deploy tokens only work on the endpoints calling this,
+// user JWTs and agent tokens do not.
+func ValidateDeployTokenAuth(ctx context.Context, authHeader string, deployTokensService *deploytokens.Service) (context.Context, error) {
+	if authHeader == "" {
+		return ctx, huma.Error401Unauthorized("Missing Authorization header")
+	}
+
+	// Extract Bearer token
+	parts := strings.Split(authHeader, " ")
+	if len(parts) != 2 || parts[0] != "Bearer" {
+		return ctx, huma.Error401Unauthorized("Invalid Authorization header format")
+	}
+
+	deployToken, err := deployTokensService.Authenticate(ctx, parts[1])
+	if err != nil {
+		if errors.Is(err, deploytokens.ErrInvalidToken) {
+			return ctx, huma.Error401Unauthorized("Invalid deploy token")
+		}
+		fmt.Println("Error authenticating deploy token:", err)
+		return ctx, huma.Error500InternalServerError("Failed to authenticate deploy token", err)
+	}
+
+	ctx = context.WithValue(ctx, deployTokenKey, deployToken)
+
+	return ctx, nil
+}
+
+// GetDeployToken extracts the deploy token from the context set by
+// ValidateDeployTokenAuth.
+func GetDeployToken(ctx context.Context) *deploytokens.DeployToken {
+	if deployToken, ok := ctx.Value(deployTokenKey).(*deploytokens.DeployToken); ok {
+		return deployToken
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+package httpserver
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+// DefaultProblemDocsURL is where the troubleshooting pages of problem types are hosted,
+// overridden with NIMBUL_PROBLEM_DOCS_URL
+const DefaultProblemDocsURL = "https://nimbul.dev/docs/errors/"
+
+// problemTypes names the troubleshooting page of error responses, by their detail
+var problemTypes = map[string]string{
+	"Missing Authorization header":                                       "not-logged-in",
+	"Invalid Authorization header format":                                "not-logged-in",
+	"Invalid or expired token":                                           "token-expired",
+	"Account is disabled":                                                "account-disabled",
+	"GitHub access token not found":                                      "github-not-connected",
+	"GitHub refresh token not found":                                     "github-not-connected",
+	"GitHub tokens expired. Please reconnect your GitHub account":        "github-token-expired",
+	"GitHub refresh token expired. Please reconnect your GitHub account": "github-token-expired",
+	"Invalid or expired password reset token":                            "reset-token-expired",
+	"Invalid or expired email change token":                              "email-change-token-expired",
+	"If-Match does not match the current ETag":                           "version-mismatch",
+}
+
+// problemPrefixes names the troubleshooting page of error responses whose detail
+// starts with a prefix, for details that carry specifics
+var problemPrefixes = map[string]string{
+	"usage quota exceeded":         "quota-exceeded",
+	"config limit reached":         "config-limit",
+	"GitHub App is not installed":  "github-app-not-installed",
+	"webhook delivery is older":    "webhook-replay",
+	"webhook delivery was already": "webhook-replay",
+}
+
+// problemStatuses names the troubleshooting page of other error responses, by status
+var problemStatuses = map[int]string{
+	http.StatusBadRequest:            "invalid-request",
+	http.StatusUnauthorized:          "unauthorized",
+	http.StatusForbidden:             "forbidden",
+	http.StatusNotFound:              "not-found",
+	http.StatusConflict:              "conflict",
+	http.StatusPreconditionFailed:    "version-mismatch",
+	http.StatusRequestEntityTooLarge: "payload-too-large",
+	http.StatusUnprocessableEntity:   "invalid-request",
+	http.StatusTooManyRequests:       "rate-limited",
+}
+
+// problemType returns the slug of the troubleshooting page of an error response
+func problemType(status int, detail string) string {
+	if slug, ok := problemTypes[detail]; ok {
+		return slug
+	}
+	for prefix, slug := range problemPrefixes {
+		if strings.HasPrefix(detail, prefix) {
+			return slug
+		}
+	}
+	if slug, ok := problemStatuses[status]; ok {
+		return slug
+	}
+	if status >= 500 {
+		return "server-error"
+	}
+	return ""
+}
+
+// messageCatalog translates the titles and details of error responses, by language
+// and English message
+type messageCatalog map[string]map[string]string
+
+// loadMessageCatalog reads the catalog at NIMBUL_MESSAGE_CATALOG, a JSON object of
+// languages to English messages to their translation:
+//
+//	{"de": {"Invalid or expired token": "Ungültiges oder abgelaufenes Token"}}
+//
+// Without one, errors are only reported in English.
+func loadMessageCatalog() (messageCatalog, error) {
+	path := os.Getenv("NIMBUL_MESSAGE_CATALOG")
+	if path == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read message catalog: %w", err)
+	}
+
+	var catalog messageCatalog
+	if err := json.Unmarshal(data, &catalog); err != nil {
+		return nil, fmt.Errorf("failed to parse message catalog: %w", err)
+	}
+
+	// Languages match case-insensitively
+	normalized := make(messageCatalog, len(catalog))
+	for lang, messages := range catalog {
+		normalized[strings.ToLower(lang)] = messages
+	}
+	return normalized, nil
+}
+
+// language picks the catalog language best matching an Accept-Language header, or ""
+// to answer in English. A regional language like de-AT falls back to de.
+func (c messageCatalog) language(acceptLanguage string) string {
+	type preference struct {
+		lang    string
+		quality float64
+	}
+
+	var preferences []preference
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		lang, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		quality := 1.0
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if parsed, err := strconv.ParseFloat(q, 64); err == nil {
+				quality = parsed
+			}
+		}
+		if lang != "" && quality > 0 {
+			preferences = append(preferences, preference{strings.ToLower(lang), quality})
+		}
+	}
+	slices.SortStableFunc(preferences, func(a, b preference) int {
+		return cmp.Compare(b.quality, a.quality)
+	})
+
+	for _, p := range preferences {
+		// English is what the API speaks already
+		if p.lang == "en" || strings.HasPrefix(p.lang, "en-") {
+			return ""
+		}
+		if _, ok := c[p.lang]; ok {
+			return p.lang
+		}
+		if base, _, ok := strings.Cut(p.lang, "-"); ok {
+			if _, ok := c[base]; ok {
+				return base
+			}
+		}
+	}
+	return ""
+}
+
+// problemTransformer links error responses to the troubleshooting page of their problem
+// in their type, and translates them into the language the client accepts when the
+// catalog has it
+func problemTransformer(docsURL string, catalog messageCatalog) huma.Transformer {
+	return func(ctx huma.Context, status string, v any) (any, error) {
+		problem, ok := v.(*huma.ErrorModel)
+		if !ok {
+			return v, nil
+		}
+
+		if problem.Type == "" || problem.Type == "about:blank" {
+			if slug := problemType(problem.Status, problem.Detail); slug != "" {
+				problem.Type = docsURL + slug
+			}
+		}
+
+		if catalog == nil {
+			return problem, nil
+		}
+		ctx.AppendHeader("Vary", "Accept-Language")
+		if lang := catalog.language(ctx.Header("Accept-Language")); lang != "" {
+			messages := catalog[lang]
+			if title, ok := messages[problem.Title]; ok {
+				problem.Title = title
+			}
+			if detail, ok := messages[problem.Detail]; ok {
+				problem.Detail = detail
+			}
+			ctx.SetHeader("Content-Language", lang)
+		}
+
+		return problem, nil
+	}
+}
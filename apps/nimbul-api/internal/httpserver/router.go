@@ -1,23 +1,65 @@
 package httpserver
 
 import (
+	"bufio"
+	"bytes"
+	"cmp"
 	"context"
+	"crypto/subtle"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"net"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/coding-cave-dev/nimbul/internal/activity"
+	"github.com/coding-cave-dev/nimbul/internal/admin"
+	"github.com/coding-cave-dev/nimbul/internal/agents"
+	"github.com/coding-cave-dev/nimbul/internal/artifacts"
 	"github.com/coding-cave-dev/nimbul/internal/auth"
+	"github.com/coding-cave-dev/nimbul/internal/bootstrap"
+	"github.com/coding-cave-dev/nimbul/internal/builds"
 	"github.com/coding-cave-dev/nimbul/internal/configs"
 	"github.com/coding-cave-dev/nimbul/internal/credentials"
+	"github.com/coding-cave-dev/nimbul/internal/dashboard"
 	"github.com/coding-cave-dev/nimbul/internal/db"
+	"github.com/coding-cave-dev/nimbul/internal/deliveries"
+	"github.com/coding-cave-dev/nimbul/internal/deployments"
+	"github.com/coding-cave-dev/nimbul/internal/deploytokens"
+	"github.com/coding-cave-dev/nimbul/internal/email"
+	"github.com/coding-cave-dev/nimbul/internal/github"
+	"github.com/coding-cave-dev/nimbul/internal/githubapp"
+	"github.com/coding-cave-dev/nimbul/internal/hooks"
+	"github.com/coding-cave-dev/nimbul/internal/installations"
+	"github.com/coding-cave-dev/nimbul/internal/jobs"
+	"github.com/coding-cave-dev/nimbul/internal/k8s"
+	"github.com/coding-cave-dev/nimbul/internal/limits"
+	"github.com/coding-cave-dev/nimbul/internal/notifications"
+	"github.com/coding-cave-dev/nimbul/internal/oidc"
+	"github.com/coding-cave-dev/nimbul/internal/policies"
+	"github.com/coding-cave-dev/nimbul/internal/previews"
+	"github.com/coding-cave-dev/nimbul/internal/reconcile"
+	"github.com/coding-cave-dev/nimbul/internal/registry"
+	"github.com/coding-cave-dev/nimbul/internal/releases"
+	"github.com/coding-cave-dev/nimbul/internal/retention"
+	"github.com/coding-cave-dev/nimbul/internal/sandbox"
+	"github.com/coding-cave-dev/nimbul/internal/secretscan"
+	"github.com/coding-cave-dev/nimbul/internal/storage"
+	"github.com/coding-cave-dev/nimbul/internal/trash"
+	"github.com/coding-cave-dev/nimbul/internal/twofactor"
+	"github.com/coding-cave-dev/nimbul/internal/usage"
+	"github.com/coding-cave-dev/nimbul/internal/version"
 	"github.com/coding-cave-dev/nimbul/internal/webhooks"
 	"github.com/danielgtaylor/huma/v2"
 	"github.com/danielgtaylor/huma/v2/adapters/humafiber"
 	"github.com/gofiber/fiber/v2"
-	"github.com/google/go-github/v81/github"
+	ghub "github.com/google/go-github/v81/github"
+	"golang.org/x/oauth2"
+	"k8s.io/client-go/rest"
 )
 
 type HealthCheckResponse struct {
@@ -26,6 +68,27 @@ type HealthCheckResponse struct {
 	}
 }
 
+type GetVersionResponse struct {
+	Body struct {
+		Version       string `json:"version" doc:"Release of the server, dev for builds that are not a release"`
+		MinCLIVersion string `json:"min_cli_version" doc:"Oldest CLI release the API works with"`
+	}
+}
+
+type CLIReleaseAssetResponse struct {
+	Name string `json:"name" doc:"nimbul_<os>_<arch>, with .exe on Windows, checksums.txt or checksums.txt.sig"`
+	URL  string `json:"url"`
+}
+
+type GetCLIReleaseResponse struct {
+	Body struct {
+		Version     string                    `json:"version"`
+		URL         string                    `json:"url" doc:"Release page"`
+		PublishedAt time.Time                 `json:"published_at"`
+		Assets      []CLIReleaseAssetResponse `json:"assets"`
+	}
+}
+
 type RegisterRequest struct {
 	Body struct {
 		Email    string `json:"email"`
@@ -44,37 +107,310 @@ type LoginRequest struct {
 	Body struct {
 		Email    string `json:"email"`
 		Password string `json:"password"`
+		Code     string `json:"code,omitempty" doc:"Two-factor code or recovery code, for accounts with two-factor authentication"`
 	}
 }
 
 type LoginResponse struct {
 	Body struct {
-		Token string            `json:"token"`
-		User  auth.UserResponse `json:"user"`
+		Token             string            `json:"token"`
+		User              auth.UserResponse `json:"user"`
+		TwoFactorRequired bool              `json:"two_factor_required,omitempty" doc:"The password was correct but a two-factor code is required; no token is issued"`
+	}
+}
+
+type StartOIDCLoginResponse struct {
+	Body struct {
+		DeviceCode              string    `json:"device_code" doc:"Code to exchange for a session with POST /login/oidc/token"`
+		UserCode                string    `json:"user_code" doc:"Code the user enters at the verification URI"`
+		VerificationURI         string    `json:"verification_uri"`
+		VerificationURIComplete string    `json:"verification_uri_complete,omitempty" doc:"Verification URI with the user code filled in"`
+		Interval                int64     `json:"interval" doc:"Seconds to wait between polls of the identity provider"`
+		ExpiresAt               time.Time `json:"expires_at"`
+	}
+}
+
+type CompleteOIDCLoginRequest struct {
+	Body struct {
+		DeviceCode string    `json:"device_code"`
+		Interval   int64     `json:"interval"`
+		ExpiresAt  time.Time `json:"expires_at"`
+	}
+}
+
+type RequestPasswordResetRequest struct {
+	Body struct {
+		Email string `json:"email"`
+	}
+}
+
+type RequestPasswordResetResponse struct {
+	Body struct {
+		Success bool `json:"success"`
+	}
+}
+
+type ResetPasswordRequest struct {
+	Body struct {
+		Token    string `json:"token"`
+		Password string `json:"password"`
+	}
+}
+
+type ResetPasswordResponse struct {
+	Body struct {
+		Success bool `json:"success"`
 	}
 }
 
+type NotificationPreferencesBody struct {
+	BuildFailed      bool   `json:"build_failed" doc:"Email when a build fails"`
+	DeployFailed     bool   `json:"deploy_failed" doc:"Email when a deploy fails"`
+	CredentialExpiry bool   `json:"credential_expiry" doc:"Email before a stored credential expires"`
+	SlackWebhookURL  string `json:"slack_webhook_url,omitempty" doc:"Slack incoming webhook that also receives these notifications"`
+}
+
+type GetNotificationPreferencesRequest struct {
+	AuthResolver
+}
+
+type GetNotificationPreferencesResponse struct {
+	Body NotificationPreferencesBody
+}
+
+type UpdateNotificationPreferencesRequest struct {
+	AuthResolver
+	Body NotificationPreferencesBody
+}
+
+type UpdateNotificationPreferencesResponse struct {
+	Body NotificationPreferencesBody
+}
+
 type MeRequest struct {
 	AuthResolver
 }
 
+type ExpiringCredentialResponse struct {
+	Provider  string    `json:"provider"`
+	TokenType string    `json:"token_type"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Expired   bool      `json:"expired"`
+}
+
+type MeResponseBody struct {
+	ID    string `json:"id"`
+	Email string `json:"email"`
+	Role  string `json:"role"`
+	// TwoFactorEnabled reports whether password logins require a code. OIDC logins never
+	// do, since the identity provider handles MFA.
+	TwoFactorEnabled bool `json:"two_factor_enabled"`
+	// ExpiringCredentials warns about credentials that expire soon, for clients to show as a banner
+	ExpiringCredentials []ExpiringCredentialResponse `json:"expiring_credentials,omitempty"`
+}
+
 type MeResponse struct {
-	Body auth.UserResponse `json:"body"`
+	Body MeResponseBody `json:"body"`
+}
+
+type UpdateMeRequest struct {
+	AuthResolver
+	Body struct {
+		Email           string `json:"email,omitempty" doc:"New email; it changes once the token emailed to it is confirmed"`
+		CurrentPassword string `json:"current_password" doc:"Required to change the email or the password"`
+		NewPassword     string `json:"new_password,omitempty" doc:"New password; other sessions are logged out"`
+	}
+}
+
+type UpdateMeResponse struct {
+	Body struct {
+		User            auth.UserResponse `json:"user"`
+		PendingEmail    string            `json:"pending_email,omitempty" doc:"Email waiting to be confirmed with POST /email-change/confirm"`
+		PasswordChanged bool              `json:"password_changed"`
+	}
+}
+
+type ConfirmEmailChangeRequest struct {
+	Body struct {
+		Token string `json:"token"`
+	}
+}
+
+type ConfirmEmailChangeResponse struct {
+	Body struct {
+		User auth.UserResponse `json:"user"`
+	}
+}
+
+type DeleteMeRequest struct {
+	AuthResolver
+	Body struct {
+		Password string `json:"password" doc:"Current password, to confirm the deletion"`
+	}
+}
+
+type DeleteMeResponse struct {
+	Body struct {
+		Success bool `json:"success"`
+	}
+}
+
+type EnrollTwoFactorRequest struct {
+	AuthResolver
+}
+
+type EnrollTwoFactorResponse struct {
+	Body struct {
+		Secret          string `json:"secret" doc:"Base32 TOTP secret, for authenticator apps that cannot scan QR codes"`
+		ProvisioningURI string `json:"provisioning_uri" doc:"otpauth:// URI to show as a QR code"`
+	}
+}
+
+type ConfirmTwoFactorRequest struct {
+	AuthResolver
+	Body struct {
+		Code string `json:"code" doc:"Current code from the authenticator app"`
+	}
+}
+
+type ConfirmTwoFactorResponse struct {
+	Body struct {
+		RecoveryCodes []string `json:"recovery_codes" doc:"Single-use codes for logging in without the authenticator; they are not shown again"`
+	}
+}
+
+type DisableTwoFactorRequest struct {
+	AuthResolver
+	Body struct {
+		Code string `json:"code" doc:"Current code from the authenticator app, or a recovery code"`
+	}
+}
+
+type DisableTwoFactorResponse struct {
+	Body struct {
+		Success bool `json:"success"`
+	}
+}
+
+type SetDefaultRegistryCredentialRequest struct {
+	AuthResolver
+	Body struct {
+		CredentialID int64 `json:"credential_id" doc:"Stored registry_login credential"`
+	}
+}
+
+type SetDefaultRegistryCredentialResponse struct {
+	Body struct {
+		Success bool `json:"success"`
+	}
+}
+
+type ClearDefaultRegistryCredentialRequest struct {
+	AuthResolver
+}
+
+type SessionResponse struct {
+	ID         string    `json:"id"`
+	UserAgent  string    `json:"user_agent" doc:"Client that logged in"`
+	IP         string    `json:"ip" doc:"Address the login came from"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	Current    bool      `json:"current" doc:"The session this request was made with"`
+}
+
+type ListSessionsRequest struct {
+	AuthResolver
+}
+
+type ListSessionsResponse struct {
+	Body struct {
+		Sessions []SessionResponse `json:"sessions"`
+	}
+}
+
+type RevokeSessionRequest struct {
+	AuthResolver
+	ID string `path:"id"`
+}
+
+type RevokeSessionResponse struct {
+	Body struct {
+		Success bool `json:"success"`
+	}
 }
 
 type StoreCredentialRequest struct {
 	AuthResolver
 	Body struct {
-		Provider  string    `json:"provider"`
-		TokenType string    `json:"token_type"`
-		Token     string    `json:"token"`
-		ExpiresAt time.Time `json:"expires_at"`
+		Provider   string    `json:"provider"`
+		TokenType  string    `json:"token_type"`
+		Token      string    `json:"token"`
+		ExpiresAt  time.Time `json:"expires_at"`
+		ExternalID string    `json:"external_id,omitempty" doc:"Client-chosen ID; storing again with it returns the credential stored the first time"`
 	}
 }
 
 type StoreCredentialResponse struct {
+	ETag string `header:"ETag"`
 	Body struct {
-		CredentialID int64 `json:"credential_id"`
+		CredentialID int64              `json:"credential_id"`
+		Credential   CredentialResponse `json:"credential"`
+	}
+}
+
+type CredentialResponse struct {
+	ID         int64      `json:"id"`
+	Provider   string     `json:"provider"`
+	TokenType  string     `json:"token_type"`
+	ExternalID *string    `json:"external_id,omitempty"`
+	Version    int32      `json:"version" doc:"Bumped on every change, the ETag of the credential"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty" doc:"When a build or deploy last used the credential, to the minute; unset when never used"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+}
+
+type ListCredentialsRequest struct {
+	AuthResolver
+}
+
+type ListCredentialsResponse struct {
+	Body struct {
+		Credentials []CredentialResponse `json:"credentials"`
+	}
+}
+
+type GetCredentialRequest struct {
+	AuthResolver
+	ID int64 `path:"id"`
+}
+
+type GetCredentialResponse struct {
+	ETag string `header:"ETag"`
+	Body struct {
+		Credential CredentialResponse `json:"credential"`
+	}
+}
+
+type ReplaceCredentialRequest struct {
+	AuthResolver
+	ID      int64  `path:"id"`
+	IfMatch string `header:"If-Match" doc:"ETag the credential must still have"`
+	Body    struct {
+		Token     string    `json:"token"`
+		ExpiresAt time.Time `json:"expires_at,omitempty" doc:"Unset for tokens that do not expire"`
+	}
+}
+
+type DeleteCredentialRequest struct {
+	AuthResolver
+	ID      int64  `path:"id"`
+	IfMatch string `header:"If-Match" doc:"ETag the credential must still have"`
+}
+
+type DeleteCredentialResponse struct {
+	Body struct {
+		Success bool `json:"success"`
 	}
 }
 
@@ -96,14 +432,157 @@ type CreateConfigRequest struct {
 		RepoName       string `json:"repo_name"`
 		RepoFullName   string `json:"repo_full_name"`
 		RepoCloneURL   string `json:"repo_clone_url"`
-		DockerfilePath string `json:"dockerfile_path"`
+		DockerfilePath string `json:"dockerfile_path,omitempty" doc:"Dockerfile of the first build, empty for deploy-only configs"`
 		WebhookSecret  string `json:"webhook_secret"`
+		ExternalID     string `json:"external_id,omitempty" doc:"Client-chosen ID; creating again with it returns the config created the first time"`
 	}
 }
 
 type CreateConfigResponse struct {
+	ETag string `header:"ETag"`
+	Body struct {
+		ConfigID string         `json:"config_id"`
+		Config   ConfigResponse `json:"config"`
+	}
+}
+
+type ConfigResponse struct {
+	ID                  string     `json:"id"`
+	Provider            string     `json:"provider"`
+	RepoOwner           string     `json:"repo_owner"`
+	RepoName            string     `json:"repo_name"`
+	RepoFullName        string     `json:"repo_full_name"`
+	RepoCloneURL        string     `json:"repo_clone_url"`
+	DockerfilePath      string     `json:"dockerfile_path"`
+	ClusterCredentialID *int64     `json:"cluster_credential_id,omitempty"`
+	AgentID             *string    `json:"agent_id,omitempty"`
+	ExternalID          *string    `json:"external_id,omitempty"`
+	Health              string     `json:"health" doc:"Result of the last check against GitHub: unknown, ok, app_uninstalled, repo_not_found or webhook_missing"`
+	HealthDetail        string     `json:"health_detail,omitempty" doc:"What is wrong and how to fix it"`
+	HealthCheckedAt     *time.Time `json:"health_checked_at,omitempty"`
+	Version             int32      `json:"version" doc:"Bumped on every change, the ETag of the config"`
+	CreatedAt           time.Time  `json:"created_at"`
+	UpdatedAt           time.Time  `json:"updated_at"`
+}
+
+type GetConfigRequest struct {
+	AuthResolver
+	ID string `path:"id"`
+}
+
+type UpdateConfigRequest struct {
+	AuthResolver
+	ID      string `path:"id"`
+	IfMatch string `header:"If-Match" doc:"ETag the config must still have"`
+	Body    struct {
+		RepoCloneURL   *string `json:"repo_clone_url,omitempty"`
+		DockerfilePath *string `json:"dockerfile_path,omitempty"`
+		WebhookSecret  *string `json:"webhook_secret,omitempty"`
+	}
+}
+
+type UpdateConfigResponse struct {
+	ETag string `header:"ETag"`
+	Body struct {
+		Config ConfigResponse `json:"config"`
+	}
+}
+
+type DeleteConfigRequest struct {
+	AuthResolver
+	ID      string `path:"id"`
+	IfMatch string `header:"If-Match" doc:"ETag the config must still have"`
+}
+
+type DeleteConfigResponse struct {
+	Body struct {
+		Success bool `json:"success"`
+	}
+}
+
+type RestoreConfigRequest struct {
+	AuthResolver
+	ID string `path:"id"`
+}
+
+type RestoreConfigResponse struct {
+	ETag string `header:"ETag"`
+	Body struct {
+		Config ConfigResponse `json:"config"`
+	}
+}
+
+type RestoreCredentialRequest struct {
+	AuthResolver
+	ID int64 `path:"id"`
+}
+
+type RestoreCredentialResponse struct {
+	ETag string `header:"ETag"`
+	Body struct {
+		Credential CredentialResponse `json:"credential"`
+	}
+}
+
+type GetTrashRequest struct {
+	AuthResolver
+}
+
+type TrashedConfigResponse struct {
+	ID           string    `json:"id"`
+	RepoFullName string    `json:"repo_full_name"`
+	DeletedAt    time.Time `json:"deleted_at"`
+	PurgeAt      time.Time `json:"purge_at" doc:"When the config is deleted for good, with its deployments"`
+}
+
+type TrashedCredentialResponse struct {
+	ID        int64     `json:"id"`
+	Provider  string    `json:"provider"`
+	TokenType string    `json:"token_type"`
+	DeletedAt time.Time `json:"deleted_at"`
+	PurgeAt   time.Time `json:"purge_at" doc:"When the credential is deleted for good"`
+}
+
+type GetTrashResponse struct {
+	Body struct {
+		Configs     []TrashedConfigResponse     `json:"configs"`
+		Credentials []TrashedCredentialResponse `json:"credentials"`
+	}
+}
+
+type ConfigWebhookStatus struct {
+	GitHubWebhookID *int64 `json:"github_webhook_id,omitempty" doc:"ID of the GitHub webhook triggering builds, unset until it is created"`
+	RegistryWebhook bool   `json:"registry_webhook" doc:"Whether registry pushes redeploy the config"`
+}
+
+type BuildSummaryResponse struct {
+	ID         int64      `json:"id"`
+	Name       string     `json:"name"`
+	Ref        string     `json:"ref"`
+	CommitSHA  string     `json:"commit_sha"`
+	Status     string     `json:"status"`
+	Error      string     `json:"error,omitempty"`
+	StartedAt  time.Time  `json:"started_at"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+}
+
+type GetConfigResponse struct {
+	ETag string `header:"ETag"`
 	Body struct {
-		ConfigID string `json:"config_id"`
+		Config         ConfigResponse        `json:"config"`
+		Webhook        ConfigWebhookStatus   `json:"webhook"`
+		LastBuild      *BuildSummaryResponse `json:"last_build,omitempty"`
+		LastDeployment *DeploymentResponse   `json:"last_deployment,omitempty"`
+	}
+}
+
+type ListConfigsRequest struct {
+	AuthResolver
+}
+
+type ListConfigsResponse struct {
+	Body struct {
+		Configs []ConfigResponse `json:"configs"`
 	}
 }
 
@@ -112,6 +591,15 @@ type GitHubWebhookRequest struct {
 	SignatureHeader string `header:"X-Hub-Signature"`
 	HookId          int64  `header:"X-GitHub-Hook-ID"`
 	EventType       string `header:"X-GitHub-Event"`
+	DeliveryID      string `header:"X-GitHub-Delivery"`
+	Body            json.RawMessage
+	RawBody         []byte
+}
+
+type GitHubAppWebhookRequest struct {
+	SignatureHeader string `header:"X-Hub-Signature-256"`
+	EventType       string `header:"X-GitHub-Event"`
+	DeliveryID      string `header:"X-GitHub-Delivery"`
 	Body            json.RawMessage
 	RawBody         []byte
 }
@@ -130,78 +618,4741 @@ type UpdateConfigWebhookResponse struct {
 	}
 }
 
-type GetGitHubTokenRequest struct {
+type CreateConfigWebhookRequest struct {
 	AuthResolver
+	ID   string `path:"id"`
+	Body struct {
+		APIURL string `json:"api_url,omitempty" doc:"Base URL GitHub delivers to; NIMBUL_PUBLIC_URL takes precedence when the server sets it"`
+	}
 }
 
-type GetGitHubTokenResponse struct {
+type CreateConfigWebhookResponse struct {
 	Body struct {
-		Token string `json:"token"`
+		WebhookID int64  `json:"webhook_id"`
+		URL       string `json:"url"`
 	}
 }
 
-func NewRouter(queries *db.Queries) *fiber.App {
-	app := fiber.New()
-
-	api := humafiber.New(app, huma.DefaultConfig("Nimbul API", "1.0.0"))
+type UpdateConfigClusterRequest struct {
+	AuthResolver
+	ID   string `path:"id"`
+	Body struct {
+		CredentialID int64 `json:"credential_id"`
+	}
+}
 
-	jwtSecret := os.Getenv("JWT_SECRET")
-	if jwtSecret == "" {
-		jwtSecret = "default-secret-change-in-production"
+type UpdateConfigClusterResponse struct {
+	Body struct {
+		Success bool `json:"success"`
 	}
+}
 
-	authService := auth.NewService(queries, jwtSecret)
+type CreateAgentRequest struct {
+	AuthResolver
+	Body struct {
+		Name string `json:"name"`
+	}
+}
 
-	// Initialize credentials service
-	credentialsService, err := credentials.NewService(queries)
-	if err != nil {
-		panic(fmt.Sprintf("Failed to initialize credentials service: %v", err))
+type CreateAgentResponse struct {
+	Body struct {
+		AgentID string `json:"agent_id"`
+		Token   string `json:"token"`
 	}
+}
 
-	// Initialize configs service
-	configsService := configs.NewService(queries)
+type AgentResponse struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	LastSeenAt *time.Time `json:"last_seen_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+type ListAgentsRequest struct {
+	AuthResolver
+}
+
+type ListAgentsResponse struct {
+	Body struct {
+		Agents []AgentResponse `json:"agents"`
+	}
+}
+
+type UpdateConfigAgentRequest struct {
+	AuthResolver
+	ID   string `path:"id"`
+	Body struct {
+		AgentID string `json:"agent_id"`
+	}
+}
+
+type UpdateConfigAgentResponse struct {
+	Body struct {
+		Success bool `json:"success"`
+	}
+}
+
+type CreateDeployTokenRequest struct {
+	AuthResolver
+	ID   string `path:"id"`
+	Body struct {
+		Name string `json:"name" doc:"Name telling the token apart, e.g. the CI system using it"`
+	}
+}
+
+type CreateDeployTokenResponse struct {
+	Body struct {
+		DeployTokenResponse
+		Token string `json:"token" doc:"The deploy token, only returned now"`
+	}
+}
+
+type DeployTokenResponse struct {
+	ID         string     `json:"id"`
+	ConfigID   string     `json:"config_id"`
+	Name       string     `json:"name"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+type ListDeployTokensRequest struct {
+	AuthResolver
+	ID string `path:"id"`
+}
+
+type ListDeployTokensResponse struct {
+	Body struct {
+		DeployTokens []DeployTokenResponse `json:"deploy_tokens"`
+	}
+}
+
+type DeleteDeployTokenRequest struct {
+	AuthResolver
+	ID      string `path:"id"`
+	TokenID string `path:"token_id"`
+}
+
+type DeleteDeployTokenResponse struct {
+	Body struct {
+		Success bool `json:"success"`
+	}
+}
+
+type TriggerDeployRequest struct {
+	AuthResolver
+	Body struct {
+		Branch    string `json:"branch" doc:"Branch to build and deploy the head of"`
+		CommitSHA string `json:"commit_sha,omitempty" doc:"Commit the branch has to be at, e.g. the commit the CI run tested; the trigger fails when the branch moved on"`
+	}
+}
+
+type TriggerDeployResponse struct {
+	Body struct {
+		ConfigID  string `json:"config_id"`
+		Ref       string `json:"ref"`
+		CommitSHA string `json:"commit_sha"`
+		Queued    bool   `json:"queued" doc:"The run was handed to a worker; otherwise it ran before the response"`
+	}
+}
+
+type GetDeployStatusRequest struct {
+	AuthResolver
+	CommitSHA string `query:"commit_sha" doc:"Latest deployment of this commit instead of the latest one"`
+}
+
+type GetDeployStatusResponse struct {
+	Body struct {
+		Deployment DeploymentResponse `json:"deployment"`
+	}
+}
+
+type RetentionBody struct {
+	KeepLast       *int `json:"keep_last,omitempty" minimum:"1" doc:"Builds per branch whose tags are kept, all when unset"`
+	DeletePreviews bool `json:"delete_previews" doc:"Delete the tags of a branch's builds once the branch is deleted, e.g. after merging"`
+}
+
+type GetConfigRetentionRequest struct {
+	AuthResolver
+	ID string `path:"id"`
+}
+
+type GetConfigRetentionResponse struct {
+	Body RetentionBody
+}
+
+type UpdateConfigRetentionRequest struct {
+	AuthResolver
+	ID   string `path:"id"`
+	Body RetentionBody
+}
+
+type UpdateConfigRetentionResponse struct {
+	Body RetentionBody
+}
+
+type DeniedAuthorsBody struct {
+	Authors []string `json:"authors" doc:"Commit authors whose pushes don't trigger builds, matched case-insensitively against their GitHub login, name or email, e.g. dependabot[bot]"`
+}
+
+type GetConfigDeniedAuthorsRequest struct {
+	AuthResolver
+	ID string `path:"id"`
+}
+
+type GetConfigDeniedAuthorsResponse struct {
+	Body DeniedAuthorsBody
+}
+
+type UpdateConfigDeniedAuthorsRequest struct {
+	AuthResolver
+	ID   string `path:"id"`
+	Body DeniedAuthorsBody
+}
+
+type UpdateConfigDeniedAuthorsResponse struct {
+	Body DeniedAuthorsBody
+}
+
+type SecretScanBody struct {
+	Enabled   bool     `json:"enabled" doc:"Whether builds fail when files of their context look like secrets, before any image is pushed"`
+	Allowlist []string `json:"allowlist" doc:"Accepted findings: rule IDs, e.g. private-key, or path patterns relative to the repository in .dockerignore syntax, e.g. testdata"`
+}
+
+type GetConfigSecretScanRequest struct {
+	AuthResolver
+	ID string `path:"id"`
+}
+
+type GetConfigSecretScanResponse struct {
+	Body SecretScanBody
+}
+
+type UpdateConfigSecretScanRequest struct {
+	AuthResolver
+	ID   string `path:"id"`
+	Body SecretScanBody
+}
+
+type UpdateConfigSecretScanResponse struct {
+	Body SecretScanBody
+}
+
+type RotateRegistryWebhookRequest struct {
+	AuthResolver
+	ID string `path:"id"`
+}
+
+type RotateRegistryWebhookResponse struct {
+	Body struct {
+		Token string `json:"token" doc:"Token to pass as the token query parameter or Authorization header of registry webhooks"`
+	}
+}
+
+type DisableRegistryWebhookRequest struct {
+	AuthResolver
+	ID string `path:"id"`
+}
+
+type DisableRegistryWebhookResponse struct {
+	Body struct {
+		Success bool `json:"success"`
+	}
+}
+
+type ConfigEnvVarResponse struct {
+	Name      string    `json:"name"`
+	Value     string    `json:"value"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type ConfigEnvBody struct {
+	Env []ConfigEnvVarResponse `json:"env"`
+}
+
+type GetConfigEnvRequest struct {
+	AuthResolver
+	ID string `path:"id"`
+}
+
+type GetConfigEnvResponse struct {
+	Body ConfigEnvBody
+}
+
+type SetConfigEnvRequest struct {
+	AuthResolver
+	ID   string `path:"id"`
+	Body struct {
+		Vars map[string]string `json:"vars" doc:"Variables to set by name. Builds receive them as build args, nimbul.yaml templates as {{ .ENV.<name> }}. Not for secrets: values are stored and shown in plain text."`
+	}
+}
+
+type SetConfigEnvResponse struct {
+	Body ConfigEnvBody
+}
+
+type UnsetConfigEnvRequest struct {
+	AuthResolver
+	ID   string `path:"id"`
+	Name string `path:"name"`
+}
+
+type UnsetConfigEnvResponse struct {
+	Body struct {
+		Success bool `json:"success"`
+	}
+}
+
+type RegistryWebhookRequest struct {
+	ID            string `path:"id"`
+	Token         string `query:"token"`
+	Authorization string `header:"Authorization"`
+	RawBody       []byte
+}
+
+type RegistryWebhookResponse struct {
+	Body struct {
+		Deployed []string `json:"deployed" doc:"Pushed image tags that triggered a redeploy"`
+		Queued   []string `json:"queued" doc:"Pushed image tags handed to a worker, which redeploys when the manifests reference them"`
+		Ignored  []string `json:"ignored" doc:"Pushed image tags that were ignored, with the reason"`
+	}
+}
+
+type ExportConfigRequest struct {
+	AuthResolver
+	ID string `path:"id"`
+}
+
+type ExportConfigResponse struct {
+	Body configs.Bundle
+}
+
+type ImportConfigRequest struct {
+	AuthResolver
+	Body configs.Bundle
+}
+
+type ImportConfigResponse struct {
+	Body struct {
+		ConfigID      string   `json:"config_id"`
+		WebhookSecret string   `json:"webhook_secret" doc:"Secret to register with the repository webhook"`
+		Warnings      []string `json:"warnings"`
+	}
+}
+
+type SyncConfigsRequest struct {
+	AuthResolver
+}
+
+type SyncConfigResult struct {
+	Config      ConfigResponse `json:"config"`
+	RenamedFrom string         `json:"renamed_from,omitempty" doc:"Previous name of a renamed repository the config now follows"`
+	Error       string         `json:"error,omitempty" doc:"Why GitHub could not be checked; the config keeps its previous health"`
+}
+
+type SyncConfigsResponse struct {
+	Body struct {
+		Configs []SyncConfigResult `json:"configs"`
+	}
+}
+
+type TransferResponse struct {
+	ID           string    `json:"id"`
+	ConfigID     string    `json:"config_id"`
+	RepoFullName string    `json:"repo_full_name"`
+	FromEmail    string    `json:"from_email"`
+	ToEmail      string    `json:"to_email"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type StartConfigTransferRequest struct {
+	AuthResolver
+	ID   string `path:"id"`
+	Body struct {
+		Email string `json:"email" doc:"Email of the user to transfer the config to"`
+	}
+}
+
+type StartConfigTransferResponse struct {
+	Body struct {
+		Transfer TransferResponse `json:"transfer"`
+	}
+}
+
+type CancelConfigTransferRequest struct {
+	AuthResolver
+	ID string `path:"id"`
+}
+
+type CancelConfigTransferResponse struct {
+	Body struct {
+		Success bool `json:"success"`
+	}
+}
+
+type ListTransfersRequest struct {
+	AuthResolver
+}
+
+type ListTransfersResponse struct {
+	Body struct {
+		Transfers []TransferResponse `json:"transfers" doc:"Transfers waiting for you to accept them"`
+	}
+}
+
+type AcceptTransferRequest struct {
+	AuthResolver
+	ID string `path:"id"`
+}
+
+type AcceptTransferResponse struct {
+	Body struct {
+		Config   ConfigResponse `json:"config"`
+		Warnings []string       `json:"warnings"`
+	}
+}
+
+type DeclineTransferRequest struct {
+	AuthResolver
+	ID string `path:"id"`
+}
+
+type DeclineTransferResponse struct {
+	Body struct {
+		Success bool `json:"success"`
+	}
+}
+
+type AgentDeploymentResponse struct {
+	ID        int64  `json:"id"`
+	ConfigID  string `json:"config_id"`
+	Manifests string `json:"manifests"`
+}
+
+type GetNextAgentDeploymentRequest struct {
+	AuthResolver
+	Wait int `query:"wait" minimum:"0" maximum:"60" default:"30" doc:"Seconds to wait for a deployment before returning empty"`
+}
+
+type GetNextAgentDeploymentResponse struct {
+	Body struct {
+		Deployment *AgentDeploymentResponse `json:"deployment,omitempty"`
+	}
+}
+
+type ReportAgentDeploymentRequest struct {
+	AuthResolver
+	ID   int64 `path:"id"`
+	Body struct {
+		Status string `json:"status" enum:"applied,failed"`
+		Error  string `json:"error,omitempty"`
+	}
+}
+
+type ReportAgentDeploymentResponse struct {
+	Body struct {
+		Success bool `json:"success"`
+	}
+}
+
+type DeploymentResponse struct {
+	ID         int64     `json:"id"`
+	ConfigID   string    `json:"config_id"`
+	Ref        string    `json:"ref"`
+	CommitSHA  string    `json:"commit_sha"`
+	Status     string    `json:"status"`
+	Error      string    `json:"error,omitempty"`
+	DeployedBy string    `json:"deployed_by,omitempty" doc:"GitHub or registry user who pushed, or Nimbul user who rolled back"`
+	RollbackOf *int64    `json:"rollback_of,omitempty" doc:"Deployment this rollback went back to"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+type ListConfigDeploymentsRequest struct {
+	AuthResolver
+	ID     string `path:"id"`
+	Limit  int32  `query:"limit" minimum:"1" maximum:"100" default:"20"`
+	Before int64  `query:"before" minimum:"0" doc:"Only return deployments older than this deployment ID, for fetching the next page"`
+}
+
+type ListConfigDeploymentsResponse struct {
+	Body struct {
+		Deployments []DeploymentResponse `json:"deployments"`
+		NextBefore  *int64               `json:"next_before,omitempty" doc:"Pass as before to fetch the next page, unset on the last page"`
+	}
+}
+
+type EnvironmentResponse struct {
+	Namespace  string             `json:"namespace,omitempty" doc:"Preview namespace, unset for the namespaces the manifests name"`
+	Branch     string             `json:"branch" doc:"Branch last deployed there"`
+	Deployment DeploymentResponse `json:"deployment" doc:"Deployment live there"`
+	Builds     map[string]int64   `json:"builds" doc:"Build ID of each build name the deployment used"`
+	UpdatedAt  time.Time          `json:"updated_at"`
+}
+
+type ListConfigEnvironmentsRequest struct {
+	AuthResolver
+	ID string `path:"id"`
+}
+
+type ListConfigEnvironmentsResponse struct {
+	Body struct {
+		Environments []EnvironmentResponse `json:"environments"`
+	}
+}
+
+type GetDeploymentResourcesRequest struct {
+	AuthResolver
+	ID int64 `path:"id"`
+}
+
+type GetDeploymentManifestsRequest struct {
+	AuthResolver
+	ID int64 `path:"id"`
+}
+
+type DeploymentManifestResponse struct {
+	Path    string `json:"path" doc:"Manifest path in the repository"`
+	Content string `json:"content" doc:"Multi-document YAML sent to the cluster, with overrides and templates applied"`
+}
+
+type GetDeploymentManifestsResponse struct {
+	Body struct {
+		Deployment DeploymentResponse           `json:"deployment"`
+		Manifests  []DeploymentManifestResponse `json:"manifests" doc:"In the order they were applied, empty for deployments from before manifests were recorded"`
+	}
+}
+
+type RollbackDeploymentRequest struct {
+	AuthResolver
+	ID int64 `path:"id" doc:"Deployment to roll back to"`
+}
+
+type RollbackDeploymentResponse struct {
+	Body struct {
+		RolledBackTo DeploymentResponse  `json:"rolled_back_to"`
+		Deployment   *DeploymentResponse `json:"deployment,omitempty" doc:"Deployment created by the rollback, unset for configs deployed by an agent"`
+	}
+}
+
+type GetDeploymentResourcesResponse struct {
+	Body struct {
+		Deployment DeploymentResponse   `json:"deployment"`
+		Resources  []k8s.ResourceStatus `json:"resources"`
+	}
+}
+
+type ArtifactResponse struct {
+	Name      string    `json:"name"`
+	SizeBytes int64     `json:"size_bytes"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ListBuildArtifactsRequest struct {
+	AuthResolver
+	ID int64 `path:"id"`
+}
+
+type ListBuildArtifactsResponse struct {
+	Body struct {
+		Artifacts []ArtifactResponse `json:"artifacts"`
+	}
+}
+
+type DownloadBuildArtifactRequest struct {
+	AuthResolver
+	ID   int64  `path:"id"`
+	Name string `path:"name"`
+}
+
+type ProvenanceResponse struct {
+	Image         string          `json:"image"`
+	Digest        string          `json:"digest"`
+	PredicateType string          `json:"predicate_type"`
+	Statement     json.RawMessage `json:"statement" doc:"in-toto statement whose subject is the pushed image"`
+	CreatedAt     time.Time       `json:"created_at"`
+}
+
+type GetBuildRequest struct {
+	AuthResolver
+	ID int64 `path:"id"`
+}
+
+type BuildTimingResponse struct {
+	Name       string    `json:"name" doc:"e.g. clone, context, the name of a Dockerfile stage, push or deploy"`
+	Kind       string    `json:"kind" doc:"clone, context (uploading or cloning the build context), stage (the steps of a Dockerfile stage), push or deploy"`
+	StartedAt  time.Time `json:"started_at"`
+	DurationMs int64     `json:"duration_ms"`
+}
+
+type GetBuildResponse struct {
+	Body struct {
+		BuildSummaryResponse
+		ConfigID   string                `json:"config_id"`
+		PipelineID *int64                `json:"pipeline_id,omitempty"`
+		Timings    []BuildTimingResponse `json:"timings" doc:"How long each part of the build took, in the order they ran"`
+	}
+}
+
+type GetBuildProvenanceRequest struct {
+	AuthResolver
+	ID int64 `path:"id"`
+}
+
+type GetBuildProvenanceResponse struct {
+	Body struct {
+		Provenance []ProvenanceResponse `json:"provenance"`
+	}
+}
+
+type GetBuildPipelineRequest struct {
+	AuthResolver
+	ID int64 `path:"id"`
+}
+
+type PipelineStageResponse struct {
+	Name         string     `json:"name" doc:"e.g. clone, build:api, deploy:production"`
+	Kind         string     `json:"kind" doc:"clone, build, test or deploy"`
+	Needs        []string   `json:"needs" doc:"Names of the stages this stage runs after"`
+	Status       string     `json:"status" doc:"pending, running, succeeded, failed, canceled or skipped, when an earlier stage failed"`
+	Error        string     `json:"error,omitempty"`
+	BuildID      *int64     `json:"build_id,omitempty"`
+	DeploymentID *int64     `json:"deployment_id,omitempty"`
+	StartedAt    *time.Time `json:"started_at,omitempty"`
+	FinishedAt   *time.Time `json:"finished_at,omitempty"`
+}
+
+type GetBuildPipelineResponse struct {
+	Body struct {
+		ID         int64                   `json:"id"`
+		ConfigID   string                  `json:"config_id"`
+		Ref        string                  `json:"ref"`
+		CommitSHA  string                  `json:"commit_sha"`
+		Status     string                  `json:"status" doc:"running, succeeded, partial (some stages failed, the stages independent of them succeeded), failed or canceled"`
+		CreatedAt  time.Time               `json:"created_at"`
+		FinishedAt *time.Time              `json:"finished_at,omitempty"`
+		Stages     []PipelineStageResponse `json:"stages"`
+	}
+}
+
+type GetBuildConfigRequest struct {
+	AuthResolver
+	ID int64 `path:"id"`
+}
+
+type GetBuildConfigResponse struct {
+	Body struct {
+		BuildID    int64  `json:"build_id"`
+		PipelineID int64  `json:"pipeline_id"`
+		Ref        string `json:"ref"`
+		CommitSHA  string `json:"commit_sha"`
+		Config     string `json:"config" doc:"nimbul.yaml the build's run used, with its templates rendered"`
+	}
+}
+
+type GetBuildLogsRequest struct {
+	AuthResolver
+	ID int64 `path:"id"`
+}
+
+type BuildLogEntryResponse struct {
+	Time    time.Time `json:"time"`
+	Vertex  string    `json:"vertex,omitempty" doc:"Build step that logged the entry, e.g. [2/5] RUN go build"`
+	Stream  string    `json:"stream" doc:"stdout, stderr, status (a step started, finished or failed) or system (written by Nimbul)"`
+	Message string    `json:"message" doc:"Log line without ANSI escape sequences"`
+}
+
+type GetBuildLogsResponse struct {
+	Body struct {
+		Entries   []BuildLogEntryResponse `json:"entries"`
+		Truncated bool                    `json:"truncated" doc:"Whether the build wrote more than the log limit, the log then ends with a marker entry"`
+		Running   bool                    `json:"running" doc:"Whether the build is still running, the log then holds the entries so far"`
+	}
+}
+
+type CreateHookRequest struct {
+	AuthResolver
+	Body struct {
+		URL    string   `json:"url" format:"uri"`
+		Events []string `json:"events,omitempty" doc:"Event types to deliver, all when empty"`
+	}
+}
+
+type CreateHookResponse struct {
+	Body struct {
+		HookID string `json:"hook_id"`
+		Secret string `json:"secret" doc:"HMAC-SHA256 key for verifying the X-Nimbul-Signature header"`
+	}
+}
+
+type HookResponse struct {
+	ID             string     `json:"id"`
+	URL            string     `json:"url"`
+	Events         []string   `json:"events"`
+	LastDeliveryAt *time.Time `json:"last_delivery_at,omitempty"`
+	LastStatusCode *int       `json:"last_status_code,omitempty"`
+	LastError      string     `json:"last_error,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+type ListHooksRequest struct {
+	AuthResolver
+}
+
+type ListHooksResponse struct {
+	Body struct {
+		Hooks []HookResponse `json:"hooks"`
+	}
+}
+
+type DeleteHookRequest struct {
+	AuthResolver
+	ID string `path:"id"`
+}
+
+type DeleteHookResponse struct {
+	Body struct {
+		Success bool `json:"success"`
+	}
+}
+
+type PolicyResponse struct {
+	Name       string    `json:"name"`
+	Expression string    `json:"expression"`
+	Message    string    `json:"message"`
+	Action     string    `json:"action" enum:"warn,block"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+type ListPoliciesRequest struct {
+	AuthResolver
+}
+
+type ListPoliciesResponse struct {
+	Body struct {
+		Policies []PolicyResponse `json:"policies"`
+	}
+}
+
+type SetPolicyRequest struct {
+	AuthResolver
+	Name string `path:"name"`
+	Body struct {
+		Expression string `json:"expression" minLength:"1" doc:"CEL expression over the manifest, as object, and the repository of the config, as repo, that is true for manifests complying with the policy"`
+		Message    string `json:"message,omitempty" doc:"Reported for manifests that don't comply"`
+		Action     string `json:"action" enum:"warn,block" doc:"Whether deploys with manifests that don't comply go ahead with a warning or are blocked before anything is applied"`
+	}
+}
+
+type SetPolicyResponse struct {
+	Body PolicyResponse
+}
+
+type DeletePolicyRequest struct {
+	AuthResolver
+	Name string `path:"name"`
+}
+
+type DeletePolicyResponse struct {
+	Body struct {
+		Success bool `json:"success"`
+	}
+}
+
+type DeadJobResponse struct {
+	ID        int64     `json:"id"`
+	Kind      string    `json:"kind" enum:"github_push,image_push"`
+	ConfigID  string    `json:"config_id"`
+	Payload   any       `json:"payload" doc:"What the job was started with: the GitHub push event, or the image push"`
+	Error     string    `json:"error" doc:"Error of the last attempt"`
+	Attempts  int       `json:"attempts"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ListDeadJobsRequest struct {
+	AuthResolver
+	All bool `query:"all" doc:"List the dead jobs of every user, admins only"`
+}
+
+type ListDeadJobsResponse struct {
+	Body struct {
+		Jobs []DeadJobResponse `json:"jobs"`
+	}
+}
+
+type RequeueDeadJobRequest struct {
+	AuthResolver
+	ID int64 `path:"id"`
+}
+
+type RequeueDeadJobResponse struct {
+	Body struct {
+		Requeued bool `json:"requeued"`
+	}
+}
+
+type AdminUserResponse struct {
+	ID         string     `json:"id"`
+	Email      string     `json:"email"`
+	Role       string     `json:"role"`
+	DisabledAt *time.Time `json:"disabled_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+type ListAdminUsersRequest struct {
+	AuthResolver
+}
+
+type ListAdminUsersResponse struct {
+	Body struct {
+		Users []AdminUserResponse `json:"users"`
+	}
+}
+
+type UpdateAdminUserRequest struct {
+	AuthResolver
+	ID   string `path:"id"`
+	Body struct {
+		Disabled *bool  `json:"disabled,omitempty" doc:"Disable or re-enable the account"`
+		Role     string `json:"role,omitempty" enum:"user,admin" doc:"New role of the account"`
+	}
+}
+
+type UpdateAdminUserResponse struct {
+	Body AdminUserResponse
+}
+
+type AdminActivityResponse struct {
+	DeploymentID int64     `json:"deployment_id"`
+	ConfigID     string    `json:"config_id"`
+	RepoFullName string    `json:"repo_full_name"`
+	OwnerID      string    `json:"owner_id"`
+	OwnerEmail   string    `json:"owner_email"`
+	Ref          string    `json:"ref"`
+	CommitSHA    string    `json:"commit_sha"`
+	Status       string    `json:"status"`
+	Error        string    `json:"error,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+type GetAdminActivityRequest struct {
+	AuthResolver
+	Limit int `query:"limit" default:"50" minimum:"1" maximum:"500" doc:"Number of most recent deployments to return"`
+}
+
+type GetAdminActivityResponse struct {
+	Body struct {
+		Activity []AdminActivityResponse `json:"activity"`
+	}
+}
+
+type InstanceLimitsBody struct {
+	MaxConfigsPerUser     *int `json:"max_configs_per_user,omitempty" doc:"Most configs a user may create, unlimited when unset"`
+	MaxBuildMinutes       *int `json:"max_build_minutes,omitempty" doc:"Longest a single build may run, unlimited when unset"`
+	BuildMinutesSoftQuota *int `json:"build_minutes_soft_quota,omitempty" doc:"Build minutes per user per month before warning"`
+	BuildMinutesHardQuota *int `json:"build_minutes_hard_quota,omitempty" doc:"Build minutes per user per month before new builds are blocked"`
+	DeploysSoftQuota      *int `json:"deploys_soft_quota,omitempty" doc:"Deploys per user per month before warning"`
+	DeploysHardQuota      *int `json:"deploys_hard_quota,omitempty" doc:"Deploys per user per month before new builds are blocked"`
+	StorageMBSoftQuota    *int `json:"storage_mb_soft_quota,omitempty" doc:"Log and artifact storage per user, in MB, before warning"`
+	StorageMBHardQuota    *int `json:"storage_mb_hard_quota,omitempty" doc:"Log and artifact storage per user, in MB, before new builds are blocked"`
+	MaxLogMB              *int `json:"max_log_mb,omitempty" doc:"Log a single build stores, in MB; the rest is truncated"`
+	MaxArtifactMB         *int `json:"max_artifact_mb,omitempty" doc:"Artifacts a single build may store, in MB, before it fails"`
+
+	ConfigBuildMinutesSoftQuota *int `json:"config_build_minutes_soft_quota,omitempty" doc:"Build minutes per config per month before warning"`
+	ConfigBuildMinutesHardQuota *int `json:"config_build_minutes_hard_quota,omitempty" doc:"Build minutes per config per month before new builds of the config are blocked"`
+	ConfigStorageMBSoftQuota    *int `json:"config_storage_mb_soft_quota,omitempty" doc:"Log and artifact storage per config, in MB, before warning"`
+	ConfigStorageMBHardQuota    *int `json:"config_storage_mb_hard_quota,omitempty" doc:"Log and artifact storage per config, in MB, before new builds of the config are blocked"`
+
+	BuildRetentionDays *int `json:"build_retention_days,omitempty" doc:"Days finished builds are kept, with their logs and artifacts, and past deployments; forever when unset"`
+	LogRetentionDays   *int `json:"log_retention_days,omitempty" doc:"Days build logs are kept; as long as their build when unset"`
+}
+
+type UserRetentionBody struct {
+	BuildRetentionDays *int `json:"build_retention_days,omitempty" doc:"Days the user's finished builds are kept; the instance setting when unset"`
+	LogRetentionDays   *int `json:"log_retention_days,omitempty" doc:"Days the user's build logs are kept; the instance setting when unset"`
+}
+
+type GetUserRetentionRequest struct {
+	AuthResolver
+	ID string `path:"id"`
+}
+
+type GetUserRetentionResponse struct {
+	Body UserRetentionBody
+}
+
+type UpdateUserRetentionRequest struct {
+	AuthResolver
+	ID   string `path:"id"`
+	Body UserRetentionBody
+}
+
+type UpdateUserRetentionResponse struct {
+	Body UserRetentionBody
+}
+
+type RetentionRunResponse struct {
+	BuildsDeleted  int       `json:"builds_deleted"`
+	LogsDeleted    int       `json:"logs_deleted"`
+	BytesReclaimed int64     `json:"bytes_reclaimed"`
+	StartedAt      time.Time `json:"started_at"`
+	FinishedAt     time.Time `json:"finished_at"`
+}
+
+type GetRetentionStatsRequest struct {
+	AuthResolver
+	Limit int `query:"limit" default:"10" minimum:"1" maximum:"100" doc:"How many of the latest runs to return"`
+}
+
+type GetRetentionStatsResponse struct {
+	Body struct {
+		Runs           int64                  `json:"runs" doc:"Runs of the cleanup that deleted something"`
+		BuildsDeleted  int64                  `json:"builds_deleted"`
+		LogsDeleted    int64                  `json:"logs_deleted"`
+		BytesReclaimed int64                  `json:"bytes_reclaimed" doc:"Log and artifact storage freed by the cleanup"`
+		LastRunAt      *time.Time             `json:"last_run_at,omitempty"`
+		RecentRuns     []RetentionRunResponse `json:"recent_runs"`
+	}
+}
+
+type GitHubAppResponse struct {
+	AppID            int64      `json:"app_id,omitempty"`
+	Slug             string     `json:"slug" doc:"From the app's URL, github.com/apps/<slug>"`
+	ClientID         string     `json:"client_id,omitempty"`
+	HasPrivateKey    bool       `json:"has_private_key"`
+	HasClientSecret  bool       `json:"has_client_secret"`
+	HasWebhookSecret bool       `json:"has_webhook_secret"`
+	Source           string     `json:"source" enum:"database,environment" doc:"Whether admins stored the configuration or it comes from the server's environment variables"`
+	UpdatedAt        *time.Time `json:"updated_at,omitempty"`
+}
+
+type GetGitHubAppRequest struct {
+	AuthResolver
+}
+
+type GetGitHubAppResponse struct {
+	Body GitHubAppResponse
+}
+
+type UpdateGitHubAppRequest struct {
+	AuthResolver
+	Body struct {
+		AppID         int64  `json:"app_id" minimum:"1"`
+		Slug          string `json:"slug" minLength:"1" doc:"From the app's URL, github.com/apps/<slug>"`
+		ClientID      string `json:"client_id,omitempty" doc:"Client ID of the app, used by 'nimbul connect'"`
+		ClientSecret  string `json:"client_secret,omitempty" doc:"Client secret of the app, used to refresh GitHub tokens; the stored one is kept when omitted"`
+		PrivateKey    string `json:"private_key,omitempty" doc:"PEM encoded private key of the app; the stored one is kept when omitted"`
+		WebhookSecret string `json:"webhook_secret,omitempty" doc:"Secret of the app's webhook, which receives installation events; the stored one is kept when omitted"`
+	}
+}
+
+type UpdateGitHubAppResponse struct {
+	Body GitHubAppResponse
+}
+
+type DeleteGitHubAppRequest struct {
+	AuthResolver
+}
+
+type DeleteGitHubAppResponse struct {
+	Body GitHubAppResponse
+}
+
+type BootstrapRepositoryRequest struct {
+	AuthResolver
+	Owner string `path:"owner"`
+	Repo  string `path:"repo"`
+	Body  struct {
+		Dockerfile bool   `json:"dockerfile,omitempty" doc:"Add a starter Dockerfile when the repository has none at its root"`
+		Registry   string `json:"registry,omitempty" doc:"Registry prefix for generated image tags, ghcr.io/<owner> when unset"`
+	}
+}
+
+type BootstrapRepositoryResponse struct {
+	Body struct {
+		Number int      `json:"number" doc:"Number of the pull request"`
+		URL    string   `json:"url"`
+		Branch string   `json:"branch"`
+		Files  []string `json:"files" doc:"Paths the pull request adds"`
+		Notes  []string `json:"notes" doc:"What to look at before merging"`
+	}
+}
+
+type GetGitHubAppPublicRequest struct {
+	AuthResolver
+}
+
+type GetGitHubAppPublicResponse struct {
+	Body struct {
+		Slug     string `json:"slug"`
+		ClientID string `json:"client_id"`
+	}
+}
+
+type CheckGitHubInstallationRequest struct {
+	AuthResolver
+	ID int64 `path:"id" doc:"ID of the app installation"`
+}
+
+type CheckGitHubInstallationResponse struct {
+	Body struct {
+		Success bool `json:"success"`
+	}
+}
+
+type GetInstanceLimitsRequest struct {
+	AuthResolver
+}
+
+type GetInstanceLimitsResponse struct {
+	Body InstanceLimitsBody
+}
+
+type UpdateInstanceLimitsRequest struct {
+	AuthResolver
+	Body InstanceLimitsBody
+}
+
+type UpdateInstanceLimitsResponse struct {
+	Body InstanceLimitsBody
+}
+
+type ActivityEntryResponse struct {
+	Kind         string     `json:"kind" enum:"build,deployment,rollback"`
+	ID           int64      `json:"id" doc:"ID of the build or deployment"`
+	ConfigID     string     `json:"config_id"`
+	RepoFullName string     `json:"repo_full_name"`
+	Name         string     `json:"name,omitempty" doc:"Build name, for builds"`
+	Ref          string     `json:"ref"`
+	CommitSHA    string     `json:"commit_sha"`
+	Status       string     `json:"status"`
+	Error        string     `json:"error,omitempty"`
+	Actor        string     `json:"actor,omitempty" doc:"Who deployed or rolled back"`
+	RollbackOf   *int64     `json:"rollback_of,omitempty" doc:"Deployment a rollback went back to"`
+	StartedAt    time.Time  `json:"started_at"`
+	FinishedAt   *time.Time `json:"finished_at,omitempty"`
+}
+
+type GetActivityRequest struct {
+	AuthResolver
+	ConfigID string `query:"config_id" doc:"Only the activity of this config"`
+	Limit    int    `query:"limit" default:"50" minimum:"1" maximum:"500" doc:"Number of most recent entries to return"`
+}
+
+type GetActivityResponse struct {
+	Body struct {
+		Activity []ActivityEntryResponse `json:"activity"`
+	}
+}
+
+type GetUsageRequest struct {
+	AuthResolver
+	UserID string `query:"user_id" doc:"User to report on, admins only; defaults to the caller"`
+}
+
+type QuotaStatusResponse struct {
+	Metric string  `json:"metric" enum:"build_minutes,deploys,storage_mb"`
+	Used   float64 `json:"used"`
+	Soft   *int    `json:"soft,omitempty"`
+	Hard   *int    `json:"hard,omitempty"`
+	State  string  `json:"state" enum:"ok,warning,exceeded" doc:"warning past the soft quota, exceeded past the hard quota, which blocks new builds"`
+}
+
+type ConfigUsageResponse struct {
+	ConfigID     string                `json:"config_id"`
+	RepoFullName string                `json:"repo_full_name"`
+	Builds       int64                 `json:"builds"`
+	BuildMinutes float64               `json:"build_minutes"`
+	StorageBytes int64                 `json:"storage_bytes" doc:"Stored build logs and artifacts"`
+	Quotas       []QuotaStatusResponse `json:"quotas" doc:"Per-config quotas"`
+}
+
+type GetUsageResponse struct {
+	Body struct {
+		PeriodStart  time.Time             `json:"period_start"`
+		PeriodEnd    time.Time             `json:"period_end"`
+		Builds       int64                 `json:"builds"`
+		BuildMinutes float64               `json:"build_minutes"`
+		Deploys      int64                 `json:"deploys"`
+		StorageBytes int64                 `json:"storage_bytes" doc:"Stored build logs and artifacts"`
+		Quotas       []QuotaStatusResponse `json:"quotas"`
+		Configs      []ConfigUsageResponse `json:"configs" doc:"Usage of each config"`
+	}
+}
+
+type GetGitHubTokenRequest struct {
+	AuthResolver
+}
+
+type GetGitHubTokenResponse struct {
+	Body struct {
+		Token string `json:"token"`
+	}
+}
+
+type GetGitHubInstallationTokenRequest struct {
+	AuthResolver
+	ConfigID string `query:"config_id" required:"true" doc:"Config whose repository the token is for"`
+}
+
+type GetGitHubInstallationTokenResponse struct {
+	Body struct {
+		Token      string    `json:"token" doc:"GitHub App installation token that can only access the config's repository"`
+		ExpiresAt  time.Time `json:"expires_at"`
+		Repository string    `json:"repository"`
+	}
+}
+
+func NewRouter(queries *db.Queries) *fiber.App {
+	// Webhook payloads may be larger than other request bodies
+	webhookGuard := webhooks.NewGuardFromEnv()
+	app := fiber.New(fiber.Config{
+		BodyLimit: max(fiber.DefaultBodyLimit, webhookGuard.MaxBodyBytes),
+	})
+
+	// Registered before the routes so it sees every request
+	deliveriesService := deliveries.NewService(queries)
+	app.Use(LoggingMiddleware(deliveriesService))
+
+	// Error responses link the troubleshooting page of their problem, in the client's
+	// language when the message catalog has it
+	catalog, err := loadMessageCatalog()
+	if err != nil {
+		panic(fmt.Sprintf("Failed to load message catalog: %v", err))
+	}
+	apiConfig := huma.DefaultConfig("Nimbul API", "1.0.0")
+	apiConfig.Info.Description = "API of the Nimbul server, used by the nimbul CLI, the dashboard and the Go SDK. Authenticate with `Authorization: Bearer <token>`, the token 'nimbul login' stores."
+
+	// The OpenAPI document is served at /openapi.json and /openapi.yaml, with
+	// interactive docs at /docs, unless NIMBUL_API_DOCS=false turns them off
+	apiConfig.OpenAPIPath = "/openapi"
+	apiConfig.DocsPath = "/docs"
+	if os.Getenv("NIMBUL_API_DOCS") == "false" {
+		apiConfig.OpenAPIPath = ""
+		apiConfig.DocsPath = ""
+	}
+	// The docs send their requests to the public URL of a server behind a proxy
+	if publicURL := os.Getenv("NIMBUL_PUBLIC_URL"); publicURL != "" {
+		apiConfig.Servers = []*huma.Server{{URL: strings.TrimSuffix(publicURL, "/")}}
+	}
+	apiConfig.Transformers = append(apiConfig.Transformers, problemTransformer(cmp.Or(os.Getenv("NIMBUL_PROBLEM_DOCS_URL"), DefaultProblemDocsURL), catalog))
+	api := humafiber.New(app, apiConfig)
+	api.UseMiddleware(ClientMiddleware)
+
+	jwtSecret := os.Getenv("JWT_SECRET")
+	if jwtSecret == "" {
+		jwtSecret = "default-secret-change-in-production"
+	}
+
+	authService := auth.NewService(queries, jwtSecret)
+
+	// Initialize credentials service
+	credentialsService, err := credentials.NewService(queries)
+	if err != nil {
+		panic(fmt.Sprintf("Failed to initialize credentials service: %v", err))
+	}
+
+	// Initialize two-factor service, TOTP secrets are encrypted like credentials
+	twofactorService := twofactor.NewService(queries, credentialsService)
+
+	// The GitHub App admins configure takes precedence over the environment
+	githubAppService := githubapp.NewService(queries, credentialsService)
+	github.SetAppConfigSource(githubAppService.Load)
+
+	// Large binary data, e.g. build logs and artifacts, is kept in the object storage
+	// STORAGE_BACKEND selects, local files by default
+	store := storage.NewFromEnv()
+
+	// Initialize configs service
+	configsService := configs.NewService(queries)
+
+	// Initialize agents service
+	agentsService := agents.NewService(queries, store)
+	deployTokensService := deploytokens.NewService(queries)
+
+	// Initialize deployments service
+	deploymentsService := deployments.NewService(queries, store)
+
+	// Initialize hooks service
+	hooksService := hooks.NewService(queries)
+
+	// Initialize notifications service, email is disabled unless SMTP_HOST is set
+	notificationsService := notifications.NewService(queries, email.NewFromEnv())
+
+	// Initialize limits service
+	limitsService := limits.NewService(queries)
+
+	// Initialize admin service
+	adminService := admin.NewService(queries)
+
+	// Timeline of the builds and deployments of a user's configs
+	activityService := activity.NewService(queries)
+
+	// Pull requests adding a generated nimbul.yaml to repositories
+	bootstrapService := bootstrap.NewService()
+
+	// Initialize builds service
+	buildsService := builds.NewService(queries, store)
+
+	// Initialize usage service
+	usageService := usage.NewService(queries, limitsService)
+
+	// Initialize artifacts service
+	artifactsService := artifacts.NewService(queries, store)
+
+	// Clones and deploy runs happen in per-config sandboxes; those left behind by a
+	// previous run of the server are removed before any new ones are opened
+	sandboxes := sandbox.NewManagerFromEnv()
+	sandboxes.Sweep(0)
+	go sandboxes.Run(context.Background())
+
+	// Repositories each installation of the GitHub App can access, kept in sync from
+	// the app's webhook and refreshed hourly
+	installationsService := installations.NewService(queries)
+	installationRefresher := installations.NewRefresher(installationsService)
+	go installationRefresher.Run(context.Background())
+
+	// Deploy policies are checked against the rendered manifests of every deploy
+	policiesService := policies.NewService(queries)
+
+	// Initialize webhooks service
+	webhooksService := webhooks.NewService(configsService, credentialsService, agentsService, deploymentsService, hooksService, notificationsService, limitsService, buildsService, usageService, artifactsService, installationsService, policiesService, sandboxes)
+
+	// Initialize jobs service. Pushes run as jobs, in the API server or in nimbul-worker
+	// processes, retried when they fail and kept as dead jobs to requeue once every
+	// attempt failed.
+	jobsService := jobs.NewService(queries)
+	webhooksService.RegisterJobs(jobsService, authService)
+
+	// Workers claim queued jobs and relay the logs of their builds through the control
+	// service, so build logs stream from the API server wherever the build runs
+	if jobsService.Runner() == jobs.RunnerWorker {
+		go func() {
+			if err := jobsService.ServeControl(context.Background(), buildsService); err != nil {
+				fmt.Printf("Warning: Failed to serve workers, queued jobs will not run: %v\n", err)
+			}
+		}()
+	}
+
+	// Garbage-collect stale preview namespaces in the background
+	previewReaper := previews.NewReaper(configsService, deploymentsService, webhooksService.ClusterConfig)
+	go previewReaper.Run(context.Background())
+
+	// Cross-check configs against GitHub, marking those whose repository, webhook or app
+	// installation went away
+	reconciler := reconcile.NewReconciler(configsService)
+	go reconciler.Run(context.Background())
+
+	// Delete registry tags that fall outside the configs' retention rules
+	retentionCleaner := retention.NewCleaner(configsService, buildsService, registry.NewFromEnv())
+	go retentionCleaner.Run(context.Background())
+
+	// Delete builds, build logs and deployments older than the instance's or their owner's
+	// retention
+	dataCleaner := retention.NewDataCleaner(buildsService, deploymentsService)
+	go dataCleaner.Run(context.Background())
+
+	// Delete configs and credentials that stayed in the trash past the retention window
+	trashPurger := trash.NewPurger(configsService, credentialsService)
+	go trashPurger.Run(context.Background())
+
+	// Warn owners about credentials that are about to expire
+	expiryWatcher := credentials.NewExpiryWatcher(credentialsService, notificationsService.NotifyCredentialExpiry)
+	go expiryWatcher.Run(context.Background())
+
+	huma.Get(api, "/health", func(ctx context.Context, input *struct{}) (*HealthCheckResponse, error) {
+		resp := &HealthCheckResponse{}
+		resp.Body.Message = "Nimbul API is up and running"
+		return resp, nil
+	})
+
+	// CLIs older than this refuse to run against the server; NIMBUL_MIN_CLI_VERSION
+	// raises it, e.g. to move a fleet of CLIs on
+	minCLIVersion := cmp.Or(os.Getenv("NIMBUL_MIN_CLI_VERSION"), version.MinCLIVersion)
+
+	huma.Get(api, "/version", func(ctx context.Context, input *struct{}) (*GetVersionResponse, error) {
+		resp := &GetVersionResponse{}
+		resp.Body.Version = version.Version
+		resp.Body.MinCLIVersion = minCLIVersion
+		return resp, nil
+	})
+
+	// CLIs update to the release this server points them at
+	releasesService, err := releases.NewServiceFromEnv()
+	if err != nil {
+		panic(fmt.Sprintf("Failed to initialize CLI releases: %v", err))
+	}
+
+	huma.Get(api, "/cli/release", func(ctx context.Context, input *struct{}) (*GetCLIReleaseResponse, error) {
+		release, err := releasesService.Current(ctx)
+		if err != nil {
+			if errors.Is(err, releases.ErrReleaseNotFound) {
+				return nil, huma.Error404NotFound("No CLI release found")
+			}
+			return nil, huma.Error502BadGateway("Failed to look up the CLI release", err)
+		}
+
+		resp := &GetCLIReleaseResponse{}
+		resp.Body.Version = release.Version
+		resp.Body.URL = release.URL
+		resp.Body.PublishedAt = release.PublishedAt
+		resp.Body.Assets = make([]CLIReleaseAssetResponse, len(release.Assets))
+		for i, asset := range release.Assets {
+			resp.Body.Assets[i] = CLIReleaseAssetResponse{Name: asset.Name, URL: asset.URL}
+		}
+		return resp, nil
+	})
+
+	huma.Post(api, "/register", func(ctx context.Context, input *RegisterRequest) (*RegisterResponse, error) {
+		result, err := authService.Register(ctx, input.Body.Email, input.Body.Password, GetClient(ctx))
+		if err != nil {
+			fmt.Println("Error registering:", err)
+			return nil, mapAuthError(err)
+		}
+
+		resp := &RegisterResponse{}
+		resp.Body.Token = result.Token
+		resp.Body.User = result.User
+		return resp, nil
+	})
+
+	huma.Post(api, "/login", func(ctx context.Context, input *LoginRequest) (*LoginResponse, error) {
+		user, err := authService.Authenticate(ctx, input.Body.Email, input.Body.Password)
+		if err != nil {
+			fmt.Println("Error logging in:", err)
+			return nil, mapAuthError(err)
+		}
+
+		resp := &LoginResponse{}
+
+		// Accounts with two-factor authentication only get a token along with a valid code
+		twoFactor, err := twofactorService.Enabled(ctx, user.ID)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to check two-factor authentication", err)
+		}
+		if twoFactor {
+			if input.Body.Code == "" {
+				resp.Body.TwoFactorRequired = true
+				return resp, nil
+			}
+			if err := twofactorService.Check(ctx, user.ID, input.Body.Code); err != nil {
+				switch {
+				case errors.Is(err, twofactor.ErrInvalidCode):
+					return nil, huma.Error401Unauthorized("Invalid two-factor code")
+				case errors.Is(err, twofactor.ErrTooManyCodes):
+					return nil, huma.Error429TooManyRequests("Too many invalid two-factor codes, try again in a few minutes")
+				}
+				return nil, huma.Error500InternalServerError("Failed to check two-factor code", err)
+			}
+		}
+
+		token, err := authService.StartSession(ctx, *user, GetClient(ctx))
+		if err != nil {
+			return nil, mapAuthError(err)
+		}
+
+		resp.Body.Token = token
+		resp.Body.User = *user
+		return resp, nil
+	})
+
+	// OIDC sign-in is enabled by NIMBUL_OIDC_ISSUER
+	oidcProvider, err := oidc.NewFromEnv()
+	if err != nil && !errors.Is(err, oidc.ErrNotConfigured) {
+		panic(fmt.Sprintf("Failed to initialize OIDC provider: %v", err))
+	}
+
+	huma.Post(api, "/login/oidc/device", func(ctx context.Context, input *struct{}) (*StartOIDCLoginResponse, error) {
+		if oidcProvider == nil {
+			return nil, huma.Error503ServiceUnavailable("OIDC login is not configured on this server")
+		}
+
+		device, err := oidcProvider.StartDeviceLogin(ctx)
+		if err != nil {
+			if errors.Is(err, oidc.ErrDeviceFlowDisabled) {
+				return nil, huma.Error503ServiceUnavailable(err.Error())
+			}
+			return nil, huma.Error502BadGateway("Failed to start OIDC login", err)
+		}
+
+		resp := &StartOIDCLoginResponse{}
+		resp.Body.DeviceCode = device.DeviceCode
+		resp.Body.UserCode = device.UserCode
+		resp.Body.VerificationURI = device.VerificationURI
+		resp.Body.VerificationURIComplete = device.VerificationURIComplete
+		resp.Body.Interval = device.Interval
+		resp.Body.ExpiresAt = device.Expiry
+		return resp, nil
+	})
+
+	// Waits until the user approved the login at the identity provider, so the CLI
+	// makes a single request instead of polling
+	huma.Post(api, "/login/oidc/token", func(ctx context.Context, input *CompleteOIDCLoginRequest) (*LoginResponse, error) {
+		if oidcProvider == nil {
+			return nil, huma.Error503ServiceUnavailable("OIDC login is not configured on this server")
+		}
+
+		ctx, cancel := context.WithDeadline(ctx, input.Body.ExpiresAt)
+		defer cancel()
+
+		identity, err := oidcProvider.WaitForDeviceLogin(ctx, &oauth2.DeviceAuthResponse{
+			DeviceCode: input.Body.DeviceCode,
+			Interval:   input.Body.Interval,
+			Expiry:     input.Body.ExpiresAt,
+		})
+		if err != nil {
+			fmt.Println("Error completing OIDC login:", err)
+			return nil, huma.Error401Unauthorized("OIDC login failed", err)
+		}
+
+		result, err := authService.LoginOIDC(ctx, identity, GetClient(ctx))
+		if err != nil {
+			switch {
+			case errors.Is(err, auth.ErrOIDCEmailMissing), errors.Is(err, auth.ErrOIDCEmailUnverified):
+				return nil, huma.Error403Forbidden(err.Error())
+			case errors.Is(err, auth.ErrOIDCEmailLinked):
+				return nil, huma.Error409Conflict(err.Error())
+			}
+			return nil, mapAuthError(err)
+		}
+
+		resp := &LoginResponse{}
+		resp.Body.Token = result.Token
+		resp.Body.User = result.User
+		return resp, nil
+	})
+
+	huma.Post(api, "/password-reset", func(ctx context.Context, input *RequestPasswordResetRequest) (*RequestPasswordResetResponse, error) {
+		if !notificationsService.EmailEnabled() {
+			return nil, huma.Error503ServiceUnavailable("Password reset by email is not configured on this server")
+		}
+
+		// The response is the same whether or not the account exists, so it cannot be
+		// used to find out which emails are registered
+		resp := &RequestPasswordResetResponse{}
+		resp.Body.Success = true
+
+		result, err := authService.CreatePasswordReset(ctx, input.Body.Email)
+		if err != nil {
+			if errors.Is(err, auth.ErrUserNotFound) {
+				return resp, nil
+			}
+			return nil, huma.Error500InternalServerError("Failed to create password reset", err)
+		}
+
+		if err := notificationsService.SendPasswordReset(result.User.Email, result.Token, auth.PasswordResetTTL); err != nil {
+			return nil, huma.Error500InternalServerError("Failed to send password reset email", err)
+		}
+
+		return resp, nil
+	})
+
+	huma.Post(api, "/password-reset/confirm", func(ctx context.Context, input *ResetPasswordRequest) (*ResetPasswordResponse, error) {
+		if err := authService.ResetPassword(ctx, input.Body.Token, input.Body.Password); err != nil {
+			if errors.Is(err, auth.ErrInvalidResetToken) {
+				return nil, huma.Error400BadRequest("Invalid or expired password reset token")
+			}
+			return nil, mapAuthError(err)
+		}
+
+		resp := &ResetPasswordResponse{}
+		resp.Body.Success = true
+		return resp, nil
+	})
+
+	huma.Get(api, "/me/notifications", func(ctx context.Context, input *GetNotificationPreferencesRequest) (*GetNotificationPreferencesResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		prefs, err := notificationsService.GetPreferences(ctx, userID)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to get notification preferences", err)
+		}
+
+		resp := &GetNotificationPreferencesResponse{}
+		resp.Body = NotificationPreferencesBody{
+			BuildFailed:      prefs.BuildFailed,
+			DeployFailed:     prefs.DeployFailed,
+			CredentialExpiry: prefs.CredentialExpiry,
+			SlackWebhookURL:  prefs.SlackWebhookURL,
+		}
+		return resp, nil
+	})
+
+	huma.Put(api, "/me/notifications", func(ctx context.Context, input *UpdateNotificationPreferencesRequest) (*UpdateNotificationPreferencesResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		prefs, err := notificationsService.UpdatePreferences(ctx, userID, notifications.Preferences{
+			BuildFailed:      input.Body.BuildFailed,
+			DeployFailed:     input.Body.DeployFailed,
+			CredentialExpiry: input.Body.CredentialExpiry,
+			SlackWebhookURL:  input.Body.SlackWebhookURL,
+		})
+		if err != nil {
+			if errors.Is(err, notifications.ErrInvalidSlackWebhookURL) || errors.Is(err, notifications.ErrPrivateSlackWebhookURL) {
+				return nil, huma.Error400BadRequest(err.Error())
+			}
+			return nil, huma.Error500InternalServerError("Failed to update notification preferences", err)
+		}
+
+		resp := &UpdateNotificationPreferencesResponse{}
+		resp.Body = NotificationPreferencesBody{
+			BuildFailed:      prefs.BuildFailed,
+			DeployFailed:     prefs.DeployFailed,
+			CredentialExpiry: prefs.CredentialExpiry,
+			SlackWebhookURL:  prefs.SlackWebhookURL,
+		}
+		return resp, nil
+	})
+
+	huma.Get(api, "/me", func(ctx context.Context, input *MeRequest) (*MeResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			fmt.Println("Error validating auth:", err)
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		// Get user from database
+		user, err := authService.GetUserByID(ctx, userID)
+		if err != nil {
+			if err.Error() == "user not found" {
+				return nil, huma.Error404NotFound("User not found")
+			}
+			return nil, huma.Error500InternalServerError("Internal server error", err)
+		}
+
+		expiring, err := credentialsService.GetExpiringCredentials(ctx, userID, credentials.ExpiryWarningWindow())
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to check credential expiry", err)
+		}
+
+		twoFactor, err := twofactorService.Enabled(ctx, userID)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to check two-factor authentication", err)
+		}
+
+		resp := &MeResponse{}
+		resp.Body.ID = user.ID
+		resp.Body.Email = user.Email
+		resp.Body.Role = user.Role
+		resp.Body.TwoFactorEnabled = twoFactor
+		for _, credential := range expiring {
+			resp.Body.ExpiringCredentials = append(resp.Body.ExpiringCredentials, ExpiringCredentialResponse{
+				Provider:  credential.Provider,
+				TokenType: credential.TokenType,
+				ExpiresAt: credential.ExpiresAt,
+				Expired:   credential.ExpiresAt.Before(time.Now()),
+			})
+		}
+		return resp, nil
+	})
+
+	huma.Patch(api, "/me", func(ctx context.Context, input *UpdateMeRequest) (*UpdateMeResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		if input.Body.Email == "" && input.Body.NewPassword == "" {
+			return nil, huma.Error400BadRequest("email or new_password is required")
+		}
+
+		resp := &UpdateMeResponse{}
+
+		if input.Body.Email != "" {
+			if !notificationsService.EmailEnabled() {
+				return nil, huma.Error503ServiceUnavailable("Email changes need email, which is not configured on this server")
+			}
+
+			change, err := authService.RequestEmailChange(ctx, userID, input.Body.CurrentPassword, input.Body.Email)
+			if err != nil {
+				return nil, mapAuthError(err)
+			}
+
+			if err := notificationsService.SendEmailChange(change.NewEmail, change.Token, auth.EmailChangeTTL); err != nil {
+				return nil, huma.Error500InternalServerError("Failed to send email change confirmation", err)
+			}
+			resp.Body.PendingEmail = change.NewEmail
+		}
+
+		// Changed last, since the email change checks the current password too
+		if input.Body.NewPassword != "" {
+			if err := authService.ChangePassword(ctx, userID, GetSessionID(ctx), input.Body.CurrentPassword, input.Body.NewPassword); err != nil {
+				return nil, mapAuthError(err)
+			}
+			resp.Body.PasswordChanged = true
+		}
+
+		user, err := authService.GetUserByID(ctx, userID)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to get user", err)
+		}
+
+		resp.Body.User = *user
+		return resp, nil
+	})
+
+	huma.Delete(api, "/me", func(ctx context.Context, input *DeleteMeRequest) (*DeleteMeResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		if err := authService.DeleteAccount(ctx, userID, input.Body.Password); err != nil {
+			return nil, mapAuthError(err)
+		}
+
+		resp := &DeleteMeResponse{}
+		resp.Body.Success = true
+		return resp, nil
+	})
+
+	huma.Post(api, "/email-change/confirm", func(ctx context.Context, input *ConfirmEmailChangeRequest) (*ConfirmEmailChangeResponse, error) {
+		user, err := authService.ConfirmEmailChange(ctx, input.Body.Token)
+		if err != nil {
+			if errors.Is(err, auth.ErrInvalidEmailChangeToken) {
+				return nil, huma.Error400BadRequest("Invalid or expired email change token")
+			}
+			return nil, mapAuthError(err)
+		}
+
+		resp := &ConfirmEmailChangeResponse{}
+		resp.Body.User = *user
+		return resp, nil
+	})
+
+	huma.Post(api, "/me/2fa", func(ctx context.Context, input *EnrollTwoFactorRequest) (*EnrollTwoFactorResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		enrollment, err := twofactorService.Enroll(ctx, userID, GetUserEmail(ctx))
+		if err != nil {
+			if errors.Is(err, twofactor.ErrAlreadyEnabled) {
+				return nil, huma.Error409Conflict(err.Error())
+			}
+			return nil, huma.Error500InternalServerError("Failed to set up two-factor authentication", err)
+		}
+
+		resp := &EnrollTwoFactorResponse{}
+		resp.Body.Secret = enrollment.Secret
+		resp.Body.ProvisioningURI = enrollment.ProvisioningURI
+		return resp, nil
+	})
+
+	huma.Post(api, "/me/2fa/confirm", func(ctx context.Context, input *ConfirmTwoFactorRequest) (*ConfirmTwoFactorResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		codes, err := twofactorService.Confirm(ctx, userID, input.Body.Code)
+		if err != nil {
+			switch {
+			case errors.Is(err, twofactor.ErrNotEnrolled):
+				return nil, huma.Error404NotFound("Two-factor authentication has not been set up, start with POST /me/2fa")
+			case errors.Is(err, twofactor.ErrAlreadyEnabled):
+				return nil, huma.Error409Conflict(err.Error())
+			case errors.Is(err, twofactor.ErrInvalidCode):
+				return nil, huma.Error400BadRequest("Invalid two-factor code")
+			}
+			return nil, huma.Error500InternalServerError("Failed to enable two-factor authentication", err)
+		}
+
+		resp := &ConfirmTwoFactorResponse{}
+		resp.Body.RecoveryCodes = codes
+		return resp, nil
+	})
+
+	huma.Post(api, "/me/2fa/disable", func(ctx context.Context, input *DisableTwoFactorRequest) (*DisableTwoFactorResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		if err := twofactorService.Disable(ctx, userID, input.Body.Code); err != nil {
+			switch {
+			case errors.Is(err, twofactor.ErrNotEnabled), errors.Is(err, twofactor.ErrNotEnrolled):
+				return nil, huma.Error404NotFound("Two-factor authentication is not enabled")
+			case errors.Is(err, twofactor.ErrInvalidCode):
+				return nil, huma.Error400BadRequest("Invalid two-factor code")
+			case errors.Is(err, twofactor.ErrTooManyCodes):
+				return nil, huma.Error429TooManyRequests("Too many invalid two-factor codes, try again in a few minutes")
+			}
+			return nil, huma.Error500InternalServerError("Failed to disable two-factor authentication", err)
+		}
+
+		resp := &DisableTwoFactorResponse{}
+		resp.Body.Success = true
+		return resp, nil
+	})
+
+	huma.Get(api, "/sessions", func(ctx context.Context, input *ListSessionsRequest) (*ListSessionsResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		sessions, err := authService.ListSessions(ctx, userID)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to list sessions", err)
+		}
+
+		resp := &ListSessionsResponse{}
+		resp.Body.Sessions = make([]SessionResponse, 0, len(sessions))
+		for _, session := range sessions {
+			resp.Body.Sessions = append(resp.Body.Sessions, SessionResponse{
+				ID:         session.ID,
+				UserAgent:  session.UserAgent,
+				IP:         session.IP,
+				CreatedAt:  session.CreatedAt,
+				LastUsedAt: session.LastUsedAt,
+				ExpiresAt:  session.ExpiresAt,
+				Current:    session.ID == GetSessionID(ctx),
+			})
+		}
+		return resp, nil
+	})
+
+	huma.Delete(api, "/sessions/{id}", func(ctx context.Context, input *RevokeSessionRequest) (*RevokeSessionResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		if err := authService.RevokeSession(ctx, userID, input.ID); err != nil {
+			if errors.Is(err, auth.ErrSessionNotFound) {
+				return nil, huma.Error404NotFound("Session not found")
+			}
+			return nil, huma.Error500InternalServerError("Failed to revoke session", err)
+		}
+
+		resp := &RevokeSessionResponse{}
+		resp.Body.Success = true
+		return resp, nil
+	})
+
+	huma.Post(api, "/credentials", func(ctx context.Context, input *StoreCredentialRequest) (*StoreCredentialResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		// Validate input
+		if input.Body.Provider == "" {
+			return nil, huma.Error400BadRequest("provider is required")
+		}
+		if input.Body.TokenType == "" {
+			return nil, huma.Error400BadRequest("token_type is required")
+		}
+		if input.Body.Token == "" {
+			return nil, huma.Error400BadRequest("token is required")
+		}
+		if input.Body.TokenType == registry.CredentialTypeLogin {
+			if err := validateRegistryLogin(input.Body.Provider, input.Body.Token); err != nil {
+				return nil, err
+			}
+		}
+		if k8s.IsClusterCredentialType(input.Body.TokenType) {
+			if _, err := k8s.ConfigFromCredential(input.Body.TokenType, []byte(input.Body.Token)); err != nil {
+				return nil, huma.Error400BadRequest(err.Error())
+			}
+		}
+
+		// Store credential
+		result, err := credentialsService.StoreCredential(ctx, credentials.StoreCredentialParams{
+			OwnerID:    userID,
+			Provider:   input.Body.Provider,
+			TokenType:  input.Body.TokenType,
+			Token:      input.Body.Token,
+			ExpiresAt:  input.Body.ExpiresAt,
+			ExternalID: input.Body.ExternalID,
+		})
+		if err != nil {
+			if errors.Is(err, credentials.ErrCredentialExists) || errors.Is(err, credentials.ErrExternalIDTaken) {
+				return nil, huma.Error409Conflict(err.Error())
+			}
+			return nil, huma.Error500InternalServerError("Failed to store credential", err)
+		}
+
+		credential, err := credentialsService.GetCredential(ctx, userID, result.CredentialID)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to get credential", err)
+		}
+
+		resp := &StoreCredentialResponse{}
+		resp.ETag = etag(credential.Version)
+		resp.Body.CredentialID = result.CredentialID
+		resp.Body.Credential = toCredentialResponse(credential)
+		return resp, nil
+	})
+
+	huma.Get(api, "/credentials", func(ctx context.Context, input *ListCredentialsRequest) (*ListCredentialsResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		credentialList, err := credentialsService.ListCredentials(ctx, userID)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to list credentials", err)
+		}
+
+		resp := &ListCredentialsResponse{}
+		resp.Body.Credentials = make([]CredentialResponse, len(credentialList))
+		for i, credential := range credentialList {
+			resp.Body.Credentials[i] = toCredentialResponse(&credential)
+		}
+		return resp, nil
+	})
+
+	huma.Get(api, "/credentials/{id}", func(ctx context.Context, input *GetCredentialRequest) (*GetCredentialResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		credential, err := credentialsService.GetCredential(ctx, userID, input.ID)
+		if err != nil {
+			return nil, mapCredentialError(err)
+		}
+
+		resp := &GetCredentialResponse{}
+		resp.ETag = etag(credential.Version)
+		resp.Body.Credential = toCredentialResponse(credential)
+		return resp, nil
+	})
+
+	huma.Put(api, "/credentials/{id}", func(ctx context.Context, input *ReplaceCredentialRequest) (*GetCredentialResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		if input.Body.Token == "" {
+			return nil, huma.Error400BadRequest("token is required")
+		}
+
+		expectedVersion, err := ifMatchVersion(input.IfMatch)
+		if err != nil {
+			return nil, err
+		}
+
+		existing, err := credentialsService.GetCredential(ctx, userID, input.ID)
+		if err != nil {
+			return nil, mapCredentialError(err)
+		}
+		if existing.TokenType == registry.CredentialTypeLogin {
+			if err := validateRegistryLogin(existing.Provider, input.Body.Token); err != nil {
+				return nil, err
+			}
+		}
+		if k8s.IsClusterCredentialType(existing.TokenType) {
+			if _, err := k8s.ConfigFromCredential(existing.TokenType, []byte(input.Body.Token)); err != nil {
+				return nil, huma.Error400BadRequest(err.Error())
+			}
+		}
+
+		credential, err := credentialsService.ReplaceCredential(ctx, credentials.ReplaceCredentialParams{
+			OwnerID:         userID,
+			ID:              input.ID,
+			Token:           input.Body.Token,
+			ExpiresAt:       input.Body.ExpiresAt,
+			ExpectedVersion: expectedVersion,
+		})
+		if err != nil {
+			return nil, mapCredentialError(err)
+		}
+
+		resp := &GetCredentialResponse{}
+		resp.ETag = etag(credential.Version)
+		resp.Body.Credential = toCredentialResponse(credential)
+		return resp, nil
+	})
+
+	huma.Delete(api, "/credentials/{id}", func(ctx context.Context, input *DeleteCredentialRequest) (*DeleteCredentialResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		expectedVersion, err := ifMatchVersion(input.IfMatch)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := credentialsService.DeleteCredential(ctx, userID, input.ID, expectedVersion); err != nil {
+			return nil, mapCredentialError(err)
+		}
+
+		resp := &DeleteCredentialResponse{}
+		resp.Body.Success = true
+		return resp, nil
+	})
+
+	huma.Post(api, "/credentials/{id}/restore", func(ctx context.Context, input *RestoreCredentialRequest) (*RestoreCredentialResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		credential, err := credentialsService.RestoreCredential(ctx, userID, input.ID)
+		if err != nil {
+			return nil, mapCredentialError(err)
+		}
+
+		resp := &RestoreCredentialResponse{}
+		resp.ETag = etag(credential.Version)
+		resp.Body.Credential = toCredentialResponse(credential)
+		return resp, nil
+	})
+
+	huma.Put(api, "/me/registry-credential", func(ctx context.Context, input *SetDefaultRegistryCredentialRequest) (*SetDefaultRegistryCredentialResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		credential, err := credentialsService.GetCredential(ctx, userID, input.Body.CredentialID)
+		if err != nil {
+			return nil, mapCredentialError(err)
+		}
+		if credential.TokenType != registry.CredentialTypeLogin {
+			return nil, huma.Error400BadRequest(fmt.Sprintf("Credential of type '%s' is not a registry login", credential.TokenType))
+		}
+
+		if err := credentialsService.SetDefaultRegistryCredential(ctx, userID, &credential.ID); err != nil {
+			return nil, huma.Error500InternalServerError("Failed to set default registry credential", err)
+		}
+
+		resp := &SetDefaultRegistryCredentialResponse{}
+		resp.Body.Success = true
+		return resp, nil
+	})
+
+	huma.Delete(api, "/me/registry-credential", func(ctx context.Context, input *ClearDefaultRegistryCredentialRequest) (*SetDefaultRegistryCredentialResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		if err := credentialsService.SetDefaultRegistryCredential(ctx, userID, nil); err != nil {
+			return nil, huma.Error500InternalServerError("Failed to clear default registry credential", err)
+		}
+
+		resp := &SetDefaultRegistryCredentialResponse{}
+		resp.Body.Success = true
+		return resp, nil
+	})
+
+	huma.Get(api, "/providers", func(ctx context.Context, input *GetProvidersRequest) (*GetProvidersResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		// Get unique providers from credentials
+		providers, err := queries.GetUniqueProvidersByOwnerID(ctx, userID)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to get providers", err)
+		}
+
+		resp := &GetProvidersResponse{}
+		resp.Body.Providers = providers
+		return resp, nil
+	})
+
+	huma.Post(api, "/configs", func(ctx context.Context, input *CreateConfigRequest) (*CreateConfigResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		// Validate input
+		if input.Body.Provider == "" {
+			return nil, huma.Error400BadRequest("provider is required")
+		}
+		if input.Body.RepoOwner == "" {
+			return nil, huma.Error400BadRequest("repo_owner is required")
+		}
+		if input.Body.RepoName == "" {
+			return nil, huma.Error400BadRequest("repo_name is required")
+		}
+		if input.Body.RepoFullName == "" {
+			return nil, huma.Error400BadRequest("repo_full_name is required")
+		}
+		if input.Body.RepoCloneURL == "" {
+			return nil, huma.Error400BadRequest("repo_clone_url is required")
+		}
+		if input.Body.WebhookSecret == "" {
+			return nil, huma.Error400BadRequest("webhook_secret is required")
+		}
+
+		// Enforce the instance-wide config limit, which a retried create already passed
+		retried := false
+		if input.Body.ExternalID != "" {
+			_, err := configsService.GetConfigByExternalID(ctx, userID, input.Body.ExternalID)
+			retried = err == nil
+		}
+		if !retried {
+			if err := limitsService.CheckConfigLimit(ctx, userID); err != nil {
+				if errors.Is(err, limits.ErrConfigLimitReached) {
+					return nil, huma.Error403Forbidden(err.Error())
+				}
+				return nil, huma.Error500InternalServerError("Failed to check config limit", err)
+			}
+		}
+
+		// Create config
+		result, err := configsService.CreateConfig(ctx, configs.CreateConfigParams{
+			OwnerID:        userID,
+			Provider:       input.Body.Provider,
+			RepoOwner:      input.Body.RepoOwner,
+			RepoName:       input.Body.RepoName,
+			RepoFullName:   input.Body.RepoFullName,
+			RepoCloneURL:   input.Body.RepoCloneURL,
+			DockerfilePath: input.Body.DockerfilePath,
+			WebhookSecret:  input.Body.WebhookSecret,
+			ExternalID:     input.Body.ExternalID,
+		})
+		if err != nil {
+			if errors.Is(err, configs.ErrConfigExists) || errors.Is(err, configs.ErrExternalIDTaken) {
+				return nil, mapConfigError(err)
+			}
+			return nil, huma.Error500InternalServerError("Failed to create config", err)
+		}
+
+		config, err := configsService.GetConfigByIDAndOwner(ctx, result.ConfigID, userID)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to get config", err)
+		}
+
+		resp := &CreateConfigResponse{}
+		resp.ETag = etag(config.Version)
+		resp.Body.ConfigID = result.ConfigID
+		resp.Body.Config = toConfigResponse(config)
+		return resp, nil
+	})
+
+	huma.Get(api, "/configs", func(ctx context.Context, input *ListConfigsRequest) (*ListConfigsResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		configList, err := configsService.GetConfigsByOwnerID(ctx, userID)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to get configs", err)
+		}
+
+		resp := &ListConfigsResponse{}
+		resp.Body.Configs = make([]ConfigResponse, len(configList))
+		for i, config := range configList {
+			resp.Body.Configs[i] = toConfigResponse(&config)
+		}
+		return resp, nil
+	})
+
+	huma.Get(api, "/configs/{id}", func(ctx context.Context, input *GetConfigRequest) (*GetConfigResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		// Configs of other users look the same as deleted ones
+		config, err := configsService.GetConfigByIDAndOwner(ctx, input.ID, userID)
+		if err != nil {
+			if errors.Is(err, configs.ErrConfigNotFound) {
+				return nil, huma.Error404NotFound("Config not found")
+			}
+			return nil, huma.Error500InternalServerError("Failed to get config", err)
+		}
+
+		resp := &GetConfigResponse{}
+		resp.ETag = etag(config.Version)
+		resp.Body.Config = toConfigResponse(config)
+		resp.Body.Webhook = ConfigWebhookStatus{
+			GitHubWebhookID: config.WebhookID,
+			RegistryWebhook: config.RegistryWebhookToken != nil,
+		}
+
+		build, err := buildsService.GetLatestBuildByConfigID(ctx, config.ID)
+		if err != nil && !errors.Is(err, builds.ErrBuildNotFound) {
+			return nil, huma.Error500InternalServerError("Failed to get latest build", err)
+		}
+		if build != nil {
+			resp.Body.LastBuild = &BuildSummaryResponse{
+				ID:         build.ID,
+				Name:       build.Name,
+				Ref:        build.Ref,
+				CommitSHA:  build.CommitSHA,
+				Status:     build.Status,
+				Error:      build.Error,
+				StartedAt:  build.StartedAt,
+				FinishedAt: build.FinishedAt,
+			}
+		}
+
+		latest, err := deploymentsService.GetDeploymentsByConfigID(ctx, config.ID, 1)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to get deployments", err)
+		}
+		if len(latest) > 0 {
+			deployment := toDeploymentResponse(&latest[0])
+			resp.Body.LastDeployment = &deployment
+		}
+
+		return resp, nil
+	})
+
+	huma.Patch(api, "/configs/{id}", func(ctx context.Context, input *UpdateConfigRequest) (*UpdateConfigResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		if input.Body.RepoCloneURL != nil && *input.Body.RepoCloneURL == "" {
+			return nil, huma.Error400BadRequest("repo_clone_url cannot be empty")
+		}
+		if input.Body.WebhookSecret != nil && *input.Body.WebhookSecret == "" {
+			return nil, huma.Error400BadRequest("webhook_secret cannot be empty")
+		}
+
+		expectedVersion, err := ifMatchVersion(input.IfMatch)
+		if err != nil {
+			return nil, err
+		}
+
+		// Configs of other users look the same as deleted ones
+		config, err := configsService.GetConfigByIDAndOwner(ctx, input.ID, userID)
+		if err != nil {
+			return nil, mapConfigError(err)
+		}
+
+		config, err = configsService.UpdateConfig(ctx, configs.UpdateConfigParams{
+			ID:              config.ID,
+			RepoCloneURL:    input.Body.RepoCloneURL,
+			DockerfilePath:  input.Body.DockerfilePath,
+			WebhookSecret:   input.Body.WebhookSecret,
+			ExpectedVersion: expectedVersion,
+		})
+		if err != nil {
+			return nil, mapConfigError(err)
+		}
+
+		resp := &UpdateConfigResponse{}
+		resp.ETag = etag(config.Version)
+		resp.Body.Config = toConfigResponse(config)
+		return resp, nil
+	})
+
+	huma.Delete(api, "/configs/{id}", func(ctx context.Context, input *DeleteConfigRequest) (*DeleteConfigResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		expectedVersion, err := ifMatchVersion(input.IfMatch)
+		if err != nil {
+			return nil, err
+		}
+
+		config, err := configsService.GetConfigByIDAndOwner(ctx, input.ID, userID)
+		if err != nil {
+			return nil, mapConfigError(err)
+		}
+
+		// Apps already deployed keep running, and the repository keeps its GitHub webhook,
+		// whose deliveries no longer match a config until it is restored
+		if err := configsService.DeleteConfig(ctx, config.ID, expectedVersion); err != nil {
+			return nil, mapConfigError(err)
+		}
+
+		resp := &DeleteConfigResponse{}
+		resp.Body.Success = true
+		return resp, nil
+	})
+
+	huma.Post(api, "/configs/{id}/restore", func(ctx context.Context, input *RestoreConfigRequest) (*RestoreConfigResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		config, err := configsService.RestoreConfig(ctx, input.ID, userID)
+		if err != nil {
+			return nil, mapConfigError(err)
+		}
+
+		resp := &RestoreConfigResponse{}
+		resp.ETag = etag(config.Version)
+		resp.Body.Config = toConfigResponse(config)
+		return resp, nil
+	})
+
+	huma.Get(api, "/trash", func(ctx context.Context, input *GetTrashRequest) (*GetTrashResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		deletedConfigs, err := configsService.GetDeletedConfigsByOwnerID(ctx, userID)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to list deleted configs", err)
+		}
+		deletedCredentials, err := credentialsService.ListDeletedCredentials(ctx, userID)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to list deleted credentials", err)
+		}
+
+		retention := trash.Retention()
+		resp := &GetTrashResponse{}
+		resp.Body.Configs = make([]TrashedConfigResponse, len(deletedConfigs))
+		for i, config := range deletedConfigs {
+			resp.Body.Configs[i] = TrashedConfigResponse{
+				ID:           config.ID,
+				RepoFullName: config.RepoFullName,
+				DeletedAt:    config.DeletedAt.Time,
+				PurgeAt:      config.DeletedAt.Time.Add(retention),
+			}
+		}
+		resp.Body.Credentials = make([]TrashedCredentialResponse, len(deletedCredentials))
+		for i, credential := range deletedCredentials {
+			resp.Body.Credentials[i] = TrashedCredentialResponse{
+				ID:        credential.ID,
+				Provider:  credential.Provider,
+				TokenType: credential.TokenType,
+				DeletedAt: *credential.DeletedAt,
+				PurgeAt:   credential.DeletedAt.Add(retention),
+			}
+		}
+		return resp, nil
+	})
+
+	huma.Get(api, "/credentials/github/token", func(ctx context.Context, input *GetGitHubTokenRequest) (*GetGitHubTokenResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		token, err := githubAccessToken(ctx, credentialsService, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		resp := &GetGitHubTokenResponse{}
+		resp.Body.Token = token
+		return resp, nil
+	})
+
+	huma.Get(api, "/credentials/github/installation-token", func(ctx context.Context, input *GetGitHubInstallationTokenRequest) (*GetGitHubInstallationTokenResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		// Configs of other users look the same as deleted ones
+		config, err := configsService.GetConfigByIDAndOwner(ctx, input.ConfigID, userID)
+		if err != nil {
+			return nil, mapConfigError(err)
+		}
+
+		// The app credentials stay on the server; the CLI only gets a short-lived token
+		// limited to the config's repository
+		token, expiresAt, err := repositoryToken(ctx, config)
+		if err != nil {
+			return nil, err
+		}
+
+		resp := &GetGitHubInstallationTokenResponse{}
+		resp.Body.Token = token
+		resp.Body.ExpiresAt = expiresAt
+		resp.Body.Repository = config.RepoFullName
+		return resp, nil
+	})
+
+	huma.Post(api, "/github/repos/{owner}/{repo}/bootstrap", func(ctx context.Context, input *BootstrapRepositoryRequest) (*BootstrapRepositoryResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		// The pull request is opened as the user, so GitHub checks they may push to the repository
+		token, err := githubAccessToken(ctx, credentialsService, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := bootstrapService.OpenPullRequest(ctx, token, input.Owner, input.Repo, bootstrap.Options{
+			Dockerfile: input.Body.Dockerfile,
+			Registry:   input.Body.Registry,
+		})
+		if err != nil {
+			switch {
+			case errors.Is(err, bootstrap.ErrRepositoryNotFound):
+				return nil, huma.Error404NotFound("Repository not found")
+			case errors.Is(err, bootstrap.ErrAlreadyConfigured), errors.Is(err, github.ErrBranchExists):
+				return nil, huma.Error409Conflict(err.Error())
+			case errors.Is(err, bootstrap.ErrNothingToBuild):
+				return nil, huma.Error422UnprocessableEntity(err.Error())
+			}
+			return nil, huma.Error500InternalServerError("Failed to open pull request", err)
+		}
+
+		resp := &BootstrapRepositoryResponse{}
+		resp.Body.Number = result.Number
+		resp.Body.URL = result.URL
+		resp.Body.Branch = result.Branch
+		resp.Body.Files = result.Files
+		resp.Body.Notes = result.Notes
+		if resp.Body.Notes == nil {
+			resp.Body.Notes = []string{}
+		}
+		return resp, nil
+	})
+
+	huma.Get(api, "/github/app", func(ctx context.Context, input *GetGitHubAppPublicRequest) (*GetGitHubAppPublicResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Only what 'nimbul connect' needs to sign in with and install the app
+		appConfig, err := github.LoadAppConfig(ctx)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to get GitHub App", err)
+		}
+
+		resp := &GetGitHubAppPublicResponse{}
+		resp.Body.Slug = appConfig.Slug
+		resp.Body.ClientID = appConfig.ClientID
+		return resp, nil
+	})
+
+	huma.Post(api, "/github/installations/{id}/check", func(ctx context.Context, input *CheckGitHubInstallationRequest) (*CheckGitHubInstallationResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// The app's private key stays on the server, so the CLI asks it to authenticate
+		// as the installation
+		appAuth, err := github.NewAppAuth(ctx, input.ID)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("GitHub App is not configured", err)
+		}
+		if _, err := appAuth.GetInstallationToken(ctx); err != nil {
+			return nil, huma.Error502BadGateway("Failed to authenticate as the app installation", err)
+		}
+
+		// Builds of the installation's repositories need not wait for the hourly refresh
+		go func() {
+			if err := installationsService.Sync(context.Background(), input.ID); err != nil {
+				fmt.Printf("Warning: Failed to sync installation %d: %v\n", input.ID, err)
+			}
+		}()
+
+		resp := &CheckGitHubInstallationResponse{}
+		resp.Body.Success = true
+		return resp, nil
+	})
+
+	huma.Post(api, "/configs/{id}/webhook", func(ctx context.Context, input *CreateConfigWebhookRequest) (*CreateConfigWebhookResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		// Configs of other users look the same as deleted ones
+		config, err := configsService.GetConfigByIDAndOwner(ctx, input.ID, userID)
+		if err != nil {
+			return nil, mapConfigError(err)
+		}
+
+		if config.WebhookID != nil {
+			return nil, huma.Error409Conflict(fmt.Sprintf("Config already has GitHub webhook %d", *config.WebhookID))
+		}
+
+		baseURL := cmp.Or(os.Getenv("NIMBUL_PUBLIC_URL"), input.Body.APIURL)
+		if baseURL == "" {
+			return nil, huma.Error400BadRequest("api_url is required when NIMBUL_PUBLIC_URL is not set")
+		}
+		webhookURL := fmt.Sprintf("%s/webhooks/github/%s", strings.TrimSuffix(baseURL, "/"), config.ID)
+
+		// Created with the server's GitHub App credentials, so they never leave the server
+		token, _, err := repositoryToken(ctx, config)
+		if err != nil {
+			return nil, err
+		}
+
+		webhookID, err := github.CreateWebhook(ctx, github.NewClientWithToken(token), config.RepoOwner, config.RepoName, webhookURL, config.WebhookSecret)
+		if err != nil {
+			return nil, huma.Error502BadGateway("Failed to create GitHub webhook", err)
+		}
+
+		if err := configsService.UpdateWebhookID(ctx, config.ID, userID, webhookID); err != nil {
+			return nil, huma.Error500InternalServerError("Failed to update webhook ID", err)
+		}
+
+		// Creating the webhook proved the repository and the app installation are in place
+		if err := configsService.SetHealth(ctx, config.ID, configs.HealthOK, ""); err != nil {
+			fmt.Printf("Warning: Failed to update health of config %s: %v\n", config.ID, err)
+		}
+
+		resp := &CreateConfigWebhookResponse{}
+		resp.Body.WebhookID = webhookID
+		resp.Body.URL = webhookURL
+		return resp, nil
+	})
+
+	huma.Patch(api, "/configs/{id}/webhook", func(ctx context.Context, input *UpdateConfigWebhookRequest) (*UpdateConfigWebhookResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		// Configs of other users look the same as deleted ones
+		err = configsService.UpdateWebhookID(ctx, input.ID, userID, input.Body.WebhookID)
+		if err != nil {
+			return nil, mapConfigError(err)
+		}
+
+		resp := &UpdateConfigWebhookResponse{}
+		resp.Body.Success = true
+		return resp, nil
+	})
+
+	huma.Patch(api, "/configs/{id}/cluster", func(ctx context.Context, input *UpdateConfigClusterRequest) (*UpdateConfigClusterResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		// Configs of other users look the same as deleted ones
+		config, err := configsService.GetConfigByIDAndOwner(ctx, input.ID, userID)
+		if err != nil {
+			return nil, mapConfigError(err)
+		}
+
+		// Verify credential belongs to user and is a usable cluster credential
+		credential, err := credentialsService.GetDecryptedCredentialByID(ctx, userID, input.Body.CredentialID)
+		if err != nil {
+			if errors.Is(err, credentials.ErrTokenExpired) {
+				return nil, huma.Error400BadRequest("Cluster credential has expired")
+			}
+			return nil, huma.Error404NotFound("Credential not found")
+		}
+
+		if !k8s.IsClusterCredentialType(credential.TokenType) {
+			return nil, huma.Error400BadRequest(fmt.Sprintf("Credential of type '%s' cannot be used as a cluster credential", credential.TokenType))
+		}
+
+		if _, err := k8s.ConfigFromCredential(credential.TokenType, []byte(credential.Token)); err != nil {
+			return nil, huma.Error400BadRequest(err.Error())
+		}
+
+		// Update cluster credential
+		err = configsService.UpdateClusterCredentialID(ctx, config.ID, credential.ID)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to update cluster credential", err)
+		}
+
+		resp := &UpdateConfigClusterResponse{}
+		resp.Body.Success = true
+		return resp, nil
+	})
+
+	huma.Get(api, "/configs/{id}/retention", func(ctx context.Context, input *GetConfigRetentionRequest) (*GetConfigRetentionResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		// Configs of other users look the same as deleted ones
+		config, err := configsService.GetConfigByIDAndOwner(ctx, input.ID, userID)
+		if err != nil {
+			return nil, mapConfigError(err)
+		}
+
+		resp := &GetConfigRetentionResponse{}
+		resp.Body.KeepLast = config.RetentionKeepLast
+		resp.Body.DeletePreviews = config.RetentionDeletePreviews
+		return resp, nil
+	})
+
+	huma.Put(api, "/configs/{id}/retention", func(ctx context.Context, input *UpdateConfigRetentionRequest) (*UpdateConfigRetentionResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		// Configs of other users look the same as deleted ones
+		config, err := configsService.GetConfigByIDAndOwner(ctx, input.ID, userID)
+		if err != nil {
+			return nil, mapConfigError(err)
+		}
+
+		err = configsService.UpdateRetention(ctx, config.ID, input.Body.KeepLast, input.Body.DeletePreviews)
+		if err != nil {
+			if errors.Is(err, configs.ErrInvalidRetention) {
+				return nil, huma.Error400BadRequest(err.Error())
+			}
+			return nil, huma.Error500InternalServerError("Failed to update retention", err)
+		}
+
+		resp := &UpdateConfigRetentionResponse{}
+		resp.Body = input.Body
+		return resp, nil
+	})
+
+	huma.Get(api, "/configs/{id}/denied-authors", func(ctx context.Context, input *GetConfigDeniedAuthorsRequest) (*GetConfigDeniedAuthorsResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		// Configs of other users look the same as deleted ones
+		config, err := configsService.GetConfigByIDAndOwner(ctx, input.ID, userID)
+		if err != nil {
+			return nil, mapConfigError(err)
+		}
+
+		resp := &GetConfigDeniedAuthorsResponse{}
+		resp.Body.Authors = config.DeniedAuthors
+		return resp, nil
+	})
+
+	huma.Put(api, "/configs/{id}/denied-authors", func(ctx context.Context, input *UpdateConfigDeniedAuthorsRequest) (*UpdateConfigDeniedAuthorsResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		// Configs of other users look the same as deleted ones
+		config, err := configsService.GetConfigByIDAndOwner(ctx, input.ID, userID)
+		if err != nil {
+			return nil, mapConfigError(err)
+		}
+
+		authors, err := configsService.UpdateDeniedAuthors(ctx, config.ID, input.Body.Authors)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to update denied authors", err)
+		}
+
+		resp := &UpdateConfigDeniedAuthorsResponse{}
+		resp.Body.Authors = authors
+		return resp, nil
+	})
+
+	huma.Get(api, "/configs/{id}/secret-scan", func(ctx context.Context, input *GetConfigSecretScanRequest) (*GetConfigSecretScanResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		// Configs of other users look the same as deleted ones
+		config, err := configsService.GetConfigByIDAndOwner(ctx, input.ID, userID)
+		if err != nil {
+			return nil, mapConfigError(err)
+		}
+
+		resp := &GetConfigSecretScanResponse{}
+		resp.Body.Enabled = config.SecretScan
+		resp.Body.Allowlist = config.SecretScanAllowlist
+		return resp, nil
+	})
+
+	huma.Put(api, "/configs/{id}/secret-scan", func(ctx context.Context, input *UpdateConfigSecretScanRequest) (*UpdateConfigSecretScanResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		if err := secretscan.ValidateAllowlist(input.Body.Allowlist); err != nil {
+			return nil, huma.Error422UnprocessableEntity(err.Error())
+		}
+
+		// Configs of other users look the same as deleted ones
+		config, err := configsService.GetConfigByIDAndOwner(ctx, input.ID, userID)
+		if err != nil {
+			return nil, mapConfigError(err)
+		}
+
+		config, err = configsService.UpdateSecretScan(ctx, config.ID, input.Body.Enabled, input.Body.Allowlist)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to update secret scan", err)
+		}
+
+		resp := &UpdateConfigSecretScanResponse{}
+		resp.Body.Enabled = config.SecretScan
+		resp.Body.Allowlist = config.SecretScanAllowlist
+		return resp, nil
+	})
+
+	huma.Post(api, "/configs/{id}/registry-webhook", func(ctx context.Context, input *RotateRegistryWebhookRequest) (*RotateRegistryWebhookResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		// Configs of other users look the same as deleted ones
+		config, err := configsService.GetConfigByIDAndOwner(ctx, input.ID, userID)
+		if err != nil {
+			return nil, mapConfigError(err)
+		}
+
+		token, err := configsService.RotateRegistryWebhookToken(ctx, config.ID)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to create registry webhook token", err)
+		}
+
+		resp := &RotateRegistryWebhookResponse{}
+		resp.Body.Token = token
+		return resp, nil
+	})
+
+	huma.Delete(api, "/configs/{id}/registry-webhook", func(ctx context.Context, input *DisableRegistryWebhookRequest) (*DisableRegistryWebhookResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		// Configs of other users look the same as deleted ones
+		config, err := configsService.GetConfigByIDAndOwner(ctx, input.ID, userID)
+		if err != nil {
+			return nil, mapConfigError(err)
+		}
+
+		if err := configsService.DisableRegistryWebhook(ctx, config.ID); err != nil {
+			return nil, huma.Error500InternalServerError("Failed to disable registry webhook", err)
+		}
+
+		resp := &DisableRegistryWebhookResponse{}
+		resp.Body.Success = true
+		return resp, nil
+	})
+
+	huma.Get(api, "/configs/{id}/env", func(ctx context.Context, input *GetConfigEnvRequest) (*GetConfigEnvResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		// Configs of other users look the same as deleted ones
+		config, err := configsService.GetConfigByIDAndOwner(ctx, input.ID, userID)
+		if err != nil {
+			return nil, mapConfigError(err)
+		}
+
+		vars, err := configsService.GetEnv(ctx, config.ID)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to get environment variables", err)
+		}
+
+		resp := &GetConfigEnvResponse{}
+		resp.Body = toConfigEnvBody(vars)
+		return resp, nil
+	})
+
+	huma.Post(api, "/configs/{id}/env", func(ctx context.Context, input *SetConfigEnvRequest) (*SetConfigEnvResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		// Configs of other users look the same as deleted ones
+		config, err := configsService.GetConfigByIDAndOwner(ctx, input.ID, userID)
+		if err != nil {
+			return nil, mapConfigError(err)
+		}
+
+		if err := configsService.SetEnv(ctx, config.ID, input.Body.Vars); err != nil {
+			if errors.Is(err, configs.ErrInvalidEnvName) {
+				return nil, huma.Error400BadRequest(err.Error())
+			}
+			return nil, huma.Error500InternalServerError("Failed to set environment variables", err)
+		}
+
+		vars, err := configsService.GetEnv(ctx, config.ID)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to get environment variables", err)
+		}
+
+		resp := &SetConfigEnvResponse{}
+		resp.Body = toConfigEnvBody(vars)
+		return resp, nil
+	})
+
+	huma.Delete(api, "/configs/{id}/env/{name}", func(ctx context.Context, input *UnsetConfigEnvRequest) (*UnsetConfigEnvResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		// Configs of other users look the same as deleted ones
+		config, err := configsService.GetConfigByIDAndOwner(ctx, input.ID, userID)
+		if err != nil {
+			return nil, mapConfigError(err)
+		}
+
+		if err := configsService.UnsetEnv(ctx, config.ID, input.Name); err != nil {
+			if errors.Is(err, configs.ErrEnvNotFound) {
+				return nil, huma.Error404NotFound("Environment variable not found")
+			}
+			return nil, huma.Error500InternalServerError("Failed to unset environment variable", err)
+		}
+
+		resp := &UnsetConfigEnvResponse{}
+		resp.Body.Success = true
+		return resp, nil
+	})
+
+	huma.Post(api, "/configs/{id}/transfer", func(ctx context.Context, input *StartConfigTransferRequest) (*StartConfigTransferResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		// Configs of other users look the same as deleted ones
+		config, err := configsService.GetConfigByIDAndOwner(ctx, input.ID, userID)
+		if err != nil {
+			return nil, mapConfigError(err)
+		}
+
+		if input.Body.Email == "" {
+			return nil, huma.Error400BadRequest("email is required")
+		}
+
+		transfer, err := configsService.StartTransfer(ctx, config, input.Body.Email)
+		if err != nil {
+			if errors.Is(err, configs.ErrTransferRecipientNotFound) {
+				return nil, huma.Error404NotFound(err.Error())
+			}
+			if errors.Is(err, configs.ErrTransferToSelf) {
+				return nil, huma.Error400BadRequest(err.Error())
+			}
+			return nil, huma.Error500InternalServerError("Failed to start transfer", err)
+		}
+
+		resp := &StartConfigTransferResponse{}
+		resp.Body.Transfer = toTransferResponse(transfer)
+		return resp, nil
+	})
+
+	huma.Delete(api, "/configs/{id}/transfer", func(ctx context.Context, input *CancelConfigTransferRequest) (*CancelConfigTransferResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		// Configs of other users look the same as deleted ones
+		config, err := configsService.GetConfigByIDAndOwner(ctx, input.ID, userID)
+		if err != nil {
+			return nil, mapConfigError(err)
+		}
+
+		if err := configsService.CancelTransfer(ctx, config.ID); err != nil {
+			if errors.Is(err, configs.ErrTransferNotFound) {
+				return nil, huma.Error404NotFound("The config has no pending transfer")
+			}
+			return nil, huma.Error500InternalServerError("Failed to cancel transfer", err)
+		}
+
+		resp := &CancelConfigTransferResponse{}
+		resp.Body.Success = true
+		return resp, nil
+	})
+
+	huma.Get(api, "/transfers", func(ctx context.Context, input *ListTransfersRequest) (*ListTransfersResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		transfers, err := configsService.GetIncomingTransfers(ctx, userID)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to get transfers", err)
+		}
+
+		resp := &ListTransfersResponse{}
+		resp.Body.Transfers = make([]TransferResponse, len(transfers))
+		for i, transfer := range transfers {
+			resp.Body.Transfers[i] = toTransferResponse(&transfer)
+		}
+		return resp, nil
+	})
+
+	huma.Post(api, "/transfers/{id}/accept", func(ctx context.Context, input *AcceptTransferRequest) (*AcceptTransferResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		// Only the recipient sees a transfer
+		transfer, err := configsService.GetTransferByID(ctx, input.ID)
+		if err != nil && !errors.Is(err, configs.ErrTransferNotFound) {
+			return nil, huma.Error500InternalServerError("Failed to get transfer", err)
+		}
+		if transfer == nil || transfer.ToUserID != userID {
+			return nil, huma.Error404NotFound("Transfer not found")
+		}
+
+		// The transferred config counts towards the recipient's config limit
+		if err := limitsService.CheckConfigLimit(ctx, userID); err != nil {
+			if errors.Is(err, limits.ErrConfigLimitReached) {
+				return nil, huma.Error403Forbidden(err.Error())
+			}
+			return nil, huma.Error500InternalServerError("Failed to check config limit", err)
+		}
+
+		previous, err := configsService.GetConfigByIDAndOwner(ctx, transfer.ConfigID, transfer.FromUserID)
+		if err != nil {
+			return nil, huma.Error404NotFound("Config not found")
+		}
+
+		// CI systems of the previous owner no longer deploy the config
+		config, revokedTokens, err := configsService.AcceptTransfer(ctx, transfer)
+		if err != nil {
+			if errors.Is(err, configs.ErrTransferNotFound) {
+				return nil, huma.Error404NotFound("Transfer not found")
+			}
+			if errors.Is(err, configs.ErrTransferRepoTaken) {
+				return nil, huma.Error409Conflict(err.Error())
+			}
+			return nil, huma.Error500InternalServerError("Failed to accept transfer", err)
+		}
+
+		resp := &AcceptTransferResponse{}
+		resp.Body.Config = toConfigResponse(config)
+		resp.Body.Warnings = []string{}
+		if previous.ClusterCredentialID != nil {
+			resp.Body.Warnings = append(resp.Body.Warnings, "The previous owner's cluster credential was unset; run 'nimbul cluster connect' to deploy to your cluster")
+		}
+		if previous.AgentID != nil {
+			resp.Body.Warnings = append(resp.Body.Warnings, "The previous owner's agent was unset; run 'nimbul agent use' to deploy through one of your agents")
+		}
+		if revokedTokens > 0 {
+			resp.Body.Warnings = append(resp.Body.Warnings, "The previous owner's deploy tokens were revoked; run 'nimbul deploy-tokens create' to issue new ones")
+		}
+		return resp, nil
+	})
+
+	huma.Post(api, "/transfers/{id}/decline", func(ctx context.Context, input *DeclineTransferRequest) (*DeclineTransferResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		if err := configsService.DeclineTransfer(ctx, input.ID, userID); err != nil {
+			if errors.Is(err, configs.ErrTransferNotFound) {
+				return nil, huma.Error404NotFound("Transfer not found")
+			}
+			return nil, huma.Error500InternalServerError("Failed to decline transfer", err)
+		}
+
+		resp := &DeclineTransferResponse{}
+		resp.Body.Success = true
+		return resp, nil
+	})
+
+	huma.Get(api, "/configs/{id}/export", func(ctx context.Context, input *ExportConfigRequest) (*ExportConfigResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		// Configs of other users look the same as deleted ones
+		config, err := configsService.GetConfigByIDAndOwner(ctx, input.ID, userID)
+		if err != nil {
+			return nil, mapConfigError(err)
+		}
+
+		// Agents are referenced by name, since IDs differ between instances
+		var agentName string
+		if config.AgentID != nil {
+			agent, err := agentsService.GetAgentByID(ctx, *config.AgentID)
+			if err != nil && !errors.Is(err, agents.ErrAgentNotFound) {
+				return nil, huma.Error500InternalServerError("Failed to get agent", err)
+			}
+			if agent != nil {
+				agentName = agent.Name
+			}
+		}
+
+		resp := &ExportConfigResponse{}
+		resp.Body = *configs.NewBundle(config, agentName)
+		return resp, nil
+	})
+
+	huma.Post(api, "/configs/sync", func(ctx context.Context, input *SyncConfigsRequest) (*SyncConfigsResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		configList, err := configsService.GetConfigsByOwnerID(ctx, userID)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to get configs", err)
+		}
+
+		resp := &SyncConfigsResponse{}
+		resp.Body.Configs = make([]SyncConfigResult, len(configList))
+		for i := range configList {
+			result, err := reconciler.Reconcile(ctx, &configList[i])
+			if err != nil {
+				resp.Body.Configs[i] = SyncConfigResult{
+					Config: toConfigResponse(&configList[i]),
+					Error:  err.Error(),
+				}
+				continue
+			}
+			resp.Body.Configs[i] = SyncConfigResult{
+				Config:      toConfigResponse(result.Config),
+				RenamedFrom: result.RenamedFrom,
+			}
+		}
+		return resp, nil
+	})
+
+	huma.Post(api, "/configs/import", func(ctx context.Context, input *ImportConfigRequest) (*ImportConfigResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		bundle := &input.Body
+		if err := configs.ValidateBundle(bundle); err != nil {
+			return nil, huma.Error400BadRequest(err.Error())
+		}
+
+		// Enforce the instance-wide config limit
+		if err := limitsService.CheckConfigLimit(ctx, userID); err != nil {
+			if errors.Is(err, limits.ErrConfigLimitReached) {
+				return nil, huma.Error403Forbidden(err.Error())
+			}
+			return nil, huma.Error500InternalServerError("Failed to check config limit", err)
+		}
+
+		result, err := configsService.ImportBundle(ctx, userID, bundle)
+		if err != nil {
+			if errors.Is(err, configs.ErrConfigExists) {
+				return nil, mapConfigError(err)
+			}
+			return nil, huma.Error500InternalServerError("Failed to import config", err)
+		}
+
+		resp := &ImportConfigResponse{}
+		resp.Body.ConfigID = result.ConfigID
+		resp.Body.WebhookSecret = result.WebhookSecret
+		resp.Body.Warnings = []string{}
+
+		// Restore the deploy target where this instance has a match
+		switch bundle.Deploy.Target {
+		case configs.DeployTargetAgent:
+			ownerAgents, err := agentsService.GetAgentsByOwnerID(ctx, userID)
+			if err != nil {
+				return nil, huma.Error500InternalServerError("Failed to get agents", err)
+			}
+
+			var agentID string
+			for _, agent := range ownerAgents {
+				if agent.Name == bundle.Deploy.AgentName {
+					agentID = agent.ID
+					break
+				}
+			}
+
+			if agentID == "" {
+				resp.Body.Warnings = append(resp.Body.Warnings, fmt.Sprintf("No agent named %q; create it with 'nimbul agent create' and attach it with 'nimbul agent use'", bundle.Deploy.AgentName))
+			} else if err := configsService.UpdateAgentID(ctx, result.ConfigID, agentID); err != nil {
+				return nil, huma.Error500InternalServerError("Failed to update agent", err)
+			}
+		case configs.DeployTargetCluster:
+			resp.Body.Warnings = append(resp.Body.Warnings, "Cluster credentials are not exported; attach one with 'nimbul cluster connect'")
+		}
+
+		return resp, nil
+	})
+
+	huma.Post(api, "/agents", func(ctx context.Context, input *CreateAgentRequest) (*CreateAgentResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		if input.Body.Name == "" {
+			return nil, huma.Error400BadRequest("name is required")
+		}
+
+		result, err := agentsService.CreateAgent(ctx, userID, input.Body.Name)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to create agent", err)
+		}
+
+		resp := &CreateAgentResponse{}
+		resp.Body.AgentID = result.AgentID
+		resp.Body.Token = result.Token
+		return resp, nil
+	})
+
+	huma.Get(api, "/agents", func(ctx context.Context, input *ListAgentsRequest) (*ListAgentsResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		agentList, err := agentsService.GetAgentsByOwnerID(ctx, userID)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to get agents", err)
+		}
+
+		resp := &ListAgentsResponse{}
+		resp.Body.Agents = make([]AgentResponse, len(agentList))
+		for i, agent := range agentList {
+			resp.Body.Agents[i] = AgentResponse{
+				ID:         agent.ID,
+				Name:       agent.Name,
+				LastSeenAt: agent.LastSeenAt,
+				CreatedAt:  agent.CreatedAt,
+			}
+		}
+		return resp, nil
+	})
+
+	huma.Patch(api, "/configs/{id}/agent", func(ctx context.Context, input *UpdateConfigAgentRequest) (*UpdateConfigAgentResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		// Configs of other users look the same as deleted ones
+		config, err := configsService.GetConfigByIDAndOwner(ctx, input.ID, userID)
+		if err != nil {
+			return nil, mapConfigError(err)
+		}
+
+		// Agents of other users look the same as missing ones
+		agent, err := agentsService.GetAgentByIDAndOwner(ctx, input.Body.AgentID, userID)
+		if err != nil {
+			if errors.Is(err, agents.ErrAgentNotFound) {
+				return nil, huma.Error404NotFound("Agent not found")
+			}
+			return nil, huma.Error500InternalServerError("Failed to get agent", err)
+		}
+
+		err = configsService.UpdateAgentID(ctx, config.ID, agent.ID)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to update agent", err)
+		}
+
+		resp := &UpdateConfigAgentResponse{}
+		resp.Body.Success = true
+		return resp, nil
+	})
+
+	huma.Post(api, "/configs/{id}/deploy-tokens", func(ctx context.Context, input *CreateDeployTokenRequest) (*CreateDeployTokenResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		if input.Body.Name == "" {
+			return nil, huma.Error400BadRequest("name is required")
+		}
+
+		// Configs of other users look the same as deleted ones
+		config, err := configsService.GetConfigByIDAndOwner(ctx, input.ID, userID)
+		if err != nil {
+			return nil, mapConfigError(err)
+		}
+
+		result, err := deployTokensService.Create(ctx, config.ID, userID, input.Body.Name)
+		if err != nil {
+			if errors.Is(err, deploytokens.ErrNameTaken) {
+				return nil, huma.Error409Conflict(err.Error())
+			}
+			return nil, huma.Error500InternalServerError("Failed to create deploy token", err)
+		}
+
+		resp := &CreateDeployTokenResponse{}
+		resp.Body.DeployTokenResponse = toDeployTokenResponse(&result.DeployToken)
+		resp.Body.Token = result.Token
+		return resp, nil
+	})
+
+	huma.Get(api, "/configs/{id}/deploy-tokens", func(ctx context.Context, input *ListDeployTokensRequest) (*ListDeployTokensResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		// Configs of other users look the same as deleted ones
+		config, err := configsService.GetConfigByIDAndOwner(ctx, input.ID, userID)
+		if err != nil {
+			return nil, mapConfigError(err)
+		}
+
+		deployTokens, err := deployTokensService.List(ctx, config.ID)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to get deploy tokens", err)
+		}
+
+		resp := &ListDeployTokensResponse{}
+		resp.Body.DeployTokens = make([]DeployTokenResponse, len(deployTokens))
+		for i, deployToken := range deployTokens {
+			resp.Body.DeployTokens[i] = toDeployTokenResponse(&deployToken)
+		}
+		return resp, nil
+	})
+
+	huma.Delete(api, "/configs/{id}/deploy-tokens/{token_id}", func(ctx context.Context, input *DeleteDeployTokenRequest) (*DeleteDeployTokenResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		// Configs of other users look the same as deleted ones
+		config, err := configsService.GetConfigByIDAndOwner(ctx, input.ID, userID)
+		if err != nil {
+			return nil, mapConfigError(err)
+		}
+
+		if err := deployTokensService.Delete(ctx, config.ID, input.TokenID); err != nil {
+			if errors.Is(err, deploytokens.ErrTokenNotFound) {
+				return nil, huma.Error404NotFound("Deploy token not found")
+			}
+			return nil, huma.Error500InternalServerError("Failed to delete deploy token", err)
+		}
+
+		resp := &DeleteDeployTokenResponse{}
+		resp.Body.Success = true
+		return resp, nil
+	})
+
+	huma.Post(api, "/deploy/trigger", func(ctx context.Context, input *TriggerDeployRequest) (*TriggerDeployResponse, error) {
+		// External CI systems authenticate with a deploy token of the config they deploy
+		var err error
+		ctx, err = ValidateDeployTokenAuth(ctx, input.AuthResolver.Authorization, deployTokensService)
+		if err != nil {
+			return nil, err
+		}
+
+		deployToken := GetDeployToken(ctx)
+
+		if input.Body.Branch == "" {
+			return nil, huma.Error400BadRequest("branch is required")
+		}
+
+		config, err := deployTokenConfig(ctx, configsService, authService, deployToken)
+		if err != nil {
+			return nil, err
+		}
+
+		// Triggers share the rate limit of the config's webhooks
+		if err := webhookGuard.Check(config.ID, "", time.Time{}); err != nil {
+			return nil, webhookGuardError(err)
+		}
+
+		payload, commitSHA, err := webhooksService.TriggerPushEvent(ctx, config, input.Body.Branch, input.Body.CommitSHA, "deploy token "+deployToken.Name)
+		if err != nil {
+			switch {
+			case errors.Is(err, webhooks.ErrBranchNotFound):
+				return nil, huma.Error404NotFound(err.Error())
+			case errors.Is(err, webhooks.ErrBranchMoved):
+				return nil, huma.Error409Conflict(err.Error())
+			}
+			return nil, huma.Error500InternalServerError("Failed to resolve branch", err)
+		}
+
+		// The CI run waits for the answer, so the run goes ahead of a webhook backlog
+		queued, err := jobsService.Submit(ctx, jobs.Job{Kind: jobs.KindGitHubPush, ConfigID: config.ID, Payload: payload, Priority: jobs.PriorityManual})
+		if err != nil {
+			switch {
+			case errors.Is(err, usage.ErrQuotaExceeded):
+				return nil, huma.Error403Forbidden(err.Error())
+			case jobs.IsPermanent(err):
+				// The build or deploy failed, e.g. on a mistake in nimbul.yaml
+				return nil, huma.Error422UnprocessableEntity(err.Error())
+			}
+			fmt.Printf("Error handling deploy trigger: %v\n", err)
+			return nil, huma.Error500InternalServerError("Failed to run the pipeline", err)
+		}
+
+		resp := &TriggerDeployResponse{}
+		resp.Body.ConfigID = config.ID
+		resp.Body.Ref = "refs/heads/" + strings.TrimPrefix(input.Body.Branch, "refs/heads/")
+		resp.Body.CommitSHA = commitSHA
+		resp.Body.Queued = queued
+		return resp, nil
+	})
+
+	huma.Get(api, "/deploy/status", func(ctx context.Context, input *GetDeployStatusRequest) (*GetDeployStatusResponse, error) {
+		// External CI systems authenticate with a deploy token of the config they deploy
+		var err error
+		ctx, err = ValidateDeployTokenAuth(ctx, input.AuthResolver.Authorization, deployTokensService)
+		if err != nil {
+			return nil, err
+		}
+
+		config, err := deployTokenConfig(ctx, configsService, authService, GetDeployToken(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		deploymentList, err := deploymentsService.GetDeploymentsByConfigID(ctx, config.ID, 100)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to get deployments", err)
+		}
+
+		for _, deployment := range deploymentList {
+			if input.CommitSHA != "" && !strings.EqualFold(deployment.CommitSHA, input.CommitSHA) {
+				continue
+			}
+			resp := &GetDeployStatusResponse{}
+			resp.Body.Deployment = toDeploymentResponse(&deployment)
+			return resp, nil
+		}
+
+		if input.CommitSHA != "" {
+			return nil, huma.Error404NotFound("No deployment of the commit")
+		}
+		return nil, huma.Error404NotFound("No deployment yet")
+	})
+
+	huma.Get(api, "/agent/deployments/next", func(ctx context.Context, input *GetNextAgentDeploymentRequest) (*GetNextAgentDeploymentResponse, error) {
+		// Agents authenticate with their own token
+		var err error
+		ctx, err = ValidateAgentAuth(ctx, input.AuthResolver.Authorization, agentsService)
+		if err != nil {
+			return nil, err
+		}
+
+		agentID := GetAgentID(ctx)
+
+		// Long-poll until a deployment is queued or the wait expires
+		deployment, err := agentsService.WaitForDeployment(ctx, agentID, time.Duration(input.Wait)*time.Second)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to get next deployment", err)
+		}
+
+		resp := &GetNextAgentDeploymentResponse{}
+		if deployment != nil {
+			resp.Body.Deployment = &AgentDeploymentResponse{
+				ID:        deployment.ID,
+				ConfigID:  deployment.ConfigID,
+				Manifests: deployment.Manifests,
+			}
+		}
+		return resp, nil
+	})
+
+	huma.Post(api, "/agent/deployments/{id}/status", func(ctx context.Context, input *ReportAgentDeploymentRequest) (*ReportAgentDeploymentResponse, error) {
+		// Agents authenticate with their own token
+		var err error
+		ctx, err = ValidateAgentAuth(ctx, input.AuthResolver.Authorization, agentsService)
+		if err != nil {
+			return nil, err
+		}
+
+		agentID := GetAgentID(ctx)
+
+		deployment, err := agentsService.ReportDeployment(ctx, agentID, input.ID, input.Body.Status, input.Body.Error)
+		if err != nil {
+			switch {
+			case errors.Is(err, agents.ErrInvalidStatus):
+				return nil, huma.Error400BadRequest("status must be 'applied' or 'failed'")
+			case errors.Is(err, agents.ErrDeploymentNotFound):
+				return nil, huma.Error404NotFound("Deployment not found")
+			}
+			return nil, huma.Error500InternalServerError("Failed to report deployment status", err)
+		}
+
+		// Let the config owner's hooks know how the deploy went
+		if config, err := configsService.GetConfigByID(ctx, deployment.ConfigID); err == nil {
+			event := hooks.Event{
+				Type:       hooks.EventDeploySucceeded,
+				ConfigID:   config.ID,
+				Repository: config.RepoFullName,
+			}
+			if deployment.Status == agents.DeploymentStatusFailed {
+				event.Type = hooks.EventDeployFailed
+				event.Error = input.Body.Error
+			}
+			webhooksService.Publish(ctx, config, event)
+		}
+
+		resp := &ReportAgentDeploymentResponse{}
+		resp.Body.Success = true
+		return resp, nil
+	})
+
+	huma.Post(api, "/hooks", func(ctx context.Context, input *CreateHookRequest) (*CreateHookResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		result, err := hooksService.CreateHook(ctx, userID, input.Body.URL, input.Body.Events)
+		if err != nil {
+			if errors.Is(err, hooks.ErrInvalidURL) || errors.Is(err, hooks.ErrPrivateAddress) || errors.Is(err, hooks.ErrInvalidEventType) {
+				return nil, huma.Error400BadRequest(err.Error())
+			}
+			return nil, huma.Error500InternalServerError("Failed to create hook", err)
+		}
+
+		resp := &CreateHookResponse{}
+		resp.Body.HookID = result.HookID
+		resp.Body.Secret = result.Secret
+		return resp, nil
+	})
+
+	huma.Get(api, "/hooks", func(ctx context.Context, input *ListHooksRequest) (*ListHooksResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		hookList, err := hooksService.GetHooksByOwnerID(ctx, userID)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to get hooks", err)
+		}
+
+		resp := &ListHooksResponse{}
+		resp.Body.Hooks = make([]HookResponse, len(hookList))
+		for i, hook := range hookList {
+			resp.Body.Hooks[i] = HookResponse{
+				ID:             hook.ID,
+				URL:            hook.URL,
+				Events:         hook.Events,
+				LastDeliveryAt: hook.LastDeliveryAt,
+				LastStatusCode: hook.LastStatusCode,
+				LastError:      hook.LastError,
+				CreatedAt:      hook.CreatedAt,
+			}
+		}
+		return resp, nil
+	})
+
+	huma.Delete(api, "/hooks/{id}", func(ctx context.Context, input *DeleteHookRequest) (*DeleteHookResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		if err := hooksService.DeleteHook(ctx, userID, input.ID); err != nil {
+			if errors.Is(err, hooks.ErrHookNotFound) {
+				return nil, huma.Error404NotFound("Hook not found")
+			}
+			return nil, huma.Error500InternalServerError("Failed to delete hook", err)
+		}
+
+		resp := &DeleteHookResponse{}
+		resp.Body.Success = true
+		return resp, nil
+	})
+
+	huma.Get(api, "/policies", func(ctx context.Context, input *ListPoliciesRequest) (*ListPoliciesResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		policyList, err := policiesService.List(ctx, userID)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to get policies", err)
+		}
+
+		resp := &ListPoliciesResponse{}
+		resp.Body.Policies = make([]PolicyResponse, len(policyList))
+		for i, policy := range policyList {
+			resp.Body.Policies[i] = toPolicyResponse(&policy)
+		}
+		return resp, nil
+	})
+
+	huma.Put(api, "/policies/{name}", func(ctx context.Context, input *SetPolicyRequest) (*SetPolicyResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		policy, err := policiesService.Set(ctx, userID, input.Name, policies.SetParams{
+			Expression: input.Body.Expression,
+			Message:    input.Body.Message,
+			Action:     input.Body.Action,
+		})
+		if err != nil {
+			if errors.Is(err, policies.ErrInvalidName) || errors.Is(err, policies.ErrInvalidAction) || errors.Is(err, policies.ErrInvalidExpression) {
+				return nil, huma.Error422UnprocessableEntity(err.Error())
+			}
+			return nil, huma.Error500InternalServerError("Failed to set policy", err)
+		}
+
+		return &SetPolicyResponse{Body: toPolicyResponse(policy)}, nil
+	})
+
+	huma.Delete(api, "/policies/{name}", func(ctx context.Context, input *DeletePolicyRequest) (*DeletePolicyResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		if err := policiesService.Delete(ctx, userID, input.Name); err != nil {
+			if errors.Is(err, policies.ErrPolicyNotFound) {
+				return nil, huma.Error404NotFound("Policy not found")
+			}
+			return nil, huma.Error500InternalServerError("Failed to delete policy", err)
+		}
+
+		resp := &DeletePolicyResponse{}
+		resp.Body.Success = true
+		return resp, nil
+	})
+
+	huma.Get(api, "/jobs/dead", func(ctx context.Context, input *ListDeadJobsRequest) (*ListDeadJobsResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		// Admins may look at the dead jobs of every user
+		ownerID := userID
+		if input.All {
+			if GetUserRole(ctx) != auth.RoleAdmin {
+				return nil, huma.Error403Forbidden("Admin role required to view other users' jobs")
+			}
+			ownerID = ""
+		}
+
+		deadJobs, err := jobsService.GetDeadJobs(ctx, ownerID)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to get dead jobs", err)
+		}
+
+		resp := &ListDeadJobsResponse{}
+		resp.Body.Jobs = make([]DeadJobResponse, len(deadJobs))
+		for i, job := range deadJobs {
+			resp.Body.Jobs[i] = toDeadJobResponse(&job)
+		}
+		return resp, nil
+	})
+
+	huma.Post(api, "/jobs/dead/{id}/requeue", func(ctx context.Context, input *RequeueDeadJobRequest) (*RequeueDeadJobResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		// Verify job belongs to user, unless an admin requeues it
+		job, err := jobsService.GetDeadJobByID(ctx, input.ID)
+		if err != nil {
+			if errors.Is(err, jobs.ErrJobNotFound) {
+				return nil, huma.Error404NotFound("Dead job not found")
+			}
+			return nil, huma.Error500InternalServerError("Failed to get dead job", err)
+		}
+
+		if job.OwnerID != userID && GetUserRole(ctx) != auth.RoleAdmin {
+			return nil, huma.Error404NotFound("Dead job not found")
+		}
+
+		if err := jobsService.Requeue(ctx, job); err != nil {
+			if errors.Is(err, jobs.ErrJobRequeued) {
+				return nil, huma.Error409Conflict(err.Error())
+			}
+			return nil, huma.Error500InternalServerError("Failed to requeue dead job", err)
+		}
+
+		resp := &RequeueDeadJobResponse{}
+		resp.Body.Requeued = true
+		return resp, nil
+	})
+
+	huma.Get(api, "/admin/users", func(ctx context.Context, input *ListAdminUsersRequest) (*ListAdminUsersResponse, error) {
+		// Validate admin authentication using middleware
+		var err error
+		ctx, err = ValidateAdminAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		users, err := adminService.GetUsers(ctx)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to get users", err)
+		}
+
+		resp := &ListAdminUsersResponse{}
+		resp.Body.Users = make([]AdminUserResponse, len(users))
+		for i, user := range users {
+			resp.Body.Users[i] = toAdminUserResponse(&user)
+		}
+		return resp, nil
+	})
+
+	huma.Patch(api, "/admin/users/{id}", func(ctx context.Context, input *UpdateAdminUserRequest) (*UpdateAdminUserResponse, error) {
+		// Validate admin authentication using middleware
+		var err error
+		ctx, err = ValidateAdminAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		if input.Body.Disabled == nil && input.Body.Role == "" {
+			return nil, huma.Error400BadRequest("disabled or role is required")
+		}
+
+		// Admins cannot lock themselves out
+		if input.ID == GetUserID(ctx) && ((input.Body.Disabled != nil && *input.Body.Disabled) || (input.Body.Role != "" && input.Body.Role != auth.RoleAdmin)) {
+			return nil, huma.Error400BadRequest("You cannot disable or demote your own account")
+		}
+
+		var user *admin.User
+		if input.Body.Role != "" {
+			user, err = adminService.SetRole(ctx, input.ID, input.Body.Role)
+			if err != nil {
+				return nil, mapAdminError(err)
+			}
+		}
+		if input.Body.Disabled != nil {
+			user, err = adminService.SetDisabled(ctx, input.ID, *input.Body.Disabled)
+			if err != nil {
+				return nil, mapAdminError(err)
+			}
+		}
+
+		resp := &UpdateAdminUserResponse{}
+		resp.Body = toAdminUserResponse(user)
+		return resp, nil
+	})
+
+	huma.Get(api, "/admin/activity", func(ctx context.Context, input *GetAdminActivityRequest) (*GetAdminActivityResponse, error) {
+		// Validate admin authentication using middleware
+		var err error
+		ctx, err = ValidateAdminAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		activity, err := adminService.GetActivity(ctx, input.Limit)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to get activity", err)
+		}
+
+		resp := &GetAdminActivityResponse{}
+		resp.Body.Activity = make([]AdminActivityResponse, len(activity))
+		for i, a := range activity {
+			resp.Body.Activity[i] = AdminActivityResponse{
+				DeploymentID: a.DeploymentID,
+				ConfigID:     a.ConfigID,
+				RepoFullName: a.RepoFullName,
+				OwnerID:      a.OwnerID,
+				OwnerEmail:   a.OwnerEmail,
+				Ref:          a.Ref,
+				CommitSHA:    a.CommitSHA,
+				Status:       a.Status,
+				Error:        a.Error,
+				CreatedAt:    a.CreatedAt,
+				UpdatedAt:    a.UpdatedAt,
+			}
+		}
+		return resp, nil
+	})
+
+	huma.Get(api, "/admin/limits", func(ctx context.Context, input *GetInstanceLimitsRequest) (*GetInstanceLimitsResponse, error) {
+		// Validate admin authentication using middleware
+		var err error
+		ctx, err = ValidateAdminAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		instanceLimits, err := limitsService.GetLimits(ctx)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to get instance limits", err)
+		}
+
+		resp := &GetInstanceLimitsResponse{}
+		resp.Body = toInstanceLimitsBody(instanceLimits)
+		return resp, nil
+	})
+
+	huma.Put(api, "/admin/limits", func(ctx context.Context, input *UpdateInstanceLimitsRequest) (*UpdateInstanceLimitsResponse, error) {
+		// Validate admin authentication using middleware
+		var err error
+		ctx, err = ValidateAdminAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		instanceLimits, err := limitsService.UpdateLimits(ctx, limits.Limits{
+			MaxConfigsPerUser: input.Body.MaxConfigsPerUser,
+			MaxBuildMinutes:   input.Body.MaxBuildMinutes,
+			BuildMinutesQuota: limits.Quota{Soft: input.Body.BuildMinutesSoftQuota, Hard: input.Body.BuildMinutesHardQuota},
+			DeploysQuota:      limits.Quota{Soft: input.Body.DeploysSoftQuota, Hard: input.Body.DeploysHardQuota},
+			StorageMBQuota:    limits.Quota{Soft: input.Body.StorageMBSoftQuota, Hard: input.Body.StorageMBHardQuota},
+			MaxLogMB:          input.Body.MaxLogMB,
+			MaxArtifactMB:     input.Body.MaxArtifactMB,
+
+			ConfigBuildMinutesQuota: limits.Quota{Soft: input.Body.ConfigBuildMinutesSoftQuota, Hard: input.Body.ConfigBuildMinutesHardQuota},
+			ConfigStorageMBQuota:    limits.Quota{Soft: input.Body.ConfigStorageMBSoftQuota, Hard: input.Body.ConfigStorageMBHardQuota},
+
+			Retention: limits.Retention{BuildDays: input.Body.BuildRetentionDays, LogDays: input.Body.LogRetentionDays},
+		})
+		if err != nil {
+			if errors.Is(err, limits.ErrInvalidLimit) || errors.Is(err, limits.ErrInvalidQuota) {
+				return nil, huma.Error400BadRequest(err.Error())
+			}
+			return nil, huma.Error500InternalServerError("Failed to update instance limits", err)
+		}
+
+		resp := &UpdateInstanceLimitsResponse{}
+		resp.Body = toInstanceLimitsBody(instanceLimits)
+		return resp, nil
+	})
+
+	huma.Get(api, "/admin/github-app", func(ctx context.Context, input *GetGitHubAppRequest) (*GetGitHubAppResponse, error) {
+		// Validate admin authentication using middleware
+		var err error
+		ctx, err = ValidateAdminAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		settings, err := githubAppService.Get(ctx)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to get GitHub App", err)
+		}
+
+		resp := &GetGitHubAppResponse{}
+		resp.Body = toGitHubAppResponse(settings)
+		return resp, nil
+	})
+
+	huma.Put(api, "/admin/github-app", func(ctx context.Context, input *UpdateGitHubAppRequest) (*UpdateGitHubAppResponse, error) {
+		// Validate admin authentication using middleware
+		var err error
+		ctx, err = ValidateAdminAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		settings, err := githubAppService.Set(ctx, githubapp.SetParams{
+			AppID:         input.Body.AppID,
+			Slug:          input.Body.Slug,
+			ClientID:      input.Body.ClientID,
+			ClientSecret:  input.Body.ClientSecret,
+			PrivateKey:    input.Body.PrivateKey,
+			WebhookSecret: input.Body.WebhookSecret,
+		})
+		if err != nil {
+			if errors.Is(err, githubapp.ErrInvalidPrivateKey) || errors.Is(err, githubapp.ErrPrivateKeyRequired) {
+				return nil, huma.Error400BadRequest(err.Error())
+			}
+			return nil, huma.Error500InternalServerError("Failed to update GitHub App", err)
+		}
+
+		resp := &UpdateGitHubAppResponse{}
+		resp.Body = toGitHubAppResponse(settings)
+		return resp, nil
+	})
+
+	huma.Delete(api, "/admin/github-app", func(ctx context.Context, input *DeleteGitHubAppRequest) (*DeleteGitHubAppResponse, error) {
+		// Validate admin authentication using middleware
+		var err error
+		ctx, err = ValidateAdminAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		deleted, err := githubAppService.Delete(ctx)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to delete GitHub App", err)
+		}
+		if !deleted {
+			return nil, huma.Error404NotFound("No GitHub App configuration is stored")
+		}
+
+		// The environment is in effect again
+		settings, err := githubAppService.Get(ctx)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to get GitHub App", err)
+		}
+
+		resp := &DeleteGitHubAppResponse{}
+		resp.Body = toGitHubAppResponse(settings)
+		return resp, nil
+	})
+
+	huma.Get(api, "/admin/users/{id}/retention", func(ctx context.Context, input *GetUserRetentionRequest) (*GetUserRetentionResponse, error) {
+		// Validate admin authentication using middleware
+		var err error
+		ctx, err = ValidateAdminAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		retention, err := limitsService.GetOwnerRetention(ctx, input.ID)
+		if err != nil {
+			if errors.Is(err, limits.ErrOwnerNotFound) {
+				return nil, huma.Error404NotFound("User not found")
+			}
+			return nil, huma.Error500InternalServerError("Failed to get retention", err)
+		}
+
+		resp := &GetUserRetentionResponse{}
+		resp.Body = UserRetentionBody{BuildRetentionDays: retention.BuildDays, LogRetentionDays: retention.LogDays}
+		return resp, nil
+	})
+
+	huma.Put(api, "/admin/users/{id}/retention", func(ctx context.Context, input *UpdateUserRetentionRequest) (*UpdateUserRetentionResponse, error) {
+		// Validate admin authentication using middleware
+		var err error
+		ctx, err = ValidateAdminAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		retention, err := limitsService.SetOwnerRetention(ctx, input.ID, limits.Retention{
+			BuildDays: input.Body.BuildRetentionDays,
+			LogDays:   input.Body.LogRetentionDays,
+		})
+		if err != nil {
+			switch {
+			case errors.Is(err, limits.ErrOwnerNotFound):
+				return nil, huma.Error404NotFound("User not found")
+			case errors.Is(err, limits.ErrInvalidLimit):
+				return nil, huma.Error400BadRequest(err.Error())
+			}
+			return nil, huma.Error500InternalServerError("Failed to set retention", err)
+		}
+
+		resp := &UpdateUserRetentionResponse{}
+		resp.Body = UserRetentionBody{BuildRetentionDays: retention.BuildDays, LogRetentionDays: retention.LogDays}
+		return resp, nil
+	})
+
+	huma.Get(api, "/admin/retention", func(ctx context.Context, input *GetRetentionStatsRequest) (*GetRetentionStatsResponse, error) {
+		// Validate admin authentication using middleware
+		var err error
+		ctx, err = ValidateAdminAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		stats, err := buildsService.GetRetentionStats(ctx, input.Limit)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to get retention stats", err)
+		}
+
+		resp := &GetRetentionStatsResponse{}
+		resp.Body.Runs = stats.Runs
+		resp.Body.BuildsDeleted = stats.BuildsDeleted
+		resp.Body.LogsDeleted = stats.LogsDeleted
+		resp.Body.BytesReclaimed = stats.BytesReclaimed
+		resp.Body.LastRunAt = stats.LastRunAt
+		resp.Body.RecentRuns = make([]RetentionRunResponse, len(stats.RecentRuns))
+		for i, run := range stats.RecentRuns {
+			resp.Body.RecentRuns[i] = RetentionRunResponse{
+				BuildsDeleted:  run.BuildsDeleted,
+				LogsDeleted:    run.LogsDeleted,
+				BytesReclaimed: run.BytesReclaimed,
+				StartedAt:      run.StartedAt,
+				FinishedAt:     run.FinishedAt,
+			}
+		}
+		return resp, nil
+	})
+
+	huma.Get(api, "/activity", func(ctx context.Context, input *GetActivityRequest) (*GetActivityResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		if input.ConfigID != "" {
+			// Configs of other users look the same as deleted ones
+			if _, err := configsService.GetConfigByIDAndOwner(ctx, input.ConfigID, userID); err != nil {
+				return nil, mapConfigError(err)
+			}
+		}
+
+		entries, err := activityService.List(ctx, userID, input.ConfigID, input.Limit)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to get activity", err)
+		}
+
+		resp := &GetActivityResponse{}
+		resp.Body.Activity = make([]ActivityEntryResponse, len(entries))
+		for i, entry := range entries {
+			resp.Body.Activity[i] = ActivityEntryResponse{
+				Kind:         entry.Kind,
+				ID:           entry.ID,
+				ConfigID:     entry.ConfigID,
+				RepoFullName: entry.RepoFullName,
+				Name:         entry.Name,
+				Ref:          entry.Ref,
+				CommitSHA:    entry.CommitSHA,
+				Status:       entry.Status,
+				Error:        entry.Error,
+				Actor:        entry.Actor,
+				RollbackOf:   entry.RollbackOf,
+				StartedAt:    entry.StartedAt,
+				FinishedAt:   entry.FinishedAt,
+			}
+		}
+		return resp, nil
+	})
+
+	huma.Get(api, "/usage", func(ctx context.Context, input *GetUsageRequest) (*GetUsageResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		// Admins may look at anyone's usage
+		if input.UserID != "" && input.UserID != userID {
+			if GetUserRole(ctx) != auth.RoleAdmin {
+				return nil, huma.Error403Forbidden("Admin role required to view other users' usage")
+			}
+			userID = input.UserID
+		}
+
+		ownerUsage, err := usageService.GetUsage(ctx, userID)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to get usage", err)
+		}
+
+		resp := &GetUsageResponse{}
+		resp.Body.PeriodStart = ownerUsage.PeriodStart
+		resp.Body.PeriodEnd = ownerUsage.PeriodEnd
+		resp.Body.Builds = ownerUsage.Builds
+		resp.Body.BuildMinutes = ownerUsage.BuildMinutes
+		resp.Body.Deploys = ownerUsage.Deploys
+		resp.Body.StorageBytes = ownerUsage.StorageBytes
+		resp.Body.Quotas = toQuotaStatusResponses(ownerUsage.Quotas)
+		resp.Body.Configs = make([]ConfigUsageResponse, len(ownerUsage.Configs))
+		for i, config := range ownerUsage.Configs {
+			resp.Body.Configs[i] = ConfigUsageResponse{
+				ConfigID:     config.ConfigID,
+				RepoFullName: config.RepoFullName,
+				Builds:       config.Builds,
+				BuildMinutes: config.BuildMinutes,
+				StorageBytes: config.StorageBytes,
+				Quotas:       toQuotaStatusResponses(config.Quotas),
+			}
+		}
+		return resp, nil
+	})
+
+	huma.Get(api, "/configs/{id}/deployments", func(ctx context.Context, input *ListConfigDeploymentsRequest) (*ListConfigDeploymentsResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		// Configs of other users look the same as deleted ones
+		config, err := configsService.GetConfigByIDAndOwner(ctx, input.ID, userID)
+		if err != nil {
+			return nil, mapConfigError(err)
+		}
+
+		deploymentList, err := deploymentsService.ListDeployments(ctx, config.ID, input.Before, input.Limit)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to get deployments", err)
+		}
+
+		resp := &ListConfigDeploymentsResponse{}
+		resp.Body.Deployments = make([]DeploymentResponse, len(deploymentList))
+		for i, deployment := range deploymentList {
+			resp.Body.Deployments[i] = toDeploymentResponse(&deployment)
+		}
+		// A full page may have more behind it
+		if len(deploymentList) == int(input.Limit) {
+			last := deploymentList[len(deploymentList)-1].ID
+			resp.Body.NextBefore = &last
+		}
+		return resp, nil
+	})
+
+	huma.Get(api, "/configs/{id}/environments", func(ctx context.Context, input *ListConfigEnvironmentsRequest) (*ListConfigEnvironmentsResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		// Configs of other users look the same as deleted ones
+		config, err := configsService.GetConfigByIDAndOwner(ctx, input.ID, userID)
+		if err != nil {
+			return nil, mapConfigError(err)
+		}
+
+		environments, err := deploymentsService.ListEnvironments(ctx, config.ID)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to get environments", err)
+		}
+
+		resp := &ListConfigEnvironmentsResponse{}
+		resp.Body.Environments = make([]EnvironmentResponse, len(environments))
+		for i, environment := range environments {
+			resp.Body.Environments[i] = EnvironmentResponse{
+				Namespace:  environment.Namespace,
+				Branch:     environment.Branch,
+				Deployment: toDeploymentResponse(&environment.Deployment),
+				Builds:     environment.Builds,
+				UpdatedAt:  environment.UpdatedAt,
+			}
+		}
+		return resp, nil
+	})
+
+	huma.Get(api, "/deployments/{id}/resources", func(ctx context.Context, input *GetDeploymentResourcesRequest) (*GetDeploymentResourcesResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		deployment, err := deploymentsService.GetDeploymentByIDAndOwner(ctx, input.ID, userID)
+		if err != nil {
+			if errors.Is(err, deployments.ErrDeploymentNotFound) {
+				return nil, huma.Error404NotFound("Deployment not found")
+			}
+			return nil, huma.Error500InternalServerError("Failed to get deployment", err)
+		}
+
+		config, err := configsService.GetConfigByIDAndOwner(ctx, deployment.ConfigID, userID)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to get config", err)
+		}
+
+		// Query the cluster the config deploys to
+		clusterConfig, err := webhooksService.ClusterConfig(ctx, config)
+		if err != nil {
+			if errors.Is(err, webhooks.ErrNoClusterCredential) {
+				return nil, huma.Error400BadRequest("The config has no cluster credential to query its cluster with")
+			}
+			return nil, huma.Error500InternalServerError("Failed to resolve cluster configuration", err)
+		}
+
+		statuses, err := k8s.GetResourceStatuses(ctx, clusterConfig, deployment.Resources)
+		if err != nil {
+			return nil, huma.Error502BadGateway("Failed to query cluster", err)
+		}
+
+		resp := &GetDeploymentResourcesResponse{}
+		resp.Body.Deployment = toDeploymentResponse(deployment)
+		resp.Body.Resources = statuses
+		return resp, nil
+	})
+
+	huma.Get(api, "/deployments/{id}/manifests", func(ctx context.Context, input *GetDeploymentManifestsRequest) (*GetDeploymentManifestsResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		deployment, err := deploymentsService.GetDeploymentByIDAndOwner(ctx, input.ID, userID)
+		if err != nil {
+			if errors.Is(err, deployments.ErrDeploymentNotFound) {
+				return nil, huma.Error404NotFound("Deployment not found")
+			}
+			return nil, huma.Error500InternalServerError("Failed to get deployment", err)
+		}
+
+		manifests, err := deploymentsService.ListManifests(ctx, deployment.ID)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to get manifests", err)
+		}
+
+		resp := &GetDeploymentManifestsResponse{}
+		resp.Body.Deployment = toDeploymentResponse(deployment)
+		resp.Body.Manifests = make([]DeploymentManifestResponse, len(manifests))
+		for i, manifest := range manifests {
+			resp.Body.Manifests[i] = DeploymentManifestResponse{
+				Path:    manifest.Path,
+				Content: manifest.Content,
+			}
+		}
+		return resp, nil
+	})
+
+	huma.Post(api, "/deployments/{id}/rollback", func(ctx context.Context, input *RollbackDeploymentRequest) (*RollbackDeploymentResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		target, err := deploymentsService.GetDeploymentByIDAndOwner(ctx, input.ID, userID)
+		if err != nil {
+			if errors.Is(err, deployments.ErrDeploymentNotFound) {
+				return nil, huma.Error404NotFound("Deployment not found")
+			}
+			return nil, huma.Error500InternalServerError("Failed to get deployment", err)
+		}
+
+		config, err := configsService.GetConfigByIDAndOwner(ctx, target.ConfigID, userID)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to get config", err)
+		}
+
+		user, err := authService.GetUserByID(ctx, userID)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to get user", err)
+		}
+
+		// Rollbacks take a job slot ahead of the builds waiting for one
+		release, err := jobsService.Acquire(ctx, jobs.PriorityManual)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to wait for a job slot", err)
+		}
+		defer release()
+
+		if err := webhooksService.Rollback(ctx, config, target, user.Email); err != nil {
+			if errors.Is(err, webhooks.ErrRollbackTargetFailed) {
+				return nil, huma.Error409Conflict(err.Error())
+			}
+			return nil, huma.Error500InternalServerError("Failed to roll back", err)
+		}
+
+		resp := &RollbackDeploymentResponse{}
+		resp.Body.RolledBackTo = toDeploymentResponse(target)
+
+		// Agents record their deployments separately, so only direct deploys have one here
+		if config.AgentID == nil {
+			latest, err := deploymentsService.GetDeploymentsByConfigID(ctx, config.ID, 1)
+			if err != nil {
+				return nil, huma.Error500InternalServerError("Failed to get deployments", err)
+			}
+			if len(latest) > 0 {
+				deployment := toDeploymentResponse(&latest[0])
+				resp.Body.Deployment = &deployment
+			}
+		}
+		return resp, nil
+	})
+
+	huma.Get(api, "/builds/{id}", func(ctx context.Context, input *GetBuildRequest) (*GetBuildResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
 
-	// Initialize webhooks service
-	webhooksService := webhooks.NewService(configsService)
+		// Builds of other users look the same as missing ones
+		build, err := buildsService.GetBuildByIDAndOwner(ctx, input.ID, userID)
+		if err != nil {
+			if errors.Is(err, builds.ErrBuildNotFound) {
+				return nil, huma.Error404NotFound("Build not found")
+			}
+			return nil, huma.Error500InternalServerError("Failed to get build", err)
+		}
 
-	huma.Get(api, "/health", func(ctx context.Context, input *struct{}) (*HealthCheckResponse, error) {
-		resp := &HealthCheckResponse{}
-		resp.Body.Message = "Nimbul API is up and running"
+		resp := &GetBuildResponse{}
+		resp.Body.BuildSummaryResponse = BuildSummaryResponse{
+			ID:         build.ID,
+			Name:       build.Name,
+			Ref:        build.Ref,
+			CommitSHA:  build.CommitSHA,
+			Status:     build.Status,
+			Error:      build.Error,
+			StartedAt:  build.StartedAt,
+			FinishedAt: build.FinishedAt,
+		}
+		resp.Body.ConfigID = build.ConfigID
+		resp.Body.PipelineID = build.PipelineID
+		resp.Body.Timings = make([]BuildTimingResponse, len(build.Timings))
+		for i, timing := range build.Timings {
+			resp.Body.Timings[i] = BuildTimingResponse{
+				Name:       timing.Name,
+				Kind:       timing.Kind,
+				StartedAt:  timing.Started,
+				DurationMs: timing.Duration.Milliseconds(),
+			}
+		}
 		return resp, nil
 	})
 
-	huma.Post(api, "/register", func(ctx context.Context, input *RegisterRequest) (*RegisterResponse, error) {
-		result, err := authService.Register(ctx, input.Body.Email, input.Body.Password)
+	huma.Get(api, "/builds/{id}/artifacts", func(ctx context.Context, input *ListBuildArtifactsRequest) (*ListBuildArtifactsResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
 		if err != nil {
-			fmt.Println("Error registering:", err)
-			return nil, mapAuthError(err)
+			return nil, err
 		}
 
-		resp := &RegisterResponse{}
-		resp.Body.Token = result.Token
-		resp.Body.User = result.User
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		// Builds of other users look the same as missing ones
+		build, err := buildsService.GetBuildByIDAndOwner(ctx, input.ID, userID)
+		if err != nil {
+			if errors.Is(err, builds.ErrBuildNotFound) {
+				return nil, huma.Error404NotFound("Build not found")
+			}
+			return nil, huma.Error500InternalServerError("Failed to get build", err)
+		}
+
+		artifactList, err := artifactsService.GetArtifactsByBuildID(ctx, build.ID)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to get artifacts", err)
+		}
+
+		resp := &ListBuildArtifactsResponse{}
+		resp.Body.Artifacts = make([]ArtifactResponse, len(artifactList))
+		for i, artifact := range artifactList {
+			resp.Body.Artifacts[i] = ArtifactResponse{
+				Name:      artifact.Name,
+				SizeBytes: artifact.SizeBytes,
+				CreatedAt: artifact.CreatedAt,
+			}
+		}
 		return resp, nil
 	})
 
-	huma.Post(api, "/login", func(ctx context.Context, input *LoginRequest) (*LoginResponse, error) {
-		result, err := authService.Login(ctx, input.Body.Email, input.Body.Password)
+	huma.Get(api, "/builds/{id}/artifacts/{name}", func(ctx context.Context, input *DownloadBuildArtifactRequest) (*huma.StreamResponse, error) {
+		// Validate authentication using middleware
+		var err error
+		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
 		if err != nil {
-			fmt.Println("Error logging in:", err)
-			return nil, mapAuthError(err)
+			return nil, err
 		}
 
-		resp := &LoginResponse{}
-		resp.Body.Token = result.Token
-		resp.Body.User = result.User
-		return resp, nil
+		// Get user ID from context
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return nil, huma.Error401Unauthorized("User ID not found in context")
+		}
+
+		// Builds of other users look the same as missing ones
+		build, err := buildsService.GetBuildByIDAndOwner(ctx, input.ID, userID)
+		if err != nil {
+			if errors.Is(err, builds.ErrBuildNotFound) {
+				return nil, huma.Error404NotFound("Build not found")
+			}
+			return nil, huma.Error500InternalServerError("Failed to get build", err)
+		}
+
+		artifact, contents, err := artifactsService.Open(ctx, build.ID, input.Name)
+		if err != nil {
+			if errors.Is(err, artifacts.ErrArtifactNotFound) {
+				return nil, huma.Error404NotFound("Artifact not found")
+			}
+			return nil, huma.Error500InternalServerError("Failed to get artifact", err)
+		}
+
+		return &huma.StreamResponse{
+			Body: func(hctx huma.Context) {
+				defer contents.Close()
+				hctx.SetHeader("Content-Type", "application/octet-stream")
+				hctx.SetHeader("Content-Disposition", fmt.Sprintf("attachment; filename=%q", artifact.Name))
+				if _, err := io.Copy(hctx.BodyWriter(), contents); err != nil {
+					fmt.Printf("Warning: Failed to send artifact %s of build %d: %v\n", artifact.Name, build.ID, err)
+				}
+			},
+		}, nil
 	})
 
-	huma.Get(api, "/me", func(ctx context.Context, input *MeRequest) (*MeResponse, error) {
+	huma.Get(api, "/builds/{id}/provenance", func(ctx context.Context, input *GetBuildProvenanceRequest) (*GetBuildProvenanceResponse, error) {
 		// Validate authentication using middleware
 		var err error
 		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
 		if err != nil {
-			fmt.Println("Error validating auth:", err)
 			return nil, err
 		}
 
@@ -211,21 +5362,35 @@ func NewRouter(queries *db.Queries) *fiber.App {
 			return nil, huma.Error401Unauthorized("User ID not found in context")
 		}
 
-		// Get user from database
-		user, err := authService.GetUserByID(ctx, userID)
+		// Builds of other users look the same as missing ones
+		build, err := buildsService.GetBuildByIDAndOwner(ctx, input.ID, userID)
 		if err != nil {
-			if err.Error() == "user not found" {
-				return nil, huma.Error404NotFound("User not found")
+			if errors.Is(err, builds.ErrBuildNotFound) {
+				return nil, huma.Error404NotFound("Build not found")
 			}
-			return nil, huma.Error500InternalServerError("Internal server error", err)
+			return nil, huma.Error500InternalServerError("Failed to get build", err)
 		}
 
-		resp := &MeResponse{}
-		resp.Body = *user
+		provenanceList, err := buildsService.GetProvenanceByBuildID(ctx, build.ID)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to get provenance", err)
+		}
+
+		resp := &GetBuildProvenanceResponse{}
+		resp.Body.Provenance = make([]ProvenanceResponse, len(provenanceList))
+		for i, provenance := range provenanceList {
+			resp.Body.Provenance[i] = ProvenanceResponse{
+				Image:         provenance.Image,
+				Digest:        provenance.Digest,
+				PredicateType: provenance.PredicateType,
+				Statement:     provenance.Statement,
+				CreatedAt:     provenance.CreatedAt,
+			}
+		}
 		return resp, nil
 	})
 
-	huma.Post(api, "/credentials", func(ctx context.Context, input *StoreCredentialRequest) (*StoreCredentialResponse, error) {
+	huma.Get(api, "/builds/{id}/pipeline", func(ctx context.Context, input *GetBuildPipelineRequest) (*GetBuildPipelineResponse, error) {
 		// Validate authentication using middleware
 		var err error
 		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
@@ -239,35 +5404,54 @@ func NewRouter(queries *db.Queries) *fiber.App {
 			return nil, huma.Error401Unauthorized("User ID not found in context")
 		}
 
-		// Validate input
-		if input.Body.Provider == "" {
-			return nil, huma.Error400BadRequest("provider is required")
-		}
-		if input.Body.TokenType == "" {
-			return nil, huma.Error400BadRequest("token_type is required")
+		// Builds of other users look the same as missing ones
+		build, err := buildsService.GetBuildByIDAndOwner(ctx, input.ID, userID)
+		if err != nil {
+			if errors.Is(err, builds.ErrBuildNotFound) {
+				return nil, huma.Error404NotFound("Build not found")
+			}
+			return nil, huma.Error500InternalServerError("Failed to get build", err)
 		}
-		if input.Body.Token == "" {
-			return nil, huma.Error400BadRequest("token is required")
+
+		// Builds started before pipelines were recorded have none
+		if build.PipelineID == nil {
+			return nil, huma.Error404NotFound("Build has no recorded pipeline")
 		}
 
-		// Store credential
-		result, err := credentialsService.StoreCredential(ctx, credentials.StoreCredentialParams{
-			OwnerID:   userID,
-			Provider:  input.Body.Provider,
-			TokenType: input.Body.TokenType,
-			Token:     input.Body.Token,
-			ExpiresAt: input.Body.ExpiresAt,
-		})
+		pipeline, err := buildsService.GetPipelineByID(ctx, *build.PipelineID)
 		if err != nil {
-			return nil, huma.Error500InternalServerError("Failed to store credential", err)
+			if errors.Is(err, builds.ErrPipelineNotFound) {
+				return nil, huma.Error404NotFound("Build has no recorded pipeline")
+			}
+			return nil, huma.Error500InternalServerError("Failed to get pipeline", err)
 		}
 
-		resp := &StoreCredentialResponse{}
-		resp.Body.CredentialID = result.CredentialID
+		resp := &GetBuildPipelineResponse{}
+		resp.Body.ID = pipeline.ID
+		resp.Body.ConfigID = pipeline.ConfigID
+		resp.Body.Ref = pipeline.Ref
+		resp.Body.CommitSHA = pipeline.CommitSHA
+		resp.Body.Status = pipeline.Status
+		resp.Body.CreatedAt = pipeline.CreatedAt
+		resp.Body.FinishedAt = pipeline.FinishedAt
+		resp.Body.Stages = make([]PipelineStageResponse, len(pipeline.Stages))
+		for i, stage := range pipeline.Stages {
+			resp.Body.Stages[i] = PipelineStageResponse{
+				Name:         stage.Name,
+				Kind:         stage.Kind,
+				Needs:        stage.Needs,
+				Status:       stage.Status,
+				Error:        stage.Error,
+				BuildID:      stage.BuildID,
+				DeploymentID: stage.DeploymentID,
+				StartedAt:    stage.StartedAt,
+				FinishedAt:   stage.FinishedAt,
+			}
+		}
 		return resp, nil
 	})
 
-	huma.Get(api, "/providers", func(ctx context.Context, input *GetProvidersRequest) (*GetProvidersResponse, error) {
+	huma.Get(api, "/builds/{id}/config", func(ctx context.Context, input *GetBuildConfigRequest) (*GetBuildConfigResponse, error) {
 		// Validate authentication using middleware
 		var err error
 		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
@@ -281,18 +5465,41 @@ func NewRouter(queries *db.Queries) *fiber.App {
 			return nil, huma.Error401Unauthorized("User ID not found in context")
 		}
 
-		// Get unique providers from credentials
-		providers, err := queries.GetUniqueProvidersByOwnerID(ctx, userID)
+		// Builds of other users look the same as missing ones
+		build, err := buildsService.GetBuildByIDAndOwner(ctx, input.ID, userID)
 		if err != nil {
-			return nil, huma.Error500InternalServerError("Failed to get providers", err)
+			if errors.Is(err, builds.ErrBuildNotFound) {
+				return nil, huma.Error404NotFound("Build not found")
+			}
+			return nil, huma.Error500InternalServerError("Failed to get build", err)
 		}
 
-		resp := &GetProvidersResponse{}
-		resp.Body.Providers = providers
+		// Builds started before pipelines or their config were recorded have none
+		if build.PipelineID == nil {
+			return nil, huma.Error404NotFound("Build has no recorded config")
+		}
+
+		pipeline, err := buildsService.GetPipelineByID(ctx, *build.PipelineID)
+		if err != nil {
+			if errors.Is(err, builds.ErrPipelineNotFound) {
+				return nil, huma.Error404NotFound("Build has no recorded config")
+			}
+			return nil, huma.Error500InternalServerError("Failed to get pipeline", err)
+		}
+		if pipeline.RenderedConfig == "" {
+			return nil, huma.Error404NotFound("Build has no recorded config")
+		}
+
+		resp := &GetBuildConfigResponse{}
+		resp.Body.BuildID = build.ID
+		resp.Body.PipelineID = pipeline.ID
+		resp.Body.Ref = pipeline.Ref
+		resp.Body.CommitSHA = pipeline.CommitSHA
+		resp.Body.Config = pipeline.RenderedConfig
 		return resp, nil
 	})
 
-	huma.Post(api, "/configs", func(ctx context.Context, input *CreateConfigRequest) (*CreateConfigResponse, error) {
+	huma.Get(api, "/builds/{id}/logs", func(ctx context.Context, input *GetBuildLogsRequest) (*GetBuildLogsResponse, error) {
 		// Validate authentication using middleware
 		var err error
 		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
@@ -306,204 +5513,450 @@ func NewRouter(queries *db.Queries) *fiber.App {
 			return nil, huma.Error401Unauthorized("User ID not found in context")
 		}
 
-		// Validate input
-		if input.Body.Provider == "" {
-			return nil, huma.Error400BadRequest("provider is required")
+		// Builds of other users look the same as missing ones
+		build, err := buildsService.GetBuildByIDAndOwner(ctx, input.ID, userID)
+		if err != nil {
+			if errors.Is(err, builds.ErrBuildNotFound) {
+				return nil, huma.Error404NotFound("Build not found")
+			}
+			return nil, huma.Error500InternalServerError("Failed to get build", err)
 		}
-		if input.Body.RepoOwner == "" {
-			return nil, huma.Error400BadRequest("repo_owner is required")
+
+		resp := &GetBuildLogsResponse{}
+
+		// Running builds return what they logged so far
+		if subscription, ok := buildsService.SubscribeLog(build.ID); ok {
+			subscription.Close()
+			resp.Body.Entries = toBuildLogEntries(subscription.Backlog)
+			resp.Body.Truncated = subscription.Truncated
+			resp.Body.Running = true
+			return resp, nil
 		}
-		if input.Body.RepoName == "" {
-			return nil, huma.Error400BadRequest("repo_name is required")
+
+		buildLog, err := buildsService.GetBuildLog(ctx, build.ID)
+		if err != nil {
+			if errors.Is(err, builds.ErrLogNotFound) {
+				return nil, huma.Error404NotFound("Build has no stored log")
+			}
+			return nil, huma.Error500InternalServerError("Failed to get build log", err)
 		}
-		if input.Body.RepoFullName == "" {
-			return nil, huma.Error400BadRequest("repo_full_name is required")
+
+		resp.Body.Entries = toBuildLogEntries(buildLog.Entries)
+		resp.Body.Truncated = buildLog.Truncated
+		return resp, nil
+	})
+
+	huma.Post(api, "/webhooks/github/{id}", func(ctx context.Context, input *GitHubWebhookRequest) (_ *struct{}, handlerErr error) {
+		// Get config by ID
+		config, err := configsService.GetConfigByWebhookID(ctx, input.HookId)
+		if err != nil {
+			fmt.Println("Error getting config by webhook ID:", err)
+			return nil, huma.Error404NotFound("Config not found")
 		}
-		if input.Body.RepoCloneURL == "" {
-			return nil, huma.Error400BadRequest("repo_clone_url is required")
+
+		err = ghub.ValidateSignature(input.SignatureHeader, input.RawBody, []byte(config.WebhookSecret))
+		if err != nil {
+			fmt.Println("Error validating webhook signature:", err)
+			return nil, huma.Error400BadRequest("Invalid webhook signature")
 		}
-		if input.Body.DockerfilePath == "" {
-			return nil, huma.Error400BadRequest("dockerfile_path is required")
+
+		event, err := ghub.ParseWebHook(input.EventType, input.RawBody)
+		if err != nil {
+			return nil, huma.Error400BadRequest("Invalid webhook payload")
 		}
-		if input.Body.WebhookSecret == "" {
-			return nil, huma.Error400BadRequest("webhook_secret is required")
+
+		// Replayed and flooding deliveries never reach the pipeline
+		if err := webhookGuard.Check(config.ID, input.DeliveryID, webhooks.GitHubEventTime(event)); err != nil {
+			return nil, webhookGuardError(err)
 		}
+		// Deliveries that failed can be redelivered from GitHub
+		defer func() {
+			if handlerErr != nil {
+				webhookGuard.Forget(input.DeliveryID)
+			}
+		}()
 
-		// Create config
-		result, err := configsService.CreateConfig(ctx, configs.CreateConfigParams{
-			OwnerID:        userID,
-			Provider:       input.Body.Provider,
-			RepoOwner:      input.Body.RepoOwner,
-			RepoName:       input.Body.RepoName,
-			RepoFullName:   input.Body.RepoFullName,
-			RepoCloneURL:   input.Body.RepoCloneURL,
-			DockerfilePath: input.Body.DockerfilePath,
-			WebhookSecret:  input.Body.WebhookSecret,
-		})
+		// Pushes to configs of disabled accounts are not built
+		if _, err := authService.Authorize(ctx, config.OwnerID); err != nil {
+			if errors.Is(err, auth.ErrAccountDisabled) {
+				return nil, huma.Error403Forbidden("Config owner is disabled")
+			}
+			return nil, huma.Error500InternalServerError("Failed to check config owner", err)
+		}
+
+		switch event := event.(type) {
+		case *ghub.PingEvent:
+			fmt.Printf("Ping event received: %s\n", *event.Zen)
+			return &struct{}{}, nil
+		case *ghub.RegistryPackageEvent:
+			// Images pushed to GHCR outside Nimbul redeploy the configs referencing them
+			push, ok := registry.PushFromPackageEvent(event)
+			if !ok {
+				return &struct{}{}, nil
+			}
+			payload, err := json.Marshal(push)
+			if err != nil {
+				return nil, huma.Error500InternalServerError("Failed to encode registry package event", err)
+			}
+			if _, err := jobsService.Submit(ctx, jobs.Job{Kind: jobs.KindImagePush, ConfigID: config.ID, Payload: payload}); err != nil {
+				if errors.Is(err, webhooks.ErrImageNotReferenced) || errors.Is(err, webhooks.ErrImageBuiltByNimbul) {
+					return &struct{}{}, nil
+				}
+				fmt.Printf("Error handling registry package event: %v\n", err)
+				return nil, huma.Error500InternalServerError("Failed to process registry package event", err)
+			}
+			return &struct{}{}, nil
+		case *ghub.RepositoryEvent:
+			if err := webhooksService.HandleRepositoryEvent(ctx, config, event); err != nil {
+				if errors.Is(err, configs.ErrConfigExists) {
+					return nil, huma.Error409Conflict(err.Error())
+				}
+				fmt.Printf("Error handling repository event: %v\n", err)
+				return nil, huma.Error500InternalServerError("Failed to process repository event", err)
+			}
+			return &struct{}{}, nil
+		case *ghub.PushEvent:
+			// Handle push event
+			if _, err := jobsService.Submit(ctx, jobs.Job{Kind: jobs.KindGitHubPush, ConfigID: config.ID, Payload: input.RawBody}); err != nil {
+				fmt.Printf("Error handling push event: %v\n", err)
+				// Determine error type and return appropriate HTTP status
+				if strings.Contains(err.Error(), "repository mismatch") || strings.Contains(err.Error(), "Dockerfile not found") {
+					return nil, huma.Error400BadRequest(err.Error())
+				}
+				if errors.Is(err, usage.ErrQuotaExceeded) {
+					return nil, huma.Error403Forbidden(err.Error())
+				}
+				return nil, huma.Error500InternalServerError("Failed to process push event", err)
+			}
+			return &struct{}{}, nil
+		}
+
+		return &struct{}{}, nil
+	}, limitWebhookBody(webhookGuard))
+
+	huma.Post(api, "/webhooks/github-app", func(ctx context.Context, input *GitHubAppWebhookRequest) (_ *struct{}, handlerErr error) {
+		// The app's webhook is set in its settings on GitHub, signed with its own secret
+		appConfig, err := github.LoadAppConfig(ctx)
 		if err != nil {
-			return nil, huma.Error500InternalServerError("Failed to create config", err)
+			return nil, huma.Error500InternalServerError("Failed to get GitHub App", err)
+		}
+		if appConfig.WebhookSecret == "" {
+			return nil, huma.Error404NotFound("GitHub App webhook is not configured")
+		}
+
+		err = ghub.ValidateSignature(input.SignatureHeader, input.RawBody, []byte(appConfig.WebhookSecret))
+		if err != nil {
+			fmt.Println("Error validating GitHub App webhook signature:", err)
+			return nil, huma.Error400BadRequest("Invalid webhook signature")
+		}
+
+		event, err := ghub.ParseWebHook(input.EventType, input.RawBody)
+		if err != nil {
+			return nil, huma.Error400BadRequest("Invalid webhook payload")
+		}
+
+		// Replayed and flooding deliveries are rejected like those of config webhooks
+		if err := webhookGuard.Check("github-app", input.DeliveryID, webhooks.GitHubEventTime(event)); err != nil {
+			return nil, webhookGuardError(err)
+		}
+		// Deliveries that failed can be redelivered from GitHub
+		defer func() {
+			if handlerErr != nil {
+				webhookGuard.Forget(input.DeliveryID)
+			}
+		}()
+
+		switch event := event.(type) {
+		case *ghub.InstallationEvent:
+			err = installationsService.HandleInstallationEvent(ctx, event)
+		case *ghub.InstallationRepositoriesEvent:
+			err = installationsService.HandleInstallationRepositoriesEvent(ctx, event)
+		}
+		if err != nil {
+			fmt.Printf("Error handling installation event: %v\n", err)
+			return nil, huma.Error500InternalServerError("Failed to process installation event", err)
+		}
+
+		return &struct{}{}, nil
+	}, limitWebhookBody(webhookGuard))
+
+	huma.Post(api, "/webhooks/registry/{id}", func(ctx context.Context, input *RegistryWebhookRequest) (*RegistryWebhookResponse, error) {
+		config, err := configsService.GetConfigByID(ctx, input.ID)
+		if err != nil {
+			return nil, huma.Error404NotFound("Config not found")
+		}
+
+		// Docker Hub can only pass the token in the URL; Harbor sends it as the auth header
+		token := input.Token
+		if token == "" {
+			token = strings.TrimPrefix(input.Authorization, "Bearer ")
+		}
+		if config.RegistryWebhookToken == nil || subtle.ConstantTimeCompare([]byte(token), []byte(*config.RegistryWebhookToken)) != 1 {
+			return nil, huma.Error401Unauthorized("Invalid registry webhook token")
+		}
+
+		// Pushes to configs of disabled accounts are not deployed
+		if _, err := authService.Authorize(ctx, config.OwnerID); err != nil {
+			if errors.Is(err, auth.ErrAccountDisabled) {
+				return nil, huma.Error403Forbidden("Config owner is disabled")
+			}
+			return nil, huma.Error500InternalServerError("Failed to check config owner", err)
+		}
+
+		pushes, err := registry.ParseEvent(input.RawBody)
+		if err != nil && !errors.Is(err, registry.ErrUnsupportedEvent) {
+			return nil, huma.Error400BadRequest(err.Error())
+		}
+
+		// Registries send no delivery ID, so only the age of the pushes and the rate
+		// limit protect against replays
+		var sentAt time.Time
+		for _, push := range pushes {
+			if sentAt.IsZero() || push.PushedAt.Before(sentAt) {
+				sentAt = push.PushedAt
+			}
+		}
+		if err := webhookGuard.Check(config.ID, "", sentAt); err != nil {
+			return nil, webhookGuardError(err)
+		}
+
+		resp := &RegistryWebhookResponse{}
+		resp.Body.Deployed = []string{}
+		resp.Body.Queued = []string{}
+		resp.Body.Ignored = []string{}
+		for _, push := range pushes {
+			payload, err := json.Marshal(push)
+			if err != nil {
+				return nil, huma.Error500InternalServerError("Failed to encode registry event", err)
+			}
+			queued, err := jobsService.Submit(ctx, jobs.Job{Kind: jobs.KindImagePush, ConfigID: config.ID, Payload: payload})
+			switch {
+			case err == nil && queued:
+				resp.Body.Queued = append(resp.Body.Queued, push.Reference())
+			case err == nil:
+				resp.Body.Deployed = append(resp.Body.Deployed, push.Reference())
+			case errors.Is(err, webhooks.ErrImageNotReferenced), errors.Is(err, webhooks.ErrImageBuiltByNimbul):
+				resp.Body.Ignored = append(resp.Body.Ignored, fmt.Sprintf("%s: %v", push.Reference(), err))
+			default:
+				fmt.Printf("Error handling registry push of %s: %v\n", push.Reference(), err)
+				return nil, huma.Error500InternalServerError("Failed to process registry event", err)
+			}
 		}
 
-		resp := &CreateConfigResponse{}
-		resp.Body.ConfigID = result.ConfigID
 		return resp, nil
-	})
+	}, limitWebhookBody(webhookGuard))
+
+	// Port-forwarding streams raw TCP over a hijacked connection, so it is registered
+	// on fiber directly and is not part of the OpenAPI spec
+	app.Get("/configs/:id/port-forward", func(c *fiber.Ctx) error {
+		ctx := c.UserContext()
 
-	huma.Get(api, "/credentials/github/token", func(ctx context.Context, input *GetGitHubTokenRequest) (*GetGitHubTokenResponse, error) {
-		// Validate authentication using middleware
 		var err error
-		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		ctx, err = ValidateAuth(ctx, c.Get(fiber.HeaderAuthorization), authService)
+		if err != nil {
+			return writeProblem(c, err)
+		}
+
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return writeProblem(c, huma.Error401Unauthorized("User ID not found in context"))
+		}
+
+		if !strings.EqualFold(c.Get(fiber.HeaderUpgrade), portForwardProtocol) {
+			return writeProblem(c, huma.Error400BadRequest(fmt.Sprintf("Upgrade: %s header is required", portForwardProtocol)))
+		}
+
+		service := c.Query("service")
+		if service == "" {
+			return writeProblem(c, huma.Error400BadRequest("service is required"))
+		}
+
+		port, err := strconv.Atoi(c.Query("port"))
+		if err != nil || port <= 0 || port > 65535 {
+			return writeProblem(c, huma.Error400BadRequest("port must be a valid port number"))
+		}
+
+		// Configs of other users look the same as deleted ones
+		config, err := configsService.GetConfigByIDAndOwner(ctx, c.Params("id"), userID)
+		if err != nil {
+			return writeProblem(c, mapConfigError(err))
+		}
+
+		if config.AgentID != nil {
+			return writeProblem(c, huma.Error400BadRequest("Port-forwarding is not available for configs deployed through an agent"))
+		}
+
+		clusterConfig, err := webhooksService.ClusterConfig(ctx, config)
 		if err != nil {
-			return nil, err
+			if errors.Is(err, webhooks.ErrNoClusterCredential) {
+				return writeProblem(c, huma.Error400BadRequest("The config has no cluster credential to reach its cluster with"))
+			}
+			return writeProblem(c, huma.Error500InternalServerError("Failed to resolve cluster configuration", err))
 		}
 
-		// Get user ID from context
-		userID := GetUserID(ctx)
-		if userID == "" {
-			return nil, huma.Error401Unauthorized("User ID not found in context")
+		// Only services the config's own deployments created can be forwarded to
+		namespace, err := deployedServiceNamespace(ctx, deploymentsService, config.ID, service, c.Query("namespace"))
+		if err != nil {
+			return writeProblem(c, err)
 		}
 
-		// Get decrypted GitHub access token
-		token, err := credentialsService.GetDecryptedToken(ctx, userID, "github", "oauth_access")
+		pod, podPort, err := k8s.ResolveServicePort(ctx, clusterConfig, namespace, service, port)
 		if err != nil {
-			fmt.Println("Error getting GitHub access token:", err)
-			// Check if token is expired
-			if errors.Is(err, credentials.ErrTokenExpired) {
-				// Get refresh token
-				refreshToken, refreshErr := credentialsService.GetDecryptedToken(ctx, userID, "github", "oauth_refresh")
-				if refreshErr != nil {
-					if errors.Is(refreshErr, credentials.ErrRefreshTokenExpired) || errors.Is(refreshErr, credentials.ErrTokenExpired) {
-						return nil, huma.Error401Unauthorized("GitHub tokens expired. Please reconnect your GitHub account")
-					}
-					return nil, huma.Error404NotFound("GitHub refresh token not found")
-				}
+			return writeProblem(c, huma.Error404NotFound(err.Error()))
+		}
 
-				// Refresh the tokens
-				refreshResult, refreshErr := credentialsService.RefreshGitHubToken(ctx, refreshToken)
-				if refreshErr != nil {
-					if errors.Is(refreshErr, credentials.ErrRefreshTokenExpired) {
-						return nil, huma.Error401Unauthorized("GitHub refresh token expired. Please reconnect your GitHub account")
-					}
-					return nil, huma.Error500InternalServerError("Failed to refresh GitHub token", refreshErr)
-				}
+		stream, err := k8s.DialPodPort(clusterConfig, namespace, pod, podPort)
+		if err != nil {
+			return writeProblem(c, huma.Error502BadGateway("Failed to open port-forward", err))
+		}
 
-				// Calculate expiry times
-				accessExpiry := time.Now().Add(time.Duration(refreshResult.ExpiresIn) * time.Second)
-				refreshExpiry := time.Now().Add(6 * 30 * 24 * time.Hour) // 6 months (180 days)
+		c.Set(fiber.HeaderConnection, "Upgrade")
+		c.Set(fiber.HeaderUpgrade, portForwardProtocol)
+		c.Status(fiber.StatusSwitchingProtocols)
 
-				// Update access token
-				updateErr := credentialsService.UpdateCredential(ctx, credentials.UpdateCredentialParams{
-					OwnerID:   userID,
-					Provider:  "github",
-					TokenType: "oauth_access",
-					Token:     refreshResult.AccessToken,
-					ExpiresAt: accessExpiry,
-				})
-				if updateErr != nil {
-					return nil, huma.Error500InternalServerError("Failed to update access token", updateErr)
-				}
+		c.Context().Hijack(func(conn net.Conn) {
+			defer conn.Close()
+			defer stream.Close()
 
-				// Update refresh token if a new one was provided
-				if refreshResult.RefreshToken != "" {
-					updateErr = credentialsService.UpdateCredential(ctx, credentials.UpdateCredentialParams{
-						OwnerID:   userID,
-						Provider:  "github",
-						TokenType: "oauth_refresh",
-						Token:     refreshResult.RefreshToken,
-						ExpiresAt: refreshExpiry,
-					})
-					if updateErr != nil {
-						return nil, huma.Error500InternalServerError("Failed to update refresh token", updateErr)
-					}
+			go func() {
+				if err := stream.Err(); err != nil {
+					fmt.Printf("Port-forward to %s/%s:%d failed: %v\n", namespace, pod, podPort, err)
+					stream.Close()
 				}
+			}()
 
-				// Return the new access token
-				resp := &GetGitHubTokenResponse{}
-				resp.Body.Token = refreshResult.AccessToken
-				return resp, nil
-			}
-			return nil, huma.Error404NotFound("GitHub access token not found")
-		}
+			done := make(chan struct{}, 2)
+			go func() {
+				io.Copy(stream, conn)
+				done <- struct{}{}
+			}()
+			go func() {
+				io.Copy(conn, stream)
+				done <- struct{}{}
+			}()
+			<-done
+		})
 
-		resp := &GetGitHubTokenResponse{}
-		resp.Body.Token = token
-		return resp, nil
+		return nil
 	})
 
-	huma.Patch(api, "/configs/{id}/webhook", func(ctx context.Context, input *UpdateConfigWebhookRequest) (*UpdateConfigWebhookResponse, error) {
-		// Validate authentication using middleware
+	// Deployment progress is streamed as server-sent events, which need the response
+	// flushed per event, so it is registered on fiber directly like port-forwarding
+	app.Get("/deployments/:id/events", func(c *fiber.Ctx) error {
+		ctx := c.UserContext()
+
 		var err error
-		ctx, err = ValidateAuth(ctx, input.AuthResolver.Authorization, authService)
+		ctx, err = ValidateAuth(ctx, c.Get(fiber.HeaderAuthorization), authService)
 		if err != nil {
-			return nil, err
+			return writeProblem(c, err)
 		}
 
-		// Get user ID from context
 		userID := GetUserID(ctx)
 		if userID == "" {
-			return nil, huma.Error401Unauthorized("User ID not found in context")
+			return writeProblem(c, huma.Error401Unauthorized("User ID not found in context"))
 		}
 
-		// Verify config belongs to user
-		config, err := configsService.GetConfigByID(ctx, input.ID)
+		deploymentID, err := strconv.ParseInt(c.Params("id"), 10, 64)
 		if err != nil {
-			return nil, huma.Error404NotFound("Config not found")
+			return writeProblem(c, huma.Error400BadRequest("Invalid deployment ID"))
 		}
 
-		if config.OwnerID != userID {
-			return nil, huma.Error403Forbidden("You don't have permission to update this config")
+		deployment, err := deploymentsService.GetDeploymentByIDAndOwner(ctx, deploymentID, userID)
+		if err != nil {
+			if errors.Is(err, deployments.ErrDeploymentNotFound) {
+				return writeProblem(c, huma.Error404NotFound("Deployment not found"))
+			}
+			return writeProblem(c, huma.Error500InternalServerError("Failed to get deployment", err))
 		}
 
-		// Update webhook ID
-		err = configsService.UpdateWebhookID(ctx, input.ID, input.Body.WebhookID)
+		config, err := configsService.GetConfigByIDAndOwner(ctx, deployment.ConfigID, userID)
 		if err != nil {
-			return nil, huma.Error500InternalServerError("Failed to update webhook ID", err)
+			return writeProblem(c, huma.Error500InternalServerError("Failed to get config", err))
 		}
 
-		resp := &UpdateConfigWebhookResponse{}
-		resp.Body.Success = true
-		return resp, nil
+		clusterConfig, err := webhooksService.ClusterConfig(ctx, config)
+		if err != nil {
+			if errors.Is(err, webhooks.ErrNoClusterCredential) {
+				return writeProblem(c, huma.Error400BadRequest("The config has no cluster credential to reach its cluster with"))
+			}
+			return writeProblem(c, huma.Error500InternalServerError("Failed to resolve cluster configuration", err))
+		}
+
+		// Subscribe before streaming so no event is missed in between
+		events, unsubscribe := deploymentsService.SubscribeProgress(deployment.ID)
+
+		c.Set(fiber.HeaderContentType, "text/event-stream")
+		c.Set(fiber.HeaderCacheControl, "no-cache")
+		c.Set("X-Accel-Buffering", "no")
+
+		c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+			defer unsubscribe()
+			streamDeploymentProgress(context.Background(), w, deploymentsService, clusterConfig, deployment, events)
+		})
+
+		return nil
 	})
 
-	huma.Post(api, "/webhooks/github/{id}", func(ctx context.Context, input *GitHubWebhookRequest) (*struct{}, error) {
-		// Get config by ID
-		config, err := configsService.GetConfigByWebhookID(ctx, input.HookId)
+	// Build logs are streamed as server-sent events like deployment progress
+	app.Get("/builds/:id/logs/events", func(c *fiber.Ctx) error {
+		ctx := c.UserContext()
+
+		var err error
+		ctx, err = ValidateAuth(ctx, c.Get(fiber.HeaderAuthorization), authService)
 		if err != nil {
-			fmt.Println("Error getting config by webhook ID:", err)
-			return nil, huma.Error404NotFound("Config not found")
+			return writeProblem(c, err)
+		}
+
+		userID := GetUserID(ctx)
+		if userID == "" {
+			return writeProblem(c, huma.Error401Unauthorized("User ID not found in context"))
 		}
 
-		err = github.ValidateSignature(input.SignatureHeader, input.RawBody, []byte(config.WebhookSecret))
+		buildID, err := strconv.ParseInt(c.Params("id"), 10, 64)
 		if err != nil {
-			fmt.Println("Error validating webhook signature:", err)
-			return nil, huma.Error400BadRequest("Invalid webhook signature")
+			return writeProblem(c, huma.Error400BadRequest("Invalid build ID"))
 		}
 
-		event, err := github.ParseWebHook(input.EventType, input.RawBody)
+		build, err := buildsService.GetBuildByIDAndOwner(ctx, buildID, userID)
 		if err != nil {
-			return nil, huma.Error400BadRequest("Invalid webhook payload")
+			if errors.Is(err, builds.ErrBuildNotFound) {
+				return writeProblem(c, huma.Error404NotFound("Build not found"))
+			}
+			return writeProblem(c, huma.Error500InternalServerError("Failed to get build", err))
 		}
 
-		switch event := event.(type) {
-		case *github.PingEvent:
-			fmt.Printf("Ping event received: %s\n", *event.Zen)
-			return &struct{}{}, nil
-		case *github.PushEvent:
-			// Handle push event
-			if err := webhooksService.HandlePushEvent(ctx, config, event); err != nil {
-				fmt.Printf("Error handling push event: %v\n", err)
-				// Determine error type and return appropriate HTTP status
-				if strings.Contains(err.Error(), "repository mismatch") || strings.Contains(err.Error(), "Dockerfile not found") {
-					return nil, huma.Error400BadRequest(err.Error())
+		// Follow the build while it runs, or replay its stored log once it finished
+		subscription, running := buildsService.SubscribeLog(build.ID)
+		var storedLog *builds.Log
+		if !running {
+			storedLog, err = buildsService.GetBuildLog(ctx, build.ID)
+			if err != nil {
+				if errors.Is(err, builds.ErrLogNotFound) {
+					return writeProblem(c, huma.Error404NotFound("Build has no stored log"))
 				}
-				return nil, huma.Error500InternalServerError("Failed to process push event", err)
+				return writeProblem(c, huma.Error500InternalServerError("Failed to get build log", err))
 			}
-			return &struct{}{}, nil
 		}
 
-		return &struct{}{}, nil
+		c.Set(fiber.HeaderContentType, "text/event-stream")
+		c.Set(fiber.HeaderCacheControl, "no-cache")
+		c.Set("X-Accel-Buffering", "no")
+
+		c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+			if subscription != nil {
+				defer subscription.Close()
+			}
+			streamBuildLog(context.Background(), w, buildsService, build.ID, subscription, storedLog)
+		})
+
+		return nil
 	})
 
+	// Web dashboard, served from the binary so it needs no separate deployment
+	dashboard.Register(app)
+
+	// The SDK is generated from openapi.yaml, written at build time without starting
+	// the server; running servers serve the same document at /openapi.yaml
 	generateOpenApi := os.Getenv("GENERATE_OPENAPI_SPEC")
 	if generateOpenApi == "true" {
 		spec, err := api.OpenAPI().DowngradeYAML()
@@ -516,17 +5969,589 @@ func NewRouter(queries *db.Queries) *fiber.App {
 	return app
 }
 
+// limitWebhookBody caps the payload of a webhook operation at the guard's limit
+func limitWebhookBody(guard *webhooks.Guard) func(o *huma.Operation) {
+	return func(o *huma.Operation) {
+		o.MaxBodyBytes = int64(guard.MaxBodyBytes)
+	}
+}
+
+// webhookGuardError turns a delivery rejected by the webhook guard into its response
+func webhookGuardError(err error) error {
+	switch {
+	case errors.Is(err, webhooks.ErrDeliveryRateLimited):
+		return huma.Error429TooManyRequests(err.Error())
+	case errors.Is(err, webhooks.ErrDuplicateDelivery):
+		return huma.Error409Conflict(err.Error())
+	}
+	return huma.Error400BadRequest(err.Error())
+}
+
+// portForwardProtocol is the Upgrade protocol clients request for port-forwarding
+const portForwardProtocol = "nimbul-port-forward"
+
+// deployedServiceNamespace finds the namespace a service was deployed to by a deployment
+// live in one of the config's environments or by its latest deployment. A namespace the
+// caller asked for must be one of those; otherwise the first one found is used.
+func deployedServiceNamespace(ctx context.Context, deploymentsService *deployments.Service, configID, service, namespace string) (string, error) {
+	environments, err := deploymentsService.ListEnvironments(ctx, configID)
+	if err != nil {
+		return "", huma.Error500InternalServerError("Failed to get environments", err)
+	}
+	latest, err := deploymentsService.GetDeploymentsByConfigID(ctx, configID, 1)
+	if err != nil {
+		return "", huma.Error500InternalServerError("Failed to get deployments", err)
+	}
+
+	deployed := make([]deployments.Deployment, 0, len(environments)+len(latest))
+	for _, environment := range environments {
+		deployed = append(deployed, environment.Deployment)
+	}
+	deployed = append(deployed, latest...)
+
+	for _, deployment := range deployed {
+		for _, resource := range deployment.Resources {
+			if resource.Kind != "Service" || resource.Name != service {
+				continue
+			}
+			if namespace == "" || resource.Namespace == namespace {
+				return resource.Namespace, nil
+			}
+		}
+	}
+
+	if namespace != "" {
+		return "", huma.Error404NotFound(fmt.Sprintf("Service %s was not deployed to %s by this config", service, namespace))
+	}
+	return "", huma.Error404NotFound(fmt.Sprintf("Service %s was not deployed by this config", service))
+}
+
+// Deployment progress streams poll the deployment and its resources at this interval,
+// and end after progressTimeout even if the rollout never becomes ready
+const (
+	progressPollInterval = 2 * time.Second
+	progressTimeout      = 15 * time.Minute
+)
+
+// streamDeploymentProgress writes the progress of a deployment as server-sent events:
+// its status, the resources it applies and, once applied, the live status of those
+// resources until every one is ready. The stream ends with a done event.
+func streamDeploymentProgress(ctx context.Context, w *bufio.Writer, deploymentsService *deployments.Service, clusterConfig *rest.Config, deployment *deployments.Deployment, events <-chan deployments.ProgressEvent) {
+	send := func(event deployments.ProgressEvent) bool {
+		if event.Time.IsZero() {
+			event.Time = time.Now()
+		}
+		data, err := json.Marshal(event)
+		if err != nil {
+			return false
+		}
+		fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, data)
+		// Flushing fails once the client has gone away
+		return w.Flush() == nil
+	}
+
+	// Start with where the deployment stands, in case it finished before the client connected
+	if !send(deployments.ProgressEvent{Type: deployments.ProgressDeployment, Status: deployment.Status, Error: deployment.Error}) {
+		return
+	}
+
+	ticker := time.NewTicker(progressPollInterval)
+	defer ticker.Stop()
+	timeout := time.After(progressTimeout)
+
+	var lastResources []byte
+	for {
+		select {
+		case event := <-events:
+			if !send(event) {
+				return
+			}
+		case <-timeout:
+			send(deployments.ProgressEvent{Type: deployments.ProgressDone, Status: deployment.Status, Error: "timed out waiting for the rollout"})
+			return
+		case <-ticker.C:
+			current, err := deploymentsService.GetDeploymentByID(ctx, deployment.ID)
+			if err != nil {
+				send(deployments.ProgressEvent{Type: deployments.ProgressDone, Status: deployment.Status, Error: err.Error()})
+				return
+			}
+			deployment = current
+
+			switch deployment.Status {
+			case deployments.StatusInProgress:
+				continue
+			case deployments.StatusFailed:
+				send(deployments.ProgressEvent{Type: deployments.ProgressDone, Status: deployment.Status, Error: deployment.Error})
+				return
+			}
+
+			// Applied: follow the rollout until every resource is ready
+			statuses, err := k8s.GetResourceStatuses(ctx, clusterConfig, deployment.Resources)
+			if err != nil {
+				continue
+			}
+
+			data, _ := json.Marshal(statuses)
+			if !bytes.Equal(data, lastResources) {
+				lastResources = data
+				if !send(deployments.ProgressEvent{Type: deployments.ProgressResources, Resources: statuses}) {
+					return
+				}
+			}
+
+			ready := true
+			for _, status := range statuses {
+				ready = ready && status.Ready
+			}
+			if ready {
+				send(deployments.ProgressEvent{Type: deployments.ProgressDone, Status: deployment.Status, Ready: true})
+				return
+			}
+		}
+	}
+}
+
+// buildLogDone is the last event of a build log stream
+type buildLogDone struct {
+	Status    string `json:"status"`
+	Error     string `json:"error,omitempty"`
+	Truncated bool   `json:"truncated"`
+}
+
+// streamBuildLog writes the log of a build as server-sent events: a log event per
+// entry, then a done event with the build's status once it finished. Either a
+// subscription to the running build or its stored log is set.
+func streamBuildLog(ctx context.Context, w *bufio.Writer, buildsService *builds.Service, buildID int64, subscription *builds.LogSubscription, storedLog *builds.Log) {
+	send := func(event string, value any) bool {
+		data, err := json.Marshal(value)
+		if err != nil {
+			return false
+		}
+		fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data)
+		// Flushing fails once the client has gone away
+		return w.Flush() == nil
+	}
+
+	sendEntries := func(entries []builds.LogEntry) bool {
+		for _, entry := range toBuildLogEntries(entries) {
+			if !send("log", entry) {
+				return false
+			}
+		}
+		return true
+	}
+
+	if subscription != nil {
+		if !sendEntries(subscription.Backlog) {
+			return
+		}
+		for entry := range subscription.Entries {
+			if !sendEntries([]builds.LogEntry{entry}) {
+				return
+			}
+		}
+		// The channel is closed once the log is stored
+		storedLog, _ = buildsService.GetBuildLog(ctx, buildID)
+	} else if !sendEntries(storedLog.Entries) {
+		return
+	}
+
+	done := buildLogDone{}
+	if storedLog != nil {
+		done.Truncated = storedLog.Truncated
+	}
+	if build, err := buildsService.GetBuildByID(ctx, buildID); err == nil {
+		done.Status = build.Status
+		done.Error = build.Error
+	}
+	send("done", done)
+}
+
+func toBuildLogEntries(entries []builds.LogEntry) []BuildLogEntryResponse {
+	response := make([]BuildLogEntryResponse, len(entries))
+	for i, entry := range entries {
+		response[i] = BuildLogEntryResponse{
+			Time:    entry.Time,
+			Vertex:  entry.Vertex,
+			Stream:  entry.Stream,
+			Message: entry.Message,
+		}
+	}
+	return response
+}
+
+// writeProblem writes a huma error as a problem+json response for plain fiber routes
+func writeProblem(c *fiber.Ctx, err error) error {
+	var statusErr huma.StatusError
+	if !errors.As(err, &statusErr) {
+		statusErr = huma.Error500InternalServerError(err.Error())
+	}
+
+	return c.Status(statusErr.GetStatus()).JSON(statusErr, "application/problem+json")
+}
+
+func toConfigResponse(config *configs.Config) ConfigResponse {
+	response := ConfigResponse{
+		ID:                  config.ID,
+		Provider:            config.Provider,
+		RepoOwner:           config.RepoOwner,
+		RepoName:            config.RepoName,
+		RepoFullName:        config.RepoFullName,
+		RepoCloneURL:        config.RepoCloneURL,
+		DockerfilePath:      config.DockerfilePath,
+		ClusterCredentialID: config.ClusterCredentialID,
+		AgentID:             config.AgentID,
+		ExternalID:          config.ExternalID,
+		Health:              config.Health,
+		HealthDetail:        config.HealthDetail,
+		Version:             config.Version,
+		CreatedAt:           config.CreatedAt.Time,
+		UpdatedAt:           config.UpdatedAt.Time,
+	}
+	if config.HealthCheckedAt.Valid {
+		response.HealthCheckedAt = &config.HealthCheckedAt.Time
+	}
+	return response
+}
+
+func toCredentialResponse(credential *credentials.Credential) CredentialResponse {
+	return CredentialResponse{
+		ID:         credential.ID,
+		Provider:   credential.Provider,
+		TokenType:  credential.TokenType,
+		ExternalID: credential.ExternalID,
+		Version:    credential.Version,
+		CreatedAt:  credential.CreatedAt,
+		LastUsedAt: credential.LastUsedAt,
+		ExpiresAt:  credential.ExpiresAt,
+	}
+}
+
+func toConfigEnvBody(vars []configs.EnvVar) ConfigEnvBody {
+	body := ConfigEnvBody{Env: make([]ConfigEnvVarResponse, len(vars))}
+	for i, v := range vars {
+		body.Env[i] = ConfigEnvVarResponse{
+			Name:      v.Name,
+			Value:     v.Value,
+			UpdatedAt: v.UpdatedAt,
+		}
+	}
+	return body
+}
+
+func toTransferResponse(transfer *configs.Transfer) TransferResponse {
+	return TransferResponse{
+		ID:           transfer.ID,
+		ConfigID:     transfer.ConfigID,
+		RepoFullName: transfer.RepoFullName,
+		FromEmail:    transfer.FromEmail,
+		ToEmail:      transfer.ToEmail,
+		CreatedAt:    transfer.CreatedAt,
+	}
+}
+
+func toPolicyResponse(policy *policies.Policy) PolicyResponse {
+	return PolicyResponse{
+		Name:       policy.Name,
+		Expression: policy.Expression,
+		Message:    policy.Message,
+		Action:     policy.Action,
+		CreatedAt:  policy.CreatedAt,
+		UpdatedAt:  policy.UpdatedAt,
+	}
+}
+
+func toDeployTokenResponse(deployToken *deploytokens.DeployToken) DeployTokenResponse {
+	return DeployTokenResponse{
+		ID:         deployToken.ID,
+		ConfigID:   deployToken.ConfigID,
+		Name:       deployToken.Name,
+		LastUsedAt: deployToken.LastUsedAt,
+		CreatedAt:  deployToken.CreatedAt,
+	}
+}
+
+func toDeploymentResponse(deployment *deployments.Deployment) DeploymentResponse {
+	return DeploymentResponse{
+		ID:         deployment.ID,
+		ConfigID:   deployment.ConfigID,
+		Ref:        deployment.Ref,
+		CommitSHA:  deployment.CommitSHA,
+		Status:     deployment.Status,
+		Error:      deployment.Error,
+		DeployedBy: deployment.DeployedBy,
+		RollbackOf: deployment.RollbackOf,
+		CreatedAt:  deployment.CreatedAt,
+		UpdatedAt:  deployment.UpdatedAt,
+	}
+}
+
+func toGitHubAppResponse(settings *githubapp.Settings) GitHubAppResponse {
+	return GitHubAppResponse{
+		AppID:            settings.AppID,
+		Slug:             settings.Slug,
+		ClientID:         settings.ClientID,
+		HasPrivateKey:    settings.HasPrivateKey,
+		HasClientSecret:  settings.HasClientSecret,
+		HasWebhookSecret: settings.HasWebhookSecret,
+		Source:           settings.Source,
+		UpdatedAt:        settings.UpdatedAt,
+	}
+}
+
+func toInstanceLimitsBody(instanceLimits *limits.Limits) InstanceLimitsBody {
+	return InstanceLimitsBody{
+		MaxConfigsPerUser:     instanceLimits.MaxConfigsPerUser,
+		MaxBuildMinutes:       instanceLimits.MaxBuildMinutes,
+		BuildMinutesSoftQuota: instanceLimits.BuildMinutesQuota.Soft,
+		BuildMinutesHardQuota: instanceLimits.BuildMinutesQuota.Hard,
+		DeploysSoftQuota:      instanceLimits.DeploysQuota.Soft,
+		DeploysHardQuota:      instanceLimits.DeploysQuota.Hard,
+		StorageMBSoftQuota:    instanceLimits.StorageMBQuota.Soft,
+		StorageMBHardQuota:    instanceLimits.StorageMBQuota.Hard,
+		MaxLogMB:              instanceLimits.MaxLogMB,
+		MaxArtifactMB:         instanceLimits.MaxArtifactMB,
+
+		ConfigBuildMinutesSoftQuota: instanceLimits.ConfigBuildMinutesQuota.Soft,
+		ConfigBuildMinutesHardQuota: instanceLimits.ConfigBuildMinutesQuota.Hard,
+		ConfigStorageMBSoftQuota:    instanceLimits.ConfigStorageMBQuota.Soft,
+		ConfigStorageMBHardQuota:    instanceLimits.ConfigStorageMBQuota.Hard,
+
+		BuildRetentionDays: instanceLimits.Retention.BuildDays,
+		LogRetentionDays:   instanceLimits.Retention.LogDays,
+	}
+}
+
+func toQuotaStatusResponses(quotas []usage.QuotaStatus) []QuotaStatusResponse {
+	result := make([]QuotaStatusResponse, len(quotas))
+	for i, quota := range quotas {
+		result[i] = QuotaStatusResponse{
+			Metric: quota.Metric,
+			Used:   quota.Used,
+			Soft:   quota.Soft,
+			Hard:   quota.Hard,
+			State:  quota.State,
+		}
+	}
+	return result
+}
+
+func toDeadJobResponse(job *jobs.Job) DeadJobResponse {
+	// Payloads are JSON; one that is not is returned as stored
+	var payload any = string(job.Payload)
+	if json.Valid(job.Payload) {
+		payload = json.RawMessage(job.Payload)
+	}
+
+	return DeadJobResponse{
+		ID:        job.ID,
+		Kind:      job.Kind,
+		ConfigID:  job.ConfigID,
+		Payload:   payload,
+		Error:     job.Error,
+		Attempts:  job.Attempts,
+		CreatedAt: job.CreatedAt,
+	}
+}
+
+func toAdminUserResponse(user *admin.User) AdminUserResponse {
+	return AdminUserResponse{
+		ID:         user.ID,
+		Email:      user.Email,
+		Role:       user.Role,
+		DisabledAt: user.DisabledAt,
+		CreatedAt:  user.CreatedAt,
+	}
+}
+
+func mapAdminError(err error) error {
+	switch {
+	case errors.Is(err, admin.ErrUserNotFound):
+		return huma.Error404NotFound("User not found")
+	case errors.Is(err, admin.ErrInvalidRole):
+		return huma.Error400BadRequest(err.Error())
+	default:
+		return huma.Error500InternalServerError("Failed to update user", err)
+	}
+}
+
 func mapAuthError(err error) error {
 	switch err {
 	case auth.ErrInvalidCredentials:
 		return huma.Error401Unauthorized("Invalid email or password")
+	case auth.ErrAccountDisabled:
+		return huma.Error403Forbidden("Account is disabled")
 	case auth.ErrEmailExists:
 		return huma.Error409Conflict("Email already exists")
 	case auth.ErrInvalidEmail:
 		return huma.Error400BadRequest("Invalid email format")
 	case auth.ErrInvalidPassword:
 		return huma.Error400BadRequest("Password must be at least 8 characters long")
+	case auth.ErrWrongPassword:
+		return huma.Error403Forbidden("Current password is incorrect")
+	case auth.ErrNoPassword:
+		return huma.Error400BadRequest("Your account has no password yet, set one with 'nimbul reset-password' first")
+	case auth.ErrUserNotFound:
+		return huma.Error404NotFound("User not found")
 	default:
 		return huma.Error500InternalServerError(fmt.Sprintf("Internal server error: %v", err), err)
 	}
 }
+
+// deployTokenConfig returns the config of a deploy token. Tokens of trashed configs
+// and of configs whose owner is disabled are refused.
+func deployTokenConfig(ctx context.Context, configsService *configs.Service, authService *auth.Service, deployToken *deploytokens.DeployToken) (*configs.Config, error) {
+	config, err := configsService.GetConfigByID(ctx, deployToken.ConfigID)
+	if err != nil {
+		return nil, mapConfigError(err)
+	}
+
+	if _, err := authService.Authorize(ctx, config.OwnerID); err != nil {
+		if errors.Is(err, auth.ErrAccountDisabled) {
+			return nil, huma.Error403Forbidden("Config owner is disabled")
+		}
+		return nil, huma.Error500InternalServerError("Failed to check config owner", err)
+	}
+
+	return config, nil
+}
+
+// githubAccessToken returns the GitHub access token of a user, refreshing it when it
+// has expired
+func githubAccessToken(ctx context.Context, credentialsService *credentials.Service, userID string) (string, error) {
+	// Get decrypted GitHub access token
+	token, err := credentialsService.GetDecryptedToken(ctx, userID, "github", "oauth_access")
+	if err != nil {
+		fmt.Println("Error getting GitHub access token:", err)
+		// Check if token is expired
+		if errors.Is(err, credentials.ErrTokenExpired) {
+			// Get refresh token
+			refreshToken, refreshErr := credentialsService.GetDecryptedToken(ctx, userID, "github", "oauth_refresh")
+			if refreshErr != nil {
+				if errors.Is(refreshErr, credentials.ErrRefreshTokenExpired) || errors.Is(refreshErr, credentials.ErrTokenExpired) {
+					return "", huma.Error401Unauthorized("GitHub tokens expired. Please reconnect your GitHub account")
+				}
+				return "", huma.Error404NotFound("GitHub refresh token not found")
+			}
+
+			// Refresh the tokens
+			refreshResult, refreshErr := credentialsService.RefreshGitHubToken(ctx, refreshToken)
+			if refreshErr != nil {
+				if errors.Is(refreshErr, credentials.ErrRefreshTokenExpired) {
+					return "", huma.Error401Unauthorized("GitHub refresh token expired. Please reconnect your GitHub account")
+				}
+				return "", huma.Error500InternalServerError("Failed to refresh GitHub token", refreshErr)
+			}
+
+			// Calculate expiry times
+			accessExpiry := time.Now().Add(time.Duration(refreshResult.ExpiresIn) * time.Second)
+			refreshExpiry := time.Now().Add(6 * 30 * 24 * time.Hour) // 6 months (180 days)
+
+			// Update access token
+			updateErr := credentialsService.UpdateCredential(ctx, credentials.UpdateCredentialParams{
+				OwnerID:   userID,
+				Provider:  "github",
+				TokenType: "oauth_access",
+				Token:     refreshResult.AccessToken,
+				ExpiresAt: accessExpiry,
+			})
+			if updateErr != nil {
+				return "", huma.Error500InternalServerError("Failed to update access token", updateErr)
+			}
+
+			// Update refresh token if a new one was provided
+			if refreshResult.RefreshToken != "" {
+				updateErr = credentialsService.UpdateCredential(ctx, credentials.UpdateCredentialParams{
+					OwnerID:   userID,
+					Provider:  "github",
+					TokenType: "oauth_refresh",
+					Token:     refreshResult.RefreshToken,
+					ExpiresAt: refreshExpiry,
+				})
+				if updateErr != nil {
+					return "", huma.Error500InternalServerError("Failed to update refresh token", updateErr)
+				}
+			}
+
+			// Return the new access token
+			return refreshResult.AccessToken, nil
+		}
+		return "", huma.Error404NotFound("GitHub access token not found")
+	}
+
+	return token, nil
+}
+
+// mapConfigError maps the errors of writes to a config to HTTP errors
+func mapConfigError(err error) error {
+	var exists *configs.ConfigExistsError
+	switch {
+	case errors.As(err, &exists):
+		// Clients find the existing config, e.g. to adopt it, in the error details
+		return huma.Error409Conflict(err.Error(), &huma.ErrorDetail{
+			Message:  "config already exists",
+			Location: "config_id",
+			Value:    exists.ConfigID,
+		})
+	case errors.Is(err, configs.ErrConfigNotFound):
+		return huma.Error404NotFound("Config not found")
+	case errors.Is(err, configs.ErrConfigExists), errors.Is(err, configs.ErrExternalIDTaken):
+		return huma.Error409Conflict(err.Error())
+	case errors.Is(err, configs.ErrVersionMismatch):
+		return huma.Error412PreconditionFailed(err.Error())
+	default:
+		return huma.Error500InternalServerError("Failed to update config", err)
+	}
+}
+
+// mapCredentialError maps the errors of credential operations to HTTP errors
+func mapCredentialError(err error) error {
+	switch {
+	case errors.Is(err, credentials.ErrCredentialNotFound):
+		return huma.Error404NotFound("Credential not found")
+	case errors.Is(err, credentials.ErrCredentialInUse):
+		return huma.Error409Conflict("Credential is used by a config, connect that config to another cluster first")
+	case errors.Is(err, credentials.ErrCredentialExists), errors.Is(err, credentials.ErrExternalIDTaken):
+		return huma.Error409Conflict(err.Error())
+	case errors.Is(err, credentials.ErrVersionMismatch):
+		return huma.Error412PreconditionFailed(err.Error())
+	default:
+		return huma.Error500InternalServerError("Failed to update credential", err)
+	}
+}
+
+// repositoryToken creates a GitHub App installation token that can only access the
+// repository of a config
+func repositoryToken(ctx context.Context, config *configs.Config) (string, time.Time, error) {
+	installationID, err := github.GetInstallationIDByRepository(ctx, config.RepoOwner, config.RepoName)
+	if errors.Is(err, github.ErrAppNotConfigured) {
+		return "", time.Time{}, huma.Error500InternalServerError("GitHub App is not configured", err)
+	}
+	if err != nil {
+		return "", time.Time{}, huma.Error404NotFound("GitHub App is not installed on " + config.RepoFullName)
+	}
+
+	appAuth, err := github.NewAppAuth(ctx, installationID)
+	if err != nil {
+		return "", time.Time{}, huma.Error500InternalServerError("GitHub App is not configured", err)
+	}
+
+	token, expiresAt, err := appAuth.GetRepositoryToken(ctx, config.RepoName)
+	if err != nil {
+		return "", time.Time{}, huma.Error502BadGateway("Failed to create installation token", err)
+	}
+	return token, expiresAt, nil
+}
+
+// validateRegistryLogin checks the token of a registry login, which must be stored
+// under the registry it logs in to
+func validateRegistryLogin(provider, token string) error {
+	login, err := registry.ParseLogin([]byte(token))
+	if err != nil {
+		return huma.Error400BadRequest(err.Error())
+	}
+	if provider != login.Server {
+		return huma.Error400BadRequest(fmt.Sprintf("Registry login for '%s' must use provider '%s'", login.Server, login.Server))
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package installations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/coding-cave-dev/nimbul/internal/github"
+	ghub "github.com/google/go-github/v81/github"
+)
+
+// HandleInstallationEvent follows the app being installed, uninstalled, suspended or
+// unsuspended on an account
+func (s *Service) HandleInstallationEvent(ctx context.Context, event *ghub.InstallationEvent) error {
+	installation := github.AppInstallation{
+		ID:        event.GetInstallation().GetID(),
+		Account:   event.GetInstallation().GetAccount().GetLogin(),
+		Suspended: event.GetInstallation().SuspendedAt != nil,
+	}
+
+	switch event.GetAction() {
+	case "created":
+		if err := s.Add(ctx, installation, repositoryNames(event.Repositories)); err != nil {
+			return err
+		}
+	case "deleted":
+		if err := s.Remove(ctx, installation.ID); err != nil {
+			return err
+		}
+	case "suspend", "unsuspend":
+		if err := s.SetSuspended(ctx, installation.ID, event.GetAction() == "suspend"); err != nil {
+			return err
+		}
+	default:
+		return nil
+	}
+
+	fmt.Printf("✓ GitHub App installation %d on %s %s\n", installation.ID, installation.Account, event.GetAction())
+	return nil
+}
+
+// HandleInstallationRepositoriesEvent follows repositories being given to or taken from
+// an installation
+func (s *Service) HandleInstallationRepositoriesEvent(ctx context.Context, event *ghub.InstallationRepositoriesEvent) error {
+	installation := github.AppInstallation{
+		ID:        event.GetInstallation().GetID(),
+		Account:   event.GetInstallation().GetAccount().GetLogin(),
+		Suspended: event.GetInstallation().SuspendedAt != nil,
+	}
+
+	// A selection switched to all repositories lists none of them, so it is synced
+	if event.GetRepositorySelection() == "all" && len(event.RepositoriesAdded) == 0 {
+		return s.Sync(ctx, installation.ID)
+	}
+
+	if err := s.Add(ctx, installation, repositoryNames(event.RepositoriesAdded)); err != nil {
+		return err
+	}
+	if len(event.RepositoriesRemoved) > 0 {
+		if err := s.RemoveRepositories(ctx, installation.ID, repositoryNames(event.RepositoriesRemoved)); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("✓ GitHub App installation %d on %s gained %d and lost %d repositories\n", installation.ID, installation.Account, len(event.RepositoriesAdded), len(event.RepositoriesRemoved))
+	return nil
+}
+
+func repositoryNames(repos []*ghub.Repository) []string {
+	names := make([]string, len(repos))
+	for i, repo := range repos {
+		names[i] = repo.GetFullName()
+	}
+	return names
+}
@@ -0,0 +1,58 @@
+package installations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/github"
+)
+
+// DefaultInterval is how often every installation is refreshed from GitHub, catching
+// installation events that were missed
+const DefaultInterval = time.Hour
+
+// Refresher periodically syncs the installations of the GitHub App and their
+// repositories from GitHub
+type Refresher struct {
+	service  *Service
+	interval time.Duration
+}
+
+// NewRefresher creates a refresher syncing every DefaultInterval
+func NewRefresher(service *Service) *Refresher {
+	return &Refresher{
+		service:  service,
+		interval: DefaultInterval,
+	}
+}
+
+// Run syncs every installation every interval until ctx is cancelled
+func (r *Refresher) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.Sweep(ctx)
+		}
+	}
+}
+
+// Sweep syncs every installation. Failures are logged; the next sweep retries them.
+func (r *Refresher) Sweep(ctx context.Context) {
+	synced, err := r.service.SyncAll(ctx)
+	if err != nil {
+		// Nothing to sync until admins configure the app
+		if errors.Is(err, github.ErrAppNotConfigured) {
+			return
+		}
+		fmt.Printf("Warning: Failed to sync GitHub App installations: %v\n", err)
+		return
+	}
+	fmt.Printf("✓ Synced %d GitHub App installations\n", synced)
+}
@@ -0,0 +1,211 @@
+package installations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/coding-cave-dev/nimbul/internal/db"
+	"github.com/coding-cave-dev/nimbul/internal/github"
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	// ErrNotCached means no installation on the repository's account is known yet,
+	// so only GitHub can tell whether the app can access the repository
+	ErrNotCached = errors.New("no installation of the GitHub App is known for the account")
+
+	// ErrAccessRevoked is wrapped by the reasons the app cannot access a repository
+	ErrAccessRevoked      = errors.New("the GitHub App cannot access the repository")
+	ErrAppUninstalled     = fmt.Errorf("%w: the app was uninstalled from the account; reinstall it to resume builds", ErrAccessRevoked)
+	ErrAppSuspended       = fmt.Errorf("%w: the app's installation is suspended; unsuspend it to resume builds", ErrAccessRevoked)
+	ErrRepositoryNotGiven = fmt.Errorf("%w: the app's installation lost access to it; give it access again or reinstall the app to resume builds", ErrAccessRevoked)
+)
+
+// Service caches the repositories each installation of the GitHub App can access, so
+// builds of repositories the app lost access to fail before cloning
+type Service struct {
+	queries *db.Queries
+}
+
+func NewService(queries *db.Queries) *Service {
+	return &Service{
+		queries: queries,
+	}
+}
+
+// InstallationID returns the installation that can access the repository owner/fullName.
+// Returns ErrNotCached when no installation on owner is known, or an error wrapping
+// ErrAccessRevoked when the known installation cannot access the repository.
+func (s *Service) InstallationID(ctx context.Context, owner, fullName string) (int64, error) {
+	installation, err := s.queries.GetGitHubInstallationByAccount(ctx, owner)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, ErrNotCached
+		}
+		return 0, fmt.Errorf("failed to get installation: %w", err)
+	}
+
+	switch {
+	case installation.RemovedAt.Valid:
+		return 0, fmt.Errorf("%s: %w", fullName, ErrAppUninstalled)
+	case installation.SuspendedAt.Valid:
+		return 0, fmt.Errorf("%s: %w", fullName, ErrAppSuspended)
+	}
+
+	ok, err := s.queries.HasInstallationRepository(ctx, db.HasInstallationRepositoryParams{
+		InstallationID: installation.ID,
+		RepoFullName:   fullName,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get installation repository: %w", err)
+	}
+	if !ok {
+		return 0, fmt.Errorf("%s: %w", fullName, ErrRepositoryNotGiven)
+	}
+
+	return installation.ID, nil
+}
+
+// Sync refreshes the repositories of one installation from GitHub
+func (s *Service) Sync(ctx context.Context, installationID int64) error {
+	appAuth, err := github.NewAppAuth(ctx, installationID)
+	if err != nil {
+		return err
+	}
+	installation, err := appAuth.GetInstallation(ctx)
+	if err != nil {
+		return err
+	}
+
+	return s.sync(ctx, appAuth, *installation)
+}
+
+// SyncAll refreshes every installation of the app from GitHub and marks the ones it
+// no longer lists as uninstalled. Returns how many installations were refreshed.
+func (s *Service) SyncAll(ctx context.Context) (int, error) {
+	appAuth, err := github.NewAppAuth(ctx, 0) // app-level calls need no installation
+	if err != nil {
+		return 0, err
+	}
+	installations, err := appAuth.ListInstallations(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	synced := 0
+	ids := make([]int64, len(installations))
+	for i, installation := range installations {
+		ids[i] = installation.ID
+
+		installationAuth, err := github.NewAppAuth(ctx, installation.ID)
+		if err != nil {
+			return synced, err
+		}
+		if err := s.sync(ctx, installationAuth, installation); err != nil {
+			fmt.Printf("Warning: Failed to sync installation %d of %s: %v\n", installation.ID, installation.Account, err)
+			continue
+		}
+		synced++
+	}
+
+	if _, err := s.queries.RemoveGitHubInstallationsExcept(ctx, ids); err != nil {
+		return synced, fmt.Errorf("failed to remove uninstalled installations: %w", err)
+	}
+
+	return synced, nil
+}
+
+// sync stores an installation and replaces its repositories with the ones it can access
+func (s *Service) sync(ctx context.Context, appAuth *github.AppAuth, installation github.AppInstallation) error {
+	// Suspended installations cannot create tokens to list their repositories
+	var repos []string
+	if !installation.Suspended {
+		var err error
+		if repos, err = appAuth.ListRepositories(ctx); err != nil {
+			return err
+		}
+	}
+
+	if err := s.Add(ctx, installation, repos); err != nil {
+		return err
+	}
+	if installation.Suspended {
+		return nil
+	}
+
+	if _, err := s.queries.DeleteInstallationRepositoriesExcept(ctx, db.DeleteInstallationRepositoriesExceptParams{
+		InstallationID: installation.ID,
+		RepoFullNames:  lowerAll(repos),
+	}); err != nil {
+		return fmt.Errorf("failed to delete installation repositories: %w", err)
+	}
+
+	return nil
+}
+
+// Add stores an installation and adds repositories it gained access to
+func (s *Service) Add(ctx context.Context, installation github.AppInstallation, repos []string) error {
+	if _, err := s.queries.UpsertGitHubInstallation(ctx, db.UpsertGitHubInstallationParams{
+		ID:           installation.ID,
+		AccountLogin: installation.Account,
+		Suspended:    installation.Suspended,
+	}); err != nil {
+		return fmt.Errorf("failed to store installation: %w", err)
+	}
+
+	if len(repos) == 0 {
+		return nil
+	}
+	if err := s.queries.AddInstallationRepositories(ctx, db.AddInstallationRepositoriesParams{
+		InstallationID: installation.ID,
+		RepoFullNames:  repos,
+	}); err != nil {
+		return fmt.Errorf("failed to add installation repositories: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveRepositories forgets repositories an installation lost access to
+func (s *Service) RemoveRepositories(ctx context.Context, installationID int64, repos []string) error {
+	if _, err := s.queries.RemoveInstallationRepositories(ctx, db.RemoveInstallationRepositoriesParams{
+		InstallationID: installationID,
+		RepoFullNames:  lowerAll(repos),
+	}); err != nil {
+		return fmt.Errorf("failed to remove installation repositories: %w", err)
+	}
+	return nil
+}
+
+// SetSuspended records that an installation was suspended or unsuspended
+func (s *Service) SetSuspended(ctx context.Context, installationID int64, suspended bool) error {
+	if _, err := s.queries.SetGitHubInstallationSuspended(ctx, db.SetGitHubInstallationSuspendedParams{
+		ID:        installationID,
+		Suspended: suspended,
+	}); err != nil {
+		return fmt.Errorf("failed to update installation: %w", err)
+	}
+	return nil
+}
+
+// Remove records that the app was uninstalled, forgetting the installation's repositories
+func (s *Service) Remove(ctx context.Context, installationID int64) error {
+	if _, err := s.queries.RemoveGitHubInstallation(ctx, installationID); err != nil {
+		return fmt.Errorf("failed to remove installation: %w", err)
+	}
+	if _, err := s.queries.DeleteInstallationRepositories(ctx, installationID); err != nil {
+		return fmt.Errorf("failed to delete installation repositories: %w", err)
+	}
+	return nil
+}
+
+// lowerAll lowercases repository names, which GitHub compares case-insensitively
+func lowerAll(repos []string) []string {
+	lowered := make([]string, len(repos))
+	for i, repo := range repos {
+		lowered[i] = strings.ToLower(repo)
+	}
+	return lowered
+}
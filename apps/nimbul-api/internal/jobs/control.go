@@ -0,0 +1,364 @@
+package jobs
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/builds"
+	"github.com/coding-cave-dev/nimbul/internal/workerpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultControlAddr is where the API server serves workers by default
+const DefaultControlAddr = ":9090"
+
+// logRelayBuffer is how many log entries a worker holds while the API server is slow
+// or unreachable before dropping them; the stored log keeps every entry
+const logRelayBuffer = 1024
+
+// controlServer serves the WorkerControl service of the API server
+type controlServer struct {
+	workerpb.UnimplementedWorkerControlServer
+	jobs   *Service
+	builds *builds.Service
+}
+
+// ServeControl serves the control service nimbul-worker processes claim jobs and relay
+// build logs through, on NIMBUL_WORKER_GRPC_ADDR (default :9090), until ctx is
+// cancelled. Workers authenticate with NIMBUL_WORKER_TOKEN, without which it does not
+// serve. It serves TLS with the certificate and key in NIMBUL_WORKER_GRPC_TLS_CERT and
+// NIMBUL_WORKER_GRPC_TLS_KEY, and does not serve without them. Plaintext is only served
+// when NIMBUL_WORKER_GRPC_INSECURE is "true", for a proxy or mesh that terminates TLS
+// in front of it.
+func (s *Service) ServeControl(ctx context.Context, buildsService *builds.Service) error {
+	token := os.Getenv("NIMBUL_WORKER_TOKEN")
+	if token == "" {
+		return errors.New("NIMBUL_WORKER_TOKEN is not set")
+	}
+	addr := os.Getenv("NIMBUL_WORKER_GRPC_ADDR")
+	if addr == "" {
+		addr = DefaultControlAddr
+	}
+
+	var transport credentials.TransportCredentials
+	if os.Getenv("NIMBUL_WORKER_GRPC_INSECURE") == "true" {
+		fmt.Println("Warning: NIMBUL_WORKER_GRPC_INSECURE is set, workers are served in plaintext")
+		transport = insecure.NewCredentials()
+	} else {
+		certFile, keyFile := os.Getenv("NIMBUL_WORKER_GRPC_TLS_CERT"), os.Getenv("NIMBUL_WORKER_GRPC_TLS_KEY")
+		if certFile == "" || keyFile == "" {
+			return errors.New("NIMBUL_WORKER_GRPC_TLS_CERT and NIMBUL_WORKER_GRPC_TLS_KEY are not set; set NIMBUL_WORKER_GRPC_INSECURE=true to serve workers in plaintext")
+		}
+		var err error
+		if transport, err = credentials.NewServerTLSFromFile(certFile, keyFile); err != nil {
+			return fmt.Errorf("failed to load the TLS certificate for workers: %w", err)
+		}
+	}
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen for workers: %w", err)
+	}
+
+	server := grpc.NewServer(
+		grpc.Creds(transport),
+		grpc.UnaryInterceptor(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			if err := authorizeWorker(ctx, token); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}),
+		grpc.StreamInterceptor(func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			if err := authorizeWorker(stream.Context(), token); err != nil {
+				return err
+			}
+			return handler(srv, stream)
+		}),
+	)
+	workerpb.RegisterWorkerControlServer(server, &controlServer{jobs: s, builds: buildsService})
+
+	go func() {
+		<-ctx.Done()
+		server.GracefulStop()
+	}()
+	fmt.Printf("Serving workers on %s (%s)\n", addr, transport.Info().SecurityProtocol)
+	return server.Serve(listener)
+}
+
+// authorizeWorker checks the bearer token of a worker's call
+func authorizeWorker(ctx context.Context, token string) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, value := range md.Get("authorization") {
+		if subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(value, "Bearer ")), []byte(token)) == 1 {
+			return nil
+		}
+	}
+	return status.Error(codes.Unauthenticated, "invalid worker token")
+}
+
+func (c *controlServer) ClaimJob(ctx context.Context, req *workerpb.ClaimJobRequest) (*workerpb.ClaimJobResponse, error) {
+	job, err := c.jobs.claimQueued(ctx, time.Duration(req.GetWaitSeconds())*time.Second)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if job == nil {
+		return &workerpb.ClaimJobResponse{}, nil
+	}
+	fmt.Printf("Worker %s claimed %s job %d of config %s\n", req.GetWorkerId(), job.Kind, job.ID, job.ConfigID)
+
+	return &workerpb.ClaimJobResponse{Job: &workerpb.Job{
+		Id:       job.ID,
+		Kind:     job.Kind,
+		ConfigId: job.ConfigID,
+		Payload:  job.Payload,
+		Priority: int32(job.Priority),
+		Attempt:  int32(job.Attempts),
+	}}, nil
+}
+
+func (c *controlServer) Heartbeat(ctx context.Context, req *workerpb.HeartbeatRequest) (*workerpb.HeartbeatResponse, error) {
+	gone, err := c.jobs.renewQueued(ctx, req.GetJobIds())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &workerpb.HeartbeatResponse{CancelJobIds: gone}, nil
+}
+
+func (c *controlServer) StreamLogs(stream grpc.ClientStreamingServer[workerpb.LogEntry, workerpb.StreamLogsResponse]) error {
+	// The logs a worker stops relaying, e.g. because it died, end with the stream
+	open := make(map[int64]bool)
+	defer func() {
+		for buildID := range open {
+			c.builds.EndReceivedLog(buildID)
+		}
+	}()
+
+	for {
+		entry, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return stream.SendAndClose(&workerpb.StreamLogsResponse{})
+		}
+		if err != nil {
+			return err
+		}
+
+		if entry.GetEnd() {
+			c.builds.EndReceivedLog(entry.GetBuildId())
+			delete(open, entry.GetBuildId())
+			continue
+		}
+		open[entry.GetBuildId()] = true
+		c.builds.ReceiveLog(entry.GetBuildId(), builds.LogEntry{
+			Time:    time.Unix(0, entry.GetTimeUnixNano()),
+			Vertex:  entry.GetVertex(),
+			Stream:  entry.GetStream(),
+			Message: entry.GetMessage(),
+		})
+	}
+}
+
+func (c *controlServer) FinishJob(ctx context.Context, req *workerpb.FinishJobRequest) (*workerpb.FinishJobResponse, error) {
+	if err := c.jobs.finishQueued(ctx, req.GetJobId(), req.GetError(), req.GetPermanent()); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &workerpb.FinishJobResponse{}, nil
+}
+
+// ControlClient is a worker's connection to the control service of the API server. It
+// dispatches jobs to the worker and relays the logs of its builds.
+type ControlClient struct {
+	conn     *grpc.ClientConn
+	client   workerpb.WorkerControlClient
+	workerID string
+	logs     chan *workerpb.LogEntry
+}
+
+// NewControlClientFromEnv connects to the control service at NIMBUL_WORKER_GRPC_URL,
+// e.g. nimbul-api:9090, authenticating with NIMBUL_WORKER_TOKEN. The connection uses
+// TLS, trusting the CA certificates in NIMBUL_WORKER_GRPC_TLS_CA or else the system's.
+// The token is only sent in plaintext when NIMBUL_WORKER_GRPC_INSECURE is "true", for
+// a network that secures the traffic itself.
+func NewControlClientFromEnv(workerID string) (*ControlClient, error) {
+	target := os.Getenv("NIMBUL_WORKER_GRPC_URL")
+	if target == "" {
+		return nil, errors.New("NIMBUL_WORKER_GRPC_URL is not set")
+	}
+	token := os.Getenv("NIMBUL_WORKER_TOKEN")
+	if token == "" {
+		return nil, errors.New("NIMBUL_WORKER_TOKEN is not set")
+	}
+
+	var transport credentials.TransportCredentials
+	if os.Getenv("NIMBUL_WORKER_GRPC_INSECURE") == "true" {
+		fmt.Println("Warning: NIMBUL_WORKER_GRPC_INSECURE is set, the worker token is sent in plaintext")
+		transport = insecure.NewCredentials()
+	} else {
+		config := &tls.Config{MinVersion: tls.VersionTLS12}
+		if caFile := os.Getenv("NIMBUL_WORKER_GRPC_TLS_CA"); caFile != "" {
+			pem, err := os.ReadFile(caFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read NIMBUL_WORKER_GRPC_TLS_CA: %w", err)
+			}
+			config.RootCAs = x509.NewCertPool()
+			if !config.RootCAs.AppendCertsFromPEM(pem) {
+				return nil, fmt.Errorf("NIMBUL_WORKER_GRPC_TLS_CA has no PEM certificates")
+			}
+		}
+		transport = credentials.NewTLS(config)
+	}
+
+	conn, err := grpc.NewClient(target,
+		grpc.WithTransportCredentials(transport),
+		grpc.WithPerRPCCredentials(workerToken{token: token, secure: transport.Info().SecurityProtocol == "tls"}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", target, err)
+	}
+
+	return &ControlClient{
+		conn:     conn,
+		client:   workerpb.NewWorkerControlClient(conn),
+		workerID: workerID,
+		logs:     make(chan *workerpb.LogEntry, logRelayBuffer),
+	}, nil
+}
+
+// workerToken sends the worker token with every call
+type workerToken struct {
+	token  string
+	secure bool
+}
+
+func (t workerToken) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + t.token}, nil
+}
+
+func (t workerToken) RequireTransportSecurity() bool {
+	return t.secure
+}
+
+// Close closes the connection
+func (c *ControlClient) Close() error {
+	return c.conn.Close()
+}
+
+func (c *ControlClient) ClaimJob(ctx context.Context, wait time.Duration) (*Job, error) {
+	resp, err := c.client.ClaimJob(ctx, &workerpb.ClaimJobRequest{
+		WorkerId:    c.workerID,
+		WaitSeconds: int32(wait.Seconds()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetJob() == nil {
+		return nil, nil
+	}
+
+	job := resp.GetJob()
+	return &Job{
+		ID:       job.GetId(),
+		Kind:     job.GetKind(),
+		ConfigID: job.GetConfigId(),
+		Payload:  job.GetPayload(),
+		Priority: int(job.GetPriority()),
+		Attempts: int(job.GetAttempt()),
+	}, nil
+}
+
+func (c *ControlClient) Heartbeat(ctx context.Context, jobIDs []int64) ([]int64, error) {
+	resp, err := c.client.Heartbeat(ctx, &workerpb.HeartbeatRequest{
+		WorkerId: c.workerID,
+		JobIds:   jobIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetCancelJobIds(), nil
+}
+
+func (c *ControlClient) FinishJob(ctx context.Context, jobID int64, jobErr error) error {
+	req := &workerpb.FinishJobRequest{JobId: jobID}
+	if jobErr != nil {
+		req.Error = jobErr.Error()
+		req.Permanent = IsPermanent(jobErr)
+	}
+	_, err := c.client.FinishJob(ctx, req)
+	return err
+}
+
+// RelayLog queues a log entry for the API server, dropping it when the queue is full
+func (c *ControlClient) RelayLog(buildID int64, entry builds.LogEntry) {
+	c.relay(&workerpb.LogEntry{
+		BuildId:      buildID,
+		TimeUnixNano: entry.Time.UnixNano(),
+		Vertex:       entry.Vertex,
+		Stream:       entry.Stream,
+		Message:      entry.Message,
+	})
+}
+
+// EndLog tells the API server that the log of a build was stored
+func (c *ControlClient) EndLog(buildID int64) {
+	c.relay(&workerpb.LogEntry{BuildId: buildID, End: true})
+}
+
+func (c *ControlClient) relay(entry *workerpb.LogEntry) {
+	select {
+	case c.logs <- entry:
+	default:
+	}
+}
+
+// RelayLogs streams the queued log entries to the API server until ctx is cancelled,
+// reconnecting when the stream breaks
+func (c *ControlClient) RelayLogs(ctx context.Context) {
+	for ctx.Err() == nil {
+		if err := c.streamLogs(ctx); err != nil && ctx.Err() == nil {
+			fmt.Printf("Warning: Failed to relay build logs: %v\n", err)
+			select {
+			case <-ctx.Done():
+			case <-time.After(retryDelay):
+			}
+		}
+	}
+}
+
+// streamLogs sends queued log entries over one stream until it breaks
+func (c *ControlClient) streamLogs(ctx context.Context) error {
+	// The stream outlives ctx long enough to flush
+	stream, err := c.client.StreamLogs(context.WithoutCancel(ctx))
+	if err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			// Flush what is queued so the logs of the last builds end
+			for len(c.logs) > 0 {
+				if err := stream.Send(<-c.logs); err != nil {
+					return err
+				}
+			}
+			_, err := stream.CloseAndRecv()
+			return err
+		case entry := <-c.logs:
+			if err := stream.Send(entry); err != nil {
+				return err
+			}
+		}
+	}
+}
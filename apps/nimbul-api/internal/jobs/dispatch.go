@@ -0,0 +1,107 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/db"
+	"github.com/jackc/pgx/v5"
+)
+
+// pollInterval is how often a claim waiting for a job looks at the queue again
+const pollInterval = 2 * time.Second
+
+// maxClaimWait caps how long a claim waits for a job
+const maxClaimWait = time.Minute
+
+// claimQueued waits up to wait for the queued job of the highest priority and leases
+// it. Returns nil when no job came up.
+func (s *Service) claimQueued(ctx context.Context, wait time.Duration) (*Job, error) {
+	deadline := time.Now().Add(min(wait, maxClaimWait))
+	for {
+		queued, err := s.queries.ClaimNextJob(ctx, int32(leaseDuration.Seconds()))
+		if err == nil {
+			return &Job{
+				ID:       queued.ID,
+				Kind:     queued.Kind,
+				ConfigID: queued.ConfigID,
+				Payload:  queued.Payload,
+				Priority: int(queued.Priority),
+				Attempts: int(queued.Attempts),
+			}, nil
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("failed to claim job: %w", err)
+		}
+
+		if time.Until(deadline) <= 0 {
+			return nil, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(min(pollInterval, time.Until(deadline))):
+		}
+	}
+}
+
+// renewQueued renews the leases of running jobs. Returns the jobs no longer queued,
+// which are to be canceled.
+func (s *Service) renewQueued(ctx context.Context, jobIDs []int64) ([]int64, error) {
+	var gone []int64
+	for _, id := range jobIDs {
+		rows, err := s.queries.RenewJobClaim(ctx, db.RenewJobClaimParams{ID: id, LeaseSeconds: int32(leaseDuration.Seconds())})
+		if err != nil {
+			return nil, fmt.Errorf("failed to renew lease of job %d: %w", id, err)
+		}
+		if rows == 0 {
+			gone = append(gone, id)
+		}
+	}
+	return gone, nil
+}
+
+// finishQueued records the outcome of an attempt at a queued job: it removes the job
+// from the queue, or schedules its next attempt after a failure retrying can fix. A
+// job failing every attempt is dead-lettered.
+func (s *Service) finishQueued(ctx context.Context, jobID int64, errText string, permanent bool) error {
+	queued, err := s.queries.GetQueuedJobByID(ctx, jobID)
+	if err != nil {
+		// Removed while it ran, e.g. with its config
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return fmt.Errorf("failed to get queued job: %w", err)
+	}
+
+	job := Job{
+		ID:       queued.ID,
+		Kind:     queued.Kind,
+		ConfigID: queued.ConfigID,
+		Payload:  queued.Payload,
+		Priority: int(queued.Priority),
+	}
+	attempt := int(queued.Attempts)
+
+	if errText != "" && !permanent && attempt <= len(retryDelays) {
+		delay := retryDelays[attempt-1]
+		fmt.Printf("Warning: %s job %d of config %s failed, retrying in %s: %s\n", job.Kind, job.ID, job.ConfigID, delay, errText)
+		err := s.queries.RetryQueuedJob(ctx, db.RetryQueuedJobParams{ID: job.ID, DelaySeconds: int32(delay.Seconds())})
+		if err != nil {
+			return fmt.Errorf("failed to schedule retry of job %d: %w", job.ID, err)
+		}
+		return nil
+	}
+
+	if errText != "" && !permanent {
+		s.deadLetter(job, attempt, errors.New(errText))
+	} else if errText != "" {
+		fmt.Printf("%s job %d of config %s failed: %s\n", job.Kind, job.ID, job.ConfigID, errText)
+	}
+	if err := s.queries.DeleteQueuedJob(ctx, job.ID); err != nil {
+		return fmt.Errorf("failed to remove job %d from the queue: %w", job.ID, err)
+	}
+	return nil
+}
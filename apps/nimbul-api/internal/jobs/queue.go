@@ -0,0 +1,113 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"sync"
+)
+
+// DefaultMaxRunningJobs is how many jobs run at once by default
+const DefaultMaxRunningJobs = 8
+
+// Priorities of jobs; a job waiting for a slot runs before the waiting jobs of lower
+// priority, and after those of its priority that waited longer
+const (
+	// PriorityWebhook is the priority of jobs triggered by webhooks
+	PriorityWebhook = 0
+	// PriorityManual is the priority of jobs a user triggered and waits for, like a
+	// rollback or a requeued dead job, so they jump ahead of a webhook backlog
+	PriorityManual = 10
+)
+
+// slots limits how many jobs run at once, handing free slots to the waiting job of
+// the highest priority
+type slots struct {
+	limit int // 0 for no limit
+
+	mu      sync.Mutex
+	running int
+	next    uint64 // order of the next waiter
+	waiting []*waiter
+}
+
+type waiter struct {
+	priority int
+	order    uint64
+	ready    chan struct{}
+}
+
+// newSlotsFromEnv creates the job slots, their number set with NIMBUL_MAX_RUNNING_JOBS
+// (0 for no limit)
+func newSlotsFromEnv() *slots {
+	limit := DefaultMaxRunningJobs
+	if value := os.Getenv("NIMBUL_MAX_RUNNING_JOBS"); value != "" {
+		if parsed, err := strconv.Atoi(value); err == nil && parsed >= 0 {
+			limit = parsed
+		} else {
+			fmt.Printf("Warning: Invalid NIMBUL_MAX_RUNNING_JOBS %q, using %d\n", value, limit)
+		}
+	}
+	return &slots{limit: limit}
+}
+
+// acquire waits for a free slot. The returned func frees it again.
+func (s *slots) acquire(ctx context.Context, priority int) (func(), error) {
+	s.mu.Lock()
+	if s.limit == 0 || (s.running < s.limit && len(s.waiting) == 0) {
+		s.running++
+		s.mu.Unlock()
+		return s.release, nil
+	}
+
+	w := &waiter{priority: priority, order: s.next, ready: make(chan struct{})}
+	s.next++
+	// Waiters are kept by priority, then by how long they waited
+	i, _ := slices.BinarySearchFunc(s.waiting, w, func(a, b *waiter) int {
+		if a.priority != b.priority {
+			return b.priority - a.priority
+		}
+		return int(a.order) - int(b.order)
+	})
+	s.waiting = slices.Insert(s.waiting, i, w)
+	s.mu.Unlock()
+
+	select {
+	case <-w.ready:
+		return s.release, nil
+	case <-ctx.Done():
+		s.mu.Lock()
+		i := slices.Index(s.waiting, w)
+		if i >= 0 {
+			s.waiting = slices.Delete(s.waiting, i, i+1)
+		}
+		s.mu.Unlock()
+		// A slot handed over just now is passed on
+		if i < 0 {
+			s.release()
+		}
+		return nil, ctx.Err()
+	}
+}
+
+// release frees a slot, handing it to the first waiter
+func (s *slots) release() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.waiting) > 0 {
+		w := s.waiting[0]
+		s.waiting = s.waiting[1:]
+		close(w.ready)
+		return
+	}
+	s.running--
+}
+
+// Acquire waits for a free job slot for work that does not run as a job, like a
+// rollback, so it takes turns with the jobs. The returned func frees the slot.
+func (s *Service) Acquire(ctx context.Context, priority int) (func(), error) {
+	return s.slots.acquire(ctx, priority)
+}
@@ -0,0 +1,288 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/db"
+	"github.com/jackc/pgx/v5"
+)
+
+// Job kinds
+const (
+	// KindGitHubPush runs the pipeline of a GitHub push; its payload is the push event
+	KindGitHubPush = "github_push"
+	// KindImagePush redeploys after an image push; its payload is the JSON registry.Push
+	KindImagePush = "image_push"
+)
+
+var (
+	ErrJobNotFound = errors.New("dead job not found")
+	ErrJobRequeued = errors.New("dead job was already requeued")
+	ErrUnknownKind = errors.New("unknown job kind")
+)
+
+// Failed attempts are retried with these delays before the job is dead-lettered
+var retryDelays = []time.Duration{10 * time.Second, time.Minute}
+
+// Handler runs a job. Errors retrying cannot fix are returned wrapped in Permanent.
+type Handler func(ctx context.Context, job Job) error
+
+// Job runners, set with NIMBUL_JOB_RUNNER
+const (
+	// RunnerAPI runs jobs in the API server, in the request submitting them
+	RunnerAPI = "api"
+	// RunnerWorker queues jobs for nimbul-worker processes
+	RunnerWorker = "worker"
+)
+
+type Service struct {
+	queries  *db.Queries
+	handlers map[string]Handler
+	slots    *slots
+	runner   string
+}
+
+// NewService creates the service running jobs. At most NIMBUL_MAX_RUNNING_JOBS jobs
+// run at once (default 8, 0 for no limit); the others wait in the order of their
+// priority. Jobs run in the API server unless NIMBUL_JOB_RUNNER is "worker".
+func NewService(queries *db.Queries) *Service {
+	runner := RunnerAPI
+	if value := os.Getenv("NIMBUL_JOB_RUNNER"); value != "" {
+		if value == RunnerAPI || value == RunnerWorker {
+			runner = value
+		} else {
+			fmt.Printf("Warning: Invalid NIMBUL_JOB_RUNNER %q, using %s\n", value, runner)
+		}
+	}
+
+	return &Service{
+		queries:  queries,
+		handlers: make(map[string]Handler),
+		slots:    newSlotsFromEnv(),
+		runner:   runner,
+	}
+}
+
+// Runner returns where jobs run, RunnerAPI or RunnerWorker
+func (s *Service) Runner() string {
+	return s.runner
+}
+
+// Job is a unit of pipeline work, e.g. handling a push. Jobs handed to a worker have
+// the ID of their queue entry; dead jobs, those that failed every attempt, have their
+// own ID and record how they failed.
+type Job struct {
+	ID       int64
+	Kind     string
+	ConfigID string
+	OwnerID  string
+	Payload  []byte
+	// Priority orders the job among the jobs waiting to run, PriorityWebhook by default
+	Priority int
+
+	Error      string
+	Attempts   int
+	CreatedAt  time.Time
+	RequeuedAt *time.Time
+}
+
+// Handle registers the handler running jobs of a kind
+func (s *Service) Handle(kind string, handler Handler) {
+	s.handlers[kind] = handler
+}
+
+// permanentError marks a job error that retrying cannot fix
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent marks err as one retrying cannot fix, like an invalid nimbul.yaml or a
+// failed build, so the job fails right away and is not dead-lettered
+func Permanent(err error) error {
+	if err == nil || IsPermanent(err) {
+		return err
+	}
+	return &permanentError{err: err}
+}
+
+// IsPermanent reports whether err was marked with Permanent
+func IsPermanent(err error) bool {
+	var permanent *permanentError
+	return errors.As(err, &permanent)
+}
+
+// Submit runs a job, or queues it for a worker when jobs run in workers. Returns
+// whether the job was queued, and otherwise the error of running it like Run.
+func (s *Service) Submit(ctx context.Context, job Job) (queued bool, err error) {
+	if s.runner != RunnerWorker {
+		return false, s.Run(ctx, job)
+	}
+
+	if _, ok := s.handlers[job.Kind]; !ok {
+		return false, fmt.Errorf("%w: %s", ErrUnknownKind, job.Kind)
+	}
+	err = s.queries.EnqueueJob(ctx, db.EnqueueJobParams{
+		Kind:     job.Kind,
+		ConfigID: job.ConfigID,
+		Payload:  job.Payload,
+		Priority: int32(job.Priority),
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to queue job: %w", err)
+	}
+	return true, nil
+}
+
+// Run runs a job, retrying failed attempts. A job failing every attempt is stored as a
+// dead job to be requeued once whatever it depends on is back. Returns the error of the
+// last attempt.
+func (s *Service) Run(ctx context.Context, job Job) error {
+	handler, ok := s.handlers[job.Kind]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownKind, job.Kind)
+	}
+
+	var jobErr error
+	attempts := 0
+	for ; ; attempts++ {
+		release, err := s.slots.acquire(ctx, job.Priority)
+		if err != nil {
+			return err
+		}
+		jobErr = handler(ctx, job)
+		release()
+		if jobErr == nil || IsPermanent(jobErr) {
+			return jobErr
+		}
+		if attempts == len(retryDelays) || ctx.Err() != nil {
+			break
+		}
+		fmt.Printf("Warning: %s job of config %s failed, retrying in %s: %v\n", job.Kind, job.ConfigID, retryDelays[attempts], jobErr)
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(retryDelays[attempts]):
+		}
+	}
+
+	s.deadLetter(job, attempts+1, jobErr)
+	return jobErr
+}
+
+// deadLetter stores a job that failed every attempt as a dead job
+func (s *Service) deadLetter(job Job, attempts int, jobErr error) {
+	_, err := s.queries.CreateDeadJob(context.Background(), db.CreateDeadJobParams{
+		Kind:     job.Kind,
+		ConfigID: job.ConfigID,
+		Payload:  job.Payload,
+		Error:    jobErr.Error(),
+		Attempts: int32(attempts),
+	})
+	if err != nil {
+		fmt.Printf("Warning: Failed to dead-letter %s job of config %s: %v\n", job.Kind, job.ConfigID, err)
+		return
+	}
+	fmt.Printf("Dead-lettered %s job of config %s after %d attempts: %v\n", job.Kind, job.ConfigID, attempts, jobErr)
+}
+
+// GetDeadJobs lists the dead jobs of an owner's configs that were not requeued, newest
+// first, or those of every owner when ownerID is empty
+func (s *Service) GetDeadJobs(ctx context.Context, ownerID string) ([]Job, error) {
+	var rows []db.GetDeadJobByIDRow
+	if ownerID == "" {
+		all, err := s.queries.GetAllDeadJobs(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get dead jobs: %w", err)
+		}
+		for _, row := range all {
+			rows = append(rows, db.GetDeadJobByIDRow(row))
+		}
+	} else {
+		owned, err := s.queries.GetDeadJobsByOwnerID(ctx, ownerID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get dead jobs: %w", err)
+		}
+		for _, row := range owned {
+			rows = append(rows, db.GetDeadJobByIDRow(row))
+		}
+	}
+
+	jobs := make([]Job, len(rows))
+	for i, row := range rows {
+		jobs[i] = *dbDeadJobToJob(row)
+	}
+	return jobs, nil
+}
+
+// GetDeadJobByID retrieves a dead job by its ID
+func (s *Service) GetDeadJobByID(ctx context.Context, id int64) (*Job, error) {
+	row, err := s.queries.GetDeadJobByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrJobNotFound
+		}
+		return nil, fmt.Errorf("failed to get dead job: %w", err)
+	}
+
+	return dbDeadJobToJob(row), nil
+}
+
+// Requeue runs a dead job again in the background, or queues it for a worker. A job
+// failing again is dead-lettered as a new dead job.
+func (s *Service) Requeue(ctx context.Context, job *Job) error {
+	if _, ok := s.handlers[job.Kind]; !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownKind, job.Kind)
+	}
+
+	rows, err := s.queries.MarkDeadJobRequeued(ctx, job.ID)
+	if err != nil {
+		return fmt.Errorf("failed to requeue dead job: %w", err)
+	}
+	if rows == 0 {
+		return ErrJobRequeued
+	}
+
+	// Requeuing is what an operator waits for after an outage, so it goes before
+	// the webhook backlog
+	requeued := *job
+	requeued.ID = 0
+	requeued.Priority = PriorityManual
+	if s.runner == RunnerWorker {
+		_, err := s.Submit(ctx, requeued)
+		return err
+	}
+	go func() {
+		if err := s.Run(context.Background(), requeued); err != nil {
+			fmt.Printf("Warning: Requeued dead job %d failed: %v\n", job.ID, err)
+		}
+	}()
+
+	return nil
+}
+
+// dbDeadJobToJob converts a dead job row to a Job
+func dbDeadJobToJob(row db.GetDeadJobByIDRow) *Job {
+	var requeuedAt *time.Time
+	if row.RequeuedAt.Valid {
+		requeuedAt = &row.RequeuedAt.Time
+	}
+
+	return &Job{
+		ID:         row.ID,
+		Kind:       row.Kind,
+		ConfigID:   row.ConfigID,
+		OwnerID:    row.OwnerID,
+		Payload:    row.Payload,
+		Error:      row.Error,
+		Attempts:   int(row.Attempts),
+		CreatedAt:  row.CreatedAt.Time,
+		RequeuedAt: requeuedAt,
+	}
+}
@@ -0,0 +1,135 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// A worker leases the jobs it claims, renewing the lease while the job runs, so the
+// jobs of a worker that died are claimed again once their lease ran out
+const (
+	leaseDuration = 5 * time.Minute
+	renewInterval = time.Minute
+)
+
+// claimWait is how long a worker waits for a job per claim
+const claimWait = 30 * time.Second
+
+// retryDelay is how long a worker backs off after failing to reach the API server
+const retryDelay = 5 * time.Second
+
+// Dispatcher hands queued jobs to a worker and takes their outcome, implemented by
+// the API server's control service
+type Dispatcher interface {
+	// ClaimJob waits up to wait for a queued job, nil when none came up
+	ClaimJob(ctx context.Context, wait time.Duration) (*Job, error)
+	// Heartbeat renews the leases of the running jobs and returns those to cancel
+	Heartbeat(ctx context.Context, jobIDs []int64) ([]int64, error)
+	// FinishJob reports the outcome of an attempt at a job
+	FinishJob(ctx context.Context, jobID int64, jobErr error) error
+}
+
+// Work runs the jobs dispatcher hands out, as many at once as the job slots allow,
+// until ctx is cancelled. The running jobs are waited for before it returns.
+func (s *Service) Work(ctx context.Context, dispatcher Dispatcher) {
+	var mu sync.Mutex
+	cancels := make(map[int64]context.CancelFunc) // of the running jobs
+
+	heartbeatCtx, stopHeartbeat := context.WithCancel(context.Background())
+	defer stopHeartbeat()
+	var running sync.WaitGroup
+	defer running.Wait()
+
+	go func() {
+		ticker := time.NewTicker(renewInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-heartbeatCtx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			mu.Lock()
+			jobIDs := make([]int64, 0, len(cancels))
+			for id := range cancels {
+				jobIDs = append(jobIDs, id)
+			}
+			mu.Unlock()
+
+			cancelIDs, err := dispatcher.Heartbeat(heartbeatCtx, jobIDs)
+			if err != nil {
+				if heartbeatCtx.Err() == nil {
+					fmt.Printf("Warning: Failed to renew job leases: %v\n", err)
+				}
+				continue
+			}
+			mu.Lock()
+			for _, id := range cancelIDs {
+				if cancel, ok := cancels[id]; ok {
+					fmt.Printf("Canceling job %d: it was removed from the queue\n", id)
+					cancel()
+				}
+			}
+			mu.Unlock()
+		}
+	}()
+
+	for {
+		// A slot is taken before claiming, so a claimed job never waits for one;
+		// the queue hands out the job of the highest priority first
+		release, err := s.slots.acquire(ctx, PriorityWebhook)
+		if err != nil {
+			return
+		}
+
+		job, err := dispatcher.ClaimJob(ctx, claimWait)
+		if err != nil || job == nil {
+			release()
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				fmt.Printf("Warning: Failed to claim job: %v\n", err)
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(retryDelay):
+				}
+			}
+			continue
+		}
+
+		// The job keeps running when the worker is asked to stop
+		jobCtx, cancel := context.WithCancel(context.Background())
+		mu.Lock()
+		cancels[job.ID] = cancel
+		mu.Unlock()
+
+		running.Go(func() {
+			defer release()
+			defer func() {
+				mu.Lock()
+				delete(cancels, job.ID)
+				mu.Unlock()
+				cancel()
+			}()
+
+			jobErr := s.attempt(jobCtx, *job)
+			if err := dispatcher.FinishJob(context.Background(), job.ID, jobErr); err != nil {
+				fmt.Printf("Warning: Failed to report outcome of job %d: %v\n", job.ID, err)
+			}
+		})
+	}
+}
+
+// attempt makes one attempt at a job with its handler
+func (s *Service) attempt(ctx context.Context, job Job) error {
+	handler, ok := s.handlers[job.Kind]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownKind, job.Kind)
+	}
+	return handler(ctx, job)
+}
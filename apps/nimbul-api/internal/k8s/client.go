@@ -2,13 +2,18 @@ package k8s
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer/yaml"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/discovery/cached/memory"
@@ -17,6 +22,7 @@ import (
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/restmapper"
 	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 )
 
 func getConfig() (*rest.Config, error) {
@@ -38,6 +44,104 @@ func getConfig() (*rest.Config, error) {
 	return config, nil
 }
 
+// Credential token types for stored cluster credentials
+const (
+	CredentialTypeKubeconfig     = "kubeconfig"
+	CredentialTypeServiceAccount = "service_account_token"
+)
+
+// serviceAccountCredential is the stored form of a service account token credential
+type serviceAccountCredential struct {
+	Server                   string `json:"server"`
+	CertificateAuthorityData string `json:"certificate_authority_data"` // base64-encoded PEM
+	Token                    string `json:"token"`
+}
+
+// ConfigFromCredential builds a REST config from a stored cluster credential.
+// tokenType selects how data is interpreted: a full kubeconfig (current context is used)
+// or a JSON service account credential with server, CA data and bearer token.
+func ConfigFromCredential(tokenType string, data []byte) (*rest.Config, error) {
+	switch tokenType {
+	case CredentialTypeKubeconfig:
+		kubeconfig, err := clientcmd.Load(data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid kubeconfig: %w", err)
+		}
+		if err := checkKubeconfig(kubeconfig); err != nil {
+			return nil, err
+		}
+		config, err := clientcmd.NewDefaultClientConfig(*kubeconfig, &clientcmd.ConfigOverrides{}).ClientConfig()
+		if err != nil {
+			return nil, fmt.Errorf("invalid kubeconfig: %w", err)
+		}
+		return config, nil
+
+	case CredentialTypeServiceAccount:
+		var sa serviceAccountCredential
+		if err := json.Unmarshal(data, &sa); err != nil {
+			return nil, fmt.Errorf("invalid service account credential: %w", err)
+		}
+		if sa.Server == "" || sa.Token == "" {
+			return nil, fmt.Errorf("service account credential requires server and token")
+		}
+
+		config := &rest.Config{
+			Host:        sa.Server,
+			BearerToken: sa.Token,
+		}
+		if sa.CertificateAuthorityData != "" {
+			caData, err := base64.StdEncoding.DecodeString(sa.CertificateAuthorityData)
+			if err != nil {
+				return nil, fmt.Errorf("invalid certificate_authority_data: %w", err)
+			}
+			config.TLSClientConfig.CAData = caData
+		}
+		return config, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported cluster credential type: %s", tokenType)
+	}
+}
+
+// ErrUnsafeKubeconfig is returned for kubeconfigs that would run commands or read files
+// on the server, which only works for the server's own access
+var ErrUnsafeKubeconfig = errors.New("kubeconfig must embed its credentials")
+
+// checkKubeconfig rejects exec and auth-provider plugins and references to files in a
+// stored kubeconfig: credentials, certificates and keys must be embedded as data
+func checkKubeconfig(kubeconfig *clientcmdapi.Config) error {
+	for name, user := range kubeconfig.AuthInfos {
+		switch {
+		case user.Exec != nil:
+			return fmt.Errorf("%w: user %q uses an exec plugin", ErrUnsafeKubeconfig, name)
+		case user.AuthProvider != nil:
+			return fmt.Errorf("%w: user %q uses an auth provider", ErrUnsafeKubeconfig, name)
+		case user.TokenFile != "":
+			return fmt.Errorf("%w: user %q uses tokenFile, use token", ErrUnsafeKubeconfig, name)
+		case user.ClientCertificate != "":
+			return fmt.Errorf("%w: user %q uses client-certificate, use client-certificate-data", ErrUnsafeKubeconfig, name)
+		case user.ClientKey != "":
+			return fmt.Errorf("%w: user %q uses client-key, use client-key-data", ErrUnsafeKubeconfig, name)
+		}
+	}
+	for name, cluster := range kubeconfig.Clusters {
+		if cluster.CertificateAuthority != "" {
+			return fmt.Errorf("%w: cluster %q uses certificate-authority, use certificate-authority-data", ErrUnsafeKubeconfig, name)
+		}
+	}
+	return nil
+}
+
+// IsClusterCredentialType reports whether tokenType is a supported cluster credential type
+func IsClusterCredentialType(tokenType string) bool {
+	return tokenType == CredentialTypeKubeconfig || tokenType == CredentialTypeServiceAccount
+}
+
+// GetServerConfig returns the REST config of the API server itself (KUBECONFIG or in-cluster)
+func GetServerConfig() (*rest.Config, error) {
+	return getConfig()
+}
+
 func GetClient() (*kubernetes.Clientset, error) {
 	config, err := getConfig()
 	if err != nil {
@@ -55,24 +159,69 @@ func GetDynamicClient() (dynamic.Interface, error) {
 	return dynamic.NewForConfig(config)
 }
 
-// ApplyManifests applies multi-document YAML manifests to the cluster
+// GetClientForConfig returns a typed client for the given REST config
+func GetClientForConfig(config *rest.Config) (*kubernetes.Clientset, error) {
+	return kubernetes.NewForConfig(config)
+}
+
+// ResourceRef identifies a resource applied to a cluster
+type ResourceRef struct {
+	APIVersion string `json:"api_version"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"` // empty for cluster-scoped resources
+	Name       string `json:"name"`
+}
+
+// ApplyManifests applies multi-document YAML manifests to the server's own cluster
 func ApplyManifests(ctx context.Context, yamlBytes []byte) error {
-	// Get dynamic client
-	dynamicClient, err := GetDynamicClient()
+	config, err := getConfig()
 	if err != nil {
-		return fmt.Errorf("failed to get dynamic client: %w", err)
+		return fmt.Errorf("failed to get config: %w", err)
 	}
 
-	// Get REST config for discovery
-	config, err := getConfig()
+	_, err = ApplyManifestsWithConfig(ctx, config, yamlBytes)
+	return err
+}
+
+// Labels and annotations Nimbul sets on the resources it applies, next to LabelConfigID
+// and AnnotationBranch. Selecting on LabelConfigID finds every resource of a config.
+const (
+	AnnotationCommit   = "nimbul.dev/commit"
+	AnnotationBuildID  = "nimbul.dev/build-id"
+	AnnotationDeployer = "nimbul.dev/deployed-by"
+)
+
+// ApplyManifestsWithConfig applies multi-document YAML manifests to the cluster
+// described by config and returns the resources that were applied. On error, the
+// returned refs cover the resources applied before the failure.
+func ApplyManifestsWithConfig(ctx context.Context, config *rest.Config, yamlBytes []byte) ([]ResourceRef, error) {
+	return applyManifests(ctx, config, yamlBytes, false)
+}
+
+// DryRunManifestsWithConfig validates multi-document YAML manifests against the cluster
+// described by config: first against the cluster's OpenAPI schemas, which catches
+// fields server-side apply would drop, then with a server-side dry-run apply. Nothing
+// is persisted.
+func DryRunManifestsWithConfig(ctx context.Context, config *rest.Config, yamlBytes []byte) error {
+	if err := ValidateManifestSchemasWithConfig(config, yamlBytes); err != nil {
+		return err
+	}
+	_, err := applyManifests(ctx, config, yamlBytes, true)
+	return err
+}
+
+// applyManifests server-side applies each document, or only validates them when dryRun is set
+func applyManifests(ctx context.Context, config *rest.Config, yamlBytes []byte, dryRun bool) ([]ResourceRef, error) {
+	// Get dynamic client
+	dynamicClient, err := dynamic.NewForConfig(config)
 	if err != nil {
-		return fmt.Errorf("failed to get config: %w", err)
+		return nil, fmt.Errorf("failed to get dynamic client: %w", err)
 	}
 
 	// Create discovery client and REST mapper
 	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
 	if err != nil {
-		return fmt.Errorf("failed to create discovery client: %w", err)
+		return nil, fmt.Errorf("failed to create discovery client: %w", err)
 	}
 
 	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient))
@@ -83,10 +232,18 @@ func ApplyManifests(ctx context.Context, yamlBytes []byte) error {
 	// Split multi-document YAML
 	manifests := strings.Split(string(yamlBytes), "---")
 	if len(manifests) == 0 {
-		return fmt.Errorf("no manifests found")
+		return nil, fmt.Errorf("no manifests found")
+	}
+
+	// A dry run cannot see namespaces created by the same manifests, so resources
+	// in those namespaces are allowed to fail with NotFound during validation
+	var declaredNamespaces map[string]bool
+	if dryRun {
+		declaredNamespaces = namespacesDeclared(decoder, manifests)
 	}
 
 	// Apply each manifest
+	var applied []ResourceRef
 	for i, manifest := range manifests {
 		manifest = strings.TrimSpace(manifest)
 		if manifest == "" {
@@ -97,20 +254,21 @@ func ApplyManifests(ctx context.Context, yamlBytes []byte) error {
 		obj := &unstructured.Unstructured{}
 		_, gvk, err := decoder.Decode([]byte(manifest), nil, obj)
 		if err != nil {
-			return fmt.Errorf("failed to decode manifest %d: %w", i+1, err)
+			return applied, fmt.Errorf("failed to decode manifest %d: %w", i+1, err)
 		}
 
 		// Find GVR (GroupVersionResource) from GVK (GroupVersionKind)
 		mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
 		if err != nil {
-			return fmt.Errorf("failed to find REST mapping for %s: %w", gvk, err)
+			return applied, fmt.Errorf("failed to find REST mapping for %s: %w", gvk, err)
 		}
 
 		// Get resource interface
 		var dr dynamic.ResourceInterface
+		namespace := ""
 		if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
 			// Namespaced resource
-			namespace := obj.GetNamespace()
+			namespace = obj.GetNamespace()
 			if namespace == "" {
 				namespace = "default"
 			}
@@ -123,20 +281,64 @@ func ApplyManifests(ctx context.Context, yamlBytes []byte) error {
 		// Get resource name
 		name := obj.GetName()
 		if name == "" {
-			return fmt.Errorf("manifest %d: resource name is required", i+1)
+			return applied, fmt.Errorf("manifest %d: resource name is required", i+1)
 		}
 
 		// Apply using server-side apply
-		_, err = dr.Apply(ctx, name, obj, metav1.ApplyOptions{
+		applyOptions := metav1.ApplyOptions{
 			FieldManager: "nimbul",
 			Force:        true,
-		})
+		}
+		if dryRun {
+			applyOptions.DryRun = []string{metav1.DryRunAll}
+		}
+
+		_, err = dr.Apply(ctx, name, obj, applyOptions)
 		if err != nil {
-			return fmt.Errorf("failed to apply resource %s/%s (%s): %w", obj.GetNamespace(), name, gvk, err)
+			if dryRun && apierrors.IsNotFound(err) && declaredNamespaces[namespace] {
+				continue
+			}
+			if dryRun {
+				return applied, fmt.Errorf("dry run failed for resource %s/%s (%s): %w", obj.GetNamespace(), name, gvk, err)
+			}
+			return applied, fmt.Errorf("failed to apply resource %s/%s (%s): %w", obj.GetNamespace(), name, gvk, err)
 		}
 
-		fmt.Printf("✓ Applied %s %s/%s\n", gvk.Kind, obj.GetNamespace(), name)
+		if dryRun {
+			fmt.Printf("✓ Validated %s %s/%s\n", gvk.Kind, obj.GetNamespace(), name)
+		} else {
+			fmt.Printf("✓ Applied %s %s/%s\n", gvk.Kind, obj.GetNamespace(), name)
+		}
+
+		applied = append(applied, ResourceRef{
+			APIVersion: obj.GetAPIVersion(),
+			Kind:       gvk.Kind,
+			Namespace:  namespace,
+			Name:       name,
+		})
 	}
 
-	return nil
+	return applied, nil
+}
+
+// namespacesDeclared returns the names of Namespace resources among the documents
+func namespacesDeclared(decoder runtime.Decoder, manifests []string) map[string]bool {
+	namespaces := make(map[string]bool)
+	for _, manifest := range manifests {
+		manifest = strings.TrimSpace(manifest)
+		if manifest == "" {
+			continue
+		}
+
+		obj := &unstructured.Unstructured{}
+		_, gvk, err := decoder.Decode([]byte(manifest), nil, obj)
+		if err != nil {
+			continue
+		}
+		if gvk.Group == "" && gvk.Kind == "Namespace" {
+			namespaces[obj.GetName()] = true
+		}
+	}
+
+	return namespaces
 }
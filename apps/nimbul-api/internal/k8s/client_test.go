@@ -0,0 +1,64 @@
+package k8s
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+const testKubeconfig = `apiVersion: v1
+kind: Config
+current-context: test
+clusters:
+- name: test
+  cluster:
+    server: https://cluster.example.com
+    certificate-authority-data: ""
+contexts:
+- name: test
+  context:
+    cluster: test
+    user: test
+users:
+- name: test
+  user:
+    token: secret
+`
+
+func TestConfigFromCredentialKubeconfig(t *testing.T) {
+	config, err := ConfigFromCredential(CredentialTypeKubeconfig, []byte(testKubeconfig))
+	if err != nil {
+		t.Fatalf("ConfigFromCredential() error = %v", err)
+	}
+	if config.Host != "https://cluster.example.com" || config.BearerToken != "secret" {
+		t.Errorf("ConfigFromCredential() = host %q, token %q", config.Host, config.BearerToken)
+	}
+}
+
+func TestConfigFromCredentialRejectsUnsafeKubeconfigs(t *testing.T) {
+	tests := []struct {
+		name string
+		old  string
+		new  string
+	}{
+		{"exec plugin", "    token: secret\n", "    exec:\n      apiVersion: client.authentication.k8s.io/v1\n      command: /bin/sh\n      args: [-c, id]\n"},
+		{"auth provider", "    token: secret\n", "    auth-provider:\n      name: oidc\n      config:\n        idp-issuer-url: https://issuer.example.com\n"},
+		{"token file", "    token: secret\n", "    tokenFile: /var/run/secrets/kubernetes.io/serviceaccount/token\n"},
+		{"client certificate", "    token: secret\n", "    client-certificate: /etc/nimbul/tls.crt\n"},
+		{"client key", "    token: secret\n", "    client-key: /etc/nimbul/tls.key\n"},
+		{"certificate authority", "    certificate-authority-data: \"\"\n", "    certificate-authority: /var/run/secrets/kubernetes.io/serviceaccount/ca.crt\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kubeconfig := strings.Replace(testKubeconfig, tt.old, tt.new, 1)
+			if kubeconfig == testKubeconfig {
+				t.Fatal("test kubeconfig not changed")
+			}
+			_, err := ConfigFromCredential(CredentialTypeKubeconfig, []byte(kubeconfig))
+			if !errors.Is(err, ErrUnsafeKubeconfig) {
+				t.Errorf("ConfigFromCredential() error = %v, want %v", err, ErrUnsafeKubeconfig)
+			}
+		})
+	}
+}
@@ -0,0 +1,213 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"gopkg.in/yaml.v3"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8syaml "k8s.io/apimachinery/pkg/runtime/serializer/yaml"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+)
+
+// What applying a manifest would do to its resource
+const (
+	DiffCreate    = "create"
+	DiffUpdate    = "update"
+	DiffUnchanged = "unchanged"
+)
+
+// ResourceDiff is how a resource in the cluster differs from its manifest
+type ResourceDiff struct {
+	ResourceRef
+	Action string
+	Diff   string // unified diff from the live resource to the manifest
+	Error  string // why the resource could not be compared
+}
+
+// DiffManifestsWithConfig compares multi-document YAML manifests with the resources
+// they would update in the cluster described by config. Only the fields a manifest
+// sets are compared, so fields the cluster defaults or other controllers manage do
+// not show up, and neither do the nimbul.dev labels and annotations that change on
+// every deploy. Lookup failures of a single resource are reported in its Error field.
+func DiffManifestsWithConfig(ctx context.Context, config *rest.Config, yamlBytes []byte) ([]ResourceDiff, error) {
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dynamic client: %w", err)
+	}
+
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create discovery client: %w", err)
+	}
+
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient))
+	decoder := k8syaml.NewDecodingSerializer(unstructured.UnstructuredJSONScheme)
+
+	var diffs []ResourceDiff
+	for i, manifest := range strings.Split(string(yamlBytes), "---") {
+		manifest = strings.TrimSpace(manifest)
+		if manifest == "" {
+			continue
+		}
+
+		obj := &unstructured.Unstructured{}
+		_, gvk, err := decoder.Decode([]byte(manifest), nil, obj)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode manifest %d: %w", i+1, err)
+		}
+
+		diff := ResourceDiff{ResourceRef: ResourceRef{
+			APIVersion: obj.GetAPIVersion(),
+			Kind:       gvk.Kind,
+			Name:       obj.GetName(),
+		}}
+
+		mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		if err != nil {
+			diff.Error = fmt.Sprintf("failed to find REST mapping: %v", err)
+			diffs = append(diffs, diff)
+			continue
+		}
+
+		var dr dynamic.ResourceInterface
+		if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+			diff.Namespace = obj.GetNamespace()
+			if diff.Namespace == "" {
+				diff.Namespace = "default"
+			}
+			dr = dynamicClient.Resource(mapping.Resource).Namespace(diff.Namespace)
+		} else {
+			dr = dynamicClient.Resource(mapping.Resource)
+		}
+
+		desired := withoutNimbulMetadata(obj.Object)
+		var live interface{}
+		existing, err := dr.Get(ctx, obj.GetName(), metav1.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+			diff.Action = DiffCreate
+		case err != nil:
+			diff.Error = err.Error()
+			diffs = append(diffs, diff)
+			continue
+		default:
+			live = pruneTo(withoutNimbulMetadata(existing.Object), desired)
+		}
+
+		diff.Diff, err = unifiedDiff(live, desired, diff.Kind+" "+diff.Name)
+		if err != nil {
+			return nil, err
+		}
+		if diff.Action == "" {
+			diff.Action = DiffUpdate
+			if diff.Diff == "" {
+				diff.Action = DiffUnchanged
+			}
+		}
+		diffs = append(diffs, diff)
+	}
+
+	return diffs, nil
+}
+
+// pruneTo keeps the parts of live that desired sets. Lists of the same length are
+// compared item by item, others as a whole.
+func pruneTo(live, desired interface{}) interface{} {
+	switch d := desired.(type) {
+	case map[string]interface{}:
+		l, ok := live.(map[string]interface{})
+		if !ok {
+			return live
+		}
+		pruned := make(map[string]interface{}, len(d))
+		for key, value := range d {
+			if liveValue, ok := l[key]; ok {
+				pruned[key] = pruneTo(liveValue, value)
+			}
+		}
+		return pruned
+	case []interface{}:
+		l, ok := live.([]interface{})
+		if !ok || len(l) != len(d) {
+			return live
+		}
+		pruned := make([]interface{}, len(l))
+		for i := range l {
+			pruned[i] = pruneTo(l[i], d[i])
+		}
+		return pruned
+	}
+	return live
+}
+
+// withoutNimbulMetadata returns a copy of an object without the nimbul.dev labels and
+// annotations
+func withoutNimbulMetadata(object map[string]interface{}) map[string]interface{} {
+	obj := &unstructured.Unstructured{Object: object}
+	obj = obj.DeepCopy()
+	for _, strip := range []struct {
+		get func() map[string]string
+		set func(map[string]string)
+	}{
+		{obj.GetLabels, obj.SetLabels},
+		{obj.GetAnnotations, obj.SetAnnotations},
+	} {
+		values := strip.get()
+		for key := range values {
+			if strings.HasPrefix(key, "nimbul.dev/") {
+				delete(values, key)
+			}
+		}
+		strip.set(values)
+	}
+	return obj.Object
+}
+
+// unifiedDiff returns the unified diff between two objects serialized to YAML, or ""
+// when they are equal. A nil from is diffed as an empty document.
+func unifiedDiff(from, to interface{}, name string) (string, error) {
+	fromYAML := ""
+	if from != nil {
+		var err error
+		if fromYAML, err = marshalYAML(from); err != nil {
+			return "", fmt.Errorf("failed to serialize %s: %w", name, err)
+		}
+	}
+
+	toYAML, err := marshalYAML(to)
+	if err != nil {
+		return "", fmt.Errorf("failed to serialize %s: %w", name, err)
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(fromYAML),
+		B:        difflib.SplitLines(toYAML),
+		FromFile: "live",
+		ToFile:   "manifest",
+		Context:  3,
+	})
+}
+
+// marshalYAML serializes an object with the two-space indent manifests are written in
+func marshalYAML(object interface{}) (string, error) {
+	var b strings.Builder
+	encoder := yaml.NewEncoder(&b)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(object); err != nil {
+		return "", err
+	}
+	if err := encoder.Close(); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,70 @@
+package k8s
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDiffComparesFieldsTheManifestSets(t *testing.T) {
+	desired := withoutNimbulMetadata(map[string]interface{}{
+		"kind": "Deployment",
+		"metadata": map[string]interface{}{
+			"name":        "api",
+			"annotations": map[string]interface{}{"nimbul.dev/commit": "def456"},
+		},
+		"spec": map[string]interface{}{
+			"replicas": int64(3),
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"name": "api", "image": "api:v2"},
+					},
+				},
+			},
+		},
+	})
+	live := withoutNimbulMetadata(map[string]interface{}{
+		"kind": "Deployment",
+		"metadata": map[string]interface{}{
+			"name":            "api",
+			"resourceVersion": "42",
+			"annotations":     map[string]interface{}{"nimbul.dev/commit": "abc123"},
+		},
+		"spec": map[string]interface{}{
+			"replicas":             int64(3),
+			"revisionHistoryLimit": int64(10),
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"name": "api", "image": "api:v1", "imagePullPolicy": "IfNotPresent"},
+					},
+				},
+			},
+		},
+		"status": map[string]interface{}{"replicas": int64(3)},
+	})
+
+	diff, err := unifiedDiff(pruneTo(live, desired), desired, "Deployment api")
+	if err != nil {
+		t.Fatalf("unifiedDiff() error = %v", err)
+	}
+
+	for _, want := range []string{"-        - image: api:v1\n", "+        - image: api:v2\n"} {
+		if !strings.Contains(diff, want) {
+			t.Errorf("diff is missing %q:\n%s", want, diff)
+		}
+	}
+	for _, unwanted := range []string{"resourceVersion", "revisionHistoryLimit", "imagePullPolicy", "status", "nimbul.dev"} {
+		if strings.Contains(diff, unwanted) {
+			t.Errorf("diff should not mention %q:\n%s", unwanted, diff)
+		}
+	}
+
+	unchanged, err := unifiedDiff(pruneTo(desired, desired), desired, "Deployment api")
+	if err != nil {
+		t.Fatalf("unifiedDiff() error = %v", err)
+	}
+	if unchanged != "" {
+		t.Errorf("diff of equal objects = %q, want none", unchanged)
+	}
+}
@@ -0,0 +1,160 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// Labels and annotations Nimbul sets on preview namespaces
+const (
+	LabelPreview         = "nimbul.dev/preview"
+	LabelConfigID        = "nimbul.dev/config-id"
+	AnnotationBranch     = "nimbul.dev/branch"
+	AnnotationLastDeploy = "nimbul.dev/last-deployed-at"
+	AnnotationPreviewTTL = "nimbul.dev/ttl"
+)
+
+// PreviewNamespace is a preview namespace created by Nimbul
+type PreviewNamespace struct {
+	Name           string
+	ConfigID       string
+	Branch         string
+	LastDeployedAt time.Time
+	// TTL is the idle time after which the namespace is reaped; 0 uses the reaper default
+	TTL time.Duration
+}
+
+// EnsurePreviewNamespace creates or updates the preview namespace of a config branch and
+// records the time of this deploy so the reaper knows the preview is still in use
+func EnsurePreviewNamespace(ctx context.Context, config *rest.Config, name, configID, branch string, ttl time.Duration) error {
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return fmt.Errorf("failed to get client: %w", err)
+	}
+
+	annotations := map[string]string{
+		AnnotationBranch:     branch,
+		AnnotationLastDeploy: time.Now().UTC().Format(time.RFC3339),
+	}
+	if ttl > 0 {
+		annotations[AnnotationPreviewTTL] = ttl.String()
+	}
+
+	namespaces := clientset.CoreV1().Namespaces()
+	existing, err := namespaces.Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = namespaces.Create(ctx, &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+				Labels: map[string]string{
+					LabelPreview:  "true",
+					LabelConfigID: configID,
+				},
+				Annotations: annotations,
+			},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to create preview namespace %s: %w", name, err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get preview namespace %s: %w", name, err)
+	}
+
+	// Never take over a namespace Nimbul did not create for this config
+	if existing.Labels[LabelPreview] != "true" || existing.Labels[LabelConfigID] != configID {
+		return fmt.Errorf("namespace %s exists and is not a preview namespace of this config", name)
+	}
+
+	if existing.Annotations == nil {
+		existing.Annotations = map[string]string{}
+	}
+	for key, value := range annotations {
+		existing.Annotations[key] = value
+	}
+	if ttl == 0 {
+		delete(existing.Annotations, AnnotationPreviewTTL)
+	}
+
+	if _, err := namespaces.Update(ctx, existing, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to update preview namespace %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// ListPreviewNamespaces lists the preview namespaces of a config
+func ListPreviewNamespaces(ctx context.Context, config *rest.Config, configID string) ([]PreviewNamespace, error) {
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client: %w", err)
+	}
+
+	selector := labels.SelectorFromSet(labels.Set{
+		LabelPreview:  "true",
+		LabelConfigID: configID,
+	})
+	list, err := clientset.CoreV1().Namespaces().List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list preview namespaces: %w", err)
+	}
+
+	result := make([]PreviewNamespace, 0, len(list.Items))
+	for _, ns := range list.Items {
+		preview := PreviewNamespace{
+			Name:     ns.Name,
+			ConfigID: ns.Labels[LabelConfigID],
+			Branch:   ns.Annotations[AnnotationBranch],
+		}
+
+		// Namespaces with an unreadable deploy time count from their creation
+		preview.LastDeployedAt = ns.CreationTimestamp.Time
+		if lastDeploy, err := time.Parse(time.RFC3339, ns.Annotations[AnnotationLastDeploy]); err == nil {
+			preview.LastDeployedAt = lastDeploy
+		}
+		if ttl, err := time.ParseDuration(ns.Annotations[AnnotationPreviewTTL]); err == nil {
+			preview.TTL = ttl
+		}
+
+		result = append(result, preview)
+	}
+
+	return result, nil
+}
+
+// DeletePreviewNamespace deletes a preview namespace of a config and everything in it.
+// Namespaces that no longer exist or are not previews of the config are left alone.
+func DeletePreviewNamespace(ctx context.Context, config *rest.Config, name, configID string) error {
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return fmt.Errorf("failed to get client: %w", err)
+	}
+
+	existing, err := clientset.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get preview namespace %s: %w", name, err)
+	}
+	if existing.Labels[LabelPreview] != "true" || existing.Labels[LabelConfigID] != configID {
+		return nil
+	}
+
+	propagation := metav1.DeletePropagationForeground
+	err = clientset.CoreV1().Namespaces().Delete(ctx, name, metav1.DeleteOptions{PropagationPolicy: &propagation})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete preview namespace %s: %w", name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,183 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+)
+
+// ResolveServicePort finds a ready pod backing a service and the container port the
+// given service port targets
+func ResolveServicePort(ctx context.Context, config *rest.Config, namespace, service string, port int) (string, int, error) {
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to get client: %w", err)
+	}
+
+	svc, err := clientset.CoreV1().Services(namespace).Get(ctx, service, metav1.GetOptions{})
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to get service %s/%s: %w", namespace, service, err)
+	}
+
+	if len(svc.Spec.Selector) == 0 {
+		return "", 0, fmt.Errorf("service %s/%s has no pod selector", namespace, service)
+	}
+
+	var servicePort *corev1.ServicePort
+	for i := range svc.Spec.Ports {
+		if int(svc.Spec.Ports[i].Port) == port {
+			servicePort = &svc.Spec.Ports[i]
+			break
+		}
+	}
+	if servicePort == nil {
+		return "", 0, fmt.Errorf("service %s/%s does not expose port %d", namespace, service, port)
+	}
+
+	pods, err := clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(svc.Spec.Selector).String(),
+	})
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to list pods for service %s/%s: %w", namespace, service, err)
+	}
+
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != corev1.PodRunning || !isPodReady(&pod) {
+			continue
+		}
+
+		targetPort, err := containerPort(&pod, servicePort)
+		if err != nil {
+			return "", 0, err
+		}
+		return pod.Name, targetPort, nil
+	}
+
+	return "", 0, fmt.Errorf("no ready pods found for service %s/%s", namespace, service)
+}
+
+// PortForwardStream is a single forwarded connection to a pod port
+type PortForwardStream struct {
+	conn        httpstream.Connection
+	dataStream  httpstream.Stream
+	errorStream httpstream.Stream
+}
+
+// DialPodPort opens a port-forward connection to a pod port over SPDY
+func DialPodPort(config *rest.Config, namespace, pod string, port int) (*PortForwardStream, error) {
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client: %w", err)
+	}
+
+	transport, upgrader, err := spdy.RoundTripperFor(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create SPDY transport: %w", err)
+	}
+
+	url := clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(namespace).
+		Name(pod).
+		SubResource("portforward").
+		URL()
+
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, url)
+	conn, _, err := dialer.Dial(portforward.PortForwardProtocolV1Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to pod %s/%s: %w", namespace, pod, err)
+	}
+
+	headers := http.Header{}
+	headers.Set(corev1.StreamType, corev1.StreamTypeError)
+	headers.Set(corev1.PortHeader, strconv.Itoa(port))
+	headers.Set(corev1.PortForwardRequestIDHeader, "0")
+	errorStream, err := conn.CreateStream(headers)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to create error stream: %w", err)
+	}
+	// The error stream is only read from
+	errorStream.Close()
+
+	headers.Set(corev1.StreamType, corev1.StreamTypeData)
+	dataStream, err := conn.CreateStream(headers)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to create data stream: %w", err)
+	}
+
+	return &PortForwardStream{
+		conn:        conn,
+		dataStream:  dataStream,
+		errorStream: errorStream,
+	}, nil
+}
+
+func (s *PortForwardStream) Read(p []byte) (int, error) {
+	return s.dataStream.Read(p)
+}
+
+func (s *PortForwardStream) Write(p []byte) (int, error) {
+	return s.dataStream.Write(p)
+}
+
+// Close tears down the whole port-forward connection
+func (s *PortForwardStream) Close() error {
+	return s.conn.Close()
+}
+
+// Err returns the error reported by the kubelet for this forward, if any.
+// It blocks until the error stream is closed.
+func (s *PortForwardStream) Err() error {
+	message, err := io.ReadAll(s.errorStream)
+	if err != nil {
+		return fmt.Errorf("failed to read port-forward error stream: %w", err)
+	}
+	if len(message) > 0 {
+		return fmt.Errorf("port-forward failed: %s", string(message))
+	}
+	return nil
+}
+
+// isPodReady reports whether the pod's Ready condition is true
+func isPodReady(pod *corev1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// containerPort resolves a service port's targetPort to a numeric container port on pod
+func containerPort(pod *corev1.Pod, servicePort *corev1.ServicePort) (int, error) {
+	targetPort := servicePort.TargetPort
+	if targetPort.StrVal == "" {
+		if targetPort.IntVal == 0 {
+			return int(servicePort.Port), nil
+		}
+		return int(targetPort.IntVal), nil
+	}
+
+	for _, container := range pod.Spec.Containers {
+		for _, port := range container.Ports {
+			if port.Name == targetPort.StrVal {
+				return int(port.ContainerPort), nil
+			}
+		}
+	}
+
+	return 0, fmt.Errorf("pod %s has no container port named %s", pod.Name, targetPort.StrVal)
+}
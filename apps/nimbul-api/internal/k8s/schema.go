@@ -0,0 +1,333 @@
+package k8s
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer/yaml"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/openapi"
+	"k8s.io/client-go/rest"
+)
+
+// ValidateManifestSchemasWithConfig checks every document against the OpenAPI schema
+// the cluster publishes for its kind, so that misspelled or misplaced fields, which
+// server-side apply drops with no more than a warning, fail before anything is applied.
+// Kinds the cluster has no schema for, such as custom resources whose definition is in
+// the same manifests, are not checked.
+func ValidateManifestSchemasWithConfig(config *rest.Config, yamlBytes []byte) error {
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return fmt.Errorf("failed to create discovery client: %w", err)
+	}
+
+	paths, err := discoveryClient.OpenAPIV3().Paths()
+	if err != nil {
+		// Clusters before 1.27 may not serve OpenAPI v3, the dry run still validates
+		fmt.Printf("Warning: Skipping schema validation, the cluster does not publish OpenAPI v3 schemas: %v\n", err)
+		return nil
+	}
+
+	decoder := yaml.NewDecodingSerializer(unstructured.UnstructuredJSONScheme)
+	schemas := make(map[schema.GroupVersion]*openAPIDocument)
+
+	var problems []string
+	for i, manifest := range strings.Split(string(yamlBytes), "---") {
+		manifest = strings.TrimSpace(manifest)
+		if manifest == "" {
+			continue
+		}
+
+		obj := &unstructured.Unstructured{}
+		_, gvk, err := decoder.Decode([]byte(manifest), nil, obj)
+		if err != nil {
+			return fmt.Errorf("failed to decode manifest %d: %w", i+1, err)
+		}
+
+		doc, ok := schemas[gvk.GroupVersion()]
+		if !ok {
+			doc, err = fetchOpenAPIDocument(paths, gvk.GroupVersion())
+			if err != nil {
+				return err
+			}
+			schemas[gvk.GroupVersion()] = doc
+		}
+		if doc == nil {
+			continue
+		}
+
+		kindSchema := doc.kindSchema(*gvk)
+		if kindSchema == nil {
+			continue
+		}
+
+		for _, problem := range doc.validate(kindSchema, obj.Object, "") {
+			problems = append(problems, fmt.Sprintf("%s %s: %s", gvk.Kind, obj.GetName(), problem))
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("manifests do not match the cluster's schema:\n  %s", strings.Join(problems, "\n  "))
+	}
+	return nil
+}
+
+// openAPIDocument is the OpenAPI v3 document of a group version
+type openAPIDocument struct {
+	Components struct {
+		Schemas map[string]*openAPISchema `json:"schemas"`
+	} `json:"components"`
+}
+
+// openAPISchema holds the parts of a schema that validation needs
+type openAPISchema struct {
+	Ref                  string                    `json:"$ref"`
+	Type                 string                    `json:"type"`
+	Properties           map[string]*openAPISchema `json:"properties"`
+	AdditionalProperties *openAPISchema            `json:"additionalProperties"`
+	Items                *openAPISchema            `json:"items"`
+	Required             []string                  `json:"required"`
+	AllOf                []*openAPISchema          `json:"allOf"`
+	OneOf                []*openAPISchema          `json:"oneOf"`
+	AnyOf                []*openAPISchema          `json:"anyOf"`
+	Enum                 []interface{}             `json:"enum"`
+	PreserveUnknown      bool                      `json:"x-kubernetes-preserve-unknown-fields"`
+	IntOrString          bool                      `json:"x-kubernetes-int-or-string"`
+	EmbeddedResource     bool                      `json:"x-kubernetes-embedded-resource"`
+	GroupVersionKinds    []struct {
+		Group   string `json:"group"`
+		Version string `json:"version"`
+		Kind    string `json:"kind"`
+	} `json:"x-kubernetes-group-version-kind"`
+}
+
+// fetchOpenAPIDocument returns the OpenAPI document of a group version, or nil when the
+// cluster does not serve one
+func fetchOpenAPIDocument(paths map[string]openapi.GroupVersion, gv schema.GroupVersion) (*openAPIDocument, error) {
+	path := "apis/" + gv.Group + "/" + gv.Version
+	if gv.Group == "" {
+		path = "api/" + gv.Version
+	}
+
+	groupVersion, ok := paths[path]
+	if !ok {
+		return nil, nil
+	}
+
+	data, err := groupVersion.Schema("application/json")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get OpenAPI schema of %s: %w", gv, err)
+	}
+
+	var doc openAPIDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse OpenAPI schema of %s: %w", gv, err)
+	}
+	return &doc, nil
+}
+
+// kindSchema returns the schema of a kind, or nil when the document has none
+func (d *openAPIDocument) kindSchema(gvk schema.GroupVersionKind) *openAPISchema {
+	for _, s := range d.Components.Schemas {
+		for _, candidate := range s.GroupVersionKinds {
+			if candidate.Group == gvk.Group && candidate.Version == gvk.Version && candidate.Kind == gvk.Kind {
+				return s
+			}
+		}
+	}
+	return nil
+}
+
+// resolve follows references and the single-element allOf the API server wraps
+// references with defaults in
+func (d *openAPIDocument) resolve(s *openAPISchema) *openAPISchema {
+	for s != nil {
+		switch {
+		case s.Ref != "":
+			s = d.Components.Schemas[strings.TrimPrefix(s.Ref, "#/components/schemas/")]
+		case len(s.AllOf) == 1 && s.Type == "" && len(s.Properties) == 0:
+			s = s.AllOf[0]
+		default:
+			return s
+		}
+	}
+	return nil
+}
+
+// validate returns the problems of value against a schema, each prefixed with the
+// path of the field
+func (d *openAPIDocument) validate(s *openAPISchema, value interface{}, path string) []string {
+	s = d.resolve(s)
+	// Null fields are dropped by the API server, unresolvable references accept anything
+	if s == nil || value == nil || s.PreserveUnknown {
+		return nil
+	}
+
+	if alternatives := slices.Concat(s.OneOf, s.AnyOf); len(alternatives) > 0 && s.Type == "" {
+		for _, alternative := range alternatives {
+			if len(d.validate(alternative, value, path)) == 0 {
+				return nil
+			}
+		}
+		return []string{fmt.Sprintf("%s: unexpected %s", fieldPath(path), describe(value))}
+	}
+
+	if s.IntOrString {
+		switch value.(type) {
+		case string, int64:
+			return nil
+		}
+		return []string{fmt.Sprintf("%s: expected an integer or a string, got %s", fieldPath(path), describe(value))}
+	}
+
+	typ := s.Type
+	if typ == "" && len(s.Properties) > 0 {
+		typ = "object"
+	}
+
+	switch typ {
+	case "object":
+		return d.validateObject(s, value, path)
+	case "array":
+		items, ok := value.([]interface{})
+		if !ok {
+			return []string{fmt.Sprintf("%s: expected a list, got %s", fieldPath(path), describe(value))}
+		}
+		var problems []string
+		for i, item := range items {
+			problems = append(problems, d.validate(s.Items, item, fmt.Sprintf("%s[%d]", path, i))...)
+		}
+		return problems
+	case "string":
+		if _, ok := value.(string); !ok {
+			return []string{fmt.Sprintf("%s: expected a string, got %s", fieldPath(path), describe(value))}
+		}
+	case "integer":
+		if _, ok := value.(int64); !ok {
+			return []string{fmt.Sprintf("%s: expected an integer, got %s", fieldPath(path), describe(value))}
+		}
+	case "number":
+		switch value.(type) {
+		case int64, float64:
+		default:
+			return []string{fmt.Sprintf("%s: expected a number, got %s", fieldPath(path), describe(value))}
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return []string{fmt.Sprintf("%s: expected true or false, got %s", fieldPath(path), describe(value))}
+		}
+	}
+
+	if _, ok := value.(string); ok && len(s.Enum) > 0 && !slices.Contains(s.Enum, value) {
+		return []string{fmt.Sprintf("%s: unsupported value %v", fieldPath(path), value)}
+	}
+	return nil
+}
+
+func (d *openAPIDocument) validateObject(s *openAPISchema, value interface{}, path string) []string {
+	fields, ok := value.(map[string]interface{})
+	if !ok {
+		return []string{fmt.Sprintf("%s: expected an object, got %s", fieldPath(path), describe(value))}
+	}
+
+	// Report fields in a stable order
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var problems []string
+	for _, name := range names {
+		fieldSchema, declared := s.Properties[name]
+		switch {
+		case declared:
+			problems = append(problems, d.validate(fieldSchema, fields[name], path+"."+name)...)
+		case s.AdditionalProperties != nil:
+			problems = append(problems, d.validate(s.AdditionalProperties, fields[name], path+"."+name)...)
+		case len(s.Properties) == 0:
+			// Free-form objects accept any field
+		case s.EmbeddedResource && (name == "apiVersion" || name == "kind" || name == "metadata"):
+		default:
+			problem := fmt.Sprintf("%s: unknown field", fieldPath(path+"."+name))
+			if suggestion := closestField(name, s.Properties); suggestion != "" {
+				problem += fmt.Sprintf(", did you mean %s?", suggestion)
+			}
+			problems = append(problems, problem)
+		}
+	}
+
+	for _, name := range s.Required {
+		if _, ok := fields[name]; !ok {
+			problems = append(problems, fmt.Sprintf("%s: required field is missing", fieldPath(path+"."+name)))
+		}
+	}
+	return problems
+}
+
+// closestField returns the declared field a misspelled one most likely meant, or ""
+// when none is close
+func closestField(name string, properties map[string]*openAPISchema) string {
+	candidates := make([]string, 0, len(properties))
+	for candidate := range properties {
+		candidates = append(candidates, candidate)
+	}
+	sort.Strings(candidates)
+
+	best, bestDistance := "", len(name)/3+1
+	for _, candidate := range candidates {
+		if distance := editDistance(strings.ToLower(name), strings.ToLower(candidate)); distance < bestDistance {
+			best, bestDistance = candidate, distance
+		}
+	}
+	return best
+}
+
+// editDistance is the Levenshtein distance between a and b
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+func fieldPath(path string) string {
+	if path == "" {
+		return "(root)"
+	}
+	return strings.TrimPrefix(path, ".")
+}
+
+func describe(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return fmt.Sprintf("string %q", v)
+	case int64, float64:
+		return fmt.Sprintf("number %v", v)
+	case bool:
+		return fmt.Sprintf("%t", v)
+	case []interface{}:
+		return "a list"
+	case map[string]interface{}:
+		return "an object"
+	}
+	return fmt.Sprintf("%T", value)
+}
@@ -0,0 +1,130 @@
+package k8s
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer/yaml"
+)
+
+// testDocument is a trimmed-down apps/v1 document in the shape the API server serves
+const testDocument = `{
+  "components": {
+    "schemas": {
+      "io.k8s.api.apps.v1.Deployment": {
+        "type": "object",
+        "properties": {
+          "apiVersion": {"type": "string"},
+          "kind": {"type": "string"},
+          "metadata": {"allOf": [{"$ref": "#/components/schemas/io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta"}], "default": {}},
+          "spec": {"allOf": [{"$ref": "#/components/schemas/io.k8s.api.apps.v1.DeploymentSpec"}], "default": {}}
+        },
+        "x-kubernetes-group-version-kind": [{"group": "apps", "version": "v1", "kind": "Deployment"}]
+      },
+      "io.k8s.api.apps.v1.DeploymentSpec": {
+        "type": "object",
+        "required": ["selector"],
+        "properties": {
+          "replicas": {"type": "integer"},
+          "selector": {"type": "object", "properties": {"matchLabels": {"type": "object", "additionalProperties": {"type": "string"}}}},
+          "maxUnavailable": {"allOf": [{"$ref": "#/components/schemas/io.k8s.apimachinery.pkg.util.intstr.IntOrString"}]},
+          "template": {"type": "object", "x-kubernetes-preserve-unknown-fields": true}
+        }
+      },
+      "io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta": {
+        "type": "object",
+        "properties": {
+          "name": {"type": "string"},
+          "labels": {"type": "object", "additionalProperties": {"type": "string"}}
+        }
+      },
+      "io.k8s.apimachinery.pkg.util.intstr.IntOrString": {
+        "type": "string",
+        "format": "int-or-string",
+        "x-kubernetes-int-or-string": true
+      }
+    }
+  }
+}`
+
+func TestValidateSchema(t *testing.T) {
+	var doc openAPIDocument
+	if err := json.Unmarshal([]byte(testDocument), &doc); err != nil {
+		t.Fatalf("failed to parse test document: %v", err)
+	}
+	kindSchema := doc.kindSchema(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"})
+	if kindSchema == nil {
+		t.Fatal("Deployment schema not found")
+	}
+
+	tests := []struct {
+		name     string
+		manifest string
+		want     []string
+	}{
+		{
+			name: "valid",
+			manifest: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: api
+  labels:
+    app: api
+spec:
+  replicas: 3
+  maxUnavailable: 25%
+  selector:
+    matchLabels:
+      app: api
+  template:
+    anything: goes`,
+		},
+		{
+			name: "misspelled field",
+			manifest: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: api
+spec:
+  replica: 3
+  selector: {}`,
+			want: []string{"spec.replica: unknown field, did you mean replicas?"},
+		},
+		{
+			name: "wrong types and missing required field",
+			manifest: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: api
+  labels:
+    tier: 1
+spec:
+  replicas: "3"
+  maxUnavailable: true`,
+			want: []string{
+				"metadata.labels.tier: expected a string, got number 1",
+				`spec.maxUnavailable: expected an integer or a string, got true`,
+				`spec.replicas: expected an integer, got string "3"`,
+				"spec.selector: required field is missing",
+			},
+		},
+	}
+
+	decoder := yaml.NewDecodingSerializer(unstructured.UnstructuredJSONScheme)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := &unstructured.Unstructured{}
+			if _, _, err := decoder.Decode([]byte(tt.manifest), nil, obj); err != nil {
+				t.Fatalf("failed to decode manifest: %v", err)
+			}
+
+			got := doc.validate(kindSchema, obj.Object, "")
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("validate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
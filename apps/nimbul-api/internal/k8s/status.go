@@ -0,0 +1,219 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+)
+
+// maxEventsPerResource caps how many recent events are returned for each resource
+const maxEventsPerResource = 5
+
+// ResourceCondition is a single entry of a resource's status.conditions
+type ResourceCondition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// ResourceEvent is a recent Kubernetes event involving a resource
+type ResourceEvent struct {
+	Type     string    `json:"type"`
+	Reason   string    `json:"reason"`
+	Message  string    `json:"message"`
+	Count    int32     `json:"count"`
+	LastSeen time.Time `json:"last_seen"`
+}
+
+// ResourceStatus is the live state of an applied resource
+type ResourceStatus struct {
+	ResourceRef
+	Exists        bool                `json:"exists"`
+	Ready         bool                `json:"ready"`
+	Replicas      *int64              `json:"replicas,omitempty"`
+	ReadyReplicas *int64              `json:"ready_replicas,omitempty"`
+	Conditions    []ResourceCondition `json:"conditions,omitempty"`
+	Events        []ResourceEvent     `json:"events,omitempty"`
+	Error         string              `json:"error,omitempty"`
+}
+
+// GetResourceStatuses fetches the live status of each resource from the cluster
+// described by config. Lookup failures of a single resource are reported in its
+// Error field rather than failing the whole request.
+func GetResourceStatuses(ctx context.Context, config *rest.Config, refs []ResourceRef) ([]ResourceStatus, error) {
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dynamic client: %w", err)
+	}
+
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client: %w", err)
+	}
+
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create discovery client: %w", err)
+	}
+
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient))
+
+	statuses := make([]ResourceStatus, len(refs))
+	for i, ref := range refs {
+		statuses[i] = resourceStatus(ctx, dynamicClient, clientset, mapper, ref)
+	}
+
+	return statuses, nil
+}
+
+// resourceStatus looks up a single resource and its recent events
+func resourceStatus(ctx context.Context, dynamicClient dynamic.Interface, clientset kubernetes.Interface, mapper meta.RESTMapper, ref ResourceRef) ResourceStatus {
+	status := ResourceStatus{ResourceRef: ref}
+
+	gv, err := schema.ParseGroupVersion(ref.APIVersion)
+	if err != nil {
+		status.Error = fmt.Sprintf("invalid apiVersion: %v", err)
+		return status
+	}
+
+	mapping, err := mapper.RESTMapping(schema.GroupKind{Group: gv.Group, Kind: ref.Kind}, gv.Version)
+	if err != nil {
+		status.Error = fmt.Sprintf("failed to find REST mapping: %v", err)
+		return status
+	}
+
+	var dr dynamic.ResourceInterface
+	if ref.Namespace != "" {
+		dr = dynamicClient.Resource(mapping.Resource).Namespace(ref.Namespace)
+	} else {
+		dr = dynamicClient.Resource(mapping.Resource)
+	}
+
+	obj, err := dr.Get(ctx, ref.Name, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			status.Error = err.Error()
+		}
+		return status
+	}
+
+	status.Exists = true
+	status.Conditions = objectConditions(obj)
+
+	if replicas, found, _ := unstructured.NestedInt64(obj.Object, "status", "replicas"); found {
+		status.Replicas = &replicas
+		readyReplicas, _, _ := unstructured.NestedInt64(obj.Object, "status", "readyReplicas")
+		status.ReadyReplicas = &readyReplicas
+	}
+
+	status.Ready = isReady(status)
+
+	events, err := resourceEvents(ctx, clientset, ref)
+	if err != nil {
+		status.Error = fmt.Sprintf("failed to list events: %v", err)
+	}
+	status.Events = events
+
+	return status
+}
+
+// objectConditions extracts status.conditions from an unstructured object
+func objectConditions(obj *unstructured.Unstructured) []ResourceCondition {
+	rawConditions, found, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	if err != nil || !found {
+		return nil
+	}
+
+	var conditions []ResourceCondition
+	for _, raw := range rawConditions {
+		condition, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		conditions = append(conditions, ResourceCondition{
+			Type:    stringField(condition, "type"),
+			Status:  stringField(condition, "status"),
+			Reason:  stringField(condition, "reason"),
+			Message: stringField(condition, "message"),
+		})
+	}
+
+	return conditions
+}
+
+// isReady derives readiness from the Ready/Available conditions, falling back to
+// replica counts, and treats resources without either as ready once they exist
+func isReady(status ResourceStatus) bool {
+	for _, condition := range status.Conditions {
+		if condition.Type == "Ready" || condition.Type == "Available" {
+			return condition.Status == "True"
+		}
+	}
+
+	if status.Replicas != nil && status.ReadyReplicas != nil {
+		return *status.ReadyReplicas >= *status.Replicas
+	}
+
+	return status.Exists
+}
+
+// resourceEvents returns the most recent events involving a resource, newest first
+func resourceEvents(ctx context.Context, clientset kubernetes.Interface, ref ResourceRef) ([]ResourceEvent, error) {
+	selector := fields.Set{
+		"involvedObject.kind": ref.Kind,
+		"involvedObject.name": ref.Name,
+	}.AsSelector().String()
+
+	eventList, err := clientset.CoreV1().Events(ref.Namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: selector,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]ResourceEvent, 0, len(eventList.Items))
+	for _, event := range eventList.Items {
+		lastSeen := event.LastTimestamp.Time
+		if lastSeen.IsZero() {
+			lastSeen = event.EventTime.Time
+		}
+		events = append(events, ResourceEvent{
+			Type:     event.Type,
+			Reason:   event.Reason,
+			Message:  event.Message,
+			Count:    event.Count,
+			LastSeen: lastSeen,
+		})
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].LastSeen.After(events[j].LastSeen)
+	})
+
+	if len(events) > maxEventsPerResource {
+		events = events[:maxEventsPerResource]
+	}
+
+	return events, nil
+}
+
+// stringField returns a string value from a map, or "" when missing
+func stringField(m map[string]interface{}, key string) string {
+	value, _ := m[key].(string)
+	return value
+}
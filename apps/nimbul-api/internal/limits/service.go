@@ -0,0 +1,276 @@
+package limits
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var (
+	ErrInvalidLimit       = errors.New("limits must be positive, or unset for unlimited")
+	ErrInvalidQuota       = errors.New("soft quota must not be above the hard quota")
+	ErrConfigLimitReached = errors.New("config limit reached")
+	ErrOwnerNotFound      = errors.New("owner not found")
+)
+
+type Service struct {
+	queries *db.Queries
+}
+
+func NewService(queries *db.Queries) *Service {
+	return &Service{
+		queries: queries,
+	}
+}
+
+// Limits are instance-wide limits set by admins. A nil limit is unlimited.
+type Limits struct {
+	MaxConfigsPerUser *int
+	// MaxBuildMinutes is the longest a single build may run
+	MaxBuildMinutes *int
+	// MaxLogMB and MaxArtifactMB cap the log and artifacts a single build stores
+	MaxLogMB      *int
+	MaxArtifactMB *int
+	// Usage quotas per owner. Build minutes and deploys are counted per calendar month.
+	BuildMinutesQuota Quota
+	DeploysQuota      Quota
+	StorageMBQuota    Quota
+	// Usage quotas per config, so one repository cannot use up its owner's quotas
+	ConfigBuildMinutesQuota Quota
+	ConfigStorageMBQuota    Quota
+	// Retention is how long finished builds and their logs are kept
+	Retention Retention
+	UpdatedAt *time.Time
+}
+
+// Retention is how many days finished builds and their logs are kept before the
+// cleanup deletes them; past deployments follow the build retention. A nil period keeps
+// them forever, or for an owner's override, uses the instance setting.
+type Retention struct {
+	BuildDays *int
+	LogDays   *int
+}
+
+// Quota caps the usage of each owner. Exceeding Soft only warns; exceeding Hard
+// blocks new builds. A nil bound is not enforced.
+type Quota struct {
+	Soft *int
+	Hard *int
+}
+
+// GetLimits retrieves the instance limits
+func (s *Service) GetLimits(ctx context.Context) (*Limits, error) {
+	limits, err := s.queries.GetInstanceLimits(ctx)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &Limits{}, nil
+		}
+		return nil, fmt.Errorf("failed to get instance limits: %w", err)
+	}
+
+	return dbLimitsToLimits(limits), nil
+}
+
+// UpdateLimits replaces the instance limits
+func (s *Service) UpdateLimits(ctx context.Context, limits Limits) (*Limits, error) {
+	quotas := []Quota{
+		limits.BuildMinutesQuota, limits.DeploysQuota, limits.StorageMBQuota,
+		limits.ConfigBuildMinutesQuota, limits.ConfigStorageMBQuota,
+	}
+
+	values := []*int{
+		limits.MaxConfigsPerUser, limits.MaxBuildMinutes, limits.MaxLogMB, limits.MaxArtifactMB,
+		limits.Retention.BuildDays, limits.Retention.LogDays,
+	}
+	for _, quota := range quotas {
+		values = append(values, quota.Soft, quota.Hard)
+	}
+	for _, limit := range values {
+		if limit != nil && *limit <= 0 {
+			return nil, ErrInvalidLimit
+		}
+	}
+
+	for _, quota := range quotas {
+		if quota.Soft != nil && quota.Hard != nil && *quota.Soft > *quota.Hard {
+			return nil, ErrInvalidQuota
+		}
+	}
+
+	updated, err := s.queries.UpdateInstanceLimits(ctx, db.UpdateInstanceLimitsParams{
+		MaxConfigsPerUser:           toInt4(limits.MaxConfigsPerUser),
+		MaxBuildMinutes:             toInt4(limits.MaxBuildMinutes),
+		BuildMinutesSoftQuota:       toInt4(limits.BuildMinutesQuota.Soft),
+		BuildMinutesHardQuota:       toInt4(limits.BuildMinutesQuota.Hard),
+		DeploysSoftQuota:            toInt4(limits.DeploysQuota.Soft),
+		DeploysHardQuota:            toInt4(limits.DeploysQuota.Hard),
+		StorageMbSoftQuota:          toInt4(limits.StorageMBQuota.Soft),
+		StorageMbHardQuota:          toInt4(limits.StorageMBQuota.Hard),
+		MaxLogMb:                    toInt4(limits.MaxLogMB),
+		MaxArtifactMb:               toInt4(limits.MaxArtifactMB),
+		ConfigBuildMinutesSoftQuota: toInt4(limits.ConfigBuildMinutesQuota.Soft),
+		ConfigBuildMinutesHardQuota: toInt4(limits.ConfigBuildMinutesQuota.Hard),
+		ConfigStorageMbSoftQuota:    toInt4(limits.ConfigStorageMBQuota.Soft),
+		ConfigStorageMbHardQuota:    toInt4(limits.ConfigStorageMBQuota.Hard),
+		BuildRetentionDays:          toInt4(limits.Retention.BuildDays),
+		LogRetentionDays:            toInt4(limits.Retention.LogDays),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update instance limits: %w", err)
+	}
+
+	return dbLimitsToLimits(updated), nil
+}
+
+// GetOwnerRetention retrieves the retention override of an owner
+func (s *Service) GetOwnerRetention(ctx context.Context, ownerID string) (*Retention, error) {
+	user, err := s.queries.GetUserByID(ctx, ownerID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrOwnerNotFound
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	return &Retention{
+		BuildDays: fromInt4(user.BuildRetentionDays),
+		LogDays:   fromInt4(user.LogRetentionDays),
+	}, nil
+}
+
+// SetOwnerRetention replaces the retention override of an owner
+func (s *Service) SetOwnerRetention(ctx context.Context, ownerID string, retention Retention) (*Retention, error) {
+	for _, days := range []*int{retention.BuildDays, retention.LogDays} {
+		if days != nil && *days <= 0 {
+			return nil, ErrInvalidLimit
+		}
+	}
+
+	user, err := s.queries.SetUserRetention(ctx, db.SetUserRetentionParams{
+		ID:                 ownerID,
+		BuildRetentionDays: toInt4(retention.BuildDays),
+		LogRetentionDays:   toInt4(retention.LogDays),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrOwnerNotFound
+		}
+		return nil, fmt.Errorf("failed to set retention: %w", err)
+	}
+
+	return &Retention{
+		BuildDays: fromInt4(user.BuildRetentionDays),
+		LogDays:   fromInt4(user.LogRetentionDays),
+	}, nil
+}
+
+// CheckConfigLimit returns ErrConfigLimitReached when ownerID may not create another config
+func (s *Service) CheckConfigLimit(ctx context.Context, ownerID string) error {
+	limits, err := s.GetLimits(ctx)
+	if err != nil {
+		return err
+	}
+
+	if limits.MaxConfigsPerUser == nil {
+		return nil
+	}
+
+	count, err := s.queries.CountConfigsByOwnerID(ctx, ownerID)
+	if err != nil {
+		return fmt.Errorf("failed to count configs: %w", err)
+	}
+
+	if count >= int64(*limits.MaxConfigsPerUser) {
+		return fmt.Errorf("%w: at most %d configs per user", ErrConfigLimitReached, *limits.MaxConfigsPerUser)
+	}
+
+	return nil
+}
+
+// BuildTimeout returns how long a single build may run, or 0 when builds are unlimited
+func (s *Service) BuildTimeout(ctx context.Context) (time.Duration, error) {
+	limits, err := s.GetLimits(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if limits.MaxBuildMinutes == nil {
+		return 0, nil
+	}
+
+	return time.Duration(*limits.MaxBuildMinutes) * time.Minute, nil
+}
+
+// BuildStorageLimits returns how many bytes of log and of artifacts a single build may
+// store, 0 when unlimited
+func (s *Service) BuildStorageLimits(ctx context.Context) (logBytes, artifactBytes int64, err error) {
+	limits, err := s.GetLimits(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if limits.MaxLogMB != nil {
+		logBytes = int64(*limits.MaxLogMB) * 1024 * 1024
+	}
+	if limits.MaxArtifactMB != nil {
+		artifactBytes = int64(*limits.MaxArtifactMB) * 1024 * 1024
+	}
+	return logBytes, artifactBytes, nil
+}
+
+// toInt4 converts an optional limit to a nullable integer
+func toInt4(limit *int) pgtype.Int4 {
+	if limit == nil {
+		return pgtype.Int4{}
+	}
+	return pgtype.Int4{Int32: int32(*limit), Valid: true}
+}
+
+// fromInt4 converts a nullable integer to an optional limit
+func fromInt4(value pgtype.Int4) *int {
+	if !value.Valid {
+		return nil
+	}
+	limit := int(value.Int32)
+	return &limit
+}
+
+// dbLimitsToLimits converts a db.InstanceLimit to limits.Limits
+func dbLimitsToLimits(dbLimits db.InstanceLimit) *Limits {
+	return &Limits{
+		MaxConfigsPerUser: fromInt4(dbLimits.MaxConfigsPerUser),
+		MaxBuildMinutes:   fromInt4(dbLimits.MaxBuildMinutes),
+		MaxLogMB:          fromInt4(dbLimits.MaxLogMb),
+		MaxArtifactMB:     fromInt4(dbLimits.MaxArtifactMb),
+		BuildMinutesQuota: Quota{
+			Soft: fromInt4(dbLimits.BuildMinutesSoftQuota),
+			Hard: fromInt4(dbLimits.BuildMinutesHardQuota),
+		},
+		DeploysQuota: Quota{
+			Soft: fromInt4(dbLimits.DeploysSoftQuota),
+			Hard: fromInt4(dbLimits.DeploysHardQuota),
+		},
+		StorageMBQuota: Quota{
+			Soft: fromInt4(dbLimits.StorageMbSoftQuota),
+			Hard: fromInt4(dbLimits.StorageMbHardQuota),
+		},
+		ConfigBuildMinutesQuota: Quota{
+			Soft: fromInt4(dbLimits.ConfigBuildMinutesSoftQuota),
+			Hard: fromInt4(dbLimits.ConfigBuildMinutesHardQuota),
+		},
+		ConfigStorageMBQuota: Quota{
+			Soft: fromInt4(dbLimits.ConfigStorageMbSoftQuota),
+			Hard: fromInt4(dbLimits.ConfigStorageMbHardQuota),
+		},
+		Retention: Retention{
+			BuildDays: fromInt4(dbLimits.BuildRetentionDays),
+			LogDays:   fromInt4(dbLimits.LogRetentionDays),
+		},
+		UpdatedAt: &dbLimits.UpdatedAt.Time,
+	}
+}
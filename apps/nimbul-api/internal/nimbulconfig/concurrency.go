@@ -0,0 +1,16 @@
+package nimbulconfig
+
+// GroupFor returns the concurrency group of a run for branch. Without a configured
+// group, each branch is its own group; runs without a branch are not grouped.
+func (c *ConcurrencyConfig) GroupFor(branch string) string {
+	if c == nil || c.Group == "" {
+		return branch
+	}
+	return c.Group
+}
+
+// CancelsInProgress reports whether a newer run cancels the builds of older runs in
+// its group
+func (c *ConcurrencyConfig) CancelsInProgress() bool {
+	return c == nil || c.CancelInProgress == nil || *c.CancelInProgress
+}
@@ -0,0 +1,26 @@
+package nimbulconfig
+
+import "testing"
+
+func TestConcurrencyGroup(t *testing.T) {
+	var unset *ConcurrencyConfig
+	if unset.GroupFor("main") != "main" || !unset.CancelsInProgress() {
+		t.Error("Expected runs to be grouped by branch and cancelled by default")
+	}
+
+	config, err := RenderConfig(&NimbulConfig{
+		Version:     "1",
+		Concurrency: &ConcurrencyConfig{Group: "deploy-{{ .BRANCH }}"},
+	}, NewTemplateContext("abc123", "main", "acme/api"))
+	if err != nil {
+		t.Fatalf("RenderConfig failed: %v", err)
+	}
+	if group := config.Concurrency.GroupFor("main"); group != "deploy-main" {
+		t.Errorf("Expected rendered group deploy-main, got %q", group)
+	}
+
+	keep := false
+	if (&ConcurrencyConfig{CancelInProgress: &keep}).CancelsInProgress() {
+		t.Error("Expected cancelInProgress: false to keep older runs")
+	}
+}
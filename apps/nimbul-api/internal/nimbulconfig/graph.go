@@ -0,0 +1,61 @@
+package nimbulconfig
+
+import (
+	"fmt"
+	"strings"
+)
+
+// BuildOrder sorts builds so each comes after the builds it depends on, keeping the
+// order of nimbul.yaml otherwise. Returns an error for unknown dependencies and cycles.
+func BuildOrder(builds []BuildConfig) ([]BuildConfig, error) {
+	index := make(map[string]int, len(builds))
+	for i, build := range builds {
+		index[build.Name] = i
+	}
+
+	for i, build := range builds {
+		for _, dep := range build.DependsOn {
+			if _, ok := index[dep]; !ok {
+				return nil, fmt.Errorf("build[%d]: dependsOn '%s' not found", i, dep)
+			}
+		}
+	}
+
+	ordered := make([]BuildConfig, 0, len(builds))
+	done := make([]bool, len(builds))
+	for len(ordered) < len(builds) {
+		// Take the first build whose dependencies are all done
+		next := -1
+		for i, build := range builds {
+			if done[i] {
+				continue
+			}
+			ready := true
+			for _, dep := range build.DependsOn {
+				if !done[index[dep]] {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				next = i
+				break
+			}
+		}
+
+		if next == -1 {
+			var cycle []string
+			for i, build := range builds {
+				if !done[i] {
+					cycle = append(cycle, build.Name)
+				}
+			}
+			return nil, fmt.Errorf("builds depend on each other in a cycle: %s", strings.Join(cycle, ", "))
+		}
+
+		done[next] = true
+		ordered = append(ordered, builds[next])
+	}
+
+	return ordered, nil
+}
@@ -0,0 +1,69 @@
+package nimbulconfig
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBuildOrder(t *testing.T) {
+	ordered, err := BuildOrder([]BuildConfig{
+		{Name: "api", DependsOn: []string{"base"}},
+		{Name: "worker", DependsOn: []string{"base", "api"}},
+		{Name: "base"},
+		{Name: "docs"},
+	})
+	if err != nil {
+		t.Fatalf("BuildOrder failed: %v", err)
+	}
+
+	var names []string
+	for _, build := range ordered {
+		names = append(names, build.Name)
+	}
+	if got := strings.Join(names, ","); got != "base,api,worker,docs" {
+		t.Errorf("Expected base,api,worker,docs, got %s", got)
+	}
+
+	_, err = BuildOrder([]BuildConfig{
+		{Name: "a", DependsOn: []string{"b"}},
+		{Name: "b", DependsOn: []string{"a"}},
+	})
+	if err == nil || !strings.Contains(err.Error(), "cycle") {
+		t.Errorf("Expected cycle error, got %v", err)
+	}
+
+	_, err = BuildOrder([]BuildConfig{{Name: "a", DependsOn: []string{"missing"}}})
+	if err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("Expected unknown dependency error, got %v", err)
+	}
+}
+
+func TestRenderConfigDependsOn(t *testing.T) {
+	config := &NimbulConfig{
+		Version: "1",
+		Build: []BuildConfig{
+			{Name: "base", Dockerfile: "Dockerfile.base", Tags: []string{"ghcr.io/acme/base:{{ .COMMIT_SHORT }}"}},
+			{
+				Name:       "api",
+				Dockerfile: "Dockerfile",
+				Tags:       []string{"ghcr.io/acme/api:{{ .COMMIT_SHORT }}"},
+				DependsOn:  []string{"base"},
+				Args:       map[string]string{"BASE_IMAGE": "{{ .DEPENDS_ON.base }}"},
+			},
+		},
+	}
+
+	rendered, err := RenderConfig(config, NewTemplateContext("abc123", "main", "acme/api"))
+	if err != nil {
+		t.Fatalf("RenderConfig failed: %v", err)
+	}
+	if got := rendered.Build[1].Args["BASE_IMAGE"]; got != "ghcr.io/acme/base:abc123" {
+		t.Errorf("Expected base tag as build arg, got %q", got)
+	}
+
+	// Only dependencies are available
+	config.Build[1].DependsOn = nil
+	if _, err := RenderConfig(config, NewTemplateContext("abc123", "main", "acme/api")); err == nil {
+		t.Error("Expected error for a build arg referring to a build it does not depend on")
+	}
+}
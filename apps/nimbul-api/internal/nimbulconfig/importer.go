@@ -0,0 +1,635 @@
+package nimbulconfig
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Import sources, in the order they are tried
+const (
+	ImportSourceWorkflow   = "github-actions"
+	ImportSourceCompose    = "docker-compose"
+	ImportSourceDockerfile = "dockerfile"
+)
+
+// ManifestDir is where Import writes generated Kubernetes manifests, relative to the repository
+const ManifestDir = "k8s"
+
+// ImportOptions tune how existing CI configuration is translated
+type ImportOptions struct {
+	// Registry prefixes generated image tags, e.g. "ghcr.io/acme". Tags found in
+	// the imported configuration are kept as they are.
+	Registry string
+}
+
+// ImportResult is a nimbul.yaml generated from existing CI configuration
+type ImportResult struct {
+	Source string
+	Config *NimbulConfig
+	// Manifests maps repository-relative paths to generated Kubernetes manifests
+	// referenced by Config's deploy stage
+	Manifests map[string][]byte
+	// Notes explain what could not be translated and needs a manual look
+	Notes []string
+}
+
+// importedBuild is a build found in existing CI configuration, with what is known
+// about running its image
+type importedBuild struct {
+	build BuildConfig
+	port  int
+	env   map[string]string
+}
+
+// Import generates a nimbul.yaml for the repository at repoDir from, in order of
+// preference, a GitHub Actions docker build job, a docker-compose file, or the
+// Dockerfiles in the repository. Each build gets a Deployment (and a Service when a
+// port is known) so the result can be deployed right away.
+func Import(repoDir string, opts ImportOptions) (*ImportResult, error) {
+	importers := []struct {
+		source string
+		find   func(repoDir string, opts ImportOptions) ([]importedBuild, []string, error)
+	}{
+		{ImportSourceWorkflow, importWorkflows},
+		{ImportSourceCompose, importCompose},
+		{ImportSourceDockerfile, importDockerfiles},
+	}
+
+	for _, importer := range importers {
+		builds, notes, err := importer.find(repoDir, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to import %s configuration: %w", importer.source, err)
+		}
+		if len(builds) == 0 {
+			continue
+		}
+
+		result := &ImportResult{
+			Source:    importer.source,
+			Config:    &NimbulConfig{Version: "1"},
+			Manifests: make(map[string][]byte),
+			Notes:     notes,
+		}
+
+		for _, b := range builds {
+			// Fall back to the Dockerfile when the CI configuration did not say which port to expose
+			if b.port == 0 {
+				b.port = exposedPort(filepath.Join(repoDir, filepath.FromSlash(b.build.Dockerfile)))
+			}
+
+			manifestPath := path.Join(ManifestDir, b.build.Name+".yaml")
+			manifest, err := generateManifest(b)
+			if err != nil {
+				return nil, err
+			}
+
+			result.Config.Build = append(result.Config.Build, b.build)
+			result.Config.Deploy = append(result.Config.Deploy, DeployConfig{
+				Name:    "deploy-" + b.build.Name,
+				BuildID: b.build.Name,
+				Manifests: []ManifestConfig{{
+					Path: manifestPath,
+					Overrides: []OverrideConfig{{
+						Path:  "spec.template.spec.containers[0].image",
+						Match: MatchConfig{Kind: "Deployment", Name: b.build.Name},
+						Value: "{{ .BUILD_TAG[0] }}",
+					}},
+				}},
+			})
+			result.Manifests[manifestPath] = manifest
+		}
+
+		if err := Validate(result.Config); err != nil {
+			return nil, fmt.Errorf("generated nimbul.yaml is invalid: %w", err)
+		}
+
+		return result, nil
+	}
+
+	return nil, fmt.Errorf("no GitHub Actions docker build, docker-compose file or Dockerfile found in %s", repoDir)
+}
+
+// workflowFile is the subset of a GitHub Actions workflow Import reads
+type workflowFile struct {
+	Jobs map[string]struct {
+		Steps []struct {
+			Uses string         `yaml:"uses"`
+			With map[string]any `yaml:"with"`
+			Run  string         `yaml:"run"`
+		} `yaml:"steps"`
+	} `yaml:"jobs"`
+}
+
+// importWorkflows finds docker/build-push-action steps and docker build commands in .github/workflows
+func importWorkflows(repoDir string, opts ImportOptions) ([]importedBuild, []string, error) {
+	paths, _ := filepath.Glob(filepath.Join(repoDir, ".github", "workflows", "*.y*ml"))
+	sort.Strings(paths)
+
+	var builds []importedBuild
+	var notes []string
+	names := make(map[string]bool)
+
+	for _, workflowPath := range paths {
+		data, err := os.ReadFile(workflowPath)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var workflow workflowFile
+		if err := yaml.Unmarshal(data, &workflow); err != nil {
+			notes = append(notes, fmt.Sprintf("skipped %s: %v", filepath.Base(workflowPath), err))
+			continue
+		}
+
+		jobNames := make([]string, 0, len(workflow.Jobs))
+		for name := range workflow.Jobs {
+			jobNames = append(jobNames, name)
+		}
+		sort.Strings(jobNames)
+
+		for _, jobName := range jobNames {
+			for _, step := range workflow.Jobs[jobName].Steps {
+				var found []dockerBuild
+				switch {
+				case strings.HasPrefix(step.Uses, "docker/build-push-action"):
+					found = append(found, buildPushActionBuild(step.With))
+				case step.Run != "":
+					found = parseDockerBuildCommands(step.Run)
+				}
+
+				for _, db := range found {
+					build, buildNotes := db.toBuildConfig(names, opts)
+					builds = append(builds, importedBuild{build: build})
+					notes = append(notes, buildNotes...)
+				}
+			}
+		}
+	}
+
+	return builds, notes, nil
+}
+
+// composeFile is the subset of a docker-compose file Import reads
+type composeFile struct {
+	Services map[string]struct {
+		Build       any    `yaml:"build"`
+		Image       string `yaml:"image"`
+		Ports       []any  `yaml:"ports"`
+		Environment any    `yaml:"environment"`
+	} `yaml:"services"`
+}
+
+// composeFileNames are the docker-compose file names looked for at the repository root
+var composeFileNames = []string{"compose.yaml", "compose.yml", "docker-compose.yaml", "docker-compose.yml"}
+
+// importCompose turns every docker-compose service with a build section into a build.
+// Services that only run an existing image are reported in the notes.
+func importCompose(repoDir string, opts ImportOptions) ([]importedBuild, []string, error) {
+	var data []byte
+	var composeName string
+	for _, name := range composeFileNames {
+		content, err := os.ReadFile(filepath.Join(repoDir, name))
+		if err == nil {
+			data, composeName = content, name
+			break
+		}
+	}
+	if data == nil {
+		return nil, nil, nil
+	}
+
+	var compose composeFile
+	if err := yaml.Unmarshal(data, &compose); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse %s: %w", composeName, err)
+	}
+
+	serviceNames := make([]string, 0, len(compose.Services))
+	for name := range compose.Services {
+		serviceNames = append(serviceNames, name)
+	}
+	sort.Strings(serviceNames)
+
+	var builds []importedBuild
+	var notes []string
+	names := make(map[string]bool)
+
+	for _, serviceName := range serviceNames {
+		service := compose.Services[serviceName]
+
+		db := dockerBuild{name: serviceName, context: "."}
+		switch build := service.Build.(type) {
+		case nil:
+			if service.Image != "" {
+				notes = append(notes, fmt.Sprintf("service %s runs the existing image %s and was not imported; deploy it separately", serviceName, service.Image))
+			}
+			continue
+		case string:
+			db.context = build
+		case map[string]any:
+			if context, ok := build["context"].(string); ok {
+				db.context = context
+			}
+			if dockerfile, ok := build["dockerfile"].(string); ok {
+				db.dockerfile = path.Join(cleanRepoPath(db.context), dockerfile)
+			}
+		}
+		if service.Image != "" {
+			db.tags = []string{service.Image}
+		}
+
+		build, buildNotes := db.toBuildConfig(names, opts)
+		notes = append(notes, buildNotes...)
+
+		imported := importedBuild{
+			build: build,
+			env:   composeEnvironment(service.Environment),
+		}
+		if len(service.Ports) > 0 {
+			imported.port = composePort(service.Ports[0])
+			if len(service.Ports) > 1 {
+				notes = append(notes, fmt.Sprintf("service %s publishes several ports; only the first is exposed by its Service", serviceName))
+			}
+		}
+
+		builds = append(builds, imported)
+	}
+
+	return builds, notes, nil
+}
+
+// skippedDirs are never searched for Dockerfiles
+var skippedDirs = map[string]bool{"node_modules": true, "vendor": true}
+
+// maxDockerfileDepth limits how deep importDockerfiles looks for Dockerfiles
+const maxDockerfileDepth = 3
+
+// importDockerfiles turns every Dockerfile near the top of the repository into a build
+func importDockerfiles(repoDir string, opts ImportOptions) ([]importedBuild, []string, error) {
+	var found []string
+	err := filepath.WalkDir(repoDir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, _ := filepath.Rel(repoDir, p)
+		rel = filepath.ToSlash(rel)
+
+		if d.IsDir() {
+			if rel != "." && (strings.HasPrefix(d.Name(), ".") || skippedDirs[d.Name()] || strings.Count(rel, "/") >= maxDockerfileDepth) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if d.Name() == "Dockerfile" {
+			found = append(found, rel)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var builds []importedBuild
+	var notes []string
+	names := make(map[string]bool)
+
+	for _, dockerfile := range found {
+		context := path.Dir(dockerfile)
+		name := path.Base(context)
+		if context == "." {
+			name = filepath.Base(repoDir)
+		}
+
+		build, buildNotes := dockerBuild{name: name, context: context, dockerfile: dockerfile}.toBuildConfig(names, opts)
+		builds = append(builds, importedBuild{build: build})
+		notes = append(notes, buildNotes...)
+	}
+
+	return builds, notes, nil
+}
+
+// dockerBuild is a docker build found in CI configuration, before it is turned into a BuildConfig.
+// Paths are relative to the repository root.
+type dockerBuild struct {
+	name       string
+	context    string
+	dockerfile string
+	tags       []string
+}
+
+// buildPushActionBuild reads the inputs of a docker/build-push-action step
+func buildPushActionBuild(with map[string]any) dockerBuild {
+	db := dockerBuild{context: "."}
+	if context, ok := with["context"].(string); ok && context != "" {
+		db.context = context
+	}
+	if file, ok := with["file"].(string); ok {
+		db.dockerfile = file
+	}
+	if tags, ok := with["tags"].(string); ok {
+		db.tags = strings.FieldsFunc(tags, func(r rune) bool { return r == '\n' || r == ',' })
+	}
+	return db
+}
+
+// parseDockerBuildCommands finds `docker build` and `docker buildx build` commands in a shell script
+func parseDockerBuildCommands(script string) []dockerBuild {
+	// Join continued lines so each command is on one line
+	script = strings.ReplaceAll(script, "\\\n", " ")
+
+	var builds []dockerBuild
+	for _, line := range strings.Split(script, "\n") {
+		fields := strings.Fields(line)
+
+		start := -1
+		for i := 0; i+1 < len(fields); i++ {
+			if fields[i] == "docker" && fields[i+1] == "build" {
+				start = i + 2
+			} else if i+2 < len(fields) && fields[i] == "docker" && fields[i+1] == "buildx" && fields[i+2] == "build" {
+				start = i + 3
+			}
+			if start >= 0 {
+				break
+			}
+		}
+		if start < 0 {
+			continue
+		}
+
+		db := dockerBuild{context: "."}
+		for i := start; i < len(fields); i++ {
+			field := strings.Trim(fields[i], `"'`)
+			flag, value, hasValue := strings.Cut(field, "=")
+			switch {
+			case flag == "-t" || flag == "--tag" || flag == "-f" || flag == "--file":
+				if !hasValue && i+1 < len(fields) {
+					i++
+					value = strings.Trim(fields[i], `"'`)
+				}
+				if flag == "-t" || flag == "--tag" {
+					db.tags = append(db.tags, value)
+				} else {
+					db.dockerfile = value
+				}
+			case strings.HasPrefix(field, "-"):
+				// Skip the value of other flags, except the ones that take none
+				if !hasValue && !dockerBuildBoolFlags[field] && i+1 < len(fields) {
+					i++
+				}
+			case field == "&&" || field == ";" || field == "|":
+				i = len(fields)
+			default:
+				db.context = field
+			}
+		}
+
+		builds = append(builds, db)
+	}
+
+	return builds
+}
+
+// dockerBuildBoolFlags are docker build flags that take no value
+var dockerBuildBoolFlags = map[string]bool{
+	"--push": true, "--load": true, "--no-cache": true, "--pull": true,
+	"-q": true, "--quiet": true, "--rm": true, "--force-rm": true,
+}
+
+// githubExpressions maps CI variables to nimbul.yaml template variables
+var githubExpressions = []struct {
+	pattern *regexp.Regexp
+	value   string
+}{
+	{regexp.MustCompile(`\$\{\{\s*github\.sha\s*\}\}|\$\{?GITHUB_SHA\}?`), "{{ .COMMIT_SHA }}"},
+	{regexp.MustCompile(`\$\{\{\s*github\.ref_name\s*\}\}|\$\{?GITHUB_REF_NAME\}?`), "{{ .BRANCH }}"},
+	{regexp.MustCompile(`\$\{\{\s*github\.repository\s*\}\}|\$\{?GITHUB_REPOSITORY\}?`), "{{ .REPO }}"},
+	{regexp.MustCompile(`\$\{\{\s*github\.actor\s*\}\}|\$\{?GITHUB_ACTOR\}?`), "{{ .ACTOR }}"},
+	{regexp.MustCompile(`\$\{\{\s*github\.event\.(pull_request\.)?number\s*\}\}`), "{{ .PR_NUMBER }}"},
+}
+
+// unresolvedVariable matches CI expressions and shell variables left after translation
+var unresolvedVariable = regexp.MustCompile(`\$\{\{|\$\{?[A-Za-z_]`)
+
+// toBuildConfig turns a docker build into a BuildConfig with a unique name, translating
+// CI variables in its tags. Tags that cannot be translated are replaced by a default tag.
+func (db dockerBuild) toBuildConfig(names map[string]bool, opts ImportOptions) (BuildConfig, []string) {
+	var notes []string
+
+	db.context = cleanRepoPath(db.context)
+	if db.dockerfile == "" {
+		db.dockerfile = path.Join(db.context, "Dockerfile")
+	}
+	db.dockerfile = cleanRepoPath(db.dockerfile)
+
+	var tags []string
+	for _, tag := range db.tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+		for _, expr := range githubExpressions {
+			tag = expr.pattern.ReplaceAllString(tag, expr.value)
+		}
+		if unresolvedVariable.MatchString(tag) {
+			notes = append(notes, fmt.Sprintf("dropped tag %s: it uses CI variables nimbul.yaml cannot provide", tag))
+			continue
+		}
+		tags = append(tags, tag)
+	}
+
+	name := db.name
+	if name == "" && len(tags) > 0 {
+		imageName, _, _ := strings.Cut(path.Base(tags[0]), ":")
+		name = imageName
+	}
+	if name == "" || name == "." {
+		name = path.Base(db.context)
+	}
+	name = uniqueName(sanitizeName(name), names)
+
+	if len(tags) == 0 {
+		image := name
+		if opts.Registry != "" {
+			image = strings.TrimSuffix(opts.Registry, "/") + "/" + name
+		}
+		tags = []string{image + ":{{ .COMMIT_SHORT }}"}
+	}
+
+	return BuildConfig{
+		Name:       name,
+		Dockerfile: db.dockerfile,
+		Context:    db.context,
+		Tags:       tags,
+	}, notes
+}
+
+// cleanRepoPath normalizes a path relative to the repository root
+func cleanRepoPath(p string) string {
+	p = path.Clean(strings.TrimPrefix(filepath.ToSlash(p), "./"))
+	if p == "" || p == "/" {
+		return "."
+	}
+	return p
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// sanitizeName turns s into a valid Kubernetes resource name
+func sanitizeName(s string) string {
+	name := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(s), "-"), "-")
+	if len(name) > 50 {
+		name = strings.TrimRight(name[:50], "-")
+	}
+	if name == "" {
+		return "app"
+	}
+	return name
+}
+
+// uniqueName returns name, suffixed with a number when it is already taken, and marks it as taken
+func uniqueName(name string, names map[string]bool) string {
+	unique := name
+	for i := 2; names[unique]; i++ {
+		unique = fmt.Sprintf("%s-%d", name, i)
+	}
+	names[unique] = true
+	return unique
+}
+
+// composePort returns the container port of a docker-compose port mapping
+func composePort(port any) int {
+	switch p := port.(type) {
+	case int:
+		return p
+	case string:
+		p, _, _ = strings.Cut(p, "/")
+		parts := strings.Split(p, ":")
+		var n int
+		fmt.Sscanf(parts[len(parts)-1], "%d", &n)
+		return n
+	case map[string]any:
+		if target, ok := p["target"].(int); ok {
+			return target
+		}
+	}
+	return 0
+}
+
+// composeEnvironment reads a docker-compose environment in list or map form
+func composeEnvironment(environment any) map[string]string {
+	env := make(map[string]string)
+	switch e := environment.(type) {
+	case []any:
+		for _, entry := range e {
+			if s, ok := entry.(string); ok {
+				key, value, _ := strings.Cut(s, "=")
+				env[key] = value
+			}
+		}
+	case map[string]any:
+		for key, value := range e {
+			if value == nil {
+				env[key] = ""
+			} else {
+				env[key] = fmt.Sprint(value)
+			}
+		}
+	}
+	return env
+}
+
+var exposeInstruction = regexp.MustCompile(`(?im)^\s*EXPOSE\s+(\d+)`)
+
+// exposedPort returns the first port a Dockerfile EXPOSEs, or 0
+func exposedPort(dockerfilePath string) int {
+	data, err := os.ReadFile(dockerfilePath)
+	if err != nil {
+		return 0
+	}
+
+	match := exposeInstruction.FindSubmatch(data)
+	if match == nil {
+		return 0
+	}
+
+	var port int
+	fmt.Sscanf(string(match[1]), "%d", &port)
+	return port
+}
+
+// generateManifest renders a Deployment for an imported build, plus a Service when its port is known.
+// The image is a placeholder; the deploy stage overrides it with the build's first tag.
+func generateManifest(b importedBuild) ([]byte, error) {
+	name := b.build.Name
+	labels := map[string]string{"app": name}
+
+	container := map[string]any{
+		"name":  name,
+		"image": name,
+	}
+	if b.port != 0 {
+		container["ports"] = []map[string]any{{"containerPort": b.port}}
+	}
+	if len(b.env) > 0 {
+		keys := make([]string, 0, len(b.env))
+		for key := range b.env {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		env := make([]map[string]string, len(keys))
+		for i, key := range keys {
+			env[i] = map[string]string{"name": key, "value": b.env[key]}
+		}
+		container["env"] = env
+	}
+
+	docs := []map[string]any{{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]any{"name": name, "labels": labels},
+		"spec": map[string]any{
+			"replicas": 1,
+			"selector": map[string]any{"matchLabels": labels},
+			"template": map[string]any{
+				"metadata": map[string]any{"labels": labels},
+				"spec":     map[string]any{"containers": []any{container}},
+			},
+		},
+	}}
+
+	if b.port != 0 {
+		docs = append(docs, map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata":   map[string]any{"name": name, "labels": labels},
+			"spec": map[string]any{
+				"selector": labels,
+				"ports":    []map[string]any{{"port": b.port, "targetPort": b.port}},
+			},
+		})
+	}
+
+	var out strings.Builder
+	for i, doc := range docs {
+		if i > 0 {
+			out.WriteString("---\n")
+		}
+		data, err := marshalYAML(doc)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode manifest for %s: %w", name, err)
+		}
+		out.Write(data)
+	}
+
+	return []byte(out.String()), nil
+}
@@ -0,0 +1,167 @@
+package nimbulconfig
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeFiles creates files with the given contents below dir
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestImportWorkflow(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		".github/workflows/release.yml": `
+jobs:
+  docker:
+    steps:
+      - uses: actions/checkout@v4
+      - uses: docker/build-push-action@v6
+        with:
+          context: ./api
+          file: api/Dockerfile.prod
+          tags: |
+            ghcr.io/acme/api:${{ github.sha }}
+            ghcr.io/acme/api:${{ steps.meta.outputs.version }}
+      - run: |
+          docker build -t ghcr.io/acme/worker:$GITHUB_SHA \
+            --build-arg VERSION=1 -f worker/Dockerfile worker
+`,
+		"api/Dockerfile.prod": "FROM scratch\nEXPOSE 8080\n",
+	})
+
+	result, err := Import(dir, ImportOptions{})
+	if err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+
+	if result.Source != ImportSourceWorkflow {
+		t.Errorf("Expected source %s, got %s", ImportSourceWorkflow, result.Source)
+	}
+
+	if len(result.Config.Build) != 2 {
+		t.Fatalf("Expected 2 builds, got %d", len(result.Config.Build))
+	}
+
+	api := result.Config.Build[0]
+	if api.Name != "api" || api.Context != "api" || api.Dockerfile != "api/Dockerfile.prod" {
+		t.Errorf("Unexpected api build: %+v", api)
+	}
+	if len(api.Tags) != 1 || api.Tags[0] != "ghcr.io/acme/api:{{ .COMMIT_SHA }}" {
+		t.Errorf("Expected translated tag without the untranslatable one, got %v", api.Tags)
+	}
+
+	worker := result.Config.Build[1]
+	if worker.Name != "worker" || worker.Context != "worker" || worker.Dockerfile != "worker/Dockerfile" {
+		t.Errorf("Unexpected worker build: %+v", worker)
+	}
+	if len(worker.Tags) != 1 || worker.Tags[0] != "ghcr.io/acme/worker:{{ .COMMIT_SHA }}" {
+		t.Errorf("Unexpected worker tags: %v", worker.Tags)
+	}
+
+	if len(result.Notes) != 1 {
+		t.Errorf("Expected a note about the dropped tag, got %v", result.Notes)
+	}
+
+	manifest := string(result.Manifests["k8s/api.yaml"])
+	if !strings.Contains(manifest, "kind: Service") || !strings.Contains(manifest, "containerPort: 8080") {
+		t.Errorf("Expected api manifest with the exposed port, got:\n%s", manifest)
+	}
+	if strings.Contains(string(result.Manifests["k8s/worker.yaml"]), "kind: Service") {
+		t.Error("Expected no Service for a build without a known port")
+	}
+}
+
+func TestImportCompose(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"docker-compose.yml": `
+services:
+  web:
+    build:
+      context: ./web
+      dockerfile: Dockerfile.dev
+    ports:
+      - "3000:80"
+    environment:
+      - MODE=production
+  db:
+    image: postgres:16
+`,
+	})
+
+	result, err := Import(dir, ImportOptions{Registry: "ghcr.io/acme/"})
+	if err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+
+	if result.Source != ImportSourceCompose {
+		t.Errorf("Expected source %s, got %s", ImportSourceCompose, result.Source)
+	}
+
+	if len(result.Config.Build) != 1 {
+		t.Fatalf("Expected 1 build, got %d", len(result.Config.Build))
+	}
+
+	web := result.Config.Build[0]
+	if web.Dockerfile != "web/Dockerfile.dev" || web.Tags[0] != "ghcr.io/acme/web:{{ .COMMIT_SHORT }}" {
+		t.Errorf("Unexpected web build: %+v", web)
+	}
+
+	deploy := result.Config.Deploy[0]
+	if deploy.BuildID != "web" || deploy.Manifests[0].Path != "k8s/web.yaml" {
+		t.Errorf("Unexpected deploy: %+v", deploy)
+	}
+
+	manifest := string(result.Manifests["k8s/web.yaml"])
+	for _, want := range []string{"containerPort: 80", "name: MODE", "value: production"} {
+		if !strings.Contains(manifest, want) {
+			t.Errorf("Expected manifest to contain %q, got:\n%s", want, manifest)
+		}
+	}
+
+	if len(result.Notes) != 1 || !strings.Contains(result.Notes[0], "postgres:16") {
+		t.Errorf("Expected a note about the db service, got %v", result.Notes)
+	}
+}
+
+func TestImportDockerfiles(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"Dockerfile":                  "FROM scratch\n",
+		"services/billing/Dockerfile": "FROM scratch\n",
+		"node_modules/pkg/Dockerfile": "FROM scratch\n",
+	})
+
+	result, err := Import(dir, ImportOptions{})
+	if err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+
+	if len(result.Config.Build) != 2 {
+		t.Fatalf("Expected 2 builds, got %+v", result.Config.Build)
+	}
+
+	if result.Config.Build[1].Name != "billing" || result.Config.Build[1].Context != "services/billing" {
+		t.Errorf("Unexpected billing build: %+v", result.Config.Build[1])
+	}
+}
+
+func TestImportNothingFound(t *testing.T) {
+	if _, err := Import(t.TempDir(), ImportOptions{}); err == nil {
+		t.Error("Expected an error for a repository without a build")
+	}
+}
@@ -67,6 +67,133 @@ func ApplyOverrides(docs []map[string]interface{}, overrides []OverrideConfig) e
 	return nil
 }
 
+// clusterScopedKinds are resource kinds that cannot be placed in a namespace
+var clusterScopedKinds = map[string]bool{
+	"Namespace":                      true,
+	"ClusterRole":                    true,
+	"ClusterRoleBinding":             true,
+	"CustomResourceDefinition":       true,
+	"PersistentVolume":               true,
+	"StorageClass":                   true,
+	"IngressClass":                   true,
+	"PriorityClass":                  true,
+	"MutatingWebhookConfiguration":   true,
+	"ValidatingWebhookConfiguration": true,
+}
+
+// SetNamespace places every namespaced document in namespace, replacing any
+// namespace the manifest declared. Cluster-scoped documents are left untouched.
+func SetNamespace(docs []map[string]interface{}, namespace string) {
+	for _, doc := range docs {
+		kind, _ := doc["kind"].(string)
+		if clusterScopedKinds[kind] {
+			continue
+		}
+
+		metadata, ok := doc["metadata"].(map[string]interface{})
+		if !ok {
+			metadata = make(map[string]interface{})
+			doc["metadata"] = metadata
+		}
+		metadata["namespace"] = namespace
+	}
+}
+
+// SetMetadata adds labels and annotations to every document, keeping the ones the
+// manifest declared unless they share a key
+func SetMetadata(docs []map[string]interface{}, labels, annotations map[string]string) {
+	for _, doc := range docs {
+		metadata, ok := doc["metadata"].(map[string]interface{})
+		if !ok {
+			metadata = make(map[string]interface{})
+			doc["metadata"] = metadata
+		}
+		mergeStringMap(metadata, "labels", labels)
+		mergeStringMap(metadata, "annotations", annotations)
+	}
+}
+
+func mergeStringMap(metadata map[string]interface{}, key string, values map[string]string) {
+	if len(values) == 0 {
+		return
+	}
+
+	existing, ok := metadata[key].(map[string]interface{})
+	if !ok {
+		existing = make(map[string]interface{})
+		metadata[key] = existing
+	}
+	for k, v := range values {
+		existing[k] = v
+	}
+}
+
+// containerListKeys are the pod spec fields holding containers
+var containerListKeys = []string{"containers", "initContainers", "ephemeralContainers"}
+
+// ReplaceImages sets the image of every container in docs, at any depth, to the result
+// of replace and returns how many images changed
+func ReplaceImages(docs []map[string]interface{}, replace func(image string) string) int {
+	replaced := 0
+	for _, doc := range docs {
+		replaced += replaceImages(doc, replace)
+	}
+	return replaced
+}
+
+// Images returns the image of every container in docs, at any depth
+func Images(docs []map[string]interface{}) []string {
+	var images []string
+	ReplaceImages(docs, func(image string) string {
+		images = append(images, image)
+		return image
+	})
+	return images
+}
+
+func replaceImages(node interface{}, replace func(image string) string) int {
+	replaced := 0
+	switch node := node.(type) {
+	case map[string]interface{}:
+		for _, key := range containerListKeys {
+			containers, _ := node[key].([]interface{})
+			for _, container := range containers {
+				container, ok := container.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				image, ok := container["image"].(string)
+				if !ok {
+					continue
+				}
+				if newImage := replace(image); newImage != image {
+					container["image"] = newImage
+					replaced++
+				}
+			}
+		}
+		for key, value := range node {
+			if !isContainerListKey(key) {
+				replaced += replaceImages(value, replace)
+			}
+		}
+	case []interface{}:
+		for _, item := range node {
+			replaced += replaceImages(item, replace)
+		}
+	}
+	return replaced
+}
+
+func isContainerListKey(key string) bool {
+	for _, k := range containerListKeys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
 // SerializeManifests converts documents back to YAML string with `---` separators
 func SerializeManifests(docs []map[string]interface{}) (string, error) {
 	if len(docs) == 0 {
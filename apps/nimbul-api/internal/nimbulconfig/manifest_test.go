@@ -0,0 +1,81 @@
+package nimbulconfig
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestReplaceImages(t *testing.T) {
+	docs, err := ParseManifestBytes([]byte(`
+apiVersion: batch/v1
+kind: CronJob
+spec:
+  jobTemplate:
+    spec:
+      template:
+        spec:
+          initContainers:
+            - name: migrate
+              image: ghcr.io/acme/api:main
+          containers:
+            - name: api
+              image: ghcr.io/acme/api:main
+            - name: proxy
+              image: envoyproxy/envoy:v1.31
+---
+apiVersion: v1
+kind: ConfigMap
+data:
+  image: ghcr.io/acme/api:main
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	replaced := ReplaceImages(docs, func(image string) string {
+		if image == "ghcr.io/acme/api:main" {
+			return image + "@sha256:abc"
+		}
+		return image
+	})
+	if replaced != 2 {
+		t.Errorf("Expected 2 replaced images, got %d", replaced)
+	}
+
+	serialized, err := SerializeManifests(docs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Count(serialized, "ghcr.io/acme/api:main@sha256:abc") != 2 || !strings.Contains(serialized, "image: ghcr.io/acme/api:main\n") {
+		t.Errorf("Expected only container images to be replaced, got:\n%s", serialized)
+	}
+}
+
+func TestSetMetadata(t *testing.T) {
+	docs, err := ParseManifestBytes([]byte(`
+apiVersion: v1
+kind: Service
+metadata:
+  name: api
+  labels:
+    app: api
+---
+apiVersion: v1
+kind: ConfigMap
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	SetMetadata(docs, map[string]string{"nimbul.dev/config-id": "cfg"}, map[string]string{"nimbul.dev/commit": "abc"})
+
+	labels := docs[0]["metadata"].(map[string]interface{})["labels"].(map[string]interface{})
+	if labels["app"] != "api" || labels["nimbul.dev/config-id"] != "cfg" {
+		t.Errorf("Expected declared and added labels, got %v", labels)
+	}
+
+	annotations := docs[1]["metadata"].(map[string]interface{})["annotations"].(map[string]interface{})
+	if annotations["nimbul.dev/commit"] != "abc" {
+		t.Errorf("Expected annotation on a document without metadata, got %v", annotations)
+	}
+}
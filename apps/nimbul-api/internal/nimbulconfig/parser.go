@@ -1,6 +1,7 @@
 package nimbulconfig
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 	"os"
@@ -39,3 +40,23 @@ func ParseBytes(data []byte) (*NimbulConfig, error) {
 
 	return &config, nil
 }
+
+// Marshal encodes a NimbulConfig as nimbul.yaml
+func Marshal(config *NimbulConfig) ([]byte, error) {
+	return marshalYAML(config)
+}
+
+// marshalYAML encodes v as YAML indented with two spaces, like hand-written Kubernetes and nimbul.yaml files
+func marshalYAML(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(v); err != nil {
+		return nil, fmt.Errorf("failed to encode YAML: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, fmt.Errorf("failed to encode YAML: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
@@ -195,6 +195,41 @@ func TestValidate(t *testing.T) {
 			wantErr: true,
 			errMsg:  "does not reference an existing build",
 		},
+		{
+			name: "deploy without build",
+			config: &NimbulConfig{
+				Version: "1",
+				Deploy: []DeployConfig{
+					{
+						Name: "redis",
+						Manifests: []ManifestConfig{
+							{Path: "k8s/redis.yaml", Overrides: []OverrideConfig{
+								{Path: "spec.template.spec.containers[0].image", Value: "redis:7.4"},
+							}},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "build tag without buildId",
+			config: &NimbulConfig{
+				Version: "1",
+				Deploy: []DeployConfig{
+					{
+						Name: "api",
+						Manifests: []ManifestConfig{
+							{Path: "k8s/api.yaml", Overrides: []OverrideConfig{
+								{Path: "spec.template.spec.containers[0].image", Value: "{{ .BUILD_TAG[0] }}"},
+							}},
+						},
+					},
+				},
+			},
+			wantErr: true,
+			errMsg:  "BUILD_TAG needs a buildId",
+		},
 		{
 			name: "missing manifest path",
 			config: &NimbulConfig{
@@ -263,6 +298,97 @@ func TestValidate(t *testing.T) {
 			wantErr: true,
 			errMsg:  "value is required",
 		},
+		{
+			name: "artifacts without stage",
+			config: &NimbulConfig{
+				Version: "1",
+				Build: []BuildConfig{
+					{Name: "build-1", Dockerfile: "Dockerfile", Tags: []string{"tag1"}, Artifacts: &ArtifactsConfig{Paths: []string{"bin/app"}}},
+				},
+				Deploy: []DeployConfig{},
+			},
+			wantErr: true,
+			errMsg:  "stage is required",
+		},
+		{
+			name: "artifact path outside stage",
+			config: &NimbulConfig{
+				Version: "1",
+				Build: []BuildConfig{
+					{Name: "build-1", Dockerfile: "Dockerfile", Tags: []string{"tag1"}, Artifacts: &ArtifactsConfig{Stage: "artifacts", Paths: []string{"../etc/passwd"}}},
+				},
+				Deploy: []DeployConfig{},
+			},
+			wantErr: true,
+			errMsg:  "must be a relative path inside the stage",
+		},
+		{
+			name: "duplicate artifact name",
+			config: &NimbulConfig{
+				Version: "1",
+				Build: []BuildConfig{
+					{Name: "build-1", Dockerfile: "Dockerfile", Tags: []string{"tag1"}, Artifacts: &ArtifactsConfig{Stage: "artifacts", Paths: []string{"linux/app", "darwin/app"}}},
+				},
+				Deploy: []DeployConfig{},
+			},
+			wantErr: true,
+			errMsg:  "duplicate artifact name",
+		},
+		{
+			name: "context outside the repository",
+			config: &NimbulConfig{
+				Version: "1",
+				Build: []BuildConfig{
+					{Name: "build-1", Dockerfile: "Dockerfile", Context: "../..", Tags: []string{"tag1"}},
+				},
+			},
+			wantErr: true,
+			errMsg:  "context '../..' must be a relative path inside the repository",
+		},
+		{
+			name: "absolute dockerfile",
+			config: &NimbulConfig{
+				Version: "1",
+				Build: []BuildConfig{
+					{Name: "build-1", Dockerfile: "/etc/passwd", Tags: []string{"tag1"}},
+				},
+			},
+			wantErr: true,
+			errMsg:  "must be a relative path inside the repository",
+		},
+		{
+			name: "manifest path outside the repository",
+			config: &NimbulConfig{
+				Version: "1",
+				Deploy: []DeployConfig{
+					{Name: "deploy-1", Manifests: []ManifestConfig{{Path: "k8s/../../secrets.yaml"}}},
+				},
+			},
+			wantErr: true,
+			errMsg:  "must be a relative path inside the repository",
+		},
+		{
+			name: "preview without branches",
+			config: &NimbulConfig{
+				Version: "1",
+				Build:   []BuildConfig{},
+				Deploy:  []DeployConfig{},
+				Preview: &PreviewConfig{TTL: "24h"},
+			},
+			wantErr: true,
+			errMsg:  "at least one branch pattern is required",
+		},
+		{
+			name: "invalid preview ttl",
+			config: &NimbulConfig{
+				Version: "1",
+				Build:   []BuildConfig{},
+				Deploy:  []DeployConfig{},
+				Preview: &PreviewConfig{Branches: []string{"feature/*"}, TTL: "3 days"},
+			},
+			wantErr: true,
+			errMsg:  "invalid ttl",
+		},
 	}
 
 	for _, tt := range tests {
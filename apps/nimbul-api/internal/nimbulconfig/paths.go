@@ -0,0 +1,50 @@
+package nimbulconfig
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+)
+
+// ErrPathOutsideRepo is returned for paths of nimbul.yaml that lead outside the repository
+var ErrPathOutsideRepo = errors.New("path leads outside the repository")
+
+// checkRepoPath checks that a path of nimbul.yaml is relative and stays inside the
+// repository, e.g. "services/api" but neither "/etc" nor "../other"
+func checkRepoPath(p string) error {
+	if path.IsAbs(p) || filepath.IsAbs(p) || !filepath.IsLocal(filepath.FromSlash(p)) {
+		return fmt.Errorf("'%s' must be a relative path inside the repository", p)
+	}
+	return nil
+}
+
+// RepoPath resolves a path of nimbul.yaml within the cloned repository at repoDir.
+// Symlinks are followed, so a path through a symlink pointing outside the repository
+// fails with ErrPathOutsideRepo instead of reading files of the server.
+func RepoPath(repoDir, p string) (string, error) {
+	if err := checkRepoPath(p); err != nil {
+		return "", fmt.Errorf("%w: %w", ErrPathOutsideRepo, err)
+	}
+
+	root, err := filepath.EvalSymlinks(repoDir)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve repository directory: %w", err)
+	}
+
+	full := filepath.Join(repoDir, filepath.FromSlash(p))
+	resolved, err := filepath.EvalSymlinks(full)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", fmt.Errorf("'%s' does not exist in the repository", p)
+		}
+		return "", fmt.Errorf("failed to resolve '%s': %w", p, err)
+	}
+
+	if rel, err := filepath.Rel(root, resolved); err != nil || !filepath.IsLocal(rel) {
+		return "", fmt.Errorf("%w: '%s' follows a symlink out of it", ErrPathOutsideRepo, p)
+	}
+
+	return full, nil
+}
@@ -0,0 +1,59 @@
+package nimbulconfig
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRepoPath(t *testing.T) {
+	repoDir := t.TempDir()
+	outside := t.TempDir()
+
+	if err := os.MkdirAll(filepath.Join(repoDir, "services", "api"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(repoDir, "services", "api", "Dockerfile"), []byte("FROM scratch\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(filepath.Join(repoDir, "services"), filepath.Join(repoDir, "apps")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(outside, filepath.Join(repoDir, "escape")); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		wantErr bool
+		outside bool // whether the error is ErrPathOutsideRepo
+	}{
+		{"repository root", ".", false, false},
+		{"file", "services/api/Dockerfile", false, false},
+		{"symlink inside the repository", "apps/api", false, false},
+		{"missing path", "services/web", true, false},
+		{"parent directory", "../other", true, true},
+		{"absolute path", "/etc/passwd", true, true},
+		{"symlink outside the repository", "escape", true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := RepoPath(repoDir, tt.path)
+			if !tt.wantErr {
+				if err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("Expected error but got none")
+			}
+			if outside := errors.Is(err, ErrPathOutsideRepo); outside != tt.outside {
+				t.Errorf("Expected ErrPathOutsideRepo %v, got %v", tt.outside, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package nimbulconfig
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// maxNamespaceLength is the Kubernetes limit for namespace names (DNS label)
+const maxNamespaceLength = 63
+
+var invalidNamespaceChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// Matches reports whether branch matches one of the preview branch patterns
+func (p *PreviewConfig) Matches(branch string) bool {
+	if p == nil || branch == "" {
+		return false
+	}
+
+	for _, pattern := range p.Branches {
+		if matched, _ := path.Match(pattern, branch); matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TTLDuration parses the preview TTL. Returns 0 when no TTL is configured.
+func (p *PreviewConfig) TTLDuration() (time.Duration, error) {
+	if p == nil || p.TTL == "" {
+		return 0, nil
+	}
+
+	ttl, err := time.ParseDuration(p.TTL)
+	if err != nil {
+		return 0, fmt.Errorf("invalid ttl '%s': %w", p.TTL, err)
+	}
+	if ttl <= 0 {
+		return 0, fmt.Errorf("ttl must be positive, got '%s'", p.TTL)
+	}
+
+	return ttl, nil
+}
+
+// PreviewNamespace returns the namespace a branch preview of a config is deployed to.
+// The name is a valid DNS label and stays unique per config and branch.
+func PreviewNamespace(configID, branch string) string {
+	suffix := strings.ToLower(configID)
+	if len(suffix) > 6 {
+		suffix = suffix[len(suffix)-6:]
+	}
+
+	slug := invalidNamespaceChars.ReplaceAllString(strings.ToLower(branch), "-")
+	slug = strings.Trim(slug, "-")
+
+	// "preview-" + slug + "-" + suffix must fit in a DNS label
+	maxSlug := maxNamespaceLength - len("preview-") - len(suffix) - 1
+	if len(slug) > maxSlug {
+		slug = strings.TrimRight(slug[:maxSlug], "-")
+	}
+	if slug == "" {
+		slug = "branch"
+	}
+
+	return fmt.Sprintf("preview-%s-%s", slug, suffix)
+}
@@ -0,0 +1,68 @@
+package nimbulconfig
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPreviewMatches(t *testing.T) {
+	preview := &PreviewConfig{Branches: []string{"feature/*", "preview-*"}}
+
+	tests := []struct {
+		branch string
+		want   bool
+	}{
+		{"feature/login", true},
+		{"preview-42", true},
+		{"main", false},
+		{"feature/login/v2", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := preview.Matches(tt.branch); got != tt.want {
+			t.Errorf("Matches(%q) = %v, expected %v", tt.branch, got, tt.want)
+		}
+	}
+
+	var disabled *PreviewConfig
+	if disabled.Matches("feature/login") {
+		t.Error("Expected nil preview config to match nothing")
+	}
+}
+
+func TestPreviewNamespace(t *testing.T) {
+	tests := []struct {
+		name     string
+		configID string
+		branch   string
+		expected string
+	}{
+		{
+			name:     "simple branch",
+			configID: "01HZX3ABCDEF",
+			branch:   "main",
+			expected: "preview-main-abcdef",
+		},
+		{
+			name:     "branch with slashes and uppercase",
+			configID: "01HZX3ABCDEF",
+			branch:   "Feature/Login_Page",
+			expected: "preview-feature-login-page-abcdef",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := PreviewNamespace(tt.configID, tt.branch)
+			if result != tt.expected {
+				t.Errorf("Expected '%s', got '%s'", tt.expected, result)
+			}
+		})
+	}
+
+	long := PreviewNamespace("01HZX3ABCDEF", "feature/"+strings.Repeat("x", 100))
+	if len(long) > 63 {
+		t.Errorf("Expected namespace of at most 63 characters, got %d", len(long))
+	}
+}
@@ -0,0 +1,65 @@
+package nimbulconfig
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// starterDockerfile is a Dockerfile for projects of a language, recognized by a file
+// at the repository root
+type starterDockerfile struct {
+	language string
+	marker   string
+	content  string
+}
+
+// starterDockerfiles are tried in order, the first whose marker exists is used
+var starterDockerfiles = []starterDockerfile{
+	{"Go", "go.mod", `FROM golang:1.24 AS build
+WORKDIR /src
+COPY go.mod go.sum* ./
+RUN go mod download
+COPY . .
+RUN CGO_ENABLED=0 go build -o /app .
+
+FROM gcr.io/distroless/static-debian12
+COPY --from=build /app /app
+EXPOSE 8080
+ENTRYPOINT ["/app"]
+`},
+	{"Node.js", "package.json", `FROM node:22-alpine
+WORKDIR /app
+COPY package*.json ./
+RUN npm ci --omit=dev
+COPY . .
+EXPOSE 3000
+CMD ["npm", "start"]
+`},
+	{"Python", "requirements.txt", `FROM python:3.13-slim
+WORKDIR /app
+COPY requirements.txt ./
+RUN pip install --no-cache-dir -r requirements.txt
+COPY . .
+EXPOSE 8000
+CMD ["python", "main.py"]
+`},
+	{"Python", "pyproject.toml", `FROM python:3.13-slim
+WORKDIR /app
+COPY . .
+RUN pip install --no-cache-dir .
+EXPOSE 8000
+CMD ["python", "-m", "app"]
+`},
+}
+
+// StarterDockerfile returns a Dockerfile to start from for the repository at repoDir,
+// picked by the language its root files suggest, along with that language
+func StarterDockerfile(repoDir string) (content []byte, language string, err error) {
+	for _, starter := range starterDockerfiles {
+		if _, err := os.Stat(filepath.Join(repoDir, starter.marker)); err == nil {
+			return []byte(starter.content), starter.language, nil
+		}
+	}
+	return nil, "", fmt.Errorf("no go.mod, package.json, requirements.txt or pyproject.toml found to pick a starter Dockerfile")
+}
@@ -0,0 +1,26 @@
+package nimbulconfig
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStarterDockerfile(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"package.json":     `{"name": "web"}`,
+		"requirements.txt": "flask\n",
+	})
+
+	content, language, err := StarterDockerfile(dir)
+	if err != nil {
+		t.Fatalf("StarterDockerfile() error = %v", err)
+	}
+	if language != "Node.js" || !strings.HasPrefix(string(content), "FROM node:") {
+		t.Errorf("StarterDockerfile() = %s Dockerfile starting %q, want the Node.js one", language, strings.SplitN(string(content), "\n", 2)[0])
+	}
+
+	if _, _, err := StarterDockerfile(t.TempDir()); err == nil {
+		t.Error("StarterDockerfile() of an empty repository should fail")
+	}
+}
@@ -16,7 +16,20 @@ type TemplateContext struct {
 	BRANCH       string
 	REPO         string
 	TIMESTAMP    string
-	BUILD_TAGS   []string // Available for deploy steps
+	// EVENT is what triggered the run: push, registry or rollback
+	EVENT string
+	// ACTOR is who triggered the run: the GitHub login of the pusher, the registry
+	// user or the email of the user rolling back
+	ACTOR string
+	// PR_NUMBER is the number of the open pull request of the branch, empty when
+	// there is none
+	PR_NUMBER  string
+	BUILD_TAGS []string // Available for deploy steps
+	// DEPENDS_ON maps the builds a build depends on to their first tag. Available for build args.
+	DEPENDS_ON map[string]string
+	// ENV holds the environment variables of the config, which builds also receive as
+	// build args
+	ENV map[string]string
 }
 
 // NewTemplateContext creates a new template context with the provided values
@@ -33,6 +46,7 @@ func NewTemplateContext(commitSHA, branch, repo string) *TemplateContext {
 		REPO:         repo,
 		TIMESTAMP:    strconv.FormatInt(time.Now().Unix(), 10),
 		BUILD_TAGS:   []string{},
+		ENV:          map[string]string{},
 	}
 }
 
@@ -48,7 +62,7 @@ func RenderString(tmpl string, ctx *TemplateContext) (string, error) {
 	tmpl = transformBuildTagSyntax(tmpl)
 
 	// Create template with custom functions
-	t, err := template.New("nimbul").Funcs(template.FuncMap{
+	t, err := template.New("nimbul").Option("missingkey=error").Funcs(template.FuncMap{
 		"tag": func(index int) (string, error) {
 			if index < 0 || index >= len(ctx.BUILD_TAGS) {
 				return "", fmt.Errorf("BUILD_TAG index %d out of range (available: %d tags)", index, len(ctx.BUILD_TAGS))
@@ -121,9 +135,23 @@ func RenderConfig(config *NimbulConfig, ctx *TemplateContext) (*NimbulConfig, er
 
 	// Create a deep copy to avoid modifying the original
 	rendered := &NimbulConfig{
-		Version: config.Version,
-		Build:   make([]BuildConfig, len(config.Build)),
-		Deploy:  make([]DeployConfig, len(config.Deploy)),
+		Version:             config.Version,
+		Build:               make([]BuildConfig, len(config.Build)),
+		Deploy:              make([]DeployConfig, len(config.Deploy)),
+		Preview:             config.Preview,
+		RemoteContext:       config.RemoteContext,
+		PullRequestComments: config.PullRequestComments,
+	}
+
+	if config.Concurrency != nil {
+		group, err := RenderString(config.Concurrency.Group, ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render concurrency.group: %w", err)
+		}
+		rendered.Concurrency = &ConcurrencyConfig{
+			Group:            group,
+			CancelInProgress: config.Concurrency.CancelInProgress,
+		}
 	}
 
 	// Render build configs first
@@ -133,6 +161,9 @@ func RenderConfig(config *NimbulConfig, ctx *TemplateContext) (*NimbulConfig, er
 			Dockerfile: build.Dockerfile,
 			Context:    build.Context,
 			Tags:       make([]string, len(build.Tags)),
+			Artifacts:  build.Artifacts,
+			DependsOn:  build.DependsOn,
+			Registry:   build.Registry,
 		}
 
 		// Render tags
@@ -147,23 +178,53 @@ func RenderConfig(config *NimbulConfig, ctx *TemplateContext) (*NimbulConfig, er
 		rendered.Build[i] = renderedBuild
 	}
 
-	// Render deploy configs
-	for i, deploy := range config.Deploy {
-		// Find the linked build to get its tags
-		var linkedBuild *BuildConfig
-		for _, build := range rendered.Build {
-			if build.Name == deploy.BuildID {
-				linkedBuild = &build
-				break
+	// Render build args once every tag is known, they may refer to the tags of dependencies.
+	// Environment variables are passed as build args too, unless nimbul.yaml sets the same arg.
+	for i, build := range config.Build {
+		if len(build.Args) == 0 && len(ctx.ENV) == 0 {
+			continue
+		}
+
+		buildCtx := *ctx
+		buildCtx.DEPENDS_ON = make(map[string]string)
+		for _, dep := range build.DependsOn {
+			for _, renderedDep := range rendered.Build {
+				if renderedDep.Name == dep && len(renderedDep.Tags) > 0 {
+					buildCtx.DEPENDS_ON[dep] = renderedDep.Tags[0]
+				}
 			}
 		}
-		if linkedBuild == nil {
-			return nil, fmt.Errorf("deploy[%d]: buildId '%s' not found", i, deploy.BuildID)
+
+		rendered.Build[i].Args = make(map[string]string, len(ctx.ENV)+len(build.Args))
+		for name, value := range ctx.ENV {
+			rendered.Build[i].Args[name] = value
+		}
+		for name, value := range build.Args {
+			renderedValue, err := RenderString(value, &buildCtx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to render build[%d].args.%s: %w", i, name, err)
+			}
+			rendered.Build[i].Args[name] = renderedValue
 		}
+	}
 
-		// Create context with BUILD_TAGS from linked build
+	// Render deploy configs
+	for i, deploy := range config.Deploy {
+		// Create context with BUILD_TAGS from the linked build, if any
 		deployCtx := *ctx
-		deployCtx.BUILD_TAGS = linkedBuild.Tags
+		if deploy.BuildID != "" {
+			var linkedBuild *BuildConfig
+			for _, build := range rendered.Build {
+				if build.Name == deploy.BuildID {
+					linkedBuild = &build
+					break
+				}
+			}
+			if linkedBuild == nil {
+				return nil, fmt.Errorf("deploy[%d]: buildId '%s' not found", i, deploy.BuildID)
+			}
+			deployCtx.BUILD_TAGS = linkedBuild.Tags
+		}
 
 		renderedDeploy := DeployConfig{
 			Name:      deploy.Name,
@@ -7,6 +7,9 @@ import (
 
 func TestRenderString(t *testing.T) {
 	ctx := NewTemplateContext("abc123def456789", "main", "owner/repo")
+	ctx.EVENT = "push"
+	ctx.ACTOR = "octocat"
+	ctx.PR_NUMBER = "42"
 
 	tests := []struct {
 		name     string
@@ -56,6 +59,12 @@ func TestRenderString(t *testing.T) {
 			expected: "owner/repo:abc123def456",
 			wantErr:  false,
 		},
+		{
+			name:     "trigger variables",
+			template: "pr-{{ .PR_NUMBER }}-{{ .ACTOR }}-{{ .EVENT }}",
+			expected: "pr-42-octocat-push",
+			wantErr:  false,
+		},
 		{
 			name:     "BUILD_TAG with index",
 			template: "{{ .BUILD_TAG[0] }}",
@@ -168,6 +177,43 @@ func TestRenderConfig(t *testing.T) {
 	}
 }
 
+func TestRenderConfigEnv(t *testing.T) {
+	ctx := NewTemplateContext("abc123", "main", "owner/repo")
+	ctx.ENV = map[string]string{"REGISTRY": "ghcr.io/owner", "GO_VERSION": "1.24"}
+
+	config := &NimbulConfig{
+		Version: "1",
+		Build: []BuildConfig{
+			{
+				Name:       "build-1",
+				Dockerfile: "Dockerfile",
+				Tags:       []string{"{{ .ENV.REGISTRY }}/api:latest"},
+				Args:       map[string]string{"GO_VERSION": "1.25"},
+			},
+		},
+	}
+
+	rendered, err := RenderConfig(config, ctx)
+	if err != nil {
+		t.Fatalf("Failed to render config: %v", err)
+	}
+
+	if rendered.Build[0].Tags[0] != "ghcr.io/owner/api:latest" {
+		t.Errorf("Expected tag 'ghcr.io/owner/api:latest', got '%s'", rendered.Build[0].Tags[0])
+	}
+	if rendered.Build[0].Args["REGISTRY"] != "ghcr.io/owner" {
+		t.Errorf("Expected build arg REGISTRY from the environment, got '%s'", rendered.Build[0].Args["REGISTRY"])
+	}
+	// nimbul.yaml args take precedence over environment variables
+	if rendered.Build[0].Args["GO_VERSION"] != "1.25" {
+		t.Errorf("Expected build arg GO_VERSION '1.25', got '%s'", rendered.Build[0].Args["GO_VERSION"])
+	}
+
+	if _, err := RenderString("{{ .ENV.MISSING }}", ctx); err == nil {
+		t.Error("Expected error for unset environment variable, got none")
+	}
+}
+
 func TestRenderConfigInvalidBuildID(t *testing.T) {
 	ctx := NewTemplateContext("abc123", "main", "owner/repo")
 
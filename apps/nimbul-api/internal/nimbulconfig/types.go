@@ -3,22 +3,53 @@ package nimbulconfig
 // NimbulConfig represents the root configuration structure
 type NimbulConfig struct {
 	Version string         `yaml:"version"`
-	Build   []BuildConfig  `yaml:"build"`
+	Build   []BuildConfig  `yaml:"build,omitempty"`
 	Deploy  []DeployConfig `yaml:"deploy"`
+	Preview *PreviewConfig `yaml:"preview,omitempty"`
+	// Concurrency groups pipeline runs; a newer run cancels the builds of older
+	// runs in its group. Without it, each branch is a group.
+	Concurrency *ConcurrencyConfig `yaml:"concurrency,omitempty"`
+	// RemoteContext builds straight from git without cloning the repository first. It
+	// only applies to configs without deploys, whose manifests are read from a clone.
+	RemoteContext bool `yaml:"remoteContext,omitempty"`
+	// PullRequestComments comments on the open pull request of a pushed branch with the
+	// images the run pushed and how its manifests differ from the cluster, updating the
+	// comment on every push. The GitHub App needs write access to pull requests.
+	PullRequestComments bool `yaml:"pullRequestComments,omitempty"`
 }
 
 // BuildConfig defines a Docker build configuration
 type BuildConfig struct {
-	Name       string   `yaml:"name"`
-	Dockerfile string   `yaml:"dockerfile"`
-	Context    string   `yaml:"context"`
-	Tags       []string `yaml:"tags"`
+	Name       string           `yaml:"name"`
+	Dockerfile string           `yaml:"dockerfile"`
+	Context    string           `yaml:"context"`
+	Tags       []string         `yaml:"tags"`
+	Artifacts  *ArtifactsConfig `yaml:"artifacts,omitempty"`
+	// DependsOn names builds pushed before this one, e.g. a base image it is built FROM
+	DependsOn []string `yaml:"dependsOn,omitempty"`
+	// Args are passed to the Dockerfile as build args, with template support.
+	// {{ .DEPENDS_ON.<name> }} is the first tag of a build this one depends on.
+	Args map[string]string `yaml:"args,omitempty"`
+	// Registry selects the stored registry login to push with, e.g. ghcr.io. Without
+	// it builds push with the owner's default login, or the server's Docker config.
+	Registry string `yaml:"registry,omitempty"`
 }
 
-// DeployConfig defines a deployment configuration
+// ArtifactsConfig declares files produced during a build that are kept for download.
+// The paths are copied out of the filesystem of a Dockerfile stage, usually a
+// "FROM scratch AS artifacts" stage that only copies them in. Directories are
+// stored as .tar.gz archives.
+type ArtifactsConfig struct {
+	Stage string   `yaml:"stage"` // Dockerfile target stage to export
+	Paths []string `yaml:"paths"` // paths within the stage, e.g. "bin/api"
+}
+
+// DeployConfig defines a deployment configuration.
+// A deploy without a buildId applies manifests with fixed image references, e.g. a
+// database or proxy, and cannot use BUILD_TAG templates.
 type DeployConfig struct {
 	Name      string           `yaml:"name"`
-	BuildID   string           `yaml:"buildId"`
+	BuildID   string           `yaml:"buildId,omitempty"`
 	Manifests []ManifestConfig `yaml:"manifests"`
 }
 
@@ -40,3 +71,18 @@ type MatchConfig struct {
 	Kind string `yaml:"kind"` // e.g., "Deployment", "Service"
 	Name string `yaml:"name"` // Optional: resource name
 }
+
+// ConcurrencyConfig groups pipeline runs so a newer push supersedes older runs of
+// the same group, e.g. rapid pushes to one branch
+type ConcurrencyConfig struct {
+	Group            string `yaml:"group"`                      // template, e.g. "deploy-{{ .BRANCH }}"; empty groups by branch
+	CancelInProgress *bool  `yaml:"cancelInProgress,omitempty"` // cancel builds of superseded runs, default true
+}
+
+// PreviewConfig enables ephemeral preview deployments for matching branches.
+// Each matching branch is deployed into its own namespace, which is garbage
+// collected once the branch is deleted or the preview has been idle for TTL.
+type PreviewConfig struct {
+	Branches []string `yaml:"branches"` // glob patterns, e.g. "feature/*"
+	TTL      string   `yaml:"ttl"`      // e.g. "72h"; empty uses the server default
+}
@@ -2,6 +2,7 @@ package nimbulconfig
 
 import (
 	"fmt"
+	"path"
 	"strings"
 )
 
@@ -25,6 +26,11 @@ func Validate(config *NimbulConfig) error {
 		buildNames[build.Name] = true
 	}
 
+	// Builds may only depend on each other without cycles
+	if _, err := BuildOrder(config.Build); err != nil {
+		return err
+	}
+
 	// 3. Validate deploys
 	deployNames := make(map[string]bool)
 	for i, deploy := range config.Deploy {
@@ -34,6 +40,13 @@ func Validate(config *NimbulConfig) error {
 		deployNames[deploy.Name] = true
 	}
 
+	// 4. Validate preview settings
+	if config.Preview != nil {
+		if err := validatePreview(config.Preview); err != nil {
+			return fmt.Errorf("preview: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -52,12 +65,57 @@ func validateBuild(build BuildConfig, index int, buildNames map[string]bool) err
 		return fmt.Errorf("build[%d]: dockerfile is required", index)
 	}
 
-	// context defaults to "." if empty (handled during processing, not validation)
+	// dockerfile and context stay inside the repository; context defaults to "." if
+	// empty (handled during processing)
+	if err := checkRepoPath(build.Dockerfile); err != nil {
+		return fmt.Errorf("build[%d]: dockerfile %w", index, err)
+	}
+	if build.Context != "" {
+		if err := checkRepoPath(build.Context); err != nil {
+			return fmt.Errorf("build[%d]: context %w", index, err)
+		}
+	}
+
 	// tags has at least one entry
 	if len(build.Tags) == 0 {
 		return fmt.Errorf("build[%d]: at least one tag is required", index)
 	}
 
+	// artifacts name a stage and paths inside it
+	if build.Artifacts != nil {
+		if err := validateArtifacts(build.Artifacts); err != nil {
+			return fmt.Errorf("build[%d].artifacts: %w", index, err)
+		}
+	}
+
+	return nil
+}
+
+// validateArtifacts validates an ArtifactsConfig
+func validateArtifacts(artifacts *ArtifactsConfig) error {
+	if artifacts.Stage == "" {
+		return fmt.Errorf("stage is required")
+	}
+
+	if len(artifacts.Paths) == 0 {
+		return fmt.Errorf("at least one path is required")
+	}
+
+	// Artifacts are downloaded by name, so paths must not share a base name
+	names := make(map[string]bool)
+	for i, p := range artifacts.Paths {
+		cleaned := path.Clean(strings.TrimPrefix(p, "./"))
+		if p == "" || path.IsAbs(p) || cleaned == "." || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+			return fmt.Errorf("paths[%d]: '%s' must be a relative path inside the stage", i, p)
+		}
+
+		name := path.Base(cleaned)
+		if names[name] {
+			return fmt.Errorf("paths[%d]: duplicate artifact name '%s'", i, name)
+		}
+		names[name] = true
+	}
+
 	return nil
 }
 
@@ -71,11 +129,8 @@ func validateDeploy(deploy DeployConfig, index int, deployNames map[string]bool,
 		return fmt.Errorf("deploy[%d]: duplicate deploy name '%s'", index, deploy.Name)
 	}
 
-	// buildId references an existing build name
-	if deploy.BuildID == "" {
-		return fmt.Errorf("deploy[%d]: buildId is required", index)
-	}
-	if !buildNames[deploy.BuildID] {
+	// buildId references an existing build name when set
+	if deploy.BuildID != "" && !buildNames[deploy.BuildID] {
 		return fmt.Errorf("deploy[%d]: buildId '%s' does not reference an existing build", index, deploy.BuildID)
 	}
 
@@ -89,6 +144,15 @@ func validateDeploy(deploy DeployConfig, index int, deployNames map[string]bool,
 		if err := validateManifest(manifest, i); err != nil {
 			return fmt.Errorf("deploy[%d].manifest[%d]: %w", index, i, err)
 		}
+
+		// Without a build there are no tags to fill in
+		if deploy.BuildID == "" {
+			for j, override := range manifest.Overrides {
+				if strings.Contains(override.Value, "BUILD_TAG") {
+					return fmt.Errorf("deploy[%d].manifest[%d].override[%d]: BUILD_TAG needs a buildId", index, i, j)
+				}
+			}
+		}
 	}
 
 	return nil
@@ -100,6 +164,9 @@ func validateManifest(manifest ManifestConfig, index int) error {
 	if manifest.Path == "" {
 		return fmt.Errorf("path is required")
 	}
+	if err := checkRepoPath(manifest.Path); err != nil {
+		return fmt.Errorf("path %w", err)
+	}
 
 	// Validate each override
 	for i, override := range manifest.Overrides {
@@ -144,3 +211,23 @@ func validateOverride(override OverrideConfig, index int) error {
 
 	return nil
 }
+
+// validatePreview validates the PreviewConfig
+func validatePreview(preview *PreviewConfig) error {
+	// branches has at least one valid pattern
+	if len(preview.Branches) == 0 {
+		return fmt.Errorf("at least one branch pattern is required")
+	}
+	for i, pattern := range preview.Branches {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("branches[%d]: invalid pattern '%s'", i, pattern)
+		}
+	}
+
+	// ttl is a positive duration when set
+	if _, err := preview.TTLDuration(); err != nil {
+		return err
+	}
+
+	return nil
+}
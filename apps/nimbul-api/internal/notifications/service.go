@@ -0,0 +1,273 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/credentials"
+	"github.com/coding-cave-dev/nimbul/internal/db"
+	"github.com/coding-cave-dev/nimbul/internal/email"
+	"github.com/coding-cave-dev/nimbul/internal/hooks"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var (
+	ErrInvalidSlackWebhookURL = errors.New("slack webhook URL must be an https URL")
+	ErrPrivateSlackWebhookURL = errors.New("slack webhook URL must not point to a private, loopback or link-local address")
+)
+
+type Service struct {
+	queries *db.Queries
+	sender  *email.Sender
+	client  *http.Client
+}
+
+func NewService(queries *db.Queries, sender *email.Sender) *Service {
+	return &Service{
+		queries: queries,
+		sender:  sender,
+		// Webhook URLs are chosen by users, so posts only go to public addresses
+		client: hooks.NewDeliveryClient(),
+	}
+}
+
+// EmailEnabled reports whether emails can be sent at all
+func (s *Service) EmailEnabled() bool {
+	return s.sender.Enabled()
+}
+
+// Preferences selects which notifications a user receives. Notifications are emailed
+// and, when SlackWebhookURL is set, posted to Slack. Password reset emails are always sent.
+type Preferences struct {
+	BuildFailed      bool
+	DeployFailed     bool
+	CredentialExpiry bool
+	SlackWebhookURL  string
+}
+
+// defaultPreferences apply to users who never changed their preferences
+var defaultPreferences = Preferences{
+	BuildFailed:      true,
+	DeployFailed:     true,
+	CredentialExpiry: true,
+}
+
+// GetPreferences retrieves a user's notification preferences
+func (s *Service) GetPreferences(ctx context.Context, userID string) (*Preferences, error) {
+	prefs, err := s.queries.GetNotificationPreferences(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			defaults := defaultPreferences
+			return &defaults, nil
+		}
+		return nil, fmt.Errorf("failed to get notification preferences: %w", err)
+	}
+
+	return dbPreferencesToPreferences(prefs), nil
+}
+
+// UpdatePreferences replaces a user's notification preferences
+func (s *Service) UpdatePreferences(ctx context.Context, userID string, prefs Preferences) (*Preferences, error) {
+	if prefs.SlackWebhookURL != "" {
+		parsed, err := url.Parse(prefs.SlackWebhookURL)
+		if err != nil || parsed.Scheme != "https" || parsed.Host == "" {
+			return nil, ErrInvalidSlackWebhookURL
+		}
+		if err := hooks.ValidateURL(ctx, prefs.SlackWebhookURL); err != nil {
+			if errors.Is(err, hooks.ErrPrivateAddress) {
+				return nil, ErrPrivateSlackWebhookURL
+			}
+			return nil, fmt.Errorf("%w: %s does not resolve", ErrInvalidSlackWebhookURL, parsed.Hostname())
+		}
+	}
+
+	updated, err := s.queries.UpsertNotificationPreferences(ctx, db.UpsertNotificationPreferencesParams{
+		UserID:           userID,
+		BuildFailed:      prefs.BuildFailed,
+		DeployFailed:     prefs.DeployFailed,
+		CredentialExpiry: prefs.CredentialExpiry,
+		SlackWebhookUrl:  pgtype.Text{String: prefs.SlackWebhookURL, Valid: prefs.SlackWebhookURL != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update notification preferences: %w", err)
+	}
+
+	return dbPreferencesToPreferences(updated), nil
+}
+
+// SendPasswordReset emails a password reset token to a user
+func (s *Service) SendPasswordReset(to, token string, validFor time.Duration) error {
+	body := fmt.Sprintf(`Someone requested a password reset for your Nimbul account.
+
+Reset your password with:
+
+  nimbul reset-password --token %s
+
+This token is valid for %s and can only be used once. If you did not
+request a reset, you can ignore this email.
+`, token, validFor)
+
+	return s.sender.Send(to, "Reset your Nimbul password", body)
+}
+
+// SendEmailChange emails the confirmation token of an email change to the new address
+func (s *Service) SendEmailChange(to, token string, validFor time.Duration) error {
+	body := fmt.Sprintf(`Someone asked to use this address for their Nimbul account.
+
+Confirm the change with:
+
+  nimbul me confirm-email --token %s
+
+This token is valid for %s and can only be used once. If you did not
+ask for this, you can ignore this email.
+`, token, validFor)
+
+	return s.sender.Send(to, "Confirm your new Nimbul email", body)
+}
+
+// NotifyPipelineFailure emails the owner of a config about a failed build or deploy,
+// unless they turned these emails off. Other event types are ignored.
+func (s *Service) NotifyPipelineFailure(ctx context.Context, userID string, event hooks.Event) {
+	if event.Type != hooks.EventBuildFailed && event.Type != hooks.EventDeployFailed {
+		return
+	}
+
+	prefs, err := s.GetPreferences(ctx, userID)
+	if err != nil {
+		fmt.Printf("Warning: Failed to load notification preferences of %s: %v\n", userID, err)
+		return
+	}
+
+	var subject string
+	switch {
+	case event.Type == hooks.EventBuildFailed && prefs.BuildFailed:
+		subject = fmt.Sprintf("Build %s failed for %s", event.Build, event.Repository)
+	case event.Type == hooks.EventDeployFailed && prefs.DeployFailed:
+		subject = fmt.Sprintf("Deploy failed for %s", event.Repository)
+	default:
+		return
+	}
+
+	var body strings.Builder
+	fmt.Fprintf(&body, "%s\n\n", subject)
+	fmt.Fprintf(&body, "Config:     %s\n", event.ConfigID)
+	if event.Ref != "" {
+		fmt.Fprintf(&body, "Ref:        %s\n", event.Ref)
+	}
+	if event.CommitSHA != "" {
+		fmt.Fprintf(&body, "Commit:     %s\n", event.CommitSHA)
+	}
+	if event.DeploymentID != 0 {
+		fmt.Fprintf(&body, "Deployment: %d (nimbul status %s --deployment %d)\n", event.DeploymentID, event.ConfigID, event.DeploymentID)
+	}
+	if event.Error != "" {
+		fmt.Fprintf(&body, "\nError:\n%s\n", event.Error)
+	}
+	body.WriteString("\nTurn these notifications off with 'nimbul notifications'.\n")
+
+	// Sending can take a while; never hold up the pipeline on it
+	go func() {
+		if err := s.send(context.Background(), userID, prefs, subject, body.String()); err != nil {
+			fmt.Printf("Warning: Failed to send %s notification to %s: %v\n", event.Type, userID, err)
+		}
+	}()
+}
+
+// NotifyCredentialExpiry warns a user that stored credentials expire soon, unless
+// they turned these notifications off
+func (s *Service) NotifyCredentialExpiry(ctx context.Context, userID string, expiring []credentials.ExpiringCredential) error {
+	prefs, err := s.GetPreferences(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if !prefs.CredentialExpiry || len(expiring) == 0 {
+		return nil
+	}
+
+	subject := "Nimbul credentials are about to expire"
+	if len(expiring) == 1 {
+		subject = fmt.Sprintf("Your %s credential is about to expire", expiring[0].Provider)
+	}
+
+	var body strings.Builder
+	body.WriteString("Builds triggered by webhooks will fail once these credentials expire:\n\n")
+	for _, credential := range expiring {
+		state := "expires"
+		if credential.ExpiresAt.Before(time.Now()) {
+			state = "expired"
+		}
+		fmt.Fprintf(&body, "  - %s %s %s %s\n", credential.Provider, credential.TokenType, state, credential.ExpiresAt.Format(time.RFC1123))
+	}
+	body.WriteString("\nReconnect with 'nimbul connect' to renew them.\n")
+	body.WriteString("\nTurn these notifications off with 'nimbul notifications --credential-expiry=false'.\n")
+
+	return s.send(ctx, userID, prefs, subject, body.String())
+}
+
+// send delivers a notification by email, when configured, and to the user's Slack webhook, when set
+func (s *Service) send(ctx context.Context, userID string, prefs *Preferences, subject, body string) error {
+	var errs []error
+
+	if s.sender.Enabled() {
+		user, err := s.queries.GetUserByID(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("failed to load user: %w", err)
+		}
+		if err := s.sender.Send(user.Email, subject, body); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if prefs.SlackWebhookURL != "" {
+		if err := s.postSlack(ctx, prefs.SlackWebhookURL, fmt.Sprintf("*%s*\n%s", subject, body)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// postSlack posts a message to a Slack incoming webhook
+func (s *Service) postSlack(ctx context.Context, webhookURL, text string) error {
+	payload, err := json.Marshal(map[string]string{"text": text})
+	if err != nil {
+		return fmt.Errorf("failed to encode slack message: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create slack request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post to slack: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("slack returned %s", resp.Status)
+	}
+
+	return nil
+}
+
+// dbPreferencesToPreferences converts db.NotificationPreference to notifications.Preferences
+func dbPreferencesToPreferences(dbPrefs db.NotificationPreference) *Preferences {
+	return &Preferences{
+		BuildFailed:      dbPrefs.BuildFailed,
+		DeployFailed:     dbPrefs.DeployFailed,
+		CredentialExpiry: dbPrefs.CredentialExpiry,
+		SlackWebhookURL:  dbPrefs.SlackWebhookUrl.String,
+	}
+}
@@ -0,0 +1,158 @@
+package oidc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// keySet holds the signing keys the provider publishes at its jwks_uri
+type keySet struct {
+	provider *Provider
+	uri      string
+
+	mu   sync.Mutex
+	keys map[string]any // public keys by key ID
+}
+
+// jsonWebKey is a public key of a JWK set
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	// RSA keys
+	N string `json:"n"`
+	E string `json:"e"`
+	// EC keys
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// keySet returns the provider's key set, discovering its location on first use
+func (p *Provider) keySet(ctx context.Context) (*keySet, error) {
+	discovery, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.keys == nil {
+		p.keys = &keySet{provider: p, uri: discovery.JWKSURI}
+	}
+	return p.keys, nil
+}
+
+// keyFunc looks up the key a token was signed with. Keys are fetched again when a
+// token names an unknown key, since providers rotate their keys.
+func (k *keySet) keyFunc(ctx context.Context) jwt.Keyfunc {
+	return func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+
+		k.mu.Lock()
+		defer k.mu.Unlock()
+
+		if key, ok := k.keys[kid]; ok {
+			return key, nil
+		}
+
+		if err := k.refresh(ctx); err != nil {
+			return nil, err
+		}
+
+		if key, ok := k.keys[kid]; ok {
+			return key, nil
+		}
+		// Providers with a single key may leave kid out
+		if kid == "" && len(k.keys) == 1 {
+			for _, key := range k.keys {
+				return key, nil
+			}
+		}
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+}
+
+// refresh fetches the key set. The caller holds k.mu.
+func (k *keySet) refresh(ctx context.Context) error {
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := k.provider.getJSON(ctx, k.uri, &set); err != nil {
+		return fmt.Errorf("failed to fetch OIDC signing keys: %w", err)
+	}
+
+	keys := make(map[string]any)
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			// Keys of unsupported types cannot have signed the tokens Nimbul accepts
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+
+	k.keys = keys
+	return nil
+}
+
+// publicKey decodes an RSA or EC key
+func (j jsonWebKey) publicKey() (any, error) {
+	switch j.Kty {
+	case "RSA":
+		n, err := decodeBigInt(j.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(j.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+
+	case "EC":
+		var curve elliptic.Curve
+		switch j.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", j.Crv)
+		}
+		x, err := decodeBigInt(j.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(j.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported key type %q", j.Kty)
+}
+
+// decodeBigInt decodes a base64url-encoded big-endian integer
+func decodeBigInt(s string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(data), nil
+}
@@ -0,0 +1,247 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/oauth2"
+)
+
+var (
+	ErrNotConfigured      = errors.New("OIDC login is not configured")
+	ErrDeviceFlowDisabled = errors.New("the identity provider does not support the device authorization grant")
+	ErrInvalidIDToken     = errors.New("invalid ID token")
+)
+
+// Identity is a user authenticated by the identity provider
+type Identity struct {
+	Issuer        string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Groups        []string
+}
+
+// InGroup reports whether the identity belongs to any of groups
+func (i Identity) InGroup(groups []string) bool {
+	for _, group := range groups {
+		if slices.Contains(i.Groups, group) {
+			return true
+		}
+	}
+	return false
+}
+
+// Provider signs users in with an OpenID Connect identity provider. The CLI cannot
+// receive redirects, so sign-in uses the device authorization grant: the user approves
+// the login in a browser while the API waits for the provider to issue tokens.
+type Provider struct {
+	issuer       string
+	clientID     string
+	clientSecret string
+	scopes       []string
+	groupsClaim  string
+	httpClient   *http.Client
+
+	mu        sync.Mutex
+	discovery *discovery
+	keys      *keySet
+}
+
+// discovery is the part of the provider's OpenID configuration Nimbul uses
+type discovery struct {
+	Issuer                      string `json:"issuer"`
+	AuthorizationEndpoint       string `json:"authorization_endpoint"`
+	TokenEndpoint               string `json:"token_endpoint"`
+	DeviceAuthorizationEndpoint string `json:"device_authorization_endpoint"`
+	JWKSURI                     string `json:"jwks_uri"`
+}
+
+// NewFromEnv configures the provider from NIMBUL_OIDC_ISSUER and NIMBUL_OIDC_CLIENT_ID,
+// with the optional NIMBUL_OIDC_CLIENT_SECRET, NIMBUL_OIDC_SCOPES (space-separated,
+// default "openid email profile") and NIMBUL_OIDC_GROUPS_CLAIM (default "groups").
+// Returns ErrNotConfigured when no issuer is set.
+func NewFromEnv() (*Provider, error) {
+	issuer := strings.TrimSuffix(os.Getenv("NIMBUL_OIDC_ISSUER"), "/")
+	if issuer == "" {
+		return nil, ErrNotConfigured
+	}
+
+	clientID := os.Getenv("NIMBUL_OIDC_CLIENT_ID")
+	if clientID == "" {
+		return nil, fmt.Errorf("NIMBUL_OIDC_CLIENT_ID environment variable is not set")
+	}
+
+	scopes := strings.Fields(os.Getenv("NIMBUL_OIDC_SCOPES"))
+	if len(scopes) == 0 {
+		scopes = []string{"openid", "email", "profile"}
+	}
+
+	groupsClaim := os.Getenv("NIMBUL_OIDC_GROUPS_CLAIM")
+	if groupsClaim == "" {
+		groupsClaim = "groups"
+	}
+
+	return &Provider{
+		issuer:       issuer,
+		clientID:     clientID,
+		clientSecret: os.Getenv("NIMBUL_OIDC_CLIENT_SECRET"),
+		scopes:       scopes,
+		groupsClaim:  groupsClaim,
+		httpClient:   &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+// Issuer returns the issuer URL of the provider
+func (p *Provider) Issuer() string {
+	return p.issuer
+}
+
+// StartDeviceLogin asks the provider for a code the user enters at its verification URI
+func (p *Provider) StartDeviceLogin(ctx context.Context) (*oauth2.DeviceAuthResponse, error) {
+	config, err := p.oauthConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if config.Endpoint.DeviceAuthURL == "" {
+		return nil, ErrDeviceFlowDisabled
+	}
+
+	device, err := config.DeviceAuth(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start device login: %w", err)
+	}
+	return device, nil
+}
+
+// WaitForDeviceLogin polls the provider until the user approved or denied the login,
+// or the device code expired, and returns the identity from the verified ID token
+func (p *Provider) WaitForDeviceLogin(ctx context.Context, device *oauth2.DeviceAuthResponse) (*Identity, error) {
+	config, err := p.oauthConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := config.DeviceAccessToken(ctx, device)
+	if err != nil {
+		return nil, fmt.Errorf("device login was not completed: %w", err)
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		return nil, fmt.Errorf("%w: the provider did not return one, is the openid scope requested?", ErrInvalidIDToken)
+	}
+
+	return p.verify(ctx, rawIDToken)
+}
+
+// verify checks the signature, issuer, audience and expiry of an ID token
+func (p *Provider) verify(ctx context.Context, rawIDToken string) (*Identity, error) {
+	keys, err := p.keySet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(rawIDToken, claims, keys.keyFunc(ctx),
+		jwt.WithIssuer(p.issuer),
+		jwt.WithAudience(p.clientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidIDToken, err)
+	}
+
+	identity := &Identity{Issuer: p.issuer}
+	identity.Subject, _ = claims["sub"].(string)
+	identity.Email, _ = claims["email"].(string)
+	identity.EmailVerified, _ = claims["email_verified"].(bool)
+	if identity.Subject == "" {
+		return nil, fmt.Errorf("%w: missing sub claim", ErrInvalidIDToken)
+	}
+
+	// Providers send a single group as a string
+	switch groups := claims[p.groupsClaim].(type) {
+	case string:
+		identity.Groups = []string{groups}
+	case []any:
+		for _, group := range groups {
+			if name, ok := group.(string); ok {
+				identity.Groups = append(identity.Groups, name)
+			}
+		}
+	}
+
+	return identity, nil
+}
+
+// oauthConfig builds the OAuth2 client configuration from the provider's discovery document
+func (p *Provider) oauthConfig(ctx context.Context) (*oauth2.Config, error) {
+	discovery, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &oauth2.Config{
+		ClientID:     p.clientID,
+		ClientSecret: p.clientSecret,
+		Scopes:       p.scopes,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:       discovery.AuthorizationEndpoint,
+			TokenURL:      discovery.TokenEndpoint,
+			DeviceAuthURL: discovery.DeviceAuthorizationEndpoint,
+		},
+	}, nil
+}
+
+// discover fetches the provider's OpenID configuration once
+func (p *Provider) discover(ctx context.Context) (*discovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+
+	var doc discovery
+	if err := p.getJSON(ctx, p.issuer+"/.well-known/openid-configuration", &doc); err != nil {
+		return nil, fmt.Errorf("failed to discover OIDC provider: %w", err)
+	}
+
+	if strings.TrimSuffix(doc.Issuer, "/") != p.issuer {
+		return nil, fmt.Errorf("OIDC provider reports issuer %q, expected %q", doc.Issuer, p.issuer)
+	}
+
+	p.discovery = &doc
+	return p.discovery, nil
+}
+
+// getJSON decodes the JSON response of a GET request to url into v
+func (p *Provider) getJSON(ctx context.Context, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
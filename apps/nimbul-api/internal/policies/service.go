@@ -0,0 +1,236 @@
+package policies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/db"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/ext"
+)
+
+// Actions taken on manifests that don't comply with a policy
+const (
+	ActionWarn  = "warn"  // the deploy goes ahead, reporting the violation
+	ActionBlock = "block" // nothing is applied
+)
+
+// costLimit bounds the work of evaluating a policy against one manifest, so a policy
+// cannot stall deploys
+const costLimit = 1_000_000
+
+var (
+	ErrPolicyNotFound    = errors.New("policy not found")
+	ErrInvalidName       = errors.New("policy names may only contain lowercase letters, digits and dashes")
+	ErrInvalidAction     = errors.New("policy action must be warn or block")
+	ErrInvalidExpression = errors.New("invalid policy expression")
+)
+
+var namePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,62}$`)
+
+// Service manages the deploy policies of owners and checks manifests against them.
+// Policies are CEL expressions over a manifest, available as object, and the
+// repository of the config, available as repo, with the string extensions such as
+// split and lowerAscii.
+type Service struct {
+	queries *db.Queries
+	env     *cel.Env
+}
+
+func NewService(queries *db.Queries) *Service {
+	env, err := cel.NewEnv(
+		cel.Variable("object", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("repo", cel.StringType),
+		ext.Strings(),
+	)
+	if err != nil {
+		// The declarations are fixed, so this cannot fail at runtime
+		panic(fmt.Sprintf("failed to create policy environment: %v", err))
+	}
+
+	return &Service{
+		queries: queries,
+		env:     env,
+	}
+}
+
+type Policy struct {
+	Name       string
+	Expression string
+	Message    string
+	Action     string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type SetParams struct {
+	Expression string
+	Message    string
+	Action     string
+}
+
+// List returns the policies of an owner by name
+func (s *Service) List(ctx context.Context, ownerID string) ([]Policy, error) {
+	rows, err := s.queries.GetDeployPoliciesByOwnerID(ctx, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get policies: %w", err)
+	}
+
+	policies := make([]Policy, len(rows))
+	for i, row := range rows {
+		policies[i] = toPolicy(row)
+	}
+	return policies, nil
+}
+
+// Set creates or replaces the policy of an owner with the given name
+func (s *Service) Set(ctx context.Context, ownerID, name string, params SetParams) (*Policy, error) {
+	if !namePattern.MatchString(name) {
+		return nil, ErrInvalidName
+	}
+	if params.Action != ActionWarn && params.Action != ActionBlock {
+		return nil, ErrInvalidAction
+	}
+	if _, err := s.compile(params.Expression); err != nil {
+		return nil, err
+	}
+
+	row, err := s.queries.UpsertDeployPolicy(ctx, db.UpsertDeployPolicyParams{
+		OwnerID:    ownerID,
+		Name:       name,
+		Expression: params.Expression,
+		Message:    params.Message,
+		Action:     params.Action,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to store policy: %w", err)
+	}
+
+	policy := toPolicy(row)
+	return &policy, nil
+}
+
+// Delete removes a policy of an owner
+func (s *Service) Delete(ctx context.Context, ownerID, name string) error {
+	rows, err := s.queries.DeleteDeployPolicy(ctx, db.DeleteDeployPolicyParams{
+		OwnerID: ownerID,
+		Name:    name,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete policy: %w", err)
+	}
+	if rows == 0 {
+		return ErrPolicyNotFound
+	}
+	return nil
+}
+
+// Violation is a manifest that does not comply with a policy
+type Violation struct {
+	Policy   string
+	Action   string
+	Message  string
+	Resource string // kind/name of the manifest
+}
+
+func (v Violation) String() string {
+	return fmt.Sprintf("%s: %s (policy %s)", v.Resource, v.Message, v.Policy)
+}
+
+// Check evaluates the policies of an owner against the manifests of a deploy of repo.
+// A policy that cannot be evaluated against a manifest, e.g. because it reads a field
+// the manifest does not have, counts as a violation.
+func (s *Service) Check(ctx context.Context, ownerID, repo string, manifests []map[string]interface{}) ([]Violation, error) {
+	policies, err := s.List(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	return s.evaluate(ctx, policies, repo, manifests), nil
+}
+
+func (s *Service) evaluate(ctx context.Context, policies []Policy, repo string, manifests []map[string]interface{}) []Violation {
+	var violations []Violation
+	for _, policy := range policies {
+		program, err := s.compile(policy.Expression)
+		if err != nil {
+			// Stored policies were valid when set; report rather than skip them
+			violations = append(violations, Violation{Policy: policy.Name, Action: policy.Action, Message: err.Error()})
+			continue
+		}
+
+		for _, manifest := range manifests {
+			violation := Violation{
+				Policy:   policy.Name,
+				Action:   policy.Action,
+				Message:  policy.Message,
+				Resource: resourceName(manifest),
+			}
+
+			out, _, err := program.ContextEval(ctx, map[string]interface{}{
+				"object": manifest,
+				"repo":   repo,
+			})
+			if err != nil {
+				violation.Message = fmt.Sprintf("could not be evaluated: %v", err)
+				violations = append(violations, violation)
+				continue
+			}
+			if complies, ok := out.Value().(bool); !ok || !complies {
+				violations = append(violations, violation)
+			}
+		}
+	}
+	return violations
+}
+
+// Blocking returns the violations of policies that block deploys
+func Blocking(violations []Violation) []Violation {
+	var blocking []Violation
+	for _, violation := range violations {
+		if violation.Action == ActionBlock {
+			blocking = append(blocking, violation)
+		}
+	}
+	return blocking
+}
+
+// compile checks that an expression is valid CEL returning a bool
+func (s *Service) compile(expression string) (cel.Program, error) {
+	ast, issues := s.env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidExpression, issues.Err())
+	}
+	if ast.OutputType() != cel.BoolType {
+		return nil, fmt.Errorf("%w: it must evaluate to a bool, not %s", ErrInvalidExpression, ast.OutputType())
+	}
+
+	program, err := s.env.Program(ast, cel.CostLimit(costLimit))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidExpression, err)
+	}
+	return program, nil
+}
+
+// resourceName names a manifest as kind/name
+func resourceName(manifest map[string]interface{}) string {
+	kind, _ := manifest["kind"].(string)
+	name := ""
+	if metadata, ok := manifest["metadata"].(map[string]interface{}); ok {
+		name, _ = metadata["name"].(string)
+	}
+	return kind + "/" + name
+}
+
+func toPolicy(row db.DeployPolicy) Policy {
+	return Policy{
+		Name:       row.Name,
+		Expression: row.Expression,
+		Message:    row.Message,
+		Action:     row.Action,
+		CreatedAt:  row.CreatedAt.Time,
+		UpdatedAt:  row.UpdatedAt.Time,
+	}
+}
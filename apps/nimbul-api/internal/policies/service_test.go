@@ -0,0 +1,105 @@
+package policies
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/coding-cave-dev/nimbul/internal/nimbulconfig"
+)
+
+const manifests = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: api
+  namespace: team-a
+spec:
+  template:
+    spec:
+      containers:
+        - name: api
+          image: ghcr.io/acme/api:latest
+          resources:
+            limits:
+              memory: 256Mi
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: api
+  namespace: team-b
+`
+
+func TestEvaluate(t *testing.T) {
+	docs, err := nimbulconfig.ParseManifestBytes([]byte(manifests))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := NewService(nil)
+	policies := []Policy{
+		{
+			Name:       "no-latest",
+			Expression: `object.kind != "Deployment" || !object.spec.template.spec.containers.exists(c, c.image.endsWith(":latest"))`,
+			Message:    "images must not use the latest tag",
+			Action:     ActionBlock,
+		},
+		{
+			Name:       "team-namespace",
+			Expression: `object.metadata.namespace == "team-a"`,
+			Message:    "resources must be in the team's namespace",
+			Action:     ActionWarn,
+		},
+		{
+			Name:       "limits",
+			Expression: `object.kind != "Deployment" || object.spec.template.spec.containers.all(c, has(c.resources.limits))`,
+			Message:    "containers must set resource limits",
+			Action:     ActionBlock,
+		},
+		{
+			Name:       "missing-field",
+			Expression: `object.spec.replicas > 0`,
+			Message:    "replicas must be set",
+			Action:     ActionWarn,
+		},
+	}
+
+	violations := s.evaluate(context.Background(), policies, "acme/api", docs)
+
+	want := []string{
+		"Deployment/api: images must not use the latest tag (policy no-latest)",
+		"Service/api: resources must be in the team's namespace (policy team-namespace)",
+	}
+	for _, w := range want {
+		found := false
+		for _, v := range violations {
+			if v.String() == w {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("missing violation %q in %v", w, violations)
+		}
+	}
+
+	// Both manifests lack spec.replicas, which cannot be evaluated
+	if got := len(violations); got != len(want)+2 {
+		t.Errorf("got %d violations, want %d: %v", got, len(want)+2, violations)
+	}
+	if got := len(Blocking(violations)); got != 1 {
+		t.Errorf("got %d blocking violations, want 1", got)
+	}
+}
+
+func TestCompile(t *testing.T) {
+	s := NewService(nil)
+
+	if _, err := s.compile(`object.kind == "Deployment"`); err != nil {
+		t.Errorf("compile() error = %v", err)
+	}
+	for _, expression := range []string{`object.kind ==`, `object.kind`, `unknown == 1`} {
+		if _, err := s.compile(expression); !errors.Is(err, ErrInvalidExpression) {
+			t.Errorf("compile(%q) error = %v, want ErrInvalidExpression", expression, err)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package previews
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/configs"
+	"github.com/coding-cave-dev/nimbul/internal/deployments"
+	"github.com/coding-cave-dev/nimbul/internal/k8s"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	// DefaultTTL is how long a preview may go without a deploy before it is reaped,
+	// unless its nimbul.yaml sets preview.ttl
+	DefaultTTL = 72 * time.Hour
+	// DefaultInterval is how often the reaper looks for stale previews
+	DefaultInterval = 10 * time.Minute
+)
+
+// ClusterConfigFunc resolves the Kubernetes REST config a config deploys to
+type ClusterConfigFunc func(ctx context.Context, config *configs.Config) (*rest.Config, error)
+
+// Reaper periodically deletes preview namespaces that have been idle for longer than their TTL.
+// Namespaces of deleted branches are removed when the branch deletion is pushed; the reaper
+// catches everything else, e.g. branches that stopped receiving pushes or missed webhooks.
+type Reaper struct {
+	configsService     *configs.Service
+	deploymentsService *deployments.Service
+	clusterConfig      ClusterConfigFunc
+	ttl                time.Duration
+	interval           time.Duration
+}
+
+// NewReaper creates a reaper. The default TTL and sweep interval can be overridden with
+// NIMBUL_PREVIEW_TTL and NIMBUL_PREVIEW_REAP_INTERVAL (Go durations, e.g. "24h").
+func NewReaper(configsService *configs.Service, deploymentsService *deployments.Service, clusterConfig ClusterConfigFunc) *Reaper {
+	return &Reaper{
+		configsService:     configsService,
+		deploymentsService: deploymentsService,
+		clusterConfig:      clusterConfig,
+		ttl:                durationFromEnv("NIMBUL_PREVIEW_TTL", DefaultTTL),
+		interval:           durationFromEnv("NIMBUL_PREVIEW_REAP_INTERVAL", DefaultInterval),
+	}
+}
+
+// Run sweeps for stale previews every interval until ctx is cancelled
+func (r *Reaper) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.Sweep(ctx)
+		}
+	}
+}
+
+// Sweep deletes the stale preview namespaces of every config. Failures are logged and
+// do not stop the sweep; the next sweep retries them.
+func (r *Reaper) Sweep(ctx context.Context) {
+	allConfigs, err := r.configsService.GetAllConfigs(ctx)
+	if err != nil {
+		fmt.Printf("Warning: Preview reaper failed to list configs: %v\n", err)
+		return
+	}
+
+	now := time.Now()
+	for i := range allConfigs {
+		config := &allConfigs[i]
+
+		// The API server cannot reach clusters managed by an agent, nor those of configs
+		// without a cluster credential
+		if config.AgentID != nil || config.ClusterCredentialID == nil {
+			continue
+		}
+
+		clusterConfig, err := r.clusterConfig(ctx, config)
+		if err != nil {
+			fmt.Printf("Warning: Preview reaper failed to resolve cluster for config %s: %v\n", config.ID, err)
+			continue
+		}
+
+		namespaces, err := k8s.ListPreviewNamespaces(ctx, clusterConfig, config.ID)
+		if err != nil {
+			fmt.Printf("Warning: Preview reaper failed to list previews of config %s: %v\n", config.ID, err)
+			continue
+		}
+
+		for _, ns := range namespaces {
+			if !r.expired(ns, now) {
+				continue
+			}
+
+			if err := k8s.DeletePreviewNamespace(ctx, clusterConfig, ns.Name, config.ID); err != nil {
+				fmt.Printf("Warning: Preview reaper failed to delete %s: %v\n", ns.Name, err)
+				continue
+			}
+			if err := r.deploymentsService.DeleteEnvironment(ctx, config.ID, ns.Name); err != nil {
+				fmt.Printf("Warning: Preview reaper failed to forget environment %s: %v\n", ns.Name, err)
+			}
+			fmt.Printf("✓ Reaped preview namespace %s (branch %s, last deployed %s)\n", ns.Name, ns.Branch, ns.LastDeployedAt.Format(time.RFC3339))
+		}
+	}
+}
+
+// expired reports whether a preview has gone without a deploy for longer than its TTL
+func (r *Reaper) expired(ns k8s.PreviewNamespace, now time.Time) bool {
+	ttl := ns.TTL
+	if ttl == 0 {
+		ttl = r.ttl
+	}
+	return now.Sub(ns.LastDeployedAt) > ttl
+}
+
+// durationFromEnv parses a duration from an environment variable, falling back to def
+// when it is unset or invalid
+func durationFromEnv(key string, def time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return def
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration <= 0 {
+		fmt.Printf("Warning: Invalid %s %q, using %s\n", key, value, def)
+		return def
+	}
+
+	return duration
+}
@@ -0,0 +1,181 @@
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/configs"
+	"github.com/coding-cave-dev/nimbul/internal/github"
+)
+
+// DefaultInterval is how often every config is checked against GitHub
+const DefaultInterval = 6 * time.Hour
+
+// Reconciler cross-checks stored configs against GitHub and records their health: it
+// notices deleted repositories, removed webhooks and uninstalled GitHub Apps, follows
+// renamed repositories, and subscribes webhooks created before Nimbul handled repository
+// events to them.
+type Reconciler struct {
+	configsService *configs.Service
+	interval       time.Duration
+}
+
+// Result is the outcome of reconciling one config
+type Result struct {
+	Config *configs.Config
+	// RenamedFrom is the previous name of a repository whose rename was followed
+	RenamedFrom string
+}
+
+// NewReconciler creates a reconciler. The interval can be overridden with
+// NIMBUL_RECONCILE_INTERVAL (a Go duration, e.g. "1h").
+func NewReconciler(configsService *configs.Service) *Reconciler {
+	interval := DefaultInterval
+	if value := os.Getenv("NIMBUL_RECONCILE_INTERVAL"); value != "" {
+		if parsed, err := time.ParseDuration(value); err == nil && parsed > 0 {
+			interval = parsed
+		} else {
+			fmt.Printf("Warning: Invalid NIMBUL_RECONCILE_INTERVAL %q, using %s\n", value, interval)
+		}
+	}
+
+	return &Reconciler{
+		configsService: configsService,
+		interval:       interval,
+	}
+}
+
+// Run reconciles every config every interval until ctx is cancelled
+func (r *Reconciler) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.Sweep(ctx)
+		}
+	}
+}
+
+// Sweep reconciles every config. Failures are logged and do not stop the sweep; the
+// next sweep retries them.
+func (r *Reconciler) Sweep(ctx context.Context) {
+	configList, err := r.configsService.GetAllConfigs(ctx)
+	if err != nil {
+		fmt.Printf("Warning: Reconciler failed to list configs: %v\n", err)
+		return
+	}
+
+	for i := range configList {
+		if _, err := r.Reconcile(ctx, &configList[i]); err != nil {
+			fmt.Printf("Warning: Failed to reconcile config %s: %v\n", configList[i].ID, err)
+		}
+	}
+}
+
+// Reconcile checks a config against GitHub and records its health. Errors reaching
+// GitHub leave the recorded health as it was.
+func (r *Reconciler) Reconcile(ctx context.Context, config *configs.Config) (*Result, error) {
+	result := &Result{Config: config}
+	if config.Provider != "github" {
+		return result, nil
+	}
+
+	health, detail, err := r.check(ctx, result)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.configsService.SetHealth(ctx, result.Config.ID, health, detail); err != nil {
+		return nil, err
+	}
+	updated, err := r.configsService.GetConfigByID(ctx, result.Config.ID)
+	if err != nil {
+		return nil, err
+	}
+	result.Config = updated
+	return result, nil
+}
+
+// check finds the health of result's config, following a rename of its repository
+func (r *Reconciler) check(ctx context.Context, result *Result) (health, detail string, err error) {
+	config := result.Config
+
+	installationID, err := github.GetInstallationIDByRepository(ctx, config.RepoOwner, config.RepoName)
+	if github.IsNotFound(err) {
+		// GitHub does not tell a deleted repository from one the app cannot see, but an
+		// account without the app can only mean it was uninstalled
+		appAuth, err := github.NewAppAuth(ctx, 0) // app-level calls need no installation
+		if err != nil {
+			return "", "", err
+		}
+		installed, err := appAuth.HasAccountInstallation(ctx, config.RepoOwner)
+		if err != nil {
+			return "", "", err
+		}
+		if !installed {
+			return configs.HealthAppUninstalled, fmt.Sprintf("The GitHub App is not installed on %s anymore; install it again to resume builds", config.RepoOwner), nil
+		}
+		return configs.HealthRepoNotFound, fmt.Sprintf("%s was deleted, or the GitHub App lost access to it; grant the app access again, or delete the config", config.RepoFullName), nil
+	}
+	if err != nil {
+		return "", "", err
+	}
+
+	appAuth, err := github.NewAppAuth(ctx, installationID)
+	if err != nil {
+		return "", "", err
+	}
+	client, err := appAuth.GetInstallationClient(ctx)
+	if err != nil {
+		return "", "", err
+	}
+
+	repo, err := github.GetRepository(ctx, client, config.RepoOwner, config.RepoName)
+	if github.IsNotFound(err) {
+		return configs.HealthRepoNotFound, fmt.Sprintf("%s was deleted, or the GitHub App lost access to it; grant the app access again, or delete the config", config.RepoFullName), nil
+	}
+	if err != nil {
+		return "", "", err
+	}
+
+	// The old name of a renamed repository can be taken by another repository, so the
+	// config moves to the new name rather than relying on GitHub's redirect
+	if !strings.EqualFold(repo.FullName, config.RepoFullName) {
+		renamed, err := r.configsService.RenameRepository(ctx, config.ID, repo.Owner, repo.Name, repo.FullName, repo.CloneURL)
+		if errors.Is(err, configs.ErrConfigExists) {
+			return configs.HealthRepoNotFound, fmt.Sprintf("%s was renamed to %s, which already has another config; delete one of them", config.RepoFullName, repo.FullName), nil
+		}
+		if err != nil {
+			return "", "", err
+		}
+		fmt.Printf("Config %s follows the rename of %s to %s\n", config.ID, config.RepoFullName, repo.FullName)
+		result.RenamedFrom = config.RepoFullName
+		result.Config = renamed
+		config = renamed
+	}
+
+	if config.WebhookID == nil {
+		return configs.HealthWebhookMissing, "The config has no GitHub webhook, so pushes do not trigger builds; run 'nimbul init --adopt' in the repository to create it", nil
+	}
+	exists, err := github.SyncHook(ctx, client, config.RepoOwner, config.RepoName, *config.WebhookID)
+	if err != nil {
+		return "", "", err
+	}
+	if !exists {
+		// Forgotten so that a new webhook can be created in its place
+		if err := r.configsService.ClearWebhookID(ctx, config.ID, *config.WebhookID); err != nil {
+			return "", "", err
+		}
+		return configs.HealthWebhookMissing, "The GitHub webhook was removed, so pushes do not trigger builds; run 'nimbul init --adopt' in the repository to create it again", nil
+	}
+
+	return configs.HealthOK, "", nil
+}
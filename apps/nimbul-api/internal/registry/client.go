@@ -0,0 +1,179 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/containerd/containerd/v2/core/remotes"
+	"github.com/containerd/containerd/v2/core/remotes/docker"
+	"github.com/distribution/reference"
+	"github.com/docker/cli/cli/config"
+)
+
+// Client talks to container registries with the credentials of a Docker config
+// directory, the same BuildKit pushes images with
+type Client struct {
+	DockerConfig string // e.g. ~/.docker or /docker (mounted secret)
+	// Login, when set, is used for its registry instead of the Docker config's credentials
+	Login *Login
+}
+
+func NewFromEnv() *Client {
+	dcfg := os.Getenv("DOCKER_CONFIG")
+	if dcfg == "" {
+		home, _ := os.UserHomeDir()
+		dcfg = filepath.Join(home, ".docker")
+	}
+	return &Client{DockerConfig: dcfg}
+}
+
+// Repository returns the fully qualified repository of an image reference, without
+// tag or digest, e.g. docker.io/library/nginx for nginx:1.27
+func Repository(imageRef string) (string, error) {
+	named, err := reference.ParseNormalizedNamed(imageRef)
+	if err != nil {
+		return "", fmt.Errorf("invalid image reference %q: %w", imageRef, err)
+	}
+	return named.Name(), nil
+}
+
+// NormalizeReference returns the fully qualified form of an image reference, with the
+// latest tag when it has neither tag nor digest, e.g. docker.io/library/nginx:latest
+// for nginx
+func NormalizeReference(imageRef string) (string, error) {
+	named, err := reference.ParseNormalizedNamed(imageRef)
+	if err != nil {
+		return "", fmt.Errorf("invalid image reference %q: %w", imageRef, err)
+	}
+	return reference.TagNameOnly(named).String(), nil
+}
+
+// PinDigest returns imageRef pinned to digest. The tag is kept for readability; the
+// digest decides what is pulled.
+func PinDigest(imageRef, digest string) (string, error) {
+	named, err := reference.ParseNormalizedNamed(imageRef)
+	if err != nil {
+		return "", fmt.Errorf("invalid image reference %q: %w", imageRef, err)
+	}
+	return reference.FamiliarString(reference.TagNameOnly(named)) + "@" + digest, nil
+}
+
+// Resolver returns a resolver for reading images from registries
+func (c *Client) Resolver() (remotes.Resolver, error) {
+	hosts, err := c.hosts()
+	if err != nil {
+		return nil, err
+	}
+	return docker.NewResolver(docker.ResolverOptions{Hosts: hosts}), nil
+}
+
+// ResolveDigest returns the digest of the manifest an image tag points at
+func (c *Client) ResolveDigest(ctx context.Context, imageRef string) (string, error) {
+	named, err := reference.ParseNormalizedNamed(imageRef)
+	if err != nil {
+		return "", fmt.Errorf("invalid image reference %q: %w", imageRef, err)
+	}
+
+	resolver, err := c.Resolver()
+	if err != nil {
+		return "", err
+	}
+
+	_, desc, err := resolver.Resolve(ctx, reference.TagNameOnly(named).String())
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve %s: %w", imageRef, err)
+	}
+
+	return desc.Digest.String(), nil
+}
+
+// DeleteManifest deletes a manifest by digest from the repository of imageRef.
+// Registries remove every tag pointing at the manifest along with it.
+func (c *Client) DeleteManifest(ctx context.Context, imageRef, digest string) error {
+	named, err := reference.ParseNormalizedNamed(imageRef)
+	if err != nil {
+		return fmt.Errorf("invalid image reference %q: %w", imageRef, err)
+	}
+
+	hosts, err := c.hosts()
+	if err != nil {
+		return err
+	}
+	registryHosts, err := hosts(reference.Domain(named))
+	if err != nil {
+		return err
+	}
+	host := registryHosts[0]
+
+	path := reference.Path(named)
+	ctx = docker.WithScope(ctx, "repository:"+path+":delete")
+	url := fmt.Sprintf("%s://%s%s/%s/manifests/%s", host.Scheme, host.Host, host.Path, path, digest)
+
+	// The first attempt usually answers with an authentication challenge
+	var responses []*http.Response
+	for attempt := 0; attempt < 2; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
+		if err != nil {
+			return err
+		}
+		if err := host.Authorizer.Authorize(ctx, req); err != nil {
+			return fmt.Errorf("failed to authorize with %s: %w", host.Host, err)
+		}
+
+		resp, err := host.Client.Do(req)
+		if err != nil {
+			return fmt.Errorf("failed to delete %s@%s: %w", named.Name(), digest, err)
+		}
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		resp.Body.Close()
+
+		switch resp.StatusCode {
+		case http.StatusAccepted, http.StatusOK, http.StatusNotFound:
+			// Already gone counts as deleted
+			return nil
+		case http.StatusUnauthorized:
+			responses = append(responses, resp)
+			if err := host.Authorizer.AddResponses(ctx, responses); err != nil {
+				return fmt.Errorf("failed to authenticate with %s: %w", host.Host, err)
+			}
+		default:
+			return fmt.Errorf("failed to delete %s@%s: %s: %s", named.Name(), digest, resp.Status, strings.TrimSpace(string(body)))
+		}
+	}
+
+	return fmt.Errorf("failed to delete %s@%s: unauthorized", named.Name(), digest)
+}
+
+// hosts configures registry hosts that authenticate with the Docker config's credentials
+func (c *Client) hosts() (docker.RegistryHosts, error) {
+	dockerConfig, err := config.Load(c.DockerConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load docker config: %w", err)
+	}
+	if c.Login != nil {
+		c.Login.Apply(dockerConfig)
+	}
+
+	creds := func(host string) (string, string, error) {
+		if host == "registry-1.docker.io" {
+			host = "https://index.docker.io/v1/"
+		}
+		auth, err := dockerConfig.GetAuthConfig(host)
+		if err != nil {
+			return "", "", err
+		}
+		if auth.IdentityToken != "" {
+			return "", auth.IdentityToken, nil
+		}
+		return auth.Username, auth.Password, nil
+	}
+
+	return docker.ConfigureDefaultRegistries(
+		docker.WithAuthorizer(docker.NewDockerAuthorizer(docker.WithAuthCreds(creds))),
+	), nil
+}
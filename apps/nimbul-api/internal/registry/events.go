@@ -0,0 +1,151 @@
+package registry
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/distribution/reference"
+	"github.com/google/go-github/v81/github"
+)
+
+// ErrUnsupportedEvent is returned for registry webhook payloads that do not announce
+// a pushed image tag
+var ErrUnsupportedEvent = errors.New("unsupported registry event")
+
+// Push is an image tag pushed to a registry
+type Push struct {
+	Repository string // fully qualified, e.g. docker.io/acme/api
+	Tag        string
+	Digest     string    // empty when the registry does not send it
+	Pusher     string    // registry user who pushed, if known
+	PushedAt   time.Time // zero when the registry does not send it
+}
+
+// Reference returns the tagged image reference of the push
+func (p Push) Reference() string {
+	return p.Repository + ":" + p.Tag
+}
+
+// dockerHubEvent is the payload of a Docker Hub repository webhook
+type dockerHubEvent struct {
+	PushData *struct {
+		Tag      string `json:"tag"`
+		Pusher   string `json:"pusher"`
+		PushedAt int64  `json:"pushed_at"`
+	} `json:"push_data"`
+	Repository struct {
+		RepoName string `json:"repo_name"`
+	} `json:"repository"`
+}
+
+// harborEvent is the payload of a Harbor webhook in the default payload format
+type harborEvent struct {
+	Type      string `json:"type"`
+	OccurAt   int64  `json:"occur_at"`
+	Operator  string `json:"operator"`
+	EventData *struct {
+		Resources []struct {
+			Digest      string `json:"digest"`
+			Tag         string `json:"tag"`
+			ResourceURL string `json:"resource_url"`
+		} `json:"resources"`
+	} `json:"event_data"`
+}
+
+// ParseEvent parses the pushes announced by a Docker Hub or Harbor webhook
+func ParseEvent(payload []byte) ([]Push, error) {
+	var harbor harborEvent
+	if err := json.Unmarshal(payload, &harbor); err != nil {
+		return nil, fmt.Errorf("invalid registry event: %w", err)
+	}
+	if harbor.EventData != nil {
+		if harbor.Type != "PUSH_ARTIFACT" {
+			return nil, ErrUnsupportedEvent
+		}
+
+		var pushes []Push
+		for _, resource := range harbor.EventData.Resources {
+			if resource.Tag == "" {
+				continue
+			}
+			repository, err := Repository(resource.ResourceURL)
+			if err != nil {
+				return nil, err
+			}
+			pushes = append(pushes, Push{Repository: repository, Tag: resource.Tag, Digest: resource.Digest, Pusher: harbor.Operator, PushedAt: unixTime(harbor.OccurAt)})
+		}
+		return pushes, nil
+	}
+
+	var hub dockerHubEvent
+	if err := json.Unmarshal(payload, &hub); err != nil {
+		return nil, fmt.Errorf("invalid registry event: %w", err)
+	}
+	if hub.PushData == nil || hub.PushData.Tag == "" || hub.Repository.RepoName == "" {
+		return nil, ErrUnsupportedEvent
+	}
+
+	repository, err := Repository(hub.Repository.RepoName)
+	if err != nil {
+		return nil, err
+	}
+
+	return []Push{{Repository: repository, Tag: hub.PushData.Tag, Pusher: hub.PushData.Pusher, PushedAt: unixTime(hub.PushData.PushedAt)}}, nil
+}
+
+// unixTime converts a Unix timestamp of a payload, the zero time when it is unset
+func unixTime(seconds int64) time.Time {
+	if seconds == 0 {
+		return time.Time{}
+	}
+	return time.Unix(seconds, 0)
+}
+
+// PushFromPackageEvent returns the image tag a GitHub Container Registry package
+// event announces, or false for other packages and untagged versions
+func PushFromPackageEvent(event *github.RegistryPackageEvent) (Push, bool) {
+	pkg := event.GetRegistryPackage()
+	if !strings.EqualFold(pkg.GetPackageType(), "container") {
+		return Push{}, false
+	}
+
+	tag := pkg.GetPackageVersion().GetContainerMetadata().GetTag()
+	if tag.GetName() == "" {
+		return Push{}, false
+	}
+
+	namespace := pkg.GetNamespace()
+	if namespace == "" {
+		namespace = pkg.GetOwner().GetLogin()
+	}
+
+	return Push{
+		Repository: strings.ToLower("ghcr.io/" + namespace + "/" + pkg.GetName()),
+		Tag:        tag.GetName(),
+		Digest:     tag.GetDigest(),
+		Pusher:     event.GetSender().GetLogin(),
+	}, true
+}
+
+// Pin returns image pinned to the pushed digest when it refers to the pushed tag, so
+// the rollout picks up the new image even though the tag did not change. Other images
+// are returned unchanged.
+func (p Push) Pin(image string) string {
+	named, err := reference.ParseNormalizedNamed(image)
+	if err != nil || named.Name() != p.Repository {
+		return image
+	}
+	if _, digested := named.(reference.Digested); digested {
+		return image
+	}
+
+	tagged, ok := reference.TagNameOnly(named).(reference.NamedTagged)
+	if !ok || tagged.Tag() != p.Tag {
+		return image
+	}
+
+	return reference.FamiliarString(tagged) + "@" + p.Digest
+}
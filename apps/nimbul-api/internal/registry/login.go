@@ -0,0 +1,90 @@
+package registry
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/distribution/reference"
+	"github.com/docker/cli/cli/config/configfile"
+	"github.com/docker/cli/cli/config/types"
+)
+
+// CredentialTypeLogin is the token type of stored registry logins. Their provider is
+// the registry they log in to, so an owner has one login per registry.
+const CredentialTypeLogin = "registry_login"
+
+// Login is the token of a stored registry login
+type Login struct {
+	Server   string `json:"server"` // e.g. ghcr.io or docker.io
+	Username string `json:"username"`
+	Password string `json:"password"` // password or access token
+}
+
+// ParseLogin decodes the token of a stored registry login
+func ParseLogin(data []byte) (*Login, error) {
+	var login Login
+	if err := json.Unmarshal(data, &login); err != nil {
+		return nil, fmt.Errorf("invalid registry login: %w", err)
+	}
+	if login.Server == "" || login.Username == "" || login.Password == "" {
+		return nil, fmt.Errorf("registry login requires server, username and password")
+	}
+	login.Server = NormalizeServer(login.Server)
+	return &login, nil
+}
+
+// NormalizeServer returns the host of a registry as it appears in image references,
+// e.g. docker.io for https://index.docker.io/v1/
+func NormalizeServer(server string) string {
+	server = strings.TrimPrefix(strings.TrimPrefix(server, "https://"), "http://")
+	server, _, _ = strings.Cut(server, "/")
+	switch server {
+	case "index.docker.io", "registry-1.docker.io":
+		return "docker.io"
+	}
+	return server
+}
+
+// AuthKey returns the key Docker config files store the login under
+func (l Login) AuthKey() string {
+	return AuthKey(l.Server)
+}
+
+// AuthKey returns the key Docker config files store the credentials of a registry
+// under, given its host as it appears in image references
+func AuthKey(server string) string {
+	if server == "docker.io" {
+		return "https://index.docker.io/v1/"
+	}
+	return server
+}
+
+// Apply adds the login to a loaded Docker config, in place of any credentials it has
+// for the registry
+func (l Login) Apply(dockerConfig *configfile.ConfigFile) {
+	key := l.AuthKey()
+
+	// An empty helper makes the login win over a configured credential store
+	if dockerConfig.CredentialHelpers == nil {
+		dockerConfig.CredentialHelpers = make(map[string]string)
+	}
+	dockerConfig.CredentialHelpers[key] = ""
+	if dockerConfig.AuthConfigs == nil {
+		dockerConfig.AuthConfigs = make(map[string]types.AuthConfig)
+	}
+	dockerConfig.AuthConfigs[key] = types.AuthConfig{
+		ServerAddress: key,
+		Username:      l.Username,
+		Password:      l.Password,
+	}
+}
+
+// Host returns the registry of an image reference, e.g. docker.io for nginx:1.27
+func Host(imageRef string) (string, error) {
+	named, err := reference.ParseNormalizedNamed(imageRef)
+	if err != nil {
+		return "", fmt.Errorf("invalid image reference %q: %w", imageRef, err)
+	}
+	return reference.Domain(named), nil
+}
@@ -0,0 +1,123 @@
+package releases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/github"
+	ghub "github.com/google/go-github/v81/github"
+)
+
+// Assets of a CLI release are the binaries, named nimbul_<os>_<arch> with .exe on
+// Windows, and a manifest.json of the release version and the SHA-256 of each binary,
+// {"version": "v1.2.3", "checksums": {"nimbul_linux_amd64": "<hex>"}}, signed by
+// manifest.json.sig: the base64 Ed25519 signature of manifest.json. Both are written
+// by scripts/sign-release.sh.
+const (
+	ManifestAsset  = "manifest.json"
+	SignatureAsset = "manifest.json.sig"
+)
+
+// DefaultRepository publishes the CLI releases unless NIMBUL_CLI_RELEASES_REPO names another
+const DefaultRepository = "coding-cave-dev/nimbul"
+
+// cacheTTL is how long a looked up release is served before GitHub is asked again,
+// so fleets of CLIs checking for updates stay under its rate limit
+const cacheTTL = 10 * time.Minute
+
+var ErrReleaseNotFound = errors.New("no CLI release found")
+
+// Asset is a file of a release
+type Asset struct {
+	Name string
+	URL  string
+}
+
+// Release is the CLI release the CLIs of an instance should run
+type Release struct {
+	Version     string
+	URL         string // release page
+	PublishedAt time.Time
+	Assets      []Asset
+}
+
+type Service struct {
+	owner   string
+	repo    string
+	version string // release tag CLIs are pinned to, the latest release when empty
+	token   string
+
+	mu        sync.Mutex
+	cached    *Release
+	fetchedAt time.Time
+}
+
+// NewServiceFromEnv looks releases up in the GitHub repository NIMBUL_CLI_RELEASES_REPO
+// (owner/name, default DefaultRepository). NIMBUL_CLI_VERSION pins CLIs to the release
+// of that tag instead of the latest one, and NIMBUL_CLI_RELEASES_TOKEN authenticates
+// the lookups.
+func NewServiceFromEnv() (*Service, error) {
+	repository := os.Getenv("NIMBUL_CLI_RELEASES_REPO")
+	if repository == "" {
+		repository = DefaultRepository
+	}
+	owner, repo, ok := strings.Cut(repository, "/")
+	if !ok || owner == "" || repo == "" {
+		return nil, fmt.Errorf("NIMBUL_CLI_RELEASES_REPO must be owner/name, got %q", repository)
+	}
+
+	return &Service{
+		owner:   owner,
+		repo:    repo,
+		version: os.Getenv("NIMBUL_CLI_VERSION"),
+		token:   os.Getenv("NIMBUL_CLI_RELEASES_TOKEN"),
+	}, nil
+}
+
+// Current returns the release CLIs should update to
+func (s *Service) Current(ctx context.Context) (*Release, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cached != nil && time.Since(s.fetchedAt) < cacheTTL {
+		return s.cached, nil
+	}
+
+	client := ghub.NewClient(nil)
+	if s.token != "" {
+		client = github.NewClientWithToken(s.token)
+	}
+
+	var release *ghub.RepositoryRelease
+	var err error
+	if s.version != "" {
+		release, _, err = client.Repositories.GetReleaseByTag(ctx, s.owner, s.repo, s.version)
+	} else {
+		release, _, err = client.Repositories.GetLatestRelease(ctx, s.owner, s.repo)
+	}
+	if err != nil {
+		if github.IsNotFound(err) {
+			return nil, ErrReleaseNotFound
+		}
+		return nil, fmt.Errorf("failed to get release: %w", err)
+	}
+
+	result := &Release{
+		Version:     release.GetTagName(),
+		URL:         release.GetHTMLURL(),
+		PublishedAt: release.GetPublishedAt().Time,
+		Assets:      make([]Asset, len(release.Assets)),
+	}
+	for i, asset := range release.Assets {
+		result.Assets[i] = Asset{Name: asset.GetName(), URL: asset.GetBrowserDownloadURL()}
+	}
+
+	s.cached = result
+	s.fetchedAt = time.Now()
+	return result, nil
+}
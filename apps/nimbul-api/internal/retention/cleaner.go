@@ -0,0 +1,158 @@
+package retention
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/builds"
+	"github.com/coding-cave-dev/nimbul/internal/configs"
+	"github.com/coding-cave-dev/nimbul/internal/registry"
+)
+
+// DefaultInterval is how often the cleaner applies retention rules
+const DefaultInterval = time.Hour
+
+// Cleaner periodically deletes the registry tags that fall outside the retention rules
+// of their config: tags of builds beyond the newest N per branch, and tags of deleted
+// branches when previews are to be cleaned up.
+type Cleaner struct {
+	configsService *configs.Service
+	buildsService  *builds.Service
+	registry       *registry.Client
+	interval       time.Duration
+}
+
+// NewCleaner creates a cleaner. The sweep interval can be overridden with
+// NIMBUL_RETENTION_INTERVAL (a Go duration, e.g. "30m").
+func NewCleaner(configsService *configs.Service, buildsService *builds.Service, registryClient *registry.Client) *Cleaner {
+	interval := DefaultInterval
+	if value := os.Getenv("NIMBUL_RETENTION_INTERVAL"); value != "" {
+		if parsed, err := time.ParseDuration(value); err == nil && parsed > 0 {
+			interval = parsed
+		} else {
+			fmt.Printf("Warning: Invalid NIMBUL_RETENTION_INTERVAL %q, using %s\n", value, interval)
+		}
+	}
+
+	return &Cleaner{
+		configsService: configsService,
+		buildsService:  buildsService,
+		registry:       registryClient,
+		interval:       interval,
+	}
+}
+
+// Run applies retention rules every interval until ctx is cancelled
+func (c *Cleaner) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.Sweep(ctx)
+		}
+	}
+}
+
+// Sweep deletes the expired images of every config with retention rules. Failures are
+// logged and do not stop the sweep; the next sweep retries them.
+func (c *Cleaner) Sweep(ctx context.Context) {
+	configList, err := c.configsService.GetConfigsWithRetention(ctx)
+	if err != nil {
+		fmt.Printf("Warning: Retention cleaner failed to list configs: %v\n", err)
+		return
+	}
+
+	for i := range configList {
+		config := &configList[i]
+
+		images, err := c.buildsService.GetLiveImagesByConfigID(ctx, config.ID)
+		if err != nil {
+			fmt.Printf("Warning: Retention cleaner failed to list images of config %s: %v\n", config.ID, err)
+			continue
+		}
+
+		// Deleting a manifest removes all of its tags, so images are deleted per manifest
+		for _, manifest := range expiredManifests(images, config.RetentionKeepLast, config.RetentionDeletePreviews) {
+			if err := c.registry.DeleteManifest(ctx, manifest.images[0].Image, manifest.digest); err != nil {
+				fmt.Printf("Warning: Retention cleaner failed to delete %s: %v\n", manifest.images[0].Image, err)
+				continue
+			}
+
+			ids := make([]int64, len(manifest.images))
+			for j, image := range manifest.images {
+				ids[j] = image.ID
+			}
+			if err := c.buildsService.MarkImagesDeleted(ctx, ids); err != nil {
+				fmt.Printf("Warning: Retention cleaner failed to record deletion of %s: %v\n", manifest.digest, err)
+				continue
+			}
+
+			for _, image := range manifest.images {
+				fmt.Printf("✓ Deleted %s (config %s)\n", image.Image, config.ID)
+			}
+		}
+	}
+}
+
+// manifest groups the images of one repository that point at the same manifest
+type manifest struct {
+	digest string
+	images []builds.Image
+}
+
+// expiredManifests selects the manifests retention rules remove from images, which
+// are ordered newest build first. A manifest is kept while any of its images is kept.
+func expiredManifests(images []builds.Image, keepLast *int, deletePreviews bool) []*manifest {
+	keptBuilds := make(map[string]map[int64]bool) // per ref
+	kept := make(map[string]bool)
+	manifests := make(map[string]*manifest)
+	var order []string
+
+	for _, image := range images {
+		repository, err := registry.Repository(image.Image)
+		if err != nil {
+			continue
+		}
+		key := repository + "@" + image.Digest
+
+		expired := deletePreviews && image.BranchDeleted
+		if !expired && keepLast != nil {
+			refBuilds := keptBuilds[image.Ref]
+			if refBuilds == nil {
+				refBuilds = make(map[int64]bool)
+				keptBuilds[image.Ref] = refBuilds
+			}
+			if !refBuilds[image.BuildID] {
+				if len(refBuilds) < *keepLast {
+					refBuilds[image.BuildID] = true
+				} else {
+					expired = true
+				}
+			}
+		}
+
+		if !expired {
+			kept[key] = true
+		}
+
+		if manifests[key] == nil {
+			manifests[key] = &manifest{digest: image.Digest}
+			order = append(order, key)
+		}
+		manifests[key].images = append(manifests[key].images, image)
+	}
+
+	var result []*manifest
+	for _, key := range order {
+		if !kept[key] {
+			result = append(result, manifests[key])
+		}
+	}
+	return result
+}
@@ -0,0 +1,99 @@
+package retention
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/builds"
+	"github.com/coding-cave-dev/nimbul/internal/deployments"
+)
+
+// DataCleaner periodically deletes the builds, build logs and deployments older than the
+// retention set for the instance or their owner, and records the space it reclaims
+type DataCleaner struct {
+	buildsService      *builds.Service
+	deploymentsService *deployments.Service
+	interval           time.Duration
+}
+
+// NewDataCleaner creates a data cleaner sweeping every DefaultInterval
+func NewDataCleaner(buildsService *builds.Service, deploymentsService *deployments.Service) *DataCleaner {
+	return &DataCleaner{
+		buildsService:      buildsService,
+		deploymentsService: deploymentsService,
+		interval:           DefaultInterval,
+	}
+}
+
+// Run deletes expired data every interval until ctx is cancelled
+func (c *DataCleaner) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.Sweep(ctx)
+		}
+	}
+}
+
+// Sweep deletes expired builds, then the expired logs of the builds kept, then expired
+// deployments with their manifests, in batches until none are left. Failures are
+// logged; the next sweep retries them.
+func (c *DataCleaner) Sweep(ctx context.Context) {
+	run := builds.RetentionRun{StartedAt: time.Now()}
+
+	for ctx.Err() == nil {
+		deleted, reclaimed, err := c.buildsService.DeleteExpiredBuilds(ctx)
+		run.BuildsDeleted += deleted
+		run.BytesReclaimed += reclaimed
+		if err != nil {
+			fmt.Printf("Warning: Retention cleaner failed to delete expired builds: %v\n", err)
+			break
+		}
+		if deleted < builds.ExpiredBatch {
+			break
+		}
+	}
+
+	for ctx.Err() == nil {
+		deleted, reclaimed, err := c.buildsService.DeleteExpiredLogs(ctx)
+		run.LogsDeleted += deleted
+		run.BytesReclaimed += reclaimed
+		if err != nil {
+			fmt.Printf("Warning: Retention cleaner failed to delete expired build logs: %v\n", err)
+			break
+		}
+		if deleted < builds.ExpiredBatch {
+			break
+		}
+	}
+
+	deploymentsDeleted := 0
+	for ctx.Err() == nil {
+		deleted, err := c.deploymentsService.DeleteExpiredDeployments(ctx)
+		deploymentsDeleted += deleted
+		if err != nil {
+			fmt.Printf("Warning: Retention cleaner failed to delete expired deployments: %v\n", err)
+			break
+		}
+		if deleted < deployments.ExpiredBatch {
+			break
+		}
+	}
+	if deploymentsDeleted > 0 {
+		fmt.Printf("✓ Deleted %d expired deployments\n", deploymentsDeleted)
+	}
+
+	if run.BuildsDeleted == 0 && run.LogsDeleted == 0 {
+		return
+	}
+	fmt.Printf("✓ Deleted %d expired builds and %d expired logs, reclaiming %d bytes\n", run.BuildsDeleted, run.LogsDeleted, run.BytesReclaimed)
+	if err := c.buildsService.RecordRetentionRun(context.WithoutCancel(ctx), run); err != nil {
+		fmt.Printf("Warning: %v\n", err)
+	}
+}
@@ -0,0 +1,459 @@
+package sandbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultDiskLimit is how many bytes a sandbox may hold, e.g. a clone and the
+	// exported artifacts of its builds
+	DefaultDiskLimit = 5 << 30
+	// DefaultStaleAfter is how old a sandbox left behind, e.g. by a crash, gets
+	// before a sweep removes it. No build or deploy runs this long.
+	DefaultStaleAfter = 24 * time.Hour
+	// DefaultSweepInterval is how often stale sandboxes are looked for
+	DefaultSweepInterval = time.Hour
+
+	// diskCheckInterval is how often the disk usage of a sandbox is measured while
+	// its disk limit is enforced
+	diskCheckInterval = 2 * time.Second
+	// cpuPeriod is the cgroup CPU period, in microseconds, CPU limits are spread over
+	cpuPeriod = 100000
+	// uidDir is the directory of the sandbox root recording which config each user ID
+	// is allocated to. Config directory names never start with a dot.
+	uidDir = ".uids"
+	// defaultPath is the PATH of commands when the server has none
+	defaultPath = "/usr/local/bin:/usr/bin:/bin"
+)
+
+var (
+	ErrDiskQuota = errors.New("sandbox disk quota exceeded")
+	ErrNoUID     = errors.New("every sandbox user ID is in use, raise NIMBUL_SANDBOX_UID_COUNT")
+)
+
+// passedEnv are the variables of the server commands in sandboxes get too. Everything
+// else, e.g. DATABASE_URL and MASTER_ENCRYPTION_KEY, stays out of their reach.
+var passedEnv = []string{
+	"PATH",
+	"LANG",
+	"LC_ALL",
+	"TZ",
+	"HTTP_PROXY",
+	"HTTPS_PROXY",
+	"NO_PROXY",
+	"http_proxy",
+	"https_proxy",
+	"no_proxy",
+	"SSL_CERT_FILE",
+	"SSL_CERT_DIR",
+}
+
+// Manager hands out sandboxes: a directory per run of a config, below a directory per
+// config, whose commands run in a cgroup of the config and as a user of the config
+// when those are configured. Clones, template rendering and manifest processing of
+// different configs never share a directory.
+type Manager struct {
+	root        string
+	diskLimit   int64
+	memoryLimit int64   // bytes per config, 0 for no limit
+	cpuLimit    float64 // cores per config, 0 for no limit
+	cgroupRoot  string  // delegated cgroup v2 directory, empty when cgroups are not used
+	uidBase     int
+	uidCount    int // 0 when commands run as the server's user
+	staleAfter  time.Duration
+	interval    time.Duration
+
+	// mu serializes creating and removing the directories and cgroups of configs, and
+	// allocating user IDs
+	mu sync.Mutex
+}
+
+// NewManagerFromEnv creates a manager configured by environment variables:
+//
+//	NIMBUL_SANDBOX_ROOT          directory holding the sandboxes (default: nimbul-sandboxes in the temp dir)
+//	NIMBUL_SANDBOX_DISK_LIMIT    bytes a sandbox may hold
+//	NIMBUL_SANDBOX_CGROUP        delegated cgroup v2 directory to create a cgroup per config in
+//	NIMBUL_SANDBOX_MEMORY_LIMIT  bytes of memory the commands of a config may use, with a cgroup
+//	NIMBUL_SANDBOX_CPU_LIMIT     cores the commands of a config may use, e.g. 1.5, with a cgroup
+//	NIMBUL_SANDBOX_UID_BASE      first user ID to run the commands of configs as, needs root
+//	NIMBUL_SANDBOX_UID_COUNT     number of user IDs allocated to configs (default 1000)
+//
+// Each config gets a user ID of its own, recorded in the sandbox root. Servers and
+// workers sharing a host need their own root and their own range of user IDs.
+func NewManagerFromEnv() *Manager {
+	m := &Manager{
+		root:        os.Getenv("NIMBUL_SANDBOX_ROOT"),
+		diskLimit:   intFromEnv("NIMBUL_SANDBOX_DISK_LIMIT", DefaultDiskLimit),
+		memoryLimit: intFromEnv("NIMBUL_SANDBOX_MEMORY_LIMIT", 0),
+		cgroupRoot:  os.Getenv("NIMBUL_SANDBOX_CGROUP"),
+		staleAfter:  DefaultStaleAfter,
+		interval:    DefaultSweepInterval,
+	}
+	if m.root == "" {
+		m.root = filepath.Join(os.TempDir(), "nimbul-sandboxes")
+	}
+
+	if value := os.Getenv("NIMBUL_SANDBOX_CPU_LIMIT"); value != "" {
+		if parsed, err := strconv.ParseFloat(value, 64); err == nil && parsed > 0 {
+			m.cpuLimit = parsed
+		} else {
+			fmt.Printf("Warning: Invalid NIMBUL_SANDBOX_CPU_LIMIT %q, not limiting CPU\n", value)
+		}
+	}
+
+	if m.cgroupRoot != "" {
+		if err := enableControllers(m.cgroupRoot); err != nil {
+			fmt.Printf("Warning: Not using cgroups for sandboxes: %v\n", err)
+			m.cgroupRoot = ""
+		}
+	} else if m.memoryLimit > 0 || m.cpuLimit > 0 {
+		fmt.Printf("Warning: Sandbox memory and CPU limits need NIMBUL_SANDBOX_CGROUP, not limiting them\n")
+	}
+
+	if value := os.Getenv("NIMBUL_SANDBOX_UID_BASE"); value != "" {
+		base, err := strconv.Atoi(value)
+		switch {
+		case err != nil || base <= 0:
+			fmt.Printf("Warning: Invalid NIMBUL_SANDBOX_UID_BASE %q, running commands as the server's user\n", value)
+		case runtime.GOOS != "linux":
+			fmt.Printf("Warning: NIMBUL_SANDBOX_UID_BASE is only supported on Linux, running commands as the server's user\n")
+		case os.Geteuid() != 0:
+			fmt.Printf("Warning: NIMBUL_SANDBOX_UID_BASE needs the server to run as root, running commands as the server's user\n")
+		default:
+			m.uidBase = base
+			m.uidCount = int(intFromEnv("NIMBUL_SANDBOX_UID_COUNT", 1000))
+		}
+	}
+
+	return m
+}
+
+// Sandbox is the directory of a run of a config, removed with everything in it on Close
+type Sandbox struct {
+	Dir string
+
+	manager *Manager
+	cgroup  *os.File // cgroup directory of the config, nil when not using cgroups
+	uid     int      // -1 when commands run as the server's user
+}
+
+// Open creates a sandbox for a run of a config; purpose names its directory, e.g. "build"
+func (m *Manager) Open(configID, purpose string) (*Sandbox, error) {
+	name := dirName(configID)
+	configDir := filepath.Join(m.root, name)
+	// Sandbox users pass through the root to their own directory, but cannot list it
+	if err := os.MkdirAll(m.root, 0o711); err != nil {
+		return nil, fmt.Errorf("failed to create sandbox root: %w", err)
+	}
+
+	// The directory and cgroup of the config are removed as its last sandbox closes
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := os.Mkdir(configDir, 0o700); err != nil && !errors.Is(err, os.ErrExist) {
+		return nil, fmt.Errorf("failed to create sandbox directory: %w", err)
+	}
+	dir, err := os.MkdirTemp(configDir, purpose+"-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sandbox directory: %w", err)
+	}
+
+	s := &Sandbox{Dir: dir, manager: m, uid: -1}
+	if m.uidCount > 0 {
+		uid, err := m.allocateUID(name)
+		if err != nil {
+			s.close()
+			return nil, err
+		}
+		s.uid = uid
+
+		// The user of the config may enter only its own sandboxes
+		if err := os.Chown(configDir, s.uid, s.uid); err != nil {
+			s.close()
+			return nil, fmt.Errorf("failed to hand sandbox to user %d: %w", s.uid, err)
+		}
+		if err := os.Chown(dir, s.uid, s.uid); err != nil {
+			s.close()
+			return nil, fmt.Errorf("failed to hand sandbox to user %d: %w", s.uid, err)
+		}
+	}
+
+	if m.cgroupRoot != "" {
+		cgroup, err := m.configCgroup(name)
+		if err != nil {
+			fmt.Printf("Warning: Running commands of config %s outside a cgroup: %v\n", configID, err)
+		} else {
+			s.cgroup = cgroup
+		}
+	}
+
+	return s, nil
+}
+
+// allocateUID returns the user ID of a config, allocating one on its first sandbox.
+// Configs keep their user ID; once all are allocated, the one least recently used by a
+// config without sandboxes is handed to the next config. m.mu must be held.
+func (m *Manager) allocateUID(name string) (int, error) {
+	dir := filepath.Join(m.root, uidDir)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return 0, fmt.Errorf("failed to create sandbox user directory: %w", err)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list sandbox users: %w", err)
+	}
+
+	taken := make(map[int]bool, len(entries))
+	reusable, reusableAt := -1, time.Time{}
+	for _, entry := range entries {
+		uid, err := strconv.Atoi(entry.Name())
+		if err != nil || uid < m.uidBase || uid >= m.uidBase+m.uidCount {
+			continue
+		}
+		file := filepath.Join(dir, entry.Name())
+		owner, err := os.ReadFile(file)
+		if err != nil {
+			return 0, fmt.Errorf("failed to read sandbox user %d: %w", uid, err)
+		}
+		if string(owner) == name {
+			now := time.Now()
+			os.Chtimes(file, now, now)
+			return uid, nil
+		}
+		taken[uid] = true
+
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(m.root, string(owner))); errors.Is(err, os.ErrNotExist) &&
+			(reusable < 0 || info.ModTime().Before(reusableAt)) {
+			reusable, reusableAt = uid, info.ModTime()
+		}
+	}
+
+	uid := reusable
+	for candidate := m.uidBase; candidate < m.uidBase+m.uidCount; candidate++ {
+		if !taken[candidate] {
+			uid = candidate
+			break
+		}
+	}
+	if uid < 0 {
+		return 0, ErrNoUID
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, strconv.Itoa(uid)), []byte(name), 0o600); err != nil {
+		return 0, fmt.Errorf("failed to allocate sandbox user %d: %w", uid, err)
+	}
+	return uid, nil
+}
+
+// MkdirTemp creates a new directory in the sandbox
+func (s *Sandbox) MkdirTemp(pattern string) (string, error) {
+	dir, err := os.MkdirTemp(s.Dir, pattern)
+	if err != nil {
+		return "", err
+	}
+	if s.uid >= 0 {
+		if err := os.Chown(dir, s.uid, s.uid); err != nil {
+			return "", err
+		}
+	}
+	return dir, nil
+}
+
+// Command prepares a command to run in the sandbox: in the cgroup and as the user of
+// the config when configured, with temporary files kept in the sandbox
+func (s *Sandbox) Command(ctx context.Context, name string, args ...string) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Env = s.environ()
+	s.prepare(cmd)
+	return cmd
+}
+
+// environ returns the environment of commands in the sandbox: the few variables of
+// passedEnv, and the sandbox as home and temporary directory so neither the files nor
+// the settings of the server's home are used
+func (s *Sandbox) environ() []string {
+	env := []string{"HOME=" + s.Dir, "TMPDIR=" + s.Dir}
+	for _, key := range passedEnv {
+		if value, ok := os.LookupEnv(key); ok {
+			env = append(env, key+"="+value)
+		}
+	}
+	if _, ok := os.LookupEnv("PATH"); !ok {
+		env = append(env, "PATH="+defaultPath)
+	}
+	return env
+}
+
+// LimitDisk returns a context that is canceled with ErrDiskQuota once the sandbox holds
+// more than the disk limit. Call the returned function to stop measuring.
+func (s *Sandbox) LimitDisk(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	go func() {
+		ticker := time.NewTicker(diskCheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if usage := s.DiskUsage(); usage > s.manager.diskLimit {
+					cancel(fmt.Errorf("%w: more than %d bytes", ErrDiskQuota, s.manager.diskLimit))
+					return
+				}
+			}
+		}
+	}()
+	return ctx, func() { cancel(context.Canceled) }
+}
+
+// DiskUsage returns how many bytes the files of the sandbox take up
+func (s *Sandbox) DiskUsage() int64 {
+	var usage int64
+	filepath.WalkDir(s.Dir, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		if info, err := d.Info(); err == nil && info.Mode().IsRegular() {
+			usage += info.Size()
+		}
+		return nil
+	})
+	return usage
+}
+
+// Close removes the sandbox with everything in it
+func (s *Sandbox) Close() error {
+	s.manager.mu.Lock()
+	defer s.manager.mu.Unlock()
+	return s.close()
+}
+
+// close removes the sandbox, and the directory and cgroup of its config once no other
+// run of it uses them. s.manager.mu must be held.
+func (s *Sandbox) close() error {
+	if s.cgroup != nil {
+		s.cgroup.Close()
+	}
+	if err := removeAll(s.Dir); err != nil {
+		return fmt.Errorf("failed to remove sandbox %s: %w", s.Dir, err)
+	}
+
+	configDir := filepath.Dir(s.Dir)
+	if err := os.Remove(configDir); err == nil && s.manager.cgroupRoot != "" {
+		s.manager.removeConfigCgroup(filepath.Base(configDir))
+	}
+	return nil
+}
+
+// Run sweeps for stale sandboxes every interval until ctx is cancelled
+func (m *Manager) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.Sweep(m.staleAfter)
+		}
+	}
+}
+
+// Sweep removes the sandboxes older than olderThan, all of them when it is 0. Sandboxes
+// are removed once their run ends; sweeping catches those left by a crash.
+func (m *Manager) Sweep(olderThan time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	configDirs, err := os.ReadDir(m.root)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		fmt.Printf("Warning: Failed to list sandboxes: %v\n", err)
+		return
+	}
+
+	for _, configDir := range configDirs {
+		if configDir.Name() == uidDir {
+			continue
+		}
+		dir := filepath.Join(m.root, configDir.Name())
+		runs, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, run := range runs {
+			info, err := run.Info()
+			if err != nil || olderThan > 0 && time.Since(info.ModTime()) < olderThan {
+				continue
+			}
+			if err := removeAll(filepath.Join(dir, run.Name())); err != nil {
+				fmt.Printf("Warning: Failed to remove stale sandbox %s: %v\n", run.Name(), err)
+			}
+		}
+		os.Remove(dir)
+	}
+
+	// Also catches the cgroups that still held processes when their config's last
+	// sandbox closed
+	if m.cgroupRoot != "" {
+		m.sweepCgroups()
+	}
+}
+
+// removeAll removes a directory tree, also when commands in the sandbox took away the
+// write permission of some of its directories
+func removeAll(dir string) error {
+	if err := os.RemoveAll(dir); err == nil {
+		return nil
+	}
+
+	filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err == nil && d.IsDir() {
+			os.Chmod(p, 0o700)
+		}
+		return nil
+	})
+	return os.RemoveAll(dir)
+}
+
+// dirName returns the directory name of a config's sandboxes. Config IDs are ULIDs,
+// anything else is replaced so it cannot lead out of the sandbox root.
+func dirName(configID string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_' {
+			return r
+		}
+		return '_'
+	}, configID)
+}
+
+func intFromEnv(key string, def int64) int64 {
+	value := os.Getenv(key)
+	if value == "" {
+		return def
+	}
+
+	parsed, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || parsed <= 0 {
+		fmt.Printf("Warning: Invalid %s %q, using %d\n", key, value, def)
+		return def
+	}
+
+	return parsed
+}
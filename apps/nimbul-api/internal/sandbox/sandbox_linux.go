@@ -0,0 +1,85 @@
+package sandbox
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// enableControllers lets the cgroups of configs below root limit memory and CPU. root
+// must be a cgroup v2 directory delegated to the server.
+func enableControllers(root string) error {
+	if err := os.WriteFile(filepath.Join(root, "cgroup.subtree_control"), []byte("+memory +cpu"), 0); err != nil {
+		return fmt.Errorf("failed to enable the memory and cpu controllers in %s: %w", root, err)
+	}
+	return nil
+}
+
+// configCgroup creates or updates the cgroup of a config and opens it, for commands
+// to be started in
+func (m *Manager) configCgroup(name string) (*os.File, error) {
+	dir := filepath.Join(m.cgroupRoot, "config-"+name)
+	if err := os.Mkdir(dir, 0o755); err != nil && !errors.Is(err, os.ErrExist) {
+		return nil, fmt.Errorf("failed to create cgroup: %w", err)
+	}
+
+	if m.memoryLimit > 0 {
+		if err := os.WriteFile(filepath.Join(dir, "memory.max"), []byte(strconv.FormatInt(m.memoryLimit, 10)), 0); err != nil {
+			return nil, fmt.Errorf("failed to limit memory: %w", err)
+		}
+	}
+	if m.cpuLimit > 0 {
+		quota := fmt.Sprintf("%d %d", int64(m.cpuLimit*cpuPeriod), cpuPeriod)
+		if err := os.WriteFile(filepath.Join(dir, "cpu.max"), []byte(quota), 0); err != nil {
+			return nil, fmt.Errorf("failed to limit CPU: %w", err)
+		}
+	}
+
+	return os.Open(dir)
+}
+
+// removeConfigCgroup removes the cgroup of a config whose last sandbox closed. A
+// cgroup still holding processes, e.g. ones a command left behind, stays until a sweep
+// finds it empty.
+func (m *Manager) removeConfigCgroup(name string) {
+	dir := filepath.Join(m.cgroupRoot, "config-"+name)
+	if err := os.Remove(dir); err != nil && !errors.Is(err, os.ErrNotExist) {
+		fmt.Printf("Warning: Failed to remove cgroup %s: %v\n", dir, err)
+	}
+}
+
+// sweepCgroups removes the cgroups of configs without sandboxes. m.mu must be held.
+func (m *Manager) sweepCgroups() {
+	entries, err := os.ReadDir(m.cgroupRoot)
+	if err != nil {
+		fmt.Printf("Warning: Failed to list cgroups: %v\n", err)
+		return
+	}
+	for _, entry := range entries {
+		name, ok := strings.CutPrefix(entry.Name(), "config-")
+		if !ok || !entry.IsDir() {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(m.root, name)); errors.Is(err, os.ErrNotExist) {
+			m.removeConfigCgroup(name)
+		}
+	}
+}
+
+// prepare starts a command in the cgroup of the sandbox and as the sandbox user
+func (s *Sandbox) prepare(cmd *exec.Cmd) {
+	attr := &syscall.SysProcAttr{}
+	if s.cgroup != nil {
+		attr.UseCgroupFD = true
+		attr.CgroupFD = int(s.cgroup.Fd())
+	}
+	if s.uid >= 0 {
+		attr.Credential = &syscall.Credential{Uid: uint32(s.uid), Gid: uint32(s.uid)}
+	}
+	cmd.SysProcAttr = attr
+}
@@ -0,0 +1,53 @@
+package sandbox
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// The cgroup root is a plain directory here: without limits, creating, opening and
+// removing a config's cgroup are directory operations
+func TestCloseRemovesConfigCgroup(t *testing.T) {
+	m := &Manager{root: t.TempDir(), cgroupRoot: t.TempDir()}
+
+	sb, err := m.Open("01HZX", "build")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cgroup := filepath.Join(m.cgroupRoot, "config-01HZX")
+	if _, err := os.Stat(cgroup); err != nil {
+		t.Fatalf("cgroup of the config not created: %v", err)
+	}
+
+	if err := sb.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(cgroup); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("cgroup left after the config's last sandbox closed: %v", err)
+	}
+}
+
+func TestSweepRemovesOrphanedCgroups(t *testing.T) {
+	m := &Manager{root: t.TempDir(), cgroupRoot: t.TempDir()}
+
+	sb, err := m.Open("01HZX", "build")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sb.Close()
+	orphaned := filepath.Join(m.cgroupRoot, "config-01HZY")
+	if err := os.Mkdir(orphaned, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	m.Sweep(DefaultStaleAfter)
+
+	if _, err := os.Stat(orphaned); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("cgroup of a config without sandboxes left: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(m.cgroupRoot, "config-01HZX")); err != nil {
+		t.Errorf("cgroup of a config with a sandbox removed: %v", err)
+	}
+}
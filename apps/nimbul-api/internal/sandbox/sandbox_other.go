@@ -0,0 +1,24 @@
+//go:build !linux
+
+package sandbox
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+)
+
+func enableControllers(root string) error {
+	return errors.New("cgroups are only available on Linux")
+}
+
+func (m *Manager) configCgroup(name string) (*os.File, error) {
+	return nil, errors.New("cgroups are only available on Linux")
+}
+
+func (m *Manager) removeConfigCgroup(name string) {}
+
+func (m *Manager) sweepCgroups() {}
+
+// prepare leaves commands as they are, sandbox users need Linux too
+func (s *Sandbox) prepare(cmd *exec.Cmd) {}
@@ -0,0 +1,168 @@
+package sandbox
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAllocateUID(t *testing.T) {
+	m := &Manager{root: t.TempDir(), uidBase: 2000, uidCount: 3}
+
+	allocate := func(name string) int {
+		t.Helper()
+		// Configs being allocated a user ID have a sandbox
+		if err := os.MkdirAll(filepath.Join(m.root, name), 0o700); err != nil {
+			t.Fatal(err)
+		}
+		uid, err := m.allocateUID(name)
+		if err != nil {
+			t.Fatalf("allocateUID(%s) error = %v", name, err)
+		}
+		return uid
+	}
+
+	seen := map[int]string{}
+	for _, name := range []string{"a", "b", "c"} {
+		uid := allocate(name)
+		if uid < 2000 || uid >= 2003 {
+			t.Fatalf("allocateUID(%s) = %d, outside 2000-2002", name, uid)
+		}
+		if other, ok := seen[uid]; ok {
+			t.Fatalf("allocateUID(%s) = %d, already allocated to %s", name, uid, other)
+		}
+		seen[uid] = name
+	}
+
+	for uid, name := range seen {
+		if got := allocate(name); got != uid {
+			t.Errorf("allocateUID(%s) = %d again, want %d", name, got, uid)
+		}
+	}
+
+	if _, err := m.allocateUID("d"); !errors.Is(err, ErrNoUID) {
+		t.Fatalf("allocateUID(d) with every user ID in use error = %v, want %v", err, ErrNoUID)
+	}
+
+	// Without sandboxes, b's user ID goes to the next config
+	if err := os.Remove(filepath.Join(m.root, "b")); err != nil {
+		t.Fatal(err)
+	}
+	uidOf := map[string]int{}
+	for uid, name := range seen {
+		uidOf[name] = uid
+	}
+	if got := allocate("d"); got != uidOf["b"] {
+		t.Errorf("allocateUID(d) = %d, want b's %d", got, uidOf["b"])
+	}
+
+	// and b gets another one once that is free
+	if err := os.Remove(filepath.Join(m.root, "a")); err != nil {
+		t.Fatal(err)
+	}
+	if got := allocate("b"); got != uidOf["a"] {
+		t.Errorf("allocateUID(b) = %d, want a's %d", got, uidOf["a"])
+	}
+}
+
+func TestAllocateUIDReusesLeastRecentlyUsed(t *testing.T) {
+	m := &Manager{root: t.TempDir(), uidBase: 2000, uidCount: 2}
+
+	for _, name := range []string{"a", "b"} {
+		if _, err := m.allocateUID(name); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// a was used last
+	old := time.Now().Add(-time.Hour)
+	if err := os.Chtimes(filepath.Join(m.root, uidDir, "2001"), old, old); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.allocateUID("a"); err != nil {
+		t.Fatal(err)
+	}
+
+	uid, err := m.allocateUID("c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uid != 2001 {
+		t.Errorf("allocateUID(c) = %d, want b's 2001", uid)
+	}
+}
+
+func TestCommandEnvironment(t *testing.T) {
+	t.Setenv("DATABASE_URL", "postgres://nimbul:secret@db/nimbul")
+	t.Setenv("MASTER_ENCRYPTION_KEY", "secret")
+	t.Setenv("HTTPS_PROXY", "http://proxy:3128")
+
+	m := &Manager{root: t.TempDir()}
+	sb, err := m.Open("01HZX", "build")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sb.Close()
+
+	env := sb.Command(t.Context(), "true").Env
+	for _, variable := range env {
+		if strings.Contains(variable, "secret") {
+			t.Errorf("command environment has %s", variable)
+		}
+	}
+	for _, want := range []string{"HOME=" + sb.Dir, "TMPDIR=" + sb.Dir, "HTTPS_PROXY=http://proxy:3128"} {
+		if !contains(env, want) {
+			t.Errorf("command environment %v lacks %s", env, want)
+		}
+	}
+	if !hasPrefix(env, "PATH=") {
+		t.Errorf("command environment %v lacks PATH", env)
+	}
+}
+
+func TestCloseRemovesConfigDirectory(t *testing.T) {
+	m := &Manager{root: t.TempDir()}
+
+	first, err := m.Open("01HZX", "build")
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := m.Open("01HZX", "deploy")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	configDir := filepath.Join(m.root, "01HZX")
+	if err := first.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(configDir); err != nil {
+		t.Fatalf("config directory removed while a sandbox uses it: %v", err)
+	}
+	if err := second.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(configDir); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("config directory left after its last sandbox closed: %v", err)
+	}
+}
+
+func contains(env []string, want string) bool {
+	for _, variable := range env {
+		if variable == want {
+			return true
+		}
+	}
+	return false
+}
+
+func hasPrefix(env []string, prefix string) bool {
+	for _, variable := range env {
+		if strings.HasPrefix(variable, prefix) {
+			return true
+		}
+	}
+	return false
+}
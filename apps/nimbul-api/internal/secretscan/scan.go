@@ -0,0 +1,187 @@
+package secretscan
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/moby/patternmatcher"
+)
+
+const (
+	// maxFileSize is the size above which files are not scanned, as secrets live in
+	// small text files rather than in bundles or data sets
+	maxFileSize = 1 << 20
+	// MaxFindings stops a scan once this many possible secrets are found
+	MaxFindings = 50
+)
+
+// Rule detects one kind of secret
+type Rule struct {
+	ID      string
+	pattern *regexp.Regexp
+}
+
+// Rules are the secrets a scan looks for. They only match well-known token formats,
+// so a finding is rarely a false positive.
+var Rules = []Rule{
+	{"private-key", regexp.MustCompile(`-----BEGIN (?:RSA |EC |DSA |OPENSSH |PGP |ENCRYPTED )?PRIVATE KEY(?: BLOCK)?-----`)},
+	{"aws-access-key", regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	{"github-token", regexp.MustCompile(`\b(?:gh[pousr]_[A-Za-z0-9]{36,}|github_pat_[A-Za-z0-9_]{60,})\b`)},
+	{"gitlab-token", regexp.MustCompile(`\bglpat-[A-Za-z0-9_-]{20,}\b`)},
+	{"slack-token", regexp.MustCompile(`\bxox[abposr]-[A-Za-z0-9-]{10,}\b`)},
+	{"stripe-key", regexp.MustCompile(`\b[sr]k_live_[A-Za-z0-9]{20,}\b`)},
+	{"google-api-key", regexp.MustCompile(`\bAIza[0-9A-Za-z_-]{35}\b`)},
+	{"npm-token", regexp.MustCompile(`\bnpm_[A-Za-z0-9]{36}\b`)},
+}
+
+// Finding is a possible secret in a file
+type Finding struct {
+	Path string // relative to the scanned directory, with forward slashes
+	Line int
+	Rule string
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%s:%d (%s)", f.Path, f.Line, f.Rule)
+}
+
+// Scan looks for secrets in the files of dir, skipping those matching excludes, in
+// .dockerignore syntax, as they are not sent to the builder. It stops after
+// MaxFindings findings.
+func Scan(dir string, excludes []string) ([]Finding, error) {
+	matcher, err := patternmatcher.New(excludes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exclude patterns: %w", err)
+	}
+
+	var findings []Finding
+	err = filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil || rel == "." {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		excluded, err := matcher.MatchesOrParentMatches(rel)
+		if err != nil {
+			return err
+		}
+		if excluded {
+			// Negated patterns may still send files below an excluded directory
+			if entry.IsDir() && !matcher.Exclusions() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		// Symlinks are sent as links, not as what they point to
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		fileFindings, err := scanFile(path, rel)
+		if err != nil {
+			return err
+		}
+		findings = append(findings, fileFindings...)
+		if len(findings) >= MaxFindings {
+			findings = findings[:MaxFindings]
+			return fs.SkipAll
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan for secrets: %w", err)
+	}
+
+	return findings, nil
+}
+
+// scanFile scans one file, skipping large and binary ones
+func scanFile(path, rel string) ([]Finding, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if info.Size() > maxFileSize {
+		return nil, nil
+	}
+
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.IndexByte(data[:min(len(data), 8000)], 0) >= 0 {
+		return nil, nil
+	}
+
+	var findings []Finding
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), maxFileSize)
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		for _, rule := range Rules {
+			if rule.pattern.MatchString(text) {
+				findings = append(findings, Finding{Path: rel, Line: line, Rule: rule.ID})
+			}
+		}
+	}
+	return findings, scanner.Err()
+}
+
+// Allow drops the findings an allowlist accepts. Each entry is either the ID of a
+// rule, allowing it everywhere, or a path pattern in .dockerignore syntax matched
+// against the finding's path with prefix prepended.
+func Allow(findings []Finding, allowlist []string, prefix string) ([]Finding, error) {
+	var rules, patterns []string
+	for _, entry := range allowlist {
+		if slices.ContainsFunc(Rules, func(rule Rule) bool { return rule.ID == entry }) {
+			rules = append(rules, entry)
+		} else {
+			patterns = append(patterns, entry)
+		}
+	}
+
+	matcher, err := patternmatcher.New(patterns)
+	if err != nil {
+		return nil, fmt.Errorf("invalid allowlist: %w", err)
+	}
+
+	var kept []Finding
+	for _, finding := range findings {
+		if slices.Contains(rules, finding.Rule) {
+			continue
+		}
+		allowed, err := matcher.MatchesOrParentMatches(strings.TrimPrefix(filepath.ToSlash(filepath.Join(prefix, finding.Path)), "/"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid allowlist: %w", err)
+		}
+		if !allowed {
+			kept = append(kept, finding)
+		}
+	}
+	return kept, nil
+}
+
+// ValidateAllowlist reports allowlist entries that are neither rule IDs nor valid path
+// patterns
+func ValidateAllowlist(allowlist []string) error {
+	_, err := Allow(nil, allowlist, "")
+	return err
+}
@@ -0,0 +1,13 @@
+package storage
+
+// NewGCSStore returns a store keeping objects in a Google Cloud Storage bucket,
+// reached through its S3-interoperable XML API with an HMAC key of a service account
+func NewGCSStore(bucket, accessKey, secretKey string) *S3Store {
+	return &S3Store{
+		Endpoint:  "https://storage.googleapis.com",
+		Region:    "auto",
+		Bucket:    bucket,
+		AccessKey: accessKey,
+		SecretKey: secretKey,
+	}
+}
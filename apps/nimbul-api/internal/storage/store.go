@@ -0,0 +1,79 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+var ErrNotFound = errors.New("object not found")
+
+// Store keeps large binary data under slash-separated keys, so it stays out of the
+// database: build artifacts and logs, provenance statements, deployment manifests and
+// the manifests handed to agents
+type Store interface {
+	// Put stores size bytes read from r under key, replacing any existing object
+	Put(ctx context.Context, key string, r io.Reader, size int64) error
+	// Get opens the object stored under key, or returns ErrNotFound
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the object stored under key; deleting a missing object is not an error
+	Delete(ctx context.Context, key string) error
+}
+
+// Storage backends, set with STORAGE_BACKEND
+const (
+	BackendLocal = "local"
+	BackendS3    = "s3"
+	BackendGCS   = "gcs"
+)
+
+// NewFromEnv returns the store STORAGE_BACKEND selects: "s3" for an S3-compatible
+// service, "gcs" for Google Cloud Storage or "local" for the STORAGE_DIR directory.
+// Without STORAGE_BACKEND, the S3 store is used when STORAGE_S3_BUCKET is set.
+func NewFromEnv() Store {
+	backend := os.Getenv("STORAGE_BACKEND")
+	switch backend {
+	case "":
+		backend = BackendLocal
+		if os.Getenv("STORAGE_S3_BUCKET") != "" {
+			backend = BackendS3
+		}
+	case BackendLocal, BackendS3, BackendGCS:
+	default:
+		fmt.Printf("Warning: Invalid STORAGE_BACKEND %q, using %s\n", backend, BackendLocal)
+		backend = BackendLocal
+	}
+
+	switch backend {
+	case BackendS3:
+		endpoint := os.Getenv("STORAGE_S3_ENDPOINT")
+		region := os.Getenv("STORAGE_S3_REGION")
+		if region == "" {
+			region = "us-east-1"
+		}
+		if endpoint == "" {
+			endpoint = "https://s3." + region + ".amazonaws.com"
+		}
+		return &S3Store{
+			Endpoint:  endpoint,
+			Region:    region,
+			Bucket:    os.Getenv("STORAGE_S3_BUCKET"),
+			AccessKey: os.Getenv("STORAGE_S3_ACCESS_KEY"),
+			SecretKey: os.Getenv("STORAGE_S3_SECRET_KEY"),
+		}
+	case BackendGCS:
+		return NewGCSStore(
+			os.Getenv("STORAGE_GCS_BUCKET"),
+			os.Getenv("STORAGE_GCS_ACCESS_KEY"),
+			os.Getenv("STORAGE_GCS_SECRET_KEY"),
+		)
+	}
+
+	dir := os.Getenv("STORAGE_DIR")
+	if dir == "" {
+		dir = "data/storage"
+	}
+	return &DirStore{Dir: dir}
+}
@@ -0,0 +1,83 @@
+package trash
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/configs"
+	"github.com/coding-cave-dev/nimbul/internal/credentials"
+)
+
+const (
+	// DefaultRetentionDays is how long deleted configs and credentials stay restorable,
+	// unless NIMBUL_TRASH_RETENTION_DAYS is set
+	DefaultRetentionDays = 30
+	// purgeInterval is how often the purger empties the trash
+	purgeInterval = time.Hour
+)
+
+// Retention returns how long deleted configs and credentials stay in the trash
+func Retention() time.Duration {
+	days := DefaultRetentionDays
+	if value := os.Getenv("NIMBUL_TRASH_RETENTION_DAYS"); value != "" {
+		if parsed, err := strconv.Atoi(value); err == nil && parsed >= 0 {
+			days = parsed
+		} else {
+			fmt.Printf("Warning: Invalid NIMBUL_TRASH_RETENTION_DAYS %q, using %d\n", value, days)
+		}
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// Purger periodically deletes the configs and credentials that have been in the trash
+// for longer than the retention window
+type Purger struct {
+	configsService     *configs.Service
+	credentialsService *credentials.Service
+	retention          time.Duration
+}
+
+func NewPurger(configsService *configs.Service, credentialsService *credentials.Service) *Purger {
+	return &Purger{
+		configsService:     configsService,
+		credentialsService: credentialsService,
+		retention:          Retention(),
+	}
+}
+
+// Run empties the trash every hour until ctx is cancelled
+func (p *Purger) Run(ctx context.Context) {
+	ticker := time.NewTicker(purgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.Purge(ctx)
+		}
+	}
+}
+
+// Purge deletes what went to the trash before the retention window
+func (p *Purger) Purge(ctx context.Context) {
+	before := time.Now().Add(-p.retention)
+
+	purged, err := p.configsService.PurgeDeletedConfigs(ctx, before)
+	if err != nil {
+		fmt.Printf("Warning: %v\n", err)
+	} else if purged > 0 {
+		fmt.Printf("Purged %d deleted configs\n", purged)
+	}
+
+	purged, err = p.credentialsService.PurgeDeletedCredentials(ctx, before)
+	if err != nil {
+		fmt.Printf("Warning: %v\n", err)
+	} else if purged > 0 {
+		fmt.Printf("Purged %d deleted credentials\n", purged)
+	}
+}
@@ -0,0 +1,310 @@
+package twofactor
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/credentials"
+	"github.com/coding-cave-dev/nimbul/internal/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Issuer is the name authenticator apps show next to Nimbul codes
+const Issuer = "Nimbul"
+
+// recoveryCodeCount is the number of recovery codes issued when 2FA is enabled
+const recoveryCodeCount = 10
+
+// After maxCodeAttempts invalid codes in a row, codes are refused for codeLockout, so
+// someone who has the password cannot guess the 6-digit code
+const (
+	maxCodeAttempts = 5
+	codeLockout     = 15 * time.Minute
+)
+
+var (
+	ErrAlreadyEnabled = errors.New("two-factor authentication is already enabled")
+	ErrNotEnrolled    = errors.New("two-factor authentication has not been set up")
+	ErrNotEnabled     = errors.New("two-factor authentication is not enabled")
+	ErrInvalidCode    = errors.New("invalid two-factor code")
+	ErrTooManyCodes   = errors.New("too many invalid two-factor codes, try again later")
+)
+
+// Enrollment is a TOTP secret waiting for the user to confirm it with a code
+type Enrollment struct {
+	Secret          string
+	ProvisioningURI string
+}
+
+// Service manages TOTP two-factor authentication. Secrets are encrypted with the
+// master key, like cluster credentials; recovery codes are stored as hashes.
+type Service struct {
+	queries     *db.Queries
+	credentials *credentials.Service
+}
+
+func NewService(queries *db.Queries, credentials *credentials.Service) *Service {
+	return &Service{
+		queries:     queries,
+		credentials: credentials,
+	}
+}
+
+// Enroll generates a new secret for the user. It is not required at login until the
+// user confirms it, and enrolling again before then replaces it.
+func (s *Service) Enroll(ctx context.Context, userID, email string) (*Enrollment, error) {
+	secret, err := GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	sealed, err := s.credentials.Seal([]byte(secret))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.queries.UpsertPendingUserTOTP(ctx, db.UpsertPendingUserTOTPParams{
+		UserID:     userID,
+		Ciphertext: sealed.Ciphertext,
+		TokenNonce: sealed.TokenNonce,
+		WrappedDek: sealed.WrappedDEK,
+		DekNonce:   sealed.DEKNonce,
+	})
+	if err != nil {
+		// The upsert skips users whose TOTP is already enabled
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrAlreadyEnabled
+		}
+		return nil, fmt.Errorf("failed to store TOTP secret: %w", err)
+	}
+
+	return &Enrollment{
+		Secret:          secret,
+		ProvisioningURI: ProvisioningURI(Issuer, email, secret),
+	}, nil
+}
+
+// Confirm enables 2FA once the user proves their authenticator works, and returns
+// recovery codes. They are only ever shown here.
+func (s *Service) Confirm(ctx context.Context, userID, code string) ([]string, error) {
+	totp, err := s.getTOTP(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if totp.EnabledAt.Valid {
+		return nil, ErrAlreadyEnabled
+	}
+
+	if err := s.verifyCode(ctx, totp, code); err != nil {
+		return nil, err
+	}
+
+	if err := s.queries.DeleteRecoveryCodes(ctx, userID); err != nil {
+		return nil, fmt.Errorf("failed to delete recovery codes: %w", err)
+	}
+
+	codes := make([]string, 0, recoveryCodeCount)
+	for range recoveryCodeCount {
+		code, err := generateRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+		err = s.queries.CreateRecoveryCode(ctx, db.CreateRecoveryCodeParams{
+			CodeHash: hashRecoveryCode(code),
+			UserID:   userID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to store recovery code: %w", err)
+		}
+		codes = append(codes, code)
+	}
+
+	if err := s.queries.EnableUserTOTP(ctx, userID); err != nil {
+		return nil, fmt.Errorf("failed to enable TOTP: %w", err)
+	}
+
+	return codes, nil
+}
+
+// Disable turns 2FA off. It takes a current code or a recovery code, so a stolen
+// session token alone cannot remove the second factor.
+func (s *Service) Disable(ctx context.Context, userID, code string) error {
+	enabled, err := s.Enabled(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !enabled {
+		return ErrNotEnabled
+	}
+
+	if err := s.Check(ctx, userID, code); err != nil {
+		return err
+	}
+
+	if err := s.queries.DeleteRecoveryCodes(ctx, userID); err != nil {
+		return fmt.Errorf("failed to delete recovery codes: %w", err)
+	}
+	if err := s.queries.DeleteUserTOTP(ctx, userID); err != nil {
+		return fmt.Errorf("failed to delete TOTP: %w", err)
+	}
+	return nil
+}
+
+// Enabled reports whether the user must enter a code to log in
+func (s *Service) Enabled(ctx context.Context, userID string) (bool, error) {
+	totp, err := s.getTOTP(ctx, userID)
+	if errors.Is(err, ErrNotEnrolled) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return totp.EnabledAt.Valid, nil
+}
+
+// Check accepts a code from the user's authenticator or an unused recovery code.
+// Each authenticator code and each recovery code works only once, and too many invalid
+// codes lock the user out of 2FA for a while.
+func (s *Service) Check(ctx context.Context, userID, code string) error {
+	totp, err := s.getTOTP(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !totp.EnabledAt.Valid {
+		return ErrNotEnabled
+	}
+
+	attempts, err := s.queries.StartUserTOTPAttempt(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrTooManyCodes
+		}
+		return fmt.Errorf("failed to record two-factor attempt: %w", err)
+	}
+	if attempts > maxCodeAttempts {
+		// Guesses made at the same time as the one that locked the enrollment
+		return s.lock(ctx, userID, ErrTooManyCodes)
+	}
+
+	err = s.checkCode(ctx, totp, code)
+	switch {
+	case err == nil:
+		if err := s.queries.ResetUserTOTPAttempts(ctx, userID); err != nil {
+			return fmt.Errorf("failed to reset two-factor attempts: %w", err)
+		}
+		return nil
+	case errors.Is(err, ErrInvalidCode) && attempts == maxCodeAttempts:
+		return s.lock(ctx, userID, err)
+	default:
+		return err
+	}
+}
+
+// lock refuses the user's codes for codeLockout and returns err
+func (s *Service) lock(ctx context.Context, userID string, err error) error {
+	lockErr := s.queries.LockUserTOTP(ctx, db.LockUserTOTPParams{
+		UserID:      userID,
+		LockedUntil: pgtype.Timestamptz{Time: time.Now().Add(codeLockout), Valid: true},
+	})
+	if lockErr != nil {
+		return fmt.Errorf("failed to lock two-factor authentication: %w", lockErr)
+	}
+	return err
+}
+
+// checkCode accepts an authenticator code or an unused recovery code
+func (s *Service) checkCode(ctx context.Context, totp db.UserTotp, code string) error {
+	if !isRecoveryCode(code) {
+		return s.verifyCode(ctx, totp, code)
+	}
+
+	used, err := s.queries.UseRecoveryCode(ctx, db.UseRecoveryCodeParams{
+		CodeHash: hashRecoveryCode(code),
+		UserID:   totp.UserID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to use recovery code: %w", err)
+	}
+	if used == 0 {
+		return ErrInvalidCode
+	}
+	return nil
+}
+
+// verifyCode checks an authenticator code and records its time step against replays
+func (s *Service) verifyCode(ctx context.Context, totp db.UserTotp, code string) error {
+	secret, err := s.credentials.Open(&credentials.Sealed{
+		Ciphertext: totp.Ciphertext,
+		TokenNonce: totp.TokenNonce,
+		WrappedDEK: totp.WrappedDek,
+		DEKNonce:   totp.DekNonce,
+	})
+	if err != nil {
+		return err
+	}
+
+	step, ok := Verify(string(secret), code, time.Now())
+	if !ok {
+		return ErrInvalidCode
+	}
+
+	used, err := s.queries.UseUserTOTPStep(ctx, db.UseUserTOTPStepParams{
+		UserID:       totp.UserID,
+		LastUsedStep: step,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record TOTP code: %w", err)
+	}
+	if used == 0 {
+		return ErrInvalidCode
+	}
+	return nil
+}
+
+// getTOTP returns the user's TOTP enrollment
+func (s *Service) getTOTP(ctx context.Context, userID string) (db.UserTotp, error) {
+	totp, err := s.queries.GetUserTOTP(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return db.UserTotp{}, ErrNotEnrolled
+		}
+		return db.UserTotp{}, fmt.Errorf("failed to get TOTP: %w", err)
+	}
+	return totp, nil
+}
+
+// generateRecoveryCode returns a code like "3f9a1-c02b7"
+func generateRecoveryCode() (string, error) {
+	b := make([]byte, 5)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate recovery code: %w", err)
+	}
+	code := hex.EncodeToString(b)
+	return code[:5] + "-" + code[5:], nil
+}
+
+// isRecoveryCode tells recovery codes apart from the 6-digit authenticator codes
+func isRecoveryCode(code string) bool {
+	return len(normalizeRecoveryCode(code)) == 10
+}
+
+// hashRecoveryCode hashes a recovery code for storage. The codes are random, so a
+// plain SHA-256 is enough.
+func hashRecoveryCode(code string) string {
+	sum := sha256.Sum256([]byte(normalizeRecoveryCode(code)))
+	return hex.EncodeToString(sum[:])
+}
+
+// normalizeRecoveryCode drops the dash and spaces users may or may not type
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	code = strings.ReplaceAll(code, "-", "")
+	return strings.ReplaceAll(code, " ", "")
+}
@@ -0,0 +1,108 @@
+package twofactor
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// period is the number of seconds each code is valid for (RFC 6238 default)
+	period = 30
+	// digits is the length of a code, the one authenticator apps expect by default
+	digits = 6
+	// skew is the number of steps before and after the current one that are accepted,
+	// to allow for clock drift between the server and the authenticator
+	skew = 1
+)
+
+var secretEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret returns a random 160-bit secret, base32-encoded as authenticator apps expect
+func GenerateSecret() (string, error) {
+	secret := make([]byte, 20)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("failed to generate TOTP secret: %w", err)
+	}
+	return secretEncoding.EncodeToString(secret), nil
+}
+
+// Code returns the code for secret at time t
+func Code(secret string, t time.Time) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+	return code(key, step(t)), nil
+}
+
+// Verify checks a code against secret at time t and returns the time step it belongs to,
+// so callers can refuse a code that was already used
+func Verify(secret, passcode string, t time.Time) (int64, bool) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return 0, false
+	}
+
+	passcode = strings.ReplaceAll(strings.TrimSpace(passcode), " ", "")
+	if len(passcode) != digits {
+		return 0, false
+	}
+
+	current := step(t)
+	for i := int64(-skew); i <= skew; i++ {
+		if hmac.Equal([]byte(code(key, current+i)), []byte(passcode)) {
+			return current + i, true
+		}
+	}
+	return 0, false
+}
+
+// ProvisioningURI returns the otpauth:// URI authenticator apps read from a QR code
+func ProvisioningURI(issuer, account, secret string) string {
+	label := url.PathEscape(issuer + ":" + account)
+
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", issuer)
+
+	return "otpauth://totp/" + label + "?" + query.Encode()
+}
+
+// step returns the RFC 6238 time step of t
+func step(t time.Time) int64 {
+	return t.Unix() / period
+}
+
+// code computes the HOTP value (RFC 4226) of key for counter
+func code(key []byte, counter int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(counter))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	// Dynamic truncation
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", digits, value%1000000)
+}
+
+// decodeSecret decodes a base32 secret, tolerating lowercase, spaces and padding
+func decodeSecret(secret string) ([]byte, error) {
+	secret = strings.ToUpper(strings.ReplaceAll(secret, " ", ""))
+	secret = strings.TrimRight(secret, "=")
+	key, err := secretEncoding.DecodeString(secret)
+	if err != nil {
+		return nil, fmt.Errorf("invalid TOTP secret: %w", err)
+	}
+	return key, nil
+}
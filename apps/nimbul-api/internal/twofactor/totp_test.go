@@ -0,0 +1,90 @@
+package twofactor
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfcSecret is the SHA-1 test key of RFC 6238 appendix B
+var rfcSecret = base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+func TestCode(t *testing.T) {
+	// RFC 6238 appendix B vectors, truncated to 6 digits
+	tests := []struct {
+		unix     int64
+		expected string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+
+	for _, tt := range tests {
+		got, err := Code(rfcSecret, time.Unix(tt.unix, 0))
+		if err != nil {
+			t.Fatalf("Code(%d) error = %v", tt.unix, err)
+		}
+		if got != tt.expected {
+			t.Errorf("Code(%d) = %q, want %q", tt.unix, got, tt.expected)
+		}
+	}
+}
+
+func TestVerify(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+
+	tests := []struct {
+		name     string
+		at       time.Time
+		passcode string
+		valid    bool
+	}{
+		{name: "current step", at: now, passcode: "050471", valid: true},
+		{name: "previous step", at: now.Add(-period * time.Second), passcode: "050471", valid: true},
+		{name: "next step", at: now.Add(period * time.Second), passcode: "050471", valid: true},
+		{name: "outside window", at: now.Add(2 * period * time.Second), passcode: "050471", valid: false},
+		{name: "spaces", at: now, passcode: "050 471", valid: true},
+		{name: "wrong code", at: now, passcode: "123456", valid: false},
+		{name: "too short", at: now, passcode: "05047", valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Verify(rfcSecret, tt.passcode, tt.at)
+			if ok != tt.valid {
+				t.Fatalf("Verify() ok = %v, want %v", ok, tt.valid)
+			}
+			if ok && got != step(now) {
+				t.Errorf("Verify() step = %d, want %d", got, step(now))
+			}
+		})
+	}
+}
+
+func TestGenerateSecret(t *testing.T) {
+	secret, err := GenerateSecret()
+	if err != nil {
+		t.Fatalf("GenerateSecret() error = %v", err)
+	}
+
+	// Generated secrets must be usable by Code
+	if _, err := Code(secret, time.Now()); err != nil {
+		t.Errorf("Code() with generated secret error = %v", err)
+	}
+	if strings.Contains(secret, "=") {
+		t.Errorf("GenerateSecret() = %q, want no padding", secret)
+	}
+}
+
+func TestProvisioningURI(t *testing.T) {
+	got := ProvisioningURI("Nimbul", "dev@example.com", "JBSWY3DPEHPK3PXP")
+	expected := "otpauth://totp/Nimbul:dev@example.com?issuer=Nimbul&secret=JBSWY3DPEHPK3PXP"
+	if got != expected {
+		t.Errorf("ProvisioningURI() = %q, want %q", got, expected)
+	}
+}
@@ -0,0 +1,210 @@
+package usage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/coding-cave-dev/nimbul/internal/db"
+	"github.com/coding-cave-dev/nimbul/internal/limits"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var ErrQuotaExceeded = errors.New("usage quota exceeded")
+
+// Metered resources
+const (
+	MetricBuildMinutes = "build_minutes"
+	MetricDeploys      = "deploys"
+	MetricStorageMB    = "storage_mb"
+)
+
+// Quota states
+const (
+	StateOK       = "ok"
+	StateWarning  = "warning"  // soft quota reached
+	StateExceeded = "exceeded" // hard quota reached, new builds are blocked
+)
+
+type Service struct {
+	queries       *db.Queries
+	limitsService *limits.Service
+}
+
+func NewService(queries *db.Queries, limitsService *limits.Service) *Service {
+	return &Service{
+		queries:       queries,
+		limitsService: limitsService,
+	}
+}
+
+// Usage is what an owner used in the current billing period. Build minutes and deploys
+// are counted per calendar month (UTC); storage is what is currently stored.
+type Usage struct {
+	PeriodStart  time.Time
+	PeriodEnd    time.Time
+	Builds       int64
+	BuildMinutes float64
+	Deploys      int64
+	StorageBytes int64
+	Quotas       []QuotaStatus
+	// Configs breaks the usage down per config of the owner
+	Configs []ConfigUsage
+}
+
+// ConfigUsage is what one config used in the current billing period, checked against
+// the per-config quotas
+type ConfigUsage struct {
+	ConfigID     string
+	RepoFullName string
+	Builds       int64
+	BuildMinutes float64
+	StorageBytes int64
+	Quotas       []QuotaStatus
+}
+
+// QuotaStatus compares the usage of one metric with its quota
+type QuotaStatus struct {
+	Metric string
+	Used   float64
+	Soft   *int
+	Hard   *int
+	State  string
+}
+
+// GetUsage meters ownerID's usage in the current period and checks it against the instance quotas
+func (s *Service) GetUsage(ctx context.Context, ownerID string) (*Usage, error) {
+	periodStart := monthStart(time.Now())
+	since := pgtype.Timestamptz{Time: periodStart, Valid: true}
+
+	buildUsage, err := s.queries.GetBuildUsageByOwnerIDSince(ctx, db.GetBuildUsageByOwnerIDSinceParams{
+		OwnerID:   ownerID,
+		StartedAt: since,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get build usage: %w", err)
+	}
+
+	deploys, err := s.queries.CountDeploymentsByOwnerIDSince(ctx, db.CountDeploymentsByOwnerIDSinceParams{
+		OwnerID:   ownerID,
+		CreatedAt: since,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deploy usage: %w", err)
+	}
+
+	storageBytes, err := s.queries.GetStorageUsageByOwnerID(ctx, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get storage usage: %w", err)
+	}
+
+	configUsage, err := s.queries.GetConfigUsageByOwnerIDSince(ctx, db.GetConfigUsageByOwnerIDSinceParams{
+		OwnerID:   ownerID,
+		StartedAt: since,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get config usage: %w", err)
+	}
+
+	instanceLimits, err := s.limitsService.GetLimits(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := &Usage{
+		PeriodStart:  periodStart,
+		PeriodEnd:    periodStart.AddDate(0, 1, 0),
+		Builds:       buildUsage.Builds,
+		BuildMinutes: float64(buildUsage.BuildSeconds) / 60,
+		Deploys:      deploys,
+		StorageBytes: storageBytes,
+	}
+
+	usage.Quotas = []QuotaStatus{
+		newQuotaStatus(MetricBuildMinutes, usage.BuildMinutes, instanceLimits.BuildMinutesQuota),
+		newQuotaStatus(MetricDeploys, float64(usage.Deploys), instanceLimits.DeploysQuota),
+		newQuotaStatus(MetricStorageMB, float64(usage.StorageBytes)/(1024*1024), instanceLimits.StorageMBQuota),
+	}
+
+	usage.Configs = make([]ConfigUsage, len(configUsage))
+	for i, c := range configUsage {
+		buildMinutes := float64(c.BuildSeconds) / 60
+		usage.Configs[i] = ConfigUsage{
+			ConfigID:     c.ConfigID,
+			RepoFullName: c.RepoFullName,
+			Builds:       c.Builds,
+			BuildMinutes: buildMinutes,
+			StorageBytes: c.StorageBytes,
+			Quotas: []QuotaStatus{
+				newQuotaStatus(MetricBuildMinutes, buildMinutes, instanceLimits.ConfigBuildMinutesQuota),
+				newQuotaStatus(MetricStorageMB, float64(c.StorageBytes)/(1024*1024), instanceLimits.ConfigStorageMBQuota),
+			},
+		}
+	}
+
+	return usage, nil
+}
+
+// CheckBuildQuota returns an error wrapping ErrQuotaExceeded when ownerID, or their
+// config configID, reached a hard quota. Reaching a soft quota only logs a warning.
+func (s *Service) CheckBuildQuota(ctx context.Context, ownerID, configID string) error {
+	usage, err := s.GetUsage(ctx, ownerID)
+	if err != nil {
+		return err
+	}
+
+	exceeded := checkQuotas(ownerID, "", usage.Quotas)
+	for _, config := range usage.Configs {
+		if config.ConfigID == configID {
+			exceeded = append(exceeded, checkQuotas(config.RepoFullName, " of the config", config.Quotas)...)
+		}
+	}
+
+	if len(exceeded) > 0 {
+		return fmt.Errorf("%w: %s", ErrQuotaExceeded, strings.Join(exceeded, ", "))
+	}
+
+	return nil
+}
+
+// checkQuotas describes the hard quotas reached in quotas, and logs the soft ones
+// reached by who
+func checkQuotas(who, scope string, quotas []QuotaStatus) []string {
+	var exceeded []string
+	for _, quota := range quotas {
+		switch quota.State {
+		case StateExceeded:
+			exceeded = append(exceeded, fmt.Sprintf("%s%s %.0f of %d", quota.Metric, scope, quota.Used, *quota.Hard))
+		case StateWarning:
+			fmt.Printf("Warning: %s reached the soft %s quota (%.0f of %d)\n", who, quota.Metric, quota.Used, *quota.Soft)
+		}
+	}
+	return exceeded
+}
+
+// newQuotaStatus compares used with quota
+func newQuotaStatus(metric string, used float64, quota limits.Quota) QuotaStatus {
+	state := StateOK
+	switch {
+	case quota.Hard != nil && used >= float64(*quota.Hard):
+		state = StateExceeded
+	case quota.Soft != nil && used >= float64(*quota.Soft):
+		state = StateWarning
+	}
+
+	return QuotaStatus{
+		Metric: metric,
+		Used:   used,
+		Soft:   quota.Soft,
+		Hard:   quota.Hard,
+		State:  state,
+	}
+}
+
+// monthStart returns the start of the calendar month (UTC) containing t
+func monthStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,26 @@
+// Package version holds the release of the Nimbul binaries and the releases of the
+// CLI and API that work together.
+package version
+
+import "golang.org/x/mod/semver"
+
+// Version is the release of the binary, set when building a release with
+// -ldflags "-X github.com/coding-cave-dev/nimbul/internal/version.Version=v1.2.3"
+var Version = "dev"
+
+// MinCLIVersion is the oldest CLI release the API works with. Raise it along with
+// changes to the API that older CLIs cannot read.
+const MinCLIVersion = "v0.1.0"
+
+// MinServerVersion is the oldest server release the CLI works with. Raise it when the
+// CLI starts relying on a change to the API.
+const MinServerVersion = "v0.1.0"
+
+// Older reports whether v is a release before min. Builds that are not a release, like
+// dev, are taken to be current and never older.
+func Older(v, min string) bool {
+	if !semver.IsValid(v) || !semver.IsValid(min) {
+		return false
+	}
+	return semver.Compare(v, min) < 0
+}
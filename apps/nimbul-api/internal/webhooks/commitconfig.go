@@ -0,0 +1,63 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/coding-cave-dev/nimbul/internal/builds"
+	"github.com/coding-cave-dev/nimbul/internal/configs"
+	"github.com/coding-cave-dev/nimbul/internal/nimbulconfig"
+)
+
+// cachedCommitConfig returns the nimbul.yaml an earlier run of a commit parsed and
+// validated, or nil when no run cached it
+func (s *Service) cachedCommitConfig(ctx context.Context, config *configs.Config, commitSHA string) *nimbulconfig.NimbulConfig {
+	content, err := s.buildsService.GetCommitConfig(ctx, config.ID, commitSHA)
+	if err != nil {
+		if !errors.Is(err, builds.ErrCommitConfigNotFound) {
+			fmt.Printf("Warning: %v\n", err)
+		}
+		return nil
+	}
+	nimbulConfig, err := nimbulconfig.ParseBytes(content)
+	if err != nil {
+		return nil
+	}
+	return nimbulConfig
+}
+
+// cacheCommitConfig caches the validated nimbul.yaml of a commit for later runs of it
+func (s *Service) cacheCommitConfig(ctx context.Context, config *configs.Config, commitSHA string, content []byte) {
+	if err := s.buildsService.SaveCommitConfig(ctx, config.ID, commitSHA, content); err != nil {
+		fmt.Printf("Warning: %v\n", err)
+	}
+}
+
+// commitConfig returns the parsed and validated nimbul.yaml of a commit checked out in
+// repoDir. Retries and rollbacks of a commit reuse what its first run parsed.
+func (s *Service) commitConfig(ctx context.Context, config *configs.Config, commitSHA, repoDir string) (*nimbulconfig.NimbulConfig, error) {
+	if nimbulConfig := s.cachedCommitConfig(ctx, config, commitSHA); nimbulConfig != nil {
+		return nimbulConfig, nil
+	}
+
+	nimbulConfigPath, err := nimbulconfig.RepoPath(repoDir, "nimbul.yaml")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read nimbul.yaml at %s: %w", commitSHA, err)
+	}
+	content, err := os.ReadFile(nimbulConfigPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read nimbul.yaml at %s: %w", commitSHA, err)
+	}
+	nimbulConfig, err := nimbulconfig.ParseBytes(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse nimbul.yaml: %w", err)
+	}
+	if err := nimbulconfig.Validate(nimbulConfig); err != nil {
+		return nil, fmt.Errorf("invalid nimbul.yaml: %w", err)
+	}
+
+	s.cacheCommitConfig(ctx, config, commitSHA, content)
+	return nimbulConfig, nil
+}
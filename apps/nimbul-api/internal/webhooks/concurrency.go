@@ -0,0 +1,88 @@
+package webhooks
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+// pipelineRuns tracks the running pipelines of each concurrency group, so a newer push
+// can cancel the builds of the runs it supersedes
+type pipelineRuns struct {
+	mu     sync.Mutex
+	groups map[string]*runGroup
+}
+
+// runGroup is a concurrency group with running pipelines
+type runGroup struct {
+	latest *pipelineRun
+	runs   int
+	// deployMu serializes the deploys of the group, so a superseded run that is
+	// already deploying finishes before the newer run deploys
+	deployMu sync.Mutex
+}
+
+// pipelineRun is a running pipeline of a concurrency group
+type pipelineRun struct {
+	group    *runGroup
+	cancel   context.CancelFunc
+	canceled atomic.Bool
+}
+
+func newPipelineRuns() *pipelineRuns {
+	return &pipelineRuns{groups: make(map[string]*runGroup)}
+}
+
+// start registers a run in the group of key and returns the context its builds run
+// with. When cancelInProgress is set, the previous run of the group is cancelled.
+func (r *pipelineRuns) start(ctx context.Context, key string, cancelInProgress bool) (context.Context, *pipelineRun) {
+	runCtx, cancel := context.WithCancel(ctx)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	group := r.groups[key]
+	if group == nil {
+		group = &runGroup{}
+		r.groups[key] = group
+	}
+
+	if group.latest != nil && cancelInProgress {
+		group.latest.canceled.Store(true)
+		group.latest.cancel()
+	}
+
+	run := &pipelineRun{group: group, cancel: cancel}
+	group.latest = run
+	group.runs++
+
+	return runCtx, run
+}
+
+// finish removes a run from the group of key
+func (r *pipelineRuns) finish(key string, run *pipelineRun) {
+	run.cancel()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	group := run.group
+	if group.latest == run {
+		group.latest = nil
+	}
+	group.runs--
+	if group.runs == 0 {
+		delete(r.groups, key)
+	}
+}
+
+// Canceled reports whether a newer run of the group cancelled this one
+func (p *pipelineRun) Canceled() bool {
+	return p.canceled.Load()
+}
+
+// lockDeploy waits for other deploys of the group and returns the function releasing the lock
+func (p *pipelineRun) lockDeploy() func() {
+	p.group.deployMu.Lock()
+	return p.group.deployMu.Unlock
+}
@@ -0,0 +1,165 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/coding-cave-dev/nimbul/internal/builds"
+	"github.com/coding-cave-dev/nimbul/internal/configs"
+	"github.com/coding-cave-dev/nimbul/internal/nimbulconfig"
+	"github.com/coding-cave-dev/nimbul/internal/registry"
+)
+
+// pinImageDigests pins every image tag the manifests deploy that a Nimbul build pushed
+// to the digest the build recorded, so the cluster runs exactly what was built even if
+// the tag is pushed again before the rollout. Tags pushed by the builds of the run are
+// first checked against the registry, so an image overwritten or tampered with between
+// build and deploy fails the deploy. Runs without builds, like rollbacks and registry
+// redeploys, pin to the stored digests of the config's builds, preferring those of the
+// run's commit. Images already pinned and images no build pushed are left alone.
+func (s *Service) pinImageDigests(ctx context.Context, config *configs.Config, renderedConfig *nimbulconfig.NimbulConfig, manifests []renderedManifest, run deployRun) error {
+	pushedImages, err := s.pushedImages(ctx, config, run)
+	if err != nil {
+		return err
+	}
+	if len(pushedImages) == 0 {
+		return nil
+	}
+
+	if len(run.BuildIDs) > 0 {
+		if err := s.verifyImageDigests(ctx, config, renderedConfig, manifests, pushedImages, run); err != nil {
+			return err
+		}
+	}
+
+	pinned := 0
+	for i := range manifests {
+		count := nimbulconfig.ReplaceImages(manifests[i].Docs, func(image string) string {
+			if strings.Contains(image, "@") {
+				return image
+			}
+			ref, err := registry.NormalizeReference(image)
+			if err != nil {
+				return image
+			}
+			expected, ok := pushedImages[ref]
+			if !ok {
+				return image
+			}
+			pinnedImage, err := registry.PinDigest(image, expected.Digest)
+			if err != nil {
+				return image
+			}
+			return pinnedImage
+		})
+		if count == 0 {
+			continue
+		}
+		pinned += count
+
+		manifests[i].Serialized, err = nimbulconfig.SerializeManifests(manifests[i].Docs)
+		if err != nil {
+			return fmt.Errorf("failed to serialize manifest %s: %w", manifests[i].Path, err)
+		}
+	}
+
+	if pinned > 0 {
+		fmt.Printf("✓ Pinned %d images to the digests their builds pushed\n", pinned)
+	}
+	return nil
+}
+
+// pushedImages returns the images Nimbul builds pushed that a run may deploy, by
+// normalized reference: those of the run's builds, or for runs without builds the
+// stored images of the config, newest first unless a build of the run's commit pushed
+// the same tag
+func (s *Service) pushedImages(ctx context.Context, config *configs.Config, run deployRun) (map[string]builds.Image, error) {
+	var pushed []builds.Image
+	var err error
+	if len(run.BuildIDs) > 0 {
+		buildIDs := make([]int64, 0, len(run.BuildIDs))
+		for _, id := range run.BuildIDs {
+			buildIDs = append(buildIDs, id)
+		}
+		pushed, err = s.buildsService.GetImagesByBuildIDs(ctx, buildIDs)
+	} else {
+		pushed, err = s.buildsService.GetLiveImagesByConfigID(ctx, config.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	images := make(map[string]builds.Image, len(pushed))
+	for _, image := range pushed {
+		ref, err := registry.NormalizeReference(image.Image)
+		if err != nil {
+			continue
+		}
+		if existing, ok := images[ref]; ok && (existing.CommitSHA == run.CommitSHA || image.CommitSHA != run.CommitSHA) {
+			continue
+		}
+		images[ref] = image
+	}
+	return images, nil
+}
+
+// verifyImageDigests checks that every image tag the manifests deploy which a build of
+// the run pushed still points at the digest the build pushed
+func (s *Service) verifyImageDigests(ctx context.Context, config *configs.Config, renderedConfig *nimbulconfig.NimbulConfig, manifests []renderedManifest, pushedImages map[string]builds.Image, run deployRun) error {
+	buildNames := make(map[int64]string, len(run.BuildIDs))
+	for name, id := range run.BuildIDs {
+		buildNames[id] = name
+	}
+
+	var docs []map[string]interface{}
+	for _, manifest := range manifests {
+		docs = append(docs, manifest.Docs...)
+	}
+
+	verified := make(map[string]bool)
+	var mismatches []string
+	for _, image := range nimbulconfig.Images(docs) {
+		if strings.Contains(image, "@") {
+			continue
+		}
+		ref, err := registry.NormalizeReference(image)
+		if err != nil || verified[ref] {
+			continue
+		}
+		expected, ok := pushedImages[ref]
+		if !ok {
+			continue
+		}
+
+		// The registry is read with the login the build pushed with
+		client := registry.NewFromEnv()
+		for _, build := range renderedConfig.Build {
+			if build.Name != buildNames[expected.BuildID] {
+				continue
+			}
+			login, err := s.registryLogin(ctx, config.OwnerID, build)
+			if err != nil {
+				return err
+			}
+			client.Login = login
+		}
+
+		digest, err := client.ResolveDigest(ctx, image)
+		if err != nil {
+			return fmt.Errorf("failed to verify image %s: %w", image, err)
+		}
+		if digest != expected.Digest {
+			mismatches = append(mismatches, fmt.Sprintf("%s points at %s, build %d pushed %s", image, digest, expected.BuildID, expected.Digest))
+		}
+		verified[ref] = true
+	}
+
+	if len(mismatches) > 0 {
+		return fmt.Errorf("images changed in the registry since they were built, nothing was applied: %s", strings.Join(mismatches, "; "))
+	}
+	if len(verified) > 0 {
+		fmt.Printf("✓ Verified the digests of %d images\n", len(verified))
+	}
+	return nil
+}
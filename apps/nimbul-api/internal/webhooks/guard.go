@@ -0,0 +1,162 @@
+package webhooks
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	ghub "github.com/google/go-github/v81/github"
+)
+
+// Defaults of a Guard
+const (
+	// DefaultMaxBodyBytes matches the default body limit of the HTTP server
+	DefaultMaxBodyBytes = 4 * 1024 * 1024
+	DefaultReplayWindow = time.Hour
+	// DefaultRateLimit is how many deliveries a config accepts per minute
+	DefaultRateLimit = 60
+)
+
+var (
+	ErrStaleDelivery       = errors.New("webhook delivery is older than the replay window")
+	ErrDuplicateDelivery   = errors.New("webhook delivery was already received")
+	ErrDeliveryRateLimited = errors.New("too many webhook deliveries for this config")
+)
+
+// Guard protects the pipeline from floods of webhook deliveries: it rejects deliveries
+// sent longer ago than the replay window, a delivery ID received before, and
+// deliveries past the rate limit of their config. Its state is kept in memory, so
+// each server instance guards the deliveries it receives.
+type Guard struct {
+	// MaxBodyBytes is the largest webhook payload accepted
+	MaxBodyBytes int
+
+	replayWindow time.Duration
+	rateLimit    int
+
+	mu      sync.Mutex
+	seen    map[string]time.Time // delivery IDs by when they were received
+	pruned  time.Time
+	buckets map[string]*deliveryBucket
+}
+
+// deliveryBucket is the token bucket limiting the deliveries of a config
+type deliveryBucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+// NewGuardFromEnv creates a Guard configured by the environment:
+//
+//	NIMBUL_WEBHOOK_MAX_BODY_BYTES   largest payload accepted (default 4 MiB)
+//	NIMBUL_WEBHOOK_REPLAY_WINDOW    oldest delivery accepted, a Go duration (default 1h, 0 disables)
+//	NIMBUL_WEBHOOK_RATE_LIMIT       deliveries per config per minute (default 60, 0 disables)
+func NewGuardFromEnv() *Guard {
+	g := &Guard{
+		MaxBodyBytes: DefaultMaxBodyBytes,
+		replayWindow: DefaultReplayWindow,
+		rateLimit:    DefaultRateLimit,
+		seen:         make(map[string]time.Time),
+		buckets:      make(map[string]*deliveryBucket),
+	}
+
+	if value := os.Getenv("NIMBUL_WEBHOOK_MAX_BODY_BYTES"); value != "" {
+		if parsed, err := strconv.Atoi(value); err == nil && parsed > 0 {
+			g.MaxBodyBytes = parsed
+		} else {
+			fmt.Printf("Warning: Invalid NIMBUL_WEBHOOK_MAX_BODY_BYTES %q, using %d\n", value, g.MaxBodyBytes)
+		}
+	}
+	if value := os.Getenv("NIMBUL_WEBHOOK_REPLAY_WINDOW"); value != "" {
+		if parsed, err := time.ParseDuration(value); err == nil && parsed >= 0 {
+			g.replayWindow = parsed
+		} else {
+			fmt.Printf("Warning: Invalid NIMBUL_WEBHOOK_REPLAY_WINDOW %q, using %s\n", value, g.replayWindow)
+		}
+	}
+	if value := os.Getenv("NIMBUL_WEBHOOK_RATE_LIMIT"); value != "" {
+		if parsed, err := strconv.Atoi(value); err == nil && parsed >= 0 {
+			g.rateLimit = parsed
+		} else {
+			fmt.Printf("Warning: Invalid NIMBUL_WEBHOOK_RATE_LIMIT %q, using %d\n", value, g.rateLimit)
+		}
+	}
+
+	return g
+}
+
+// Check admits a delivery to configID. deliveryID identifies the delivery, "" when the
+// sender has none, and sentAt is when the payload says it was sent, zero when unknown.
+// Only authenticated deliveries should be checked, so that forged ones cannot use up
+// the rate limit of a config.
+func (g *Guard) Check(configID, deliveryID string, sentAt time.Time) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := time.Now()
+
+	if g.replayWindow > 0 {
+		if !sentAt.IsZero() && now.Sub(sentAt) > g.replayWindow {
+			return fmt.Errorf("%w (%s)", ErrStaleDelivery, g.replayWindow)
+		}
+
+		// Delivery IDs are forgotten once a replay would be stale anyway
+		if now.Sub(g.pruned) > time.Minute {
+			for id, received := range g.seen {
+				if now.Sub(received) > g.replayWindow {
+					delete(g.seen, id)
+				}
+			}
+			g.pruned = now
+		}
+		if deliveryID != "" {
+			if _, ok := g.seen[deliveryID]; ok {
+				return ErrDuplicateDelivery
+			}
+		}
+	}
+
+	if g.rateLimit > 0 {
+		bucket := g.buckets[configID]
+		if bucket == nil {
+			bucket = &deliveryBucket{tokens: float64(g.rateLimit), updated: now}
+			g.buckets[configID] = bucket
+		}
+		refill := now.Sub(bucket.updated).Minutes() * float64(g.rateLimit)
+		bucket.tokens = min(bucket.tokens+refill, float64(g.rateLimit))
+		bucket.updated = now
+		if bucket.tokens < 1 {
+			return fmt.Errorf("%w (%d per minute)", ErrDeliveryRateLimited, g.rateLimit)
+		}
+		bucket.tokens--
+	}
+
+	// Recorded once admitted, so a rate limited delivery can be redelivered
+	if g.replayWindow > 0 && deliveryID != "" {
+		g.seen[deliveryID] = now
+	}
+
+	return nil
+}
+
+// Forget lets a delivery ID be received again, e.g. after processing the delivery failed
+func (g *Guard) Forget(deliveryID string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.seen, deliveryID)
+}
+
+// GitHubEventTime returns when GitHub sent a webhook event, as far as its signed
+// payload tells, or the zero time for events that do not carry it
+func GitHubEventTime(event any) time.Time {
+	switch event := event.(type) {
+	case *ghub.PushEvent:
+		return event.GetRepo().GetPushedAt().Time
+	case *ghub.RegistryPackageEvent:
+		return event.GetRegistryPackage().GetPackageVersion().GetUpdatedAt().Time
+	}
+	return time.Time{}
+}
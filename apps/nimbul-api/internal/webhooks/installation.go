@@ -0,0 +1,41 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/coding-cave-dev/nimbul/internal/configs"
+	"github.com/coding-cave-dev/nimbul/internal/github"
+	"github.com/coding-cave-dev/nimbul/internal/installations"
+	"github.com/coding-cave-dev/nimbul/internal/jobs"
+)
+
+// installationID finds the installation of the GitHub App that can access the
+// repository of config, from the installation cache when it knows the repository's
+// account and from GitHub otherwise. When the app lost access, the run fails for good
+// and the config's health tells the owner to reinstall the app.
+func (s *Service) installationID(ctx context.Context, config *configs.Config) (int64, error) {
+	installationID, err := s.installationsService.InstallationID(ctx, config.RepoOwner, config.RepoFullName)
+	switch {
+	case err == nil:
+		return installationID, nil
+	case errors.Is(err, installations.ErrAccessRevoked):
+		health := configs.HealthRepoNotFound
+		if errors.Is(err, installations.ErrAppUninstalled) {
+			health = configs.HealthAppUninstalled
+		}
+		if err := s.configsService.SetHealth(ctx, config.ID, health, err.Error()); err != nil {
+			fmt.Printf("Warning: Failed to record health of config %s: %v\n", config.ID, err)
+		}
+		return 0, jobs.Permanent(err)
+	case !errors.Is(err, installations.ErrNotCached):
+		fmt.Printf("Warning: Failed to check installation cache: %v\n", err)
+	}
+
+	installationID, err = github.GetInstallationIDByRepository(ctx, config.RepoOwner, config.RepoName)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get installation ID: %w", err)
+	}
+	return installationID, nil
+}
@@ -0,0 +1,62 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/coding-cave-dev/nimbul/internal/auth"
+	"github.com/coding-cave-dev/nimbul/internal/configs"
+	"github.com/coding-cave-dev/nimbul/internal/jobs"
+	"github.com/coding-cave-dev/nimbul/internal/registry"
+	ghub "github.com/google/go-github/v81/github"
+)
+
+// RegisterJobs registers the handlers of the pipeline jobs, pushes and image pushes,
+// with jobsService. The API server and nimbul-worker both register them, so either
+// can run the jobs.
+func (s *Service) RegisterJobs(jobsService *jobs.Service, authService *auth.Service) {
+	jobsService.Handle(jobs.KindGitHubPush, func(ctx context.Context, job jobs.Job) error {
+		config, err := s.jobConfig(ctx, authService, job)
+		if err != nil {
+			return err
+		}
+		var event ghub.PushEvent
+		if err := json.Unmarshal(job.Payload, &event); err != nil {
+			return jobs.Permanent(fmt.Errorf("invalid push event: %w", err))
+		}
+		return s.HandlePushEvent(ctx, config, &event)
+	})
+	jobsService.Handle(jobs.KindImagePush, func(ctx context.Context, job jobs.Job) error {
+		config, err := s.jobConfig(ctx, authService, job)
+		if err != nil {
+			return err
+		}
+		var push registry.Push
+		if err := json.Unmarshal(job.Payload, &push); err != nil {
+			return jobs.Permanent(fmt.Errorf("invalid image push: %w", err))
+		}
+		return s.HandleImagePush(ctx, config, push)
+	})
+}
+
+// jobConfig loads the config a job runs for. Jobs of deleted configs and of configs of
+// disabled accounts fail for good.
+func (s *Service) jobConfig(ctx context.Context, authService *auth.Service, job jobs.Job) (*configs.Config, error) {
+	config, err := s.configsService.GetConfigByID(ctx, job.ConfigID)
+	if err != nil {
+		if errors.Is(err, configs.ErrConfigNotFound) {
+			return nil, jobs.Permanent(err)
+		}
+		return nil, err
+	}
+	// Pushes to configs of disabled accounts are neither built nor deployed
+	if _, err := authService.Authorize(ctx, config.OwnerID); err != nil {
+		if errors.Is(err, auth.ErrAccountDisabled) {
+			return nil, jobs.Permanent(err)
+		}
+		return nil, err
+	}
+	return config, nil
+}
@@ -0,0 +1,234 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/coding-cave-dev/nimbul/internal/builds"
+	"github.com/coding-cave-dev/nimbul/internal/configs"
+	"github.com/coding-cave-dev/nimbul/internal/nimbulconfig"
+)
+
+// stageRecorder records the progress of a push through its pipeline stages. The
+// stages are only for display, so failing to record them does not fail the run, and
+// a nil recorder records nothing.
+type stageRecorder struct {
+	buildsService *builds.Service
+	pipelineID    int64
+	stageIDs      map[string]int64 // by stage name
+	deploys       []string         // names of the deploy stages
+
+	mu        sync.Mutex // guards the outcomes, builds finish concurrently
+	succeeded bool       // a build or deploy stage succeeded
+	canceled  bool       // a stage was canceled
+}
+
+// startPipeline records a pipeline run for a push, starting with its clone stage
+func (s *Service) startPipeline(ctx context.Context, config *configs.Config, ref, commitSHA string) *stageRecorder {
+	pipeline, err := s.buildsService.CreatePipeline(ctx, builds.CreatePipelineParams{
+		ConfigID:  config.ID,
+		Ref:       ref,
+		CommitSHA: commitSHA,
+	})
+	if err != nil {
+		fmt.Printf("Warning: Failed to record pipeline of %s: %v\n", commitSHA, err)
+		return nil
+	}
+
+	r := &stageRecorder{
+		buildsService: s.buildsService,
+		pipelineID:    pipeline.ID,
+		stageIDs:      make(map[string]int64),
+	}
+	r.add(ctx, builds.StageSpec{Name: builds.StageClone, Kind: builds.StageClone})
+	r.start(ctx, builds.StageClone)
+	return r
+}
+
+// ID returns the ID of the pipeline, 0 when it was not recorded
+func (r *stageRecorder) ID() int64 {
+	if r == nil {
+		return 0
+	}
+	return r.pipelineID
+}
+
+// setConfig records the rendered nimbul.yaml the run uses, so it can be looked at later
+func (r *stageRecorder) setConfig(ctx context.Context, renderedConfig *nimbulconfig.NimbulConfig) {
+	if r == nil {
+		return
+	}
+	rendered, err := nimbulconfig.Marshal(renderedConfig)
+	if err == nil {
+		err = r.buildsService.SetPipelineConfig(ctx, r.pipelineID, rendered)
+	}
+	if err != nil {
+		fmt.Printf("Warning: Failed to record rendered nimbul.yaml: %v\n", err)
+	}
+}
+
+// addStages adds the builds and deploys of nimbul.yaml. Builds run after the clone or
+// the builds they depend on, deploys after the build they deploy, or after every build
+// when they name none.
+func (r *stageRecorder) addStages(ctx context.Context, renderedConfig *nimbulconfig.NimbulConfig) {
+	if r == nil {
+		return
+	}
+
+	var buildStages []string
+	for _, build := range renderedConfig.Build {
+		name := builds.BuildStageName(build.Name)
+		needs := []string{builds.StageClone}
+		if len(build.DependsOn) > 0 {
+			needs = make([]string, len(build.DependsOn))
+			for i, dep := range build.DependsOn {
+				needs[i] = builds.BuildStageName(dep)
+			}
+		}
+		r.add(ctx, builds.StageSpec{Name: name, Kind: builds.StageBuild, Needs: needs})
+		buildStages = append(buildStages, name)
+	}
+	if len(buildStages) == 0 {
+		buildStages = []string{builds.StageClone}
+	}
+
+	for _, deploy := range renderedConfig.Deploy {
+		needs := buildStages
+		if deploy.BuildID != "" {
+			needs = []string{builds.BuildStageName(deploy.BuildID)}
+		}
+		name := builds.DeployStageName(deploy.Name)
+		r.add(ctx, builds.StageSpec{Name: name, Kind: builds.StageDeploy, Needs: needs})
+		r.deploys = append(r.deploys, name)
+	}
+}
+
+// add adds a stage to the pipeline
+func (r *stageRecorder) add(ctx context.Context, spec builds.StageSpec) {
+	stage, err := r.buildsService.AddStage(ctx, r.pipelineID, spec)
+	if err != nil {
+		fmt.Printf("Warning: %v\n", err)
+		return
+	}
+	r.stageIDs[spec.Name] = stage.ID
+}
+
+// start marks a stage as running
+func (r *stageRecorder) start(ctx context.Context, name string) {
+	if r == nil {
+		return
+	}
+	if id, ok := r.stageIDs[name]; ok {
+		if err := r.buildsService.StartStage(ctx, id); err != nil {
+			fmt.Printf("Warning: Failed to record start of stage %s: %v\n", name, err)
+		}
+	}
+}
+
+// finish records the outcome of a stage
+func (r *stageRecorder) finish(ctx context.Context, name string, stageErr error) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	switch {
+	case errors.Is(stageErr, builds.ErrCanceled):
+		r.canceled = true
+	case stageErr == nil && name != builds.StageClone:
+		r.succeeded = true
+	}
+	r.mu.Unlock()
+	if id, ok := r.stageIDs[name]; ok {
+		if err := r.buildsService.FinishStage(ctx, id, stageErr); err != nil {
+			fmt.Printf("Warning: Failed to record outcome of stage %s: %v\n", name, err)
+		}
+	}
+}
+
+// linkBuild links a build stage to its build record
+func (r *stageRecorder) linkBuild(ctx context.Context, name string, buildID int64) {
+	if r == nil {
+		return
+	}
+	if id, ok := r.stageIDs[name]; ok {
+		if err := r.buildsService.SetStageBuild(ctx, id, buildID); err != nil {
+			fmt.Printf("Warning: %v\n", err)
+		}
+	}
+}
+
+// keepDeploys limits the deploy stages run to those of deploys, the others stay
+// pending and are skipped when the run ends
+func (r *stageRecorder) keepDeploys(deploys []nimbulconfig.DeployConfig) {
+	if r == nil {
+		return
+	}
+	r.deploys = make([]string, len(deploys))
+	for i, deploy := range deploys {
+		r.deploys[i] = builds.DeployStageName(deploy.Name)
+	}
+}
+
+// startDeploys marks every deploy stage as running. The deploys of a run are applied together.
+func (r *stageRecorder) startDeploys(ctx context.Context) {
+	if r == nil {
+		return
+	}
+	for _, name := range r.deploys {
+		r.start(ctx, name)
+	}
+}
+
+// linkDeployment links every deploy stage to the deployment recording them
+func (r *stageRecorder) linkDeployment(ctx context.Context, deploymentID int64) {
+	if r == nil {
+		return
+	}
+	for _, name := range r.deploys {
+		if id, ok := r.stageIDs[name]; ok {
+			if err := r.buildsService.SetStageDeployment(ctx, id, deploymentID); err != nil {
+				fmt.Printf("Warning: %v\n", err)
+			}
+		}
+	}
+}
+
+// finishDeploys records the outcome of every deploy stage
+func (r *stageRecorder) finishDeploys(ctx context.Context, deployErr error) {
+	if r == nil {
+		return
+	}
+	for _, name := range r.deploys {
+		r.finish(ctx, name, deployErr)
+	}
+}
+
+// end marks the stages that never started as skipped and records the outcome of the
+// run, given the error it ended with. A run that failed after some of its builds or
+// deploys succeeded is partial.
+func (r *stageRecorder) end(ctx context.Context, runErr error) {
+	if r == nil {
+		return
+	}
+	if err := r.buildsService.SkipPendingStages(ctx, r.pipelineID); err != nil {
+		fmt.Printf("Warning: %v\n", err)
+	}
+
+	r.mu.Lock()
+	status := builds.StatusSucceeded
+	switch {
+	case r.canceled:
+		status = builds.StatusCanceled
+	case runErr != nil && r.succeeded:
+		status = builds.StatusPartial
+	case runErr != nil:
+		status = builds.StatusFailed
+	}
+	r.mu.Unlock()
+
+	if err := r.buildsService.FinishPipeline(ctx, r.pipelineID, status); err != nil {
+		fmt.Printf("Warning: %v\n", err)
+	}
+}
@@ -0,0 +1,52 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/coding-cave-dev/nimbul/internal/configs"
+	"github.com/coding-cave-dev/nimbul/internal/deployments"
+	"github.com/coding-cave-dev/nimbul/internal/policies"
+)
+
+// admitManifests checks the rendered manifests of a deploy against the owner's policies
+// before anything is applied. Violations of warning policies are logged and, when
+// deploymentID is non-zero, streamed to the deployment's progress subscribers; those of
+// blocking policies fail the deploy.
+func (s *Service) admitManifests(ctx context.Context, config *configs.Config, manifests []renderedManifest, deploymentID int64) error {
+	var docs []map[string]interface{}
+	for _, manifest := range manifests {
+		docs = append(docs, manifest.Docs...)
+	}
+
+	violations, err := s.policiesService.Check(ctx, config.OwnerID, config.RepoFullName, docs)
+	if err != nil {
+		return err
+	}
+
+	var blocked []string
+	for _, violation := range violations {
+		if violation.Action == policies.ActionBlock {
+			blocked = append(blocked, violation.String())
+			continue
+		}
+		fmt.Printf("Warning: Policy violation: %s\n", violation)
+		if deploymentID != 0 {
+			s.deploymentsService.PublishProgress(deploymentID, deployments.ProgressEvent{
+				Type:    deployments.ProgressPolicyWarning,
+				Message: violation.String(),
+			})
+		}
+	}
+	if len(blocked) == 0 {
+		return nil
+	}
+
+	// The error names the first few, like the secret scan does
+	listed := strings.Join(blocked[:min(len(blocked), 5)], "; ")
+	if len(blocked) > 5 {
+		listed += fmt.Sprintf(" and %d more", len(blocked)-5)
+	}
+	return fmt.Errorf("deploy blocked by %d policy violations, nothing was applied: %s", len(blocked), listed)
+}
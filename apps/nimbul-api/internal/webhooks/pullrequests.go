@@ -0,0 +1,145 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/coding-cave-dev/nimbul/internal/configs"
+	"github.com/coding-cave-dev/nimbul/internal/github"
+	"github.com/coding-cave-dev/nimbul/internal/k8s"
+	"github.com/coding-cave-dev/nimbul/internal/nimbulconfig"
+)
+
+// maxCommentDiffBytes bounds the manifest diffs of a pull request comment, GitHub
+// rejects comments over 65536 characters
+const maxCommentDiffBytes = 50_000
+
+// commentPullRequest posts or updates the comment on pull request number summarizing
+// the images of a run and how the manifests of its deploys differ from the cluster,
+// before they are deployed. Failures are logged, they never fail the run.
+func (s *Service) commentPullRequest(ctx context.Context, installationID int64, config *configs.Config, number string, renderedConfig, deployConfig *nimbulconfig.NimbulConfig, repoDir string, run deployRun, succeeded map[string]bool) {
+	prNumber, err := strconv.Atoi(number)
+	if err != nil {
+		return
+	}
+
+	body := s.pullRequestComment(ctx, config, renderedConfig, deployConfig, repoDir, run, succeeded)
+
+	appAuth, err := github.NewAppAuth(ctx, installationID)
+	if err != nil {
+		fmt.Printf("Warning: Failed to create app auth: %v\n", err)
+		return
+	}
+	client, err := appAuth.GetInstallationClient(ctx)
+	if err != nil {
+		fmt.Printf("Warning: Failed to get installation client: %v\n", err)
+		return
+	}
+
+	// One comment per config, so monorepos with several configs get one each
+	marker := fmt.Sprintf("<!-- nimbul:pull-request config=%s -->", config.ID)
+	if err := github.UpsertIssueComment(ctx, client, config.RepoOwner, config.RepoName, prNumber, marker, body); err != nil {
+		fmt.Printf("Warning: Failed to comment on pull request #%d: %v\n", prNumber, err)
+		return
+	}
+	fmt.Printf("✓ Commented on pull request #%d\n", prNumber)
+}
+
+// pullRequestComment renders the markdown of a pull request comment
+func (s *Service) pullRequestComment(ctx context.Context, config *configs.Config, renderedConfig, deployConfig *nimbulconfig.NimbulConfig, repoDir string, run deployRun, succeeded map[string]bool) string {
+	var b strings.Builder
+
+	commit := run.CommitSHA
+	if len(commit) > 7 {
+		commit = commit[:7]
+	}
+	fmt.Fprintf(&b, "### Nimbul: %s at `%s`\n\n", config.RepoFullName, commit)
+
+	if len(renderedConfig.Build) > 0 {
+		b.WriteString("| Build | Images | |\n|---|---|---|\n")
+		for _, build := range renderedConfig.Build {
+			status := "✗ failed"
+			if succeeded[build.Name] {
+				status = "✓ pushed"
+			}
+			tags := make([]string, len(build.Tags))
+			for i, tag := range build.Tags {
+				tags[i] = "`" + tag + "`"
+			}
+			fmt.Fprintf(&b, "| %s | %s | %s |\n", build.Name, strings.Join(tags, "<br>"), status)
+		}
+		b.WriteString("\n")
+	}
+
+	if len(renderedConfig.Deploy) == 0 {
+		return b.String()
+	}
+	if len(deployConfig.Deploy) == 0 {
+		b.WriteString("Nothing would be deployed, the builds of every deploy failed.\n")
+		return b.String()
+	}
+
+	diffs, err := s.manifestDiffs(ctx, config, deployConfig, repoDir, run)
+	if err != nil {
+		fmt.Fprintf(&b, "The manifests could not be compared with the cluster: %v\n", err)
+		return b.String()
+	}
+
+	b.WriteString("**Manifests compared with the cluster**\n\n")
+	diffBytes := 0
+	for _, diff := range diffs {
+		name := diff.Kind + " " + diff.Name
+		if diff.Namespace != "" {
+			name = diff.Kind + " " + diff.Namespace + "/" + diff.Name
+		}
+
+		switch {
+		case diff.Error != "":
+			fmt.Fprintf(&b, "- `%s`: could not be compared: %s\n", name, diff.Error)
+		case diff.Action == k8s.DiffUnchanged:
+			fmt.Fprintf(&b, "- `%s`: unchanged\n", name)
+		case diffBytes+len(diff.Diff) > maxCommentDiffBytes:
+			fmt.Fprintf(&b, "- `%s`: %s, diff omitted as the comment is too long\n", name, diff.Action)
+		default:
+			diffBytes += len(diff.Diff)
+			fmt.Fprintf(&b, "- <details><summary><code>%s</code>: %s</summary>\n\n  ```diff\n%s  ```\n  </details>\n", name, diff.Action, indent(diff.Diff, "  "))
+		}
+	}
+	return b.String()
+}
+
+// manifestDiffs renders the manifests of the ready deploys as a merge would deploy
+// them, outside any preview namespace, and compares them with the cluster
+func (s *Service) manifestDiffs(ctx context.Context, config *configs.Config, deployConfig *nimbulconfig.NimbulConfig, repoDir string, run deployRun) ([]k8s.ResourceDiff, error) {
+	if config.AgentID != nil {
+		return nil, fmt.Errorf("the config deploys through agent %s, the server cannot reach its cluster", *config.AgentID)
+	}
+
+	manifests, err := renderDeployStage(deployConfig, repoDir, "", run)
+	if err != nil {
+		return nil, err
+	}
+	serialized := make([]string, 0, len(manifests))
+	for _, manifest := range manifests {
+		serialized = append(serialized, manifest.Serialized)
+	}
+
+	clusterConfig, err := s.ClusterConfig(ctx, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve cluster configuration: %w", err)
+	}
+	return k8s.DiffManifestsWithConfig(ctx, clusterConfig, []byte(strings.Join(serialized, "\n---\n")))
+}
+
+// indent prefixes every line of text
+func indent(text, prefix string) string {
+	lines := strings.SplitAfter(text, "\n")
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = prefix + line
+		}
+	}
+	return strings.Join(lines, "")
+}
@@ -0,0 +1,155 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/coding-cave-dev/nimbul/internal/configs"
+	"github.com/coding-cave-dev/nimbul/internal/credentials"
+	"github.com/coding-cave-dev/nimbul/internal/github"
+	"github.com/coding-cave-dev/nimbul/internal/jobs"
+	"github.com/coding-cave-dev/nimbul/internal/nimbulconfig"
+	"github.com/coding-cave-dev/nimbul/internal/registry"
+)
+
+var (
+	ErrImageNotReferenced = errors.New("image is not referenced by the deploy stage")
+	ErrImageBuiltByNimbul = errors.New("image was pushed by a Nimbul build")
+)
+
+// HandleImagePush redeploys the default branch of a config after an image tag its
+// deploy stage references was pushed outside Nimbul. Nothing is built: the deploy
+// stage runs with the pushed tag pinned to its digest so the workloads roll out.
+func (s *Service) HandleImagePush(ctx context.Context, config *configs.Config, push registry.Push) error {
+	// Registries that do not send the digest are asked for it
+	if push.Digest == "" {
+		digest, err := registry.NewFromEnv().ResolveDigest(ctx, push.Reference())
+		if err != nil {
+			return err
+		}
+		push.Digest = digest
+	}
+
+	// Pushes by Nimbul's own builds are deployed by the pipeline that built them
+	built, err := s.buildsService.IsBuiltImage(ctx, push.Digest)
+	if err != nil {
+		return err
+	}
+	if built {
+		return jobs.Permanent(ErrImageBuiltByNimbul)
+	}
+
+	// Get installation ID for the repository
+	installationID, err := github.GetInstallationIDByRepository(ctx, config.RepoOwner, config.RepoName)
+	if err != nil {
+		return fmt.Errorf("failed to get installation ID: %w", err)
+	}
+
+	sb, err := s.sandboxes.Open(config.ID, "deploy")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := sb.Close(); err != nil {
+			fmt.Printf("Warning: %v\n", err)
+		}
+	}()
+
+	// Clone the default branch, whose manifests describe what is deployed
+	tempDir, err := cloneInSandbox(ctx, sb, installationID, config, "")
+	if err != nil {
+		return err
+	}
+
+	branch, commitSHA, err := github.CurrentRef(ctx, tempDir, sb.Command)
+	if err != nil {
+		return err
+	}
+	ref := "refs/heads/" + branch
+
+	nimbulConfig, err := s.commitConfig(ctx, config, commitSHA, tempDir)
+	if err != nil {
+		return jobs.Permanent(err)
+	}
+
+	templateCtx, err := s.templateContext(ctx, config, commitSHA, branch)
+	if err != nil {
+		return err
+	}
+	templateCtx.EVENT = "registry"
+	templateCtx.ACTOR = push.Pusher
+	renderedConfig, err := nimbulconfig.RenderConfig(nimbulConfig, templateCtx)
+	if err != nil {
+		return jobs.Permanent(fmt.Errorf("failed to render nimbul.yaml templates: %w", err))
+	}
+
+	run := deployRun{
+		ConfigID:  config.ID,
+		Ref:       ref,
+		CommitSHA: commitSHA,
+		Deployer:  push.Pusher,
+		Pin:       &push,
+	}
+
+	// Only pushes of a tag the manifests deploy trigger a rollout
+	manifests, err := renderDeployStage(renderedConfig, tempDir, "", run)
+	if err != nil {
+		return jobs.Permanent(err)
+	}
+	pinned := 0
+	for _, manifest := range manifests {
+		pinned += manifest.Pinned
+	}
+	if pinned == 0 {
+		return jobs.Permanent(ErrImageNotReferenced)
+	}
+
+	fmt.Printf("✓ %s was pushed as %s, redeploying %s\n", push.Reference(), push.Digest, branch)
+
+	return s.deploy(ctx, config, renderedConfig, tempDir, run)
+}
+
+// registryLogin resolves the registry login a build pushes with: the stored login of the
+// registry nimbul.yaml names, else the owner's default login. Returns nil when the
+// owner has no default, the build then pushes with the server's Docker config.
+func (s *Service) registryLogin(ctx context.Context, ownerID string, build nimbulconfig.BuildConfig) (*registry.Login, error) {
+	var token string
+	if build.Registry != "" {
+		server := registry.NormalizeServer(build.Registry)
+		stored, err := s.credentialsService.GetDecryptedToken(ctx, ownerID, server, registry.CredentialTypeLogin)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load registry login for %s: %w", server, err)
+		}
+		token = stored
+	} else {
+		credential, err := s.credentialsService.GetDefaultRegistryCredential(ctx, ownerID)
+		if errors.Is(err, credentials.ErrCredentialNotFound) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to load default registry login: %w", err)
+		}
+		token = credential.Token
+	}
+
+	login, err := registry.ParseLogin([]byte(token))
+	if err != nil {
+		return nil, err
+	}
+
+	// A registry named in nimbul.yaml is where every tag of the build goes
+	if build.Registry != "" {
+		for _, tag := range build.Tags {
+			host, err := registry.Host(tag)
+			if err != nil {
+				return nil, err
+			}
+			if host != login.Server {
+				return nil, fmt.Errorf("build %s: tag %s is not on registry %s", build.Name, tag, login.Server)
+			}
+		}
+	}
+
+	return login, nil
+}
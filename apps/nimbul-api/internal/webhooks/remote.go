@@ -0,0 +1,93 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"path/filepath"
+
+	"github.com/coding-cave-dev/nimbul/internal/configs"
+	"github.com/coding-cave-dev/nimbul/internal/github"
+	"github.com/coding-cave-dev/nimbul/internal/nimbulconfig"
+)
+
+// remoteContext is the commit the builder clones the build contexts of a remote
+// context build from
+type remoteContext struct {
+	URL   string // e.g. https://github.com/org/repo.git#<commit>
+	Token string // installation token to clone with
+}
+
+// contextURL returns the git URL of a build context directory of the repository
+func (r *remoteContext) contextURL(buildContext string) string {
+	dir := path.Clean(filepath.ToSlash(buildContext))
+	if dir == "." {
+		return r.URL
+	}
+	return r.URL + ":" + dir
+}
+
+// remoteConfig reads nimbul.yaml of a commit through the GitHub API. When it sets
+// remoteContext and needs nothing from the working tree, it is returned with the
+// remote context to build from, so the repository is never cloned. Otherwise, also
+// when reading it fails, both are nil and the push is built from a clone.
+func (s *Service) remoteConfig(ctx context.Context, installationID int64, config *configs.Config, commitSHA string) (*nimbulconfig.NimbulConfig, *remoteContext) {
+	// Build contexts are scanned for secrets in a clone
+	if config.SecretScan {
+		return nil, nil
+	}
+
+	appAuth, err := github.NewAppAuth(ctx, installationID)
+	if err != nil {
+		fmt.Printf("Warning: Failed to create app auth: %v\n", err)
+		return nil, nil
+	}
+
+	// Retries of a commit reuse the nimbul.yaml its first run read
+	nimbulConfig := s.cachedCommitConfig(ctx, config, commitSHA)
+	if nimbulConfig == nil {
+		client, err := appAuth.GetInstallationClient(ctx)
+		if err != nil {
+			fmt.Printf("Warning: Failed to get installation client: %v\n", err)
+			return nil, nil
+		}
+
+		data, err := github.GetFileContents(ctx, client, config.RepoOwner, config.RepoName, "nimbul.yaml", commitSHA)
+		if err != nil {
+			// The clone reports a missing or unreadable nimbul.yaml
+			return nil, nil
+		}
+		nimbulConfig, err = nimbulconfig.ParseBytes(data)
+		if err != nil || nimbulconfig.Validate(nimbulConfig) != nil {
+			return nil, nil
+		}
+		s.cacheCommitConfig(ctx, config, commitSHA, data)
+	}
+	if !nimbulConfig.RemoteContext {
+		return nil, nil
+	}
+
+	// Manifests are read from the working tree, and BuildKit only reads Dockerfiles
+	// from within the context
+	if len(nimbulConfig.Deploy) > 0 {
+		fmt.Printf("Cloning %s despite remoteContext: its deploys read manifests from the repository\n", config.RepoFullName)
+		return nil, nil
+	}
+	for _, build := range nimbulConfig.Build {
+		if rel, err := filepath.Rel(build.Context, build.Dockerfile); err != nil || !filepath.IsLocal(rel) {
+			fmt.Printf("Cloning %s despite remoteContext: the Dockerfile of build %s is outside its context\n", config.RepoFullName, build.Name)
+			return nil, nil
+		}
+	}
+
+	token, err := appAuth.GetInstallationToken(ctx)
+	if err != nil {
+		fmt.Printf("Warning: Failed to get installation token: %v\n", err)
+		return nil, nil
+	}
+
+	return nimbulConfig, &remoteContext{
+		URL:   fmt.Sprintf("https://github.com/%s/%s.git#%s", config.RepoOwner, config.RepoName, commitSHA),
+		Token: token,
+	}
+}
@@ -0,0 +1,84 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/coding-cave-dev/nimbul/internal/builds"
+	"github.com/coding-cave-dev/nimbul/internal/configs"
+	"github.com/coding-cave-dev/nimbul/internal/github"
+	"github.com/coding-cave-dev/nimbul/internal/nimbulconfig"
+)
+
+// checkFirstRunFiles checks, on the first run of a config, that the Dockerfile of every
+// build exists at the pushed commit. init only checked nimbul.yaml and the Dockerfiles
+// on the branch it was told about, and pushes may come from another; a missing
+// Dockerfile is reported here by name rather than as a BuildKit failure. Later runs
+// leave it to the builds.
+func (s *Service) checkFirstRunFiles(ctx context.Context, installationID int64, config *configs.Config, nimbulConfig *nimbulconfig.NimbulConfig, repoDir, commitSHA, branch string) error {
+	if len(nimbulConfig.Build) == 0 {
+		return nil
+	}
+
+	_, err := s.buildsService.GetLatestBuildByConfigID(ctx, config.ID)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, builds.ErrBuildNotFound) {
+		fmt.Printf("Warning: %v\n", err)
+		return nil
+	}
+
+	exists := func(p string) (bool, error) {
+		fullPath, err := nimbulconfig.RepoPath(repoDir, p)
+		if err != nil {
+			if errors.Is(err, nimbulconfig.ErrPathOutsideRepo) {
+				return false, err
+			}
+			return false, nil
+		}
+		_, err = os.Stat(fullPath)
+		return err == nil, nil
+	}
+	// Builds straight from git have no clone to look in
+	if repoDir == "" {
+		appAuth, err := github.NewAppAuth(ctx, installationID)
+		if err != nil {
+			return fmt.Errorf("failed to create app auth: %w", err)
+		}
+		client, err := appAuth.GetInstallationClient(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get installation client: %w", err)
+		}
+		exists = func(p string) (bool, error) {
+			return github.FileExists(ctx, client, config.RepoOwner, config.RepoName, p, commitSHA)
+		}
+	}
+
+	var missing []string
+	for _, build := range nimbulConfig.Build {
+		// Paths with templates are only known once rendered
+		if strings.Contains(build.Dockerfile, "{{") {
+			continue
+		}
+		found, err := exists(build.Dockerfile)
+		if err != nil {
+			return fmt.Errorf("dockerfile of %s: %w", build.Name, err)
+		}
+		if !found {
+			missing = append(missing, fmt.Sprintf("%s (build %s)", build.Dockerfile, build.Name))
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	where := commitSHA
+	if branch != "" {
+		where = "branch " + branch
+	}
+	return fmt.Errorf("nimbul.yaml names Dockerfiles that do not exist on %s: %s", where, strings.Join(missing, ", "))
+}
@@ -0,0 +1,33 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/coding-cave-dev/nimbul/internal/configs"
+	ghub "github.com/google/go-github/v81/github"
+)
+
+// HandleRepositoryEvent moves a config to the new name of its repository after the
+// repository was renamed or transferred to another account, so later pushes, which
+// carry the new name, still match the config
+func (s *Service) HandleRepositoryEvent(ctx context.Context, config *configs.Config, event *ghub.RepositoryEvent) error {
+	switch event.GetAction() {
+	case "renamed", "transferred":
+	default:
+		return nil
+	}
+
+	repo := event.GetRepo()
+	if repo.GetFullName() == "" || repo.GetFullName() == config.RepoFullName {
+		return nil
+	}
+
+	renamed, err := s.configsService.RenameRepository(ctx, config.ID, repo.GetOwner().GetLogin(), repo.GetName(), repo.GetFullName(), repo.GetCloneURL())
+	if err != nil {
+		return fmt.Errorf("failed to follow %s of %s to %s: %w", event.GetAction(), config.RepoFullName, repo.GetFullName(), err)
+	}
+
+	fmt.Printf("✓ Config %s follows %s repository %s to %s\n", config.ID, event.GetAction(), config.RepoFullName, renamed.RepoFullName)
+	return nil
+}
@@ -0,0 +1,73 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/coding-cave-dev/nimbul/internal/configs"
+	"github.com/coding-cave-dev/nimbul/internal/deployments"
+	"github.com/coding-cave-dev/nimbul/internal/github"
+	"github.com/coding-cave-dev/nimbul/internal/nimbulconfig"
+)
+
+var ErrRollbackTargetFailed = errors.New("only a succeeded deployment can be rolled back to")
+
+// Rollback redeploys the commit of an earlier deployment of a config. Nothing is
+// built: the deploy stage of that commit is rendered again, so it references the
+// images its builds pushed at the time.
+func (s *Service) Rollback(ctx context.Context, config *configs.Config, target *deployments.Deployment, deployer string) error {
+	if target.Status != deployments.StatusSucceeded {
+		return ErrRollbackTargetFailed
+	}
+
+	// Get installation ID for the repository
+	installationID, err := github.GetInstallationIDByRepository(ctx, config.RepoOwner, config.RepoName)
+	if err != nil {
+		return fmt.Errorf("failed to get installation ID: %w", err)
+	}
+
+	sb, err := s.sandboxes.Open(config.ID, "rollback")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := sb.Close(); err != nil {
+			fmt.Printf("Warning: %v\n", err)
+		}
+	}()
+
+	// Check out the deployed commit rather than its branch, which may have moved on
+	tempDir, err := cloneInSandbox(ctx, sb, installationID, config, target.CommitSHA)
+	if err != nil {
+		return err
+	}
+
+	nimbulConfig, err := s.commitConfig(ctx, config, target.CommitSHA, tempDir)
+	if err != nil {
+		return err
+	}
+
+	templateCtx, err := s.templateContext(ctx, config, target.CommitSHA, extractBranch(target.Ref))
+	if err != nil {
+		return err
+	}
+	templateCtx.EVENT = "rollback"
+	templateCtx.ACTOR = deployer
+	renderedConfig, err := nimbulconfig.RenderConfig(nimbulConfig, templateCtx)
+	if err != nil {
+		return fmt.Errorf("failed to render nimbul.yaml templates: %w", err)
+	}
+
+	run := deployRun{
+		ConfigID:   config.ID,
+		Ref:        target.Ref,
+		CommitSHA:  target.CommitSHA,
+		Deployer:   deployer,
+		RollbackOf: target.ID,
+	}
+
+	fmt.Printf("✓ Rolling back %s to deployment %d (%s)\n", config.ID, target.ID, target.CommitSHA)
+
+	return s.deploy(ctx, config, renderedConfig, tempDir, run)
+}
@@ -0,0 +1,106 @@
+package webhooks
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/coding-cave-dev/nimbul/internal/builds"
+	"github.com/coding-cave-dev/nimbul/internal/nimbulconfig"
+)
+
+// DefaultMaxParallelBuilds is how many builds of a push run at once by default
+const DefaultMaxParallelBuilds = 4
+
+// MaxParallelBuilds returns how many builds of a push may run at once, set with
+// NIMBUL_MAX_PARALLEL_BUILDS
+func MaxParallelBuilds() int {
+	workers := DefaultMaxParallelBuilds
+	if value := os.Getenv("NIMBUL_MAX_PARALLEL_BUILDS"); value != "" {
+		if parsed, err := strconv.Atoi(value); err == nil && parsed > 0 {
+			workers = parsed
+		} else {
+			fmt.Printf("Warning: Invalid NIMBUL_MAX_PARALLEL_BUILDS %q, using %d\n", value, workers)
+		}
+	}
+	return workers
+}
+
+// runBuildGraph runs every build once the builds it depends on succeeded, up to
+// workers at a time, in the order of nimbul.yaml. A failed build only stops the builds
+// depending on it, the builds independent of it still run; once a build is canceled
+// no more builds start. Returns the builds that succeeded and the errors of all
+// failed builds. The dependencies must have been validated, builds left in a cycle
+// never run.
+func runBuildGraph(buildConfigs []nimbulconfig.BuildConfig, workers int, run func(nimbulconfig.BuildConfig) error) (map[string]bool, error) {
+	if workers < 1 {
+		workers = 1
+	}
+
+	type result struct {
+		name string
+		err  error
+	}
+
+	succeeded := make(map[string]bool)
+	started := make([]bool, len(buildConfigs))
+	results := make(chan result)
+	running := 0
+	canceled := false
+	var errs []error
+
+	for {
+		// Start every build that is ready while there are free workers
+		for i, build := range buildConfigs {
+			if canceled || running >= workers {
+				break
+			}
+			if started[i] || !dependenciesSucceeded(build, succeeded) {
+				continue
+			}
+			started[i] = true
+			running++
+			go func() {
+				results <- result{name: build.Name, err: run(build)}
+			}()
+		}
+
+		if running == 0 {
+			return succeeded, errors.Join(errs...)
+		}
+
+		r := <-results
+		running--
+		if r.err != nil {
+			errs = append(errs, r.err)
+			canceled = canceled || errors.Is(r.err, builds.ErrCanceled)
+		} else {
+			succeeded[r.name] = true
+		}
+	}
+}
+
+// dependenciesSucceeded reports whether every build that build depends on succeeded
+func dependenciesSucceeded(build nimbulconfig.BuildConfig, succeeded map[string]bool) bool {
+	for _, dep := range build.DependsOn {
+		if !succeeded[dep] {
+			return false
+		}
+	}
+	return true
+}
+
+// readyDeploys returns the deploys whose builds succeeded. A deploy naming a build
+// runs when that build succeeded, one naming none only when every build did.
+func readyDeploys(deploys []nimbulconfig.DeployConfig, buildConfigs []nimbulconfig.BuildConfig, succeeded map[string]bool) []nimbulconfig.DeployConfig {
+	allSucceeded := len(succeeded) == len(buildConfigs)
+
+	var ready []nimbulconfig.DeployConfig
+	for _, deploy := range deploys {
+		if deploy.BuildID != "" && succeeded[deploy.BuildID] || deploy.BuildID == "" && allSucceeded {
+			ready = append(ready, deploy)
+		}
+	}
+	return ready
+}
@@ -0,0 +1,57 @@
+package webhooks
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/coding-cave-dev/nimbul/internal/buildkit"
+	"github.com/coding-cave-dev/nimbul/internal/nimbulconfig"
+	"github.com/coding-cave-dev/nimbul/internal/secretscan"
+)
+
+// scanSecrets fails a build whose context sends files that look like secrets to the
+// builder, before its images are built and pushed. Findings the allowlist accepts
+// are ignored; the others are logged through logLine.
+func scanSecrets(repoDir string, build nimbulconfig.BuildConfig, allowlist []string, logLine func(string)) error {
+	req, err := newBuildRequest(repoDir, nil, build)
+	if err != nil {
+		return err
+	}
+	excludes, err := buildkit.ContextExcludes(req.ContextDir, req.Dockerfile)
+	if err != nil {
+		return err
+	}
+
+	findings, err := secretscan.Scan(req.ContextDir, excludes)
+	if err != nil {
+		return err
+	}
+
+	// The allowlist is relative to the repository, the findings to the build context
+	contextPath, err := filepath.Rel(repoDir, req.ContextDir)
+	if err != nil {
+		return fmt.Errorf("failed to resolve build context: %w", err)
+	}
+	findings, err = secretscan.Allow(findings, allowlist, contextPath)
+	if err != nil {
+		return err
+	}
+	if len(findings) == 0 {
+		return nil
+	}
+
+	paths := make([]string, len(findings))
+	for i, finding := range findings {
+		finding.Path = filepath.ToSlash(filepath.Join(contextPath, finding.Path))
+		paths[i] = finding.String()
+		logLine("Possible secret: " + paths[i])
+	}
+	// The log has every finding; the build's error names the first few
+	listed := strings.Join(paths[:min(len(paths), 5)], ", ")
+	if len(paths) > 5 {
+		listed += fmt.Sprintf(" and %d more", len(paths)-5)
+	}
+	return fmt.Errorf("secret scan found %d possible secrets in the build context of %s: %s. Remove them, add them to .dockerignore or allow them with 'nimbul secret-scan --allow'",
+		len(findings), build.Name, listed)
+}
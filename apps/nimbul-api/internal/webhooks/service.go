@@ -1,35 +1,104 @@
 package webhooks
 
 import (
+	"cmp"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/coding-cave-dev/nimbul/internal/agents"
+	"github.com/coding-cave-dev/nimbul/internal/artifacts"
 	"github.com/coding-cave-dev/nimbul/internal/buildkit"
+	"github.com/coding-cave-dev/nimbul/internal/builds"
 	"github.com/coding-cave-dev/nimbul/internal/configs"
+	"github.com/coding-cave-dev/nimbul/internal/credentials"
+	"github.com/coding-cave-dev/nimbul/internal/deployments"
 	"github.com/coding-cave-dev/nimbul/internal/github"
+	"github.com/coding-cave-dev/nimbul/internal/hooks"
+	"github.com/coding-cave-dev/nimbul/internal/installations"
+	"github.com/coding-cave-dev/nimbul/internal/jobs"
 	"github.com/coding-cave-dev/nimbul/internal/k8s"
+	"github.com/coding-cave-dev/nimbul/internal/limits"
 	"github.com/coding-cave-dev/nimbul/internal/nimbulconfig"
+	"github.com/coding-cave-dev/nimbul/internal/notifications"
+	"github.com/coding-cave-dev/nimbul/internal/policies"
+	"github.com/coding-cave-dev/nimbul/internal/registry"
+	"github.com/coding-cave-dev/nimbul/internal/sandbox"
+	"github.com/coding-cave-dev/nimbul/internal/usage"
 	ghub "github.com/google/go-github/v81/github"
+	"k8s.io/client-go/rest"
 )
 
 type Service struct {
-	configsService *configs.Service
+	configsService       *configs.Service
+	credentialsService   *credentials.Service
+	agentsService        *agents.Service
+	deploymentsService   *deployments.Service
+	hooksService         *hooks.Service
+	notificationsService *notifications.Service
+	limitsService        *limits.Service
+	buildsService        *builds.Service
+	usageService         *usage.Service
+	artifactsService     *artifacts.Service
+	installationsService *installations.Service
+	policiesService      *policies.Service
+	sandboxes            *sandbox.Manager
+	runs                 *pipelineRuns
 }
 
-func NewService(configsService *configs.Service) *Service {
+func NewService(configsService *configs.Service, credentialsService *credentials.Service, agentsService *agents.Service, deploymentsService *deployments.Service, hooksService *hooks.Service, notificationsService *notifications.Service, limitsService *limits.Service, buildsService *builds.Service, usageService *usage.Service, artifactsService *artifacts.Service, installationsService *installations.Service, policiesService *policies.Service, sandboxes *sandbox.Manager) *Service {
 	return &Service{
-		configsService: configsService,
+		configsService:       configsService,
+		credentialsService:   credentialsService,
+		agentsService:        agentsService,
+		deploymentsService:   deploymentsService,
+		hooksService:         hooksService,
+		notificationsService: notificationsService,
+		limitsService:        limitsService,
+		buildsService:        buildsService,
+		usageService:         usageService,
+		artifactsService:     artifactsService,
+		installationsService: installationsService,
+		policiesService:      policiesService,
+		sandboxes:            sandboxes,
+		runs:                 newPipelineRuns(),
 	}
 }
 
+// ErrNoClusterCredential is returned for configs that have neither a cluster credential
+// nor an agent to deploy with, such as configs just transferred to a new owner
+var ErrNoClusterCredential = errors.New("config has no cluster credential or agent to deploy with")
+
+// ClusterConfig resolves the Kubernetes REST config used to deploy a config, from the
+// stored cluster credential of its owner. The server's own cluster access is never
+// lent to configs.
+func (s *Service) ClusterConfig(ctx context.Context, config *configs.Config) (*rest.Config, error) {
+	if config.ClusterCredentialID == nil {
+		return nil, ErrNoClusterCredential
+	}
+
+	credential, err := s.credentialsService.GetDecryptedCredentialByID(ctx, config.OwnerID, *config.ClusterCredentialID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load cluster credential: %w", err)
+	}
+
+	return k8s.ConfigFromCredential(credential.TokenType, []byte(credential.Token))
+}
+
 // HandlePushEvent processes a GitHub push event
-func (s *Service) HandlePushEvent(ctx context.Context, config *configs.Config, pushEvent *ghub.PushEvent) error {
+func (s *Service) HandlePushEvent(ctx context.Context, config *configs.Config, pushEvent *ghub.PushEvent) (runErr error) {
 	// 1. Verify the event repo matches the config repo
 	if pushEvent.Repo.GetFullName() != config.RepoFullName {
-		return fmt.Errorf("repository mismatch: expected %s, got %s", config.RepoFullName, pushEvent.Repo.GetFullName())
+		return jobs.Permanent(fmt.Errorf("repository mismatch: expected %s, got %s", config.RepoFullName, pushEvent.Repo.GetFullName()))
 	}
 
 	// Get the ref from the push event (e.g., "refs/heads/main" or commit SHA)
@@ -39,125 +108,431 @@ func (s *Service) HandlePushEvent(ctx context.Context, config *configs.Config, p
 		ref = pushEvent.GetHeadCommit().GetSHA()
 	}
 
+	// A deleted branch has nothing to build; tear down its preview instead
+	if pushEvent.GetDeleted() {
+		// Retention rules may remove the images the branch's builds pushed
+		if err := s.buildsService.MarkBranchDeleted(ctx, config.ID, ref); err != nil {
+			fmt.Printf("Warning: Failed to record deletion of %s: %v\n", ref, err)
+		}
+		return s.deletePreview(ctx, config, extractBranch(ref))
+	}
+
 	// Get commit SHA
 	commitSHA := pushEvent.GetHeadCommit().GetID()
 	if commitSHA == "" {
-		return fmt.Errorf("push event missing head commit SHA")
+		return jobs.Permanent(fmt.Errorf("push event missing head commit SHA"))
+	}
+
+	// Automated commits, e.g. version bumps by a bot, can opt out of builds
+	if reason := skipReason(config, pushEvent); reason != "" {
+		fmt.Printf("Skipping build of %s: %s\n", commitSHA, reason)
+		return nil
 	}
 
-	// Get installation ID for the repository
-	installationID, err := github.GetInstallationIDByRepository(ctx, config.RepoOwner, config.RepoName)
+	// Get installation ID for the repository; repositories the app lost access to fail
+	// here rather than when cloning
+	installationID, err := s.installationID(ctx, config)
 	if err != nil {
-		return fmt.Errorf("failed to get installation ID: %w", err)
+		return err
 	}
 
-	// 2. Clone repository to temp directory
-	tempDir, err := os.MkdirTemp("", fmt.Sprintf("nimbul-build-%s-*", config.ID))
+	// Record the run's stages; cloning through rendering nimbul.yaml is the clone stage
+	stages := s.startPipeline(ctx, config, ref, commitSHA)
+	defer func() { stages.end(ctx, runErr) }()
+	cloneFailed := func(err error) error {
+		stages.finish(ctx, builds.StageClone, err)
+		return err
+	}
+	// Mistakes in nimbul.yaml fail the run for good; the other clone failures, like
+	// GitHub being unreachable, are retried
+	invalidConfig := func(err error) error {
+		return jobs.Permanent(cloneFailed(err))
+	}
+	cloneStarted := time.Now()
+
+	// Clones and artifacts stay in a sandbox of the config, removed once the run ends
+	sb, err := s.sandboxes.Open(config.ID, "build")
 	if err != nil {
-		return fmt.Errorf("failed to create temp directory: %w", err)
+		return cloneFailed(err)
 	}
 	defer func() {
-		if err := github.CleanupRepository(tempDir); err != nil {
-			fmt.Printf("Warning: Failed to cleanup temp directory %s: %v\n", tempDir, err)
+		if err := sb.Close(); err != nil {
+			fmt.Printf("Warning: %v\n", err)
 		}
 	}()
 
-	// Clone repository
-	if err := github.CloneRepository(ctx, installationID, config.RepoOwner, config.RepoName, ref, tempDir); err != nil {
-		return fmt.Errorf("failed to clone repository: %w", err)
+	// 2. Clone repository into the sandbox, unless nimbul.yaml opts into building
+	// straight from git and needs nothing else from the working tree
+	nimbulConfig, remote := s.remoteConfig(ctx, installationID, config, commitSHA)
+	tempDir := ""
+	if remote != nil {
+		fmt.Printf("Building %s from git without cloning\n", commitSHA)
+	} else {
+		if tempDir, err = cloneInSandbox(ctx, sb, installationID, config, ref); err != nil {
+			return cloneFailed(err)
+		}
+
+		// 3. Read, parse and validate nimbul.yaml from cloned repo, unless an earlier
+		// run of the commit did
+		if nimbulConfig, err = s.commitConfig(ctx, config, commitSHA, tempDir); err != nil {
+			return invalidConfig(err)
+		}
 	}
 
-	// 3. Fetch and parse nimbul.yaml from cloned repo
-	nimbulConfigPath := filepath.Join(tempDir, "nimbul.yaml")
-	nimbulConfig, err := nimbulconfig.ParseFile(nimbulConfigPath)
+	branch := extractBranch(ref)
+	if err := s.checkFirstRunFiles(ctx, installationID, config, nimbulConfig, tempDir, commitSHA, branch); err != nil {
+		return invalidConfig(err)
+	}
+
+	// 4. Create template context
+	templateCtx, err := s.templateContext(ctx, config, commitSHA, branch)
 	if err != nil {
-		return fmt.Errorf("failed to parse nimbul.yaml: %w", err)
+		return cloneFailed(err)
+	}
+	templateCtx.EVENT = "push"
+	templateCtx.ACTOR = cmp.Or(pushEvent.GetSender().GetLogin(), pushEvent.GetPusher().GetName())
+	// Pushes to the default branch are not the head of a pull request
+	if branch != "" && branch != pushEvent.GetRepo().GetDefaultBranch() {
+		templateCtx.PR_NUMBER = s.pullRequestNumber(ctx, installationID, config, branch)
 	}
 
-	// 4. Validate config
-	if err := nimbulconfig.Validate(nimbulConfig); err != nil {
-		return fmt.Errorf("invalid nimbul.yaml: %w", err)
+	// 5. Render config with template variables
+	renderedConfig, err := nimbulconfig.RenderConfig(nimbulConfig, templateCtx)
+	if err != nil {
+		return invalidConfig(fmt.Errorf("failed to render nimbul.yaml templates: %w", err))
 	}
+	stages.setConfig(ctx, renderedConfig)
+	stages.finish(ctx, builds.StageClone, nil)
+	stages.addStages(ctx, renderedConfig)
+	cloneTiming := builds.Timing{Name: "clone", Kind: builds.TimingClone, Started: cloneStarted, Duration: time.Since(cloneStarted)}
 
-	// 5. Create template context
-	branch := extractBranch(ref)
-	templateCtx := nimbulconfig.NewTemplateContext(commitSHA, branch, config.RepoFullName)
+	// Owners or configs over a hard usage quota cannot start new builds; deploy-only
+	// configs still deploy. The builds report the exceeded quota.
+	if len(renderedConfig.Build) > 0 {
+		if err := s.usageService.CheckBuildQuota(ctx, config.OwnerID, config.ID); err != nil {
+			for _, build := range renderedConfig.Build {
+				stages.finish(ctx, builds.BuildStageName(build.Name), err)
+			}
+			return jobs.Permanent(err)
+		}
+	}
 
-	// 6. Render config with template variables
-	renderedConfig, err := nimbulconfig.RenderConfig(nimbulConfig, templateCtx)
+	// 6. Build Docker images for each build config using BuildKit
+	// Each build is cut off once it runs longer than the instance limit
+	buildTimeout, err := s.limitsService.BuildTimeout(ctx)
+	if err != nil {
+		return err
+	}
+	maxLogBytes, maxArtifactBytes, err := s.limitsService.BuildStorageLimits(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to render nimbul.yaml templates: %w", err)
+		return err
+	}
+
+	run := deployRun{
+		ConfigID:  config.ID,
+		Ref:       ref,
+		CommitSHA: commitSHA,
+		Deployer:  pushEvent.GetPusher().GetName(),
+		BuildIDs:  make(map[string]int64),
+		Stages:    stages,
+	}
+
+	// A newer push to the same concurrency group cancels the builds of this run
+	buildCtx := ctx
+	var pipeline *pipelineRun
+	if group := renderedConfig.Concurrency.GroupFor(branch); group != "" {
+		key := config.ID + "/" + group
+		buildCtx, pipeline = s.runs.start(ctx, key, renderedConfig.Concurrency.CancelsInProgress())
+		defer s.runs.finish(key, pipeline)
 	}
 
-	// 7. Build Docker images for each build config using BuildKit
-	builder := buildkit.NewFromEnv()
-	for _, build := range renderedConfig.Build {
-		// Get full paths relative to cloned repo
-		buildContext := filepath.Join(tempDir, build.Context)
-		dockerfileFullPath := filepath.Join(tempDir, build.Dockerfile)
+	// Independent builds run in parallel; base images are built and pushed before
+	// the builds using them. A failed build only stops what depends on it.
+	builder := buildkit.NewImageBuilderFromEnv()
+	var buildIDsMu sync.Mutex
+	succeeded, buildErr := runBuildGraph(renderedConfig.Build, MaxParallelBuilds(), func(build nimbulconfig.BuildConfig) error {
+		buildEvent := newEvent(config, ref, commitSHA)
+		buildEvent.Build = build.Name
+		buildEvent.Images = build.Tags
+		stage := builds.BuildStageName(build.Name)
+		stages.start(ctx, stage)
 
-		// Calculate Dockerfile path relative to context
-		// Both build.Context and build.Dockerfile are relative to repo root
-		dockerfileRelPath, err := filepath.Rel(buildContext, dockerfileFullPath)
+		// A commit built before with the same build, e.g. after a force push or a
+		// revert resetting the branch, reuses the images of that build and goes
+		// straight to deploying them
+		configHash := buildConfigHash(build)
+		if previous, err := s.buildsService.GetReusableBuild(ctx, config.ID, build.Name, commitSHA, configHash); err == nil {
+			stages.linkBuild(ctx, stage, previous.ID)
+			buildEvent.BuildID = previous.ID
+			buildIDsMu.Lock()
+			run.BuildIDs[build.Name] = previous.ID
+			buildIDsMu.Unlock()
+			stages.finish(ctx, stage, nil)
+			fmt.Printf("Build %s of %s reuses build %d\n", build.Name, commitSHA, previous.ID)
+			buildEvent.Type = hooks.EventBuildSucceeded
+			s.Publish(ctx, config, buildEvent)
+			return nil
+		} else if !errors.Is(err, builds.ErrBuildNotFound) {
+			fmt.Printf("Warning: %v\n", err)
+		}
+
+		// Record the build for usage metering and artifacts
+		record, err := s.buildsService.StartBuild(ctx, builds.StartBuildParams{
+			ConfigID:   config.ID,
+			OwnerID:    config.OwnerID,
+			Name:       build.Name,
+			Ref:        ref,
+			CommitSHA:  commitSHA,
+			PipelineID: stages.ID(),
+			ConfigHash: configHash,
+		})
 		if err != nil {
-			return fmt.Errorf("failed to calculate Dockerfile path relative to context: %w", err)
+			stages.finish(ctx, stage, err)
+			return err
 		}
+		stages.linkBuild(ctx, stage, record.ID)
+		buildEvent.BuildID = record.ID
+		buildIDsMu.Lock()
+		run.BuildIDs[build.Name] = record.ID
+		buildIDsMu.Unlock()
 
-		// Build image with each tag
-		for _, tag := range build.Tags {
-			// Parse image:tag format
-			imageName, tagValue := parseImageTag(tag)
-			imageRef := fmt.Sprintf("%s:%s", imageName, tagValue)
+		buildEvent.Type = hooks.EventBuildStarted
+		s.Publish(ctx, config, buildEvent)
 
-			buildReq := buildkit.BuildRequest{
-				ContextDir: buildContext,
-				Dockerfile: dockerfileRelPath,
-				ImageRef:   imageRef,
-				Push:       true,
-			}
+		// Stream the build's output to log subscribers and store it once it finishes,
+		// along with how long each part of the build took
+		buildLog := s.buildsService.NewLogRecorder(record.ID, maxLogBytes)
+		timings := []builds.Timing{cloneTiming}
+		observer := buildObserver{
+			OnLog: func(entry buildkit.LogEntry) {
+				buildLog.Add(builds.LogEntry(entry))
+			},
+			OnTiming: func(timing buildkit.Timing) {
+				timings = append(timings, builds.Timing(timing))
+			},
+		}
 
-			if err := builder.BuildAndPush(ctx, buildReq); err != nil {
-				return fmt.Errorf("failed to build Docker image %s:%s: %w", imageName, tagValue, err)
-			}
-			fmt.Printf("Successfully built Docker image: %s:%s\n", imageName, tagValue)
+		login, buildErr := s.registryLogin(ctx, config.OwnerID, build)
+		if buildErr == nil && config.SecretScan {
+			buildErr = scanSecrets(tempDir, build, config.SecretScanAllowlist, func(line string) {
+				buildLog.Add(builds.LogEntry{Stream: builds.LogSystem, Message: line})
+			})
+		}
+		var images []pushedImage
+		if buildErr == nil {
+			images, buildErr = buildWithTimeout(buildCtx, builder, tempDir, remote, build, login, observer, config.RepoCloneURL, commitSHA, buildTimeout)
+		}
+		if buildErr == nil {
+			s.recordImages(ctx, record.ID, images)
+			s.storeProvenance(ctx, builder, record.ID, images, login)
+		}
+		if buildErr == nil && build.Artifacts != nil {
+			buildErr = s.storeArtifacts(buildCtx, builder, sb, tempDir, remote, build, login, observer, record.ID, maxArtifactBytes)
+		}
+		if buildErr != nil && pipeline != nil && pipeline.Canceled() {
+			buildErr = builds.ErrCanceled
 		}
+		if buildErr != nil {
+			buildLog.Add(builds.LogEntry{Stream: builds.LogSystem, Message: buildErr.Error()})
+		}
+		if err := s.buildsService.AddBuildTimings(ctx, record.ID, timings...); err != nil {
+			fmt.Printf("Warning: Failed to record timings of build %d: %v\n", record.ID, err)
+		}
+		if err := s.buildsService.FinishBuild(ctx, record.ID, buildErr); err != nil {
+			fmt.Printf("Warning: Failed to record build %d: %v\n", record.ID, err)
+		}
+		if err := buildLog.Save(ctx); err != nil {
+			fmt.Printf("Warning: Failed to store log of build %d: %v\n", record.ID, err)
+		}
+		stages.finish(ctx, stage, buildErr)
+
+		if errors.Is(buildErr, builds.ErrCanceled) {
+			buildEvent.Type = hooks.EventBuildCanceled
+			buildEvent.Error = buildErr.Error()
+			s.Publish(ctx, config, buildEvent)
+			fmt.Printf("Build %s of %s canceled: superseded by a newer push\n", build.Name, commitSHA)
+			return buildErr
+		}
+
+		if buildErr != nil {
+			buildEvent.Type = hooks.EventBuildFailed
+			buildEvent.Error = buildErr.Error()
+			s.Publish(ctx, config, buildEvent)
+			return buildErr
+		}
+
+		buildEvent.Type = hooks.EventBuildSucceeded
+		s.Publish(ctx, config, buildEvent)
+		return nil
+	})
+	if errors.Is(buildErr, builds.ErrCanceled) {
+		return nil
 	}
+	// Failed builds are recorded with their logs for the config's owner to fix, so the
+	// run is not retried
+	buildErr = jobs.Permanent(buildErr)
 
-	// 8. Process deploy stage for each deploy config
-	for _, deploy := range renderedConfig.Deploy {
-		for _, manifest := range deploy.Manifests {
-			// Get full path to manifest file in cloned repo
-			manifestPath := filepath.Join(tempDir, manifest.Path)
+	// 7. Process deploy stage for each deploy config whose builds succeeded; the
+	// others are skipped and the run reports the failed builds
+	deployConfig := *renderedConfig
+	deployConfig.Deploy = readyDeploys(renderedConfig.Deploy, renderedConfig.Build, succeeded)
+	stages.keepDeploys(deployConfig.Deploy)
 
-			// Parse manifest file
-			docs, err := nimbulconfig.ParseManifestFile(manifestPath)
-			if err != nil {
-				return fmt.Errorf("failed to parse manifest file %s: %w", manifest.Path, err)
-			}
+	// Reviewers of the branch's pull request see what merging it would deploy
+	if renderedConfig.PullRequestComments && templateCtx.PR_NUMBER != "" {
+		s.commentPullRequest(ctx, installationID, config, templateCtx.PR_NUMBER, renderedConfig, &deployConfig, tempDir, run, succeeded)
+	}
 
-			// Apply overrides
-			if err := nimbulconfig.ApplyOverrides(docs, manifest.Overrides); err != nil {
-				return fmt.Errorf("failed to apply overrides to manifest %s: %w", manifest.Path, err)
-			}
+	if buildErr != nil {
+		if len(deployConfig.Deploy) == 0 {
+			return buildErr
+		}
+		fmt.Printf("Deploying %d of %d deploys of %s despite failed builds\n", len(deployConfig.Deploy), len(renderedConfig.Deploy), commitSHA)
+	}
 
-			// Serialize manifest
-			serialized, err := nimbulconfig.SerializeManifests(docs)
-			if err != nil {
-				return fmt.Errorf("failed to serialize manifest %s: %w", manifest.Path, err)
-			}
+	// Deploys of a group run one at a time, and a superseded run leaves deploying to
+	// the newer one so an older commit is never applied over it
+	if pipeline != nil {
+		unlock := pipeline.lockDeploy()
+		defer unlock()
+		if pipeline.Canceled() {
+			fmt.Printf("Skipping deploy of %s: superseded by a newer push\n", commitSHA)
+			stages.finishDeploys(ctx, builds.ErrCanceled)
+			return nil
+		}
+	}
 
-			// Apply manifest to cluster
-			fmt.Printf("\n=== Applying Manifest: %s ===\n", manifest.Path)
-			if err := k8s.ApplyManifests(ctx, []byte(serialized)); err != nil {
-				return fmt.Errorf("failed to apply manifest %s: %w", manifest.Path, err)
-			}
-			fmt.Printf("✓ Successfully applied manifest: %s\n", manifest.Path)
+	stages.startDeploys(ctx)
+	deployStarted := time.Now()
+	err = s.deploy(ctx, config, &deployConfig, tempDir, run)
+	stages.finishDeploys(ctx, err)
+
+	// The deploy is part of the breakdown of every build it ran after
+	deployTiming := builds.Timing{Name: "deploy", Kind: builds.TimingDeploy, Started: deployStarted, Duration: time.Since(deployStarted)}
+	for name, buildID := range run.BuildIDs {
+		if !succeeded[name] {
+			continue
+		}
+		if err := s.buildsService.AddBuildTimings(ctx, buildID, deployTiming); err != nil {
+			fmt.Printf("Warning: Failed to record timings of build %d: %v\n", buildID, err)
 		}
 	}
+	return errors.Join(buildErr, err)
+}
+
+// deployRun describes a run of the deploy stage. Besides the ref it deploys, it names
+// who triggered it and the builds it deploys, which are recorded on the applied resources.
+type deployRun struct {
+	ConfigID  string
+	Ref       string
+	CommitSHA string
+	Deployer  string           // GitHub or registry user who pushed, or Nimbul user rolling back
+	BuildIDs  map[string]int64 // build record of each build name
+	// Pin, when set, pins the images referring to its tag to the pushed digest
+	Pin *registry.Push
+	// RollbackOf is the deployment a rollback goes back to, 0 for other deploys
+	RollbackOf int64
+	// Stages records the deploy stages of a push's pipeline, nil for other deploys
+	Stages *stageRecorder
+}
 
-	// 9. Test Kubernetes client connectivity
+// metadata returns the labels and annotations of the resources a deploy entry linked
+// to buildName applies
+func (r deployRun) metadata(buildName string) (labels, annotations map[string]string) {
+	labels = map[string]string{k8s.LabelConfigID: r.ConfigID}
+	annotations = map[string]string{k8s.AnnotationCommit: r.CommitSHA}
+
+	if branch := extractBranch(r.Ref); branch != "" {
+		annotations[k8s.AnnotationBranch] = branch
+	}
+	if r.Deployer != "" {
+		annotations[k8s.AnnotationDeployer] = r.Deployer
+	}
+	if buildID, ok := r.BuildIDs[buildName]; ok {
+		annotations[k8s.AnnotationBuildID] = strconv.FormatInt(buildID, 10)
+	}
+
+	return labels, annotations
+}
+
+// deploy runs the deploy stage of a rendered config
+func (s *Service) deploy(ctx context.Context, config *configs.Config, renderedConfig *nimbulconfig.NimbulConfig, repoDir string, run deployRun) error {
+	// Branches matching the preview patterns are deployed into their own namespace
+	branch := extractBranch(run.Ref)
+	preview := renderedConfig.Preview.Matches(branch)
+
+	// Configs deployed through an agent hand the rendered manifests over instead of
+	// talking to the cluster, which the API server may not be able to reach
+	if config.AgentID != nil {
+		if preview {
+			return fmt.Errorf("preview deployments are not supported for configs deployed by an agent")
+		}
+		return s.queueAgentDeployment(ctx, config, renderedConfig, repoDir, run)
+	}
+
+	clusterConfig, err := s.ClusterConfig(ctx, config)
+	if errors.Is(err, ErrNoClusterCredential) {
+		return jobs.Permanent(err)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to resolve cluster configuration: %w", err)
+	}
+
+	namespace := ""
+	if preview {
+		ttl, err := renderedConfig.Preview.TTLDuration()
+		if err != nil {
+			return err
+		}
+		namespace = nimbulconfig.PreviewNamespace(config.ID, branch)
+		if err := k8s.EnsurePreviewNamespace(ctx, clusterConfig, namespace, config.ID, branch, ttl); err != nil {
+			return err
+		}
+		fmt.Printf("✓ Deploying preview of %s into namespace %s\n", branch, namespace)
+	}
+
+	// Record the deployment so its resources can be inspected later
+	deployment, err := s.deploymentsService.CreateDeployment(ctx, deployments.CreateDeploymentParams{
+		ConfigID:   config.ID,
+		Ref:        run.Ref,
+		CommitSHA:  run.CommitSHA,
+		DeployedBy: run.Deployer,
+		RollbackOf: run.RollbackOf,
+	})
+	if err != nil {
+		return err
+	}
+	run.Stages.linkDeployment(ctx, deployment.ID)
+
+	deployEvent := newEvent(config, run.Ref, run.CommitSHA)
+	deployEvent.DeploymentID = deployment.ID
+	if run.Pin != nil {
+		deployEvent.Images = []string{run.Pin.Reference()}
+	}
+	deployEvent.Type = hooks.EventDeployStarted
+	s.Publish(ctx, config, deployEvent)
+
+	resources, deployErr := s.applyDeployStage(ctx, config, clusterConfig, renderedConfig, repoDir, namespace, run, deployment.ID)
+	if err := s.deploymentsService.CompleteDeployment(ctx, deployment.ID, resources, deployErr); err != nil {
+		fmt.Printf("Warning: Failed to record result of deployment %d: %v\n", deployment.ID, err)
+	}
+	if deployErr != nil {
+		deployEvent.Type = hooks.EventDeployFailed
+		deployEvent.Error = deployErr.Error()
+		s.Publish(ctx, config, deployEvent)
+		return deployErr
+	}
+
+	deployEvent.Type = hooks.EventDeploySucceeded
+	s.Publish(ctx, config, deployEvent)
+
+	if err := s.deploymentsService.SetLiveDeployment(ctx, config.ID, namespace, branch, deployment.ID, run.BuildIDs); err != nil {
+		fmt.Printf("Warning: Failed to record deployment %d as live: %v\n", deployment.ID, err)
+	}
+
+	// Test Kubernetes client connectivity
 	fmt.Println("\n=== Testing Kubernetes Client ===")
-	k8sClient, err := k8s.GetClient()
+	k8sClient, err := k8s.GetClientForConfig(clusterConfig)
 	if err != nil {
 		return fmt.Errorf("failed to initialize Kubernetes client: %w", err)
 	}
@@ -175,6 +550,443 @@ func (s *Service) HandlePushEvent(ctx context.Context, config *configs.Config, p
 	return nil
 }
 
+// renderedManifest is a manifest of the deploy stage rendered for applying
+type renderedManifest struct {
+	Path       string
+	Serialized string
+	Docs       []map[string]interface{} // the documents Serialized was produced from
+	Pinned     int                      // images pinned to a pushed digest
+}
+
+// renderDeployStage renders every manifest of the deploy stage for run. A non-empty
+// namespace places every namespaced resource in it.
+func renderDeployStage(renderedConfig *nimbulconfig.NimbulConfig, repoDir, namespace string, run deployRun) ([]renderedManifest, error) {
+	var manifests []renderedManifest
+	for _, deploy := range renderedConfig.Deploy {
+		labels, annotations := run.metadata(deploy.BuildID)
+		for _, manifest := range deploy.Manifests {
+			rendered, err := renderManifest(repoDir, manifest, namespace, labels, annotations, run.Pin)
+			if err != nil {
+				return nil, err
+			}
+			manifests = append(manifests, rendered)
+		}
+	}
+	return manifests, nil
+}
+
+// applyDeployStage renders every manifest of the deploy stage, pins the images Nimbul
+// builds pushed to their digests, checks the manifests against the owner's policies,
+// validates all of them with a server-side dry run and only then applies them, so an
+// invalid manifest does not leave the cluster half-updated. A non-empty namespace
+// places every namespaced resource in it. Returns the resources applied (including
+// those applied before a failure), each of which is also announced to the progress
+// subscribers of the deployment.
+func (s *Service) applyDeployStage(ctx context.Context, config *configs.Config, clusterConfig *rest.Config, renderedConfig *nimbulconfig.NimbulConfig, repoDir, namespace string, run deployRun, deploymentID int64) ([]k8s.ResourceRef, error) {
+	manifests, err := renderDeployStage(renderedConfig, repoDir, namespace, run)
+	if err != nil {
+		return nil, err
+	}
+
+	// Kept with the deployment, also when a policy or the cluster refuses them
+	recorded := make([]deployments.Manifest, len(manifests))
+	for i, manifest := range manifests {
+		recorded[i] = deployments.Manifest{Path: manifest.Path, Content: manifest.Serialized}
+	}
+	if err := s.deploymentsService.SaveManifests(ctx, deploymentID, recorded); err != nil {
+		fmt.Printf("Warning: Failed to record manifests of deployment %d: %v\n", deploymentID, err)
+	}
+
+	// Pinned first, so policies see the images that are deployed
+	if err := s.pinImageDigests(ctx, config, renderedConfig, manifests, run); err != nil {
+		return nil, err
+	}
+
+	if err := s.admitManifests(ctx, config, manifests, deploymentID); err != nil {
+		return nil, err
+	}
+
+	serialized := make([]string, len(manifests))
+	for i, manifest := range manifests {
+		serialized[i] = manifest.Serialized
+	}
+
+	// Validate all manifests together so resources may depend on each other
+	fmt.Println("\n=== Validating Manifests (dry run) ===")
+	if err := k8s.DryRunManifestsWithConfig(ctx, clusterConfig, []byte(strings.Join(serialized, "\n---\n"))); err != nil {
+		return nil, fmt.Errorf("manifest validation failed, nothing was applied: %w", err)
+	}
+
+	var resources []k8s.ResourceRef
+	for _, manifest := range manifests {
+		// Apply manifest to cluster
+		fmt.Printf("\n=== Applying Manifest: %s ===\n", manifest.Path)
+		applied, err := k8s.ApplyManifestsWithConfig(ctx, clusterConfig, []byte(manifest.Serialized))
+		resources = append(resources, applied...)
+		for _, resource := range applied {
+			s.deploymentsService.PublishProgress(deploymentID, deployments.ProgressEvent{
+				Type:     deployments.ProgressResourceApplied,
+				Resource: &resource,
+			})
+		}
+		if err != nil {
+			return resources, fmt.Errorf("failed to apply manifest %s: %w", manifest.Path, err)
+		}
+		fmt.Printf("✓ Successfully applied manifest: %s\n", manifest.Path)
+	}
+
+	return resources, nil
+}
+
+// renderManifest parses a manifest file from the cloned repo, applies its overrides
+// and serializes it back to multi-document YAML. A non-empty namespace overrides the
+// namespace of every namespaced resource, labels and annotations are added to every
+// resource, and a non-nil pin pins the images referring to its tag to the pushed digest.
+func renderManifest(repoDir string, manifest nimbulconfig.ManifestConfig, namespace string, labels, annotations map[string]string, pin *registry.Push) (renderedManifest, error) {
+	rendered := renderedManifest{Path: manifest.Path}
+
+	// Get full path to manifest file in cloned repo, refusing symlinks out of it
+	manifestPath, err := nimbulconfig.RepoPath(repoDir, manifest.Path)
+	if err != nil {
+		return rendered, fmt.Errorf("manifest %s: %w", manifest.Path, err)
+	}
+
+	// Parse manifest file
+	docs, err := nimbulconfig.ParseManifestFile(manifestPath)
+	if err != nil {
+		return rendered, fmt.Errorf("failed to parse manifest file %s: %w", manifest.Path, err)
+	}
+
+	// Apply overrides
+	if err := nimbulconfig.ApplyOverrides(docs, manifest.Overrides); err != nil {
+		return rendered, fmt.Errorf("failed to apply overrides to manifest %s: %w", manifest.Path, err)
+	}
+
+	if namespace != "" {
+		nimbulconfig.SetNamespace(docs, namespace)
+	}
+
+	nimbulconfig.SetMetadata(docs, labels, annotations)
+
+	if pin != nil {
+		rendered.Pinned = nimbulconfig.ReplaceImages(docs, pin.Pin)
+	}
+	rendered.Docs = docs
+
+	// Serialize manifest
+	rendered.Serialized, err = nimbulconfig.SerializeManifests(docs)
+	if err != nil {
+		return rendered, fmt.Errorf("failed to serialize manifest %s: %w", manifest.Path, err)
+	}
+
+	return rendered, nil
+}
+
+// queueAgentDeployment renders every manifest of the deploy stage and queues them
+// as a single deployment for the config's agent to apply
+func (s *Service) queueAgentDeployment(ctx context.Context, config *configs.Config, renderedConfig *nimbulconfig.NimbulConfig, repoDir string, run deployRun) error {
+	rendered, err := renderDeployStage(renderedConfig, repoDir, "", run)
+	if err != nil {
+		return err
+	}
+
+	if err := s.pinImageDigests(ctx, config, renderedConfig, rendered, run); err != nil {
+		return err
+	}
+
+	// Agent deployments are recorded once queued, so warnings are only logged
+	if err := s.admitManifests(ctx, config, rendered, 0); err != nil {
+		return err
+	}
+
+	var manifests []string
+	for _, manifest := range rendered {
+		if manifest.Serialized != "" {
+			manifests = append(manifests, manifest.Serialized)
+		}
+	}
+
+	if len(manifests) == 0 {
+		return nil
+	}
+
+	deployment, err := s.agentsService.EnqueueDeployment(ctx, *config.AgentID, config.ID, strings.Join(manifests, "\n---\n"))
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ Queued deployment %d for agent %s\n", deployment.ID, *config.AgentID)
+
+	// The agent reports the outcome, which publishes deploy.succeeded or deploy.failed
+	deployEvent := newEvent(config, run.Ref, run.CommitSHA)
+	if run.Pin != nil {
+		deployEvent.Images = []string{run.Pin.Reference()}
+	}
+	deployEvent.Type = hooks.EventDeployStarted
+	s.Publish(ctx, config, deployEvent)
+
+	return nil
+}
+
+// pushedImage is an image pushed by a build
+type pushedImage struct {
+	Ref    string
+	Digest string
+}
+
+// buildWithTimeout builds the images of a build config, giving up after timeout when it is non-zero
+func buildWithTimeout(ctx context.Context, builder buildkit.ImageBuilder, repoDir string, remote *remoteContext, build nimbulconfig.BuildConfig, login *registry.Login, observer buildObserver, source, commitSHA string, timeout time.Duration) ([]pushedImage, error) {
+	if timeout == 0 {
+		return buildImages(ctx, builder, repoDir, remote, build, login, observer, source, commitSHA)
+	}
+
+	buildCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	images, err := buildImages(buildCtx, builder, repoDir, remote, build, login, observer, source, commitSHA)
+	if err != nil && errors.Is(buildCtx.Err(), context.DeadlineExceeded) {
+		return nil, fmt.Errorf("build %s exceeded the limit of %s: %w", build.Name, timeout, err)
+	}
+	return images, err
+}
+
+// buildImages builds the image of a build config once and pushes it under each of its
+// tags, logging in with login when it is set. source and commitSHA are recorded in the
+// provenance attestations of the images.
+func buildImages(ctx context.Context, builder buildkit.ImageBuilder, repoDir string, remote *remoteContext, build nimbulconfig.BuildConfig, login *registry.Login, observer buildObserver, source, commitSHA string) ([]pushedImage, error) {
+	buildReq, err := newBuildRequest(repoDir, remote, build)
+	if err != nil {
+		return nil, err
+	}
+	buildReq.Login = login
+	buildReq.OnLog = observer.OnLog
+	buildReq.OnTiming = observer.OnTiming
+	buildReq.VCSSource = source
+	buildReq.VCSRevision = commitSHA
+	buildReq.Push = true
+
+	for _, tag := range build.Tags {
+		// Parse image:tag format
+		imageName, tagValue := parseImageTag(tag)
+		buildReq.ImageRefs = append(buildReq.ImageRefs, fmt.Sprintf("%s:%s", imageName, tagValue))
+	}
+	if len(buildReq.ImageRefs) == 0 {
+		return nil, nil
+	}
+
+	digest, err := builder.BuildAndPush(ctx, buildReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build Docker image %s: %w", strings.Join(buildReq.ImageRefs, ", "), err)
+	}
+
+	// Every tag names the same image
+	images := make([]pushedImage, len(buildReq.ImageRefs))
+	for i, imageRef := range buildReq.ImageRefs {
+		fmt.Printf("Successfully built Docker image: %s\n", imageRef)
+		images[i] = pushedImage{Ref: imageRef, Digest: digest}
+	}
+
+	return images, nil
+}
+
+// recordImages records the images a build pushed, for registry retention rules
+func (s *Service) recordImages(ctx context.Context, buildID int64, images []pushedImage) {
+	for _, image := range images {
+		if image.Digest == "" {
+			continue
+		}
+		if err := s.buildsService.AddImage(ctx, buildID, image.Ref, image.Digest); err != nil {
+			fmt.Printf("Warning: Failed to record image %s: %v\n", image.Ref, err)
+		}
+	}
+}
+
+// storeProvenance keeps the provenance attestations BuildKit pushed with the images of a build.
+// The images are already pushed, so failures are logged rather than failing the build.
+func (s *Service) storeProvenance(ctx context.Context, builder buildkit.ImageBuilder, buildID int64, images []pushedImage, login *registry.Login) {
+	for _, image := range images {
+		if image.Digest == "" {
+			continue
+		}
+
+		provenance, err := builder.FetchProvenance(ctx, image.Ref, image.Digest, login)
+		if errors.Is(err, buildkit.ErrNoProvenance) {
+			continue
+		}
+		if err != nil {
+			fmt.Printf("Warning: Failed to fetch provenance of %s: %v\n", image.Ref, err)
+			continue
+		}
+
+		err = s.buildsService.AddProvenance(ctx, builds.AddProvenanceParams{
+			BuildID:       buildID,
+			Image:         image.Ref,
+			Digest:        image.Digest,
+			PredicateType: provenance.PredicateType,
+			Statement:     provenance.Statement,
+		})
+		if err != nil {
+			fmt.Printf("Warning: Failed to store provenance of %s: %v\n", image.Ref, err)
+		}
+	}
+}
+
+// buildObserver receives the output of a build as it runs
+type buildObserver struct {
+	OnLog    func(buildkit.LogEntry)
+	OnTiming func(buildkit.Timing)
+}
+
+// newBuildRequest resolves the context directory and Dockerfile of a build config within
+// repoDir, or within the repository of remote when it is set
+func newBuildRequest(repoDir string, remote *remoteContext, build nimbulconfig.BuildConfig) (buildkit.BuildRequest, error) {
+	// Get full paths relative to cloned repo
+	buildContext := filepath.Join(repoDir, build.Context)
+	dockerfileFullPath := filepath.Join(repoDir, build.Dockerfile)
+	if remote == nil {
+		// Neither may follow a symlink out of the clone, or files of the server would
+		// be sent to the builder
+		var err error
+		if buildContext, err = nimbulconfig.RepoPath(repoDir, cmp.Or(build.Context, ".")); err != nil {
+			return buildkit.BuildRequest{}, fmt.Errorf("build context of %s: %w", build.Name, err)
+		}
+		if dockerfileFullPath, err = nimbulconfig.RepoPath(repoDir, build.Dockerfile); err != nil {
+			return buildkit.BuildRequest{}, fmt.Errorf("dockerfile of %s: %w", build.Name, err)
+		}
+	}
+
+	// Calculate Dockerfile path relative to context
+	// Both build.Context and build.Dockerfile are relative to repo root
+	dockerfileRelPath, err := filepath.Rel(buildContext, dockerfileFullPath)
+	if err != nil {
+		return buildkit.BuildRequest{}, fmt.Errorf("failed to calculate Dockerfile path relative to context: %w", err)
+	}
+
+	buildReq := buildkit.BuildRequest{
+		ContextDir: buildContext,
+		Dockerfile: dockerfileRelPath,
+		BuildArgs:  build.Args,
+	}
+	if remote != nil {
+		buildReq.ContextDir = ""
+		buildReq.GitContext = remote.contextURL(build.Context)
+		buildReq.GitToken = remote.Token
+	}
+	return buildReq, nil
+}
+
+// buildConfigHash returns the hex-encoded sha256 of a rendered build. Tags, args and
+// environment variables are rendered into it, so a change to any of them builds the
+// commit again.
+func buildConfigHash(build nimbulconfig.BuildConfig) string {
+	data, err := json.Marshal(build)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// cloneInSandbox clones ref of a config's repository into a new directory of the
+// sandbox, with git running in it and stopped when the clone outgrows its disk limit
+func cloneInSandbox(ctx context.Context, sb *sandbox.Sandbox, installationID int64, config *configs.Config, ref string) (string, error) {
+	repoDir, err := sb.MkdirTemp("repo-*")
+	if err != nil {
+		return "", fmt.Errorf("failed to create clone directory: %w", err)
+	}
+
+	cloneCtx, stop := sb.LimitDisk(ctx)
+	defer stop()
+	if err := github.CloneRepository(cloneCtx, installationID, config.RepoOwner, config.RepoName, ref, repoDir, sb.Command); err != nil {
+		if cause := context.Cause(cloneCtx); errors.Is(cause, sandbox.ErrDiskQuota) {
+			err = cause
+		}
+		return "", fmt.Errorf("failed to clone repository: %w", err)
+	}
+	return repoDir, nil
+}
+
+// storeArtifacts exports the artifacts stage of a build config and uploads the declared
+// paths, up to maxBytes in total (0 for no limit)
+func (s *Service) storeArtifacts(ctx context.Context, builder buildkit.ImageBuilder, sb *sandbox.Sandbox, repoDir string, remote *remoteContext, build nimbulconfig.BuildConfig, login *registry.Login, observer buildObserver, buildID int64, maxBytes int64) error {
+	buildReq, err := newBuildRequest(repoDir, remote, build)
+	if err != nil {
+		return err
+	}
+	buildReq.Login = login
+	buildReq.OnLog = observer.OnLog
+
+	outputDir, err := sb.MkdirTemp("artifacts-*")
+	if err != nil {
+		return fmt.Errorf("failed to create artifacts directory: %w", err)
+	}
+	defer os.RemoveAll(outputDir)
+
+	exportCtx, stop := sb.LimitDisk(ctx)
+	defer stop()
+	if err := builder.ExportStage(exportCtx, buildReq, build.Artifacts.Stage, outputDir); err != nil {
+		if cause := context.Cause(exportCtx); errors.Is(cause, sandbox.ErrDiskQuota) {
+			err = cause
+		}
+		return fmt.Errorf("failed to export artifacts stage %s: %w", build.Artifacts.Stage, err)
+	}
+
+	if err := s.artifactsService.Upload(ctx, buildID, outputDir, build.Artifacts.Paths, maxBytes); err != nil {
+		return err
+	}
+	fmt.Printf("Stored %d artifact(s) of build %s\n", len(build.Artifacts.Paths), build.Name)
+
+	return nil
+}
+
+// Publish announces a pipeline event to the config owner's hooks and emails them
+// about failures
+func (s *Service) Publish(ctx context.Context, config *configs.Config, event hooks.Event) {
+	s.hooksService.Publish(ctx, config.OwnerID, event)
+	s.notificationsService.NotifyPipelineFailure(ctx, config.OwnerID, event)
+
+	// Deploy events are also streamed to clients following the deployment
+	if event.DeploymentID != 0 {
+		s.deploymentsService.PublishProgress(event.DeploymentID, deployments.ProgressEvent{
+			Type:  event.Type,
+			Error: event.Error,
+		})
+	}
+}
+
+// newEvent starts a hook event for a pipeline run of config
+func newEvent(config *configs.Config, ref, commitSHA string) hooks.Event {
+	return hooks.Event{
+		ConfigID:   config.ID,
+		Repository: config.RepoFullName,
+		Ref:        ref,
+		CommitSHA:  commitSHA,
+	}
+}
+
+// deletePreview removes the preview namespace of a deleted branch, if it has one
+func (s *Service) deletePreview(ctx context.Context, config *configs.Config, branch string) error {
+	if branch == "" || config.AgentID != nil || config.ClusterCredentialID == nil {
+		return nil
+	}
+
+	clusterConfig, err := s.ClusterConfig(ctx, config)
+	if err != nil {
+		return fmt.Errorf("failed to resolve cluster configuration: %w", err)
+	}
+
+	namespace := nimbulconfig.PreviewNamespace(config.ID, branch)
+	if err := k8s.DeletePreviewNamespace(ctx, clusterConfig, namespace, config.ID); err != nil {
+		return err
+	}
+	if err := s.deploymentsService.DeleteEnvironment(ctx, config.ID, namespace); err != nil {
+		fmt.Printf("Warning: Failed to forget preview environment %s: %v\n", namespace, err)
+	}
+
+	fmt.Printf("✓ Branch %s deleted, removed preview namespace %s\n", branch, namespace)
+	return nil
+}
+
 // normalizeRefForTag normalizes a git ref for use as a Docker tag
 // Removes refs/heads/ and refs/tags/ prefixes, and uses commit SHA if ref is empty
 func normalizeRefForTag(ref, commitSHA string) string {
@@ -214,6 +1026,44 @@ func isHexString(s string) bool {
 	return true
 }
 
+// templateContext creates the template context of a commit of a config, with the
+// config's environment variables
+func (s *Service) templateContext(ctx context.Context, config *configs.Config, commitSHA, branch string) (*nimbulconfig.TemplateContext, error) {
+	env, err := s.configsService.EnvMap(ctx, config.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	templateCtx := nimbulconfig.NewTemplateContext(commitSHA, branch, config.RepoFullName)
+	templateCtx.ENV = env
+	return templateCtx, nil
+}
+
+// pullRequestNumber returns the number of the open pull request of a branch, or ""
+// when there is none or GitHub cannot tell
+func (s *Service) pullRequestNumber(ctx context.Context, installationID int64, config *configs.Config, branch string) string {
+	appAuth, err := github.NewAppAuth(ctx, installationID)
+	if err != nil {
+		fmt.Printf("Warning: Failed to create app auth: %v\n", err)
+		return ""
+	}
+	client, err := appAuth.GetInstallationClient(ctx)
+	if err != nil {
+		fmt.Printf("Warning: Failed to get installation client: %v\n", err)
+		return ""
+	}
+
+	number, err := github.FindPullRequestNumber(ctx, client, config.RepoOwner, config.RepoName, branch)
+	if err != nil {
+		fmt.Printf("Warning: Failed to find the pull request of %s: %v\n", branch, err)
+		return ""
+	}
+	if number == 0 {
+		return ""
+	}
+	return strconv.Itoa(number)
+}
+
 // extractBranch extracts the branch name from a git ref
 // Examples:
 //   - "refs/heads/main" -> "main"
@@ -0,0 +1,44 @@
+package webhooks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/coding-cave-dev/nimbul/internal/configs"
+	ghub "github.com/google/go-github/v81/github"
+)
+
+// skipMarkers in the message of a push's head commit keep the push from triggering a build
+var skipMarkers = []string{"[skip ci]", "[ci skip]", "[nimbul skip]", "[skip nimbul]"}
+
+// skipReason returns why a push should not trigger a build, or "" when it should: its
+// head commit asks to be skipped, or was authored or pushed by a denied author of the
+// config. Bots committing back to the repository would otherwise rebuild forever.
+func skipReason(config *configs.Config, pushEvent *ghub.PushEvent) string {
+	headCommit := pushEvent.GetHeadCommit()
+
+	message := strings.ToLower(headCommit.GetMessage())
+	for _, marker := range skipMarkers {
+		if strings.Contains(message, marker) {
+			return fmt.Sprintf("commit message contains %s", marker)
+		}
+	}
+
+	author := headCommit.GetAuthor()
+	candidates := []string{
+		author.GetLogin(),
+		author.GetName(),
+		author.GetEmail(),
+		pushEvent.GetPusher().GetName(),
+		pushEvent.GetSender().GetLogin(),
+	}
+	for _, denied := range config.DeniedAuthors {
+		for _, candidate := range candidates {
+			if candidate != "" && strings.EqualFold(candidate, denied) {
+				return fmt.Sprintf("%s is a denied author", candidate)
+			}
+		}
+	}
+
+	return ""
+}
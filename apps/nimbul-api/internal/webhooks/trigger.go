@@ -0,0 +1,80 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/coding-cave-dev/nimbul/internal/configs"
+	"github.com/coding-cave-dev/nimbul/internal/github"
+	ghub "github.com/google/go-github/v81/github"
+)
+
+var (
+	ErrBranchNotFound = errors.New("branch not found")
+	ErrBranchMoved    = errors.New("the branch has moved on from the commit")
+)
+
+// TriggerPushEvent returns the push event of the head of branch as if actor had just
+// pushed it, and the commit the branch is at, for deploys triggered through the API,
+// e.g. with a deploy token. The event runs the pipeline of a push, with the same skip
+// markers and denied authors. When commitSHA is set it has to be the head of the
+// branch, since the pipeline builds the head and a CI system asking for the commit it
+// tested should not get another one.
+func (s *Service) TriggerPushEvent(ctx context.Context, config *configs.Config, branch, commitSHA, actor string) ([]byte, string, error) {
+	branch = strings.TrimPrefix(branch, "refs/heads/")
+
+	installationID, err := s.installationID(ctx, config)
+	if err != nil {
+		return nil, "", err
+	}
+	appAuth, err := github.NewAppAuth(ctx, installationID)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create app auth: %w", err)
+	}
+	client, err := appAuth.GetInstallationClient(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get installation client: %w", err)
+	}
+
+	head, _, err := client.Repositories.GetBranch(ctx, config.RepoOwner, config.RepoName, branch, 1)
+	if err != nil {
+		if github.IsNotFound(err) {
+			return nil, "", fmt.Errorf("%w: %s", ErrBranchNotFound, branch)
+		}
+		return nil, "", fmt.Errorf("failed to get branch %s: %w", branch, err)
+	}
+
+	headSHA := head.GetCommit().GetSHA()
+	if commitSHA != "" && !strings.EqualFold(commitSHA, headSHA) {
+		return nil, "", fmt.Errorf("%w: %s is at %s, not %s", ErrBranchMoved, branch, headSHA, commitSHA)
+	}
+
+	commit := head.GetCommit().GetCommit()
+	event := &ghub.PushEvent{
+		Ref: ghub.Ptr("refs/heads/" + branch),
+		Repo: &ghub.PushEventRepository{
+			FullName: ghub.Ptr(config.RepoFullName),
+		},
+		HeadCommit: &ghub.HeadCommit{
+			ID:      ghub.Ptr(headSHA),
+			Message: ghub.Ptr(commit.GetMessage()),
+			Author: &ghub.CommitAuthor{
+				Name:  ghub.Ptr(commit.GetAuthor().GetName()),
+				Email: ghub.Ptr(commit.GetAuthor().GetEmail()),
+				Login: ghub.Ptr(head.GetCommit().GetAuthor().GetLogin()),
+			},
+		},
+		Pusher: &ghub.CommitAuthor{
+			Name: ghub.Ptr(actor),
+		},
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to encode push event: %w", err)
+	}
+	return payload, headSHA, nil
+}
@@ -0,0 +1,623 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v5.29.3
+// source: internal/workerpb/worker.proto
+
+package workerpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Job is a queued pipeline job leased to a worker
+type Job struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // github_push or image_push
+	ConfigId      string                 `protobuf:"bytes,3,opt,name=config_id,json=configId,proto3" json:"config_id,omitempty"`
+	Payload       []byte                 `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	Priority      int32                  `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`
+	Attempt       int32                  `protobuf:"varint,6,opt,name=attempt,proto3" json:"attempt,omitempty"` // 1 for the first attempt
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Job) Reset() {
+	*x = Job{}
+	mi := &file_internal_workerpb_worker_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Job) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Job) ProtoMessage() {}
+
+func (x *Job) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_workerpb_worker_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Job.ProtoReflect.Descriptor instead.
+func (*Job) Descriptor() ([]byte, []int) {
+	return file_internal_workerpb_worker_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Job) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Job) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Job) GetConfigId() string {
+	if x != nil {
+		return x.ConfigId
+	}
+	return ""
+}
+
+func (x *Job) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *Job) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Job) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+type ClaimJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkerId      string                 `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	WaitSeconds   int32                  `protobuf:"varint,2,opt,name=wait_seconds,json=waitSeconds,proto3" json:"wait_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimJobRequest) Reset() {
+	*x = ClaimJobRequest{}
+	mi := &file_internal_workerpb_worker_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimJobRequest) ProtoMessage() {}
+
+func (x *ClaimJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_workerpb_worker_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimJobRequest.ProtoReflect.Descriptor instead.
+func (*ClaimJobRequest) Descriptor() ([]byte, []int) {
+	return file_internal_workerpb_worker_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ClaimJobRequest) GetWorkerId() string {
+	if x != nil {
+		return x.WorkerId
+	}
+	return ""
+}
+
+func (x *ClaimJobRequest) GetWaitSeconds() int32 {
+	if x != nil {
+		return x.WaitSeconds
+	}
+	return 0
+}
+
+type ClaimJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *Job                   `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimJobResponse) Reset() {
+	*x = ClaimJobResponse{}
+	mi := &file_internal_workerpb_worker_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimJobResponse) ProtoMessage() {}
+
+func (x *ClaimJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_workerpb_worker_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimJobResponse.ProtoReflect.Descriptor instead.
+func (*ClaimJobResponse) Descriptor() ([]byte, []int) {
+	return file_internal_workerpb_worker_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ClaimJobResponse) GetJob() *Job {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type HeartbeatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkerId      string                 `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	JobIds        []int64                `protobuf:"varint,2,rep,packed,name=job_ids,json=jobIds,proto3" json:"job_ids,omitempty"` // jobs the worker runs
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HeartbeatRequest) Reset() {
+	*x = HeartbeatRequest{}
+	mi := &file_internal_workerpb_worker_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeartbeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatRequest) ProtoMessage() {}
+
+func (x *HeartbeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_workerpb_worker_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatRequest.ProtoReflect.Descriptor instead.
+func (*HeartbeatRequest) Descriptor() ([]byte, []int) {
+	return file_internal_workerpb_worker_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *HeartbeatRequest) GetWorkerId() string {
+	if x != nil {
+		return x.WorkerId
+	}
+	return ""
+}
+
+func (x *HeartbeatRequest) GetJobIds() []int64 {
+	if x != nil {
+		return x.JobIds
+	}
+	return nil
+}
+
+type HeartbeatResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CancelJobIds  []int64                `protobuf:"varint,1,rep,packed,name=cancel_job_ids,json=cancelJobIds,proto3" json:"cancel_job_ids,omitempty"` // jobs removed from the queue, e.g. with their config
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HeartbeatResponse) Reset() {
+	*x = HeartbeatResponse{}
+	mi := &file_internal_workerpb_worker_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeartbeatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatResponse) ProtoMessage() {}
+
+func (x *HeartbeatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_workerpb_worker_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatResponse.ProtoReflect.Descriptor instead.
+func (*HeartbeatResponse) Descriptor() ([]byte, []int) {
+	return file_internal_workerpb_worker_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *HeartbeatResponse) GetCancelJobIds() []int64 {
+	if x != nil {
+		return x.CancelJobIds
+	}
+	return nil
+}
+
+// LogEntry is a line of the log of a running build
+type LogEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BuildId       int64                  `protobuf:"varint,1,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+	TimeUnixNano  int64                  `protobuf:"varint,2,opt,name=time_unix_nano,json=timeUnixNano,proto3" json:"time_unix_nano,omitempty"`
+	Vertex        string                 `protobuf:"bytes,3,opt,name=vertex,proto3" json:"vertex,omitempty"`
+	Stream        string                 `protobuf:"bytes,4,opt,name=stream,proto3" json:"stream,omitempty"`
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	End           bool                   `protobuf:"varint,6,opt,name=end,proto3" json:"end,omitempty"` // the build's log was stored, no more entries follow
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogEntry) Reset() {
+	*x = LogEntry{}
+	mi := &file_internal_workerpb_worker_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogEntry) ProtoMessage() {}
+
+func (x *LogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_workerpb_worker_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogEntry.ProtoReflect.Descriptor instead.
+func (*LogEntry) Descriptor() ([]byte, []int) {
+	return file_internal_workerpb_worker_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LogEntry) GetBuildId() int64 {
+	if x != nil {
+		return x.BuildId
+	}
+	return 0
+}
+
+func (x *LogEntry) GetTimeUnixNano() int64 {
+	if x != nil {
+		return x.TimeUnixNano
+	}
+	return 0
+}
+
+func (x *LogEntry) GetVertex() string {
+	if x != nil {
+		return x.Vertex
+	}
+	return ""
+}
+
+func (x *LogEntry) GetStream() string {
+	if x != nil {
+		return x.Stream
+	}
+	return ""
+}
+
+func (x *LogEntry) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *LogEntry) GetEnd() bool {
+	if x != nil {
+		return x.End
+	}
+	return false
+}
+
+type StreamLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamLogsResponse) Reset() {
+	*x = StreamLogsResponse{}
+	mi := &file_internal_workerpb_worker_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamLogsResponse) ProtoMessage() {}
+
+func (x *StreamLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_workerpb_worker_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamLogsResponse.ProtoReflect.Descriptor instead.
+func (*StreamLogsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_workerpb_worker_proto_rawDescGZIP(), []int{6}
+}
+
+type FinishJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         int64                  `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`          // empty when the job succeeded
+	Permanent     bool                   `protobuf:"varint,3,opt,name=permanent,proto3" json:"permanent,omitempty"` // retrying cannot fix the error
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinishJobRequest) Reset() {
+	*x = FinishJobRequest{}
+	mi := &file_internal_workerpb_worker_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishJobRequest) ProtoMessage() {}
+
+func (x *FinishJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_workerpb_worker_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishJobRequest.ProtoReflect.Descriptor instead.
+func (*FinishJobRequest) Descriptor() ([]byte, []int) {
+	return file_internal_workerpb_worker_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *FinishJobRequest) GetJobId() int64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *FinishJobRequest) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *FinishJobRequest) GetPermanent() bool {
+	if x != nil {
+		return x.Permanent
+	}
+	return false
+}
+
+type FinishJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinishJobResponse) Reset() {
+	*x = FinishJobResponse{}
+	mi := &file_internal_workerpb_worker_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishJobResponse) ProtoMessage() {}
+
+func (x *FinishJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_workerpb_worker_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishJobResponse.ProtoReflect.Descriptor instead.
+func (*FinishJobResponse) Descriptor() ([]byte, []int) {
+	return file_internal_workerpb_worker_proto_rawDescGZIP(), []int{8}
+}
+
+var File_internal_workerpb_worker_proto protoreflect.FileDescriptor
+
+const file_internal_workerpb_worker_proto_rawDesc = "" +
+	"\n" +
+	"\x1einternal/workerpb/worker.proto\x12\x10nimbul.worker.v1\"\x96\x01\n" +
+	"\x03Job\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x1b\n" +
+	"\tconfig_id\x18\x03 \x01(\tR\bconfigId\x12\x18\n" +
+	"\apayload\x18\x04 \x01(\fR\apayload\x12\x1a\n" +
+	"\bpriority\x18\x05 \x01(\x05R\bpriority\x12\x18\n" +
+	"\aattempt\x18\x06 \x01(\x05R\aattempt\"Q\n" +
+	"\x0fClaimJobRequest\x12\x1b\n" +
+	"\tworker_id\x18\x01 \x01(\tR\bworkerId\x12!\n" +
+	"\fwait_seconds\x18\x02 \x01(\x05R\vwaitSeconds\";\n" +
+	"\x10ClaimJobResponse\x12'\n" +
+	"\x03job\x18\x01 \x01(\v2\x15.nimbul.worker.v1.JobR\x03job\"H\n" +
+	"\x10HeartbeatRequest\x12\x1b\n" +
+	"\tworker_id\x18\x01 \x01(\tR\bworkerId\x12\x17\n" +
+	"\ajob_ids\x18\x02 \x03(\x03R\x06jobIds\"9\n" +
+	"\x11HeartbeatResponse\x12$\n" +
+	"\x0ecancel_job_ids\x18\x01 \x03(\x03R\fcancelJobIds\"\xa7\x01\n" +
+	"\bLogEntry\x12\x19\n" +
+	"\bbuild_id\x18\x01 \x01(\x03R\abuildId\x12$\n" +
+	"\x0etime_unix_nano\x18\x02 \x01(\x03R\ftimeUnixNano\x12\x16\n" +
+	"\x06vertex\x18\x03 \x01(\tR\x06vertex\x12\x16\n" +
+	"\x06stream\x18\x04 \x01(\tR\x06stream\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12\x10\n" +
+	"\x03end\x18\x06 \x01(\bR\x03end\"\x14\n" +
+	"\x12StreamLogsResponse\"]\n" +
+	"\x10FinishJobRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\x03R\x05jobId\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12\x1c\n" +
+	"\tpermanent\x18\x03 \x01(\bR\tpermanent\"\x13\n" +
+	"\x11FinishJobResponse2\xe0\x02\n" +
+	"\rWorkerControl\x12Q\n" +
+	"\bClaimJob\x12!.nimbul.worker.v1.ClaimJobRequest\x1a\".nimbul.worker.v1.ClaimJobResponse\x12T\n" +
+	"\tHeartbeat\x12\".nimbul.worker.v1.HeartbeatRequest\x1a#.nimbul.worker.v1.HeartbeatResponse\x12P\n" +
+	"\n" +
+	"StreamLogs\x12\x1a.nimbul.worker.v1.LogEntry\x1a$.nimbul.worker.v1.StreamLogsResponse(\x01\x12T\n" +
+	"\tFinishJob\x12\".nimbul.worker.v1.FinishJobRequest\x1a#.nimbul.worker.v1.FinishJobResponseB5Z3github.com/coding-cave-dev/nimbul/internal/workerpbb\x06proto3"
+
+var (
+	file_internal_workerpb_worker_proto_rawDescOnce sync.Once
+	file_internal_workerpb_worker_proto_rawDescData []byte
+)
+
+func file_internal_workerpb_worker_proto_rawDescGZIP() []byte {
+	file_internal_workerpb_worker_proto_rawDescOnce.Do(func() {
+		file_internal_workerpb_worker_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_internal_workerpb_worker_proto_rawDesc), len(file_internal_workerpb_worker_proto_rawDesc)))
+	})
+	return file_internal_workerpb_worker_proto_rawDescData
+}
+
+var file_internal_workerpb_worker_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_internal_workerpb_worker_proto_goTypes = []any{
+	(*Job)(nil),                // 0: nimbul.worker.v1.Job
+	(*ClaimJobRequest)(nil),    // 1: nimbul.worker.v1.ClaimJobRequest
+	(*ClaimJobResponse)(nil),   // 2: nimbul.worker.v1.ClaimJobResponse
+	(*HeartbeatRequest)(nil),   // 3: nimbul.worker.v1.HeartbeatRequest
+	(*HeartbeatResponse)(nil),  // 4: nimbul.worker.v1.HeartbeatResponse
+	(*LogEntry)(nil),           // 5: nimbul.worker.v1.LogEntry
+	(*StreamLogsResponse)(nil), // 6: nimbul.worker.v1.StreamLogsResponse
+	(*FinishJobRequest)(nil),   // 7: nimbul.worker.v1.FinishJobRequest
+	(*FinishJobResponse)(nil),  // 8: nimbul.worker.v1.FinishJobResponse
+}
+var file_internal_workerpb_worker_proto_depIdxs = []int32{
+	0, // 0: nimbul.worker.v1.ClaimJobResponse.job:type_name -> nimbul.worker.v1.Job
+	1, // 1: nimbul.worker.v1.WorkerControl.ClaimJob:input_type -> nimbul.worker.v1.ClaimJobRequest
+	3, // 2: nimbul.worker.v1.WorkerControl.Heartbeat:input_type -> nimbul.worker.v1.HeartbeatRequest
+	5, // 3: nimbul.worker.v1.WorkerControl.StreamLogs:input_type -> nimbul.worker.v1.LogEntry
+	7, // 4: nimbul.worker.v1.WorkerControl.FinishJob:input_type -> nimbul.worker.v1.FinishJobRequest
+	2, // 5: nimbul.worker.v1.WorkerControl.ClaimJob:output_type -> nimbul.worker.v1.ClaimJobResponse
+	4, // 6: nimbul.worker.v1.WorkerControl.Heartbeat:output_type -> nimbul.worker.v1.HeartbeatResponse
+	6, // 7: nimbul.worker.v1.WorkerControl.StreamLogs:output_type -> nimbul.worker.v1.StreamLogsResponse
+	8, // 8: nimbul.worker.v1.WorkerControl.FinishJob:output_type -> nimbul.worker.v1.FinishJobResponse
+	5, // [5:9] is the sub-list for method output_type
+	1, // [1:5] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_internal_workerpb_worker_proto_init() }
+func file_internal_workerpb_worker_proto_init() {
+	if File_internal_workerpb_worker_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_workerpb_worker_proto_rawDesc), len(file_internal_workerpb_worker_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_internal_workerpb_worker_proto_goTypes,
+		DependencyIndexes: file_internal_workerpb_worker_proto_depIdxs,
+		MessageInfos:      file_internal_workerpb_worker_proto_msgTypes,
+	}.Build()
+	File_internal_workerpb_worker_proto = out.File
+	file_internal_workerpb_worker_proto_goTypes = nil
+	file_internal_workerpb_worker_proto_depIdxs = nil
+}
@@ -0,0 +1,251 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: internal/workerpb/worker.proto
+
+package workerpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	WorkerControl_ClaimJob_FullMethodName   = "/nimbul.worker.v1.WorkerControl/ClaimJob"
+	WorkerControl_Heartbeat_FullMethodName  = "/nimbul.worker.v1.WorkerControl/Heartbeat"
+	WorkerControl_StreamLogs_FullMethodName = "/nimbul.worker.v1.WorkerControl/StreamLogs"
+	WorkerControl_FinishJob_FullMethodName  = "/nimbul.worker.v1.WorkerControl/FinishJob"
+)
+
+// WorkerControlClient is the client API for WorkerControl service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// WorkerControl is served by the API server to nimbul-worker processes when
+// NIMBUL_JOB_RUNNER is "worker". Workers authenticate with the bearer token
+// NIMBUL_WORKER_TOKEN in the authorization metadata.
+type WorkerControlClient interface {
+	// ClaimJob waits up to wait_seconds for the queued job of the highest priority
+	// and leases it to the worker. The response has no job when none came up.
+	ClaimJob(ctx context.Context, in *ClaimJobRequest, opts ...grpc.CallOption) (*ClaimJobResponse, error)
+	// Heartbeat renews the leases of the jobs a worker runs and names those to cancel
+	Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error)
+	// StreamLogs relays the log entries of the builds a worker runs to the API server,
+	// which streams them to its log subscribers
+	StreamLogs(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[LogEntry, StreamLogsResponse], error)
+	// FinishJob reports the outcome of an attempt at a job; the API server retries,
+	// dead-letters or removes the job
+	FinishJob(ctx context.Context, in *FinishJobRequest, opts ...grpc.CallOption) (*FinishJobResponse, error)
+}
+
+type workerControlClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWorkerControlClient(cc grpc.ClientConnInterface) WorkerControlClient {
+	return &workerControlClient{cc}
+}
+
+func (c *workerControlClient) ClaimJob(ctx context.Context, in *ClaimJobRequest, opts ...grpc.CallOption) (*ClaimJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaimJobResponse)
+	err := c.cc.Invoke(ctx, WorkerControl_ClaimJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerControlClient) Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HeartbeatResponse)
+	err := c.cc.Invoke(ctx, WorkerControl_Heartbeat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerControlClient) StreamLogs(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[LogEntry, StreamLogsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &WorkerControl_ServiceDesc.Streams[0], WorkerControl_StreamLogs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[LogEntry, StreamLogsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WorkerControl_StreamLogsClient = grpc.ClientStreamingClient[LogEntry, StreamLogsResponse]
+
+func (c *workerControlClient) FinishJob(ctx context.Context, in *FinishJobRequest, opts ...grpc.CallOption) (*FinishJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FinishJobResponse)
+	err := c.cc.Invoke(ctx, WorkerControl_FinishJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WorkerControlServer is the server API for WorkerControl service.
+// All implementations must embed UnimplementedWorkerControlServer
+// for forward compatibility.
+//
+// WorkerControl is served by the API server to nimbul-worker processes when
+// NIMBUL_JOB_RUNNER is "worker". Workers authenticate with the bearer token
+// NIMBUL_WORKER_TOKEN in the authorization metadata.
+type WorkerControlServer interface {
+	// ClaimJob waits up to wait_seconds for the queued job of the highest priority
+	// and leases it to the worker. The response has no job when none came up.
+	ClaimJob(context.Context, *ClaimJobRequest) (*ClaimJobResponse, error)
+	// Heartbeat renews the leases of the jobs a worker runs and names those to cancel
+	Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error)
+	// StreamLogs relays the log entries of the builds a worker runs to the API server,
+	// which streams them to its log subscribers
+	StreamLogs(grpc.ClientStreamingServer[LogEntry, StreamLogsResponse]) error
+	// FinishJob reports the outcome of an attempt at a job; the API server retries,
+	// dead-letters or removes the job
+	FinishJob(context.Context, *FinishJobRequest) (*FinishJobResponse, error)
+	mustEmbedUnimplementedWorkerControlServer()
+}
+
+// UnimplementedWorkerControlServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWorkerControlServer struct{}
+
+func (UnimplementedWorkerControlServer) ClaimJob(context.Context, *ClaimJobRequest) (*ClaimJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimJob not implemented")
+}
+func (UnimplementedWorkerControlServer) Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+}
+func (UnimplementedWorkerControlServer) StreamLogs(grpc.ClientStreamingServer[LogEntry, StreamLogsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamLogs not implemented")
+}
+func (UnimplementedWorkerControlServer) FinishJob(context.Context, *FinishJobRequest) (*FinishJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishJob not implemented")
+}
+func (UnimplementedWorkerControlServer) mustEmbedUnimplementedWorkerControlServer() {}
+func (UnimplementedWorkerControlServer) testEmbeddedByValue()                       {}
+
+// UnsafeWorkerControlServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WorkerControlServer will
+// result in compilation errors.
+type UnsafeWorkerControlServer interface {
+	mustEmbedUnimplementedWorkerControlServer()
+}
+
+func RegisterWorkerControlServer(s grpc.ServiceRegistrar, srv WorkerControlServer) {
+	// If the following call pancis, it indicates UnimplementedWorkerControlServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WorkerControl_ServiceDesc, srv)
+}
+
+func _WorkerControl_ClaimJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerControlServer).ClaimJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkerControl_ClaimJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerControlServer).ClaimJob(ctx, req.(*ClaimJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkerControl_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerControlServer).Heartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkerControl_Heartbeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerControlServer).Heartbeat(ctx, req.(*HeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkerControl_StreamLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(WorkerControlServer).StreamLogs(&grpc.GenericServerStream[LogEntry, StreamLogsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WorkerControl_StreamLogsServer = grpc.ClientStreamingServer[LogEntry, StreamLogsResponse]
+
+func _WorkerControl_FinishJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinishJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerControlServer).FinishJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkerControl_FinishJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerControlServer).FinishJob(ctx, req.(*FinishJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WorkerControl_ServiceDesc is the grpc.ServiceDesc for WorkerControl service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WorkerControl_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "nimbul.worker.v1.WorkerControl",
+	HandlerType: (*WorkerControlServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ClaimJob",
+			Handler:    _WorkerControl_ClaimJob_Handler,
+		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _WorkerControl_Heartbeat_Handler,
+		},
+		{
+			MethodName: "FinishJob",
+			Handler:    _WorkerControl_FinishJob_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamLogs",
+			Handler:       _WorkerControl_StreamLogs_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "internal/workerpb/worker.proto",
+}
@@ -0,0 +1,188 @@
+package nimbul
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultTimeout    = 30 * time.Second
+	defaultMaxRetries = 3
+	defaultBackoff    = 500 * time.Millisecond
+	maxBackoff        = 10 * time.Second
+)
+
+func DefaultNimbulClient() *Client {
+	baseURL := os.Getenv("NIMBUL_API_URL")
+	if baseURL == "" {
+		baseURL = "http://localhost:8080"
+	}
+
+	client, err := NewClient(baseURL)
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
+// Options configure a client made by New. Zero values pick the defaults.
+type Options struct {
+	// BaseURL of the API, NIMBUL_API_URL or http://localhost:8080 when empty
+	BaseURL string
+
+	// Token returns the bearer token sent with every request. Requests go out
+	// without one when Token is nil or returns "", and keep an Authorization
+	// header they set themselves.
+	Token func() (string, error)
+
+	// UserAgent is sent with every request when set
+	UserAgent string
+
+	// AcceptLanguage is sent with every request when set, e.g. "de-DE", so that error
+	// messages come back in that language where the API has a translation
+	AcceptLanguage string
+
+	// Timeout bounds how long each attempt waits for the response headers, so
+	// long downloads and streams are not cut off. Defaults to 30s.
+	Timeout time.Duration
+
+	// MaxRetries is how many times a request is retried after a 429 or 5xx
+	// response, backing off between attempts. Defaults to 3; negative disables retries.
+	MaxRetries int
+}
+
+// New returns a client for the Nimbul API that authenticates, times out and retries
+// its requests as configured by opts
+func New(opts Options) (*ClientWithResponses, error) {
+	baseURL := opts.BaseURL
+	if baseURL == "" {
+		baseURL = os.Getenv("NIMBUL_API_URL")
+	}
+	if baseURL == "" {
+		baseURL = "http://localhost:8080"
+	}
+
+	timeout := opts.Timeout
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+	maxRetries := opts.MaxRetries
+	if maxRetries == 0 {
+		maxRetries = defaultMaxRetries
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.ResponseHeaderTimeout = timeout
+
+	httpClient := &http.Client{
+		Transport: &retryTransport{
+			next:       transport,
+			maxRetries: max(maxRetries, 0),
+			backoff:    defaultBackoff,
+		},
+	}
+
+	return NewClientWithResponses(baseURL,
+		WithHTTPClient(httpClient),
+		WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+			if opts.UserAgent != "" {
+				req.Header.Set("User-Agent", opts.UserAgent)
+			}
+			if opts.AcceptLanguage != "" {
+				req.Header.Set("Accept-Language", opts.AcceptLanguage)
+			}
+			if opts.Token == nil || req.Header.Get("Authorization") != "" {
+				return nil
+			}
+			token, err := opts.Token()
+			if err != nil {
+				return fmt.Errorf("failed to load token: %w", err)
+			}
+			if token != "" {
+				req.Header.Set("Authorization", "Bearer "+token)
+			}
+			return nil
+		}),
+	)
+}
+
+// retryTransport retries requests the API answered with 429 or a 5xx status
+type retryTransport struct {
+	next       http.RoundTripper
+	maxRetries int
+	backoff    time.Duration
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := t.next.RoundTrip(req)
+		if err != nil || attempt >= t.maxRetries || !shouldRetry(req, resp) {
+			return resp, err
+		}
+
+		// A body that cannot be sent again ends the retries
+		retry := req.Clone(req.Context())
+		if req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				return resp, nil
+			}
+			body, err := req.GetBody()
+			if err != nil {
+				return resp, nil
+			}
+			retry.Body = body
+		}
+
+		delay := retryDelay(resp, attempt, t.backoff)
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+		req = retry
+	}
+}
+
+// shouldRetry reports whether a response is worth another attempt. 429 and 503 mean
+// the API did not handle the request, so any request is retried; other 5xx errors are
+// only retried for methods that are safe to repeat.
+func shouldRetry(req *http.Request, resp *http.Response) bool {
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusNotImplemented, http.StatusHTTPVersionNotSupported:
+		return false
+	}
+	if resp.StatusCode < 500 {
+		return false
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryDelay honours a Retry-After in seconds and otherwise backs off exponentially
+// with jitter, never waiting longer than maxBackoff
+func retryDelay(resp *http.Response, attempt int, backoff time.Duration) time.Duration {
+	if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+		return min(time.Duration(seconds)*time.Second, maxBackoff)
+	}
+
+	delay := min(backoff<<attempt, maxBackoff)
+	// Up to 50% jitter keeps clients retrying at once from hitting the API together
+	return delay/2 + rand.N(delay/2+1)
+}
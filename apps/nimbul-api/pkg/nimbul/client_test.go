@@ -0,0 +1,82 @@
+package nimbul
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryTransport(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		statuses     []int
+		wantStatus   int
+		wantAttempts int32
+	}{
+		{"retries 503 until success", http.MethodPost, []int{503, 503, 200}, 200, 3},
+		{"retries 429", http.MethodPost, []int{429, 201}, 201, 2},
+		{"retries 500 on GET", http.MethodGet, []int{500, 200}, 200, 2},
+		{"does not retry 500 on POST", http.MethodPost, []int{500, 200}, 500, 1},
+		{"does not retry 4xx", http.MethodGet, []int{404, 200}, 404, 1},
+		{"gives up after max retries", http.MethodGet, []int{502, 502, 502, 502, 200}, 502, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := attempts.Add(1)
+				body, _ := io.ReadAll(r.Body)
+				if r.Method == http.MethodPost && string(body) != "payload" {
+					t.Errorf("attempt %d got body %q", n, body)
+				}
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(tt.statuses[n-1])
+			}))
+			defer server.Close()
+
+			client := &http.Client{Transport: &retryTransport{
+				next:       http.DefaultTransport,
+				maxRetries: 3,
+				backoff:    time.Millisecond,
+			}}
+
+			req, err := http.NewRequest(tt.method, server.URL, strings.NewReader("payload"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			if got := attempts.Load(); got != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", got, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	resp := &http.Response{Header: http.Header{}}
+	for attempt := range 8 {
+		want := min(100*time.Millisecond<<attempt, maxBackoff)
+		if got := retryDelay(resp, attempt, 100*time.Millisecond); got < want/2 || got > want {
+			t.Errorf("attempt %d: delay %v outside [%v, %v]", attempt, got, want/2, want)
+		}
+	}
+
+	resp.Header.Set("Retry-After", "3")
+	if got := retryDelay(resp, 0, 100*time.Millisecond); got != 3*time.Second {
+		t.Errorf("Retry-After delay = %v, want 3s", got)
+	}
+}
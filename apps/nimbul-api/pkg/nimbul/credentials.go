@@ -0,0 +1,62 @@
+package nimbul
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Token types of stored credentials the API treats specially
+const (
+	CredentialTypeKubeconfig     = "kubeconfig"            // token is a kubeconfig file
+	CredentialTypeServiceAccount = "service_account_token" // token is a ServiceAccountCredential
+	CredentialTypeRegistryLogin  = "registry_login"        // token is a RegistryLogin
+)
+
+// ServiceAccountCredential is the token of a service_account_token credential
+type ServiceAccountCredential struct {
+	Server                   string `json:"server"`
+	Token                    string `json:"token"`
+	CertificateAuthorityData string `json:"certificate_authority_data,omitempty"` // base64 PEM
+}
+
+// RegistryLogin is the token of a registry_login credential. Its provider must be
+// the registry it logs in to, as NormalizeRegistryServer returns it.
+type RegistryLogin struct {
+	Server   string `json:"server"` // e.g. ghcr.io or docker.io
+	Username string `json:"username"`
+	Password string `json:"password"` // password or access token
+}
+
+// NewRegistryLogin checks a registry login and normalizes its server
+func NewRegistryLogin(server, username, password string) (*RegistryLogin, error) {
+	if server == "" || username == "" || password == "" {
+		return nil, fmt.Errorf("registry login requires server, username and password")
+	}
+	return &RegistryLogin{
+		Server:   NormalizeRegistryServer(server),
+		Username: username,
+		Password: password,
+	}, nil
+}
+
+// Token encodes the login as the token of a registry_login credential
+func (l RegistryLogin) Token() (string, error) {
+	data, err := json.Marshal(l)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode registry login: %w", err)
+	}
+	return string(data), nil
+}
+
+// NormalizeRegistryServer returns the host of a registry as it appears in image
+// references, e.g. docker.io for https://index.docker.io/v1/
+func NormalizeRegistryServer(server string) string {
+	server = strings.TrimPrefix(strings.TrimPrefix(server, "https://"), "http://")
+	server, _, _ = strings.Cut(server, "/")
+	switch server {
+	case "index.docker.io", "registry-1.docker.io":
+		return "docker.io"
+	}
+	return server
+}
@@ -0,0 +1,128 @@
+package nimbul
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Errors an *Error matches with errors.Is, depending on its status code
+var (
+	ErrInvalidRequest = errors.New("invalid request")     // 400, 422
+	ErrUnauthorized   = errors.New("unauthorized")        // 401
+	ErrForbidden      = errors.New("forbidden")           // 403
+	ErrNotFound       = errors.New("not found")           // 404
+	ErrConflict       = errors.New("conflict")            // 409
+	ErrRateLimited    = errors.New("rate limited")        // 429
+	ErrUnavailable    = errors.New("service unavailable") // 5xx
+)
+
+// Error is an error response of the API
+type Error struct {
+	StatusCode int
+	Title      string
+	Detail     string
+	// Type is a URL identifying the problem, usually of a page explaining how to fix it
+	Type string
+	// Errors points at the invalid parts of a rejected request, when the API reports them
+	Errors []ErrorDetail
+}
+
+func (e *Error) Error() string {
+	if e.Detail != "" {
+		return e.Detail
+	}
+	if e.Title != "" {
+		return e.Title
+	}
+	return fmt.Sprintf("status %d", e.StatusCode)
+}
+
+// HelpURL returns the page explaining the problem of e and how to fix it, or "" when
+// the API links none
+func (e *Error) HelpURL() string {
+	if strings.HasPrefix(e.Type, "https://") || strings.HasPrefix(e.Type, "http://") {
+		return e.Type
+	}
+	return ""
+}
+
+// Is matches the sentinel error for the status code of e
+func (e *Error) Is(target error) bool {
+	switch e.StatusCode {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return target == ErrInvalidRequest
+	case http.StatusUnauthorized:
+		return target == ErrUnauthorized
+	case http.StatusForbidden:
+		return target == ErrForbidden
+	case http.StatusNotFound:
+		return target == ErrNotFound
+	case http.StatusConflict:
+		return target == ErrConflict
+	case http.StatusTooManyRequests:
+		return target == ErrRateLimited
+	}
+	return e.StatusCode >= 500 && target == ErrUnavailable
+}
+
+// CheckResponse returns nil for a 2xx status code and an *Error otherwise. Pass it the
+// status code and problem document of a response:
+//
+//	resp, err := client.GetMeWithResponse(ctx, nil)
+//	if err != nil {
+//		return err
+//	}
+//	if err := nimbul.CheckResponse(resp.StatusCode(), resp.ApplicationproblemJSONDefault); err != nil {
+//		return err
+//	}
+func CheckResponse(statusCode int, problem *ErrorModel) error {
+	if statusCode >= 200 && statusCode < 300 {
+		return nil
+	}
+	return newError(statusCode, problem)
+}
+
+// ProblemMessage extracts a readable message from an error response of the API,
+// falling back to the status code when the body carries no detail
+func ProblemMessage(problem *ErrorModel, statusCode int) string {
+	return newError(statusCode, problem).Error()
+}
+
+func newError(statusCode int, problem *ErrorModel) *Error {
+	apiErr := &Error{StatusCode: statusCode}
+	if problem == nil {
+		return apiErr
+	}
+	if problem.Title != nil {
+		apiErr.Title = *problem.Title
+	}
+	if problem.Detail != nil {
+		apiErr.Detail = *problem.Detail
+	}
+	if problem.Type != nil {
+		apiErr.Type = *problem.Type
+	}
+	if problem.Errors != nil {
+		apiErr.Errors = *problem.Errors
+	}
+	return apiErr
+}
+
+// ExistingConfigID returns the ID of the config that made creating or importing one
+// fail, when err is the API's response that the repository already has a config
+func ExistingConfigID(err error) (string, bool) {
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusConflict {
+		return "", false
+	}
+
+	for _, detail := range apiErr.Errors {
+		if detail.Location != nil && *detail.Location == "config_id" {
+			id, ok := detail.Value.(string)
+			return id, ok && id != ""
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,48 @@
+package nimbul
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestExistingConfigID(t *testing.T) {
+	location := "config_id"
+	conflict := CheckResponse(http.StatusConflict, &ErrorModel{
+		Errors: &[]ErrorDetail{{Location: &location, Value: "01JCONFIG"}},
+	})
+
+	if id, ok := ExistingConfigID(fmt.Errorf("failed to create config: %w", conflict)); !ok || id != "01JCONFIG" {
+		t.Errorf("ExistingConfigID() = %q, %v, want 01JCONFIG, true", id, ok)
+	}
+
+	// Other conflicts, e.g. a taken external ID, carry no config ID
+	if _, ok := ExistingConfigID(CheckResponse(http.StatusConflict, nil)); ok {
+		t.Error("ExistingConfigID() found a config ID in a conflict without one")
+	}
+	if _, ok := ExistingConfigID(CheckResponse(http.StatusBadRequest, &ErrorModel{
+		Errors: &[]ErrorDetail{{Location: &location, Value: "01JCONFIG"}},
+	})); ok {
+		t.Error("ExistingConfigID() found a config ID in a non-conflict response")
+	}
+}
+
+func TestErrorHelpURL(t *testing.T) {
+	help := "https://nimbul.dev/docs/errors/token-expired"
+	err := CheckResponse(http.StatusUnauthorized, &ErrorModel{Type: &help})
+
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("CheckResponse() = %v, want an *Error", err)
+	}
+	if url := apiErr.HelpURL(); url != help {
+		t.Errorf("HelpURL() = %q, want %q", url, help)
+	}
+
+	// The default problem type links nothing
+	blank := "about:blank"
+	if url := newError(http.StatusNotFound, &ErrorModel{Type: &blank}).HelpURL(); url != "" {
+		t.Errorf("HelpURL() = %q, want none", url)
+	}
+}
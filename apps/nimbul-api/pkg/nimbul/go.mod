@@ -0,0 +1,10 @@
+module github.com/coding-cave-dev/nimbul/pkg/nimbul
+
+go 1.25.5
+
+require github.com/oapi-codegen/runtime v1.1.2
+
+require (
+	github.com/apapsch/go-jsonmerge/v2 v2.0.0 // indirect
+	github.com/google/uuid v1.5.0 // indirect
+)
@@ -0,0 +1,60 @@
+package nimbul
+
+import (
+	"context"
+	"errors"
+	"iter"
+)
+
+// ConfigDeployments iterates over the deployments of a config, newest first, fetching
+// pageSize of them per request. Iteration stops after yielding an error.
+//
+//	for deployment, err := range client.ConfigDeployments(ctx, configID, 50) {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(deployment.Id, deployment.Status)
+//	}
+func (c *ClientWithResponses) ConfigDeployments(ctx context.Context, configID string, pageSize int32, reqEditors ...RequestEditorFn) iter.Seq2[DeploymentResponse, error] {
+	return paginate(func(before *int64) ([]DeploymentResponse, *int64, error) {
+		resp, err := c.GetConfigsByIdDeploymentsWithResponse(ctx, configID, &GetConfigsByIdDeploymentsParams{
+			Limit:  &pageSize,
+			Before: before,
+		}, reqEditors...)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := CheckResponse(resp.StatusCode(), resp.ApplicationproblemJSONDefault); err != nil {
+			return nil, nil, err
+		}
+		if resp.JSON200 == nil || resp.JSON200.Deployments == nil {
+			return nil, nil, errors.New("empty response body")
+		}
+		return *resp.JSON200.Deployments, resp.JSON200.NextBefore, nil
+	})
+}
+
+// paginate yields the items of the pages returned by fetch, passing each call the
+// cursor the previous page returned, until a page returns no cursor
+func paginate[T, C any](fetch func(cursor *C) ([]T, *C, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var cursor *C
+		for {
+			items, next, err := fetch(cursor)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if next == nil {
+				return
+			}
+			cursor = next
+		}
+	}
+}
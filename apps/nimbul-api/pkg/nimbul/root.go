@@ -0,0 +1,18231 @@
+// Package nimbul provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+package nimbul
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/oapi-codegen/runtime"
+)
+
+// Defines values for ActivityEntryResponseKind.
+const (
+	Build      ActivityEntryResponseKind = "build"
+	Deployment ActivityEntryResponseKind = "deployment"
+	Rollback   ActivityEntryResponseKind = "rollback"
+)
+
+// Defines values for BundleDeployTarget.
+const (
+	Agent             BundleDeployTarget = "agent"
+	ClusterCredential BundleDeployTarget = "cluster_credential"
+	Server            BundleDeployTarget = "server"
+)
+
+// Defines values for DeadJobResponseKind.
+const (
+	GithubPush DeadJobResponseKind = "github_push"
+	ImagePush  DeadJobResponseKind = "image_push"
+)
+
+// Defines values for GitHubAppResponseSource.
+const (
+	Database    GitHubAppResponseSource = "database"
+	Environment GitHubAppResponseSource = "environment"
+)
+
+// Defines values for PolicyResponseAction.
+const (
+	PolicyResponseActionBlock PolicyResponseAction = "block"
+	PolicyResponseActionWarn  PolicyResponseAction = "warn"
+)
+
+// Defines values for QuotaStatusResponseMetric.
+const (
+	BuildMinutes QuotaStatusResponseMetric = "build_minutes"
+	Deploys      QuotaStatusResponseMetric = "deploys"
+	StorageMb    QuotaStatusResponseMetric = "storage_mb"
+)
+
+// Defines values for QuotaStatusResponseState.
+const (
+	Exceeded QuotaStatusResponseState = "exceeded"
+	Ok       QuotaStatusResponseState = "ok"
+	Warning  QuotaStatusResponseState = "warning"
+)
+
+// Defines values for ReportAgentDeploymentRequestBodyStatus.
+const (
+	Applied ReportAgentDeploymentRequestBodyStatus = "applied"
+	Failed  ReportAgentDeploymentRequestBodyStatus = "failed"
+)
+
+// Defines values for SetPolicyRequestBodyAction.
+const (
+	SetPolicyRequestBodyActionBlock SetPolicyRequestBodyAction = "block"
+	SetPolicyRequestBodyActionWarn  SetPolicyRequestBodyAction = "warn"
+)
+
+// Defines values for UpdateAdminUserRequestBodyRole.
+const (
+	Admin UpdateAdminUserRequestBodyRole = "admin"
+	User  UpdateAdminUserRequestBodyRole = "user"
+)
+
+// AcceptTransferResponseBody defines model for AcceptTransferResponseBody.
+type AcceptTransferResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema   *string        `json:"$schema,omitempty"`
+	Config   ConfigResponse `json:"config"`
+	Warnings *[]string      `json:"warnings"`
+}
+
+// ActivityEntryResponse defines model for ActivityEntryResponse.
+type ActivityEntryResponse struct {
+	// Actor Who deployed or rolled back
+	Actor      *string    `json:"actor,omitempty"`
+	CommitSha  string     `json:"commit_sha"`
+	ConfigId   string     `json:"config_id"`
+	Error      *string    `json:"error,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+
+	// Id ID of the build or deployment
+	Id   int64                     `json:"id"`
+	Kind ActivityEntryResponseKind `json:"kind"`
+
+	// Name Build name, for builds
+	Name         *string `json:"name,omitempty"`
+	Ref          string  `json:"ref"`
+	RepoFullName string  `json:"repo_full_name"`
+
+	// RollbackOf Deployment a rollback went back to
+	RollbackOf *int64    `json:"rollback_of,omitempty"`
+	StartedAt  time.Time `json:"started_at"`
+	Status     string    `json:"status"`
+}
+
+// ActivityEntryResponseKind defines model for ActivityEntryResponse.Kind.
+type ActivityEntryResponseKind string
+
+// AdminActivityResponse defines model for AdminActivityResponse.
+type AdminActivityResponse struct {
+	CommitSha    string    `json:"commit_sha"`
+	ConfigId     string    `json:"config_id"`
+	CreatedAt    time.Time `json:"created_at"`
+	DeploymentId int64     `json:"deployment_id"`
+	Error        *string   `json:"error,omitempty"`
+	OwnerEmail   string    `json:"owner_email"`
+	OwnerId      string    `json:"owner_id"`
+	Ref          string    `json:"ref"`
+	RepoFullName string    `json:"repo_full_name"`
+	Status       string    `json:"status"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// AdminUserResponse defines model for AdminUserResponse.
+type AdminUserResponse struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema     *string    `json:"$schema,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	DisabledAt *time.Time `json:"disabled_at,omitempty"`
+	Email      string     `json:"email"`
+	Id         string     `json:"id"`
+	Role       string     `json:"role"`
+}
+
+// AgentDeploymentResponse defines model for AgentDeploymentResponse.
+type AgentDeploymentResponse struct {
+	ConfigId  string `json:"config_id"`
+	Id        int64  `json:"id"`
+	Manifests string `json:"manifests"`
+}
+
+// AgentResponse defines model for AgentResponse.
+type AgentResponse struct {
+	CreatedAt  time.Time  `json:"created_at"`
+	Id         string     `json:"id"`
+	LastSeenAt *time.Time `json:"last_seen_at,omitempty"`
+	Name       string     `json:"name"`
+}
+
+// ArtifactResponse defines model for ArtifactResponse.
+type ArtifactResponse struct {
+	CreatedAt time.Time `json:"created_at"`
+	Name      string    `json:"name"`
+	SizeBytes int64     `json:"size_bytes"`
+}
+
+// BootstrapRepositoryRequestBody defines model for BootstrapRepositoryRequestBody.
+type BootstrapRepositoryRequestBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+
+	// Dockerfile Add a starter Dockerfile when the repository has none at its root
+	Dockerfile *bool `json:"dockerfile,omitempty"`
+
+	// Registry Registry prefix for generated image tags, ghcr.io/<owner> when unset
+	Registry *string `json:"registry,omitempty"`
+}
+
+// BootstrapRepositoryResponseBody defines model for BootstrapRepositoryResponseBody.
+type BootstrapRepositoryResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	Branch string  `json:"branch"`
+
+	// Files Paths the pull request adds
+	Files *[]string `json:"files"`
+
+	// Notes What to look at before merging
+	Notes *[]string `json:"notes"`
+
+	// Number Number of the pull request
+	Number int64  `json:"number"`
+	Url    string `json:"url"`
+}
+
+// BuildLogEntryResponse defines model for BuildLogEntryResponse.
+type BuildLogEntryResponse struct {
+	// Message Log line without ANSI escape sequences
+	Message string `json:"message"`
+
+	// Stream stdout, stderr, status (a step started, finished or failed) or system (written by Nimbul)
+	Stream string    `json:"stream"`
+	Time   time.Time `json:"time"`
+
+	// Vertex Build step that logged the entry, e.g. [2/5] RUN go build
+	Vertex *string `json:"vertex,omitempty"`
+}
+
+// BuildSummaryResponse defines model for BuildSummaryResponse.
+type BuildSummaryResponse struct {
+	CommitSha  string     `json:"commit_sha"`
+	Error      *string    `json:"error,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	Id         int64      `json:"id"`
+	Name       string     `json:"name"`
+	Ref        string     `json:"ref"`
+	StartedAt  time.Time  `json:"started_at"`
+	Status     string     `json:"status"`
+}
+
+// BuildTimingResponse defines model for BuildTimingResponse.
+type BuildTimingResponse struct {
+	DurationMs int64 `json:"duration_ms"`
+
+	// Kind clone, context (uploading or cloning the build context), stage (the steps of a Dockerfile stage), push or deploy
+	Kind string `json:"kind"`
+
+	// Name e.g. clone, context, the name of a Dockerfile stage, push or deploy
+	Name      string    `json:"name"`
+	StartedAt time.Time `json:"started_at"`
+}
+
+// Bundle defines model for Bundle.
+type Bundle struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema         *string      `json:"$schema,omitempty"`
+	Deploy         BundleDeploy `json:"deploy"`
+	DockerfilePath string       `json:"dockerfile_path"`
+	ExportedAt     time.Time    `json:"exported_at"`
+	Provider       string       `json:"provider"`
+	RepoCloneUrl   string       `json:"repo_clone_url"`
+	RepoFullName   string       `json:"repo_full_name"`
+	RepoName       string       `json:"repo_name"`
+	RepoOwner      string       `json:"repo_owner"`
+	Version        string       `json:"version"`
+}
+
+// BundleDeploy defines model for BundleDeploy.
+type BundleDeploy struct {
+	AgentName *string            `json:"agent_name,omitempty"`
+	Target    BundleDeployTarget `json:"target"`
+}
+
+// BundleDeployTarget defines model for BundleDeploy.Target.
+type BundleDeployTarget string
+
+// CLIReleaseAssetResponse defines model for CLIReleaseAssetResponse.
+type CLIReleaseAssetResponse struct {
+	// Name nimbul_<os>_<arch>, with .exe on Windows, checksums.txt or checksums.txt.sig
+	Name string `json:"name"`
+	Url  string `json:"url"`
+}
+
+// CancelConfigTransferResponseBody defines model for CancelConfigTransferResponseBody.
+type CancelConfigTransferResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema  *string `json:"$schema,omitempty"`
+	Success bool    `json:"success"`
+}
+
+// CheckGitHubInstallationResponseBody defines model for CheckGitHubInstallationResponseBody.
+type CheckGitHubInstallationResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema  *string `json:"$schema,omitempty"`
+	Success bool    `json:"success"`
+}
+
+// CompleteOIDCLoginRequestBody defines model for CompleteOIDCLoginRequestBody.
+type CompleteOIDCLoginRequestBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema     *string   `json:"$schema,omitempty"`
+	DeviceCode string    `json:"device_code"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	Interval   int64     `json:"interval"`
+}
+
+// ConfigEnvBody defines model for ConfigEnvBody.
+type ConfigEnvBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string                 `json:"$schema,omitempty"`
+	Env    *[]ConfigEnvVarResponse `json:"env"`
+}
+
+// ConfigEnvVarResponse defines model for ConfigEnvVarResponse.
+type ConfigEnvVarResponse struct {
+	Name      string    `json:"name"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Value     string    `json:"value"`
+}
+
+// ConfigResponse defines model for ConfigResponse.
+type ConfigResponse struct {
+	AgentId             *string   `json:"agent_id,omitempty"`
+	ClusterCredentialId *int64    `json:"cluster_credential_id,omitempty"`
+	CreatedAt           time.Time `json:"created_at"`
+	DockerfilePath      string    `json:"dockerfile_path"`
+	ExternalId          *string   `json:"external_id,omitempty"`
+
+	// Health Result of the last check against GitHub: unknown, ok, app_uninstalled, repo_not_found or webhook_missing
+	Health          string     `json:"health"`
+	HealthCheckedAt *time.Time `json:"health_checked_at,omitempty"`
+
+	// HealthDetail What is wrong and how to fix it
+	HealthDetail *string   `json:"health_detail,omitempty"`
+	Id           string    `json:"id"`
+	Provider     string    `json:"provider"`
+	RepoCloneUrl string    `json:"repo_clone_url"`
+	RepoFullName string    `json:"repo_full_name"`
+	RepoName     string    `json:"repo_name"`
+	RepoOwner    string    `json:"repo_owner"`
+	UpdatedAt    time.Time `json:"updated_at"`
+
+	// Version Bumped on every change, the ETag of the config
+	Version int32 `json:"version"`
+}
+
+// ConfigUsageResponse defines model for ConfigUsageResponse.
+type ConfigUsageResponse struct {
+	BuildMinutes float64 `json:"build_minutes"`
+	Builds       int64   `json:"builds"`
+	ConfigId     string  `json:"config_id"`
+
+	// Quotas Per-config quotas
+	Quotas       *[]QuotaStatusResponse `json:"quotas"`
+	RepoFullName string                 `json:"repo_full_name"`
+
+	// StorageBytes Stored build logs and artifacts
+	StorageBytes int64 `json:"storage_bytes"`
+}
+
+// ConfigWebhookStatus defines model for ConfigWebhookStatus.
+type ConfigWebhookStatus struct {
+	// GithubWebhookId ID of the GitHub webhook triggering builds, unset until it is created
+	GithubWebhookId *int64 `json:"github_webhook_id,omitempty"`
+
+	// RegistryWebhook Whether registry pushes redeploy the config
+	RegistryWebhook bool `json:"registry_webhook"`
+}
+
+// ConfirmEmailChangeRequestBody defines model for ConfirmEmailChangeRequestBody.
+type ConfirmEmailChangeRequestBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	Token  string  `json:"token"`
+}
+
+// ConfirmEmailChangeResponseBody defines model for ConfirmEmailChangeResponseBody.
+type ConfirmEmailChangeResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string      `json:"$schema,omitempty"`
+	User   UserResponse `json:"user"`
+}
+
+// ConfirmTwoFactorRequestBody defines model for ConfirmTwoFactorRequestBody.
+type ConfirmTwoFactorRequestBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+
+	// Code Current code from the authenticator app
+	Code string `json:"code"`
+}
+
+// ConfirmTwoFactorResponseBody defines model for ConfirmTwoFactorResponseBody.
+type ConfirmTwoFactorResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+
+	// RecoveryCodes Single-use codes for logging in without the authenticator; they are not shown again
+	RecoveryCodes *[]string `json:"recovery_codes"`
+}
+
+// CreateAgentRequestBody defines model for CreateAgentRequestBody.
+type CreateAgentRequestBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	Name   string  `json:"name"`
+}
+
+// CreateAgentResponseBody defines model for CreateAgentResponseBody.
+type CreateAgentResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema  *string `json:"$schema,omitempty"`
+	AgentId string  `json:"agent_id"`
+	Token   string  `json:"token"`
+}
+
+// CreateConfigRequestBody defines model for CreateConfigRequestBody.
+type CreateConfigRequestBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+
+	// DockerfilePath Dockerfile of the first build, empty for deploy-only configs
+	DockerfilePath *string `json:"dockerfile_path,omitempty"`
+
+	// ExternalId Client-chosen ID; creating again with it returns the config created the first time
+	ExternalId    *string `json:"external_id,omitempty"`
+	Provider      string  `json:"provider"`
+	RepoCloneUrl  string  `json:"repo_clone_url"`
+	RepoFullName  string  `json:"repo_full_name"`
+	RepoName      string  `json:"repo_name"`
+	RepoOwner     string  `json:"repo_owner"`
+	WebhookSecret string  `json:"webhook_secret"`
+}
+
+// CreateConfigResponseBody defines model for CreateConfigResponseBody.
+type CreateConfigResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema   *string        `json:"$schema,omitempty"`
+	Config   ConfigResponse `json:"config"`
+	ConfigId string         `json:"config_id"`
+}
+
+// CreateConfigWebhookRequestBody defines model for CreateConfigWebhookRequestBody.
+type CreateConfigWebhookRequestBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+
+	// ApiUrl Base URL GitHub delivers to; NIMBUL_PUBLIC_URL takes precedence when the server sets it
+	ApiUrl *string `json:"api_url,omitempty"`
+}
+
+// CreateConfigWebhookResponseBody defines model for CreateConfigWebhookResponseBody.
+type CreateConfigWebhookResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema    *string `json:"$schema,omitempty"`
+	Url       string  `json:"url"`
+	WebhookId int64   `json:"webhook_id"`
+}
+
+// CreateDeployTokenRequestBody defines model for CreateDeployTokenRequestBody.
+type CreateDeployTokenRequestBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+
+	// Name Name telling the token apart, e.g. the CI system using it
+	Name string `json:"name"`
+}
+
+// CreateDeployTokenResponseBody defines model for CreateDeployTokenResponseBody.
+type CreateDeployTokenResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema     *string    `json:"$schema,omitempty"`
+	ConfigId   string     `json:"config_id"`
+	CreatedAt  time.Time  `json:"created_at"`
+	Id         string     `json:"id"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	Name       string     `json:"name"`
+
+	// Token The deploy token, only returned now
+	Token string `json:"token"`
+}
+
+// CreateHookRequestBody defines model for CreateHookRequestBody.
+type CreateHookRequestBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+
+	// Events Event types to deliver, all when empty
+	Events *[]string `json:"events"`
+	Url    string    `json:"url"`
+}
+
+// CreateHookResponseBody defines model for CreateHookResponseBody.
+type CreateHookResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	HookId string  `json:"hook_id"`
+
+	// Secret HMAC-SHA256 key for verifying the X-Nimbul-Signature header
+	Secret string `json:"secret"`
+}
+
+// CredentialResponse defines model for CredentialResponse.
+type CredentialResponse struct {
+	CreatedAt  time.Time  `json:"created_at"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	ExternalId *string    `json:"external_id,omitempty"`
+	Id         int64      `json:"id"`
+
+	// LastUsedAt When a build or deploy last used the credential, to the minute; unset when never used
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	Provider   string     `json:"provider"`
+	TokenType  string     `json:"token_type"`
+
+	// Version Bumped on every change, the ETag of the credential
+	Version int32 `json:"version"`
+}
+
+// DeadJobResponse defines model for DeadJobResponse.
+type DeadJobResponse struct {
+	Attempts  int64     `json:"attempts"`
+	ConfigId  string    `json:"config_id"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// Error Error of the last attempt
+	Error string              `json:"error"`
+	Id    int64               `json:"id"`
+	Kind  DeadJobResponseKind `json:"kind"`
+
+	// Payload What the job was started with: the GitHub push event, or the image push
+	Payload interface{} `json:"payload"`
+}
+
+// DeadJobResponseKind defines model for DeadJobResponse.Kind.
+type DeadJobResponseKind string
+
+// DeclineTransferResponseBody defines model for DeclineTransferResponseBody.
+type DeclineTransferResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema  *string `json:"$schema,omitempty"`
+	Success bool    `json:"success"`
+}
+
+// DeleteConfigResponseBody defines model for DeleteConfigResponseBody.
+type DeleteConfigResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema  *string `json:"$schema,omitempty"`
+	Success bool    `json:"success"`
+}
+
+// DeleteCredentialResponseBody defines model for DeleteCredentialResponseBody.
+type DeleteCredentialResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema  *string `json:"$schema,omitempty"`
+	Success bool    `json:"success"`
+}
+
+// DeleteDeployTokenResponseBody defines model for DeleteDeployTokenResponseBody.
+type DeleteDeployTokenResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema  *string `json:"$schema,omitempty"`
+	Success bool    `json:"success"`
+}
+
+// DeleteHookResponseBody defines model for DeleteHookResponseBody.
+type DeleteHookResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema  *string `json:"$schema,omitempty"`
+	Success bool    `json:"success"`
+}
+
+// DeleteMeRequestBody defines model for DeleteMeRequestBody.
+type DeleteMeRequestBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+
+	// Password Current password, to confirm the deletion
+	Password string `json:"password"`
+}
+
+// DeleteMeResponseBody defines model for DeleteMeResponseBody.
+type DeleteMeResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema  *string `json:"$schema,omitempty"`
+	Success bool    `json:"success"`
+}
+
+// DeletePolicyResponseBody defines model for DeletePolicyResponseBody.
+type DeletePolicyResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema  *string `json:"$schema,omitempty"`
+	Success bool    `json:"success"`
+}
+
+// DeniedAuthorsBody defines model for DeniedAuthorsBody.
+type DeniedAuthorsBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+
+	// Authors Commit authors whose pushes don't trigger builds, matched case-insensitively against their GitHub login, name or email, e.g. dependabot[bot]
+	Authors *[]string `json:"authors"`
+}
+
+// DeployTokenResponse defines model for DeployTokenResponse.
+type DeployTokenResponse struct {
+	ConfigId   string     `json:"config_id"`
+	CreatedAt  time.Time  `json:"created_at"`
+	Id         string     `json:"id"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	Name       string     `json:"name"`
+}
+
+// DeploymentManifestResponse defines model for DeploymentManifestResponse.
+type DeploymentManifestResponse struct {
+	// Content Multi-document YAML sent to the cluster, with overrides and templates applied
+	Content string `json:"content"`
+
+	// Path Manifest path in the repository
+	Path string `json:"path"`
+}
+
+// DeploymentResponse defines model for DeploymentResponse.
+type DeploymentResponse struct {
+	CommitSha string    `json:"commit_sha"`
+	ConfigId  string    `json:"config_id"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// DeployedBy GitHub or registry user who pushed, or Nimbul user who rolled back
+	DeployedBy *string `json:"deployed_by,omitempty"`
+	Error      *string `json:"error,omitempty"`
+	Id         int64   `json:"id"`
+	Ref        string  `json:"ref"`
+
+	// RollbackOf Deployment this rollback went back to
+	RollbackOf *int64    `json:"rollback_of,omitempty"`
+	Status     string    `json:"status"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// DisableRegistryWebhookResponseBody defines model for DisableRegistryWebhookResponseBody.
+type DisableRegistryWebhookResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema  *string `json:"$schema,omitempty"`
+	Success bool    `json:"success"`
+}
+
+// DisableTwoFactorRequestBody defines model for DisableTwoFactorRequestBody.
+type DisableTwoFactorRequestBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+
+	// Code Current code from the authenticator app, or a recovery code
+	Code string `json:"code"`
+}
+
+// DisableTwoFactorResponseBody defines model for DisableTwoFactorResponseBody.
+type DisableTwoFactorResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema  *string `json:"$schema,omitempty"`
+	Success bool    `json:"success"`
+}
+
+// EnrollTwoFactorResponseBody defines model for EnrollTwoFactorResponseBody.
+type EnrollTwoFactorResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+
+	// ProvisioningUri otpauth:// URI to show as a QR code
+	ProvisioningUri string `json:"provisioning_uri"`
+
+	// Secret Base32 TOTP secret, for authenticator apps that cannot scan QR codes
+	Secret string `json:"secret"`
+}
+
+// EnvironmentResponse defines model for EnvironmentResponse.
+type EnvironmentResponse struct {
+	// Branch Branch last deployed there
+	Branch string `json:"branch"`
+
+	// Builds Build ID of each build name the deployment used
+	Builds     map[string]int64   `json:"builds"`
+	Deployment DeploymentResponse `json:"deployment"`
+
+	// Namespace Preview namespace, unset for the namespaces the manifests name
+	Namespace *string   `json:"namespace,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ErrorDetail defines model for ErrorDetail.
+type ErrorDetail struct {
+	// Location Where the error occurred, e.g. 'body.items[3].tags' or 'path.thing-id'
+	Location *string `json:"location,omitempty"`
+
+	// Message Error message text
+	Message *string `json:"message,omitempty"`
+
+	// Value The value at the given location
+	Value interface{} `json:"value,omitempty"`
+}
+
+// ErrorModel defines model for ErrorModel.
+type ErrorModel struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+
+	// Detail A human-readable explanation specific to this occurrence of the problem.
+	Detail *string `json:"detail,omitempty"`
+
+	// Errors Optional list of individual error details
+	Errors *[]ErrorDetail `json:"errors"`
+
+	// Instance A URI reference that identifies the specific occurrence of the problem.
+	Instance *string `json:"instance,omitempty"`
+
+	// Status HTTP status code
+	Status *int64 `json:"status,omitempty"`
+
+	// Title A short, human-readable summary of the problem type. This value should not change between occurrences of the error.
+	Title *string `json:"title,omitempty"`
+
+	// Type A URI reference to human-readable documentation for the error.
+	Type *string `json:"type,omitempty"`
+}
+
+// ExpiringCredentialResponse defines model for ExpiringCredentialResponse.
+type ExpiringCredentialResponse struct {
+	Expired   bool      `json:"expired"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Provider  string    `json:"provider"`
+	TokenType string    `json:"token_type"`
+}
+
+// GetActivityResponseBody defines model for GetActivityResponseBody.
+type GetActivityResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema   *string                  `json:"$schema,omitempty"`
+	Activity *[]ActivityEntryResponse `json:"activity"`
+}
+
+// GetAdminActivityResponseBody defines model for GetAdminActivityResponseBody.
+type GetAdminActivityResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema   *string                  `json:"$schema,omitempty"`
+	Activity *[]AdminActivityResponse `json:"activity"`
+}
+
+// GetBuildConfigResponseBody defines model for GetBuildConfigResponseBody.
+type GetBuildConfigResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema    *string `json:"$schema,omitempty"`
+	BuildId   int64   `json:"build_id"`
+	CommitSha string  `json:"commit_sha"`
+
+	// Config nimbul.yaml the build's run used, with its templates rendered
+	Config     string `json:"config"`
+	PipelineId int64  `json:"pipeline_id"`
+	Ref        string `json:"ref"`
+}
+
+// GetBuildLogsResponseBody defines model for GetBuildLogsResponseBody.
+type GetBuildLogsResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema  *string                  `json:"$schema,omitempty"`
+	Entries *[]BuildLogEntryResponse `json:"entries"`
+
+	// Running Whether the build is still running, the log then holds the entries so far
+	Running bool `json:"running"`
+
+	// Truncated Whether the build wrote more than the log limit, the log then ends with a marker entry
+	Truncated bool `json:"truncated"`
+}
+
+// GetBuildPipelineResponseBody defines model for GetBuildPipelineResponseBody.
+type GetBuildPipelineResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema     *string                  `json:"$schema,omitempty"`
+	CommitSha  string                   `json:"commit_sha"`
+	ConfigId   string                   `json:"config_id"`
+	CreatedAt  time.Time                `json:"created_at"`
+	FinishedAt *time.Time               `json:"finished_at,omitempty"`
+	Id         int64                    `json:"id"`
+	Ref        string                   `json:"ref"`
+	Stages     *[]PipelineStageResponse `json:"stages"`
+
+	// Status running, succeeded, partial (some stages failed, the stages independent of them succeeded), failed or canceled
+	Status string `json:"status"`
+}
+
+// GetBuildProvenanceResponseBody defines model for GetBuildProvenanceResponseBody.
+type GetBuildProvenanceResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema     *string               `json:"$schema,omitempty"`
+	Provenance *[]ProvenanceResponse `json:"provenance"`
+}
+
+// GetBuildResponseBody defines model for GetBuildResponseBody.
+type GetBuildResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema     *string    `json:"$schema,omitempty"`
+	CommitSha  string     `json:"commit_sha"`
+	ConfigId   string     `json:"config_id"`
+	Error      *string    `json:"error,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	Id         int64      `json:"id"`
+	Name       string     `json:"name"`
+	PipelineId *int64     `json:"pipeline_id,omitempty"`
+	Ref        string     `json:"ref"`
+	StartedAt  time.Time  `json:"started_at"`
+	Status     string     `json:"status"`
+
+	// Timings How long each part of the build took, in the order they ran
+	Timings *[]BuildTimingResponse `json:"timings"`
+}
+
+// GetCLIReleaseResponseBody defines model for GetCLIReleaseResponseBody.
+type GetCLIReleaseResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema      *string                    `json:"$schema,omitempty"`
+	Assets      *[]CLIReleaseAssetResponse `json:"assets"`
+	PublishedAt time.Time                  `json:"published_at"`
+
+	// Url Release page
+	Url     string `json:"url"`
+	Version string `json:"version"`
+}
+
+// GetConfigResponseBody defines model for GetConfigResponseBody.
+type GetConfigResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema         *string               `json:"$schema,omitempty"`
+	Config         ConfigResponse        `json:"config"`
+	LastBuild      *BuildSummaryResponse `json:"last_build,omitempty"`
+	LastDeployment *DeploymentResponse   `json:"last_deployment,omitempty"`
+	Webhook        ConfigWebhookStatus   `json:"webhook"`
+}
+
+// GetCredentialResponseBody defines model for GetCredentialResponseBody.
+type GetCredentialResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema     *string            `json:"$schema,omitempty"`
+	Credential CredentialResponse `json:"credential"`
+}
+
+// GetDeployStatusResponseBody defines model for GetDeployStatusResponseBody.
+type GetDeployStatusResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema     *string            `json:"$schema,omitempty"`
+	Deployment DeploymentResponse `json:"deployment"`
+}
+
+// GetDeploymentManifestsResponseBody defines model for GetDeploymentManifestsResponseBody.
+type GetDeploymentManifestsResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema     *string            `json:"$schema,omitempty"`
+	Deployment DeploymentResponse `json:"deployment"`
+
+	// Manifests In the order they were applied, empty for deployments from before manifests were recorded
+	Manifests *[]DeploymentManifestResponse `json:"manifests"`
+}
+
+// GetDeploymentResourcesResponseBody defines model for GetDeploymentResourcesResponseBody.
+type GetDeploymentResourcesResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema     *string            `json:"$schema,omitempty"`
+	Deployment DeploymentResponse `json:"deployment"`
+	Resources  *[]ResourceStatus  `json:"resources"`
+}
+
+// GetGitHubAppPublicResponseBody defines model for GetGitHubAppPublicResponseBody.
+type GetGitHubAppPublicResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema   *string `json:"$schema,omitempty"`
+	ClientId string  `json:"client_id"`
+	Slug     string  `json:"slug"`
+}
+
+// GetGitHubInstallationTokenResponseBody defines model for GetGitHubInstallationTokenResponseBody.
+type GetGitHubInstallationTokenResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema     *string   `json:"$schema,omitempty"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	Repository string    `json:"repository"`
+
+	// Token GitHub App installation token that can only access the config's repository
+	Token string `json:"token"`
+}
+
+// GetGitHubTokenResponseBody defines model for GetGitHubTokenResponseBody.
+type GetGitHubTokenResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	Token  string  `json:"token"`
+}
+
+// GetNextAgentDeploymentResponseBody defines model for GetNextAgentDeploymentResponseBody.
+type GetNextAgentDeploymentResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema     *string                  `json:"$schema,omitempty"`
+	Deployment *AgentDeploymentResponse `json:"deployment,omitempty"`
+}
+
+// GetProvidersResponseBody defines model for GetProvidersResponseBody.
+type GetProvidersResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema    *string   `json:"$schema,omitempty"`
+	Providers *[]string `json:"providers"`
+}
+
+// GetRetentionStatsResponseBody defines model for GetRetentionStatsResponseBody.
+type GetRetentionStatsResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema        *string `json:"$schema,omitempty"`
+	BuildsDeleted int64   `json:"builds_deleted"`
+
+	// BytesReclaimed Log and artifact storage freed by the cleanup
+	BytesReclaimed int64                   `json:"bytes_reclaimed"`
+	LastRunAt      *time.Time              `json:"last_run_at,omitempty"`
+	LogsDeleted    int64                   `json:"logs_deleted"`
+	RecentRuns     *[]RetentionRunResponse `json:"recent_runs"`
+
+	// Runs Runs of the cleanup that deleted something
+	Runs int64 `json:"runs"`
+}
+
+// GetTrashResponseBody defines model for GetTrashResponseBody.
+type GetTrashResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema      *string                      `json:"$schema,omitempty"`
+	Configs     *[]TrashedConfigResponse     `json:"configs"`
+	Credentials *[]TrashedCredentialResponse `json:"credentials"`
+}
+
+// GetUsageResponseBody defines model for GetUsageResponseBody.
+type GetUsageResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema       *string `json:"$schema,omitempty"`
+	BuildMinutes float64 `json:"build_minutes"`
+	Builds       int64   `json:"builds"`
+
+	// Configs Usage of each config
+	Configs     *[]ConfigUsageResponse `json:"configs"`
+	Deploys     int64                  `json:"deploys"`
+	PeriodEnd   time.Time              `json:"period_end"`
+	PeriodStart time.Time              `json:"period_start"`
+	Quotas      *[]QuotaStatusResponse `json:"quotas"`
+
+	// StorageBytes Stored build logs and artifacts
+	StorageBytes int64 `json:"storage_bytes"`
+}
+
+// GetVersionResponseBody defines model for GetVersionResponseBody.
+type GetVersionResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+
+	// MinCliVersion Oldest CLI release the API works with
+	MinCliVersion string `json:"min_cli_version"`
+
+	// Version Release of the server, dev for builds that are not a release
+	Version string `json:"version"`
+}
+
+// GitHubAppResponse defines model for GitHubAppResponse.
+type GitHubAppResponse struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema           *string `json:"$schema,omitempty"`
+	AppId            *int64  `json:"app_id,omitempty"`
+	ClientId         *string `json:"client_id,omitempty"`
+	HasClientSecret  bool    `json:"has_client_secret"`
+	HasPrivateKey    bool    `json:"has_private_key"`
+	HasWebhookSecret bool    `json:"has_webhook_secret"`
+
+	// Slug From the app's URL, github.com/apps/<slug>
+	Slug string `json:"slug"`
+
+	// Source Whether admins stored the configuration or it comes from the server's environment variables
+	Source    GitHubAppResponseSource `json:"source"`
+	UpdatedAt *time.Time              `json:"updated_at,omitempty"`
+}
+
+// GitHubAppResponseSource Whether admins stored the configuration or it comes from the server's environment variables
+type GitHubAppResponseSource string
+
+// HealthCheckResponseBody defines model for HealthCheckResponseBody.
+type HealthCheckResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema  *string `json:"$schema,omitempty"`
+	Message string  `json:"message"`
+}
+
+// HookResponse defines model for HookResponse.
+type HookResponse struct {
+	CreatedAt      time.Time  `json:"created_at"`
+	Events         *[]string  `json:"events"`
+	Id             string     `json:"id"`
+	LastDeliveryAt *time.Time `json:"last_delivery_at,omitempty"`
+	LastError      *string    `json:"last_error,omitempty"`
+	LastStatusCode *int64     `json:"last_status_code,omitempty"`
+	Url            string     `json:"url"`
+}
+
+// ImportConfigResponseBody defines model for ImportConfigResponseBody.
+type ImportConfigResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema   *string   `json:"$schema,omitempty"`
+	ConfigId string    `json:"config_id"`
+	Warnings *[]string `json:"warnings"`
+
+	// WebhookSecret Secret to register with the repository webhook
+	WebhookSecret string `json:"webhook_secret"`
+}
+
+// InstanceLimitsBody defines model for InstanceLimitsBody.
+type InstanceLimitsBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+
+	// BuildMinutesHardQuota Build minutes per user per month before new builds are blocked
+	BuildMinutesHardQuota *int64 `json:"build_minutes_hard_quota,omitempty"`
+
+	// BuildMinutesSoftQuota Build minutes per user per month before warning
+	BuildMinutesSoftQuota *int64 `json:"build_minutes_soft_quota,omitempty"`
+
+	// BuildRetentionDays Days finished builds are kept, with their logs and artifacts, and past deployments; forever when unset
+	BuildRetentionDays *int64 `json:"build_retention_days,omitempty"`
+
+	// ConfigBuildMinutesHardQuota Build minutes per config per month before new builds of the config are blocked
+	ConfigBuildMinutesHardQuota *int64 `json:"config_build_minutes_hard_quota,omitempty"`
+
+	// ConfigBuildMinutesSoftQuota Build minutes per config per month before warning
+	ConfigBuildMinutesSoftQuota *int64 `json:"config_build_minutes_soft_quota,omitempty"`
+
+	// ConfigStorageMbHardQuota Log and artifact storage per config, in MB, before new builds of the config are blocked
+	ConfigStorageMbHardQuota *int64 `json:"config_storage_mb_hard_quota,omitempty"`
+
+	// ConfigStorageMbSoftQuota Log and artifact storage per config, in MB, before warning
+	ConfigStorageMbSoftQuota *int64 `json:"config_storage_mb_soft_quota,omitempty"`
+
+	// DeploysHardQuota Deploys per user per month before new builds are blocked
+	DeploysHardQuota *int64 `json:"deploys_hard_quota,omitempty"`
+
+	// DeploysSoftQuota Deploys per user per month before warning
+	DeploysSoftQuota *int64 `json:"deploys_soft_quota,omitempty"`
+
+	// LogRetentionDays Days build logs are kept; as long as their build when unset
+	LogRetentionDays *int64 `json:"log_retention_days,omitempty"`
+
+	// MaxArtifactMb Artifacts a single build may store, in MB, before it fails
+	MaxArtifactMb *int64 `json:"max_artifact_mb,omitempty"`
+
+	// MaxBuildMinutes Longest a single build may run, unlimited when unset
+	MaxBuildMinutes *int64 `json:"max_build_minutes,omitempty"`
+
+	// MaxConfigsPerUser Most configs a user may create, unlimited when unset
+	MaxConfigsPerUser *int64 `json:"max_configs_per_user,omitempty"`
+
+	// MaxLogMb Log a single build stores, in MB; the rest is truncated
+	MaxLogMb *int64 `json:"max_log_mb,omitempty"`
+
+	// StorageMbHardQuota Log and artifact storage per user, in MB, before new builds are blocked
+	StorageMbHardQuota *int64 `json:"storage_mb_hard_quota,omitempty"`
+
+	// StorageMbSoftQuota Log and artifact storage per user, in MB, before warning
+	StorageMbSoftQuota *int64 `json:"storage_mb_soft_quota,omitempty"`
+}
+
+// ListAdminUsersResponseBody defines model for ListAdminUsersResponseBody.
+type ListAdminUsersResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string              `json:"$schema,omitempty"`
+	Users  *[]AdminUserResponse `json:"users"`
+}
+
+// ListAgentsResponseBody defines model for ListAgentsResponseBody.
+type ListAgentsResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string          `json:"$schema,omitempty"`
+	Agents *[]AgentResponse `json:"agents"`
+}
+
+// ListBuildArtifactsResponseBody defines model for ListBuildArtifactsResponseBody.
+type ListBuildArtifactsResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema    *string             `json:"$schema,omitempty"`
+	Artifacts *[]ArtifactResponse `json:"artifacts"`
+}
+
+// ListConfigDeploymentsResponseBody defines model for ListConfigDeploymentsResponseBody.
+type ListConfigDeploymentsResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema      *string               `json:"$schema,omitempty"`
+	Deployments *[]DeploymentResponse `json:"deployments"`
+
+	// NextBefore Pass as before to fetch the next page, unset on the last page
+	NextBefore *int64 `json:"next_before,omitempty"`
+}
+
+// ListConfigEnvironmentsResponseBody defines model for ListConfigEnvironmentsResponseBody.
+type ListConfigEnvironmentsResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema       *string                `json:"$schema,omitempty"`
+	Environments *[]EnvironmentResponse `json:"environments"`
+}
+
+// ListConfigsResponseBody defines model for ListConfigsResponseBody.
+type ListConfigsResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema  *string           `json:"$schema,omitempty"`
+	Configs *[]ConfigResponse `json:"configs"`
+}
+
+// ListCredentialsResponseBody defines model for ListCredentialsResponseBody.
+type ListCredentialsResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema      *string               `json:"$schema,omitempty"`
+	Credentials *[]CredentialResponse `json:"credentials"`
+}
+
+// ListDeadJobsResponseBody defines model for ListDeadJobsResponseBody.
+type ListDeadJobsResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string            `json:"$schema,omitempty"`
+	Jobs   *[]DeadJobResponse `json:"jobs"`
+}
+
+// ListDeployTokensResponseBody defines model for ListDeployTokensResponseBody.
+type ListDeployTokensResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema       *string                `json:"$schema,omitempty"`
+	DeployTokens *[]DeployTokenResponse `json:"deploy_tokens"`
+}
+
+// ListHooksResponseBody defines model for ListHooksResponseBody.
+type ListHooksResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string         `json:"$schema,omitempty"`
+	Hooks  *[]HookResponse `json:"hooks"`
+}
+
+// ListPoliciesResponseBody defines model for ListPoliciesResponseBody.
+type ListPoliciesResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema   *string           `json:"$schema,omitempty"`
+	Policies *[]PolicyResponse `json:"policies"`
+}
+
+// ListSessionsResponseBody defines model for ListSessionsResponseBody.
+type ListSessionsResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema   *string            `json:"$schema,omitempty"`
+	Sessions *[]SessionResponse `json:"sessions"`
+}
+
+// ListTransfersResponseBody defines model for ListTransfersResponseBody.
+type ListTransfersResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+
+	// Transfers Transfers waiting for you to accept them
+	Transfers *[]TransferResponse `json:"transfers"`
+}
+
+// LoginRequestBody defines model for LoginRequestBody.
+type LoginRequestBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+
+	// Code Two-factor code or recovery code, for accounts with two-factor authentication
+	Code     *string `json:"code,omitempty"`
+	Email    string  `json:"email"`
+	Password string  `json:"password"`
+}
+
+// LoginResponseBody defines model for LoginResponseBody.
+type LoginResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	Token  string  `json:"token"`
+
+	// TwoFactorRequired The password was correct but a two-factor code is required; no token is issued
+	TwoFactorRequired *bool        `json:"two_factor_required,omitempty"`
+	User              UserResponse `json:"user"`
+}
+
+// MeResponseBody defines model for MeResponseBody.
+type MeResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema              *string                       `json:"$schema,omitempty"`
+	Email               string                        `json:"email"`
+	ExpiringCredentials *[]ExpiringCredentialResponse `json:"expiring_credentials"`
+	Id                  string                        `json:"id"`
+	Role                string                        `json:"role"`
+	TwoFactorEnabled    bool                          `json:"two_factor_enabled"`
+}
+
+// NotificationPreferencesBody defines model for NotificationPreferencesBody.
+type NotificationPreferencesBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+
+	// BuildFailed Email when a build fails
+	BuildFailed bool `json:"build_failed"`
+
+	// CredentialExpiry Email before a stored credential expires
+	CredentialExpiry bool `json:"credential_expiry"`
+
+	// DeployFailed Email when a deploy fails
+	DeployFailed bool `json:"deploy_failed"`
+
+	// SlackWebhookUrl Slack incoming webhook that also receives these notifications
+	SlackWebhookUrl *string `json:"slack_webhook_url,omitempty"`
+}
+
+// PipelineStageResponse defines model for PipelineStageResponse.
+type PipelineStageResponse struct {
+	BuildId      *int64     `json:"build_id,omitempty"`
+	DeploymentId *int64     `json:"deployment_id,omitempty"`
+	Error        *string    `json:"error,omitempty"`
+	FinishedAt   *time.Time `json:"finished_at,omitempty"`
+
+	// Kind clone, build, test or deploy
+	Kind string `json:"kind"`
+
+	// Name e.g. clone, build:api, deploy:production
+	Name string `json:"name"`
+
+	// Needs Names of the stages this stage runs after
+	Needs     *[]string  `json:"needs"`
+	StartedAt *time.Time `json:"started_at,omitempty"`
+
+	// Status pending, running, succeeded, failed, canceled or skipped, when an earlier stage failed
+	Status string `json:"status"`
+}
+
+// PolicyResponse defines model for PolicyResponse.
+type PolicyResponse struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema     *string              `json:"$schema,omitempty"`
+	Action     PolicyResponseAction `json:"action"`
+	CreatedAt  time.Time            `json:"created_at"`
+	Expression string               `json:"expression"`
+	Message    string               `json:"message"`
+	Name       string               `json:"name"`
+	UpdatedAt  time.Time            `json:"updated_at"`
+}
+
+// PolicyResponseAction defines model for PolicyResponse.Action.
+type PolicyResponseAction string
+
+// ProvenanceResponse defines model for ProvenanceResponse.
+type ProvenanceResponse struct {
+	CreatedAt     time.Time `json:"created_at"`
+	Digest        string    `json:"digest"`
+	Image         string    `json:"image"`
+	PredicateType string    `json:"predicate_type"`
+
+	// Statement in-toto statement whose subject is the pushed image
+	Statement interface{} `json:"statement"`
+}
+
+// QuotaStatusResponse defines model for QuotaStatusResponse.
+type QuotaStatusResponse struct {
+	Hard   *int64                    `json:"hard,omitempty"`
+	Metric QuotaStatusResponseMetric `json:"metric"`
+	Soft   *int64                    `json:"soft,omitempty"`
+
+	// State warning past the soft quota, exceeded past the hard quota, which blocks new builds
+	State QuotaStatusResponseState `json:"state"`
+	Used  float64                  `json:"used"`
+}
+
+// QuotaStatusResponseMetric defines model for QuotaStatusResponse.Metric.
+type QuotaStatusResponseMetric string
+
+// QuotaStatusResponseState warning past the soft quota, exceeded past the hard quota, which blocks new builds
+type QuotaStatusResponseState string
+
+// RegisterRequestBody defines model for RegisterRequestBody.
+type RegisterRequestBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema   *string `json:"$schema,omitempty"`
+	Email    string  `json:"email"`
+	Password string  `json:"password"`
+}
+
+// RegisterResponseBody defines model for RegisterResponseBody.
+type RegisterResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string      `json:"$schema,omitempty"`
+	Token  string       `json:"token"`
+	User   UserResponse `json:"user"`
+}
+
+// RegistryWebhookResponseBody defines model for RegistryWebhookResponseBody.
+type RegistryWebhookResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+
+	// Deployed Pushed image tags that triggered a redeploy
+	Deployed *[]string `json:"deployed"`
+
+	// Ignored Pushed image tags that were ignored, with the reason
+	Ignored *[]string `json:"ignored"`
+
+	// Queued Pushed image tags handed to a worker, which redeploys when the manifests reference them
+	Queued *[]string `json:"queued"`
+}
+
+// ReplaceCredentialRequestBody defines model for ReplaceCredentialRequestBody.
+type ReplaceCredentialRequestBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+
+	// ExpiresAt Unset for tokens that do not expire
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	Token     string     `json:"token"`
+}
+
+// ReportAgentDeploymentRequestBody defines model for ReportAgentDeploymentRequestBody.
+type ReportAgentDeploymentRequestBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string                                `json:"$schema,omitempty"`
+	Error  *string                                `json:"error,omitempty"`
+	Status ReportAgentDeploymentRequestBodyStatus `json:"status"`
+}
+
+// ReportAgentDeploymentRequestBodyStatus defines model for ReportAgentDeploymentRequestBody.Status.
+type ReportAgentDeploymentRequestBodyStatus string
+
+// ReportAgentDeploymentResponseBody defines model for ReportAgentDeploymentResponseBody.
+type ReportAgentDeploymentResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema  *string `json:"$schema,omitempty"`
+	Success bool    `json:"success"`
+}
+
+// RequestPasswordResetRequestBody defines model for RequestPasswordResetRequestBody.
+type RequestPasswordResetRequestBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	Email  string  `json:"email"`
+}
+
+// RequestPasswordResetResponseBody defines model for RequestPasswordResetResponseBody.
+type RequestPasswordResetResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema  *string `json:"$schema,omitempty"`
+	Success bool    `json:"success"`
+}
+
+// RequeueDeadJobResponseBody defines model for RequeueDeadJobResponseBody.
+type RequeueDeadJobResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema   *string `json:"$schema,omitempty"`
+	Requeued bool    `json:"requeued"`
+}
+
+// ResetPasswordRequestBody defines model for ResetPasswordRequestBody.
+type ResetPasswordRequestBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema   *string `json:"$schema,omitempty"`
+	Password string  `json:"password"`
+	Token    string  `json:"token"`
+}
+
+// ResetPasswordResponseBody defines model for ResetPasswordResponseBody.
+type ResetPasswordResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema  *string `json:"$schema,omitempty"`
+	Success bool    `json:"success"`
+}
+
+// ResourceCondition defines model for ResourceCondition.
+type ResourceCondition struct {
+	Message *string `json:"message,omitempty"`
+	Reason  *string `json:"reason,omitempty"`
+	Status  string  `json:"status"`
+	Type    string  `json:"type"`
+}
+
+// ResourceEvent defines model for ResourceEvent.
+type ResourceEvent struct {
+	Count    int32     `json:"count"`
+	LastSeen time.Time `json:"last_seen"`
+	Message  string    `json:"message"`
+	Reason   string    `json:"reason"`
+	Type     string    `json:"type"`
+}
+
+// ResourceStatus defines model for ResourceStatus.
+type ResourceStatus struct {
+	ApiVersion    string               `json:"api_version"`
+	Conditions    *[]ResourceCondition `json:"conditions"`
+	Error         *string              `json:"error,omitempty"`
+	Events        *[]ResourceEvent     `json:"events"`
+	Exists        bool                 `json:"exists"`
+	Kind          string               `json:"kind"`
+	Name          string               `json:"name"`
+	Namespace     *string              `json:"namespace,omitempty"`
+	Ready         bool                 `json:"ready"`
+	ReadyReplicas *int64               `json:"ready_replicas,omitempty"`
+	Replicas      *int64               `json:"replicas,omitempty"`
+}
+
+// RestoreConfigResponseBody defines model for RestoreConfigResponseBody.
+type RestoreConfigResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string        `json:"$schema,omitempty"`
+	Config ConfigResponse `json:"config"`
+}
+
+// RestoreCredentialResponseBody defines model for RestoreCredentialResponseBody.
+type RestoreCredentialResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema     *string            `json:"$schema,omitempty"`
+	Credential CredentialResponse `json:"credential"`
+}
+
+// RetentionBody defines model for RetentionBody.
+type RetentionBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+
+	// DeletePreviews Delete the tags of a branch's builds once the branch is deleted, e.g. after merging
+	DeletePreviews bool `json:"delete_previews"`
+
+	// KeepLast Builds per branch whose tags are kept, all when unset
+	KeepLast *int64 `json:"keep_last,omitempty"`
+}
+
+// RetentionRunResponse defines model for RetentionRunResponse.
+type RetentionRunResponse struct {
+	BuildsDeleted  int64     `json:"builds_deleted"`
+	BytesReclaimed int64     `json:"bytes_reclaimed"`
+	FinishedAt     time.Time `json:"finished_at"`
+	LogsDeleted    int64     `json:"logs_deleted"`
+	StartedAt      time.Time `json:"started_at"`
+}
+
+// RevokeSessionResponseBody defines model for RevokeSessionResponseBody.
+type RevokeSessionResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema  *string `json:"$schema,omitempty"`
+	Success bool    `json:"success"`
+}
+
+// RollbackDeploymentResponseBody defines model for RollbackDeploymentResponseBody.
+type RollbackDeploymentResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema       *string             `json:"$schema,omitempty"`
+	Deployment   *DeploymentResponse `json:"deployment,omitempty"`
+	RolledBackTo DeploymentResponse  `json:"rolled_back_to"`
+}
+
+// RotateRegistryWebhookResponseBody defines model for RotateRegistryWebhookResponseBody.
+type RotateRegistryWebhookResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+
+	// Token Token to pass as the token query parameter or Authorization header of registry webhooks
+	Token string `json:"token"`
+}
+
+// SecretScanBody defines model for SecretScanBody.
+type SecretScanBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+
+	// Allowlist Accepted findings: rule IDs, e.g. private-key, or path patterns relative to the repository in .dockerignore syntax, e.g. testdata
+	Allowlist *[]string `json:"allowlist"`
+
+	// Enabled Whether builds fail when files of their context look like secrets, before any image is pushed
+	Enabled bool `json:"enabled"`
+}
+
+// SessionResponse defines model for SessionResponse.
+type SessionResponse struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// Current The session this request was made with
+	Current   bool      `json:"current"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Id        string    `json:"id"`
+
+	// Ip Address the login came from
+	Ip         string    `json:"ip"`
+	LastUsedAt time.Time `json:"last_used_at"`
+
+	// UserAgent Client that logged in
+	UserAgent string `json:"user_agent"`
+}
+
+// SetConfigEnvRequestBody defines model for SetConfigEnvRequestBody.
+type SetConfigEnvRequestBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+
+	// Vars Variables to set by name. Builds receive them as build args, nimbul.yaml templates as {{ .ENV.<name> }}. Not for secrets: values are stored and shown in plain text.
+	Vars map[string]string `json:"vars"`
+}
+
+// SetDefaultRegistryCredentialRequestBody defines model for SetDefaultRegistryCredentialRequestBody.
+type SetDefaultRegistryCredentialRequestBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+
+	// CredentialId Stored registry_login credential
+	CredentialId int64 `json:"credential_id"`
+}
+
+// SetDefaultRegistryCredentialResponseBody defines model for SetDefaultRegistryCredentialResponseBody.
+type SetDefaultRegistryCredentialResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema  *string `json:"$schema,omitempty"`
+	Success bool    `json:"success"`
+}
+
+// SetPolicyRequestBody defines model for SetPolicyRequestBody.
+type SetPolicyRequestBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+
+	// Action Whether deploys with manifests that don't comply go ahead with a warning or are blocked before anything is applied
+	Action SetPolicyRequestBodyAction `json:"action"`
+
+	// Expression CEL expression over the manifest, as object, and the repository of the config, as repo, that is true for manifests complying with the policy
+	Expression string `json:"expression"`
+
+	// Message Reported for manifests that don't comply
+	Message *string `json:"message,omitempty"`
+}
+
+// SetPolicyRequestBodyAction Whether deploys with manifests that don't comply go ahead with a warning or are blocked before anything is applied
+type SetPolicyRequestBodyAction string
+
+// StartConfigTransferRequestBody defines model for StartConfigTransferRequestBody.
+type StartConfigTransferRequestBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+
+	// Email Email of the user to transfer the config to
+	Email string `json:"email"`
+}
+
+// StartConfigTransferResponseBody defines model for StartConfigTransferResponseBody.
+type StartConfigTransferResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema   *string          `json:"$schema,omitempty"`
+	Transfer TransferResponse `json:"transfer"`
+}
+
+// StartOIDCLoginResponseBody defines model for StartOIDCLoginResponseBody.
+type StartOIDCLoginResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+
+	// DeviceCode Code to exchange for a session with POST /login/oidc/token
+	DeviceCode string    `json:"device_code"`
+	ExpiresAt  time.Time `json:"expires_at"`
+
+	// Interval Seconds to wait between polls of the identity provider
+	Interval int64 `json:"interval"`
+
+	// UserCode Code the user enters at the verification URI
+	UserCode        string `json:"user_code"`
+	VerificationUri string `json:"verification_uri"`
+
+	// VerificationUriComplete Verification URI with the user code filled in
+	VerificationUriComplete *string `json:"verification_uri_complete,omitempty"`
+}
+
+// StoreCredentialRequestBody defines model for StoreCredentialRequestBody.
+type StoreCredentialRequestBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema    *string   `json:"$schema,omitempty"`
+	ExpiresAt time.Time `json:"expires_at"`
+
+	// ExternalId Client-chosen ID; storing again with it returns the credential stored the first time
+	ExternalId *string `json:"external_id,omitempty"`
+	Provider   string  `json:"provider"`
+	Token      string  `json:"token"`
+	TokenType  string  `json:"token_type"`
+}
+
+// StoreCredentialResponseBody defines model for StoreCredentialResponseBody.
+type StoreCredentialResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema       *string            `json:"$schema,omitempty"`
+	Credential   CredentialResponse `json:"credential"`
+	CredentialId int64              `json:"credential_id"`
+}
+
+// SyncConfigResult defines model for SyncConfigResult.
+type SyncConfigResult struct {
+	Config ConfigResponse `json:"config"`
+
+	// Error Why GitHub could not be checked; the config keeps its previous health
+	Error *string `json:"error,omitempty"`
+
+	// RenamedFrom Previous name of a renamed repository the config now follows
+	RenamedFrom *string `json:"renamed_from,omitempty"`
+}
+
+// SyncConfigsResponseBody defines model for SyncConfigsResponseBody.
+type SyncConfigsResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema  *string             `json:"$schema,omitempty"`
+	Configs *[]SyncConfigResult `json:"configs"`
+}
+
+// TransferResponse defines model for TransferResponse.
+type TransferResponse struct {
+	ConfigId     string    `json:"config_id"`
+	CreatedAt    time.Time `json:"created_at"`
+	FromEmail    string    `json:"from_email"`
+	Id           string    `json:"id"`
+	RepoFullName string    `json:"repo_full_name"`
+	ToEmail      string    `json:"to_email"`
+}
+
+// TrashedConfigResponse defines model for TrashedConfigResponse.
+type TrashedConfigResponse struct {
+	DeletedAt time.Time `json:"deleted_at"`
+	Id        string    `json:"id"`
+
+	// PurgeAt When the config is deleted for good, with its deployments
+	PurgeAt      time.Time `json:"purge_at"`
+	RepoFullName string    `json:"repo_full_name"`
+}
+
+// TrashedCredentialResponse defines model for TrashedCredentialResponse.
+type TrashedCredentialResponse struct {
+	DeletedAt time.Time `json:"deleted_at"`
+	Id        int64     `json:"id"`
+	Provider  string    `json:"provider"`
+
+	// PurgeAt When the credential is deleted for good
+	PurgeAt   time.Time `json:"purge_at"`
+	TokenType string    `json:"token_type"`
+}
+
+// TriggerDeployRequestBody defines model for TriggerDeployRequestBody.
+type TriggerDeployRequestBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+
+	// Branch Branch to build and deploy the head of
+	Branch string `json:"branch"`
+
+	// CommitSha Commit the branch has to be at, e.g. the commit the CI run tested; the trigger fails when the branch moved on
+	CommitSha *string `json:"commit_sha,omitempty"`
+}
+
+// TriggerDeployResponseBody defines model for TriggerDeployResponseBody.
+type TriggerDeployResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema    *string `json:"$schema,omitempty"`
+	CommitSha string  `json:"commit_sha"`
+	ConfigId  string  `json:"config_id"`
+
+	// Queued The run was handed to a worker; otherwise it ran before the response
+	Queued bool   `json:"queued"`
+	Ref    string `json:"ref"`
+}
+
+// UnsetConfigEnvResponseBody defines model for UnsetConfigEnvResponseBody.
+type UnsetConfigEnvResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema  *string `json:"$schema,omitempty"`
+	Success bool    `json:"success"`
+}
+
+// UpdateAdminUserRequestBody defines model for UpdateAdminUserRequestBody.
+type UpdateAdminUserRequestBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+
+	// Disabled Disable or re-enable the account
+	Disabled *bool `json:"disabled,omitempty"`
+
+	// Role New role of the account
+	Role *UpdateAdminUserRequestBodyRole `json:"role,omitempty"`
+}
+
+// UpdateAdminUserRequestBodyRole New role of the account
+type UpdateAdminUserRequestBodyRole string
+
+// UpdateConfigAgentRequestBody defines model for UpdateConfigAgentRequestBody.
+type UpdateConfigAgentRequestBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema  *string `json:"$schema,omitempty"`
+	AgentId string  `json:"agent_id"`
+}
+
+// UpdateConfigAgentResponseBody defines model for UpdateConfigAgentResponseBody.
+type UpdateConfigAgentResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema  *string `json:"$schema,omitempty"`
+	Success bool    `json:"success"`
+}
+
+// UpdateConfigClusterRequestBody defines model for UpdateConfigClusterRequestBody.
+type UpdateConfigClusterRequestBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema       *string `json:"$schema,omitempty"`
+	CredentialId int64   `json:"credential_id"`
+}
+
+// UpdateConfigClusterResponseBody defines model for UpdateConfigClusterResponseBody.
+type UpdateConfigClusterResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema  *string `json:"$schema,omitempty"`
+	Success bool    `json:"success"`
+}
+
+// UpdateConfigRequestBody defines model for UpdateConfigRequestBody.
+type UpdateConfigRequestBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema         *string `json:"$schema,omitempty"`
+	DockerfilePath *string `json:"dockerfile_path,omitempty"`
+	RepoCloneUrl   *string `json:"repo_clone_url,omitempty"`
+	WebhookSecret  *string `json:"webhook_secret,omitempty"`
+}
+
+// UpdateConfigResponseBody defines model for UpdateConfigResponseBody.
+type UpdateConfigResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string        `json:"$schema,omitempty"`
+	Config ConfigResponse `json:"config"`
+}
+
+// UpdateConfigWebhookRequestBody defines model for UpdateConfigWebhookRequestBody.
+type UpdateConfigWebhookRequestBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema    *string `json:"$schema,omitempty"`
+	WebhookId int64   `json:"webhook_id"`
+}
+
+// UpdateConfigWebhookResponseBody defines model for UpdateConfigWebhookResponseBody.
+type UpdateConfigWebhookResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema  *string `json:"$schema,omitempty"`
+	Success bool    `json:"success"`
+}
+
+// UpdateGitHubAppRequestBody defines model for UpdateGitHubAppRequestBody.
+type UpdateGitHubAppRequestBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	AppId  int64   `json:"app_id"`
+
+	// ClientId Client ID of the app, used by 'nimbul connect'
+	ClientId *string `json:"client_id,omitempty"`
+
+	// ClientSecret Client secret of the app, used to refresh GitHub tokens; the stored one is kept when omitted
+	ClientSecret *string `json:"client_secret,omitempty"`
+
+	// PrivateKey PEM encoded private key of the app; the stored one is kept when omitted
+	PrivateKey *string `json:"private_key,omitempty"`
+
+	// Slug From the app's URL, github.com/apps/<slug>
+	Slug string `json:"slug"`
+
+	// WebhookSecret Secret of the app's webhook, which receives installation events; the stored one is kept when omitted
+	WebhookSecret *string `json:"webhook_secret,omitempty"`
+}
+
+// UpdateMeRequestBody defines model for UpdateMeRequestBody.
+type UpdateMeRequestBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+
+	// CurrentPassword Required to change the email or the password
+	CurrentPassword string `json:"current_password"`
+
+	// Email New email; it changes once the token emailed to it is confirmed
+	Email *string `json:"email,omitempty"`
+
+	// NewPassword New password; other sessions are logged out
+	NewPassword *string `json:"new_password,omitempty"`
+}
+
+// UpdateMeResponseBody defines model for UpdateMeResponseBody.
+type UpdateMeResponseBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema          *string `json:"$schema,omitempty"`
+	PasswordChanged bool    `json:"password_changed"`
+
+	// PendingEmail Email waiting to be confirmed with POST /email-change/confirm
+	PendingEmail *string      `json:"pending_email,omitempty"`
+	User         UserResponse `json:"user"`
+}
+
+// UserResponse defines model for UserResponse.
+type UserResponse struct {
+	Email string `json:"email"`
+	Id    string `json:"id"`
+	Role  string `json:"role"`
+}
+
+// UserRetentionBody defines model for UserRetentionBody.
+type UserRetentionBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+
+	// BuildRetentionDays Days the user's finished builds are kept; the instance setting when unset
+	BuildRetentionDays *int64 `json:"build_retention_days,omitempty"`
+
+	// LogRetentionDays Days the user's build logs are kept; the instance setting when unset
+	LogRetentionDays *int64 `json:"log_retention_days,omitempty"`
+}
+
+// GetActivityParams defines parameters for GetActivity.
+type GetActivityParams struct {
+	// ConfigId Only the activity of this config
+	ConfigId *string `form:"config_id,omitempty" json:"config_id,omitempty"`
+
+	// Limit Number of most recent entries to return
+	Limit         *int64  `form:"limit,omitempty" json:"limit,omitempty"`
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetAdminActivityParams defines parameters for GetAdminActivity.
+type GetAdminActivityParams struct {
+	// Limit Number of most recent deployments to return
+	Limit         *int64  `form:"limit,omitempty" json:"limit,omitempty"`
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// DeleteAdminGithubAppParams defines parameters for DeleteAdminGithubApp.
+type DeleteAdminGithubAppParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetAdminGithubAppParams defines parameters for GetAdminGithubApp.
+type GetAdminGithubAppParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PutAdminGithubAppParams defines parameters for PutAdminGithubApp.
+type PutAdminGithubAppParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetAdminLimitsParams defines parameters for GetAdminLimits.
+type GetAdminLimitsParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PutAdminLimitsParams defines parameters for PutAdminLimits.
+type PutAdminLimitsParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetAdminRetentionParams defines parameters for GetAdminRetention.
+type GetAdminRetentionParams struct {
+	// Limit How many of the latest runs to return
+	Limit         *int64  `form:"limit,omitempty" json:"limit,omitempty"`
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetAdminUsersParams defines parameters for GetAdminUsers.
+type GetAdminUsersParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PatchAdminUsersByIdParams defines parameters for PatchAdminUsersById.
+type PatchAdminUsersByIdParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetAdminUsersByIdRetentionParams defines parameters for GetAdminUsersByIdRetention.
+type GetAdminUsersByIdRetentionParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PutAdminUsersByIdRetentionParams defines parameters for PutAdminUsersByIdRetention.
+type PutAdminUsersByIdRetentionParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetAgentDeploymentsNextParams defines parameters for GetAgentDeploymentsNext.
+type GetAgentDeploymentsNextParams struct {
+	// Wait Seconds to wait for a deployment before returning empty
+	Wait          *int64  `form:"wait,omitempty" json:"wait,omitempty"`
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PostAgentDeploymentsByIdStatusParams defines parameters for PostAgentDeploymentsByIdStatus.
+type PostAgentDeploymentsByIdStatusParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetAgentsParams defines parameters for GetAgents.
+type GetAgentsParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PostAgentsParams defines parameters for PostAgents.
+type PostAgentsParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetBuildsByIdParams defines parameters for GetBuildsById.
+type GetBuildsByIdParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetBuildsByIdArtifactsParams defines parameters for GetBuildsByIdArtifacts.
+type GetBuildsByIdArtifactsParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetBuildsByIdArtifactsByNameParams defines parameters for GetBuildsByIdArtifactsByName.
+type GetBuildsByIdArtifactsByNameParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetBuildsByIdConfigParams defines parameters for GetBuildsByIdConfig.
+type GetBuildsByIdConfigParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetBuildsByIdLogsParams defines parameters for GetBuildsByIdLogs.
+type GetBuildsByIdLogsParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetBuildsByIdPipelineParams defines parameters for GetBuildsByIdPipeline.
+type GetBuildsByIdPipelineParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetBuildsByIdProvenanceParams defines parameters for GetBuildsByIdProvenance.
+type GetBuildsByIdProvenanceParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetConfigsParams defines parameters for GetConfigs.
+type GetConfigsParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PostConfigsParams defines parameters for PostConfigs.
+type PostConfigsParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PostConfigsImportParams defines parameters for PostConfigsImport.
+type PostConfigsImportParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PostConfigsSyncParams defines parameters for PostConfigsSync.
+type PostConfigsSyncParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// DeleteConfigsByIdParams defines parameters for DeleteConfigsById.
+type DeleteConfigsByIdParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+
+	// IfMatch ETag the config must still have
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// GetConfigsByIdParams defines parameters for GetConfigsById.
+type GetConfigsByIdParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PatchConfigsByIdParams defines parameters for PatchConfigsById.
+type PatchConfigsByIdParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+
+	// IfMatch ETag the config must still have
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// PatchConfigsByIdAgentParams defines parameters for PatchConfigsByIdAgent.
+type PatchConfigsByIdAgentParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PatchConfigsByIdClusterParams defines parameters for PatchConfigsByIdCluster.
+type PatchConfigsByIdClusterParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetConfigsByIdDeniedAuthorsParams defines parameters for GetConfigsByIdDeniedAuthors.
+type GetConfigsByIdDeniedAuthorsParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PutConfigsByIdDeniedAuthorsParams defines parameters for PutConfigsByIdDeniedAuthors.
+type PutConfigsByIdDeniedAuthorsParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetConfigsByIdDeployTokensParams defines parameters for GetConfigsByIdDeployTokens.
+type GetConfigsByIdDeployTokensParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PostConfigsByIdDeployTokensParams defines parameters for PostConfigsByIdDeployTokens.
+type PostConfigsByIdDeployTokensParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// DeleteConfigsByIdDeployTokensByTokenIdParams defines parameters for DeleteConfigsByIdDeployTokensByTokenId.
+type DeleteConfigsByIdDeployTokensByTokenIdParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetConfigsByIdDeploymentsParams defines parameters for GetConfigsByIdDeployments.
+type GetConfigsByIdDeploymentsParams struct {
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Before Only return deployments older than this deployment ID, for fetching the next page
+	Before        *int64  `form:"before,omitempty" json:"before,omitempty"`
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetConfigsByIdEnvParams defines parameters for GetConfigsByIdEnv.
+type GetConfigsByIdEnvParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PostConfigsByIdEnvParams defines parameters for PostConfigsByIdEnv.
+type PostConfigsByIdEnvParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// DeleteConfigsByIdEnvByNameParams defines parameters for DeleteConfigsByIdEnvByName.
+type DeleteConfigsByIdEnvByNameParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetConfigsByIdEnvironmentsParams defines parameters for GetConfigsByIdEnvironments.
+type GetConfigsByIdEnvironmentsParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetConfigsByIdExportParams defines parameters for GetConfigsByIdExport.
+type GetConfigsByIdExportParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// DeleteConfigsByIdRegistryWebhookParams defines parameters for DeleteConfigsByIdRegistryWebhook.
+type DeleteConfigsByIdRegistryWebhookParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PostConfigsByIdRegistryWebhookParams defines parameters for PostConfigsByIdRegistryWebhook.
+type PostConfigsByIdRegistryWebhookParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PostConfigsByIdRestoreParams defines parameters for PostConfigsByIdRestore.
+type PostConfigsByIdRestoreParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetConfigsByIdRetentionParams defines parameters for GetConfigsByIdRetention.
+type GetConfigsByIdRetentionParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PutConfigsByIdRetentionParams defines parameters for PutConfigsByIdRetention.
+type PutConfigsByIdRetentionParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetConfigsByIdSecretScanParams defines parameters for GetConfigsByIdSecretScan.
+type GetConfigsByIdSecretScanParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PutConfigsByIdSecretScanParams defines parameters for PutConfigsByIdSecretScan.
+type PutConfigsByIdSecretScanParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// DeleteConfigsByIdTransferParams defines parameters for DeleteConfigsByIdTransfer.
+type DeleteConfigsByIdTransferParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PostConfigsByIdTransferParams defines parameters for PostConfigsByIdTransfer.
+type PostConfigsByIdTransferParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PatchConfigsByIdWebhookParams defines parameters for PatchConfigsByIdWebhook.
+type PatchConfigsByIdWebhookParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PostConfigsByIdWebhookParams defines parameters for PostConfigsByIdWebhook.
+type PostConfigsByIdWebhookParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetCredentialsParams defines parameters for GetCredentials.
+type GetCredentialsParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PostCredentialsParams defines parameters for PostCredentials.
+type PostCredentialsParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetCredentialsGithubInstallationTokenParams defines parameters for GetCredentialsGithubInstallationToken.
+type GetCredentialsGithubInstallationTokenParams struct {
+	// ConfigId Config whose repository the token is for
+	ConfigId      string  `form:"config_id" json:"config_id"`
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetCredentialsGithubTokenParams defines parameters for GetCredentialsGithubToken.
+type GetCredentialsGithubTokenParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// DeleteCredentialsByIdParams defines parameters for DeleteCredentialsById.
+type DeleteCredentialsByIdParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+
+	// IfMatch ETag the credential must still have
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// GetCredentialsByIdParams defines parameters for GetCredentialsById.
+type GetCredentialsByIdParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PutCredentialsByIdParams defines parameters for PutCredentialsById.
+type PutCredentialsByIdParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+
+	// IfMatch ETag the credential must still have
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// PostCredentialsByIdRestoreParams defines parameters for PostCredentialsByIdRestore.
+type PostCredentialsByIdRestoreParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetDeployStatusParams defines parameters for GetDeployStatus.
+type GetDeployStatusParams struct {
+	// CommitSha Latest deployment of this commit instead of the latest one
+	CommitSha     *string `form:"commit_sha,omitempty" json:"commit_sha,omitempty"`
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PostDeployTriggerParams defines parameters for PostDeployTrigger.
+type PostDeployTriggerParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetDeploymentsByIdManifestsParams defines parameters for GetDeploymentsByIdManifests.
+type GetDeploymentsByIdManifestsParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetDeploymentsByIdResourcesParams defines parameters for GetDeploymentsByIdResources.
+type GetDeploymentsByIdResourcesParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PostDeploymentsByIdRollbackParams defines parameters for PostDeploymentsByIdRollback.
+type PostDeploymentsByIdRollbackParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetGithubAppParams defines parameters for GetGithubApp.
+type GetGithubAppParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PostGithubInstallationsByIdCheckParams defines parameters for PostGithubInstallationsByIdCheck.
+type PostGithubInstallationsByIdCheckParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PostGithubReposByOwnerByRepoBootstrapParams defines parameters for PostGithubReposByOwnerByRepoBootstrap.
+type PostGithubReposByOwnerByRepoBootstrapParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetHooksParams defines parameters for GetHooks.
+type GetHooksParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PostHooksParams defines parameters for PostHooks.
+type PostHooksParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// DeleteHooksByIdParams defines parameters for DeleteHooksById.
+type DeleteHooksByIdParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetJobsDeadParams defines parameters for GetJobsDead.
+type GetJobsDeadParams struct {
+	// All List the dead jobs of every user, admins only
+	All           *bool   `form:"all,omitempty" json:"all,omitempty"`
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PostJobsDeadByIdRequeueParams defines parameters for PostJobsDeadByIdRequeue.
+type PostJobsDeadByIdRequeueParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// DeleteMeParams defines parameters for DeleteMe.
+type DeleteMeParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetMeParams defines parameters for GetMe.
+type GetMeParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PatchMeParams defines parameters for PatchMe.
+type PatchMeParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PostMe2FaParams defines parameters for PostMe2Fa.
+type PostMe2FaParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PostMe2FaConfirmParams defines parameters for PostMe2FaConfirm.
+type PostMe2FaConfirmParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PostMe2FaDisableParams defines parameters for PostMe2FaDisable.
+type PostMe2FaDisableParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetMeNotificationsParams defines parameters for GetMeNotifications.
+type GetMeNotificationsParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PutMeNotificationsParams defines parameters for PutMeNotifications.
+type PutMeNotificationsParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// DeleteMeRegistryCredentialParams defines parameters for DeleteMeRegistryCredential.
+type DeleteMeRegistryCredentialParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PutMeRegistryCredentialParams defines parameters for PutMeRegistryCredential.
+type PutMeRegistryCredentialParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetPoliciesParams defines parameters for GetPolicies.
+type GetPoliciesParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// DeletePoliciesByNameParams defines parameters for DeletePoliciesByName.
+type DeletePoliciesByNameParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PutPoliciesByNameParams defines parameters for PutPoliciesByName.
+type PutPoliciesByNameParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetProvidersParams defines parameters for GetProviders.
+type GetProvidersParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetSessionsParams defines parameters for GetSessions.
+type GetSessionsParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// DeleteSessionsByIdParams defines parameters for DeleteSessionsById.
+type DeleteSessionsByIdParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetTransfersParams defines parameters for GetTransfers.
+type GetTransfersParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PostTransfersByIdAcceptParams defines parameters for PostTransfersByIdAccept.
+type PostTransfersByIdAcceptParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PostTransfersByIdDeclineParams defines parameters for PostTransfersByIdDecline.
+type PostTransfersByIdDeclineParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetTrashParams defines parameters for GetTrash.
+type GetTrashParams struct {
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetUsageParams defines parameters for GetUsage.
+type GetUsageParams struct {
+	// UserId User to report on, admins only; defaults to the caller
+	UserId        *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PostWebhooksGithubAppJSONBody defines parameters for PostWebhooksGithubApp.
+type PostWebhooksGithubAppJSONBody = interface{}
+
+// PostWebhooksGithubAppParams defines parameters for PostWebhooksGithubApp.
+type PostWebhooksGithubAppParams struct {
+	XHubSignature256 *string `json:"X-Hub-Signature-256,omitempty"`
+	XGitHubEvent     *string `json:"X-GitHub-Event,omitempty"`
+	XGitHubDelivery  *string `json:"X-GitHub-Delivery,omitempty"`
+}
+
+// PostWebhooksGithubByIdJSONBody defines parameters for PostWebhooksGithubById.
+type PostWebhooksGithubByIdJSONBody = interface{}
+
+// PostWebhooksGithubByIdParams defines parameters for PostWebhooksGithubById.
+type PostWebhooksGithubByIdParams struct {
+	XHubSignature   *string `json:"X-Hub-Signature,omitempty"`
+	XGitHubHookID   *int64  `json:"X-GitHub-Hook-ID,omitempty"`
+	XGitHubEvent    *string `json:"X-GitHub-Event,omitempty"`
+	XGitHubDelivery *string `json:"X-GitHub-Delivery,omitempty"`
+}
+
+// PostWebhooksRegistryByIdParams defines parameters for PostWebhooksRegistryById.
+type PostWebhooksRegistryByIdParams struct {
+	Token         *string `form:"token,omitempty" json:"token,omitempty"`
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// PutAdminGithubAppJSONRequestBody defines body for PutAdminGithubApp for application/json ContentType.
+type PutAdminGithubAppJSONRequestBody = UpdateGitHubAppRequestBody
+
+// PutAdminLimitsJSONRequestBody defines body for PutAdminLimits for application/json ContentType.
+type PutAdminLimitsJSONRequestBody = InstanceLimitsBody
+
+// PatchAdminUsersByIdJSONRequestBody defines body for PatchAdminUsersById for application/json ContentType.
+type PatchAdminUsersByIdJSONRequestBody = UpdateAdminUserRequestBody
+
+// PutAdminUsersByIdRetentionJSONRequestBody defines body for PutAdminUsersByIdRetention for application/json ContentType.
+type PutAdminUsersByIdRetentionJSONRequestBody = UserRetentionBody
+
+// PostAgentDeploymentsByIdStatusJSONRequestBody defines body for PostAgentDeploymentsByIdStatus for application/json ContentType.
+type PostAgentDeploymentsByIdStatusJSONRequestBody = ReportAgentDeploymentRequestBody
+
+// PostAgentsJSONRequestBody defines body for PostAgents for application/json ContentType.
+type PostAgentsJSONRequestBody = CreateAgentRequestBody
+
+// PostConfigsJSONRequestBody defines body for PostConfigs for application/json ContentType.
+type PostConfigsJSONRequestBody = CreateConfigRequestBody
+
+// PostConfigsImportJSONRequestBody defines body for PostConfigsImport for application/json ContentType.
+type PostConfigsImportJSONRequestBody = Bundle
+
+// PatchConfigsByIdJSONRequestBody defines body for PatchConfigsById for application/json ContentType.
+type PatchConfigsByIdJSONRequestBody = UpdateConfigRequestBody
+
+// PatchConfigsByIdAgentJSONRequestBody defines body for PatchConfigsByIdAgent for application/json ContentType.
+type PatchConfigsByIdAgentJSONRequestBody = UpdateConfigAgentRequestBody
+
+// PatchConfigsByIdClusterJSONRequestBody defines body for PatchConfigsByIdCluster for application/json ContentType.
+type PatchConfigsByIdClusterJSONRequestBody = UpdateConfigClusterRequestBody
+
+// PutConfigsByIdDeniedAuthorsJSONRequestBody defines body for PutConfigsByIdDeniedAuthors for application/json ContentType.
+type PutConfigsByIdDeniedAuthorsJSONRequestBody = DeniedAuthorsBody
+
+// PostConfigsByIdDeployTokensJSONRequestBody defines body for PostConfigsByIdDeployTokens for application/json ContentType.
+type PostConfigsByIdDeployTokensJSONRequestBody = CreateDeployTokenRequestBody
+
+// PostConfigsByIdEnvJSONRequestBody defines body for PostConfigsByIdEnv for application/json ContentType.
+type PostConfigsByIdEnvJSONRequestBody = SetConfigEnvRequestBody
+
+// PutConfigsByIdRetentionJSONRequestBody defines body for PutConfigsByIdRetention for application/json ContentType.
+type PutConfigsByIdRetentionJSONRequestBody = RetentionBody
+
+// PutConfigsByIdSecretScanJSONRequestBody defines body for PutConfigsByIdSecretScan for application/json ContentType.
+type PutConfigsByIdSecretScanJSONRequestBody = SecretScanBody
+
+// PostConfigsByIdTransferJSONRequestBody defines body for PostConfigsByIdTransfer for application/json ContentType.
+type PostConfigsByIdTransferJSONRequestBody = StartConfigTransferRequestBody
+
+// PatchConfigsByIdWebhookJSONRequestBody defines body for PatchConfigsByIdWebhook for application/json ContentType.
+type PatchConfigsByIdWebhookJSONRequestBody = UpdateConfigWebhookRequestBody
+
+// PostConfigsByIdWebhookJSONRequestBody defines body for PostConfigsByIdWebhook for application/json ContentType.
+type PostConfigsByIdWebhookJSONRequestBody = CreateConfigWebhookRequestBody
+
+// PostCredentialsJSONRequestBody defines body for PostCredentials for application/json ContentType.
+type PostCredentialsJSONRequestBody = StoreCredentialRequestBody
+
+// PutCredentialsByIdJSONRequestBody defines body for PutCredentialsById for application/json ContentType.
+type PutCredentialsByIdJSONRequestBody = ReplaceCredentialRequestBody
+
+// PostDeployTriggerJSONRequestBody defines body for PostDeployTrigger for application/json ContentType.
+type PostDeployTriggerJSONRequestBody = TriggerDeployRequestBody
+
+// PostEmailChangeConfirmJSONRequestBody defines body for PostEmailChangeConfirm for application/json ContentType.
+type PostEmailChangeConfirmJSONRequestBody = ConfirmEmailChangeRequestBody
+
+// PostGithubReposByOwnerByRepoBootstrapJSONRequestBody defines body for PostGithubReposByOwnerByRepoBootstrap for application/json ContentType.
+type PostGithubReposByOwnerByRepoBootstrapJSONRequestBody = BootstrapRepositoryRequestBody
+
+// PostHooksJSONRequestBody defines body for PostHooks for application/json ContentType.
+type PostHooksJSONRequestBody = CreateHookRequestBody
+
+// PostLoginJSONRequestBody defines body for PostLogin for application/json ContentType.
+type PostLoginJSONRequestBody = LoginRequestBody
+
+// PostLoginOidcTokenJSONRequestBody defines body for PostLoginOidcToken for application/json ContentType.
+type PostLoginOidcTokenJSONRequestBody = CompleteOIDCLoginRequestBody
+
+// DeleteMeJSONRequestBody defines body for DeleteMe for application/json ContentType.
+type DeleteMeJSONRequestBody = DeleteMeRequestBody
+
+// PatchMeJSONRequestBody defines body for PatchMe for application/json ContentType.
+type PatchMeJSONRequestBody = UpdateMeRequestBody
+
+// PostMe2FaConfirmJSONRequestBody defines body for PostMe2FaConfirm for application/json ContentType.
+type PostMe2FaConfirmJSONRequestBody = ConfirmTwoFactorRequestBody
+
+// PostMe2FaDisableJSONRequestBody defines body for PostMe2FaDisable for application/json ContentType.
+type PostMe2FaDisableJSONRequestBody = DisableTwoFactorRequestBody
+
+// PutMeNotificationsJSONRequestBody defines body for PutMeNotifications for application/json ContentType.
+type PutMeNotificationsJSONRequestBody = NotificationPreferencesBody
+
+// PutMeRegistryCredentialJSONRequestBody defines body for PutMeRegistryCredential for application/json ContentType.
+type PutMeRegistryCredentialJSONRequestBody = SetDefaultRegistryCredentialRequestBody
+
+// PostPasswordResetJSONRequestBody defines body for PostPasswordReset for application/json ContentType.
+type PostPasswordResetJSONRequestBody = RequestPasswordResetRequestBody
+
+// PostPasswordResetConfirmJSONRequestBody defines body for PostPasswordResetConfirm for application/json ContentType.
+type PostPasswordResetConfirmJSONRequestBody = ResetPasswordRequestBody
+
+// PutPoliciesByNameJSONRequestBody defines body for PutPoliciesByName for application/json ContentType.
+type PutPoliciesByNameJSONRequestBody = SetPolicyRequestBody
+
+// PostRegisterJSONRequestBody defines body for PostRegister for application/json ContentType.
+type PostRegisterJSONRequestBody = RegisterRequestBody
+
+// PostWebhooksGithubAppJSONRequestBody defines body for PostWebhooksGithubApp for application/json ContentType.
+type PostWebhooksGithubAppJSONRequestBody = PostWebhooksGithubAppJSONBody
+
+// PostWebhooksGithubByIdJSONRequestBody defines body for PostWebhooksGithubById for application/json ContentType.
+type PostWebhooksGithubByIdJSONRequestBody = PostWebhooksGithubByIdJSONBody
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// GetActivity request
+	GetActivity(ctx context.Context, params *GetActivityParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminActivity request
+	GetAdminActivity(ctx context.Context, params *GetAdminActivityParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAdminGithubApp request
+	DeleteAdminGithubApp(ctx context.Context, params *DeleteAdminGithubAppParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminGithubApp request
+	GetAdminGithubApp(ctx context.Context, params *GetAdminGithubAppParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutAdminGithubAppWithBody request with any body
+	PutAdminGithubAppWithBody(ctx context.Context, params *PutAdminGithubAppParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutAdminGithubApp(ctx context.Context, params *PutAdminGithubAppParams, body PutAdminGithubAppJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminLimits request
+	GetAdminLimits(ctx context.Context, params *GetAdminLimitsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutAdminLimitsWithBody request with any body
+	PutAdminLimitsWithBody(ctx context.Context, params *PutAdminLimitsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutAdminLimits(ctx context.Context, params *PutAdminLimitsParams, body PutAdminLimitsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminRetention request
+	GetAdminRetention(ctx context.Context, params *GetAdminRetentionParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminUsers request
+	GetAdminUsers(ctx context.Context, params *GetAdminUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchAdminUsersByIdWithBody request with any body
+	PatchAdminUsersByIdWithBody(ctx context.Context, id string, params *PatchAdminUsersByIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchAdminUsersById(ctx context.Context, id string, params *PatchAdminUsersByIdParams, body PatchAdminUsersByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminUsersByIdRetention request
+	GetAdminUsersByIdRetention(ctx context.Context, id string, params *GetAdminUsersByIdRetentionParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutAdminUsersByIdRetentionWithBody request with any body
+	PutAdminUsersByIdRetentionWithBody(ctx context.Context, id string, params *PutAdminUsersByIdRetentionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutAdminUsersByIdRetention(ctx context.Context, id string, params *PutAdminUsersByIdRetentionParams, body PutAdminUsersByIdRetentionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAgentDeploymentsNext request
+	GetAgentDeploymentsNext(ctx context.Context, params *GetAgentDeploymentsNextParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAgentDeploymentsByIdStatusWithBody request with any body
+	PostAgentDeploymentsByIdStatusWithBody(ctx context.Context, id int64, params *PostAgentDeploymentsByIdStatusParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAgentDeploymentsByIdStatus(ctx context.Context, id int64, params *PostAgentDeploymentsByIdStatusParams, body PostAgentDeploymentsByIdStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAgents request
+	GetAgents(ctx context.Context, params *GetAgentsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAgentsWithBody request with any body
+	PostAgentsWithBody(ctx context.Context, params *PostAgentsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAgents(ctx context.Context, params *PostAgentsParams, body PostAgentsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBuildsById request
+	GetBuildsById(ctx context.Context, id int64, params *GetBuildsByIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBuildsByIdArtifacts request
+	GetBuildsByIdArtifacts(ctx context.Context, id int64, params *GetBuildsByIdArtifactsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBuildsByIdArtifactsByName request
+	GetBuildsByIdArtifactsByName(ctx context.Context, id int64, name string, params *GetBuildsByIdArtifactsByNameParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBuildsByIdConfig request
+	GetBuildsByIdConfig(ctx context.Context, id int64, params *GetBuildsByIdConfigParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBuildsByIdLogs request
+	GetBuildsByIdLogs(ctx context.Context, id int64, params *GetBuildsByIdLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBuildsByIdPipeline request
+	GetBuildsByIdPipeline(ctx context.Context, id int64, params *GetBuildsByIdPipelineParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBuildsByIdProvenance request
+	GetBuildsByIdProvenance(ctx context.Context, id int64, params *GetBuildsByIdProvenanceParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCliRelease request
+	GetCliRelease(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetConfigs request
+	GetConfigs(ctx context.Context, params *GetConfigsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostConfigsWithBody request with any body
+	PostConfigsWithBody(ctx context.Context, params *PostConfigsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostConfigs(ctx context.Context, params *PostConfigsParams, body PostConfigsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostConfigsImportWithBody request with any body
+	PostConfigsImportWithBody(ctx context.Context, params *PostConfigsImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostConfigsImport(ctx context.Context, params *PostConfigsImportParams, body PostConfigsImportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostConfigsSync request
+	PostConfigsSync(ctx context.Context, params *PostConfigsSyncParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteConfigsById request
+	DeleteConfigsById(ctx context.Context, id string, params *DeleteConfigsByIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetConfigsById request
+	GetConfigsById(ctx context.Context, id string, params *GetConfigsByIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchConfigsByIdWithBody request with any body
+	PatchConfigsByIdWithBody(ctx context.Context, id string, params *PatchConfigsByIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchConfigsById(ctx context.Context, id string, params *PatchConfigsByIdParams, body PatchConfigsByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchConfigsByIdAgentWithBody request with any body
+	PatchConfigsByIdAgentWithBody(ctx context.Context, id string, params *PatchConfigsByIdAgentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchConfigsByIdAgent(ctx context.Context, id string, params *PatchConfigsByIdAgentParams, body PatchConfigsByIdAgentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchConfigsByIdClusterWithBody request with any body
+	PatchConfigsByIdClusterWithBody(ctx context.Context, id string, params *PatchConfigsByIdClusterParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchConfigsByIdCluster(ctx context.Context, id string, params *PatchConfigsByIdClusterParams, body PatchConfigsByIdClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetConfigsByIdDeniedAuthors request
+	GetConfigsByIdDeniedAuthors(ctx context.Context, id string, params *GetConfigsByIdDeniedAuthorsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutConfigsByIdDeniedAuthorsWithBody request with any body
+	PutConfigsByIdDeniedAuthorsWithBody(ctx context.Context, id string, params *PutConfigsByIdDeniedAuthorsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutConfigsByIdDeniedAuthors(ctx context.Context, id string, params *PutConfigsByIdDeniedAuthorsParams, body PutConfigsByIdDeniedAuthorsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetConfigsByIdDeployTokens request
+	GetConfigsByIdDeployTokens(ctx context.Context, id string, params *GetConfigsByIdDeployTokensParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostConfigsByIdDeployTokensWithBody request with any body
+	PostConfigsByIdDeployTokensWithBody(ctx context.Context, id string, params *PostConfigsByIdDeployTokensParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostConfigsByIdDeployTokens(ctx context.Context, id string, params *PostConfigsByIdDeployTokensParams, body PostConfigsByIdDeployTokensJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteConfigsByIdDeployTokensByTokenId request
+	DeleteConfigsByIdDeployTokensByTokenId(ctx context.Context, id string, tokenId string, params *DeleteConfigsByIdDeployTokensByTokenIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetConfigsByIdDeployments request
+	GetConfigsByIdDeployments(ctx context.Context, id string, params *GetConfigsByIdDeploymentsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetConfigsByIdEnv request
+	GetConfigsByIdEnv(ctx context.Context, id string, params *GetConfigsByIdEnvParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostConfigsByIdEnvWithBody request with any body
+	PostConfigsByIdEnvWithBody(ctx context.Context, id string, params *PostConfigsByIdEnvParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostConfigsByIdEnv(ctx context.Context, id string, params *PostConfigsByIdEnvParams, body PostConfigsByIdEnvJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteConfigsByIdEnvByName request
+	DeleteConfigsByIdEnvByName(ctx context.Context, id string, name string, params *DeleteConfigsByIdEnvByNameParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetConfigsByIdEnvironments request
+	GetConfigsByIdEnvironments(ctx context.Context, id string, params *GetConfigsByIdEnvironmentsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetConfigsByIdExport request
+	GetConfigsByIdExport(ctx context.Context, id string, params *GetConfigsByIdExportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteConfigsByIdRegistryWebhook request
+	DeleteConfigsByIdRegistryWebhook(ctx context.Context, id string, params *DeleteConfigsByIdRegistryWebhookParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostConfigsByIdRegistryWebhook request
+	PostConfigsByIdRegistryWebhook(ctx context.Context, id string, params *PostConfigsByIdRegistryWebhookParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostConfigsByIdRestore request
+	PostConfigsByIdRestore(ctx context.Context, id string, params *PostConfigsByIdRestoreParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetConfigsByIdRetention request
+	GetConfigsByIdRetention(ctx context.Context, id string, params *GetConfigsByIdRetentionParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutConfigsByIdRetentionWithBody request with any body
+	PutConfigsByIdRetentionWithBody(ctx context.Context, id string, params *PutConfigsByIdRetentionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutConfigsByIdRetention(ctx context.Context, id string, params *PutConfigsByIdRetentionParams, body PutConfigsByIdRetentionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetConfigsByIdSecretScan request
+	GetConfigsByIdSecretScan(ctx context.Context, id string, params *GetConfigsByIdSecretScanParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutConfigsByIdSecretScanWithBody request with any body
+	PutConfigsByIdSecretScanWithBody(ctx context.Context, id string, params *PutConfigsByIdSecretScanParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutConfigsByIdSecretScan(ctx context.Context, id string, params *PutConfigsByIdSecretScanParams, body PutConfigsByIdSecretScanJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteConfigsByIdTransfer request
+	DeleteConfigsByIdTransfer(ctx context.Context, id string, params *DeleteConfigsByIdTransferParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostConfigsByIdTransferWithBody request with any body
+	PostConfigsByIdTransferWithBody(ctx context.Context, id string, params *PostConfigsByIdTransferParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostConfigsByIdTransfer(ctx context.Context, id string, params *PostConfigsByIdTransferParams, body PostConfigsByIdTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchConfigsByIdWebhookWithBody request with any body
+	PatchConfigsByIdWebhookWithBody(ctx context.Context, id string, params *PatchConfigsByIdWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchConfigsByIdWebhook(ctx context.Context, id string, params *PatchConfigsByIdWebhookParams, body PatchConfigsByIdWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostConfigsByIdWebhookWithBody request with any body
+	PostConfigsByIdWebhookWithBody(ctx context.Context, id string, params *PostConfigsByIdWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostConfigsByIdWebhook(ctx context.Context, id string, params *PostConfigsByIdWebhookParams, body PostConfigsByIdWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCredentials request
+	GetCredentials(ctx context.Context, params *GetCredentialsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostCredentialsWithBody request with any body
+	PostCredentialsWithBody(ctx context.Context, params *PostCredentialsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostCredentials(ctx context.Context, params *PostCredentialsParams, body PostCredentialsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCredentialsGithubInstallationToken request
+	GetCredentialsGithubInstallationToken(ctx context.Context, params *GetCredentialsGithubInstallationTokenParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCredentialsGithubToken request
+	GetCredentialsGithubToken(ctx context.Context, params *GetCredentialsGithubTokenParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteCredentialsById request
+	DeleteCredentialsById(ctx context.Context, id int64, params *DeleteCredentialsByIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCredentialsById request
+	GetCredentialsById(ctx context.Context, id int64, params *GetCredentialsByIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutCredentialsByIdWithBody request with any body
+	PutCredentialsByIdWithBody(ctx context.Context, id int64, params *PutCredentialsByIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutCredentialsById(ctx context.Context, id int64, params *PutCredentialsByIdParams, body PutCredentialsByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostCredentialsByIdRestore request
+	PostCredentialsByIdRestore(ctx context.Context, id int64, params *PostCredentialsByIdRestoreParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDeployStatus request
+	GetDeployStatus(ctx context.Context, params *GetDeployStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostDeployTriggerWithBody request with any body
+	PostDeployTriggerWithBody(ctx context.Context, params *PostDeployTriggerParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostDeployTrigger(ctx context.Context, params *PostDeployTriggerParams, body PostDeployTriggerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDeploymentsByIdManifests request
+	GetDeploymentsByIdManifests(ctx context.Context, id int64, params *GetDeploymentsByIdManifestsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDeploymentsByIdResources request
+	GetDeploymentsByIdResources(ctx context.Context, id int64, params *GetDeploymentsByIdResourcesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostDeploymentsByIdRollback request
+	PostDeploymentsByIdRollback(ctx context.Context, id int64, params *PostDeploymentsByIdRollbackParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostEmailChangeConfirmWithBody request with any body
+	PostEmailChangeConfirmWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostEmailChangeConfirm(ctx context.Context, body PostEmailChangeConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetGithubApp request
+	GetGithubApp(ctx context.Context, params *GetGithubAppParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostGithubInstallationsByIdCheck request
+	PostGithubInstallationsByIdCheck(ctx context.Context, id int64, params *PostGithubInstallationsByIdCheckParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostGithubReposByOwnerByRepoBootstrapWithBody request with any body
+	PostGithubReposByOwnerByRepoBootstrapWithBody(ctx context.Context, owner string, repo string, params *PostGithubReposByOwnerByRepoBootstrapParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostGithubReposByOwnerByRepoBootstrap(ctx context.Context, owner string, repo string, params *PostGithubReposByOwnerByRepoBootstrapParams, body PostGithubReposByOwnerByRepoBootstrapJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetHealth request
+	GetHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetHooks request
+	GetHooks(ctx context.Context, params *GetHooksParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostHooksWithBody request with any body
+	PostHooksWithBody(ctx context.Context, params *PostHooksParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostHooks(ctx context.Context, params *PostHooksParams, body PostHooksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteHooksById request
+	DeleteHooksById(ctx context.Context, id string, params *DeleteHooksByIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetJobsDead request
+	GetJobsDead(ctx context.Context, params *GetJobsDeadParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostJobsDeadByIdRequeue request
+	PostJobsDeadByIdRequeue(ctx context.Context, id int64, params *PostJobsDeadByIdRequeueParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostLoginWithBody request with any body
+	PostLoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostLogin(ctx context.Context, body PostLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostLoginOidcDevice request
+	PostLoginOidcDevice(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostLoginOidcTokenWithBody request with any body
+	PostLoginOidcTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostLoginOidcToken(ctx context.Context, body PostLoginOidcTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteMeWithBody request with any body
+	DeleteMeWithBody(ctx context.Context, params *DeleteMeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DeleteMe(ctx context.Context, params *DeleteMeParams, body DeleteMeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMe request
+	GetMe(ctx context.Context, params *GetMeParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchMeWithBody request with any body
+	PatchMeWithBody(ctx context.Context, params *PatchMeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchMe(ctx context.Context, params *PatchMeParams, body PatchMeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostMe2Fa request
+	PostMe2Fa(ctx context.Context, params *PostMe2FaParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostMe2FaConfirmWithBody request with any body
+	PostMe2FaConfirmWithBody(ctx context.Context, params *PostMe2FaConfirmParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostMe2FaConfirm(ctx context.Context, params *PostMe2FaConfirmParams, body PostMe2FaConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostMe2FaDisableWithBody request with any body
+	PostMe2FaDisableWithBody(ctx context.Context, params *PostMe2FaDisableParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostMe2FaDisable(ctx context.Context, params *PostMe2FaDisableParams, body PostMe2FaDisableJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMeNotifications request
+	GetMeNotifications(ctx context.Context, params *GetMeNotificationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutMeNotificationsWithBody request with any body
+	PutMeNotificationsWithBody(ctx context.Context, params *PutMeNotificationsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutMeNotifications(ctx context.Context, params *PutMeNotificationsParams, body PutMeNotificationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteMeRegistryCredential request
+	DeleteMeRegistryCredential(ctx context.Context, params *DeleteMeRegistryCredentialParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutMeRegistryCredentialWithBody request with any body
+	PutMeRegistryCredentialWithBody(ctx context.Context, params *PutMeRegistryCredentialParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutMeRegistryCredential(ctx context.Context, params *PutMeRegistryCredentialParams, body PutMeRegistryCredentialJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostPasswordResetWithBody request with any body
+	PostPasswordResetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostPasswordReset(ctx context.Context, body PostPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostPasswordResetConfirmWithBody request with any body
+	PostPasswordResetConfirmWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostPasswordResetConfirm(ctx context.Context, body PostPasswordResetConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPolicies request
+	GetPolicies(ctx context.Context, params *GetPoliciesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeletePoliciesByName request
+	DeletePoliciesByName(ctx context.Context, name string, params *DeletePoliciesByNameParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutPoliciesByNameWithBody request with any body
+	PutPoliciesByNameWithBody(ctx context.Context, name string, params *PutPoliciesByNameParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutPoliciesByName(ctx context.Context, name string, params *PutPoliciesByNameParams, body PutPoliciesByNameJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProviders request
+	GetProviders(ctx context.Context, params *GetProvidersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRegisterWithBody request with any body
+	PostRegisterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRegister(ctx context.Context, body PostRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSessions request
+	GetSessions(ctx context.Context, params *GetSessionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteSessionsById request
+	DeleteSessionsById(ctx context.Context, id string, params *DeleteSessionsByIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTransfers request
+	GetTransfers(ctx context.Context, params *GetTransfersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostTransfersByIdAccept request
+	PostTransfersByIdAccept(ctx context.Context, id string, params *PostTransfersByIdAcceptParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostTransfersByIdDecline request
+	PostTransfersByIdDecline(ctx context.Context, id string, params *PostTransfersByIdDeclineParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTrash request
+	GetTrash(ctx context.Context, params *GetTrashParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUsage request
+	GetUsage(ctx context.Context, params *GetUsageParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetVersion request
+	GetVersion(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostWebhooksGithubAppWithBody request with any body
+	PostWebhooksGithubAppWithBody(ctx context.Context, params *PostWebhooksGithubAppParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostWebhooksGithubApp(ctx context.Context, params *PostWebhooksGithubAppParams, body PostWebhooksGithubAppJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostWebhooksGithubByIdWithBody request with any body
+	PostWebhooksGithubByIdWithBody(ctx context.Context, id string, params *PostWebhooksGithubByIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostWebhooksGithubById(ctx context.Context, id string, params *PostWebhooksGithubByIdParams, body PostWebhooksGithubByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostWebhooksRegistryByIdWithBody request with any body
+	PostWebhooksRegistryByIdWithBody(ctx context.Context, id string, params *PostWebhooksRegistryByIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) GetActivity(ctx context.Context, params *GetActivityParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetActivityRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAdminActivity(ctx context.Context, params *GetAdminActivityParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminActivityRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAdminGithubApp(ctx context.Context, params *DeleteAdminGithubAppParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAdminGithubAppRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAdminGithubApp(ctx context.Context, params *GetAdminGithubAppParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminGithubAppRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAdminGithubAppWithBody(ctx context.Context, params *PutAdminGithubAppParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminGithubAppRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAdminGithubApp(ctx context.Context, params *PutAdminGithubAppParams, body PutAdminGithubAppJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminGithubAppRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAdminLimits(ctx context.Context, params *GetAdminLimitsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminLimitsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAdminLimitsWithBody(ctx context.Context, params *PutAdminLimitsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminLimitsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAdminLimits(ctx context.Context, params *PutAdminLimitsParams, body PutAdminLimitsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminLimitsRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAdminRetention(ctx context.Context, params *GetAdminRetentionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminRetentionRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAdminUsers(ctx context.Context, params *GetAdminUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminUsersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchAdminUsersByIdWithBody(ctx context.Context, id string, params *PatchAdminUsersByIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchAdminUsersByIdRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchAdminUsersById(ctx context.Context, id string, params *PatchAdminUsersByIdParams, body PatchAdminUsersByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchAdminUsersByIdRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAdminUsersByIdRetention(ctx context.Context, id string, params *GetAdminUsersByIdRetentionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminUsersByIdRetentionRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAdminUsersByIdRetentionWithBody(ctx context.Context, id string, params *PutAdminUsersByIdRetentionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminUsersByIdRetentionRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAdminUsersByIdRetention(ctx context.Context, id string, params *PutAdminUsersByIdRetentionParams, body PutAdminUsersByIdRetentionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminUsersByIdRetentionRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAgentDeploymentsNext(ctx context.Context, params *GetAgentDeploymentsNextParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAgentDeploymentsNextRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAgentDeploymentsByIdStatusWithBody(ctx context.Context, id int64, params *PostAgentDeploymentsByIdStatusParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAgentDeploymentsByIdStatusRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAgentDeploymentsByIdStatus(ctx context.Context, id int64, params *PostAgentDeploymentsByIdStatusParams, body PostAgentDeploymentsByIdStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAgentDeploymentsByIdStatusRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAgents(ctx context.Context, params *GetAgentsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAgentsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAgentsWithBody(ctx context.Context, params *PostAgentsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAgentsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAgents(ctx context.Context, params *PostAgentsParams, body PostAgentsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAgentsRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetBuildsById(ctx context.Context, id int64, params *GetBuildsByIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBuildsByIdRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetBuildsByIdArtifacts(ctx context.Context, id int64, params *GetBuildsByIdArtifactsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBuildsByIdArtifactsRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetBuildsByIdArtifactsByName(ctx context.Context, id int64, name string, params *GetBuildsByIdArtifactsByNameParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBuildsByIdArtifactsByNameRequest(c.Server, id, name, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetBuildsByIdConfig(ctx context.Context, id int64, params *GetBuildsByIdConfigParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBuildsByIdConfigRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetBuildsByIdLogs(ctx context.Context, id int64, params *GetBuildsByIdLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBuildsByIdLogsRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetBuildsByIdPipeline(ctx context.Context, id int64, params *GetBuildsByIdPipelineParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBuildsByIdPipelineRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetBuildsByIdProvenance(ctx context.Context, id int64, params *GetBuildsByIdProvenanceParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBuildsByIdProvenanceRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetCliRelease(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCliReleaseRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetConfigs(ctx context.Context, params *GetConfigsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetConfigsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostConfigsWithBody(ctx context.Context, params *PostConfigsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostConfigsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostConfigs(ctx context.Context, params *PostConfigsParams, body PostConfigsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostConfigsRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostConfigsImportWithBody(ctx context.Context, params *PostConfigsImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostConfigsImportRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostConfigsImport(ctx context.Context, params *PostConfigsImportParams, body PostConfigsImportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostConfigsImportRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostConfigsSync(ctx context.Context, params *PostConfigsSyncParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostConfigsSyncRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteConfigsById(ctx context.Context, id string, params *DeleteConfigsByIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteConfigsByIdRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetConfigsById(ctx context.Context, id string, params *GetConfigsByIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetConfigsByIdRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchConfigsByIdWithBody(ctx context.Context, id string, params *PatchConfigsByIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchConfigsByIdRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchConfigsById(ctx context.Context, id string, params *PatchConfigsByIdParams, body PatchConfigsByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchConfigsByIdRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchConfigsByIdAgentWithBody(ctx context.Context, id string, params *PatchConfigsByIdAgentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchConfigsByIdAgentRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchConfigsByIdAgent(ctx context.Context, id string, params *PatchConfigsByIdAgentParams, body PatchConfigsByIdAgentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchConfigsByIdAgentRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchConfigsByIdClusterWithBody(ctx context.Context, id string, params *PatchConfigsByIdClusterParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchConfigsByIdClusterRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchConfigsByIdCluster(ctx context.Context, id string, params *PatchConfigsByIdClusterParams, body PatchConfigsByIdClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchConfigsByIdClusterRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetConfigsByIdDeniedAuthors(ctx context.Context, id string, params *GetConfigsByIdDeniedAuthorsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetConfigsByIdDeniedAuthorsRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutConfigsByIdDeniedAuthorsWithBody(ctx context.Context, id string, params *PutConfigsByIdDeniedAuthorsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutConfigsByIdDeniedAuthorsRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutConfigsByIdDeniedAuthors(ctx context.Context, id string, params *PutConfigsByIdDeniedAuthorsParams, body PutConfigsByIdDeniedAuthorsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutConfigsByIdDeniedAuthorsRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetConfigsByIdDeployTokens(ctx context.Context, id string, params *GetConfigsByIdDeployTokensParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetConfigsByIdDeployTokensRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostConfigsByIdDeployTokensWithBody(ctx context.Context, id string, params *PostConfigsByIdDeployTokensParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostConfigsByIdDeployTokensRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostConfigsByIdDeployTokens(ctx context.Context, id string, params *PostConfigsByIdDeployTokensParams, body PostConfigsByIdDeployTokensJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostConfigsByIdDeployTokensRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteConfigsByIdDeployTokensByTokenId(ctx context.Context, id string, tokenId string, params *DeleteConfigsByIdDeployTokensByTokenIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteConfigsByIdDeployTokensByTokenIdRequest(c.Server, id, tokenId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetConfigsByIdDeployments(ctx context.Context, id string, params *GetConfigsByIdDeploymentsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetConfigsByIdDeploymentsRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetConfigsByIdEnv(ctx context.Context, id string, params *GetConfigsByIdEnvParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetConfigsByIdEnvRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostConfigsByIdEnvWithBody(ctx context.Context, id string, params *PostConfigsByIdEnvParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostConfigsByIdEnvRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostConfigsByIdEnv(ctx context.Context, id string, params *PostConfigsByIdEnvParams, body PostConfigsByIdEnvJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostConfigsByIdEnvRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteConfigsByIdEnvByName(ctx context.Context, id string, name string, params *DeleteConfigsByIdEnvByNameParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteConfigsByIdEnvByNameRequest(c.Server, id, name, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetConfigsByIdEnvironments(ctx context.Context, id string, params *GetConfigsByIdEnvironmentsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetConfigsByIdEnvironmentsRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetConfigsByIdExport(ctx context.Context, id string, params *GetConfigsByIdExportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetConfigsByIdExportRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteConfigsByIdRegistryWebhook(ctx context.Context, id string, params *DeleteConfigsByIdRegistryWebhookParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteConfigsByIdRegistryWebhookRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostConfigsByIdRegistryWebhook(ctx context.Context, id string, params *PostConfigsByIdRegistryWebhookParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostConfigsByIdRegistryWebhookRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostConfigsByIdRestore(ctx context.Context, id string, params *PostConfigsByIdRestoreParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostConfigsByIdRestoreRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetConfigsByIdRetention(ctx context.Context, id string, params *GetConfigsByIdRetentionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetConfigsByIdRetentionRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutConfigsByIdRetentionWithBody(ctx context.Context, id string, params *PutConfigsByIdRetentionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutConfigsByIdRetentionRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutConfigsByIdRetention(ctx context.Context, id string, params *PutConfigsByIdRetentionParams, body PutConfigsByIdRetentionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutConfigsByIdRetentionRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetConfigsByIdSecretScan(ctx context.Context, id string, params *GetConfigsByIdSecretScanParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetConfigsByIdSecretScanRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutConfigsByIdSecretScanWithBody(ctx context.Context, id string, params *PutConfigsByIdSecretScanParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutConfigsByIdSecretScanRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutConfigsByIdSecretScan(ctx context.Context, id string, params *PutConfigsByIdSecretScanParams, body PutConfigsByIdSecretScanJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutConfigsByIdSecretScanRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteConfigsByIdTransfer(ctx context.Context, id string, params *DeleteConfigsByIdTransferParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteConfigsByIdTransferRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostConfigsByIdTransferWithBody(ctx context.Context, id string, params *PostConfigsByIdTransferParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostConfigsByIdTransferRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostConfigsByIdTransfer(ctx context.Context, id string, params *PostConfigsByIdTransferParams, body PostConfigsByIdTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostConfigsByIdTransferRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchConfigsByIdWebhookWithBody(ctx context.Context, id string, params *PatchConfigsByIdWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchConfigsByIdWebhookRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchConfigsByIdWebhook(ctx context.Context, id string, params *PatchConfigsByIdWebhookParams, body PatchConfigsByIdWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchConfigsByIdWebhookRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostConfigsByIdWebhookWithBody(ctx context.Context, id string, params *PostConfigsByIdWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostConfigsByIdWebhookRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostConfigsByIdWebhook(ctx context.Context, id string, params *PostConfigsByIdWebhookParams, body PostConfigsByIdWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostConfigsByIdWebhookRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetCredentials(ctx context.Context, params *GetCredentialsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCredentialsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostCredentialsWithBody(ctx context.Context, params *PostCredentialsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostCredentialsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostCredentials(ctx context.Context, params *PostCredentialsParams, body PostCredentialsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostCredentialsRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetCredentialsGithubInstallationToken(ctx context.Context, params *GetCredentialsGithubInstallationTokenParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCredentialsGithubInstallationTokenRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetCredentialsGithubToken(ctx context.Context, params *GetCredentialsGithubTokenParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCredentialsGithubTokenRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteCredentialsById(ctx context.Context, id int64, params *DeleteCredentialsByIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteCredentialsByIdRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetCredentialsById(ctx context.Context, id int64, params *GetCredentialsByIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCredentialsByIdRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutCredentialsByIdWithBody(ctx context.Context, id int64, params *PutCredentialsByIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutCredentialsByIdRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutCredentialsById(ctx context.Context, id int64, params *PutCredentialsByIdParams, body PutCredentialsByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutCredentialsByIdRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostCredentialsByIdRestore(ctx context.Context, id int64, params *PostCredentialsByIdRestoreParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostCredentialsByIdRestoreRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetDeployStatus(ctx context.Context, params *GetDeployStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDeployStatusRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostDeployTriggerWithBody(ctx context.Context, params *PostDeployTriggerParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostDeployTriggerRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostDeployTrigger(ctx context.Context, params *PostDeployTriggerParams, body PostDeployTriggerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostDeployTriggerRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetDeploymentsByIdManifests(ctx context.Context, id int64, params *GetDeploymentsByIdManifestsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDeploymentsByIdManifestsRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetDeploymentsByIdResources(ctx context.Context, id int64, params *GetDeploymentsByIdResourcesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDeploymentsByIdResourcesRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostDeploymentsByIdRollback(ctx context.Context, id int64, params *PostDeploymentsByIdRollbackParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostDeploymentsByIdRollbackRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostEmailChangeConfirmWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostEmailChangeConfirmRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostEmailChangeConfirm(ctx context.Context, body PostEmailChangeConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostEmailChangeConfirmRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetGithubApp(ctx context.Context, params *GetGithubAppParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetGithubAppRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostGithubInstallationsByIdCheck(ctx context.Context, id int64, params *PostGithubInstallationsByIdCheckParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostGithubInstallationsByIdCheckRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostGithubReposByOwnerByRepoBootstrapWithBody(ctx context.Context, owner string, repo string, params *PostGithubReposByOwnerByRepoBootstrapParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostGithubReposByOwnerByRepoBootstrapRequestWithBody(c.Server, owner, repo, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostGithubReposByOwnerByRepoBootstrap(ctx context.Context, owner string, repo string, params *PostGithubReposByOwnerByRepoBootstrapParams, body PostGithubReposByOwnerByRepoBootstrapJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostGithubReposByOwnerByRepoBootstrapRequest(c.Server, owner, repo, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetHealthRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetHooks(ctx context.Context, params *GetHooksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetHooksRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostHooksWithBody(ctx context.Context, params *PostHooksParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostHooksRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostHooks(ctx context.Context, params *PostHooksParams, body PostHooksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostHooksRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteHooksById(ctx context.Context, id string, params *DeleteHooksByIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteHooksByIdRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetJobsDead(ctx context.Context, params *GetJobsDeadParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetJobsDeadRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostJobsDeadByIdRequeue(ctx context.Context, id int64, params *PostJobsDeadByIdRequeueParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostJobsDeadByIdRequeueRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostLoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostLoginRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostLogin(ctx context.Context, body PostLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostLoginRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostLoginOidcDevice(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostLoginOidcDeviceRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostLoginOidcTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostLoginOidcTokenRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostLoginOidcToken(ctx context.Context, body PostLoginOidcTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostLoginOidcTokenRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteMeWithBody(ctx context.Context, params *DeleteMeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteMeRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteMe(ctx context.Context, params *DeleteMeParams, body DeleteMeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteMeRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetMe(ctx context.Context, params *GetMeParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMeRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchMeWithBody(ctx context.Context, params *PatchMeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchMeRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchMe(ctx context.Context, params *PatchMeParams, body PatchMeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchMeRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostMe2Fa(ctx context.Context, params *PostMe2FaParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostMe2FaRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostMe2FaConfirmWithBody(ctx context.Context, params *PostMe2FaConfirmParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostMe2FaConfirmRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostMe2FaConfirm(ctx context.Context, params *PostMe2FaConfirmParams, body PostMe2FaConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostMe2FaConfirmRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostMe2FaDisableWithBody(ctx context.Context, params *PostMe2FaDisableParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostMe2FaDisableRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostMe2FaDisable(ctx context.Context, params *PostMe2FaDisableParams, body PostMe2FaDisableJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostMe2FaDisableRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetMeNotifications(ctx context.Context, params *GetMeNotificationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMeNotificationsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutMeNotificationsWithBody(ctx context.Context, params *PutMeNotificationsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutMeNotificationsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutMeNotifications(ctx context.Context, params *PutMeNotificationsParams, body PutMeNotificationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutMeNotificationsRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteMeRegistryCredential(ctx context.Context, params *DeleteMeRegistryCredentialParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteMeRegistryCredentialRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutMeRegistryCredentialWithBody(ctx context.Context, params *PutMeRegistryCredentialParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutMeRegistryCredentialRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutMeRegistryCredential(ctx context.Context, params *PutMeRegistryCredentialParams, body PutMeRegistryCredentialJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutMeRegistryCredentialRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPasswordResetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPasswordResetRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPasswordReset(ctx context.Context, body PostPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPasswordResetRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPasswordResetConfirmWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPasswordResetConfirmRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPasswordResetConfirm(ctx context.Context, body PostPasswordResetConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPasswordResetConfirmRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPolicies(ctx context.Context, params *GetPoliciesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPoliciesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeletePoliciesByName(ctx context.Context, name string, params *DeletePoliciesByNameParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePoliciesByNameRequest(c.Server, name, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutPoliciesByNameWithBody(ctx context.Context, name string, params *PutPoliciesByNameParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutPoliciesByNameRequestWithBody(c.Server, name, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutPoliciesByName(ctx context.Context, name string, params *PutPoliciesByNameParams, body PutPoliciesByNameJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutPoliciesByNameRequest(c.Server, name, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetProviders(ctx context.Context, params *GetProvidersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProvidersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRegisterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRegisterRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRegister(ctx context.Context, body PostRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRegisterRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSessions(ctx context.Context, params *GetSessionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSessionsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteSessionsById(ctx context.Context, id string, params *DeleteSessionsByIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSessionsByIdRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTransfers(ctx context.Context, params *GetTransfersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTransfersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostTransfersByIdAccept(ctx context.Context, id string, params *PostTransfersByIdAcceptParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTransfersByIdAcceptRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostTransfersByIdDecline(ctx context.Context, id string, params *PostTransfersByIdDeclineParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTransfersByIdDeclineRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTrash(ctx context.Context, params *GetTrashParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTrashRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUsage(ctx context.Context, params *GetUsageParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsageRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetVersion(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetVersionRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostWebhooksGithubAppWithBody(ctx context.Context, params *PostWebhooksGithubAppParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWebhooksGithubAppRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostWebhooksGithubApp(ctx context.Context, params *PostWebhooksGithubAppParams, body PostWebhooksGithubAppJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWebhooksGithubAppRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostWebhooksGithubByIdWithBody(ctx context.Context, id string, params *PostWebhooksGithubByIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWebhooksGithubByIdRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostWebhooksGithubById(ctx context.Context, id string, params *PostWebhooksGithubByIdParams, body PostWebhooksGithubByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWebhooksGithubByIdRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostWebhooksRegistryByIdWithBody(ctx context.Context, id string, params *PostWebhooksRegistryByIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWebhooksRegistryByIdRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetActivityRequest generates requests for GetActivity
+func NewGetActivityRequest(server string, params *GetActivityParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/activity")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ConfigId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "config_id", runtime.ParamLocationQuery, *params.ConfigId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetAdminActivityRequest generates requests for GetAdminActivity
+func NewGetAdminActivityRequest(server string, params *GetAdminActivityParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/activity")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteAdminGithubAppRequest generates requests for DeleteAdminGithubApp
+func NewDeleteAdminGithubAppRequest(server string, params *DeleteAdminGithubAppParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/github-app")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetAdminGithubAppRequest generates requests for GetAdminGithubApp
+func NewGetAdminGithubAppRequest(server string, params *GetAdminGithubAppParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/github-app")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPutAdminGithubAppRequest calls the generic PutAdminGithubApp builder with application/json body
+func NewPutAdminGithubAppRequest(server string, params *PutAdminGithubAppParams, body PutAdminGithubAppJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutAdminGithubAppRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPutAdminGithubAppRequestWithBody generates requests for PutAdminGithubApp with any type of body
+func NewPutAdminGithubAppRequestWithBody(server string, params *PutAdminGithubAppParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/github-app")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetAdminLimitsRequest generates requests for GetAdminLimits
+func NewGetAdminLimitsRequest(server string, params *GetAdminLimitsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/limits")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPutAdminLimitsRequest calls the generic PutAdminLimits builder with application/json body
+func NewPutAdminLimitsRequest(server string, params *PutAdminLimitsParams, body PutAdminLimitsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutAdminLimitsRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPutAdminLimitsRequestWithBody generates requests for PutAdminLimits with any type of body
+func NewPutAdminLimitsRequestWithBody(server string, params *PutAdminLimitsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/limits")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetAdminRetentionRequest generates requests for GetAdminRetention
+func NewGetAdminRetentionRequest(server string, params *GetAdminRetentionParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/retention")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetAdminUsersRequest generates requests for GetAdminUsers
+func NewGetAdminUsersRequest(server string, params *GetAdminUsersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPatchAdminUsersByIdRequest calls the generic PatchAdminUsersById builder with application/json body
+func NewPatchAdminUsersByIdRequest(server string, id string, params *PatchAdminUsersByIdParams, body PatchAdminUsersByIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchAdminUsersByIdRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewPatchAdminUsersByIdRequestWithBody generates requests for PatchAdminUsersById with any type of body
+func NewPatchAdminUsersByIdRequestWithBody(server string, id string, params *PatchAdminUsersByIdParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetAdminUsersByIdRetentionRequest generates requests for GetAdminUsersByIdRetention
+func NewGetAdminUsersByIdRetentionRequest(server string, id string, params *GetAdminUsersByIdRetentionParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/users/%s/retention", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPutAdminUsersByIdRetentionRequest calls the generic PutAdminUsersByIdRetention builder with application/json body
+func NewPutAdminUsersByIdRetentionRequest(server string, id string, params *PutAdminUsersByIdRetentionParams, body PutAdminUsersByIdRetentionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutAdminUsersByIdRetentionRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewPutAdminUsersByIdRetentionRequestWithBody generates requests for PutAdminUsersByIdRetention with any type of body
+func NewPutAdminUsersByIdRetentionRequestWithBody(server string, id string, params *PutAdminUsersByIdRetentionParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/users/%s/retention", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetAgentDeploymentsNextRequest generates requests for GetAgentDeploymentsNext
+func NewGetAgentDeploymentsNextRequest(server string, params *GetAgentDeploymentsNextParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/agent/deployments/next")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Wait != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "wait", runtime.ParamLocationQuery, *params.Wait); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostAgentDeploymentsByIdStatusRequest calls the generic PostAgentDeploymentsByIdStatus builder with application/json body
+func NewPostAgentDeploymentsByIdStatusRequest(server string, id int64, params *PostAgentDeploymentsByIdStatusParams, body PostAgentDeploymentsByIdStatusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAgentDeploymentsByIdStatusRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewPostAgentDeploymentsByIdStatusRequestWithBody generates requests for PostAgentDeploymentsByIdStatus with any type of body
+func NewPostAgentDeploymentsByIdStatusRequestWithBody(server string, id int64, params *PostAgentDeploymentsByIdStatusParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/agent/deployments/%s/status", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetAgentsRequest generates requests for GetAgents
+func NewGetAgentsRequest(server string, params *GetAgentsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/agents")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostAgentsRequest calls the generic PostAgents builder with application/json body
+func NewPostAgentsRequest(server string, params *PostAgentsParams, body PostAgentsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAgentsRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostAgentsRequestWithBody generates requests for PostAgents with any type of body
+func NewPostAgentsRequestWithBody(server string, params *PostAgentsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/agents")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetBuildsByIdRequest generates requests for GetBuildsById
+func NewGetBuildsByIdRequest(server string, id int64, params *GetBuildsByIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/builds/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetBuildsByIdArtifactsRequest generates requests for GetBuildsByIdArtifacts
+func NewGetBuildsByIdArtifactsRequest(server string, id int64, params *GetBuildsByIdArtifactsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/builds/%s/artifacts", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetBuildsByIdArtifactsByNameRequest generates requests for GetBuildsByIdArtifactsByName
+func NewGetBuildsByIdArtifactsByNameRequest(server string, id int64, name string, params *GetBuildsByIdArtifactsByNameParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/builds/%s/artifacts/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetBuildsByIdConfigRequest generates requests for GetBuildsByIdConfig
+func NewGetBuildsByIdConfigRequest(server string, id int64, params *GetBuildsByIdConfigParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/builds/%s/config", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetBuildsByIdLogsRequest generates requests for GetBuildsByIdLogs
+func NewGetBuildsByIdLogsRequest(server string, id int64, params *GetBuildsByIdLogsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/builds/%s/logs", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetBuildsByIdPipelineRequest generates requests for GetBuildsByIdPipeline
+func NewGetBuildsByIdPipelineRequest(server string, id int64, params *GetBuildsByIdPipelineParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/builds/%s/pipeline", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetBuildsByIdProvenanceRequest generates requests for GetBuildsByIdProvenance
+func NewGetBuildsByIdProvenanceRequest(server string, id int64, params *GetBuildsByIdProvenanceParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/builds/%s/provenance", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetCliReleaseRequest generates requests for GetCliRelease
+func NewGetCliReleaseRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cli/release")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetConfigsRequest generates requests for GetConfigs
+func NewGetConfigsRequest(server string, params *GetConfigsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/configs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostConfigsRequest calls the generic PostConfigs builder with application/json body
+func NewPostConfigsRequest(server string, params *PostConfigsParams, body PostConfigsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostConfigsRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostConfigsRequestWithBody generates requests for PostConfigs with any type of body
+func NewPostConfigsRequestWithBody(server string, params *PostConfigsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/configs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostConfigsImportRequest calls the generic PostConfigsImport builder with application/json body
+func NewPostConfigsImportRequest(server string, params *PostConfigsImportParams, body PostConfigsImportJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostConfigsImportRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostConfigsImportRequestWithBody generates requests for PostConfigsImport with any type of body
+func NewPostConfigsImportRequestWithBody(server string, params *PostConfigsImportParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/configs/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostConfigsSyncRequest generates requests for PostConfigsSync
+func NewPostConfigsSyncRequest(server string, params *PostConfigsSyncParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/configs/sync")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteConfigsByIdRequest generates requests for DeleteConfigsById
+func NewDeleteConfigsByIdRequest(server string, id string, params *DeleteConfigsByIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/configs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+		if params.IfMatch != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam1)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetConfigsByIdRequest generates requests for GetConfigsById
+func NewGetConfigsByIdRequest(server string, id string, params *GetConfigsByIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/configs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPatchConfigsByIdRequest calls the generic PatchConfigsById builder with application/json body
+func NewPatchConfigsByIdRequest(server string, id string, params *PatchConfigsByIdParams, body PatchConfigsByIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchConfigsByIdRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewPatchConfigsByIdRequestWithBody generates requests for PatchConfigsById with any type of body
+func NewPatchConfigsByIdRequestWithBody(server string, id string, params *PatchConfigsByIdParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/configs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+		if params.IfMatch != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam1)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPatchConfigsByIdAgentRequest calls the generic PatchConfigsByIdAgent builder with application/json body
+func NewPatchConfigsByIdAgentRequest(server string, id string, params *PatchConfigsByIdAgentParams, body PatchConfigsByIdAgentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchConfigsByIdAgentRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewPatchConfigsByIdAgentRequestWithBody generates requests for PatchConfigsByIdAgent with any type of body
+func NewPatchConfigsByIdAgentRequestWithBody(server string, id string, params *PatchConfigsByIdAgentParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/configs/%s/agent", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPatchConfigsByIdClusterRequest calls the generic PatchConfigsByIdCluster builder with application/json body
+func NewPatchConfigsByIdClusterRequest(server string, id string, params *PatchConfigsByIdClusterParams, body PatchConfigsByIdClusterJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchConfigsByIdClusterRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewPatchConfigsByIdClusterRequestWithBody generates requests for PatchConfigsByIdCluster with any type of body
+func NewPatchConfigsByIdClusterRequestWithBody(server string, id string, params *PatchConfigsByIdClusterParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/configs/%s/cluster", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetConfigsByIdDeniedAuthorsRequest generates requests for GetConfigsByIdDeniedAuthors
+func NewGetConfigsByIdDeniedAuthorsRequest(server string, id string, params *GetConfigsByIdDeniedAuthorsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/configs/%s/denied-authors", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPutConfigsByIdDeniedAuthorsRequest calls the generic PutConfigsByIdDeniedAuthors builder with application/json body
+func NewPutConfigsByIdDeniedAuthorsRequest(server string, id string, params *PutConfigsByIdDeniedAuthorsParams, body PutConfigsByIdDeniedAuthorsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutConfigsByIdDeniedAuthorsRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewPutConfigsByIdDeniedAuthorsRequestWithBody generates requests for PutConfigsByIdDeniedAuthors with any type of body
+func NewPutConfigsByIdDeniedAuthorsRequestWithBody(server string, id string, params *PutConfigsByIdDeniedAuthorsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/configs/%s/denied-authors", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetConfigsByIdDeployTokensRequest generates requests for GetConfigsByIdDeployTokens
+func NewGetConfigsByIdDeployTokensRequest(server string, id string, params *GetConfigsByIdDeployTokensParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/configs/%s/deploy-tokens", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostConfigsByIdDeployTokensRequest calls the generic PostConfigsByIdDeployTokens builder with application/json body
+func NewPostConfigsByIdDeployTokensRequest(server string, id string, params *PostConfigsByIdDeployTokensParams, body PostConfigsByIdDeployTokensJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostConfigsByIdDeployTokensRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewPostConfigsByIdDeployTokensRequestWithBody generates requests for PostConfigsByIdDeployTokens with any type of body
+func NewPostConfigsByIdDeployTokensRequestWithBody(server string, id string, params *PostConfigsByIdDeployTokensParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/configs/%s/deploy-tokens", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteConfigsByIdDeployTokensByTokenIdRequest generates requests for DeleteConfigsByIdDeployTokensByTokenId
+func NewDeleteConfigsByIdDeployTokensByTokenIdRequest(server string, id string, tokenId string, params *DeleteConfigsByIdDeployTokensByTokenIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "token_id", runtime.ParamLocationPath, tokenId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/configs/%s/deploy-tokens/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetConfigsByIdDeploymentsRequest generates requests for GetConfigsByIdDeployments
+func NewGetConfigsByIdDeploymentsRequest(server string, id string, params *GetConfigsByIdDeploymentsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/configs/%s/deployments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Before != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "before", runtime.ParamLocationQuery, *params.Before); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetConfigsByIdEnvRequest generates requests for GetConfigsByIdEnv
+func NewGetConfigsByIdEnvRequest(server string, id string, params *GetConfigsByIdEnvParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/configs/%s/env", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostConfigsByIdEnvRequest calls the generic PostConfigsByIdEnv builder with application/json body
+func NewPostConfigsByIdEnvRequest(server string, id string, params *PostConfigsByIdEnvParams, body PostConfigsByIdEnvJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostConfigsByIdEnvRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewPostConfigsByIdEnvRequestWithBody generates requests for PostConfigsByIdEnv with any type of body
+func NewPostConfigsByIdEnvRequestWithBody(server string, id string, params *PostConfigsByIdEnvParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/configs/%s/env", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteConfigsByIdEnvByNameRequest generates requests for DeleteConfigsByIdEnvByName
+func NewDeleteConfigsByIdEnvByNameRequest(server string, id string, name string, params *DeleteConfigsByIdEnvByNameParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/configs/%s/env/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetConfigsByIdEnvironmentsRequest generates requests for GetConfigsByIdEnvironments
+func NewGetConfigsByIdEnvironmentsRequest(server string, id string, params *GetConfigsByIdEnvironmentsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/configs/%s/environments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetConfigsByIdExportRequest generates requests for GetConfigsByIdExport
+func NewGetConfigsByIdExportRequest(server string, id string, params *GetConfigsByIdExportParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/configs/%s/export", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteConfigsByIdRegistryWebhookRequest generates requests for DeleteConfigsByIdRegistryWebhook
+func NewDeleteConfigsByIdRegistryWebhookRequest(server string, id string, params *DeleteConfigsByIdRegistryWebhookParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/configs/%s/registry-webhook", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostConfigsByIdRegistryWebhookRequest generates requests for PostConfigsByIdRegistryWebhook
+func NewPostConfigsByIdRegistryWebhookRequest(server string, id string, params *PostConfigsByIdRegistryWebhookParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/configs/%s/registry-webhook", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostConfigsByIdRestoreRequest generates requests for PostConfigsByIdRestore
+func NewPostConfigsByIdRestoreRequest(server string, id string, params *PostConfigsByIdRestoreParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/configs/%s/restore", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetConfigsByIdRetentionRequest generates requests for GetConfigsByIdRetention
+func NewGetConfigsByIdRetentionRequest(server string, id string, params *GetConfigsByIdRetentionParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/configs/%s/retention", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPutConfigsByIdRetentionRequest calls the generic PutConfigsByIdRetention builder with application/json body
+func NewPutConfigsByIdRetentionRequest(server string, id string, params *PutConfigsByIdRetentionParams, body PutConfigsByIdRetentionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutConfigsByIdRetentionRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewPutConfigsByIdRetentionRequestWithBody generates requests for PutConfigsByIdRetention with any type of body
+func NewPutConfigsByIdRetentionRequestWithBody(server string, id string, params *PutConfigsByIdRetentionParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/configs/%s/retention", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetConfigsByIdSecretScanRequest generates requests for GetConfigsByIdSecretScan
+func NewGetConfigsByIdSecretScanRequest(server string, id string, params *GetConfigsByIdSecretScanParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/configs/%s/secret-scan", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPutConfigsByIdSecretScanRequest calls the generic PutConfigsByIdSecretScan builder with application/json body
+func NewPutConfigsByIdSecretScanRequest(server string, id string, params *PutConfigsByIdSecretScanParams, body PutConfigsByIdSecretScanJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutConfigsByIdSecretScanRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewPutConfigsByIdSecretScanRequestWithBody generates requests for PutConfigsByIdSecretScan with any type of body
+func NewPutConfigsByIdSecretScanRequestWithBody(server string, id string, params *PutConfigsByIdSecretScanParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/configs/%s/secret-scan", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteConfigsByIdTransferRequest generates requests for DeleteConfigsByIdTransfer
+func NewDeleteConfigsByIdTransferRequest(server string, id string, params *DeleteConfigsByIdTransferParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/configs/%s/transfer", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostConfigsByIdTransferRequest calls the generic PostConfigsByIdTransfer builder with application/json body
+func NewPostConfigsByIdTransferRequest(server string, id string, params *PostConfigsByIdTransferParams, body PostConfigsByIdTransferJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostConfigsByIdTransferRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewPostConfigsByIdTransferRequestWithBody generates requests for PostConfigsByIdTransfer with any type of body
+func NewPostConfigsByIdTransferRequestWithBody(server string, id string, params *PostConfigsByIdTransferParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/configs/%s/transfer", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPatchConfigsByIdWebhookRequest calls the generic PatchConfigsByIdWebhook builder with application/json body
+func NewPatchConfigsByIdWebhookRequest(server string, id string, params *PatchConfigsByIdWebhookParams, body PatchConfigsByIdWebhookJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchConfigsByIdWebhookRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewPatchConfigsByIdWebhookRequestWithBody generates requests for PatchConfigsByIdWebhook with any type of body
+func NewPatchConfigsByIdWebhookRequestWithBody(server string, id string, params *PatchConfigsByIdWebhookParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/configs/%s/webhook", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostConfigsByIdWebhookRequest calls the generic PostConfigsByIdWebhook builder with application/json body
+func NewPostConfigsByIdWebhookRequest(server string, id string, params *PostConfigsByIdWebhookParams, body PostConfigsByIdWebhookJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostConfigsByIdWebhookRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewPostConfigsByIdWebhookRequestWithBody generates requests for PostConfigsByIdWebhook with any type of body
+func NewPostConfigsByIdWebhookRequestWithBody(server string, id string, params *PostConfigsByIdWebhookParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/configs/%s/webhook", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetCredentialsRequest generates requests for GetCredentials
+func NewGetCredentialsRequest(server string, params *GetCredentialsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/credentials")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostCredentialsRequest calls the generic PostCredentials builder with application/json body
+func NewPostCredentialsRequest(server string, params *PostCredentialsParams, body PostCredentialsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostCredentialsRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostCredentialsRequestWithBody generates requests for PostCredentials with any type of body
+func NewPostCredentialsRequestWithBody(server string, params *PostCredentialsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/credentials")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetCredentialsGithubInstallationTokenRequest generates requests for GetCredentialsGithubInstallationToken
+func NewGetCredentialsGithubInstallationTokenRequest(server string, params *GetCredentialsGithubInstallationTokenParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/credentials/github/installation-token")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", false, "config_id", runtime.ParamLocationQuery, params.ConfigId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetCredentialsGithubTokenRequest generates requests for GetCredentialsGithubToken
+func NewGetCredentialsGithubTokenRequest(server string, params *GetCredentialsGithubTokenParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/credentials/github/token")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteCredentialsByIdRequest generates requests for DeleteCredentialsById
+func NewDeleteCredentialsByIdRequest(server string, id int64, params *DeleteCredentialsByIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/credentials/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+		if params.IfMatch != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam1)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetCredentialsByIdRequest generates requests for GetCredentialsById
+func NewGetCredentialsByIdRequest(server string, id int64, params *GetCredentialsByIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/credentials/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPutCredentialsByIdRequest calls the generic PutCredentialsById builder with application/json body
+func NewPutCredentialsByIdRequest(server string, id int64, params *PutCredentialsByIdParams, body PutCredentialsByIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutCredentialsByIdRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewPutCredentialsByIdRequestWithBody generates requests for PutCredentialsById with any type of body
+func NewPutCredentialsByIdRequestWithBody(server string, id int64, params *PutCredentialsByIdParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/credentials/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+		if params.IfMatch != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam1)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostCredentialsByIdRestoreRequest generates requests for PostCredentialsByIdRestore
+func NewPostCredentialsByIdRestoreRequest(server string, id int64, params *PostCredentialsByIdRestoreParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/credentials/%s/restore", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetDeployStatusRequest generates requests for GetDeployStatus
+func NewGetDeployStatusRequest(server string, params *GetDeployStatusParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/deploy/status")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.CommitSha != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "commit_sha", runtime.ParamLocationQuery, *params.CommitSha); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostDeployTriggerRequest calls the generic PostDeployTrigger builder with application/json body
+func NewPostDeployTriggerRequest(server string, params *PostDeployTriggerParams, body PostDeployTriggerJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostDeployTriggerRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostDeployTriggerRequestWithBody generates requests for PostDeployTrigger with any type of body
+func NewPostDeployTriggerRequestWithBody(server string, params *PostDeployTriggerParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/deploy/trigger")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetDeploymentsByIdManifestsRequest generates requests for GetDeploymentsByIdManifests
+func NewGetDeploymentsByIdManifestsRequest(server string, id int64, params *GetDeploymentsByIdManifestsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/deployments/%s/manifests", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetDeploymentsByIdResourcesRequest generates requests for GetDeploymentsByIdResources
+func NewGetDeploymentsByIdResourcesRequest(server string, id int64, params *GetDeploymentsByIdResourcesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/deployments/%s/resources", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostDeploymentsByIdRollbackRequest generates requests for PostDeploymentsByIdRollback
+func NewPostDeploymentsByIdRollbackRequest(server string, id int64, params *PostDeploymentsByIdRollbackParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/deployments/%s/rollback", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostEmailChangeConfirmRequest calls the generic PostEmailChangeConfirm builder with application/json body
+func NewPostEmailChangeConfirmRequest(server string, body PostEmailChangeConfirmJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostEmailChangeConfirmRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostEmailChangeConfirmRequestWithBody generates requests for PostEmailChangeConfirm with any type of body
+func NewPostEmailChangeConfirmRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/email-change/confirm")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetGithubAppRequest generates requests for GetGithubApp
+func NewGetGithubAppRequest(server string, params *GetGithubAppParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/github/app")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostGithubInstallationsByIdCheckRequest generates requests for PostGithubInstallationsByIdCheck
+func NewPostGithubInstallationsByIdCheckRequest(server string, id int64, params *PostGithubInstallationsByIdCheckParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/github/installations/%s/check", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostGithubReposByOwnerByRepoBootstrapRequest calls the generic PostGithubReposByOwnerByRepoBootstrap builder with application/json body
+func NewPostGithubReposByOwnerByRepoBootstrapRequest(server string, owner string, repo string, params *PostGithubReposByOwnerByRepoBootstrapParams, body PostGithubReposByOwnerByRepoBootstrapJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostGithubReposByOwnerByRepoBootstrapRequestWithBody(server, owner, repo, params, "application/json", bodyReader)
+}
+
+// NewPostGithubReposByOwnerByRepoBootstrapRequestWithBody generates requests for PostGithubReposByOwnerByRepoBootstrap with any type of body
+func NewPostGithubReposByOwnerByRepoBootstrapRequestWithBody(server string, owner string, repo string, params *PostGithubReposByOwnerByRepoBootstrapParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/github/repos/%s/%s/bootstrap", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetHealthRequest generates requests for GetHealth
+func NewGetHealthRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/health")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetHooksRequest generates requests for GetHooks
+func NewGetHooksRequest(server string, params *GetHooksParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/hooks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostHooksRequest calls the generic PostHooks builder with application/json body
+func NewPostHooksRequest(server string, params *PostHooksParams, body PostHooksJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostHooksRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostHooksRequestWithBody generates requests for PostHooks with any type of body
+func NewPostHooksRequestWithBody(server string, params *PostHooksParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/hooks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteHooksByIdRequest generates requests for DeleteHooksById
+func NewDeleteHooksByIdRequest(server string, id string, params *DeleteHooksByIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/hooks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetJobsDeadRequest generates requests for GetJobsDead
+func NewGetJobsDeadRequest(server string, params *GetJobsDeadParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/jobs/dead")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.All != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "all", runtime.ParamLocationQuery, *params.All); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostJobsDeadByIdRequeueRequest generates requests for PostJobsDeadByIdRequeue
+func NewPostJobsDeadByIdRequeueRequest(server string, id int64, params *PostJobsDeadByIdRequeueParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/jobs/dead/%s/requeue", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostLoginRequest calls the generic PostLogin builder with application/json body
+func NewPostLoginRequest(server string, body PostLoginJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostLoginRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostLoginRequestWithBody generates requests for PostLogin with any type of body
+func NewPostLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/login")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostLoginOidcDeviceRequest generates requests for PostLoginOidcDevice
+func NewPostLoginOidcDeviceRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/login/oidc/device")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostLoginOidcTokenRequest calls the generic PostLoginOidcToken builder with application/json body
+func NewPostLoginOidcTokenRequest(server string, body PostLoginOidcTokenJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostLoginOidcTokenRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostLoginOidcTokenRequestWithBody generates requests for PostLoginOidcToken with any type of body
+func NewPostLoginOidcTokenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/login/oidc/token")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteMeRequest calls the generic DeleteMe builder with application/json body
+func NewDeleteMeRequest(server string, params *DeleteMeParams, body DeleteMeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDeleteMeRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewDeleteMeRequestWithBody generates requests for DeleteMe with any type of body
+func NewDeleteMeRequestWithBody(server string, params *DeleteMeParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetMeRequest generates requests for GetMe
+func NewGetMeRequest(server string, params *GetMeParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPatchMeRequest calls the generic PatchMe builder with application/json body
+func NewPatchMeRequest(server string, params *PatchMeParams, body PatchMeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchMeRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPatchMeRequestWithBody generates requests for PatchMe with any type of body
+func NewPatchMeRequestWithBody(server string, params *PatchMeParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostMe2FaRequest generates requests for PostMe2Fa
+func NewPostMe2FaRequest(server string, params *PostMe2FaParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/2fa")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostMe2FaConfirmRequest calls the generic PostMe2FaConfirm builder with application/json body
+func NewPostMe2FaConfirmRequest(server string, params *PostMe2FaConfirmParams, body PostMe2FaConfirmJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostMe2FaConfirmRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostMe2FaConfirmRequestWithBody generates requests for PostMe2FaConfirm with any type of body
+func NewPostMe2FaConfirmRequestWithBody(server string, params *PostMe2FaConfirmParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/2fa/confirm")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostMe2FaDisableRequest calls the generic PostMe2FaDisable builder with application/json body
+func NewPostMe2FaDisableRequest(server string, params *PostMe2FaDisableParams, body PostMe2FaDisableJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostMe2FaDisableRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostMe2FaDisableRequestWithBody generates requests for PostMe2FaDisable with any type of body
+func NewPostMe2FaDisableRequestWithBody(server string, params *PostMe2FaDisableParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/2fa/disable")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetMeNotificationsRequest generates requests for GetMeNotifications
+func NewGetMeNotificationsRequest(server string, params *GetMeNotificationsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/notifications")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPutMeNotificationsRequest calls the generic PutMeNotifications builder with application/json body
+func NewPutMeNotificationsRequest(server string, params *PutMeNotificationsParams, body PutMeNotificationsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutMeNotificationsRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPutMeNotificationsRequestWithBody generates requests for PutMeNotifications with any type of body
+func NewPutMeNotificationsRequestWithBody(server string, params *PutMeNotificationsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/notifications")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteMeRegistryCredentialRequest generates requests for DeleteMeRegistryCredential
+func NewDeleteMeRegistryCredentialRequest(server string, params *DeleteMeRegistryCredentialParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/registry-credential")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPutMeRegistryCredentialRequest calls the generic PutMeRegistryCredential builder with application/json body
+func NewPutMeRegistryCredentialRequest(server string, params *PutMeRegistryCredentialParams, body PutMeRegistryCredentialJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutMeRegistryCredentialRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPutMeRegistryCredentialRequestWithBody generates requests for PutMeRegistryCredential with any type of body
+func NewPutMeRegistryCredentialRequestWithBody(server string, params *PutMeRegistryCredentialParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/registry-credential")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostPasswordResetRequest calls the generic PostPasswordReset builder with application/json body
+func NewPostPasswordResetRequest(server string, body PostPasswordResetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPasswordResetRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostPasswordResetRequestWithBody generates requests for PostPasswordReset with any type of body
+func NewPostPasswordResetRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/password-reset")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostPasswordResetConfirmRequest calls the generic PostPasswordResetConfirm builder with application/json body
+func NewPostPasswordResetConfirmRequest(server string, body PostPasswordResetConfirmJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPasswordResetConfirmRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostPasswordResetConfirmRequestWithBody generates requests for PostPasswordResetConfirm with any type of body
+func NewPostPasswordResetConfirmRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/password-reset/confirm")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetPoliciesRequest generates requests for GetPolicies
+func NewGetPoliciesRequest(server string, params *GetPoliciesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/policies")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeletePoliciesByNameRequest generates requests for DeletePoliciesByName
+func NewDeletePoliciesByNameRequest(server string, name string, params *DeletePoliciesByNameParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/policies/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPutPoliciesByNameRequest calls the generic PutPoliciesByName builder with application/json body
+func NewPutPoliciesByNameRequest(server string, name string, params *PutPoliciesByNameParams, body PutPoliciesByNameJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutPoliciesByNameRequestWithBody(server, name, params, "application/json", bodyReader)
+}
+
+// NewPutPoliciesByNameRequestWithBody generates requests for PutPoliciesByName with any type of body
+func NewPutPoliciesByNameRequestWithBody(server string, name string, params *PutPoliciesByNameParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/policies/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetProvidersRequest generates requests for GetProviders
+func NewGetProvidersRequest(server string, params *GetProvidersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/providers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostRegisterRequest calls the generic PostRegister builder with application/json body
+func NewPostRegisterRequest(server string, body PostRegisterJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRegisterRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostRegisterRequestWithBody generates requests for PostRegister with any type of body
+func NewPostRegisterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/register")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetSessionsRequest generates requests for GetSessions
+func NewGetSessionsRequest(server string, params *GetSessionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteSessionsByIdRequest generates requests for DeleteSessionsById
+func NewDeleteSessionsByIdRequest(server string, id string, params *DeleteSessionsByIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sessions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetTransfersRequest generates requests for GetTransfers
+func NewGetTransfersRequest(server string, params *GetTransfersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transfers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostTransfersByIdAcceptRequest generates requests for PostTransfersByIdAccept
+func NewPostTransfersByIdAcceptRequest(server string, id string, params *PostTransfersByIdAcceptParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transfers/%s/accept", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostTransfersByIdDeclineRequest generates requests for PostTransfersByIdDecline
+func NewPostTransfersByIdDeclineRequest(server string, id string, params *PostTransfersByIdDeclineParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transfers/%s/decline", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetTrashRequest generates requests for GetTrash
+func NewGetTrashRequest(server string, params *GetTrashParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trash")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetUsageRequest generates requests for GetUsage
+func NewGetUsageRequest(server string, params *GetUsageParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/usage")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.UserId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "user_id", runtime.ParamLocationQuery, *params.UserId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetVersionRequest generates requests for GetVersion
+func NewGetVersionRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/version")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostWebhooksGithubAppRequest calls the generic PostWebhooksGithubApp builder with application/json body
+func NewPostWebhooksGithubAppRequest(server string, params *PostWebhooksGithubAppParams, body PostWebhooksGithubAppJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostWebhooksGithubAppRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostWebhooksGithubAppRequestWithBody generates requests for PostWebhooksGithubApp with any type of body
+func NewPostWebhooksGithubAppRequestWithBody(server string, params *PostWebhooksGithubAppParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/github-app")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XHubSignature256 != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Hub-Signature-256", runtime.ParamLocationHeader, *params.XHubSignature256)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Hub-Signature-256", headerParam0)
+		}
+
+		if params.XGitHubEvent != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "X-GitHub-Event", runtime.ParamLocationHeader, *params.XGitHubEvent)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-GitHub-Event", headerParam1)
+		}
+
+		if params.XGitHubDelivery != nil {
+			var headerParam2 string
+
+			headerParam2, err = runtime.StyleParamWithLocation("simple", false, "X-GitHub-Delivery", runtime.ParamLocationHeader, *params.XGitHubDelivery)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-GitHub-Delivery", headerParam2)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostWebhooksGithubByIdRequest calls the generic PostWebhooksGithubById builder with application/json body
+func NewPostWebhooksGithubByIdRequest(server string, id string, params *PostWebhooksGithubByIdParams, body PostWebhooksGithubByIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostWebhooksGithubByIdRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewPostWebhooksGithubByIdRequestWithBody generates requests for PostWebhooksGithubById with any type of body
+func NewPostWebhooksGithubByIdRequestWithBody(server string, id string, params *PostWebhooksGithubByIdParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/github/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XHubSignature != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Hub-Signature", runtime.ParamLocationHeader, *params.XHubSignature)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Hub-Signature", headerParam0)
+		}
+
+		if params.XGitHubHookID != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "X-GitHub-Hook-ID", runtime.ParamLocationHeader, *params.XGitHubHookID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-GitHub-Hook-ID", headerParam1)
+		}
+
+		if params.XGitHubEvent != nil {
+			var headerParam2 string
+
+			headerParam2, err = runtime.StyleParamWithLocation("simple", false, "X-GitHub-Event", runtime.ParamLocationHeader, *params.XGitHubEvent)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-GitHub-Event", headerParam2)
+		}
+
+		if params.XGitHubDelivery != nil {
+			var headerParam3 string
+
+			headerParam3, err = runtime.StyleParamWithLocation("simple", false, "X-GitHub-Delivery", runtime.ParamLocationHeader, *params.XGitHubDelivery)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-GitHub-Delivery", headerParam3)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostWebhooksRegistryByIdRequestWithBody generates requests for PostWebhooksRegistryById with any type of body
+func NewPostWebhooksRegistryByIdRequestWithBody(server string, id string, params *PostWebhooksRegistryByIdParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/registry/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Token != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "token", runtime.ParamLocationQuery, *params.Token); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Authorization != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Authorization", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetActivityWithResponse request
+	GetActivityWithResponse(ctx context.Context, params *GetActivityParams, reqEditors ...RequestEditorFn) (*GetActivityResponse, error)
+
+	// GetAdminActivityWithResponse request
+	GetAdminActivityWithResponse(ctx context.Context, params *GetAdminActivityParams, reqEditors ...RequestEditorFn) (*GetAdminActivityResponse, error)
+
+	// DeleteAdminGithubAppWithResponse request
+	DeleteAdminGithubAppWithResponse(ctx context.Context, params *DeleteAdminGithubAppParams, reqEditors ...RequestEditorFn) (*DeleteAdminGithubAppResponse, error)
+
+	// GetAdminGithubAppWithResponse request
+	GetAdminGithubAppWithResponse(ctx context.Context, params *GetAdminGithubAppParams, reqEditors ...RequestEditorFn) (*GetAdminGithubAppResponse, error)
+
+	// PutAdminGithubAppWithBodyWithResponse request with any body
+	PutAdminGithubAppWithBodyWithResponse(ctx context.Context, params *PutAdminGithubAppParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminGithubAppResponse, error)
+
+	PutAdminGithubAppWithResponse(ctx context.Context, params *PutAdminGithubAppParams, body PutAdminGithubAppJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminGithubAppResponse, error)
+
+	// GetAdminLimitsWithResponse request
+	GetAdminLimitsWithResponse(ctx context.Context, params *GetAdminLimitsParams, reqEditors ...RequestEditorFn) (*GetAdminLimitsResponse, error)
+
+	// PutAdminLimitsWithBodyWithResponse request with any body
+	PutAdminLimitsWithBodyWithResponse(ctx context.Context, params *PutAdminLimitsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminLimitsResponse, error)
+
+	PutAdminLimitsWithResponse(ctx context.Context, params *PutAdminLimitsParams, body PutAdminLimitsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminLimitsResponse, error)
+
+	// GetAdminRetentionWithResponse request
+	GetAdminRetentionWithResponse(ctx context.Context, params *GetAdminRetentionParams, reqEditors ...RequestEditorFn) (*GetAdminRetentionResponse, error)
+
+	// GetAdminUsersWithResponse request
+	GetAdminUsersWithResponse(ctx context.Context, params *GetAdminUsersParams, reqEditors ...RequestEditorFn) (*GetAdminUsersResponse, error)
+
+	// PatchAdminUsersByIdWithBodyWithResponse request with any body
+	PatchAdminUsersByIdWithBodyWithResponse(ctx context.Context, id string, params *PatchAdminUsersByIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchAdminUsersByIdResponse, error)
+
+	PatchAdminUsersByIdWithResponse(ctx context.Context, id string, params *PatchAdminUsersByIdParams, body PatchAdminUsersByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchAdminUsersByIdResponse, error)
+
+	// GetAdminUsersByIdRetentionWithResponse request
+	GetAdminUsersByIdRetentionWithResponse(ctx context.Context, id string, params *GetAdminUsersByIdRetentionParams, reqEditors ...RequestEditorFn) (*GetAdminUsersByIdRetentionResponse, error)
+
+	// PutAdminUsersByIdRetentionWithBodyWithResponse request with any body
+	PutAdminUsersByIdRetentionWithBodyWithResponse(ctx context.Context, id string, params *PutAdminUsersByIdRetentionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminUsersByIdRetentionResponse, error)
+
+	PutAdminUsersByIdRetentionWithResponse(ctx context.Context, id string, params *PutAdminUsersByIdRetentionParams, body PutAdminUsersByIdRetentionJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminUsersByIdRetentionResponse, error)
+
+	// GetAgentDeploymentsNextWithResponse request
+	GetAgentDeploymentsNextWithResponse(ctx context.Context, params *GetAgentDeploymentsNextParams, reqEditors ...RequestEditorFn) (*GetAgentDeploymentsNextResponse, error)
+
+	// PostAgentDeploymentsByIdStatusWithBodyWithResponse request with any body
+	PostAgentDeploymentsByIdStatusWithBodyWithResponse(ctx context.Context, id int64, params *PostAgentDeploymentsByIdStatusParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAgentDeploymentsByIdStatusResponse, error)
+
+	PostAgentDeploymentsByIdStatusWithResponse(ctx context.Context, id int64, params *PostAgentDeploymentsByIdStatusParams, body PostAgentDeploymentsByIdStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAgentDeploymentsByIdStatusResponse, error)
+
+	// GetAgentsWithResponse request
+	GetAgentsWithResponse(ctx context.Context, params *GetAgentsParams, reqEditors ...RequestEditorFn) (*GetAgentsResponse, error)
+
+	// PostAgentsWithBodyWithResponse request with any body
+	PostAgentsWithBodyWithResponse(ctx context.Context, params *PostAgentsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAgentsResponse, error)
+
+	PostAgentsWithResponse(ctx context.Context, params *PostAgentsParams, body PostAgentsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAgentsResponse, error)
+
+	// GetBuildsByIdWithResponse request
+	GetBuildsByIdWithResponse(ctx context.Context, id int64, params *GetBuildsByIdParams, reqEditors ...RequestEditorFn) (*GetBuildsByIdResponse, error)
+
+	// GetBuildsByIdArtifactsWithResponse request
+	GetBuildsByIdArtifactsWithResponse(ctx context.Context, id int64, params *GetBuildsByIdArtifactsParams, reqEditors ...RequestEditorFn) (*GetBuildsByIdArtifactsResponse, error)
+
+	// GetBuildsByIdArtifactsByNameWithResponse request
+	GetBuildsByIdArtifactsByNameWithResponse(ctx context.Context, id int64, name string, params *GetBuildsByIdArtifactsByNameParams, reqEditors ...RequestEditorFn) (*GetBuildsByIdArtifactsByNameResponse, error)
+
+	// GetBuildsByIdConfigWithResponse request
+	GetBuildsByIdConfigWithResponse(ctx context.Context, id int64, params *GetBuildsByIdConfigParams, reqEditors ...RequestEditorFn) (*GetBuildsByIdConfigResponse, error)
+
+	// GetBuildsByIdLogsWithResponse request
+	GetBuildsByIdLogsWithResponse(ctx context.Context, id int64, params *GetBuildsByIdLogsParams, reqEditors ...RequestEditorFn) (*GetBuildsByIdLogsResponse, error)
+
+	// GetBuildsByIdPipelineWithResponse request
+	GetBuildsByIdPipelineWithResponse(ctx context.Context, id int64, params *GetBuildsByIdPipelineParams, reqEditors ...RequestEditorFn) (*GetBuildsByIdPipelineResponse, error)
+
+	// GetBuildsByIdProvenanceWithResponse request
+	GetBuildsByIdProvenanceWithResponse(ctx context.Context, id int64, params *GetBuildsByIdProvenanceParams, reqEditors ...RequestEditorFn) (*GetBuildsByIdProvenanceResponse, error)
+
+	// GetCliReleaseWithResponse request
+	GetCliReleaseWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCliReleaseResponse, error)
+
+	// GetConfigsWithResponse request
+	GetConfigsWithResponse(ctx context.Context, params *GetConfigsParams, reqEditors ...RequestEditorFn) (*GetConfigsResponse, error)
+
+	// PostConfigsWithBodyWithResponse request with any body
+	PostConfigsWithBodyWithResponse(ctx context.Context, params *PostConfigsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostConfigsResponse, error)
+
+	PostConfigsWithResponse(ctx context.Context, params *PostConfigsParams, body PostConfigsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostConfigsResponse, error)
+
+	// PostConfigsImportWithBodyWithResponse request with any body
+	PostConfigsImportWithBodyWithResponse(ctx context.Context, params *PostConfigsImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostConfigsImportResponse, error)
+
+	PostConfigsImportWithResponse(ctx context.Context, params *PostConfigsImportParams, body PostConfigsImportJSONRequestBody, reqEditors ...RequestEditorFn) (*PostConfigsImportResponse, error)
+
+	// PostConfigsSyncWithResponse request
+	PostConfigsSyncWithResponse(ctx context.Context, params *PostConfigsSyncParams, reqEditors ...RequestEditorFn) (*PostConfigsSyncResponse, error)
+
+	// DeleteConfigsByIdWithResponse request
+	DeleteConfigsByIdWithResponse(ctx context.Context, id string, params *DeleteConfigsByIdParams, reqEditors ...RequestEditorFn) (*DeleteConfigsByIdResponse, error)
+
+	// GetConfigsByIdWithResponse request
+	GetConfigsByIdWithResponse(ctx context.Context, id string, params *GetConfigsByIdParams, reqEditors ...RequestEditorFn) (*GetConfigsByIdResponse, error)
+
+	// PatchConfigsByIdWithBodyWithResponse request with any body
+	PatchConfigsByIdWithBodyWithResponse(ctx context.Context, id string, params *PatchConfigsByIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchConfigsByIdResponse, error)
+
+	PatchConfigsByIdWithResponse(ctx context.Context, id string, params *PatchConfigsByIdParams, body PatchConfigsByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchConfigsByIdResponse, error)
+
+	// PatchConfigsByIdAgentWithBodyWithResponse request with any body
+	PatchConfigsByIdAgentWithBodyWithResponse(ctx context.Context, id string, params *PatchConfigsByIdAgentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchConfigsByIdAgentResponse, error)
+
+	PatchConfigsByIdAgentWithResponse(ctx context.Context, id string, params *PatchConfigsByIdAgentParams, body PatchConfigsByIdAgentJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchConfigsByIdAgentResponse, error)
+
+	// PatchConfigsByIdClusterWithBodyWithResponse request with any body
+	PatchConfigsByIdClusterWithBodyWithResponse(ctx context.Context, id string, params *PatchConfigsByIdClusterParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchConfigsByIdClusterResponse, error)
+
+	PatchConfigsByIdClusterWithResponse(ctx context.Context, id string, params *PatchConfigsByIdClusterParams, body PatchConfigsByIdClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchConfigsByIdClusterResponse, error)
+
+	// GetConfigsByIdDeniedAuthorsWithResponse request
+	GetConfigsByIdDeniedAuthorsWithResponse(ctx context.Context, id string, params *GetConfigsByIdDeniedAuthorsParams, reqEditors ...RequestEditorFn) (*GetConfigsByIdDeniedAuthorsResponse, error)
+
+	// PutConfigsByIdDeniedAuthorsWithBodyWithResponse request with any body
+	PutConfigsByIdDeniedAuthorsWithBodyWithResponse(ctx context.Context, id string, params *PutConfigsByIdDeniedAuthorsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutConfigsByIdDeniedAuthorsResponse, error)
+
+	PutConfigsByIdDeniedAuthorsWithResponse(ctx context.Context, id string, params *PutConfigsByIdDeniedAuthorsParams, body PutConfigsByIdDeniedAuthorsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutConfigsByIdDeniedAuthorsResponse, error)
+
+	// GetConfigsByIdDeployTokensWithResponse request
+	GetConfigsByIdDeployTokensWithResponse(ctx context.Context, id string, params *GetConfigsByIdDeployTokensParams, reqEditors ...RequestEditorFn) (*GetConfigsByIdDeployTokensResponse, error)
+
+	// PostConfigsByIdDeployTokensWithBodyWithResponse request with any body
+	PostConfigsByIdDeployTokensWithBodyWithResponse(ctx context.Context, id string, params *PostConfigsByIdDeployTokensParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostConfigsByIdDeployTokensResponse, error)
+
+	PostConfigsByIdDeployTokensWithResponse(ctx context.Context, id string, params *PostConfigsByIdDeployTokensParams, body PostConfigsByIdDeployTokensJSONRequestBody, reqEditors ...RequestEditorFn) (*PostConfigsByIdDeployTokensResponse, error)
+
+	// DeleteConfigsByIdDeployTokensByTokenIdWithResponse request
+	DeleteConfigsByIdDeployTokensByTokenIdWithResponse(ctx context.Context, id string, tokenId string, params *DeleteConfigsByIdDeployTokensByTokenIdParams, reqEditors ...RequestEditorFn) (*DeleteConfigsByIdDeployTokensByTokenIdResponse, error)
+
+	// GetConfigsByIdDeploymentsWithResponse request
+	GetConfigsByIdDeploymentsWithResponse(ctx context.Context, id string, params *GetConfigsByIdDeploymentsParams, reqEditors ...RequestEditorFn) (*GetConfigsByIdDeploymentsResponse, error)
+
+	// GetConfigsByIdEnvWithResponse request
+	GetConfigsByIdEnvWithResponse(ctx context.Context, id string, params *GetConfigsByIdEnvParams, reqEditors ...RequestEditorFn) (*GetConfigsByIdEnvResponse, error)
+
+	// PostConfigsByIdEnvWithBodyWithResponse request with any body
+	PostConfigsByIdEnvWithBodyWithResponse(ctx context.Context, id string, params *PostConfigsByIdEnvParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostConfigsByIdEnvResponse, error)
+
+	PostConfigsByIdEnvWithResponse(ctx context.Context, id string, params *PostConfigsByIdEnvParams, body PostConfigsByIdEnvJSONRequestBody, reqEditors ...RequestEditorFn) (*PostConfigsByIdEnvResponse, error)
+
+	// DeleteConfigsByIdEnvByNameWithResponse request
+	DeleteConfigsByIdEnvByNameWithResponse(ctx context.Context, id string, name string, params *DeleteConfigsByIdEnvByNameParams, reqEditors ...RequestEditorFn) (*DeleteConfigsByIdEnvByNameResponse, error)
+
+	// GetConfigsByIdEnvironmentsWithResponse request
+	GetConfigsByIdEnvironmentsWithResponse(ctx context.Context, id string, params *GetConfigsByIdEnvironmentsParams, reqEditors ...RequestEditorFn) (*GetConfigsByIdEnvironmentsResponse, error)
+
+	// GetConfigsByIdExportWithResponse request
+	GetConfigsByIdExportWithResponse(ctx context.Context, id string, params *GetConfigsByIdExportParams, reqEditors ...RequestEditorFn) (*GetConfigsByIdExportResponse, error)
+
+	// DeleteConfigsByIdRegistryWebhookWithResponse request
+	DeleteConfigsByIdRegistryWebhookWithResponse(ctx context.Context, id string, params *DeleteConfigsByIdRegistryWebhookParams, reqEditors ...RequestEditorFn) (*DeleteConfigsByIdRegistryWebhookResponse, error)
+
+	// PostConfigsByIdRegistryWebhookWithResponse request
+	PostConfigsByIdRegistryWebhookWithResponse(ctx context.Context, id string, params *PostConfigsByIdRegistryWebhookParams, reqEditors ...RequestEditorFn) (*PostConfigsByIdRegistryWebhookResponse, error)
+
+	// PostConfigsByIdRestoreWithResponse request
+	PostConfigsByIdRestoreWithResponse(ctx context.Context, id string, params *PostConfigsByIdRestoreParams, reqEditors ...RequestEditorFn) (*PostConfigsByIdRestoreResponse, error)
+
+	// GetConfigsByIdRetentionWithResponse request
+	GetConfigsByIdRetentionWithResponse(ctx context.Context, id string, params *GetConfigsByIdRetentionParams, reqEditors ...RequestEditorFn) (*GetConfigsByIdRetentionResponse, error)
+
+	// PutConfigsByIdRetentionWithBodyWithResponse request with any body
+	PutConfigsByIdRetentionWithBodyWithResponse(ctx context.Context, id string, params *PutConfigsByIdRetentionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutConfigsByIdRetentionResponse, error)
+
+	PutConfigsByIdRetentionWithResponse(ctx context.Context, id string, params *PutConfigsByIdRetentionParams, body PutConfigsByIdRetentionJSONRequestBody, reqEditors ...RequestEditorFn) (*PutConfigsByIdRetentionResponse, error)
+
+	// GetConfigsByIdSecretScanWithResponse request
+	GetConfigsByIdSecretScanWithResponse(ctx context.Context, id string, params *GetConfigsByIdSecretScanParams, reqEditors ...RequestEditorFn) (*GetConfigsByIdSecretScanResponse, error)
+
+	// PutConfigsByIdSecretScanWithBodyWithResponse request with any body
+	PutConfigsByIdSecretScanWithBodyWithResponse(ctx context.Context, id string, params *PutConfigsByIdSecretScanParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutConfigsByIdSecretScanResponse, error)
+
+	PutConfigsByIdSecretScanWithResponse(ctx context.Context, id string, params *PutConfigsByIdSecretScanParams, body PutConfigsByIdSecretScanJSONRequestBody, reqEditors ...RequestEditorFn) (*PutConfigsByIdSecretScanResponse, error)
+
+	// DeleteConfigsByIdTransferWithResponse request
+	DeleteConfigsByIdTransferWithResponse(ctx context.Context, id string, params *DeleteConfigsByIdTransferParams, reqEditors ...RequestEditorFn) (*DeleteConfigsByIdTransferResponse, error)
+
+	// PostConfigsByIdTransferWithBodyWithResponse request with any body
+	PostConfigsByIdTransferWithBodyWithResponse(ctx context.Context, id string, params *PostConfigsByIdTransferParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostConfigsByIdTransferResponse, error)
+
+	PostConfigsByIdTransferWithResponse(ctx context.Context, id string, params *PostConfigsByIdTransferParams, body PostConfigsByIdTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*PostConfigsByIdTransferResponse, error)
+
+	// PatchConfigsByIdWebhookWithBodyWithResponse request with any body
+	PatchConfigsByIdWebhookWithBodyWithResponse(ctx context.Context, id string, params *PatchConfigsByIdWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchConfigsByIdWebhookResponse, error)
+
+	PatchConfigsByIdWebhookWithResponse(ctx context.Context, id string, params *PatchConfigsByIdWebhookParams, body PatchConfigsByIdWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchConfigsByIdWebhookResponse, error)
+
+	// PostConfigsByIdWebhookWithBodyWithResponse request with any body
+	PostConfigsByIdWebhookWithBodyWithResponse(ctx context.Context, id string, params *PostConfigsByIdWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostConfigsByIdWebhookResponse, error)
+
+	PostConfigsByIdWebhookWithResponse(ctx context.Context, id string, params *PostConfigsByIdWebhookParams, body PostConfigsByIdWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*PostConfigsByIdWebhookResponse, error)
+
+	// GetCredentialsWithResponse request
+	GetCredentialsWithResponse(ctx context.Context, params *GetCredentialsParams, reqEditors ...RequestEditorFn) (*GetCredentialsResponse, error)
+
+	// PostCredentialsWithBodyWithResponse request with any body
+	PostCredentialsWithBodyWithResponse(ctx context.Context, params *PostCredentialsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostCredentialsResponse, error)
+
+	PostCredentialsWithResponse(ctx context.Context, params *PostCredentialsParams, body PostCredentialsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostCredentialsResponse, error)
+
+	// GetCredentialsGithubInstallationTokenWithResponse request
+	GetCredentialsGithubInstallationTokenWithResponse(ctx context.Context, params *GetCredentialsGithubInstallationTokenParams, reqEditors ...RequestEditorFn) (*GetCredentialsGithubInstallationTokenResponse, error)
+
+	// GetCredentialsGithubTokenWithResponse request
+	GetCredentialsGithubTokenWithResponse(ctx context.Context, params *GetCredentialsGithubTokenParams, reqEditors ...RequestEditorFn) (*GetCredentialsGithubTokenResponse, error)
+
+	// DeleteCredentialsByIdWithResponse request
+	DeleteCredentialsByIdWithResponse(ctx context.Context, id int64, params *DeleteCredentialsByIdParams, reqEditors ...RequestEditorFn) (*DeleteCredentialsByIdResponse, error)
+
+	// GetCredentialsByIdWithResponse request
+	GetCredentialsByIdWithResponse(ctx context.Context, id int64, params *GetCredentialsByIdParams, reqEditors ...RequestEditorFn) (*GetCredentialsByIdResponse, error)
+
+	// PutCredentialsByIdWithBodyWithResponse request with any body
+	PutCredentialsByIdWithBodyWithResponse(ctx context.Context, id int64, params *PutCredentialsByIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutCredentialsByIdResponse, error)
+
+	PutCredentialsByIdWithResponse(ctx context.Context, id int64, params *PutCredentialsByIdParams, body PutCredentialsByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutCredentialsByIdResponse, error)
+
+	// PostCredentialsByIdRestoreWithResponse request
+	PostCredentialsByIdRestoreWithResponse(ctx context.Context, id int64, params *PostCredentialsByIdRestoreParams, reqEditors ...RequestEditorFn) (*PostCredentialsByIdRestoreResponse, error)
+
+	// GetDeployStatusWithResponse request
+	GetDeployStatusWithResponse(ctx context.Context, params *GetDeployStatusParams, reqEditors ...RequestEditorFn) (*GetDeployStatusResponse, error)
+
+	// PostDeployTriggerWithBodyWithResponse request with any body
+	PostDeployTriggerWithBodyWithResponse(ctx context.Context, params *PostDeployTriggerParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostDeployTriggerResponse, error)
+
+	PostDeployTriggerWithResponse(ctx context.Context, params *PostDeployTriggerParams, body PostDeployTriggerJSONRequestBody, reqEditors ...RequestEditorFn) (*PostDeployTriggerResponse, error)
+
+	// GetDeploymentsByIdManifestsWithResponse request
+	GetDeploymentsByIdManifestsWithResponse(ctx context.Context, id int64, params *GetDeploymentsByIdManifestsParams, reqEditors ...RequestEditorFn) (*GetDeploymentsByIdManifestsResponse, error)
+
+	// GetDeploymentsByIdResourcesWithResponse request
+	GetDeploymentsByIdResourcesWithResponse(ctx context.Context, id int64, params *GetDeploymentsByIdResourcesParams, reqEditors ...RequestEditorFn) (*GetDeploymentsByIdResourcesResponse, error)
+
+	// PostDeploymentsByIdRollbackWithResponse request
+	PostDeploymentsByIdRollbackWithResponse(ctx context.Context, id int64, params *PostDeploymentsByIdRollbackParams, reqEditors ...RequestEditorFn) (*PostDeploymentsByIdRollbackResponse, error)
+
+	// PostEmailChangeConfirmWithBodyWithResponse request with any body
+	PostEmailChangeConfirmWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostEmailChangeConfirmResponse, error)
+
+	PostEmailChangeConfirmWithResponse(ctx context.Context, body PostEmailChangeConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*PostEmailChangeConfirmResponse, error)
+
+	// GetGithubAppWithResponse request
+	GetGithubAppWithResponse(ctx context.Context, params *GetGithubAppParams, reqEditors ...RequestEditorFn) (*GetGithubAppResponse, error)
+
+	// PostGithubInstallationsByIdCheckWithResponse request
+	PostGithubInstallationsByIdCheckWithResponse(ctx context.Context, id int64, params *PostGithubInstallationsByIdCheckParams, reqEditors ...RequestEditorFn) (*PostGithubInstallationsByIdCheckResponse, error)
+
+	// PostGithubReposByOwnerByRepoBootstrapWithBodyWithResponse request with any body
+	PostGithubReposByOwnerByRepoBootstrapWithBodyWithResponse(ctx context.Context, owner string, repo string, params *PostGithubReposByOwnerByRepoBootstrapParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostGithubReposByOwnerByRepoBootstrapResponse, error)
+
+	PostGithubReposByOwnerByRepoBootstrapWithResponse(ctx context.Context, owner string, repo string, params *PostGithubReposByOwnerByRepoBootstrapParams, body PostGithubReposByOwnerByRepoBootstrapJSONRequestBody, reqEditors ...RequestEditorFn) (*PostGithubReposByOwnerByRepoBootstrapResponse, error)
+
+	// GetHealthWithResponse request
+	GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error)
+
+	// GetHooksWithResponse request
+	GetHooksWithResponse(ctx context.Context, params *GetHooksParams, reqEditors ...RequestEditorFn) (*GetHooksResponse, error)
+
+	// PostHooksWithBodyWithResponse request with any body
+	PostHooksWithBodyWithResponse(ctx context.Context, params *PostHooksParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostHooksResponse, error)
+
+	PostHooksWithResponse(ctx context.Context, params *PostHooksParams, body PostHooksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostHooksResponse, error)
+
+	// DeleteHooksByIdWithResponse request
+	DeleteHooksByIdWithResponse(ctx context.Context, id string, params *DeleteHooksByIdParams, reqEditors ...RequestEditorFn) (*DeleteHooksByIdResponse, error)
+
+	// GetJobsDeadWithResponse request
+	GetJobsDeadWithResponse(ctx context.Context, params *GetJobsDeadParams, reqEditors ...RequestEditorFn) (*GetJobsDeadResponse, error)
+
+	// PostJobsDeadByIdRequeueWithResponse request
+	PostJobsDeadByIdRequeueWithResponse(ctx context.Context, id int64, params *PostJobsDeadByIdRequeueParams, reqEditors ...RequestEditorFn) (*PostJobsDeadByIdRequeueResponse, error)
+
+	// PostLoginWithBodyWithResponse request with any body
+	PostLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLoginResponse, error)
+
+	PostLoginWithResponse(ctx context.Context, body PostLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*PostLoginResponse, error)
+
+	// PostLoginOidcDeviceWithResponse request
+	PostLoginOidcDeviceWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostLoginOidcDeviceResponse, error)
+
+	// PostLoginOidcTokenWithBodyWithResponse request with any body
+	PostLoginOidcTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLoginOidcTokenResponse, error)
+
+	PostLoginOidcTokenWithResponse(ctx context.Context, body PostLoginOidcTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*PostLoginOidcTokenResponse, error)
+
+	// DeleteMeWithBodyWithResponse request with any body
+	DeleteMeWithBodyWithResponse(ctx context.Context, params *DeleteMeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteMeResponse, error)
+
+	DeleteMeWithResponse(ctx context.Context, params *DeleteMeParams, body DeleteMeJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteMeResponse, error)
+
+	// GetMeWithResponse request
+	GetMeWithResponse(ctx context.Context, params *GetMeParams, reqEditors ...RequestEditorFn) (*GetMeResponse, error)
+
+	// PatchMeWithBodyWithResponse request with any body
+	PatchMeWithBodyWithResponse(ctx context.Context, params *PatchMeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchMeResponse, error)
+
+	PatchMeWithResponse(ctx context.Context, params *PatchMeParams, body PatchMeJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchMeResponse, error)
+
+	// PostMe2FaWithResponse request
+	PostMe2FaWithResponse(ctx context.Context, params *PostMe2FaParams, reqEditors ...RequestEditorFn) (*PostMe2FaResponse, error)
+
+	// PostMe2FaConfirmWithBodyWithResponse request with any body
+	PostMe2FaConfirmWithBodyWithResponse(ctx context.Context, params *PostMe2FaConfirmParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostMe2FaConfirmResponse, error)
+
+	PostMe2FaConfirmWithResponse(ctx context.Context, params *PostMe2FaConfirmParams, body PostMe2FaConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*PostMe2FaConfirmResponse, error)
+
+	// PostMe2FaDisableWithBodyWithResponse request with any body
+	PostMe2FaDisableWithBodyWithResponse(ctx context.Context, params *PostMe2FaDisableParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostMe2FaDisableResponse, error)
+
+	PostMe2FaDisableWithResponse(ctx context.Context, params *PostMe2FaDisableParams, body PostMe2FaDisableJSONRequestBody, reqEditors ...RequestEditorFn) (*PostMe2FaDisableResponse, error)
+
+	// GetMeNotificationsWithResponse request
+	GetMeNotificationsWithResponse(ctx context.Context, params *GetMeNotificationsParams, reqEditors ...RequestEditorFn) (*GetMeNotificationsResponse, error)
+
+	// PutMeNotificationsWithBodyWithResponse request with any body
+	PutMeNotificationsWithBodyWithResponse(ctx context.Context, params *PutMeNotificationsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutMeNotificationsResponse, error)
+
+	PutMeNotificationsWithResponse(ctx context.Context, params *PutMeNotificationsParams, body PutMeNotificationsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutMeNotificationsResponse, error)
+
+	// DeleteMeRegistryCredentialWithResponse request
+	DeleteMeRegistryCredentialWithResponse(ctx context.Context, params *DeleteMeRegistryCredentialParams, reqEditors ...RequestEditorFn) (*DeleteMeRegistryCredentialResponse, error)
+
+	// PutMeRegistryCredentialWithBodyWithResponse request with any body
+	PutMeRegistryCredentialWithBodyWithResponse(ctx context.Context, params *PutMeRegistryCredentialParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutMeRegistryCredentialResponse, error)
+
+	PutMeRegistryCredentialWithResponse(ctx context.Context, params *PutMeRegistryCredentialParams, body PutMeRegistryCredentialJSONRequestBody, reqEditors ...RequestEditorFn) (*PutMeRegistryCredentialResponse, error)
+
+	// PostPasswordResetWithBodyWithResponse request with any body
+	PostPasswordResetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPasswordResetResponse, error)
+
+	PostPasswordResetWithResponse(ctx context.Context, body PostPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPasswordResetResponse, error)
+
+	// PostPasswordResetConfirmWithBodyWithResponse request with any body
+	PostPasswordResetConfirmWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPasswordResetConfirmResponse, error)
+
+	PostPasswordResetConfirmWithResponse(ctx context.Context, body PostPasswordResetConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPasswordResetConfirmResponse, error)
+
+	// GetPoliciesWithResponse request
+	GetPoliciesWithResponse(ctx context.Context, params *GetPoliciesParams, reqEditors ...RequestEditorFn) (*GetPoliciesResponse, error)
+
+	// DeletePoliciesByNameWithResponse request
+	DeletePoliciesByNameWithResponse(ctx context.Context, name string, params *DeletePoliciesByNameParams, reqEditors ...RequestEditorFn) (*DeletePoliciesByNameResponse, error)
+
+	// PutPoliciesByNameWithBodyWithResponse request with any body
+	PutPoliciesByNameWithBodyWithResponse(ctx context.Context, name string, params *PutPoliciesByNameParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutPoliciesByNameResponse, error)
+
+	PutPoliciesByNameWithResponse(ctx context.Context, name string, params *PutPoliciesByNameParams, body PutPoliciesByNameJSONRequestBody, reqEditors ...RequestEditorFn) (*PutPoliciesByNameResponse, error)
+
+	// GetProvidersWithResponse request
+	GetProvidersWithResponse(ctx context.Context, params *GetProvidersParams, reqEditors ...RequestEditorFn) (*GetProvidersResponse, error)
+
+	// PostRegisterWithBodyWithResponse request with any body
+	PostRegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRegisterResponse, error)
+
+	PostRegisterWithResponse(ctx context.Context, body PostRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRegisterResponse, error)
+
+	// GetSessionsWithResponse request
+	GetSessionsWithResponse(ctx context.Context, params *GetSessionsParams, reqEditors ...RequestEditorFn) (*GetSessionsResponse, error)
+
+	// DeleteSessionsByIdWithResponse request
+	DeleteSessionsByIdWithResponse(ctx context.Context, id string, params *DeleteSessionsByIdParams, reqEditors ...RequestEditorFn) (*DeleteSessionsByIdResponse, error)
+
+	// GetTransfersWithResponse request
+	GetTransfersWithResponse(ctx context.Context, params *GetTransfersParams, reqEditors ...RequestEditorFn) (*GetTransfersResponse, error)
+
+	// PostTransfersByIdAcceptWithResponse request
+	PostTransfersByIdAcceptWithResponse(ctx context.Context, id string, params *PostTransfersByIdAcceptParams, reqEditors ...RequestEditorFn) (*PostTransfersByIdAcceptResponse, error)
+
+	// PostTransfersByIdDeclineWithResponse request
+	PostTransfersByIdDeclineWithResponse(ctx context.Context, id string, params *PostTransfersByIdDeclineParams, reqEditors ...RequestEditorFn) (*PostTransfersByIdDeclineResponse, error)
+
+	// GetTrashWithResponse request
+	GetTrashWithResponse(ctx context.Context, params *GetTrashParams, reqEditors ...RequestEditorFn) (*GetTrashResponse, error)
+
+	// GetUsageWithResponse request
+	GetUsageWithResponse(ctx context.Context, params *GetUsageParams, reqEditors ...RequestEditorFn) (*GetUsageResponse, error)
+
+	// GetVersionWithResponse request
+	GetVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVersionResponse, error)
+
+	// PostWebhooksGithubAppWithBodyWithResponse request with any body
+	PostWebhooksGithubAppWithBodyWithResponse(ctx context.Context, params *PostWebhooksGithubAppParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWebhooksGithubAppResponse, error)
+
+	PostWebhooksGithubAppWithResponse(ctx context.Context, params *PostWebhooksGithubAppParams, body PostWebhooksGithubAppJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWebhooksGithubAppResponse, error)
+
+	// PostWebhooksGithubByIdWithBodyWithResponse request with any body
+	PostWebhooksGithubByIdWithBodyWithResponse(ctx context.Context, id string, params *PostWebhooksGithubByIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWebhooksGithubByIdResponse, error)
+
+	PostWebhooksGithubByIdWithResponse(ctx context.Context, id string, params *PostWebhooksGithubByIdParams, body PostWebhooksGithubByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWebhooksGithubByIdResponse, error)
+
+	// PostWebhooksRegistryByIdWithBodyWithResponse request with any body
+	PostWebhooksRegistryByIdWithBodyWithResponse(ctx context.Context, id string, params *PostWebhooksRegistryByIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWebhooksRegistryByIdResponse, error)
+}
+
+type GetActivityResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *GetActivityResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetActivityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetActivityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminActivityResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *GetAdminActivityResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminActivityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminActivityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAdminGithubAppResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *GitHubAppResponse
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAdminGithubAppResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAdminGithubAppResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminGithubAppResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *GitHubAppResponse
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminGithubAppResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminGithubAppResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAdminGithubAppResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *GitHubAppResponse
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAdminGithubAppResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAdminGithubAppResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminLimitsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *InstanceLimitsBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminLimitsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminLimitsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAdminLimitsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *InstanceLimitsBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAdminLimitsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAdminLimitsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminRetentionResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *GetRetentionStatsResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminRetentionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminRetentionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminUsersResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *ListAdminUsersResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchAdminUsersByIdResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *AdminUserResponse
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchAdminUsersByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchAdminUsersByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminUsersByIdRetentionResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *UserRetentionBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminUsersByIdRetentionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminUsersByIdRetentionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAdminUsersByIdRetentionResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *UserRetentionBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAdminUsersByIdRetentionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAdminUsersByIdRetentionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAgentDeploymentsNextResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *GetNextAgentDeploymentResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAgentDeploymentsNextResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAgentDeploymentsNextResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAgentDeploymentsByIdStatusResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *ReportAgentDeploymentResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAgentDeploymentsByIdStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAgentDeploymentsByIdStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAgentsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *ListAgentsResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAgentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAgentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAgentsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *CreateAgentResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAgentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAgentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBuildsByIdResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *GetBuildResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBuildsByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBuildsByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBuildsByIdArtifactsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *ListBuildArtifactsResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBuildsByIdArtifactsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBuildsByIdArtifactsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBuildsByIdArtifactsByNameResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBuildsByIdArtifactsByNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBuildsByIdArtifactsByNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBuildsByIdConfigResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *GetBuildConfigResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBuildsByIdConfigResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBuildsByIdConfigResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBuildsByIdLogsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *GetBuildLogsResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBuildsByIdLogsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBuildsByIdLogsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBuildsByIdPipelineResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *GetBuildPipelineResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBuildsByIdPipelineResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBuildsByIdPipelineResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBuildsByIdProvenanceResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *GetBuildProvenanceResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBuildsByIdProvenanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBuildsByIdProvenanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCliReleaseResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *GetCLIReleaseResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCliReleaseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCliReleaseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetConfigsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *ListConfigsResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetConfigsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetConfigsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostConfigsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *CreateConfigResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PostConfigsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostConfigsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostConfigsImportResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *ImportConfigResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PostConfigsImportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostConfigsImportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostConfigsSyncResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *SyncConfigsResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PostConfigsSyncResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostConfigsSyncResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteConfigsByIdResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *DeleteConfigResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteConfigsByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteConfigsByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetConfigsByIdResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *GetConfigResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetConfigsByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetConfigsByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchConfigsByIdResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *UpdateConfigResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchConfigsByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchConfigsByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchConfigsByIdAgentResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *UpdateConfigAgentResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchConfigsByIdAgentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchConfigsByIdAgentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchConfigsByIdClusterResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *UpdateConfigClusterResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchConfigsByIdClusterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchConfigsByIdClusterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetConfigsByIdDeniedAuthorsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *DeniedAuthorsBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetConfigsByIdDeniedAuthorsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetConfigsByIdDeniedAuthorsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutConfigsByIdDeniedAuthorsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *DeniedAuthorsBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PutConfigsByIdDeniedAuthorsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutConfigsByIdDeniedAuthorsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetConfigsByIdDeployTokensResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *ListDeployTokensResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetConfigsByIdDeployTokensResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetConfigsByIdDeployTokensResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostConfigsByIdDeployTokensResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *CreateDeployTokenResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PostConfigsByIdDeployTokensResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostConfigsByIdDeployTokensResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteConfigsByIdDeployTokensByTokenIdResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *DeleteDeployTokenResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteConfigsByIdDeployTokensByTokenIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteConfigsByIdDeployTokensByTokenIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetConfigsByIdDeploymentsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *ListConfigDeploymentsResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetConfigsByIdDeploymentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetConfigsByIdDeploymentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetConfigsByIdEnvResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *ConfigEnvBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetConfigsByIdEnvResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetConfigsByIdEnvResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostConfigsByIdEnvResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *ConfigEnvBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PostConfigsByIdEnvResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostConfigsByIdEnvResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteConfigsByIdEnvByNameResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *UnsetConfigEnvResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteConfigsByIdEnvByNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteConfigsByIdEnvByNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetConfigsByIdEnvironmentsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *ListConfigEnvironmentsResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetConfigsByIdEnvironmentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetConfigsByIdEnvironmentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetConfigsByIdExportResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Bundle
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetConfigsByIdExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetConfigsByIdExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteConfigsByIdRegistryWebhookResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *DisableRegistryWebhookResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteConfigsByIdRegistryWebhookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteConfigsByIdRegistryWebhookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostConfigsByIdRegistryWebhookResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *RotateRegistryWebhookResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PostConfigsByIdRegistryWebhookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostConfigsByIdRegistryWebhookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostConfigsByIdRestoreResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *RestoreConfigResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PostConfigsByIdRestoreResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostConfigsByIdRestoreResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetConfigsByIdRetentionResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *RetentionBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetConfigsByIdRetentionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetConfigsByIdRetentionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutConfigsByIdRetentionResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *RetentionBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PutConfigsByIdRetentionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutConfigsByIdRetentionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetConfigsByIdSecretScanResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *SecretScanBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetConfigsByIdSecretScanResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetConfigsByIdSecretScanResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutConfigsByIdSecretScanResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *SecretScanBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PutConfigsByIdSecretScanResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutConfigsByIdSecretScanResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteConfigsByIdTransferResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *CancelConfigTransferResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteConfigsByIdTransferResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteConfigsByIdTransferResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostConfigsByIdTransferResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *StartConfigTransferResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PostConfigsByIdTransferResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostConfigsByIdTransferResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchConfigsByIdWebhookResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *UpdateConfigWebhookResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchConfigsByIdWebhookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchConfigsByIdWebhookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostConfigsByIdWebhookResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *CreateConfigWebhookResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PostConfigsByIdWebhookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostConfigsByIdWebhookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCredentialsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *ListCredentialsResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCredentialsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCredentialsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostCredentialsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *StoreCredentialResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PostCredentialsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostCredentialsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCredentialsGithubInstallationTokenResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *GetGitHubInstallationTokenResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCredentialsGithubInstallationTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCredentialsGithubInstallationTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCredentialsGithubTokenResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *GetGitHubTokenResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCredentialsGithubTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCredentialsGithubTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteCredentialsByIdResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *DeleteCredentialResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteCredentialsByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteCredentialsByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCredentialsByIdResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *GetCredentialResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCredentialsByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCredentialsByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutCredentialsByIdResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *GetCredentialResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PutCredentialsByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutCredentialsByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostCredentialsByIdRestoreResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *RestoreCredentialResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PostCredentialsByIdRestoreResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostCredentialsByIdRestoreResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDeployStatusResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *GetDeployStatusResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDeployStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDeployStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostDeployTriggerResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *TriggerDeployResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PostDeployTriggerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostDeployTriggerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDeploymentsByIdManifestsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *GetDeploymentManifestsResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDeploymentsByIdManifestsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDeploymentsByIdManifestsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDeploymentsByIdResourcesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *GetDeploymentResourcesResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDeploymentsByIdResourcesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDeploymentsByIdResourcesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostDeploymentsByIdRollbackResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *RollbackDeploymentResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PostDeploymentsByIdRollbackResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostDeploymentsByIdRollbackResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostEmailChangeConfirmResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *ConfirmEmailChangeResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PostEmailChangeConfirmResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostEmailChangeConfirmResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetGithubAppResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *GetGitHubAppPublicResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetGithubAppResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetGithubAppResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostGithubInstallationsByIdCheckResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *CheckGitHubInstallationResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PostGithubInstallationsByIdCheckResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostGithubInstallationsByIdCheckResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostGithubReposByOwnerByRepoBootstrapResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *BootstrapRepositoryResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PostGithubReposByOwnerByRepoBootstrapResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostGithubReposByOwnerByRepoBootstrapResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHealthResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *HealthCheckResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHealthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHealthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHooksResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *ListHooksResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHooksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHooksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostHooksResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *CreateHookResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PostHooksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostHooksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteHooksByIdResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *DeleteHookResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteHooksByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteHooksByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetJobsDeadResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *ListDeadJobsResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetJobsDeadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetJobsDeadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostJobsDeadByIdRequeueResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *RequeueDeadJobResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PostJobsDeadByIdRequeueResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostJobsDeadByIdRequeueResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostLoginResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *LoginResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PostLoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostLoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostLoginOidcDeviceResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *StartOIDCLoginResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PostLoginOidcDeviceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostLoginOidcDeviceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostLoginOidcTokenResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *LoginResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PostLoginOidcTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostLoginOidcTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteMeResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *DeleteMeResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteMeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteMeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMeResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *MeResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchMeResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *UpdateMeResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchMeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchMeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostMe2FaResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *EnrollTwoFactorResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PostMe2FaResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostMe2FaResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostMe2FaConfirmResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *ConfirmTwoFactorResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PostMe2FaConfirmResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostMe2FaConfirmResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostMe2FaDisableResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *DisableTwoFactorResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PostMe2FaDisableResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostMe2FaDisableResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMeNotificationsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *NotificationPreferencesBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMeNotificationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMeNotificationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutMeNotificationsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *NotificationPreferencesBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PutMeNotificationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutMeNotificationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteMeRegistryCredentialResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *SetDefaultRegistryCredentialResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteMeRegistryCredentialResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteMeRegistryCredentialResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutMeRegistryCredentialResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *SetDefaultRegistryCredentialResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PutMeRegistryCredentialResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutMeRegistryCredentialResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPasswordResetResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *RequestPasswordResetResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPasswordResetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPasswordResetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPasswordResetConfirmResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *ResetPasswordResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPasswordResetConfirmResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPasswordResetConfirmResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPoliciesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *ListPoliciesResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPoliciesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPoliciesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeletePoliciesByNameResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *DeletePolicyResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r DeletePoliciesByNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeletePoliciesByNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutPoliciesByNameResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *PolicyResponse
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PutPoliciesByNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutPoliciesByNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProvidersResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *GetProvidersResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProvidersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProvidersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostRegisterResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *RegisterResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PostRegisterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRegisterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSessionsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *ListSessionsResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteSessionsByIdResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *RevokeSessionResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteSessionsByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSessionsByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTransfersResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *ListTransfersResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTransfersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTransfersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostTransfersByIdAcceptResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *AcceptTransferResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTransfersByIdAcceptResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTransfersByIdAcceptResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostTransfersByIdDeclineResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *DeclineTransferResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTransfersByIdDeclineResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTransfersByIdDeclineResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTrashResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *GetTrashResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTrashResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTrashResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsageResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *GetUsageResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetVersionResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *GetVersionResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetVersionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetVersionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostWebhooksGithubAppResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PostWebhooksGithubAppResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostWebhooksGithubAppResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostWebhooksGithubByIdResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PostWebhooksGithubByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostWebhooksGithubByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostWebhooksRegistryByIdResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *RegistryWebhookResponseBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PostWebhooksRegistryByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostWebhooksRegistryByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetActivityWithResponse request returning *GetActivityResponse
+func (c *ClientWithResponses) GetActivityWithResponse(ctx context.Context, params *GetActivityParams, reqEditors ...RequestEditorFn) (*GetActivityResponse, error) {
+	rsp, err := c.GetActivity(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetActivityResponse(rsp)
+}
+
+// GetAdminActivityWithResponse request returning *GetAdminActivityResponse
+func (c *ClientWithResponses) GetAdminActivityWithResponse(ctx context.Context, params *GetAdminActivityParams, reqEditors ...RequestEditorFn) (*GetAdminActivityResponse, error) {
+	rsp, err := c.GetAdminActivity(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminActivityResponse(rsp)
+}
+
+// DeleteAdminGithubAppWithResponse request returning *DeleteAdminGithubAppResponse
+func (c *ClientWithResponses) DeleteAdminGithubAppWithResponse(ctx context.Context, params *DeleteAdminGithubAppParams, reqEditors ...RequestEditorFn) (*DeleteAdminGithubAppResponse, error) {
+	rsp, err := c.DeleteAdminGithubApp(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAdminGithubAppResponse(rsp)
+}
+
+// GetAdminGithubAppWithResponse request returning *GetAdminGithubAppResponse
+func (c *ClientWithResponses) GetAdminGithubAppWithResponse(ctx context.Context, params *GetAdminGithubAppParams, reqEditors ...RequestEditorFn) (*GetAdminGithubAppResponse, error) {
+	rsp, err := c.GetAdminGithubApp(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminGithubAppResponse(rsp)
+}
+
+// PutAdminGithubAppWithBodyWithResponse request with arbitrary body returning *PutAdminGithubAppResponse
+func (c *ClientWithResponses) PutAdminGithubAppWithBodyWithResponse(ctx context.Context, params *PutAdminGithubAppParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminGithubAppResponse, error) {
+	rsp, err := c.PutAdminGithubAppWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminGithubAppResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutAdminGithubAppWithResponse(ctx context.Context, params *PutAdminGithubAppParams, body PutAdminGithubAppJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminGithubAppResponse, error) {
+	rsp, err := c.PutAdminGithubApp(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminGithubAppResponse(rsp)
+}
+
+// GetAdminLimitsWithResponse request returning *GetAdminLimitsResponse
+func (c *ClientWithResponses) GetAdminLimitsWithResponse(ctx context.Context, params *GetAdminLimitsParams, reqEditors ...RequestEditorFn) (*GetAdminLimitsResponse, error) {
+	rsp, err := c.GetAdminLimits(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminLimitsResponse(rsp)
+}
+
+// PutAdminLimitsWithBodyWithResponse request with arbitrary body returning *PutAdminLimitsResponse
+func (c *ClientWithResponses) PutAdminLimitsWithBodyWithResponse(ctx context.Context, params *PutAdminLimitsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminLimitsResponse, error) {
+	rsp, err := c.PutAdminLimitsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminLimitsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutAdminLimitsWithResponse(ctx context.Context, params *PutAdminLimitsParams, body PutAdminLimitsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminLimitsResponse, error) {
+	rsp, err := c.PutAdminLimits(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminLimitsResponse(rsp)
+}
+
+// GetAdminRetentionWithResponse request returning *GetAdminRetentionResponse
+func (c *ClientWithResponses) GetAdminRetentionWithResponse(ctx context.Context, params *GetAdminRetentionParams, reqEditors ...RequestEditorFn) (*GetAdminRetentionResponse, error) {
+	rsp, err := c.GetAdminRetention(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminRetentionResponse(rsp)
+}
+
+// GetAdminUsersWithResponse request returning *GetAdminUsersResponse
+func (c *ClientWithResponses) GetAdminUsersWithResponse(ctx context.Context, params *GetAdminUsersParams, reqEditors ...RequestEditorFn) (*GetAdminUsersResponse, error) {
+	rsp, err := c.GetAdminUsers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminUsersResponse(rsp)
+}
+
+// PatchAdminUsersByIdWithBodyWithResponse request with arbitrary body returning *PatchAdminUsersByIdResponse
+func (c *ClientWithResponses) PatchAdminUsersByIdWithBodyWithResponse(ctx context.Context, id string, params *PatchAdminUsersByIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchAdminUsersByIdResponse, error) {
+	rsp, err := c.PatchAdminUsersByIdWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchAdminUsersByIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchAdminUsersByIdWithResponse(ctx context.Context, id string, params *PatchAdminUsersByIdParams, body PatchAdminUsersByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchAdminUsersByIdResponse, error) {
+	rsp, err := c.PatchAdminUsersById(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchAdminUsersByIdResponse(rsp)
+}
+
+// GetAdminUsersByIdRetentionWithResponse request returning *GetAdminUsersByIdRetentionResponse
+func (c *ClientWithResponses) GetAdminUsersByIdRetentionWithResponse(ctx context.Context, id string, params *GetAdminUsersByIdRetentionParams, reqEditors ...RequestEditorFn) (*GetAdminUsersByIdRetentionResponse, error) {
+	rsp, err := c.GetAdminUsersByIdRetention(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminUsersByIdRetentionResponse(rsp)
+}
+
+// PutAdminUsersByIdRetentionWithBodyWithResponse request with arbitrary body returning *PutAdminUsersByIdRetentionResponse
+func (c *ClientWithResponses) PutAdminUsersByIdRetentionWithBodyWithResponse(ctx context.Context, id string, params *PutAdminUsersByIdRetentionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminUsersByIdRetentionResponse, error) {
+	rsp, err := c.PutAdminUsersByIdRetentionWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminUsersByIdRetentionResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutAdminUsersByIdRetentionWithResponse(ctx context.Context, id string, params *PutAdminUsersByIdRetentionParams, body PutAdminUsersByIdRetentionJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminUsersByIdRetentionResponse, error) {
+	rsp, err := c.PutAdminUsersByIdRetention(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminUsersByIdRetentionResponse(rsp)
+}
+
+// GetAgentDeploymentsNextWithResponse request returning *GetAgentDeploymentsNextResponse
+func (c *ClientWithResponses) GetAgentDeploymentsNextWithResponse(ctx context.Context, params *GetAgentDeploymentsNextParams, reqEditors ...RequestEditorFn) (*GetAgentDeploymentsNextResponse, error) {
+	rsp, err := c.GetAgentDeploymentsNext(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAgentDeploymentsNextResponse(rsp)
+}
+
+// PostAgentDeploymentsByIdStatusWithBodyWithResponse request with arbitrary body returning *PostAgentDeploymentsByIdStatusResponse
+func (c *ClientWithResponses) PostAgentDeploymentsByIdStatusWithBodyWithResponse(ctx context.Context, id int64, params *PostAgentDeploymentsByIdStatusParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAgentDeploymentsByIdStatusResponse, error) {
+	rsp, err := c.PostAgentDeploymentsByIdStatusWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAgentDeploymentsByIdStatusResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAgentDeploymentsByIdStatusWithResponse(ctx context.Context, id int64, params *PostAgentDeploymentsByIdStatusParams, body PostAgentDeploymentsByIdStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAgentDeploymentsByIdStatusResponse, error) {
+	rsp, err := c.PostAgentDeploymentsByIdStatus(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAgentDeploymentsByIdStatusResponse(rsp)
+}
+
+// GetAgentsWithResponse request returning *GetAgentsResponse
+func (c *ClientWithResponses) GetAgentsWithResponse(ctx context.Context, params *GetAgentsParams, reqEditors ...RequestEditorFn) (*GetAgentsResponse, error) {
+	rsp, err := c.GetAgents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAgentsResponse(rsp)
+}
+
+// PostAgentsWithBodyWithResponse request with arbitrary body returning *PostAgentsResponse
+func (c *ClientWithResponses) PostAgentsWithBodyWithResponse(ctx context.Context, params *PostAgentsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAgentsResponse, error) {
+	rsp, err := c.PostAgentsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAgentsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAgentsWithResponse(ctx context.Context, params *PostAgentsParams, body PostAgentsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAgentsResponse, error) {
+	rsp, err := c.PostAgents(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAgentsResponse(rsp)
+}
+
+// GetBuildsByIdWithResponse request returning *GetBuildsByIdResponse
+func (c *ClientWithResponses) GetBuildsByIdWithResponse(ctx context.Context, id int64, params *GetBuildsByIdParams, reqEditors ...RequestEditorFn) (*GetBuildsByIdResponse, error) {
+	rsp, err := c.GetBuildsById(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBuildsByIdResponse(rsp)
+}
+
+// GetBuildsByIdArtifactsWithResponse request returning *GetBuildsByIdArtifactsResponse
+func (c *ClientWithResponses) GetBuildsByIdArtifactsWithResponse(ctx context.Context, id int64, params *GetBuildsByIdArtifactsParams, reqEditors ...RequestEditorFn) (*GetBuildsByIdArtifactsResponse, error) {
+	rsp, err := c.GetBuildsByIdArtifacts(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBuildsByIdArtifactsResponse(rsp)
+}
+
+// GetBuildsByIdArtifactsByNameWithResponse request returning *GetBuildsByIdArtifactsByNameResponse
+func (c *ClientWithResponses) GetBuildsByIdArtifactsByNameWithResponse(ctx context.Context, id int64, name string, params *GetBuildsByIdArtifactsByNameParams, reqEditors ...RequestEditorFn) (*GetBuildsByIdArtifactsByNameResponse, error) {
+	rsp, err := c.GetBuildsByIdArtifactsByName(ctx, id, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBuildsByIdArtifactsByNameResponse(rsp)
+}
+
+// GetBuildsByIdConfigWithResponse request returning *GetBuildsByIdConfigResponse
+func (c *ClientWithResponses) GetBuildsByIdConfigWithResponse(ctx context.Context, id int64, params *GetBuildsByIdConfigParams, reqEditors ...RequestEditorFn) (*GetBuildsByIdConfigResponse, error) {
+	rsp, err := c.GetBuildsByIdConfig(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBuildsByIdConfigResponse(rsp)
+}
+
+// GetBuildsByIdLogsWithResponse request returning *GetBuildsByIdLogsResponse
+func (c *ClientWithResponses) GetBuildsByIdLogsWithResponse(ctx context.Context, id int64, params *GetBuildsByIdLogsParams, reqEditors ...RequestEditorFn) (*GetBuildsByIdLogsResponse, error) {
+	rsp, err := c.GetBuildsByIdLogs(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBuildsByIdLogsResponse(rsp)
+}
+
+// GetBuildsByIdPipelineWithResponse request returning *GetBuildsByIdPipelineResponse
+func (c *ClientWithResponses) GetBuildsByIdPipelineWithResponse(ctx context.Context, id int64, params *GetBuildsByIdPipelineParams, reqEditors ...RequestEditorFn) (*GetBuildsByIdPipelineResponse, error) {
+	rsp, err := c.GetBuildsByIdPipeline(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBuildsByIdPipelineResponse(rsp)
+}
+
+// GetBuildsByIdProvenanceWithResponse request returning *GetBuildsByIdProvenanceResponse
+func (c *ClientWithResponses) GetBuildsByIdProvenanceWithResponse(ctx context.Context, id int64, params *GetBuildsByIdProvenanceParams, reqEditors ...RequestEditorFn) (*GetBuildsByIdProvenanceResponse, error) {
+	rsp, err := c.GetBuildsByIdProvenance(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBuildsByIdProvenanceResponse(rsp)
+}
+
+// GetCliReleaseWithResponse request returning *GetCliReleaseResponse
+func (c *ClientWithResponses) GetCliReleaseWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCliReleaseResponse, error) {
+	rsp, err := c.GetCliRelease(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCliReleaseResponse(rsp)
+}
+
+// GetConfigsWithResponse request returning *GetConfigsResponse
+func (c *ClientWithResponses) GetConfigsWithResponse(ctx context.Context, params *GetConfigsParams, reqEditors ...RequestEditorFn) (*GetConfigsResponse, error) {
+	rsp, err := c.GetConfigs(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetConfigsResponse(rsp)
+}
+
+// PostConfigsWithBodyWithResponse request with arbitrary body returning *PostConfigsResponse
+func (c *ClientWithResponses) PostConfigsWithBodyWithResponse(ctx context.Context, params *PostConfigsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostConfigsResponse, error) {
+	rsp, err := c.PostConfigsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostConfigsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostConfigsWithResponse(ctx context.Context, params *PostConfigsParams, body PostConfigsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostConfigsResponse, error) {
+	rsp, err := c.PostConfigs(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostConfigsResponse(rsp)
+}
+
+// PostConfigsImportWithBodyWithResponse request with arbitrary body returning *PostConfigsImportResponse
+func (c *ClientWithResponses) PostConfigsImportWithBodyWithResponse(ctx context.Context, params *PostConfigsImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostConfigsImportResponse, error) {
+	rsp, err := c.PostConfigsImportWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostConfigsImportResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostConfigsImportWithResponse(ctx context.Context, params *PostConfigsImportParams, body PostConfigsImportJSONRequestBody, reqEditors ...RequestEditorFn) (*PostConfigsImportResponse, error) {
+	rsp, err := c.PostConfigsImport(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostConfigsImportResponse(rsp)
+}
+
+// PostConfigsSyncWithResponse request returning *PostConfigsSyncResponse
+func (c *ClientWithResponses) PostConfigsSyncWithResponse(ctx context.Context, params *PostConfigsSyncParams, reqEditors ...RequestEditorFn) (*PostConfigsSyncResponse, error) {
+	rsp, err := c.PostConfigsSync(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostConfigsSyncResponse(rsp)
+}
+
+// DeleteConfigsByIdWithResponse request returning *DeleteConfigsByIdResponse
+func (c *ClientWithResponses) DeleteConfigsByIdWithResponse(ctx context.Context, id string, params *DeleteConfigsByIdParams, reqEditors ...RequestEditorFn) (*DeleteConfigsByIdResponse, error) {
+	rsp, err := c.DeleteConfigsById(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteConfigsByIdResponse(rsp)
+}
+
+// GetConfigsByIdWithResponse request returning *GetConfigsByIdResponse
+func (c *ClientWithResponses) GetConfigsByIdWithResponse(ctx context.Context, id string, params *GetConfigsByIdParams, reqEditors ...RequestEditorFn) (*GetConfigsByIdResponse, error) {
+	rsp, err := c.GetConfigsById(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetConfigsByIdResponse(rsp)
+}
+
+// PatchConfigsByIdWithBodyWithResponse request with arbitrary body returning *PatchConfigsByIdResponse
+func (c *ClientWithResponses) PatchConfigsByIdWithBodyWithResponse(ctx context.Context, id string, params *PatchConfigsByIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchConfigsByIdResponse, error) {
+	rsp, err := c.PatchConfigsByIdWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchConfigsByIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchConfigsByIdWithResponse(ctx context.Context, id string, params *PatchConfigsByIdParams, body PatchConfigsByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchConfigsByIdResponse, error) {
+	rsp, err := c.PatchConfigsById(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchConfigsByIdResponse(rsp)
+}
+
+// PatchConfigsByIdAgentWithBodyWithResponse request with arbitrary body returning *PatchConfigsByIdAgentResponse
+func (c *ClientWithResponses) PatchConfigsByIdAgentWithBodyWithResponse(ctx context.Context, id string, params *PatchConfigsByIdAgentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchConfigsByIdAgentResponse, error) {
+	rsp, err := c.PatchConfigsByIdAgentWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchConfigsByIdAgentResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchConfigsByIdAgentWithResponse(ctx context.Context, id string, params *PatchConfigsByIdAgentParams, body PatchConfigsByIdAgentJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchConfigsByIdAgentResponse, error) {
+	rsp, err := c.PatchConfigsByIdAgent(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchConfigsByIdAgentResponse(rsp)
+}
+
+// PatchConfigsByIdClusterWithBodyWithResponse request with arbitrary body returning *PatchConfigsByIdClusterResponse
+func (c *ClientWithResponses) PatchConfigsByIdClusterWithBodyWithResponse(ctx context.Context, id string, params *PatchConfigsByIdClusterParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchConfigsByIdClusterResponse, error) {
+	rsp, err := c.PatchConfigsByIdClusterWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchConfigsByIdClusterResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchConfigsByIdClusterWithResponse(ctx context.Context, id string, params *PatchConfigsByIdClusterParams, body PatchConfigsByIdClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchConfigsByIdClusterResponse, error) {
+	rsp, err := c.PatchConfigsByIdCluster(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchConfigsByIdClusterResponse(rsp)
+}
+
+// GetConfigsByIdDeniedAuthorsWithResponse request returning *GetConfigsByIdDeniedAuthorsResponse
+func (c *ClientWithResponses) GetConfigsByIdDeniedAuthorsWithResponse(ctx context.Context, id string, params *GetConfigsByIdDeniedAuthorsParams, reqEditors ...RequestEditorFn) (*GetConfigsByIdDeniedAuthorsResponse, error) {
+	rsp, err := c.GetConfigsByIdDeniedAuthors(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetConfigsByIdDeniedAuthorsResponse(rsp)
+}
+
+// PutConfigsByIdDeniedAuthorsWithBodyWithResponse request with arbitrary body returning *PutConfigsByIdDeniedAuthorsResponse
+func (c *ClientWithResponses) PutConfigsByIdDeniedAuthorsWithBodyWithResponse(ctx context.Context, id string, params *PutConfigsByIdDeniedAuthorsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutConfigsByIdDeniedAuthorsResponse, error) {
+	rsp, err := c.PutConfigsByIdDeniedAuthorsWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutConfigsByIdDeniedAuthorsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutConfigsByIdDeniedAuthorsWithResponse(ctx context.Context, id string, params *PutConfigsByIdDeniedAuthorsParams, body PutConfigsByIdDeniedAuthorsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutConfigsByIdDeniedAuthorsResponse, error) {
+	rsp, err := c.PutConfigsByIdDeniedAuthors(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutConfigsByIdDeniedAuthorsResponse(rsp)
+}
+
+// GetConfigsByIdDeployTokensWithResponse request returning *GetConfigsByIdDeployTokensResponse
+func (c *ClientWithResponses) GetConfigsByIdDeployTokensWithResponse(ctx context.Context, id string, params *GetConfigsByIdDeployTokensParams, reqEditors ...RequestEditorFn) (*GetConfigsByIdDeployTokensResponse, error) {
+	rsp, err := c.GetConfigsByIdDeployTokens(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetConfigsByIdDeployTokensResponse(rsp)
+}
+
+// PostConfigsByIdDeployTokensWithBodyWithResponse request with arbitrary body returning *PostConfigsByIdDeployTokensResponse
+func (c *ClientWithResponses) PostConfigsByIdDeployTokensWithBodyWithResponse(ctx context.Context, id string, params *PostConfigsByIdDeployTokensParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostConfigsByIdDeployTokensResponse, error) {
+	rsp, err := c.PostConfigsByIdDeployTokensWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostConfigsByIdDeployTokensResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostConfigsByIdDeployTokensWithResponse(ctx context.Context, id string, params *PostConfigsByIdDeployTokensParams, body PostConfigsByIdDeployTokensJSONRequestBody, reqEditors ...RequestEditorFn) (*PostConfigsByIdDeployTokensResponse, error) {
+	rsp, err := c.PostConfigsByIdDeployTokens(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostConfigsByIdDeployTokensResponse(rsp)
+}
+
+// DeleteConfigsByIdDeployTokensByTokenIdWithResponse request returning *DeleteConfigsByIdDeployTokensByTokenIdResponse
+func (c *ClientWithResponses) DeleteConfigsByIdDeployTokensByTokenIdWithResponse(ctx context.Context, id string, tokenId string, params *DeleteConfigsByIdDeployTokensByTokenIdParams, reqEditors ...RequestEditorFn) (*DeleteConfigsByIdDeployTokensByTokenIdResponse, error) {
+	rsp, err := c.DeleteConfigsByIdDeployTokensByTokenId(ctx, id, tokenId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteConfigsByIdDeployTokensByTokenIdResponse(rsp)
+}
+
+// GetConfigsByIdDeploymentsWithResponse request returning *GetConfigsByIdDeploymentsResponse
+func (c *ClientWithResponses) GetConfigsByIdDeploymentsWithResponse(ctx context.Context, id string, params *GetConfigsByIdDeploymentsParams, reqEditors ...RequestEditorFn) (*GetConfigsByIdDeploymentsResponse, error) {
+	rsp, err := c.GetConfigsByIdDeployments(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetConfigsByIdDeploymentsResponse(rsp)
+}
+
+// GetConfigsByIdEnvWithResponse request returning *GetConfigsByIdEnvResponse
+func (c *ClientWithResponses) GetConfigsByIdEnvWithResponse(ctx context.Context, id string, params *GetConfigsByIdEnvParams, reqEditors ...RequestEditorFn) (*GetConfigsByIdEnvResponse, error) {
+	rsp, err := c.GetConfigsByIdEnv(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetConfigsByIdEnvResponse(rsp)
+}
+
+// PostConfigsByIdEnvWithBodyWithResponse request with arbitrary body returning *PostConfigsByIdEnvResponse
+func (c *ClientWithResponses) PostConfigsByIdEnvWithBodyWithResponse(ctx context.Context, id string, params *PostConfigsByIdEnvParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostConfigsByIdEnvResponse, error) {
+	rsp, err := c.PostConfigsByIdEnvWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostConfigsByIdEnvResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostConfigsByIdEnvWithResponse(ctx context.Context, id string, params *PostConfigsByIdEnvParams, body PostConfigsByIdEnvJSONRequestBody, reqEditors ...RequestEditorFn) (*PostConfigsByIdEnvResponse, error) {
+	rsp, err := c.PostConfigsByIdEnv(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostConfigsByIdEnvResponse(rsp)
+}
+
+// DeleteConfigsByIdEnvByNameWithResponse request returning *DeleteConfigsByIdEnvByNameResponse
+func (c *ClientWithResponses) DeleteConfigsByIdEnvByNameWithResponse(ctx context.Context, id string, name string, params *DeleteConfigsByIdEnvByNameParams, reqEditors ...RequestEditorFn) (*DeleteConfigsByIdEnvByNameResponse, error) {
+	rsp, err := c.DeleteConfigsByIdEnvByName(ctx, id, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteConfigsByIdEnvByNameResponse(rsp)
+}
+
+// GetConfigsByIdEnvironmentsWithResponse request returning *GetConfigsByIdEnvironmentsResponse
+func (c *ClientWithResponses) GetConfigsByIdEnvironmentsWithResponse(ctx context.Context, id string, params *GetConfigsByIdEnvironmentsParams, reqEditors ...RequestEditorFn) (*GetConfigsByIdEnvironmentsResponse, error) {
+	rsp, err := c.GetConfigsByIdEnvironments(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetConfigsByIdEnvironmentsResponse(rsp)
+}
+
+// GetConfigsByIdExportWithResponse request returning *GetConfigsByIdExportResponse
+func (c *ClientWithResponses) GetConfigsByIdExportWithResponse(ctx context.Context, id string, params *GetConfigsByIdExportParams, reqEditors ...RequestEditorFn) (*GetConfigsByIdExportResponse, error) {
+	rsp, err := c.GetConfigsByIdExport(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetConfigsByIdExportResponse(rsp)
+}
+
+// DeleteConfigsByIdRegistryWebhookWithResponse request returning *DeleteConfigsByIdRegistryWebhookResponse
+func (c *ClientWithResponses) DeleteConfigsByIdRegistryWebhookWithResponse(ctx context.Context, id string, params *DeleteConfigsByIdRegistryWebhookParams, reqEditors ...RequestEditorFn) (*DeleteConfigsByIdRegistryWebhookResponse, error) {
+	rsp, err := c.DeleteConfigsByIdRegistryWebhook(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteConfigsByIdRegistryWebhookResponse(rsp)
+}
+
+// PostConfigsByIdRegistryWebhookWithResponse request returning *PostConfigsByIdRegistryWebhookResponse
+func (c *ClientWithResponses) PostConfigsByIdRegistryWebhookWithResponse(ctx context.Context, id string, params *PostConfigsByIdRegistryWebhookParams, reqEditors ...RequestEditorFn) (*PostConfigsByIdRegistryWebhookResponse, error) {
+	rsp, err := c.PostConfigsByIdRegistryWebhook(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostConfigsByIdRegistryWebhookResponse(rsp)
+}
+
+// PostConfigsByIdRestoreWithResponse request returning *PostConfigsByIdRestoreResponse
+func (c *ClientWithResponses) PostConfigsByIdRestoreWithResponse(ctx context.Context, id string, params *PostConfigsByIdRestoreParams, reqEditors ...RequestEditorFn) (*PostConfigsByIdRestoreResponse, error) {
+	rsp, err := c.PostConfigsByIdRestore(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostConfigsByIdRestoreResponse(rsp)
+}
+
+// GetConfigsByIdRetentionWithResponse request returning *GetConfigsByIdRetentionResponse
+func (c *ClientWithResponses) GetConfigsByIdRetentionWithResponse(ctx context.Context, id string, params *GetConfigsByIdRetentionParams, reqEditors ...RequestEditorFn) (*GetConfigsByIdRetentionResponse, error) {
+	rsp, err := c.GetConfigsByIdRetention(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetConfigsByIdRetentionResponse(rsp)
+}
+
+// PutConfigsByIdRetentionWithBodyWithResponse request with arbitrary body returning *PutConfigsByIdRetentionResponse
+func (c *ClientWithResponses) PutConfigsByIdRetentionWithBodyWithResponse(ctx context.Context, id string, params *PutConfigsByIdRetentionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutConfigsByIdRetentionResponse, error) {
+	rsp, err := c.PutConfigsByIdRetentionWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutConfigsByIdRetentionResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutConfigsByIdRetentionWithResponse(ctx context.Context, id string, params *PutConfigsByIdRetentionParams, body PutConfigsByIdRetentionJSONRequestBody, reqEditors ...RequestEditorFn) (*PutConfigsByIdRetentionResponse, error) {
+	rsp, err := c.PutConfigsByIdRetention(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutConfigsByIdRetentionResponse(rsp)
+}
+
+// GetConfigsByIdSecretScanWithResponse request returning *GetConfigsByIdSecretScanResponse
+func (c *ClientWithResponses) GetConfigsByIdSecretScanWithResponse(ctx context.Context, id string, params *GetConfigsByIdSecretScanParams, reqEditors ...RequestEditorFn) (*GetConfigsByIdSecretScanResponse, error) {
+	rsp, err := c.GetConfigsByIdSecretScan(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetConfigsByIdSecretScanResponse(rsp)
+}
+
+// PutConfigsByIdSecretScanWithBodyWithResponse request with arbitrary body returning *PutConfigsByIdSecretScanResponse
+func (c *ClientWithResponses) PutConfigsByIdSecretScanWithBodyWithResponse(ctx context.Context, id string, params *PutConfigsByIdSecretScanParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutConfigsByIdSecretScanResponse, error) {
+	rsp, err := c.PutConfigsByIdSecretScanWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutConfigsByIdSecretScanResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutConfigsByIdSecretScanWithResponse(ctx context.Context, id string, params *PutConfigsByIdSecretScanParams, body PutConfigsByIdSecretScanJSONRequestBody, reqEditors ...RequestEditorFn) (*PutConfigsByIdSecretScanResponse, error) {
+	rsp, err := c.PutConfigsByIdSecretScan(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutConfigsByIdSecretScanResponse(rsp)
+}
+
+// DeleteConfigsByIdTransferWithResponse request returning *DeleteConfigsByIdTransferResponse
+func (c *ClientWithResponses) DeleteConfigsByIdTransferWithResponse(ctx context.Context, id string, params *DeleteConfigsByIdTransferParams, reqEditors ...RequestEditorFn) (*DeleteConfigsByIdTransferResponse, error) {
+	rsp, err := c.DeleteConfigsByIdTransfer(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteConfigsByIdTransferResponse(rsp)
+}
+
+// PostConfigsByIdTransferWithBodyWithResponse request with arbitrary body returning *PostConfigsByIdTransferResponse
+func (c *ClientWithResponses) PostConfigsByIdTransferWithBodyWithResponse(ctx context.Context, id string, params *PostConfigsByIdTransferParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostConfigsByIdTransferResponse, error) {
+	rsp, err := c.PostConfigsByIdTransferWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostConfigsByIdTransferResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostConfigsByIdTransferWithResponse(ctx context.Context, id string, params *PostConfigsByIdTransferParams, body PostConfigsByIdTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*PostConfigsByIdTransferResponse, error) {
+	rsp, err := c.PostConfigsByIdTransfer(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostConfigsByIdTransferResponse(rsp)
+}
+
+// PatchConfigsByIdWebhookWithBodyWithResponse request with arbitrary body returning *PatchConfigsByIdWebhookResponse
+func (c *ClientWithResponses) PatchConfigsByIdWebhookWithBodyWithResponse(ctx context.Context, id string, params *PatchConfigsByIdWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchConfigsByIdWebhookResponse, error) {
+	rsp, err := c.PatchConfigsByIdWebhookWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchConfigsByIdWebhookResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchConfigsByIdWebhookWithResponse(ctx context.Context, id string, params *PatchConfigsByIdWebhookParams, body PatchConfigsByIdWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchConfigsByIdWebhookResponse, error) {
+	rsp, err := c.PatchConfigsByIdWebhook(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchConfigsByIdWebhookResponse(rsp)
+}
+
+// PostConfigsByIdWebhookWithBodyWithResponse request with arbitrary body returning *PostConfigsByIdWebhookResponse
+func (c *ClientWithResponses) PostConfigsByIdWebhookWithBodyWithResponse(ctx context.Context, id string, params *PostConfigsByIdWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostConfigsByIdWebhookResponse, error) {
+	rsp, err := c.PostConfigsByIdWebhookWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostConfigsByIdWebhookResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostConfigsByIdWebhookWithResponse(ctx context.Context, id string, params *PostConfigsByIdWebhookParams, body PostConfigsByIdWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*PostConfigsByIdWebhookResponse, error) {
+	rsp, err := c.PostConfigsByIdWebhook(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostConfigsByIdWebhookResponse(rsp)
+}
+
+// GetCredentialsWithResponse request returning *GetCredentialsResponse
+func (c *ClientWithResponses) GetCredentialsWithResponse(ctx context.Context, params *GetCredentialsParams, reqEditors ...RequestEditorFn) (*GetCredentialsResponse, error) {
+	rsp, err := c.GetCredentials(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCredentialsResponse(rsp)
+}
+
+// PostCredentialsWithBodyWithResponse request with arbitrary body returning *PostCredentialsResponse
+func (c *ClientWithResponses) PostCredentialsWithBodyWithResponse(ctx context.Context, params *PostCredentialsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostCredentialsResponse, error) {
+	rsp, err := c.PostCredentialsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostCredentialsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostCredentialsWithResponse(ctx context.Context, params *PostCredentialsParams, body PostCredentialsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostCredentialsResponse, error) {
+	rsp, err := c.PostCredentials(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostCredentialsResponse(rsp)
+}
+
+// GetCredentialsGithubInstallationTokenWithResponse request returning *GetCredentialsGithubInstallationTokenResponse
+func (c *ClientWithResponses) GetCredentialsGithubInstallationTokenWithResponse(ctx context.Context, params *GetCredentialsGithubInstallationTokenParams, reqEditors ...RequestEditorFn) (*GetCredentialsGithubInstallationTokenResponse, error) {
+	rsp, err := c.GetCredentialsGithubInstallationToken(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCredentialsGithubInstallationTokenResponse(rsp)
+}
+
+// GetCredentialsGithubTokenWithResponse request returning *GetCredentialsGithubTokenResponse
+func (c *ClientWithResponses) GetCredentialsGithubTokenWithResponse(ctx context.Context, params *GetCredentialsGithubTokenParams, reqEditors ...RequestEditorFn) (*GetCredentialsGithubTokenResponse, error) {
+	rsp, err := c.GetCredentialsGithubToken(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCredentialsGithubTokenResponse(rsp)
+}
+
+// DeleteCredentialsByIdWithResponse request returning *DeleteCredentialsByIdResponse
+func (c *ClientWithResponses) DeleteCredentialsByIdWithResponse(ctx context.Context, id int64, params *DeleteCredentialsByIdParams, reqEditors ...RequestEditorFn) (*DeleteCredentialsByIdResponse, error) {
+	rsp, err := c.DeleteCredentialsById(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteCredentialsByIdResponse(rsp)
+}
+
+// GetCredentialsByIdWithResponse request returning *GetCredentialsByIdResponse
+func (c *ClientWithResponses) GetCredentialsByIdWithResponse(ctx context.Context, id int64, params *GetCredentialsByIdParams, reqEditors ...RequestEditorFn) (*GetCredentialsByIdResponse, error) {
+	rsp, err := c.GetCredentialsById(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCredentialsByIdResponse(rsp)
+}
+
+// PutCredentialsByIdWithBodyWithResponse request with arbitrary body returning *PutCredentialsByIdResponse
+func (c *ClientWithResponses) PutCredentialsByIdWithBodyWithResponse(ctx context.Context, id int64, params *PutCredentialsByIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutCredentialsByIdResponse, error) {
+	rsp, err := c.PutCredentialsByIdWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutCredentialsByIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutCredentialsByIdWithResponse(ctx context.Context, id int64, params *PutCredentialsByIdParams, body PutCredentialsByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutCredentialsByIdResponse, error) {
+	rsp, err := c.PutCredentialsById(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutCredentialsByIdResponse(rsp)
+}
+
+// PostCredentialsByIdRestoreWithResponse request returning *PostCredentialsByIdRestoreResponse
+func (c *ClientWithResponses) PostCredentialsByIdRestoreWithResponse(ctx context.Context, id int64, params *PostCredentialsByIdRestoreParams, reqEditors ...RequestEditorFn) (*PostCredentialsByIdRestoreResponse, error) {
+	rsp, err := c.PostCredentialsByIdRestore(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostCredentialsByIdRestoreResponse(rsp)
+}
+
+// GetDeployStatusWithResponse request returning *GetDeployStatusResponse
+func (c *ClientWithResponses) GetDeployStatusWithResponse(ctx context.Context, params *GetDeployStatusParams, reqEditors ...RequestEditorFn) (*GetDeployStatusResponse, error) {
+	rsp, err := c.GetDeployStatus(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDeployStatusResponse(rsp)
+}
+
+// PostDeployTriggerWithBodyWithResponse request with arbitrary body returning *PostDeployTriggerResponse
+func (c *ClientWithResponses) PostDeployTriggerWithBodyWithResponse(ctx context.Context, params *PostDeployTriggerParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostDeployTriggerResponse, error) {
+	rsp, err := c.PostDeployTriggerWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostDeployTriggerResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostDeployTriggerWithResponse(ctx context.Context, params *PostDeployTriggerParams, body PostDeployTriggerJSONRequestBody, reqEditors ...RequestEditorFn) (*PostDeployTriggerResponse, error) {
+	rsp, err := c.PostDeployTrigger(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostDeployTriggerResponse(rsp)
+}
+
+// GetDeploymentsByIdManifestsWithResponse request returning *GetDeploymentsByIdManifestsResponse
+func (c *ClientWithResponses) GetDeploymentsByIdManifestsWithResponse(ctx context.Context, id int64, params *GetDeploymentsByIdManifestsParams, reqEditors ...RequestEditorFn) (*GetDeploymentsByIdManifestsResponse, error) {
+	rsp, err := c.GetDeploymentsByIdManifests(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDeploymentsByIdManifestsResponse(rsp)
+}
+
+// GetDeploymentsByIdResourcesWithResponse request returning *GetDeploymentsByIdResourcesResponse
+func (c *ClientWithResponses) GetDeploymentsByIdResourcesWithResponse(ctx context.Context, id int64, params *GetDeploymentsByIdResourcesParams, reqEditors ...RequestEditorFn) (*GetDeploymentsByIdResourcesResponse, error) {
+	rsp, err := c.GetDeploymentsByIdResources(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDeploymentsByIdResourcesResponse(rsp)
+}
+
+// PostDeploymentsByIdRollbackWithResponse request returning *PostDeploymentsByIdRollbackResponse
+func (c *ClientWithResponses) PostDeploymentsByIdRollbackWithResponse(ctx context.Context, id int64, params *PostDeploymentsByIdRollbackParams, reqEditors ...RequestEditorFn) (*PostDeploymentsByIdRollbackResponse, error) {
+	rsp, err := c.PostDeploymentsByIdRollback(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostDeploymentsByIdRollbackResponse(rsp)
+}
+
+// PostEmailChangeConfirmWithBodyWithResponse request with arbitrary body returning *PostEmailChangeConfirmResponse
+func (c *ClientWithResponses) PostEmailChangeConfirmWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostEmailChangeConfirmResponse, error) {
+	rsp, err := c.PostEmailChangeConfirmWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostEmailChangeConfirmResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostEmailChangeConfirmWithResponse(ctx context.Context, body PostEmailChangeConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*PostEmailChangeConfirmResponse, error) {
+	rsp, err := c.PostEmailChangeConfirm(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostEmailChangeConfirmResponse(rsp)
+}
+
+// GetGithubAppWithResponse request returning *GetGithubAppResponse
+func (c *ClientWithResponses) GetGithubAppWithResponse(ctx context.Context, params *GetGithubAppParams, reqEditors ...RequestEditorFn) (*GetGithubAppResponse, error) {
+	rsp, err := c.GetGithubApp(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetGithubAppResponse(rsp)
+}
+
+// PostGithubInstallationsByIdCheckWithResponse request returning *PostGithubInstallationsByIdCheckResponse
+func (c *ClientWithResponses) PostGithubInstallationsByIdCheckWithResponse(ctx context.Context, id int64, params *PostGithubInstallationsByIdCheckParams, reqEditors ...RequestEditorFn) (*PostGithubInstallationsByIdCheckResponse, error) {
+	rsp, err := c.PostGithubInstallationsByIdCheck(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostGithubInstallationsByIdCheckResponse(rsp)
+}
+
+// PostGithubReposByOwnerByRepoBootstrapWithBodyWithResponse request with arbitrary body returning *PostGithubReposByOwnerByRepoBootstrapResponse
+func (c *ClientWithResponses) PostGithubReposByOwnerByRepoBootstrapWithBodyWithResponse(ctx context.Context, owner string, repo string, params *PostGithubReposByOwnerByRepoBootstrapParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostGithubReposByOwnerByRepoBootstrapResponse, error) {
+	rsp, err := c.PostGithubReposByOwnerByRepoBootstrapWithBody(ctx, owner, repo, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostGithubReposByOwnerByRepoBootstrapResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostGithubReposByOwnerByRepoBootstrapWithResponse(ctx context.Context, owner string, repo string, params *PostGithubReposByOwnerByRepoBootstrapParams, body PostGithubReposByOwnerByRepoBootstrapJSONRequestBody, reqEditors ...RequestEditorFn) (*PostGithubReposByOwnerByRepoBootstrapResponse, error) {
+	rsp, err := c.PostGithubReposByOwnerByRepoBootstrap(ctx, owner, repo, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostGithubReposByOwnerByRepoBootstrapResponse(rsp)
+}
+
+// GetHealthWithResponse request returning *GetHealthResponse
+func (c *ClientWithResponses) GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error) {
+	rsp, err := c.GetHealth(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHealthResponse(rsp)
+}
+
+// GetHooksWithResponse request returning *GetHooksResponse
+func (c *ClientWithResponses) GetHooksWithResponse(ctx context.Context, params *GetHooksParams, reqEditors ...RequestEditorFn) (*GetHooksResponse, error) {
+	rsp, err := c.GetHooks(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHooksResponse(rsp)
+}
+
+// PostHooksWithBodyWithResponse request with arbitrary body returning *PostHooksResponse
+func (c *ClientWithResponses) PostHooksWithBodyWithResponse(ctx context.Context, params *PostHooksParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostHooksResponse, error) {
+	rsp, err := c.PostHooksWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostHooksResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostHooksWithResponse(ctx context.Context, params *PostHooksParams, body PostHooksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostHooksResponse, error) {
+	rsp, err := c.PostHooks(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostHooksResponse(rsp)
+}
+
+// DeleteHooksByIdWithResponse request returning *DeleteHooksByIdResponse
+func (c *ClientWithResponses) DeleteHooksByIdWithResponse(ctx context.Context, id string, params *DeleteHooksByIdParams, reqEditors ...RequestEditorFn) (*DeleteHooksByIdResponse, error) {
+	rsp, err := c.DeleteHooksById(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteHooksByIdResponse(rsp)
+}
+
+// GetJobsDeadWithResponse request returning *GetJobsDeadResponse
+func (c *ClientWithResponses) GetJobsDeadWithResponse(ctx context.Context, params *GetJobsDeadParams, reqEditors ...RequestEditorFn) (*GetJobsDeadResponse, error) {
+	rsp, err := c.GetJobsDead(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetJobsDeadResponse(rsp)
+}
+
+// PostJobsDeadByIdRequeueWithResponse request returning *PostJobsDeadByIdRequeueResponse
+func (c *ClientWithResponses) PostJobsDeadByIdRequeueWithResponse(ctx context.Context, id int64, params *PostJobsDeadByIdRequeueParams, reqEditors ...RequestEditorFn) (*PostJobsDeadByIdRequeueResponse, error) {
+	rsp, err := c.PostJobsDeadByIdRequeue(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostJobsDeadByIdRequeueResponse(rsp)
+}
+
+// PostLoginWithBodyWithResponse request with arbitrary body returning *PostLoginResponse
+func (c *ClientWithResponses) PostLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLoginResponse, error) {
+	rsp, err := c.PostLoginWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostLoginResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostLoginWithResponse(ctx context.Context, body PostLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*PostLoginResponse, error) {
+	rsp, err := c.PostLogin(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostLoginResponse(rsp)
+}
+
+// PostLoginOidcDeviceWithResponse request returning *PostLoginOidcDeviceResponse
+func (c *ClientWithResponses) PostLoginOidcDeviceWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostLoginOidcDeviceResponse, error) {
+	rsp, err := c.PostLoginOidcDevice(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostLoginOidcDeviceResponse(rsp)
+}
+
+// PostLoginOidcTokenWithBodyWithResponse request with arbitrary body returning *PostLoginOidcTokenResponse
+func (c *ClientWithResponses) PostLoginOidcTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLoginOidcTokenResponse, error) {
+	rsp, err := c.PostLoginOidcTokenWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostLoginOidcTokenResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostLoginOidcTokenWithResponse(ctx context.Context, body PostLoginOidcTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*PostLoginOidcTokenResponse, error) {
+	rsp, err := c.PostLoginOidcToken(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostLoginOidcTokenResponse(rsp)
+}
+
+// DeleteMeWithBodyWithResponse request with arbitrary body returning *DeleteMeResponse
+func (c *ClientWithResponses) DeleteMeWithBodyWithResponse(ctx context.Context, params *DeleteMeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteMeResponse, error) {
+	rsp, err := c.DeleteMeWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteMeResponse(rsp)
+}
+
+func (c *ClientWithResponses) DeleteMeWithResponse(ctx context.Context, params *DeleteMeParams, body DeleteMeJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteMeResponse, error) {
+	rsp, err := c.DeleteMe(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteMeResponse(rsp)
+}
+
+// GetMeWithResponse request returning *GetMeResponse
+func (c *ClientWithResponses) GetMeWithResponse(ctx context.Context, params *GetMeParams, reqEditors ...RequestEditorFn) (*GetMeResponse, error) {
+	rsp, err := c.GetMe(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMeResponse(rsp)
+}
+
+// PatchMeWithBodyWithResponse request with arbitrary body returning *PatchMeResponse
+func (c *ClientWithResponses) PatchMeWithBodyWithResponse(ctx context.Context, params *PatchMeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchMeResponse, error) {
+	rsp, err := c.PatchMeWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchMeResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchMeWithResponse(ctx context.Context, params *PatchMeParams, body PatchMeJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchMeResponse, error) {
+	rsp, err := c.PatchMe(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchMeResponse(rsp)
+}
+
+// PostMe2FaWithResponse request returning *PostMe2FaResponse
+func (c *ClientWithResponses) PostMe2FaWithResponse(ctx context.Context, params *PostMe2FaParams, reqEditors ...RequestEditorFn) (*PostMe2FaResponse, error) {
+	rsp, err := c.PostMe2Fa(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostMe2FaResponse(rsp)
+}
+
+// PostMe2FaConfirmWithBodyWithResponse request with arbitrary body returning *PostMe2FaConfirmResponse
+func (c *ClientWithResponses) PostMe2FaConfirmWithBodyWithResponse(ctx context.Context, params *PostMe2FaConfirmParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostMe2FaConfirmResponse, error) {
+	rsp, err := c.PostMe2FaConfirmWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostMe2FaConfirmResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostMe2FaConfirmWithResponse(ctx context.Context, params *PostMe2FaConfirmParams, body PostMe2FaConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*PostMe2FaConfirmResponse, error) {
+	rsp, err := c.PostMe2FaConfirm(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostMe2FaConfirmResponse(rsp)
+}
+
+// PostMe2FaDisableWithBodyWithResponse request with arbitrary body returning *PostMe2FaDisableResponse
+func (c *ClientWithResponses) PostMe2FaDisableWithBodyWithResponse(ctx context.Context, params *PostMe2FaDisableParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostMe2FaDisableResponse, error) {
+	rsp, err := c.PostMe2FaDisableWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostMe2FaDisableResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostMe2FaDisableWithResponse(ctx context.Context, params *PostMe2FaDisableParams, body PostMe2FaDisableJSONRequestBody, reqEditors ...RequestEditorFn) (*PostMe2FaDisableResponse, error) {
+	rsp, err := c.PostMe2FaDisable(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostMe2FaDisableResponse(rsp)
+}
+
+// GetMeNotificationsWithResponse request returning *GetMeNotificationsResponse
+func (c *ClientWithResponses) GetMeNotificationsWithResponse(ctx context.Context, params *GetMeNotificationsParams, reqEditors ...RequestEditorFn) (*GetMeNotificationsResponse, error) {
+	rsp, err := c.GetMeNotifications(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMeNotificationsResponse(rsp)
+}
+
+// PutMeNotificationsWithBodyWithResponse request with arbitrary body returning *PutMeNotificationsResponse
+func (c *ClientWithResponses) PutMeNotificationsWithBodyWithResponse(ctx context.Context, params *PutMeNotificationsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutMeNotificationsResponse, error) {
+	rsp, err := c.PutMeNotificationsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutMeNotificationsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutMeNotificationsWithResponse(ctx context.Context, params *PutMeNotificationsParams, body PutMeNotificationsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutMeNotificationsResponse, error) {
+	rsp, err := c.PutMeNotifications(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutMeNotificationsResponse(rsp)
+}
+
+// DeleteMeRegistryCredentialWithResponse request returning *DeleteMeRegistryCredentialResponse
+func (c *ClientWithResponses) DeleteMeRegistryCredentialWithResponse(ctx context.Context, params *DeleteMeRegistryCredentialParams, reqEditors ...RequestEditorFn) (*DeleteMeRegistryCredentialResponse, error) {
+	rsp, err := c.DeleteMeRegistryCredential(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteMeRegistryCredentialResponse(rsp)
+}
+
+// PutMeRegistryCredentialWithBodyWithResponse request with arbitrary body returning *PutMeRegistryCredentialResponse
+func (c *ClientWithResponses) PutMeRegistryCredentialWithBodyWithResponse(ctx context.Context, params *PutMeRegistryCredentialParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutMeRegistryCredentialResponse, error) {
+	rsp, err := c.PutMeRegistryCredentialWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutMeRegistryCredentialResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutMeRegistryCredentialWithResponse(ctx context.Context, params *PutMeRegistryCredentialParams, body PutMeRegistryCredentialJSONRequestBody, reqEditors ...RequestEditorFn) (*PutMeRegistryCredentialResponse, error) {
+	rsp, err := c.PutMeRegistryCredential(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutMeRegistryCredentialResponse(rsp)
+}
+
+// PostPasswordResetWithBodyWithResponse request with arbitrary body returning *PostPasswordResetResponse
+func (c *ClientWithResponses) PostPasswordResetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPasswordResetResponse, error) {
+	rsp, err := c.PostPasswordResetWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPasswordResetResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPasswordResetWithResponse(ctx context.Context, body PostPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPasswordResetResponse, error) {
+	rsp, err := c.PostPasswordReset(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPasswordResetResponse(rsp)
+}
+
+// PostPasswordResetConfirmWithBodyWithResponse request with arbitrary body returning *PostPasswordResetConfirmResponse
+func (c *ClientWithResponses) PostPasswordResetConfirmWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPasswordResetConfirmResponse, error) {
+	rsp, err := c.PostPasswordResetConfirmWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPasswordResetConfirmResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPasswordResetConfirmWithResponse(ctx context.Context, body PostPasswordResetConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPasswordResetConfirmResponse, error) {
+	rsp, err := c.PostPasswordResetConfirm(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPasswordResetConfirmResponse(rsp)
+}
+
+// GetPoliciesWithResponse request returning *GetPoliciesResponse
+func (c *ClientWithResponses) GetPoliciesWithResponse(ctx context.Context, params *GetPoliciesParams, reqEditors ...RequestEditorFn) (*GetPoliciesResponse, error) {
+	rsp, err := c.GetPolicies(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPoliciesResponse(rsp)
+}
+
+// DeletePoliciesByNameWithResponse request returning *DeletePoliciesByNameResponse
+func (c *ClientWithResponses) DeletePoliciesByNameWithResponse(ctx context.Context, name string, params *DeletePoliciesByNameParams, reqEditors ...RequestEditorFn) (*DeletePoliciesByNameResponse, error) {
+	rsp, err := c.DeletePoliciesByName(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeletePoliciesByNameResponse(rsp)
+}
+
+// PutPoliciesByNameWithBodyWithResponse request with arbitrary body returning *PutPoliciesByNameResponse
+func (c *ClientWithResponses) PutPoliciesByNameWithBodyWithResponse(ctx context.Context, name string, params *PutPoliciesByNameParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutPoliciesByNameResponse, error) {
+	rsp, err := c.PutPoliciesByNameWithBody(ctx, name, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutPoliciesByNameResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutPoliciesByNameWithResponse(ctx context.Context, name string, params *PutPoliciesByNameParams, body PutPoliciesByNameJSONRequestBody, reqEditors ...RequestEditorFn) (*PutPoliciesByNameResponse, error) {
+	rsp, err := c.PutPoliciesByName(ctx, name, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutPoliciesByNameResponse(rsp)
+}
+
+// GetProvidersWithResponse request returning *GetProvidersResponse
+func (c *ClientWithResponses) GetProvidersWithResponse(ctx context.Context, params *GetProvidersParams, reqEditors ...RequestEditorFn) (*GetProvidersResponse, error) {
+	rsp, err := c.GetProviders(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProvidersResponse(rsp)
+}
+
+// PostRegisterWithBodyWithResponse request with arbitrary body returning *PostRegisterResponse
+func (c *ClientWithResponses) PostRegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRegisterResponse, error) {
+	rsp, err := c.PostRegisterWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRegisterResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostRegisterWithResponse(ctx context.Context, body PostRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRegisterResponse, error) {
+	rsp, err := c.PostRegister(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRegisterResponse(rsp)
+}
+
+// GetSessionsWithResponse request returning *GetSessionsResponse
+func (c *ClientWithResponses) GetSessionsWithResponse(ctx context.Context, params *GetSessionsParams, reqEditors ...RequestEditorFn) (*GetSessionsResponse, error) {
+	rsp, err := c.GetSessions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSessionsResponse(rsp)
+}
+
+// DeleteSessionsByIdWithResponse request returning *DeleteSessionsByIdResponse
+func (c *ClientWithResponses) DeleteSessionsByIdWithResponse(ctx context.Context, id string, params *DeleteSessionsByIdParams, reqEditors ...RequestEditorFn) (*DeleteSessionsByIdResponse, error) {
+	rsp, err := c.DeleteSessionsById(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteSessionsByIdResponse(rsp)
+}
+
+// GetTransfersWithResponse request returning *GetTransfersResponse
+func (c *ClientWithResponses) GetTransfersWithResponse(ctx context.Context, params *GetTransfersParams, reqEditors ...RequestEditorFn) (*GetTransfersResponse, error) {
+	rsp, err := c.GetTransfers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTransfersResponse(rsp)
+}
+
+// PostTransfersByIdAcceptWithResponse request returning *PostTransfersByIdAcceptResponse
+func (c *ClientWithResponses) PostTransfersByIdAcceptWithResponse(ctx context.Context, id string, params *PostTransfersByIdAcceptParams, reqEditors ...RequestEditorFn) (*PostTransfersByIdAcceptResponse, error) {
+	rsp, err := c.PostTransfersByIdAccept(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTransfersByIdAcceptResponse(rsp)
+}
+
+// PostTransfersByIdDeclineWithResponse request returning *PostTransfersByIdDeclineResponse
+func (c *ClientWithResponses) PostTransfersByIdDeclineWithResponse(ctx context.Context, id string, params *PostTransfersByIdDeclineParams, reqEditors ...RequestEditorFn) (*PostTransfersByIdDeclineResponse, error) {
+	rsp, err := c.PostTransfersByIdDecline(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTransfersByIdDeclineResponse(rsp)
+}
+
+// GetTrashWithResponse request returning *GetTrashResponse
+func (c *ClientWithResponses) GetTrashWithResponse(ctx context.Context, params *GetTrashParams, reqEditors ...RequestEditorFn) (*GetTrashResponse, error) {
+	rsp, err := c.GetTrash(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTrashResponse(rsp)
+}
+
+// GetUsageWithResponse request returning *GetUsageResponse
+func (c *ClientWithResponses) GetUsageWithResponse(ctx context.Context, params *GetUsageParams, reqEditors ...RequestEditorFn) (*GetUsageResponse, error) {
+	rsp, err := c.GetUsage(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsageResponse(rsp)
+}
+
+// GetVersionWithResponse request returning *GetVersionResponse
+func (c *ClientWithResponses) GetVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVersionResponse, error) {
+	rsp, err := c.GetVersion(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetVersionResponse(rsp)
+}
+
+// PostWebhooksGithubAppWithBodyWithResponse request with arbitrary body returning *PostWebhooksGithubAppResponse
+func (c *ClientWithResponses) PostWebhooksGithubAppWithBodyWithResponse(ctx context.Context, params *PostWebhooksGithubAppParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWebhooksGithubAppResponse, error) {
+	rsp, err := c.PostWebhooksGithubAppWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWebhooksGithubAppResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostWebhooksGithubAppWithResponse(ctx context.Context, params *PostWebhooksGithubAppParams, body PostWebhooksGithubAppJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWebhooksGithubAppResponse, error) {
+	rsp, err := c.PostWebhooksGithubApp(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWebhooksGithubAppResponse(rsp)
+}
+
+// PostWebhooksGithubByIdWithBodyWithResponse request with arbitrary body returning *PostWebhooksGithubByIdResponse
+func (c *ClientWithResponses) PostWebhooksGithubByIdWithBodyWithResponse(ctx context.Context, id string, params *PostWebhooksGithubByIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWebhooksGithubByIdResponse, error) {
+	rsp, err := c.PostWebhooksGithubByIdWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWebhooksGithubByIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostWebhooksGithubByIdWithResponse(ctx context.Context, id string, params *PostWebhooksGithubByIdParams, body PostWebhooksGithubByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWebhooksGithubByIdResponse, error) {
+	rsp, err := c.PostWebhooksGithubById(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWebhooksGithubByIdResponse(rsp)
+}
+
+// PostWebhooksRegistryByIdWithBodyWithResponse request with arbitrary body returning *PostWebhooksRegistryByIdResponse
+func (c *ClientWithResponses) PostWebhooksRegistryByIdWithBodyWithResponse(ctx context.Context, id string, params *PostWebhooksRegistryByIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWebhooksRegistryByIdResponse, error) {
+	rsp, err := c.PostWebhooksRegistryByIdWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWebhooksRegistryByIdResponse(rsp)
+}
+
+// ParseGetActivityResponse parses an HTTP response from a GetActivityWithResponse call
+func ParseGetActivityResponse(rsp *http.Response) (*GetActivityResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetActivityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetActivityResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminActivityResponse parses an HTTP response from a GetAdminActivityWithResponse call
+func ParseGetAdminActivityResponse(rsp *http.Response) (*GetAdminActivityResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminActivityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetAdminActivityResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAdminGithubAppResponse parses an HTTP response from a DeleteAdminGithubAppWithResponse call
+func ParseDeleteAdminGithubAppResponse(rsp *http.Response) (*DeleteAdminGithubAppResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAdminGithubAppResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GitHubAppResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminGithubAppResponse parses an HTTP response from a GetAdminGithubAppWithResponse call
+func ParseGetAdminGithubAppResponse(rsp *http.Response) (*GetAdminGithubAppResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminGithubAppResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GitHubAppResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutAdminGithubAppResponse parses an HTTP response from a PutAdminGithubAppWithResponse call
+func ParsePutAdminGithubAppResponse(rsp *http.Response) (*PutAdminGithubAppResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutAdminGithubAppResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GitHubAppResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminLimitsResponse parses an HTTP response from a GetAdminLimitsWithResponse call
+func ParseGetAdminLimitsResponse(rsp *http.Response) (*GetAdminLimitsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminLimitsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InstanceLimitsBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutAdminLimitsResponse parses an HTTP response from a PutAdminLimitsWithResponse call
+func ParsePutAdminLimitsResponse(rsp *http.Response) (*PutAdminLimitsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutAdminLimitsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InstanceLimitsBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminRetentionResponse parses an HTTP response from a GetAdminRetentionWithResponse call
+func ParseGetAdminRetentionResponse(rsp *http.Response) (*GetAdminRetentionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminRetentionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetRetentionStatsResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminUsersResponse parses an HTTP response from a GetAdminUsersWithResponse call
+func ParseGetAdminUsersResponse(rsp *http.Response) (*GetAdminUsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminUsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListAdminUsersResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchAdminUsersByIdResponse parses an HTTP response from a PatchAdminUsersByIdWithResponse call
+func ParsePatchAdminUsersByIdResponse(rsp *http.Response) (*PatchAdminUsersByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchAdminUsersByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AdminUserResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminUsersByIdRetentionResponse parses an HTTP response from a GetAdminUsersByIdRetentionWithResponse call
+func ParseGetAdminUsersByIdRetentionResponse(rsp *http.Response) (*GetAdminUsersByIdRetentionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminUsersByIdRetentionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserRetentionBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutAdminUsersByIdRetentionResponse parses an HTTP response from a PutAdminUsersByIdRetentionWithResponse call
+func ParsePutAdminUsersByIdRetentionResponse(rsp *http.Response) (*PutAdminUsersByIdRetentionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutAdminUsersByIdRetentionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserRetentionBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAgentDeploymentsNextResponse parses an HTTP response from a GetAgentDeploymentsNextWithResponse call
+func ParseGetAgentDeploymentsNextResponse(rsp *http.Response) (*GetAgentDeploymentsNextResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAgentDeploymentsNextResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetNextAgentDeploymentResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAgentDeploymentsByIdStatusResponse parses an HTTP response from a PostAgentDeploymentsByIdStatusWithResponse call
+func ParsePostAgentDeploymentsByIdStatusResponse(rsp *http.Response) (*PostAgentDeploymentsByIdStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAgentDeploymentsByIdStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReportAgentDeploymentResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAgentsResponse parses an HTTP response from a GetAgentsWithResponse call
+func ParseGetAgentsResponse(rsp *http.Response) (*GetAgentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAgentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListAgentsResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAgentsResponse parses an HTTP response from a PostAgentsWithResponse call
+func ParsePostAgentsResponse(rsp *http.Response) (*PostAgentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAgentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CreateAgentResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBuildsByIdResponse parses an HTTP response from a GetBuildsByIdWithResponse call
+func ParseGetBuildsByIdResponse(rsp *http.Response) (*GetBuildsByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBuildsByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetBuildResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBuildsByIdArtifactsResponse parses an HTTP response from a GetBuildsByIdArtifactsWithResponse call
+func ParseGetBuildsByIdArtifactsResponse(rsp *http.Response) (*GetBuildsByIdArtifactsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBuildsByIdArtifactsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListBuildArtifactsResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBuildsByIdArtifactsByNameResponse parses an HTTP response from a GetBuildsByIdArtifactsByNameWithResponse call
+func ParseGetBuildsByIdArtifactsByNameResponse(rsp *http.Response) (*GetBuildsByIdArtifactsByNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBuildsByIdArtifactsByNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBuildsByIdConfigResponse parses an HTTP response from a GetBuildsByIdConfigWithResponse call
+func ParseGetBuildsByIdConfigResponse(rsp *http.Response) (*GetBuildsByIdConfigResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBuildsByIdConfigResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetBuildConfigResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBuildsByIdLogsResponse parses an HTTP response from a GetBuildsByIdLogsWithResponse call
+func ParseGetBuildsByIdLogsResponse(rsp *http.Response) (*GetBuildsByIdLogsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBuildsByIdLogsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetBuildLogsResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBuildsByIdPipelineResponse parses an HTTP response from a GetBuildsByIdPipelineWithResponse call
+func ParseGetBuildsByIdPipelineResponse(rsp *http.Response) (*GetBuildsByIdPipelineResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBuildsByIdPipelineResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetBuildPipelineResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBuildsByIdProvenanceResponse parses an HTTP response from a GetBuildsByIdProvenanceWithResponse call
+func ParseGetBuildsByIdProvenanceResponse(rsp *http.Response) (*GetBuildsByIdProvenanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBuildsByIdProvenanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetBuildProvenanceResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCliReleaseResponse parses an HTTP response from a GetCliReleaseWithResponse call
+func ParseGetCliReleaseResponse(rsp *http.Response) (*GetCliReleaseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCliReleaseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetCLIReleaseResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetConfigsResponse parses an HTTP response from a GetConfigsWithResponse call
+func ParseGetConfigsResponse(rsp *http.Response) (*GetConfigsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetConfigsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListConfigsResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostConfigsResponse parses an HTTP response from a PostConfigsWithResponse call
+func ParsePostConfigsResponse(rsp *http.Response) (*PostConfigsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostConfigsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CreateConfigResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostConfigsImportResponse parses an HTTP response from a PostConfigsImportWithResponse call
+func ParsePostConfigsImportResponse(rsp *http.Response) (*PostConfigsImportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostConfigsImportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ImportConfigResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostConfigsSyncResponse parses an HTTP response from a PostConfigsSyncWithResponse call
+func ParsePostConfigsSyncResponse(rsp *http.Response) (*PostConfigsSyncResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostConfigsSyncResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SyncConfigsResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteConfigsByIdResponse parses an HTTP response from a DeleteConfigsByIdWithResponse call
+func ParseDeleteConfigsByIdResponse(rsp *http.Response) (*DeleteConfigsByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteConfigsByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeleteConfigResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetConfigsByIdResponse parses an HTTP response from a GetConfigsByIdWithResponse call
+func ParseGetConfigsByIdResponse(rsp *http.Response) (*GetConfigsByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetConfigsByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetConfigResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchConfigsByIdResponse parses an HTTP response from a PatchConfigsByIdWithResponse call
+func ParsePatchConfigsByIdResponse(rsp *http.Response) (*PatchConfigsByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchConfigsByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UpdateConfigResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchConfigsByIdAgentResponse parses an HTTP response from a PatchConfigsByIdAgentWithResponse call
+func ParsePatchConfigsByIdAgentResponse(rsp *http.Response) (*PatchConfigsByIdAgentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchConfigsByIdAgentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UpdateConfigAgentResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchConfigsByIdClusterResponse parses an HTTP response from a PatchConfigsByIdClusterWithResponse call
+func ParsePatchConfigsByIdClusterResponse(rsp *http.Response) (*PatchConfigsByIdClusterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchConfigsByIdClusterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UpdateConfigClusterResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetConfigsByIdDeniedAuthorsResponse parses an HTTP response from a GetConfigsByIdDeniedAuthorsWithResponse call
+func ParseGetConfigsByIdDeniedAuthorsResponse(rsp *http.Response) (*GetConfigsByIdDeniedAuthorsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetConfigsByIdDeniedAuthorsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeniedAuthorsBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutConfigsByIdDeniedAuthorsResponse parses an HTTP response from a PutConfigsByIdDeniedAuthorsWithResponse call
+func ParsePutConfigsByIdDeniedAuthorsResponse(rsp *http.Response) (*PutConfigsByIdDeniedAuthorsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutConfigsByIdDeniedAuthorsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeniedAuthorsBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetConfigsByIdDeployTokensResponse parses an HTTP response from a GetConfigsByIdDeployTokensWithResponse call
+func ParseGetConfigsByIdDeployTokensResponse(rsp *http.Response) (*GetConfigsByIdDeployTokensResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetConfigsByIdDeployTokensResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListDeployTokensResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostConfigsByIdDeployTokensResponse parses an HTTP response from a PostConfigsByIdDeployTokensWithResponse call
+func ParsePostConfigsByIdDeployTokensResponse(rsp *http.Response) (*PostConfigsByIdDeployTokensResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostConfigsByIdDeployTokensResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CreateDeployTokenResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteConfigsByIdDeployTokensByTokenIdResponse parses an HTTP response from a DeleteConfigsByIdDeployTokensByTokenIdWithResponse call
+func ParseDeleteConfigsByIdDeployTokensByTokenIdResponse(rsp *http.Response) (*DeleteConfigsByIdDeployTokensByTokenIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteConfigsByIdDeployTokensByTokenIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeleteDeployTokenResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetConfigsByIdDeploymentsResponse parses an HTTP response from a GetConfigsByIdDeploymentsWithResponse call
+func ParseGetConfigsByIdDeploymentsResponse(rsp *http.Response) (*GetConfigsByIdDeploymentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetConfigsByIdDeploymentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListConfigDeploymentsResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetConfigsByIdEnvResponse parses an HTTP response from a GetConfigsByIdEnvWithResponse call
+func ParseGetConfigsByIdEnvResponse(rsp *http.Response) (*GetConfigsByIdEnvResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetConfigsByIdEnvResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ConfigEnvBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostConfigsByIdEnvResponse parses an HTTP response from a PostConfigsByIdEnvWithResponse call
+func ParsePostConfigsByIdEnvResponse(rsp *http.Response) (*PostConfigsByIdEnvResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostConfigsByIdEnvResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ConfigEnvBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteConfigsByIdEnvByNameResponse parses an HTTP response from a DeleteConfigsByIdEnvByNameWithResponse call
+func ParseDeleteConfigsByIdEnvByNameResponse(rsp *http.Response) (*DeleteConfigsByIdEnvByNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteConfigsByIdEnvByNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UnsetConfigEnvResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetConfigsByIdEnvironmentsResponse parses an HTTP response from a GetConfigsByIdEnvironmentsWithResponse call
+func ParseGetConfigsByIdEnvironmentsResponse(rsp *http.Response) (*GetConfigsByIdEnvironmentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetConfigsByIdEnvironmentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListConfigEnvironmentsResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetConfigsByIdExportResponse parses an HTTP response from a GetConfigsByIdExportWithResponse call
+func ParseGetConfigsByIdExportResponse(rsp *http.Response) (*GetConfigsByIdExportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetConfigsByIdExportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Bundle
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteConfigsByIdRegistryWebhookResponse parses an HTTP response from a DeleteConfigsByIdRegistryWebhookWithResponse call
+func ParseDeleteConfigsByIdRegistryWebhookResponse(rsp *http.Response) (*DeleteConfigsByIdRegistryWebhookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteConfigsByIdRegistryWebhookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DisableRegistryWebhookResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostConfigsByIdRegistryWebhookResponse parses an HTTP response from a PostConfigsByIdRegistryWebhookWithResponse call
+func ParsePostConfigsByIdRegistryWebhookResponse(rsp *http.Response) (*PostConfigsByIdRegistryWebhookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostConfigsByIdRegistryWebhookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RotateRegistryWebhookResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostConfigsByIdRestoreResponse parses an HTTP response from a PostConfigsByIdRestoreWithResponse call
+func ParsePostConfigsByIdRestoreResponse(rsp *http.Response) (*PostConfigsByIdRestoreResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostConfigsByIdRestoreResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RestoreConfigResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetConfigsByIdRetentionResponse parses an HTTP response from a GetConfigsByIdRetentionWithResponse call
+func ParseGetConfigsByIdRetentionResponse(rsp *http.Response) (*GetConfigsByIdRetentionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetConfigsByIdRetentionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RetentionBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutConfigsByIdRetentionResponse parses an HTTP response from a PutConfigsByIdRetentionWithResponse call
+func ParsePutConfigsByIdRetentionResponse(rsp *http.Response) (*PutConfigsByIdRetentionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutConfigsByIdRetentionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RetentionBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetConfigsByIdSecretScanResponse parses an HTTP response from a GetConfigsByIdSecretScanWithResponse call
+func ParseGetConfigsByIdSecretScanResponse(rsp *http.Response) (*GetConfigsByIdSecretScanResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetConfigsByIdSecretScanResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SecretScanBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutConfigsByIdSecretScanResponse parses an HTTP response from a PutConfigsByIdSecretScanWithResponse call
+func ParsePutConfigsByIdSecretScanResponse(rsp *http.Response) (*PutConfigsByIdSecretScanResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutConfigsByIdSecretScanResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SecretScanBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteConfigsByIdTransferResponse parses an HTTP response from a DeleteConfigsByIdTransferWithResponse call
+func ParseDeleteConfigsByIdTransferResponse(rsp *http.Response) (*DeleteConfigsByIdTransferResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteConfigsByIdTransferResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CancelConfigTransferResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostConfigsByIdTransferResponse parses an HTTP response from a PostConfigsByIdTransferWithResponse call
+func ParsePostConfigsByIdTransferResponse(rsp *http.Response) (*PostConfigsByIdTransferResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostConfigsByIdTransferResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StartConfigTransferResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchConfigsByIdWebhookResponse parses an HTTP response from a PatchConfigsByIdWebhookWithResponse call
+func ParsePatchConfigsByIdWebhookResponse(rsp *http.Response) (*PatchConfigsByIdWebhookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchConfigsByIdWebhookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UpdateConfigWebhookResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostConfigsByIdWebhookResponse parses an HTTP response from a PostConfigsByIdWebhookWithResponse call
+func ParsePostConfigsByIdWebhookResponse(rsp *http.Response) (*PostConfigsByIdWebhookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostConfigsByIdWebhookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CreateConfigWebhookResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCredentialsResponse parses an HTTP response from a GetCredentialsWithResponse call
+func ParseGetCredentialsResponse(rsp *http.Response) (*GetCredentialsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCredentialsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListCredentialsResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostCredentialsResponse parses an HTTP response from a PostCredentialsWithResponse call
+func ParsePostCredentialsResponse(rsp *http.Response) (*PostCredentialsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostCredentialsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StoreCredentialResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCredentialsGithubInstallationTokenResponse parses an HTTP response from a GetCredentialsGithubInstallationTokenWithResponse call
+func ParseGetCredentialsGithubInstallationTokenResponse(rsp *http.Response) (*GetCredentialsGithubInstallationTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCredentialsGithubInstallationTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetGitHubInstallationTokenResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCredentialsGithubTokenResponse parses an HTTP response from a GetCredentialsGithubTokenWithResponse call
+func ParseGetCredentialsGithubTokenResponse(rsp *http.Response) (*GetCredentialsGithubTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCredentialsGithubTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetGitHubTokenResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteCredentialsByIdResponse parses an HTTP response from a DeleteCredentialsByIdWithResponse call
+func ParseDeleteCredentialsByIdResponse(rsp *http.Response) (*DeleteCredentialsByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteCredentialsByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeleteCredentialResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCredentialsByIdResponse parses an HTTP response from a GetCredentialsByIdWithResponse call
+func ParseGetCredentialsByIdResponse(rsp *http.Response) (*GetCredentialsByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCredentialsByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetCredentialResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutCredentialsByIdResponse parses an HTTP response from a PutCredentialsByIdWithResponse call
+func ParsePutCredentialsByIdResponse(rsp *http.Response) (*PutCredentialsByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutCredentialsByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetCredentialResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostCredentialsByIdRestoreResponse parses an HTTP response from a PostCredentialsByIdRestoreWithResponse call
+func ParsePostCredentialsByIdRestoreResponse(rsp *http.Response) (*PostCredentialsByIdRestoreResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostCredentialsByIdRestoreResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RestoreCredentialResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDeployStatusResponse parses an HTTP response from a GetDeployStatusWithResponse call
+func ParseGetDeployStatusResponse(rsp *http.Response) (*GetDeployStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDeployStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetDeployStatusResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostDeployTriggerResponse parses an HTTP response from a PostDeployTriggerWithResponse call
+func ParsePostDeployTriggerResponse(rsp *http.Response) (*PostDeployTriggerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostDeployTriggerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TriggerDeployResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDeploymentsByIdManifestsResponse parses an HTTP response from a GetDeploymentsByIdManifestsWithResponse call
+func ParseGetDeploymentsByIdManifestsResponse(rsp *http.Response) (*GetDeploymentsByIdManifestsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDeploymentsByIdManifestsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetDeploymentManifestsResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDeploymentsByIdResourcesResponse parses an HTTP response from a GetDeploymentsByIdResourcesWithResponse call
+func ParseGetDeploymentsByIdResourcesResponse(rsp *http.Response) (*GetDeploymentsByIdResourcesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDeploymentsByIdResourcesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetDeploymentResourcesResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostDeploymentsByIdRollbackResponse parses an HTTP response from a PostDeploymentsByIdRollbackWithResponse call
+func ParsePostDeploymentsByIdRollbackResponse(rsp *http.Response) (*PostDeploymentsByIdRollbackResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostDeploymentsByIdRollbackResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RollbackDeploymentResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostEmailChangeConfirmResponse parses an HTTP response from a PostEmailChangeConfirmWithResponse call
+func ParsePostEmailChangeConfirmResponse(rsp *http.Response) (*PostEmailChangeConfirmResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostEmailChangeConfirmResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ConfirmEmailChangeResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetGithubAppResponse parses an HTTP response from a GetGithubAppWithResponse call
+func ParseGetGithubAppResponse(rsp *http.Response) (*GetGithubAppResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetGithubAppResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetGitHubAppPublicResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostGithubInstallationsByIdCheckResponse parses an HTTP response from a PostGithubInstallationsByIdCheckWithResponse call
+func ParsePostGithubInstallationsByIdCheckResponse(rsp *http.Response) (*PostGithubInstallationsByIdCheckResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostGithubInstallationsByIdCheckResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CheckGitHubInstallationResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostGithubReposByOwnerByRepoBootstrapResponse parses an HTTP response from a PostGithubReposByOwnerByRepoBootstrapWithResponse call
+func ParsePostGithubReposByOwnerByRepoBootstrapResponse(rsp *http.Response) (*PostGithubReposByOwnerByRepoBootstrapResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostGithubReposByOwnerByRepoBootstrapResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BootstrapRepositoryResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetHealthResponse parses an HTTP response from a GetHealthWithResponse call
+func ParseGetHealthResponse(rsp *http.Response) (*GetHealthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetHealthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HealthCheckResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetHooksResponse parses an HTTP response from a GetHooksWithResponse call
+func ParseGetHooksResponse(rsp *http.Response) (*GetHooksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetHooksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListHooksResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostHooksResponse parses an HTTP response from a PostHooksWithResponse call
+func ParsePostHooksResponse(rsp *http.Response) (*PostHooksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostHooksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CreateHookResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteHooksByIdResponse parses an HTTP response from a DeleteHooksByIdWithResponse call
+func ParseDeleteHooksByIdResponse(rsp *http.Response) (*DeleteHooksByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteHooksByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeleteHookResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetJobsDeadResponse parses an HTTP response from a GetJobsDeadWithResponse call
+func ParseGetJobsDeadResponse(rsp *http.Response) (*GetJobsDeadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetJobsDeadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListDeadJobsResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostJobsDeadByIdRequeueResponse parses an HTTP response from a PostJobsDeadByIdRequeueWithResponse call
+func ParsePostJobsDeadByIdRequeueResponse(rsp *http.Response) (*PostJobsDeadByIdRequeueResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostJobsDeadByIdRequeueResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RequeueDeadJobResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostLoginResponse parses an HTTP response from a PostLoginWithResponse call
+func ParsePostLoginResponse(rsp *http.Response) (*PostLoginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostLoginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LoginResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostLoginOidcDeviceResponse parses an HTTP response from a PostLoginOidcDeviceWithResponse call
+func ParsePostLoginOidcDeviceResponse(rsp *http.Response) (*PostLoginOidcDeviceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostLoginOidcDeviceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StartOIDCLoginResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostLoginOidcTokenResponse parses an HTTP response from a PostLoginOidcTokenWithResponse call
+func ParsePostLoginOidcTokenResponse(rsp *http.Response) (*PostLoginOidcTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostLoginOidcTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LoginResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteMeResponse parses an HTTP response from a DeleteMeWithResponse call
+func ParseDeleteMeResponse(rsp *http.Response) (*DeleteMeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteMeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeleteMeResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMeResponse parses an HTTP response from a GetMeWithResponse call
+func ParseGetMeResponse(rsp *http.Response) (*GetMeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MeResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchMeResponse parses an HTTP response from a PatchMeWithResponse call
+func ParsePatchMeResponse(rsp *http.Response) (*PatchMeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchMeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UpdateMeResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostMe2FaResponse parses an HTTP response from a PostMe2FaWithResponse call
+func ParsePostMe2FaResponse(rsp *http.Response) (*PostMe2FaResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostMe2FaResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EnrollTwoFactorResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostMe2FaConfirmResponse parses an HTTP response from a PostMe2FaConfirmWithResponse call
+func ParsePostMe2FaConfirmResponse(rsp *http.Response) (*PostMe2FaConfirmResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostMe2FaConfirmResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ConfirmTwoFactorResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostMe2FaDisableResponse parses an HTTP response from a PostMe2FaDisableWithResponse call
+func ParsePostMe2FaDisableResponse(rsp *http.Response) (*PostMe2FaDisableResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostMe2FaDisableResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DisableTwoFactorResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMeNotificationsResponse parses an HTTP response from a GetMeNotificationsWithResponse call
+func ParseGetMeNotificationsResponse(rsp *http.Response) (*GetMeNotificationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMeNotificationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NotificationPreferencesBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutMeNotificationsResponse parses an HTTP response from a PutMeNotificationsWithResponse call
+func ParsePutMeNotificationsResponse(rsp *http.Response) (*PutMeNotificationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutMeNotificationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NotificationPreferencesBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteMeRegistryCredentialResponse parses an HTTP response from a DeleteMeRegistryCredentialWithResponse call
+func ParseDeleteMeRegistryCredentialResponse(rsp *http.Response) (*DeleteMeRegistryCredentialResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteMeRegistryCredentialResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SetDefaultRegistryCredentialResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutMeRegistryCredentialResponse parses an HTTP response from a PutMeRegistryCredentialWithResponse call
+func ParsePutMeRegistryCredentialResponse(rsp *http.Response) (*PutMeRegistryCredentialResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutMeRegistryCredentialResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SetDefaultRegistryCredentialResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPasswordResetResponse parses an HTTP response from a PostPasswordResetWithResponse call
+func ParsePostPasswordResetResponse(rsp *http.Response) (*PostPasswordResetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPasswordResetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RequestPasswordResetResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPasswordResetConfirmResponse parses an HTTP response from a PostPasswordResetConfirmWithResponse call
+func ParsePostPasswordResetConfirmResponse(rsp *http.Response) (*PostPasswordResetConfirmResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPasswordResetConfirmResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ResetPasswordResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPoliciesResponse parses an HTTP response from a GetPoliciesWithResponse call
+func ParseGetPoliciesResponse(rsp *http.Response) (*GetPoliciesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPoliciesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListPoliciesResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeletePoliciesByNameResponse parses an HTTP response from a DeletePoliciesByNameWithResponse call
+func ParseDeletePoliciesByNameResponse(rsp *http.Response) (*DeletePoliciesByNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeletePoliciesByNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeletePolicyResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutPoliciesByNameResponse parses an HTTP response from a PutPoliciesByNameWithResponse call
+func ParsePutPoliciesByNameResponse(rsp *http.Response) (*PutPoliciesByNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutPoliciesByNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PolicyResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetProvidersResponse parses an HTTP response from a GetProvidersWithResponse call
+func ParseGetProvidersResponse(rsp *http.Response) (*GetProvidersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProvidersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetProvidersResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostRegisterResponse parses an HTTP response from a PostRegisterWithResponse call
+func ParsePostRegisterResponse(rsp *http.Response) (*PostRegisterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostRegisterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RegisterResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSessionsResponse parses an HTTP response from a GetSessionsWithResponse call
+func ParseGetSessionsResponse(rsp *http.Response) (*GetSessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListSessionsResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteSessionsByIdResponse parses an HTTP response from a DeleteSessionsByIdWithResponse call
+func ParseDeleteSessionsByIdResponse(rsp *http.Response) (*DeleteSessionsByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteSessionsByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RevokeSessionResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTransfersResponse parses an HTTP response from a GetTransfersWithResponse call
+func ParseGetTransfersResponse(rsp *http.Response) (*GetTransfersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTransfersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListTransfersResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTransfersByIdAcceptResponse parses an HTTP response from a PostTransfersByIdAcceptWithResponse call
+func ParsePostTransfersByIdAcceptResponse(rsp *http.Response) (*PostTransfersByIdAcceptResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTransfersByIdAcceptResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AcceptTransferResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTransfersByIdDeclineResponse parses an HTTP response from a PostTransfersByIdDeclineWithResponse call
+func ParsePostTransfersByIdDeclineResponse(rsp *http.Response) (*PostTransfersByIdDeclineResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTransfersByIdDeclineResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeclineTransferResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTrashResponse parses an HTTP response from a GetTrashWithResponse call
+func ParseGetTrashResponse(rsp *http.Response) (*GetTrashResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTrashResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetTrashResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUsageResponse parses an HTTP response from a GetUsageWithResponse call
+func ParseGetUsageResponse(rsp *http.Response) (*GetUsageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetUsageResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetVersionResponse parses an HTTP response from a GetVersionWithResponse call
+func ParseGetVersionResponse(rsp *http.Response) (*GetVersionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetVersionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetVersionResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostWebhooksGithubAppResponse parses an HTTP response from a PostWebhooksGithubAppWithResponse call
+func ParsePostWebhooksGithubAppResponse(rsp *http.Response) (*PostWebhooksGithubAppResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostWebhooksGithubAppResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostWebhooksGithubByIdResponse parses an HTTP response from a PostWebhooksGithubByIdWithResponse call
+func ParsePostWebhooksGithubByIdResponse(rsp *http.Response) (*PostWebhooksGithubByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostWebhooksGithubByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostWebhooksRegistryByIdResponse parses an HTTP response from a PostWebhooksRegistryByIdWithResponse call
+func ParsePostWebhooksRegistryByIdResponse(rsp *http.Response) (*PostWebhooksRegistryByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostWebhooksRegistryByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RegistryWebhookResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
@@ -1 +1 @@
-oapi-codegen -generate "types,client" -package sdk openapi.yaml > internal/sdk/root.go
\ No newline at end of file
+oapi-codegen -generate "types,client" -package nimbul openapi.yaml > pkg/nimbul/root.go
\ No newline at end of file
@@ -0,0 +1,37 @@
+#!/usr/bin/env bash
+# Writes manifest.json for the CLI binaries (nimbul_<os>_<arch>[.exe]) in a release
+# directory and signs it as manifest.json.sig with the Ed25519 release key, the PEM file
+# in NIMBUL_RELEASE_SIGNING_KEY. CLIs verify it with internal/cli.ReleasePublicKey.
+#
+#   NIMBUL_RELEASE_SIGNING_KEY=release-key.pem scripts/sign-release.sh v1.2.3 dist
+set -euo pipefail
+
+version=${1:?usage: sign-release.sh <version> <dir>}
+dir=${2:?usage: sign-release.sh <version> <dir>}
+key=${NIMBUL_RELEASE_SIGNING_KEY:?NIMBUL_RELEASE_SIGNING_KEY must name the release key}
+
+if [[ ! $version =~ ^v[0-9]+\.[0-9]+\.[0-9]+ ]]; then
+	echo "version must look like v1.2.3, got $version" >&2
+	exit 1
+fi
+
+cd "$dir"
+shopt -s nullglob
+binaries=(nimbul_*)
+if [[ ${#binaries[@]} -eq 0 ]]; then
+	echo "no nimbul_<os>_<arch> binaries in $dir" >&2
+	exit 1
+fi
+
+{
+	printf '{"version":"%s","checksums":{' "$version"
+	separator=""
+	for binary in "${binaries[@]}"; do
+		printf '%s"%s":"%s"' "$separator" "$binary" "$(sha256sum "$binary" | cut -d' ' -f1)"
+		separator=","
+	done
+	printf '}}\n'
+} >manifest.json
+
+openssl pkeyutl -sign -inkey "$key" -rawin -in manifest.json | base64 -w0 >manifest.json.sig
+echo "Signed manifest.json of $version for ${#binaries[@]} binaries"